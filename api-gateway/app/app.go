@@ -0,0 +1,60 @@
+// Package app assembles the API gateway, for cmd/api-gateway and for
+// binaries that boot it in one process with the services behind it.
+package app
+
+import (
+	"api-gateway/internal/adapter/input/api"
+	"api-gateway/internal/adapter/output/auth/admin"
+	courierClient "api-gateway/internal/adapter/output/clients/courier"
+	customerClient "api-gateway/internal/adapter/output/clients/customer"
+	orderClient "api-gateway/internal/adapter/output/clients/order"
+	warehouseClient "api-gateway/internal/adapter/output/clients/warehouse"
+	"api-gateway/internal/infrastructure/config"
+	"api-gateway/internal/infrastructure/di"
+	"api-gateway/internal/infrastructure/logger"
+	"api-gateway/internal/infrastructure/telemetry"
+
+	"go.uber.org/fx"
+)
+
+// Module is the whole gateway. Its configuration is read from the
+// environment while the fx.App is being created, unless WithConfig sets it.
+var Module = fx.Options(
+	di.ConfigModule,
+	di.TelemetryModule,
+	di.ClientsModule,
+	di.AuthModule,
+	di.UseCasesModule,
+	di.LoggerModule,
+	di.ApiModule,
+)
+
+// Config is the configuration of the gateway, for binaries that set it up
+// themselves rather than through the environment.
+type Config struct {
+	Logger    logger.Config
+	Telemetry telemetry.Config
+	API       api.Config
+	Admin     admin.Config
+	Streaming config.StreamingConfig
+	Warehouse warehouseClient.Config
+	Courier   courierClient.Config
+	Customer  customerClient.Config
+	Order     orderClient.Config
+}
+
+// WithConfig has the gateway use cfg instead of reading its configuration
+// from the environment.
+func WithConfig(cfg Config) fx.Option {
+	return fx.Replace(
+		&cfg.Logger,
+		&cfg.Telemetry,
+		&cfg.API,
+		&cfg.Admin,
+		&cfg.Streaming,
+		&cfg.Warehouse,
+		&cfg.Courier,
+		&cfg.Customer,
+		&cfg.Order,
+	)
+}
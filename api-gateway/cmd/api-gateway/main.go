@@ -1,8 +1,8 @@
 package main
 
 import (
+	gatewayApp "api-gateway/app"
 	_ "api-gateway/internal/adapter/input/api/courier/request"
-	"api-gateway/internal/infrastructure/di"
 
 	"go.uber.org/fx"
 )
@@ -27,13 +27,5 @@ import (
 //	@description				Admin's access token.
 
 func main() {
-	fx.New(
-		di.ConfigModule,
-		di.TelemetryModule,
-		di.ClientsModule,
-		di.AuthModule,
-		di.UseCasesModule,
-		di.LoggerModule,
-		di.ApiModule,
-	).Run()
+	fx.New(gatewayApp.Module).Run()
 }
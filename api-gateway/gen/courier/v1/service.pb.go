@@ -4,7 +4,7 @@
 // 	protoc        v5.29.3
 // source: courier/v1/service.proto
 
-package courier_v1
+package v1
 
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -309,6 +309,147 @@ func (x *AuthenticateResponse) GetCourierId() string {
 	return ""
 }
 
+type GetCourierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourierId     string                 `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourierRequest) Reset() {
+	*x = GetCourierRequest{}
+	mi := &file_courier_v1_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourierRequest) ProtoMessage() {}
+
+func (x *GetCourierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courier_v1_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourierRequest.ProtoReflect.Descriptor instead.
+func (*GetCourierRequest) Descriptor() ([]byte, []int) {
+	return file_courier_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetCourierRequest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+type GetCourierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courier       *Courier               `protobuf:"bytes,1,opt,name=courier,proto3" json:"courier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourierResponse) Reset() {
+	*x = GetCourierResponse{}
+	mi := &file_courier_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourierResponse) ProtoMessage() {}
+
+func (x *GetCourierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courier_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourierResponse.ProtoReflect.Descriptor instead.
+func (*GetCourierResponse) Descriptor() ([]byte, []int) {
+	return file_courier_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCourierResponse) GetCourier() *Courier {
+	if x != nil {
+		return x.Courier
+	}
+	return nil
+}
+
+// The public profile of a courier.
+type Courier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourierId     string                 `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Courier) Reset() {
+	*x = Courier{}
+	mi := &file_courier_v1_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Courier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Courier) ProtoMessage() {}
+
+func (x *Courier) ProtoReflect() protoreflect.Message {
+	mi := &file_courier_v1_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Courier.ProtoReflect.Descriptor instead.
+func (*Courier) Descriptor() ([]byte, []int) {
+	return file_courier_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Courier) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *Courier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 var File_courier_v1_service_proto protoreflect.FileDescriptor
 
 const file_courier_v1_service_proto_rawDesc = "" +
@@ -331,11 +472,23 @@ const file_courier_v1_service_proto_rawDesc = "" +
 	"\x05token\x18\x01 \x01(\tR\x05token\"5\n" +
 	"\x14AuthenticateResponse\x12\x1d\n" +
 	"\n" +
-	"courier_id\x18\x01 \x01(\tR\tcourierId2\xec\x01\n" +
+	"courier_id\x18\x01 \x01(\tR\tcourierId\"2\n" +
+	"\x11GetCourierRequest\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\tR\tcourierId\"C\n" +
+	"\x12GetCourierResponse\x12-\n" +
+	"\acourier\x18\x01 \x01(\v2\x13.courier.v1.CourierR\acourier\"<\n" +
+	"\aCourier\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\tR\tcourierId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name2\xec\x01\n" +
 	"\x12CourierAuthService\x12E\n" +
 	"\bRegister\x12\x1b.courier.v1.RegisterRequest\x1a\x1c.courier.v1.RegisterResponse\x12<\n" +
 	"\x05Login\x12\x18.courier.v1.LoginRequest\x1a\x19.courier.v1.LoginResponse\x12Q\n" +
-	"\fAuthenticate\x12\x1f.courier.v1.AuthenticateRequest\x1a .courier.v1.AuthenticateResponseBPZNgithub.com/OlegDokuchaev/clean-ddd-app/api-gateway/proto/courier/v1;courier_v1b\x06proto3"
+	"\fAuthenticate\x12\x1f.courier.v1.AuthenticateRequest\x1a .courier.v1.AuthenticateResponse2]\n" +
+	"\x0eCourierService\x12K\n" +
+	"\n" +
+	"GetCourier\x12\x1d.courier.v1.GetCourierRequest\x1a\x1e.courier.v1.GetCourierResponseBPZNgithub.com/OlegDokuchaev/clean-ddd-app/api-gateway/proto/courier/v1;courier_v1b\x06proto3"
 
 var (
 	file_courier_v1_service_proto_rawDescOnce sync.Once
@@ -349,7 +502,7 @@ func file_courier_v1_service_proto_rawDescGZIP() []byte {
 	return file_courier_v1_service_proto_rawDescData
 }
 
-var file_courier_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_courier_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_courier_v1_service_proto_goTypes = []any{
 	(*RegisterRequest)(nil),      // 0: courier.v1.RegisterRequest
 	(*RegisterResponse)(nil),     // 1: courier.v1.RegisterResponse
@@ -357,19 +510,25 @@ var file_courier_v1_service_proto_goTypes = []any{
 	(*LoginResponse)(nil),        // 3: courier.v1.LoginResponse
 	(*AuthenticateRequest)(nil),  // 4: courier.v1.AuthenticateRequest
 	(*AuthenticateResponse)(nil), // 5: courier.v1.AuthenticateResponse
+	(*GetCourierRequest)(nil),    // 6: courier.v1.GetCourierRequest
+	(*GetCourierResponse)(nil),   // 7: courier.v1.GetCourierResponse
+	(*Courier)(nil),              // 8: courier.v1.Courier
 }
 var file_courier_v1_service_proto_depIdxs = []int32{
-	0, // 0: courier.v1.CourierAuthService.Register:input_type -> courier.v1.RegisterRequest
-	2, // 1: courier.v1.CourierAuthService.Login:input_type -> courier.v1.LoginRequest
-	4, // 2: courier.v1.CourierAuthService.Authenticate:input_type -> courier.v1.AuthenticateRequest
-	1, // 3: courier.v1.CourierAuthService.Register:output_type -> courier.v1.RegisterResponse
-	3, // 4: courier.v1.CourierAuthService.Login:output_type -> courier.v1.LoginResponse
-	5, // 5: courier.v1.CourierAuthService.Authenticate:output_type -> courier.v1.AuthenticateResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	8, // 0: courier.v1.GetCourierResponse.courier:type_name -> courier.v1.Courier
+	0, // 1: courier.v1.CourierAuthService.Register:input_type -> courier.v1.RegisterRequest
+	2, // 2: courier.v1.CourierAuthService.Login:input_type -> courier.v1.LoginRequest
+	4, // 3: courier.v1.CourierAuthService.Authenticate:input_type -> courier.v1.AuthenticateRequest
+	6, // 4: courier.v1.CourierService.GetCourier:input_type -> courier.v1.GetCourierRequest
+	1, // 5: courier.v1.CourierAuthService.Register:output_type -> courier.v1.RegisterResponse
+	3, // 6: courier.v1.CourierAuthService.Login:output_type -> courier.v1.LoginResponse
+	5, // 7: courier.v1.CourierAuthService.Authenticate:output_type -> courier.v1.AuthenticateResponse
+	7, // 8: courier.v1.CourierService.GetCourier:output_type -> courier.v1.GetCourierResponse
+	5, // [5:9] is the sub-list for method output_type
+	1, // [1:5] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_courier_v1_service_proto_init() }
@@ -383,9 +542,9 @@ func file_courier_v1_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_courier_v1_service_proto_rawDesc), len(file_courier_v1_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_courier_v1_service_proto_goTypes,
 		DependencyIndexes: file_courier_v1_service_proto_depIdxs,
@@ -4,7 +4,7 @@
 // - protoc             v5.29.3
 // source: courier/v1/service.proto
 
-package courier_v1
+package v1
 
 import (
 	context "context"
@@ -201,3 +201,109 @@ var CourierAuthService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "courier/v1/service.proto",
 }
+
+const (
+	CourierService_GetCourier_FullMethodName = "/courier.v1.CourierService/GetCourier"
+)
+
+// CourierServiceClient is the client API for CourierService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CourierService provides read access to courier profiles.
+type CourierServiceClient interface {
+	GetCourier(ctx context.Context, in *GetCourierRequest, opts ...grpc.CallOption) (*GetCourierResponse, error)
+}
+
+type courierServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCourierServiceClient(cc grpc.ClientConnInterface) CourierServiceClient {
+	return &courierServiceClient{cc}
+}
+
+func (c *courierServiceClient) GetCourier(ctx context.Context, in *GetCourierRequest, opts ...grpc.CallOption) (*GetCourierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourierResponse)
+	err := c.cc.Invoke(ctx, CourierService_GetCourier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CourierServiceServer is the server API for CourierService service.
+// All implementations must embed UnimplementedCourierServiceServer
+// for forward compatibility.
+//
+// CourierService provides read access to courier profiles.
+type CourierServiceServer interface {
+	GetCourier(context.Context, *GetCourierRequest) (*GetCourierResponse, error)
+	mustEmbedUnimplementedCourierServiceServer()
+}
+
+// UnimplementedCourierServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCourierServiceServer struct{}
+
+func (UnimplementedCourierServiceServer) GetCourier(context.Context, *GetCourierRequest) (*GetCourierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourier not implemented")
+}
+func (UnimplementedCourierServiceServer) mustEmbedUnimplementedCourierServiceServer() {}
+func (UnimplementedCourierServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCourierServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CourierServiceServer will
+// result in compilation errors.
+type UnsafeCourierServiceServer interface {
+	mustEmbedUnimplementedCourierServiceServer()
+}
+
+func RegisterCourierServiceServer(s grpc.ServiceRegistrar, srv CourierServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCourierServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CourierService_ServiceDesc, srv)
+}
+
+func _CourierService_GetCourier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierServiceServer).GetCourier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourierService_GetCourier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierServiceServer).GetCourier(ctx, req.(*GetCourierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CourierService_ServiceDesc is the grpc.ServiceDesc for CourierService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CourierService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "courier.v1.CourierService",
+	HandlerType: (*CourierServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCourier",
+			Handler:    _CourierService_GetCourier_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "courier/v1/service.proto",
+}
@@ -9,6 +9,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -32,6 +33,9 @@ const (
 	OrderStatus_DELIVERING                 OrderStatus = 3
 	OrderStatus_DELIVERED                  OrderStatus = 4
 	OrderStatus_CUSTOMER_CANCELED          OrderStatus = 5
+	OrderStatus_CANCELED_TIMEOUT           OrderStatus = 6
+	OrderStatus_CANCELING                  OrderStatus = 7
+	OrderStatus_CANCELED_BY_ADMIN          OrderStatus = 8
 )
 
 // Enum value maps for OrderStatus.
@@ -43,6 +47,9 @@ var (
 		3: "DELIVERING",
 		4: "DELIVERED",
 		5: "CUSTOMER_CANCELED",
+		6: "CANCELED_TIMEOUT",
+		7: "CANCELING",
+		8: "CANCELED_BY_ADMIN",
 	}
 	OrderStatus_value = map[string]int32{
 		"CREATED":                    0,
@@ -51,6 +58,9 @@ var (
 		"DELIVERING":                 3,
 		"DELIVERED":                  4,
 		"CUSTOMER_CANCELED":          5,
+		"CANCELED_TIMEOUT":           6,
+		"CANCELING":                  7,
+		"CANCELED_BY_ADMIN":          8,
 	}
 )
 
@@ -81,13 +91,442 @@ func (OrderStatus) EnumDescriptor() ([]byte, []int) {
 	return file_order_v1_service_proto_rawDescGZIP(), []int{0}
 }
 
+// What happens when some items are out of stock.
+type FulfillmentPolicy int32
+
+const (
+	// The order is canceled.
+	FulfillmentPolicy_ALL_OR_NOTHING FulfillmentPolicy = 0
+	// The order goes ahead with what is in stock, unless nothing is.
+	FulfillmentPolicy_ALLOW_PARTIAL FulfillmentPolicy = 1
+)
+
+// Enum value maps for FulfillmentPolicy.
+var (
+	FulfillmentPolicy_name = map[int32]string{
+		0: "ALL_OR_NOTHING",
+		1: "ALLOW_PARTIAL",
+	}
+	FulfillmentPolicy_value = map[string]int32{
+		"ALL_OR_NOTHING": 0,
+		"ALLOW_PARTIAL":  1,
+	}
+)
+
+func (x FulfillmentPolicy) Enum() *FulfillmentPolicy {
+	p := new(FulfillmentPolicy)
+	*p = x
+	return p
+}
+
+func (x FulfillmentPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FulfillmentPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_v1_service_proto_enumTypes[1].Descriptor()
+}
+
+func (FulfillmentPolicy) Type() protoreflect.EnumType {
+	return &file_order_v1_service_proto_enumTypes[1]
+}
+
+func (x FulfillmentPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FulfillmentPolicy.Descriptor instead.
+func (FulfillmentPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+type ActorType int32
+
+const (
+	ActorType_SYSTEM   ActorType = 0
+	ActorType_CUSTOMER ActorType = 1
+	ActorType_COURIER  ActorType = 2
+	ActorType_ADMIN    ActorType = 3
+)
+
+// Enum value maps for ActorType.
+var (
+	ActorType_name = map[int32]string{
+		0: "SYSTEM",
+		1: "CUSTOMER",
+		2: "COURIER",
+		3: "ADMIN",
+	}
+	ActorType_value = map[string]int32{
+		"SYSTEM":   0,
+		"CUSTOMER": 1,
+		"COURIER":  2,
+		"ADMIN":    3,
+	}
+)
+
+func (x ActorType) Enum() *ActorType {
+	p := new(ActorType)
+	*p = x
+	return p
+}
+
+func (x ActorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_v1_service_proto_enumTypes[2].Descriptor()
+}
+
+func (ActorType) Type() protoreflect.EnumType {
+	return &file_order_v1_service_proto_enumTypes[2]
+}
+
+func (x ActorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActorType.Descriptor instead.
+func (ActorType) EnumDescriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+type PromotionKind int32
+
+const (
+	PromotionKind_PERCENTAGE   PromotionKind = 0
+	PromotionKind_FIXED_AMOUNT PromotionKind = 1
+)
+
+// Enum value maps for PromotionKind.
+var (
+	PromotionKind_name = map[int32]string{
+		0: "PERCENTAGE",
+		1: "FIXED_AMOUNT",
+	}
+	PromotionKind_value = map[string]int32{
+		"PERCENTAGE":   0,
+		"FIXED_AMOUNT": 1,
+	}
+)
+
+func (x PromotionKind) Enum() *PromotionKind {
+	p := new(PromotionKind)
+	*p = x
+	return p
+}
+
+func (x PromotionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PromotionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_v1_service_proto_enumTypes[3].Descriptor()
+}
+
+func (PromotionKind) Type() protoreflect.EnumType {
+	return &file_order_v1_service_proto_enumTypes[3]
+}
+
+func (x PromotionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PromotionKind.Descriptor instead.
+func (PromotionKind) EnumDescriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+type OrderSort int32
+
+const (
+	OrderSort_NEWEST_FIRST OrderSort = 0
+	OrderSort_OLDEST_FIRST OrderSort = 1
+)
+
+// Enum value maps for OrderSort.
+var (
+	OrderSort_name = map[int32]string{
+		0: "NEWEST_FIRST",
+		1: "OLDEST_FIRST",
+	}
+	OrderSort_value = map[string]int32{
+		"NEWEST_FIRST": 0,
+		"OLDEST_FIRST": 1,
+	}
+)
+
+func (x OrderSort) Enum() *OrderSort {
+	p := new(OrderSort)
+	*p = x
+	return p
+}
+
+func (x OrderSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_v1_service_proto_enumTypes[4].Descriptor()
+}
+
+func (OrderSort) Type() protoreflect.EnumType {
+	return &file_order_v1_service_proto_enumTypes[4]
+}
+
+func (x OrderSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderSort.Descriptor instead.
+func (OrderSort) EnumDescriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+type SagaType int32
+
+const (
+	SagaType_CREATE_ORDER SagaType = 0
+	SagaType_CANCEL_ORDER SagaType = 1
+)
+
+// Enum value maps for SagaType.
+var (
+	SagaType_name = map[int32]string{
+		0: "CREATE_ORDER",
+		1: "CANCEL_ORDER",
+	}
+	SagaType_value = map[string]int32{
+		"CREATE_ORDER": 0,
+		"CANCEL_ORDER": 1,
+	}
+)
+
+func (x SagaType) Enum() *SagaType {
+	p := new(SagaType)
+	*p = x
+	return p
+}
+
+func (x SagaType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SagaType) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_v1_service_proto_enumTypes[5].Descriptor()
+}
+
+func (SagaType) Type() protoreflect.EnumType {
+	return &file_order_v1_service_proto_enumTypes[5]
+}
+
+func (x SagaType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SagaType.Descriptor instead.
+func (SagaType) EnumDescriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+type SagaStep int32
+
+const (
+	SagaStep_RESERVING_ITEMS             SagaStep = 0
+	SagaStep_ASSIGNING_COURIER           SagaStep = 1
+	SagaStep_BEGINNING_DELIVERY          SagaStep = 2
+	SagaStep_CANCELING_OUT_OF_STOCK      SagaStep = 3
+	SagaStep_RELEASING_ITEMS             SagaStep = 4
+	SagaStep_CANCELING_COURIER_NOT_FOUND SagaStep = 5
+	SagaStep_RELEASING_ITEMS_ON_TIMEOUT  SagaStep = 6
+	SagaStep_CANCELING_TIMEOUT           SagaStep = 7
+	SagaStep_SUPERSEDED_BY_CANCEL        SagaStep = 8
+	SagaStep_RELEASING_ITEMS_AND_COURIER SagaStep = 9
+	SagaStep_AWAITING_COURIER_RELEASE    SagaStep = 10
+	SagaStep_AWAITING_ITEMS_RELEASE      SagaStep = 11
+	SagaStep_CANCELING_BY_CUSTOMER       SagaStep = 12
+	SagaStep_AWAITING_DELIVERY_SLOT      SagaStep = 13
+)
+
+// Enum value maps for SagaStep.
+var (
+	SagaStep_name = map[int32]string{
+		0:  "RESERVING_ITEMS",
+		1:  "ASSIGNING_COURIER",
+		2:  "BEGINNING_DELIVERY",
+		3:  "CANCELING_OUT_OF_STOCK",
+		4:  "RELEASING_ITEMS",
+		5:  "CANCELING_COURIER_NOT_FOUND",
+		6:  "RELEASING_ITEMS_ON_TIMEOUT",
+		7:  "CANCELING_TIMEOUT",
+		8:  "SUPERSEDED_BY_CANCEL",
+		9:  "RELEASING_ITEMS_AND_COURIER",
+		10: "AWAITING_COURIER_RELEASE",
+		11: "AWAITING_ITEMS_RELEASE",
+		12: "CANCELING_BY_CUSTOMER",
+		13: "AWAITING_DELIVERY_SLOT",
+	}
+	SagaStep_value = map[string]int32{
+		"RESERVING_ITEMS":             0,
+		"ASSIGNING_COURIER":           1,
+		"BEGINNING_DELIVERY":          2,
+		"CANCELING_OUT_OF_STOCK":      3,
+		"RELEASING_ITEMS":             4,
+		"CANCELING_COURIER_NOT_FOUND": 5,
+		"RELEASING_ITEMS_ON_TIMEOUT":  6,
+		"CANCELING_TIMEOUT":           7,
+		"SUPERSEDED_BY_CANCEL":        8,
+		"RELEASING_ITEMS_AND_COURIER": 9,
+		"AWAITING_COURIER_RELEASE":    10,
+		"AWAITING_ITEMS_RELEASE":      11,
+		"CANCELING_BY_CUSTOMER":       12,
+		"AWAITING_DELIVERY_SLOT":      13,
+	}
+)
+
+func (x SagaStep) Enum() *SagaStep {
+	p := new(SagaStep)
+	*p = x
+	return p
+}
+
+func (x SagaStep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SagaStep) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_v1_service_proto_enumTypes[6].Descriptor()
+}
+
+func (SagaStep) Type() protoreflect.EnumType {
+	return &file_order_v1_service_proto_enumTypes[6]
+}
+
+func (x SagaStep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SagaStep.Descriptor instead.
+func (SagaStep) EnumDescriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+type RevenueGranularity int32
+
+const (
+	RevenueGranularity_DAY  RevenueGranularity = 0
+	RevenueGranularity_WEEK RevenueGranularity = 1
+)
+
+// Enum value maps for RevenueGranularity.
+var (
+	RevenueGranularity_name = map[int32]string{
+		0: "DAY",
+		1: "WEEK",
+	}
+	RevenueGranularity_value = map[string]int32{
+		"DAY":  0,
+		"WEEK": 1,
+	}
+)
+
+func (x RevenueGranularity) Enum() *RevenueGranularity {
+	p := new(RevenueGranularity)
+	*p = x
+	return p
+}
+
+func (x RevenueGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevenueGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_v1_service_proto_enumTypes[7].Descriptor()
+}
+
+func (RevenueGranularity) Type() protoreflect.EnumType {
+	return &file_order_v1_service_proto_enumTypes[7]
+}
+
+func (x RevenueGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevenueGranularity.Descriptor instead.
+func (RevenueGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+type CancelReason int32
+
+const (
+	CancelReason_CANCEL_REASON_CUSTOMER          CancelReason = 0
+	CancelReason_CANCEL_REASON_OUT_OF_STOCK      CancelReason = 1
+	CancelReason_CANCEL_REASON_COURIER_NOT_FOUND CancelReason = 2
+	CancelReason_CANCEL_REASON_TIMEOUT           CancelReason = 3
+	CancelReason_CANCEL_REASON_ADMIN             CancelReason = 4
+)
+
+// Enum value maps for CancelReason.
+var (
+	CancelReason_name = map[int32]string{
+		0: "CANCEL_REASON_CUSTOMER",
+		1: "CANCEL_REASON_OUT_OF_STOCK",
+		2: "CANCEL_REASON_COURIER_NOT_FOUND",
+		3: "CANCEL_REASON_TIMEOUT",
+		4: "CANCEL_REASON_ADMIN",
+	}
+	CancelReason_value = map[string]int32{
+		"CANCEL_REASON_CUSTOMER":          0,
+		"CANCEL_REASON_OUT_OF_STOCK":      1,
+		"CANCEL_REASON_COURIER_NOT_FOUND": 2,
+		"CANCEL_REASON_TIMEOUT":           3,
+		"CANCEL_REASON_ADMIN":             4,
+	}
+)
+
+func (x CancelReason) Enum() *CancelReason {
+	p := new(CancelReason)
+	*p = x
+	return p
+}
+
+func (x CancelReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CancelReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_v1_service_proto_enumTypes[8].Descriptor()
+}
+
+func (CancelReason) Type() protoreflect.EnumType {
+	return &file_order_v1_service_proto_enumTypes[8]
+}
+
+func (x CancelReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CancelReason.Descriptor instead.
+func (CancelReason) EnumDescriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
 type CreateOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Items         []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Items      []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// Optional, case-insensitive.
+	PromoCode string   `protobuf:"bytes,4,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	Address   *Address `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	// Optional; must be one of the slots GetAvailableSlots returns. Without one
+	// the order is delivered as soon as possible.
+	DeliverySlot *DeliverySlot `protobuf:"bytes,6,opt,name=delivery_slot,json=deliverySlot,proto3" json:"delivery_slot,omitempty"`
+	// Optional; by default the order is canceled unless every item is in stock.
+	FulfillmentPolicy FulfillmentPolicy `protobuf:"varint,7,opt,name=fulfillment_policy,json=fulfillmentPolicy,proto3,enum=order.v1.FulfillmentPolicy" json:"fulfillment_policy,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CreateOrderRequest) Reset() {
@@ -127,20 +566,41 @@ func (x *CreateOrderRequest) GetCustomerId() string {
 	return ""
 }
 
-func (x *CreateOrderRequest) GetAddress() string {
+func (x *CreateOrderRequest) GetItems() []*OrderItem {
 	if x != nil {
-		return x.Address
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
 	}
 	return ""
 }
 
-func (x *CreateOrderRequest) GetItems() []*OrderItem {
+func (x *CreateOrderRequest) GetAddress() *Address {
 	if x != nil {
-		return x.Items
+		return x.Address
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetDeliverySlot() *DeliverySlot {
+	if x != nil {
+		return x.DeliverySlot
 	}
 	return nil
 }
 
+func (x *CreateOrderRequest) GetFulfillmentPolicy() FulfillmentPolicy {
+	if x != nil {
+		return x.FulfillmentPolicy
+	}
+	return FulfillmentPolicy_ALL_OR_NOTHING
+}
+
 type CreateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -185,27 +645,29 @@ func (x *CreateOrderResponse) GetOrderId() string {
 	return ""
 }
 
-type CancelOrderByCustomerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+type GetOrderRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Who is asking; the id is required for customers and couriers.
+	Requester     *Actor `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelOrderByCustomerRequest) Reset() {
-	*x = CancelOrderByCustomerRequest{}
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
 	mi := &file_order_v1_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelOrderByCustomerRequest) String() string {
+func (x *GetOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelOrderByCustomerRequest) ProtoMessage() {}
+func (*GetOrderRequest) ProtoMessage() {}
 
-func (x *CancelOrderByCustomerRequest) ProtoReflect() protoreflect.Message {
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_v1_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -217,40 +679,2755 @@ func (x *CancelOrderByCustomerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelOrderByCustomerRequest.ProtoReflect.Descriptor instead.
-func (*CancelOrderByCustomerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
 	return file_order_v1_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CancelOrderByCustomerRequest) GetOrderId() string {
+func (x *GetOrderRequest) GetOrderId() string {
 	if x != nil {
 		return x.OrderId
 	}
 	return ""
 }
 
-type CompleteDeliveryRequest struct {
+func (x *GetOrderRequest) GetRequester() *Actor {
+	if x != nil {
+		return x.Requester
+	}
+	return nil
+}
+
+type GetOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderResponse) Reset() {
+	*x = GetOrderResponse{}
+	mi := &file_order_v1_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderResponse) ProtoMessage() {}
+
+func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type CancelOrderByCustomerRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	OrderId    string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// Free-form reason stored on the order; at most 500 characters.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderByCustomerRequest) Reset() {
+	*x = CancelOrderByCustomerRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderByCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderByCustomerRequest) ProtoMessage() {}
+
+func (x *CancelOrderByCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderByCustomerRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderByCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CancelOrderByCustomerRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelOrderByCustomerRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CancelOrderByCustomerRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CompleteDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CourierId     string                 `protobuf:"bytes,2,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteDeliveryRequest) Reset() {
+	*x = CompleteDeliveryRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteDeliveryRequest) ProtoMessage() {}
+
+func (x *CompleteDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*CompleteDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CompleteDeliveryRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CompleteDeliveryRequest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+type GetOrdersByCustomerRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// Page size; 0 selects the server default, larger values are capped.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Opaque token from a previous response's next_cursor; empty for the first page.
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Restricts the listing to these statuses; empty means any status.
+	Statuses []OrderStatus `protobuf:"varint,5,rep,packed,name=statuses,proto3,enum=order.v1.OrderStatus" json:"statuses,omitempty"`
+	// Inclusive lower bound on the order creation time.
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	// Exclusive upper bound on the order creation time.
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Sort          OrderSort              `protobuf:"varint,8,opt,name=sort,proto3,enum=order.v1.OrderSort" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersByCustomerRequest) Reset() {
+	*x = GetOrdersByCustomerRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByCustomerRequest) ProtoMessage() {}
+
+func (x *GetOrdersByCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByCustomerRequest.ProtoReflect.Descriptor instead.
+func (*GetOrdersByCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetOrdersByCustomerRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *GetOrdersByCustomerRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetOrdersByCustomerRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetOrdersByCustomerRequest) GetStatuses() []OrderStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *GetOrdersByCustomerRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetOrdersByCustomerRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *GetOrdersByCustomerRequest) GetSort() OrderSort {
+	if x != nil {
+		return x.Sort
+	}
+	return OrderSort_NEWEST_FIRST
+}
+
+type GetOrdersByCustomerResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Orders []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// Empty when there are no more pages.
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersByCustomerResponse) Reset() {
+	*x = GetOrdersByCustomerResponse{}
+	mi := &file_order_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByCustomerResponse) ProtoMessage() {}
+
+func (x *GetOrdersByCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByCustomerResponse.ProtoReflect.Descriptor instead.
+func (*GetOrdersByCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetOrdersByCustomerResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *GetOrdersByCustomerResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Orders the courier is delivering right now, oldest assignment first.
+type GetCurrentOrdersByCourierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourierId     string                 `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentOrdersByCourierRequest) Reset() {
+	*x = GetCurrentOrdersByCourierRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentOrdersByCourierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentOrdersByCourierRequest) ProtoMessage() {}
+
+func (x *GetCurrentOrdersByCourierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentOrdersByCourierRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentOrdersByCourierRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetCurrentOrdersByCourierRequest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+type GetCurrentOrdersByCourierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentOrdersByCourierResponse) Reset() {
+	*x = GetCurrentOrdersByCourierResponse{}
+	mi := &file_order_v1_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentOrdersByCourierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentOrdersByCourierResponse) ProtoMessage() {}
+
+func (x *GetCurrentOrdersByCourierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentOrdersByCourierResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentOrdersByCourierResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetCurrentOrdersByCourierResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type GetCourierOrderHistoryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CourierId string                 `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	// Page size; 0 selects the server default, larger values are capped.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Opaque token from a previous response's next_cursor; empty for the first page.
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Restricts the listing to these statuses; empty means any status.
+	Statuses []OrderStatus `protobuf:"varint,5,rep,packed,name=statuses,proto3,enum=order.v1.OrderStatus" json:"statuses,omitempty"`
+	// Inclusive lower bound on the order creation time.
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	// Exclusive upper bound on the order creation time.
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Sort          OrderSort              `protobuf:"varint,8,opt,name=sort,proto3,enum=order.v1.OrderSort" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourierOrderHistoryRequest) Reset() {
+	*x = GetCourierOrderHistoryRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourierOrderHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourierOrderHistoryRequest) ProtoMessage() {}
+
+func (x *GetCourierOrderHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourierOrderHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCourierOrderHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCourierOrderHistoryRequest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *GetCourierOrderHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCourierOrderHistoryRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetCourierOrderHistoryRequest) GetStatuses() []OrderStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *GetCourierOrderHistoryRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetCourierOrderHistoryRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *GetCourierOrderHistoryRequest) GetSort() OrderSort {
+	if x != nil {
+		return x.Sort
+	}
+	return OrderSort_NEWEST_FIRST
+}
+
+type GetCourierOrderHistoryResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Orders []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// Empty when there are no more pages.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// Orders per status over the requested creation time range, ignoring status filters.
+	Counts        []*OrderStatusCount `protobuf:"bytes,3,rep,name=counts,proto3" json:"counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourierOrderHistoryResponse) Reset() {
+	*x = GetCourierOrderHistoryResponse{}
+	mi := &file_order_v1_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourierOrderHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourierOrderHistoryResponse) ProtoMessage() {}
+
+func (x *GetCourierOrderHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourierOrderHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCourierOrderHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCourierOrderHistoryResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *GetCourierOrderHistoryResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetCourierOrderHistoryResponse) GetCounts() []*OrderStatusCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type OrderStatusCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        OrderStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=order.v1.OrderStatus" json:"status,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusCount) Reset() {
+	*x = OrderStatusCount{}
+	mi := &file_order_v1_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusCount) ProtoMessage() {}
+
+func (x *OrderStatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusCount.ProtoReflect.Descriptor instead.
+func (*OrderStatusCount) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OrderStatusCount) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_CREATED
+}
+
+func (x *OrderStatusCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetSagaStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSagaStateRequest) Reset() {
+	*x = GetSagaStateRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSagaStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSagaStateRequest) ProtoMessage() {}
+
+func (x *GetSagaStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSagaStateRequest.ProtoReflect.Descriptor instead.
+func (*GetSagaStateRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetSagaStateRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetSagaStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sagas         []*Saga                `protobuf:"bytes,1,rep,name=sagas,proto3" json:"sagas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSagaStateResponse) Reset() {
+	*x = GetSagaStateResponse{}
+	mi := &file_order_v1_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSagaStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSagaStateResponse) ProtoMessage() {}
+
+func (x *GetSagaStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSagaStateResponse.ProtoReflect.Descriptor instead.
+func (*GetSagaStateResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetSagaStateResponse) GetSagas() []*Saga {
+	if x != nil {
+		return x.Sagas
+	}
+	return nil
+}
+
+type Order struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	OrderId    string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Status     OrderStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=order.v1.OrderStatus" json:"status,omitempty"`
+	Version    string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Items      []*OrderItem           `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	Delivery   *Delivery              `protobuf:"bytes,6,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	Created    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`
+	// Set when the customer canceled the order and gave a reason, or an admin
+	// canceled it.
+	CancelReason string `protobuf:"bytes,8,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	// Subtotal less the discount.
+	Total *Money `protobuf:"bytes,10,opt,name=total,proto3" json:"total,omitempty"`
+	// Set when the order was placed with a promo code.
+	Discount *Discount `protobuf:"bytes,11,opt,name=discount,proto3" json:"discount,omitempty"`
+	// Sum of the item line totals.
+	Subtotal          *Money            `protobuf:"bytes,12,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	FulfillmentPolicy FulfillmentPolicy `protobuf:"varint,13,opt,name=fulfillment_policy,json=fulfillmentPolicy,proto3,enum=order.v1.FulfillmentPolicy" json:"fulfillment_policy,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_order_v1_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Order) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Order) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Order) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_CREATED
+}
+
+func (x *Order) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Order) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetDelivery() *Delivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+func (x *Order) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Order) GetCancelReason() string {
+	if x != nil {
+		return x.CancelReason
+	}
+	return ""
+}
+
+func (x *Order) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *Order) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *Order) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *Order) GetFulfillmentPolicy() FulfillmentPolicy {
+	if x != nil {
+		return x.FulfillmentPolicy
+	}
+	return FulfillmentPolicy_ALL_OR_NOTHING
+}
+
+type Discount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCode     string                 `protobuf:"bytes,1,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Discount) Reset() {
+	*x = Discount{}
+	mi := &file_order_v1_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Discount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discount) ProtoMessage() {}
+
+func (x *Discount) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discount.ProtoReflect.Descriptor instead.
+func (*Discount) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Discount) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *Discount) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// On CreateOrder only product_id, price and count are read; price must equal
+// the current catalog price. Orders carry the catalog snapshot taken at creation.
+type OrderItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Count     int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Name      string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Unit price.
+	Price *Money `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	// price * count.
+	LineTotal *Money `protobuf:"bytes,7,opt,name=line_total,json=lineTotal,proto3" json:"line_total,omitempty"`
+	// The count the customer asked for; count is what is delivered and charged,
+	// which is less when the order was partially fulfilled.
+	RequestedCount int32 `protobuf:"varint,8,opt,name=requested_count,json=requestedCount,proto3" json:"requested_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_order_v1_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OrderItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *OrderItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderItem) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *OrderItem) GetLineTotal() *Money {
+	if x != nil {
+		return x.LineTotal
+	}
+	return nil
+}
+
+func (x *OrderItem) GetRequestedCount() int32 {
+	if x != nil {
+		return x.RequestedCount
+	}
+	return 0
+}
+
+// An amount of money in one currency, as in google.type.Money: the amount is
+// units + nanos * 1e-9, and units and nanos must share a sign.
+type Money struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ISO 4217 code, e.g. "USD".
+	CurrencyCode string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Units        int64  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	// In the range (-1e9, 1e9).
+	Nanos         int32 `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_order_v1_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
+type CreatePromotionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Rules         *PromotionRules        `protobuf:"bytes,2,opt,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionRequest) Reset() {
+	*x = CreatePromotionRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionRequest) ProtoMessage() {}
+
+func (x *CreatePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreatePromotionRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetRules() *PromotionRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type CreatePromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   string                 `protobuf:"bytes,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionResponse) Reset() {
+	*x = CreatePromotionResponse{}
+	mi := &file_order_v1_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionResponse) ProtoMessage() {}
+
+func (x *CreatePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreatePromotionResponse) GetPromotionId() string {
+	if x != nil {
+		return x.PromotionId
+	}
+	return ""
+}
+
+type GetPromotionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveOnly    bool                   `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromotionsRequest) Reset() {
+	*x = GetPromotionsRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromotionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromotionsRequest) ProtoMessage() {}
+
+func (x *GetPromotionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromotionsRequest.ProtoReflect.Descriptor instead.
+func (*GetPromotionsRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPromotionsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type GetPromotionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotions    []*Promotion           `protobuf:"bytes,1,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromotionsResponse) Reset() {
+	*x = GetPromotionsResponse{}
+	mi := &file_order_v1_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromotionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromotionsResponse) ProtoMessage() {}
+
+func (x *GetPromotionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromotionsResponse.ProtoReflect.Descriptor instead.
+func (*GetPromotionsResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPromotionsResponse) GetPromotions() []*Promotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+type DeactivatePromotionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   string                 `protobuf:"bytes,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivatePromotionRequest) Reset() {
+	*x = DeactivatePromotionRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivatePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivatePromotionRequest) ProtoMessage() {}
+
+func (x *DeactivatePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivatePromotionRequest.ProtoReflect.Descriptor instead.
+func (*DeactivatePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeactivatePromotionRequest) GetPromotionId() string {
+	if x != nil {
+		return x.PromotionId
+	}
+	return ""
+}
+
+type Promotion struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId string                 `protobuf:"bytes,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	// Upper case.
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Rules         *PromotionRules        `protobuf:"bytes,3,opt,name=rules,proto3" json:"rules,omitempty"`
+	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Created       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	mi := &file_order_v1_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promotion) ProtoMessage() {}
+
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Promotion) GetPromotionId() string {
+	if x != nil {
+		return x.PromotionId
+	}
+	return ""
+}
+
+func (x *Promotion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Promotion) GetRules() *PromotionRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Promotion) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Promotion) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+type PromotionRules struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  PromotionKind          `protobuf:"varint,1,opt,name=kind,proto3,enum=order.v1.PromotionKind" json:"kind,omitempty"`
+	// PERCENTAGE only: share of the eligible subtotal taken off, 1 to 100.
+	Percent int32 `protobuf:"varint,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	// FIXED_AMOUNT only: amount taken off, capped at the eligible subtotal.
+	Amount *Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Optional minimum order subtotal.
+	MinOrderValue *Money `protobuf:"bytes,4,opt,name=min_order_value,json=minOrderValue,proto3" json:"min_order_value,omitempty"`
+	// Orders one customer may place with the code; 0 means unlimited.
+	PerCustomerLimit int32 `protobuf:"varint,5,opt,name=per_customer_limit,json=perCustomerLimit,proto3" json:"per_customer_limit,omitempty"`
+	// Optional validity window [valid_from, valid_to).
+	ValidFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	// Restricts the discount to lines of these products; empty means the whole order.
+	ProductIds    []string `protobuf:"bytes,8,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionRules) Reset() {
+	*x = PromotionRules{}
+	mi := &file_order_v1_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionRules) ProtoMessage() {}
+
+func (x *PromotionRules) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionRules.ProtoReflect.Descriptor instead.
+func (*PromotionRules) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PromotionRules) GetKind() PromotionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PromotionKind_PERCENTAGE
+}
+
+func (x *PromotionRules) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *PromotionRules) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PromotionRules) GetMinOrderValue() *Money {
+	if x != nil {
+		return x.MinOrderValue
+	}
+	return nil
+}
+
+func (x *PromotionRules) GetPerCustomerLimit() int32 {
+	if x != nil {
+		return x.PerCustomerLimit
+	}
+	return 0
+}
+
+func (x *PromotionRules) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *PromotionRules) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *PromotionRules) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type SearchOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Each filter is optional; an order must match every one given.
+	CustomerId *string `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3,oneof" json:"customer_id,omitempty"`
+	CourierId  *string `protobuf:"bytes,2,opt,name=courier_id,json=courierId,proto3,oneof" json:"courier_id,omitempty"`
+	// Matches orders with a line of this product.
+	ProductId *string `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3,oneof" json:"product_id,omitempty"`
+	// Page size; 0 selects the server default, larger values are capped.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Opaque token from a previous response's next_cursor; empty for the first page.
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Restricts the search to these statuses; empty means any status.
+	Statuses []OrderStatus `protobuf:"varint,6,rep,packed,name=statuses,proto3,enum=order.v1.OrderStatus" json:"statuses,omitempty"`
+	// Inclusive lower bound on the order creation time.
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	// Exclusive upper bound on the order creation time.
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Sort          OrderSort              `protobuf:"varint,9,opt,name=sort,proto3,enum=order.v1.OrderSort" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchOrdersRequest) Reset() {
+	*x = SearchOrdersRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOrdersRequest) ProtoMessage() {}
+
+func (x *SearchOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOrdersRequest.ProtoReflect.Descriptor instead.
+func (*SearchOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SearchOrdersRequest) GetCustomerId() string {
+	if x != nil && x.CustomerId != nil {
+		return *x.CustomerId
+	}
+	return ""
+}
+
+func (x *SearchOrdersRequest) GetCourierId() string {
+	if x != nil && x.CourierId != nil {
+		return *x.CourierId
+	}
+	return ""
+}
+
+func (x *SearchOrdersRequest) GetProductId() string {
+	if x != nil && x.ProductId != nil {
+		return *x.ProductId
+	}
+	return ""
+}
+
+func (x *SearchOrdersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchOrdersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchOrdersRequest) GetStatuses() []OrderStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *SearchOrdersRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *SearchOrdersRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *SearchOrdersRequest) GetSort() OrderSort {
+	if x != nil {
+		return x.Sort
+	}
+	return OrderSort_NEWEST_FIRST
+}
+
+type SearchOrdersResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Orders []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// Empty when there are no more pages.
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchOrdersResponse) Reset() {
+	*x = SearchOrdersResponse{}
+	mi := &file_order_v1_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOrdersResponse) ProtoMessage() {}
+
+func (x *SearchOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOrdersResponse.ProtoReflect.Descriptor instead.
+func (*SearchOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *SearchOrdersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ForceCancelOrderRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Required; at most 500 characters.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceCancelOrderRequest) Reset() {
+	*x = ForceCancelOrderRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceCancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceCancelOrderRequest) ProtoMessage() {}
+
+func (x *ForceCancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceCancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*ForceCancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ForceCancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ForceCancelOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RetrySagaStepRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Type          SagaType               `protobuf:"varint,2,opt,name=type,proto3,enum=order.v1.SagaType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetrySagaStepRequest) Reset() {
+	*x = RetrySagaStepRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrySagaStepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrySagaStepRequest) ProtoMessage() {}
+
+func (x *RetrySagaStepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrySagaStepRequest.ProtoReflect.Descriptor instead.
+func (*RetrySagaStepRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RetrySagaStepRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RetrySagaStepRequest) GetType() SagaType {
+	if x != nil {
+		return x.Type
+	}
+	return SagaType_CREATE_ORDER
+}
+
+type ReassignCourierRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrderId   string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CourierId string                 `protobuf:"bytes,2,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	// Optional; at most 500 characters.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignCourierRequest) Reset() {
+	*x = ReassignCourierRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignCourierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignCourierRequest) ProtoMessage() {}
+
+func (x *ReassignCourierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignCourierRequest.ProtoReflect.Descriptor instead.
+func (*ReassignCourierRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReassignCourierRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReassignCourierRequest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *ReassignCourierRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetStatusFunnelRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Inclusive lower bound on the order creation time.
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	// Exclusive upper bound on the order creation time.
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusFunnelRequest) Reset() {
+	*x = GetStatusFunnelRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusFunnelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusFunnelRequest) ProtoMessage() {}
+
+func (x *GetStatusFunnelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusFunnelRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusFunnelRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetStatusFunnelRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetStatusFunnelRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+type GetStatusFunnelResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Total int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// Orders per status they are in now.
+	Current []*OrderStatusCount `protobuf:"bytes,2,rep,name=current,proto3" json:"current,omitempty"`
+	// Orders per status they have been in at some point; every order counts as CREATED.
+	Reached       []*OrderStatusCount `protobuf:"bytes,3,rep,name=reached,proto3" json:"reached,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusFunnelResponse) Reset() {
+	*x = GetStatusFunnelResponse{}
+	mi := &file_order_v1_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusFunnelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusFunnelResponse) ProtoMessage() {}
+
+func (x *GetStatusFunnelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusFunnelResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusFunnelResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetStatusFunnelResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetStatusFunnelResponse) GetCurrent() []*OrderStatusCount {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *GetStatusFunnelResponse) GetReached() []*OrderStatusCount {
+	if x != nil {
+		return x.Reached
+	}
+	return nil
+}
+
+type GetRevenueRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Inclusive lower bound on the order creation time.
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	// Exclusive upper bound on the order creation time.
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Granularity   RevenueGranularity     `protobuf:"varint,3,opt,name=granularity,proto3,enum=order.v1.RevenueGranularity" json:"granularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevenueRequest) Reset() {
+	*x = GetRevenueRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevenueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevenueRequest) ProtoMessage() {}
+
+func (x *GetRevenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevenueRequest.ProtoReflect.Descriptor instead.
+func (*GetRevenueRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetRevenueRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetRevenueRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *GetRevenueRequest) GetGranularity() RevenueGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return RevenueGranularity_DAY
+}
+
+type GetRevenueResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Earliest first; days or weeks without delivered orders are left out.
+	Buckets       []*RevenueBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevenueResponse) Reset() {
+	*x = GetRevenueResponse{}
+	mi := &file_order_v1_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevenueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevenueResponse) ProtoMessage() {}
+
+func (x *GetRevenueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevenueResponse.ProtoReflect.Descriptor instead.
+func (*GetRevenueResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetRevenueResponse) GetBuckets() []*RevenueBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+// Revenue in a single currency; a bucket with orders in several currencies is
+// returned once per currency.
+type RevenueBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start of the day or week in UTC; weeks start on Monday.
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// Sum of the order totals after discounts.
+	Revenue       *Money `protobuf:"bytes,2,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	Orders        int32  `protobuf:"varint,3,opt,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueBucket) Reset() {
+	*x = RevenueBucket{}
+	mi := &file_order_v1_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueBucket) ProtoMessage() {}
+
+func (x *RevenueBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueBucket.ProtoReflect.Descriptor instead.
+func (*RevenueBucket) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RevenueBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *RevenueBucket) GetRevenue() *Money {
+	if x != nil {
+		return x.Revenue
+	}
+	return nil
+}
+
+func (x *RevenueBucket) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+type GetDeliveryTimeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Inclusive lower bound on the order creation time.
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	// Exclusive upper bound on the order creation time.
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeliveryTimeRequest) Reset() {
+	*x = GetDeliveryTimeRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeliveryTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveryTimeRequest) ProtoMessage() {}
+
+func (x *GetDeliveryTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryTimeRequest.ProtoReflect.Descriptor instead.
+func (*GetDeliveryTimeRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetDeliveryTimeRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetDeliveryTimeRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+type GetDeliveryTimeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Delivered orders the average is taken over.
+	Orders int32 `protobuf:"varint,1,opt,name=orders,proto3" json:"orders,omitempty"`
+	// Zero when there are no delivered orders.
+	Average       *durationpb.Duration `protobuf:"bytes,2,opt,name=average,proto3" json:"average,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeliveryTimeResponse) Reset() {
+	*x = GetDeliveryTimeResponse{}
+	mi := &file_order_v1_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeliveryTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveryTimeResponse) ProtoMessage() {}
+
+func (x *GetDeliveryTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryTimeResponse.ProtoReflect.Descriptor instead.
+func (*GetDeliveryTimeResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetDeliveryTimeResponse) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *GetDeliveryTimeResponse) GetAverage() *durationpb.Duration {
+	if x != nil {
+		return x.Average
+	}
+	return nil
+}
+
+type GetCancellationReasonsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Inclusive lower bound on the order creation time.
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	// Exclusive upper bound on the order creation time.
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCancellationReasonsRequest) Reset() {
+	*x = GetCancellationReasonsRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCancellationReasonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCancellationReasonsRequest) ProtoMessage() {}
+
+func (x *GetCancellationReasonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCancellationReasonsRequest.ProtoReflect.Descriptor instead.
+func (*GetCancellationReasonsRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetCancellationReasonsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetCancellationReasonsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+type GetCancellationReasonsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Reasons no order was canceled for are left out.
+	Reasons       []*CancelReasonCount `protobuf:"bytes,1,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCancellationReasonsResponse) Reset() {
+	*x = GetCancellationReasonsResponse{}
+	mi := &file_order_v1_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCancellationReasonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCancellationReasonsResponse) ProtoMessage() {}
+
+func (x *GetCancellationReasonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCancellationReasonsResponse.ProtoReflect.Descriptor instead.
+func (*GetCancellationReasonsResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetCancellationReasonsResponse) GetReasons() []*CancelReasonCount {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type CancelReasonCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        CancelReason           `protobuf:"varint,1,opt,name=reason,proto3,enum=order.v1.CancelReason" json:"reason,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReasonCount) Reset() {
+	*x = CancelReasonCount{}
+	mi := &file_order_v1_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReasonCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReasonCount) ProtoMessage() {}
+
+func (x *CancelReasonCount) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReasonCount.ProtoReflect.Descriptor instead.
+func (*CancelReasonCount) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CancelReasonCount) GetReason() CancelReason {
+	if x != nil {
+		return x.Reason
+	}
+	return CancelReason_CANCEL_REASON_CUSTOMER
+}
+
+func (x *CancelReasonCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetOrderHistoryRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Who is asking; the id is required for customers and couriers.
+	Requester     *Actor `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderHistoryRequest) Reset() {
+	*x = GetOrderHistoryRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderHistoryRequest) ProtoMessage() {}
+
+func (x *GetOrderHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetOrderHistoryRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetOrderHistoryRequest) GetRequester() *Actor {
+	if x != nil {
+		return x.Requester
+	}
+	return nil
+}
+
+type GetOrderHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       []*StatusChange        `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderHistoryResponse) Reset() {
+	*x = GetOrderHistoryResponse{}
+	mi := &file_order_v1_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderHistoryResponse) ProtoMessage() {}
+
+func (x *GetOrderHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetOrderHistoryResponse) GetHistory() []*StatusChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type StatusChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unset on the entry recording the creation of the order.
+	From     *OrderStatus           `protobuf:"varint,1,opt,name=from,proto3,enum=order.v1.OrderStatus,oneof" json:"from,omitempty"`
+	To       OrderStatus            `protobuf:"varint,2,opt,name=to,proto3,enum=order.v1.OrderStatus" json:"to,omitempty"`
+	Occurred *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred,proto3" json:"occurred,omitempty"`
+	Actor    *Actor                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason   string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The saga command that caused the change, if any.
+	MessageId     *string `protobuf:"bytes,6,opt,name=message_id,json=messageId,proto3,oneof" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusChange) Reset() {
+	*x = StatusChange{}
+	mi := &file_order_v1_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChange) ProtoMessage() {}
+
+func (x *StatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StatusChange) GetFrom() OrderStatus {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return OrderStatus_CREATED
+}
+
+func (x *StatusChange) GetTo() OrderStatus {
+	if x != nil {
+		return x.To
+	}
+	return OrderStatus_CREATED
+}
+
+func (x *StatusChange) GetOccurred() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Occurred
+	}
+	return nil
+}
+
+func (x *StatusChange) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *StatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StatusChange) GetMessageId() string {
+	if x != nil && x.MessageId != nil {
+		return *x.MessageId
+	}
+	return ""
+}
+
+type Actor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  ActorType              `protobuf:"varint,1,opt,name=type,proto3,enum=order.v1.ActorType" json:"type,omitempty"`
+	// Set for customers and couriers.
+	Id            *string `protobuf:"bytes,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Actor) Reset() {
+	*x = Actor{}
+	mi := &file_order_v1_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Actor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Actor) ProtoMessage() {}
+
+func (x *Actor) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Actor.ProtoReflect.Descriptor instead.
+func (*Actor) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Actor) GetType() ActorType {
+	if x != nil {
+		return x.Type
+	}
+	return ActorType_SYSTEM
+}
+
+func (x *Actor) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+type GetAvailableSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailableSlotsRequest) Reset() {
+	*x = GetAvailableSlotsRequest{}
+	mi := &file_order_v1_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableSlotsRequest) ProtoMessage() {}
+
+func (x *GetAvailableSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableSlotsRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailableSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{45}
+}
+
+type GetAvailableSlotsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Slots that can still be booked, earliest first.
+	Slots         []*SlotAvailability `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailableSlotsResponse) Reset() {
+	*x = GetAvailableSlotsResponse{}
+	mi := &file_order_v1_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableSlotsResponse) ProtoMessage() {}
+
+func (x *GetAvailableSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableSlotsResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailableSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetAvailableSlotsResponse) GetSlots() []*SlotAvailability {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type SlotAvailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          *DeliverySlot          `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Remaining     int32                  `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotAvailability) Reset() {
+	*x = SlotAvailability{}
+	mi := &file_order_v1_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotAvailability) ProtoMessage() {}
+
+func (x *SlotAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotAvailability.ProtoReflect.Descriptor instead.
+func (*SlotAvailability) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SlotAvailability) GetSlot() *DeliverySlot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *SlotAvailability) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *SlotAvailability) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+// The delivery window [start, end).
+type DeliverySlot struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteDeliveryRequest) Reset() {
-	*x = CompleteDeliveryRequest{}
-	mi := &file_order_v1_service_proto_msgTypes[3]
+func (x *DeliverySlot) Reset() {
+	*x = DeliverySlot{}
+	mi := &file_order_v1_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteDeliveryRequest) String() string {
+func (x *DeliverySlot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteDeliveryRequest) ProtoMessage() {}
+func (*DeliverySlot) ProtoMessage() {}
 
-func (x *CompleteDeliveryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_service_proto_msgTypes[3]
+func (x *DeliverySlot) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,42 +3438,52 @@ func (x *CompleteDeliveryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteDeliveryRequest.ProtoReflect.Descriptor instead.
-func (*CompleteDeliveryRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DeliverySlot.ProtoReflect.Descriptor instead.
+func (*DeliverySlot) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CompleteDeliveryRequest) GetOrderId() string {
+func (x *DeliverySlot) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OrderId
+		return x.Start
 	}
-	return ""
+	return nil
 }
 
-type GetOrdersByCustomerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+func (x *DeliverySlot) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type Delivery struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CourierId *string                `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3,oneof" json:"courier_id,omitempty"`
+	Arrived   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=arrived,proto3,oneof" json:"arrived,omitempty"`
+	Assigned  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=assigned,proto3,oneof" json:"assigned,omitempty"`
+	Address   *Address               `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	// Set when the customer booked a delivery slot.
+	Slot          *DeliverySlot `protobuf:"bytes,6,opt,name=slot,proto3" json:"slot,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrdersByCustomerRequest) Reset() {
-	*x = GetOrdersByCustomerRequest{}
-	mi := &file_order_v1_service_proto_msgTypes[4]
+func (x *Delivery) Reset() {
+	*x = Delivery{}
+	mi := &file_order_v1_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrdersByCustomerRequest) String() string {
+func (x *Delivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrdersByCustomerRequest) ProtoMessage() {}
+func (*Delivery) ProtoMessage() {}
 
-func (x *GetOrdersByCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_service_proto_msgTypes[4]
+func (x *Delivery) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,54 +3494,84 @@ func (x *GetOrdersByCustomerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrdersByCustomerRequest.ProtoReflect.Descriptor instead.
-func (*GetOrdersByCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Delivery.ProtoReflect.Descriptor instead.
+func (*Delivery) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetOrdersByCustomerRequest) GetCustomerId() string {
-	if x != nil {
-		return x.CustomerId
+func (x *Delivery) GetCourierId() string {
+	if x != nil && x.CourierId != nil {
+		return *x.CourierId
 	}
 	return ""
 }
 
-func (x *GetOrdersByCustomerRequest) GetLimit() int32 {
+func (x *Delivery) GetArrived() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Limit
+		return x.Arrived
 	}
-	return 0
+	return nil
 }
 
-func (x *GetOrdersByCustomerRequest) GetOffset() int32 {
+func (x *Delivery) GetAssigned() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Offset
+		return x.Assigned
 	}
-	return 0
+	return nil
 }
 
-type GetOrdersByCustomerResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+func (x *Delivery) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Delivery) GetSlot() *DeliverySlot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+// A delivery address. Country, city, street, house and postal code are required
+// on CreateOrder; the country and postal code are upper-cased.
+type Address struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ISO 3166-1 alpha-2 code, e.g. "DE".
+	Country string `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	City    string `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	Street  string `protobuf:"bytes,3,opt,name=street,proto3" json:"street,omitempty"`
+	House   string `protobuf:"bytes,4,opt,name=house,proto3" json:"house,omitempty"`
+	// Optional.
+	Apartment  string `protobuf:"bytes,5,opt,name=apartment,proto3" json:"apartment,omitempty"`
+	PostalCode string `protobuf:"bytes,6,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	// Optional.
+	Location *Location `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	// Optional notes for the courier, at most 500 characters.
+	Instructions string `protobuf:"bytes,8,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	// Output only: the free-form address of an order placed before addresses were
+	// structured. When set, all other fields are empty.
+	Legacy        string `protobuf:"bytes,9,opt,name=legacy,proto3" json:"legacy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrdersByCustomerResponse) Reset() {
-	*x = GetOrdersByCustomerResponse{}
-	mi := &file_order_v1_service_proto_msgTypes[5]
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_order_v1_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrdersByCustomerResponse) String() string {
+func (x *Address) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrdersByCustomerResponse) ProtoMessage() {}
+func (*Address) ProtoMessage() {}
 
-func (x *GetOrdersByCustomerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_service_proto_msgTypes[5]
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,100 +3582,98 @@ func (x *GetOrdersByCustomerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrdersByCustomerResponse.ProtoReflect.Descriptor instead.
-func (*GetOrdersByCustomerResponse) Descriptor() ([]byte, []int) {
-	return file_order_v1_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetOrdersByCustomerResponse) GetOrders() []*Order {
+func (x *Address) GetCountry() string {
 	if x != nil {
-		return x.Orders
+		return x.Country
 	}
-	return nil
+	return ""
 }
 
-type GetCurrentOrdersByCourierRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourierId     string                 `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
 }
 
-func (x *GetCurrentOrdersByCourierRequest) Reset() {
-	*x = GetCurrentOrdersByCourierRequest{}
-	mi := &file_order_v1_service_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
 }
 
-func (x *GetCurrentOrdersByCourierRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Address) GetHouse() string {
+	if x != nil {
+		return x.House
+	}
+	return ""
 }
 
-func (*GetCurrentOrdersByCourierRequest) ProtoMessage() {}
-
-func (x *GetCurrentOrdersByCourierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_service_proto_msgTypes[6]
+func (x *Address) GetApartment() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Apartment
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetCurrentOrdersByCourierRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrentOrdersByCourierRequest) Descriptor() ([]byte, []int) {
-	return file_order_v1_service_proto_rawDescGZIP(), []int{6}
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
 }
 
-func (x *GetCurrentOrdersByCourierRequest) GetCourierId() string {
+func (x *Address) GetLocation() *Location {
 	if x != nil {
-		return x.CourierId
+		return x.Location
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCurrentOrdersByCourierRequest) GetLimit() int32 {
+func (x *Address) GetInstructions() string {
 	if x != nil {
-		return x.Limit
+		return x.Instructions
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCurrentOrdersByCourierRequest) GetOffset() int32 {
+func (x *Address) GetLegacy() string {
 	if x != nil {
-		return x.Offset
+		return x.Legacy
 	}
-	return 0
+	return ""
 }
 
-type GetCurrentOrdersByCourierResponse struct {
+// A WGS 84 coordinate pair in degrees.
+type Location struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrentOrdersByCourierResponse) Reset() {
-	*x = GetCurrentOrdersByCourierResponse{}
-	mi := &file_order_v1_service_proto_msgTypes[7]
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_order_v1_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrentOrdersByCourierResponse) String() string {
+func (x *Location) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentOrdersByCourierResponse) ProtoMessage() {}
+func (*Location) ProtoMessage() {}
 
-func (x *GetCurrentOrdersByCourierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_service_proto_msgTypes[7]
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,46 +3684,56 @@ func (x *GetCurrentOrdersByCourierResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentOrdersByCourierResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrentOrdersByCourierResponse) Descriptor() ([]byte, []int) {
-	return file_order_v1_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetCurrentOrdersByCourierResponse) GetOrders() []*Order {
+func (x *Location) GetLatitude() float64 {
 	if x != nil {
-		return x.Orders
+		return x.Latitude
 	}
-	return nil
+	return 0
 }
 
-type Order struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Status        OrderStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=order.v1.OrderStatus" json:"status,omitempty"`
-	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
-	Items         []*OrderItem           `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
-	Delivery      *Delivery              `protobuf:"bytes,6,opt,name=delivery,proto3" json:"delivery,omitempty"`
-	Created       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`
+func (x *Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type Saga struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SagaId    string                 `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	OrderId   string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Type      SagaType               `protobuf:"varint,3,opt,name=type,proto3,enum=order.v1.SagaType" json:"type,omitempty"`
+	Step      SagaStep               `protobuf:"varint,4,opt,name=step,proto3,enum=order.v1.SagaStep" json:"step,omitempty"`
+	History   []*SagaStepRecord      `protobuf:"bytes,5,rep,name=history,proto3" json:"history,omitempty"`
+	LastError *SagaFailure           `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	Created   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`
+	Updated   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated,proto3" json:"updated,omitempty"`
+	// Set while the saga waits in its current step until this time.
+	ResumeAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=resume_at,json=resumeAt,proto3,oneof" json:"resume_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
-	mi := &file_order_v1_service_proto_msgTypes[8]
+func (x *Saga) Reset() {
+	*x = Saga{}
+	mi := &file_order_v1_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Order) String() string {
+func (x *Saga) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*Saga) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_service_proto_msgTypes[8]
+func (x *Saga) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,84 +3744,99 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
-	return file_order_v1_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Saga.ProtoReflect.Descriptor instead.
+func (*Saga) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *Order) GetOrderId() string {
+func (x *Saga) GetSagaId() string {
 	if x != nil {
-		return x.OrderId
+		return x.SagaId
 	}
 	return ""
 }
 
-func (x *Order) GetCustomerId() string {
+func (x *Saga) GetOrderId() string {
 	if x != nil {
-		return x.CustomerId
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *Order) GetStatus() OrderStatus {
+func (x *Saga) GetType() SagaType {
 	if x != nil {
-		return x.Status
+		return x.Type
 	}
-	return OrderStatus_CREATED
+	return SagaType_CREATE_ORDER
 }
 
-func (x *Order) GetVersion() string {
+func (x *Saga) GetStep() SagaStep {
 	if x != nil {
-		return x.Version
+		return x.Step
 	}
-	return ""
+	return SagaStep_RESERVING_ITEMS
 }
 
-func (x *Order) GetItems() []*OrderItem {
+func (x *Saga) GetHistory() []*SagaStepRecord {
 	if x != nil {
-		return x.Items
+		return x.History
 	}
 	return nil
 }
 
-func (x *Order) GetDelivery() *Delivery {
+func (x *Saga) GetLastError() *SagaFailure {
 	if x != nil {
-		return x.Delivery
+		return x.LastError
 	}
 	return nil
 }
 
-func (x *Order) GetCreated() *timestamppb.Timestamp {
+func (x *Saga) GetCreated() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Created
 	}
 	return nil
 }
 
-type OrderItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
-	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+func (x *Saga) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *Saga) GetResumeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResumeAt
+	}
+	return nil
+}
+
+type SagaStepRecord struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Step    SagaStep               `protobuf:"varint,1,opt,name=step,proto3,enum=order.v1.SagaStep" json:"step,omitempty"`
+	Entered *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=entered,proto3" json:"entered,omitempty"`
+	// Set on a record added when an admin retried the step it was already in.
+	Retry         bool `protobuf:"varint,3,opt,name=retry,proto3" json:"retry,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderItem) Reset() {
-	*x = OrderItem{}
-	mi := &file_order_v1_service_proto_msgTypes[9]
+func (x *SagaStepRecord) Reset() {
+	*x = SagaStepRecord{}
+	mi := &file_order_v1_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItem) String() string {
+func (x *SagaStepRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderItem) ProtoMessage() {}
+func (*SagaStepRecord) ProtoMessage() {}
 
-func (x *OrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_service_proto_msgTypes[9]
+func (x *SagaStepRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,56 +3847,56 @@ func (x *OrderItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
-func (*OrderItem) Descriptor() ([]byte, []int) {
-	return file_order_v1_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SagaStepRecord.ProtoReflect.Descriptor instead.
+func (*SagaStepRecord) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *OrderItem) GetProductId() string {
+func (x *SagaStepRecord) GetStep() SagaStep {
 	if x != nil {
-		return x.ProductId
+		return x.Step
 	}
-	return ""
+	return SagaStep_RESERVING_ITEMS
 }
 
-func (x *OrderItem) GetPrice() float64 {
+func (x *SagaStepRecord) GetEntered() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Price
+		return x.Entered
 	}
-	return 0
+	return nil
 }
 
-func (x *OrderItem) GetCount() int32 {
+func (x *SagaStepRecord) GetRetry() bool {
 	if x != nil {
-		return x.Count
+		return x.Retry
 	}
-	return 0
+	return false
 }
 
-type Delivery struct {
+type SagaFailure struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CourierId     *string                `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3,oneof" json:"courier_id,omitempty"`
-	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Arrived       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=arrived,proto3,oneof" json:"arrived,omitempty"`
+	Step          SagaStep               `protobuf:"varint,1,opt,name=step,proto3,enum=order.v1.SagaStep" json:"step,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Occurred      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred,proto3" json:"occurred,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Delivery) Reset() {
-	*x = Delivery{}
-	mi := &file_order_v1_service_proto_msgTypes[10]
+func (x *SagaFailure) Reset() {
+	*x = SagaFailure{}
+	mi := &file_order_v1_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Delivery) String() string {
+func (x *SagaFailure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Delivery) ProtoMessage() {}
+func (*SagaFailure) ProtoMessage() {}
 
-func (x *Delivery) ProtoReflect() protoreflect.Message {
-	mi := &file_order_v1_service_proto_msgTypes[10]
+func (x *SagaFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_order_v1_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,28 +3907,28 @@ func (x *Delivery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Delivery.ProtoReflect.Descriptor instead.
-func (*Delivery) Descriptor() ([]byte, []int) {
-	return file_order_v1_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use SagaFailure.ProtoReflect.Descriptor instead.
+func (*SagaFailure) Descriptor() ([]byte, []int) {
+	return file_order_v1_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *Delivery) GetCourierId() string {
-	if x != nil && x.CourierId != nil {
-		return *x.CourierId
+func (x *SagaFailure) GetStep() SagaStep {
+	if x != nil {
+		return x.Step
 	}
-	return ""
+	return SagaStep_RESERVING_ITEMS
 }
 
-func (x *Delivery) GetAddress() string {
+func (x *SagaFailure) GetMessage() string {
 	if x != nil {
-		return x.Address
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Delivery) GetArrived() *timestamppb.Timestamp {
+func (x *SagaFailure) GetOccurred() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Arrived
+		return x.Occurred
 	}
 	return nil
 }
@@ -697,32 +3937,74 @@ var File_order_v1_service_proto protoreflect.FileDescriptor
 
 const file_order_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x16order/v1/service.proto\x12\border.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"z\n" +
+	"\x16order/v1/service.proto\x12\border.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/duration.proto\"\xbb\x02\n" +
 	"\x12CreateOrderRequest\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
-	"customerId\x12\x18\n" +
-	"\aaddress\x18\x02 \x01(\tR\aaddress\x12)\n" +
-	"\x05items\x18\x03 \x03(\v2\x13.order.v1.OrderItemR\x05items\"0\n" +
+	"customerId\x12)\n" +
+	"\x05items\x18\x03 \x03(\v2\x13.order.v1.OrderItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\x04 \x01(\tR\tpromoCode\x12+\n" +
+	"\aaddress\x18\x05 \x01(\v2\x11.order.v1.AddressR\aaddress\x12;\n" +
+	"\rdelivery_slot\x18\x06 \x01(\v2\x16.order.v1.DeliverySlotR\fdeliverySlot\x12J\n" +
+	"\x12fulfillment_policy\x18\a \x01(\x0e2\x1b.order.v1.FulfillmentPolicyR\x11fulfillmentPolicyJ\x04\b\x02\x10\x03\"0\n" +
 	"\x13CreateOrderResponse\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\tR\aorderId\"9\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"[\n" +
+	"\x0fGetOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12-\n" +
+	"\trequester\x18\x02 \x01(\v2\x0f.order.v1.ActorR\trequester\"9\n" +
+	"\x10GetOrderResponse\x12%\n" +
+	"\x05order\x18\x01 \x01(\v2\x0f.order.v1.OrderR\x05order\"r\n" +
 	"\x1cCancelOrderByCustomerRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\tR\aorderId\"4\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"S\n" +
 	"\x17CompleteDeliveryRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\tR\aorderId\"k\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x02 \x01(\tR\tcourierId\"\xcf\x02\n" +
 	"\x1aGetOrdersByCustomerRequest\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
 	"customerId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"F\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x121\n" +
+	"\bstatuses\x18\x05 \x03(\x0e2\x15.order.v1.OrderStatusR\bstatuses\x12=\n" +
+	"\fcreated_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12'\n" +
+	"\x04sort\x18\b \x01(\x0e2\x13.order.v1.OrderSortR\x04sortJ\x04\b\x03\x10\x04R\x06offset\"g\n" +
 	"\x1bGetOrdersByCustomerResponse\x12'\n" +
-	"\x06orders\x18\x01 \x03(\v2\x0f.order.v1.OrderR\x06orders\"o\n" +
+	"\x06orders\x18\x01 \x03(\v2\x0f.order.v1.OrderR\x06orders\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\x88\x01\n" +
 	" GetCurrentOrdersByCourierRequest\x12\x1d\n" +
 	"\n" +
+	"courier_id\x18\x01 \x01(\tR\tcourierIdJ\x04\b\x02\x10\tR\x05limitR\x06offsetR\x06cursorR\bstatusesR\fcreated_fromR\n" +
+	"created_toR\x04sort\"_\n" +
+	"!GetCurrentOrdersByCourierResponse\x12'\n" +
+	"\x06orders\x18\x01 \x03(\v2\x0f.order.v1.OrderR\x06ordersJ\x04\b\x02\x10\x03R\vnext_cursor\"\xd0\x02\n" +
+	"\x1dGetCourierOrderHistoryRequest\x12\x1d\n" +
+	"\n" +
 	"courier_id\x18\x01 \x01(\tR\tcourierId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"L\n" +
-	"!GetCurrentOrdersByCourierResponse\x12'\n" +
-	"\x06orders\x18\x01 \x03(\v2\x0f.order.v1.OrderR\x06orders\"\x9d\x02\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x121\n" +
+	"\bstatuses\x18\x05 \x03(\x0e2\x15.order.v1.OrderStatusR\bstatuses\x12=\n" +
+	"\fcreated_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12'\n" +
+	"\x04sort\x18\b \x01(\x0e2\x13.order.v1.OrderSortR\x04sortJ\x04\b\x03\x10\x04R\x06offset\"\x9e\x01\n" +
+	"\x1eGetCourierOrderHistoryResponse\x12'\n" +
+	"\x06orders\x18\x01 \x03(\v2\x0f.order.v1.OrderR\x06orders\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x122\n" +
+	"\x06counts\x18\x03 \x03(\v2\x1a.order.v1.OrderStatusCountR\x06counts\"W\n" +
+	"\x10OrderStatusCount\x12-\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x15.order.v1.OrderStatusR\x06status\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"0\n" +
+	"\x13GetSagaStateRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"<\n" +
+	"\x14GetSagaStateResponse\x12$\n" +
+	"\x05sagas\x18\x01 \x03(\v2\x0e.order.v1.SagaR\x05sagas\"\x98\x04\n" +
 	"\x05Order\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1f\n" +
 	"\vcustomer_id\x18\x02 \x01(\tR\n" +
@@ -731,20 +4013,205 @@ const file_order_v1_service_proto_rawDesc = "" +
 	"\aversion\x18\x04 \x01(\tR\aversion\x12)\n" +
 	"\x05items\x18\x05 \x03(\v2\x13.order.v1.OrderItemR\x05items\x12.\n" +
 	"\bdelivery\x18\x06 \x01(\v2\x12.order.v1.DeliveryR\bdelivery\x124\n" +
-	"\acreated\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\acreated\"V\n" +
+	"\acreated\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12#\n" +
+	"\rcancel_reason\x18\b \x01(\tR\fcancelReason\x12%\n" +
+	"\x05total\x18\n" +
+	" \x01(\v2\x0f.order.v1.MoneyR\x05total\x12.\n" +
+	"\bdiscount\x18\v \x01(\v2\x12.order.v1.DiscountR\bdiscount\x12+\n" +
+	"\bsubtotal\x18\f \x01(\v2\x0f.order.v1.MoneyR\bsubtotal\x12J\n" +
+	"\x12fulfillment_policy\x18\r \x01(\x0e2\x1b.order.v1.FulfillmentPolicyR\x11fulfillmentPolicyJ\x04\b\t\x10\n" +
+	"\"R\n" +
+	"\bDiscount\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\x01 \x01(\tR\tpromoCode\x12'\n" +
+	"\x06amount\x18\x02 \x01(\v2\x0f.order.v1.MoneyR\x06amount\"\xe0\x01\n" +
 	"\tOrderItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x14\n" +
-	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x05R\x05count\"\x9e\x01\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12%\n" +
+	"\x05price\x18\x06 \x01(\v2\x0f.order.v1.MoneyR\x05price\x12.\n" +
+	"\n" +
+	"line_total\x18\a \x01(\v2\x0f.order.v1.MoneyR\tlineTotal\x12'\n" +
+	"\x0frequested_count\x18\b \x01(\x05R\x0erequestedCountJ\x04\b\x02\x10\x03J\x04\b\x05\x10\x06\"X\n" +
+	"\x05Money\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\x03R\x05units\x12\x14\n" +
+	"\x05nanos\x18\x03 \x01(\x05R\x05nanos\"\\\n" +
+	"\x16CreatePromotionRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12.\n" +
+	"\x05rules\x18\x02 \x01(\v2\x18.order.v1.PromotionRulesR\x05rules\"<\n" +
+	"\x17CreatePromotionResponse\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\tR\vpromotionId\"7\n" +
+	"\x14GetPromotionsRequest\x12\x1f\n" +
+	"\vactive_only\x18\x01 \x01(\bR\n" +
+	"activeOnly\"L\n" +
+	"\x15GetPromotionsResponse\x123\n" +
+	"\n" +
+	"promotions\x18\x01 \x03(\v2\x13.order.v1.PromotionR\n" +
+	"promotions\"?\n" +
+	"\x1aDeactivatePromotionRequest\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\tR\vpromotionId\"\xc0\x01\n" +
+	"\tPromotion\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\tR\vpromotionId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12.\n" +
+	"\x05rules\x18\x03 \x01(\v2\x18.order.v1.PromotionRulesR\x05rules\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\x124\n" +
+	"\acreated\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\"\xfa\x02\n" +
+	"\x0ePromotionRules\x12+\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x17.order.v1.PromotionKindR\x04kind\x12\x18\n" +
+	"\apercent\x18\x02 \x01(\x05R\apercent\x12'\n" +
+	"\x06amount\x18\x03 \x01(\v2\x0f.order.v1.MoneyR\x06amount\x127\n" +
+	"\x0fmin_order_value\x18\x04 \x01(\v2\x0f.order.v1.MoneyR\rminOrderValue\x12,\n" +
+	"\x12per_customer_limit\x18\x05 \x01(\x05R\x10perCustomerLimit\x129\n" +
+	"\n" +
+	"valid_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\x12\x1f\n" +
+	"\vproduct_ids\x18\b \x03(\tR\n" +
+	"productIds\"\xb5\x03\n" +
+	"\x13SearchOrdersRequest\x12$\n" +
+	"\vcustomer_id\x18\x01 \x01(\tH\x00R\n" +
+	"customerId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"courier_id\x18\x02 \x01(\tH\x01R\tcourierId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tH\x02R\tproductId\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x121\n" +
+	"\bstatuses\x18\x06 \x03(\x0e2\x15.order.v1.OrderStatusR\bstatuses\x12=\n" +
+	"\fcreated_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12'\n" +
+	"\x04sort\x18\t \x01(\x0e2\x13.order.v1.OrderSortR\x04sortB\x0e\n" +
+	"\f_customer_idB\r\n" +
+	"\v_courier_idB\r\n" +
+	"\v_product_id\"`\n" +
+	"\x14SearchOrdersResponse\x12'\n" +
+	"\x06orders\x18\x01 \x03(\v2\x0f.order.v1.OrderR\x06orders\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"L\n" +
+	"\x17ForceCancelOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"Y\n" +
+	"\x14RetrySagaStepRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12&\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x12.order.v1.SagaTypeR\x04type\"j\n" +
+	"\x16ReassignCourierRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x02 \x01(\tR\tcourierId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x92\x01\n" +
+	"\x16GetStatusFunnelRequest\x12=\n" +
+	"\fcreated_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\"\x9b\x01\n" +
+	"\x17GetStatusFunnelResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x124\n" +
+	"\acurrent\x18\x02 \x03(\v2\x1a.order.v1.OrderStatusCountR\acurrent\x124\n" +
+	"\areached\x18\x03 \x03(\v2\x1a.order.v1.OrderStatusCountR\areached\"\xcd\x01\n" +
+	"\x11GetRevenueRequest\x12=\n" +
+	"\fcreated_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12>\n" +
+	"\vgranularity\x18\x03 \x01(\x0e2\x1c.order.v1.RevenueGranularityR\vgranularity\"G\n" +
+	"\x12GetRevenueResponse\x121\n" +
+	"\abuckets\x18\x01 \x03(\v2\x17.order.v1.RevenueBucketR\abuckets\"\x84\x01\n" +
+	"\rRevenueBucket\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12)\n" +
+	"\arevenue\x18\x02 \x01(\v2\x0f.order.v1.MoneyR\arevenue\x12\x16\n" +
+	"\x06orders\x18\x03 \x01(\x05R\x06orders\"\x92\x01\n" +
+	"\x16GetDeliveryTimeRequest\x12=\n" +
+	"\fcreated_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\"f\n" +
+	"\x17GetDeliveryTimeResponse\x12\x16\n" +
+	"\x06orders\x18\x01 \x01(\x05R\x06orders\x123\n" +
+	"\aaverage\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\aaverage\"\x99\x01\n" +
+	"\x1dGetCancellationReasonsRequest\x12=\n" +
+	"\fcreated_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\"W\n" +
+	"\x1eGetCancellationReasonsResponse\x125\n" +
+	"\areasons\x18\x01 \x03(\v2\x1b.order.v1.CancelReasonCountR\areasons\"Y\n" +
+	"\x11CancelReasonCount\x12.\n" +
+	"\x06reason\x18\x01 \x01(\x0e2\x16.order.v1.CancelReasonR\x06reason\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"b\n" +
+	"\x16GetOrderHistoryRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12-\n" +
+	"\trequester\x18\x02 \x01(\v2\x0f.order.v1.ActorR\trequester\"K\n" +
+	"\x17GetOrderHistoryResponse\x120\n" +
+	"\ahistory\x18\x01 \x03(\v2\x16.order.v1.StatusChangeR\ahistory\"\x98\x02\n" +
+	"\fStatusChange\x12.\n" +
+	"\x04from\x18\x01 \x01(\x0e2\x15.order.v1.OrderStatusH\x00R\x04from\x88\x01\x01\x12%\n" +
+	"\x02to\x18\x02 \x01(\x0e2\x15.order.v1.OrderStatusR\x02to\x126\n" +
+	"\boccurred\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\boccurred\x12%\n" +
+	"\x05actor\x18\x04 \x01(\v2\x0f.order.v1.ActorR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\"\n" +
+	"\n" +
+	"message_id\x18\x06 \x01(\tH\x01R\tmessageId\x88\x01\x01B\a\n" +
+	"\x05_fromB\r\n" +
+	"\v_message_id\"L\n" +
+	"\x05Actor\x12'\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x13.order.v1.ActorTypeR\x04type\x12\x13\n" +
+	"\x02id\x18\x02 \x01(\tH\x00R\x02id\x88\x01\x01B\x05\n" +
+	"\x03_id\"\x1a\n" +
+	"\x18GetAvailableSlotsRequest\"M\n" +
+	"\x19GetAvailableSlotsResponse\x120\n" +
+	"\x05slots\x18\x01 \x03(\v2\x1a.order.v1.SlotAvailabilityR\x05slots\"x\n" +
+	"\x10SlotAvailability\x12*\n" +
+	"\x04slot\x18\x01 \x01(\v2\x16.order.v1.DeliverySlotR\x04slot\x12\x1a\n" +
+	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\x12\x1c\n" +
+	"\tremaining\x18\x03 \x01(\x05R\tremaining\"n\n" +
+	"\fDeliverySlot\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"\xad\x02\n" +
 	"\bDelivery\x12\"\n" +
 	"\n" +
-	"courier_id\x18\x01 \x01(\tH\x00R\tcourierId\x88\x01\x01\x12\x18\n" +
-	"\aaddress\x18\x02 \x01(\tR\aaddress\x129\n" +
-	"\aarrived\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aarrived\x88\x01\x01B\r\n" +
+	"courier_id\x18\x01 \x01(\tH\x00R\tcourierId\x88\x01\x01\x129\n" +
+	"\aarrived\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aarrived\x88\x01\x01\x12;\n" +
+	"\bassigned\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\bassigned\x88\x01\x01\x12+\n" +
+	"\aaddress\x18\x05 \x01(\v2\x11.order.v1.AddressR\aaddress\x12*\n" +
+	"\x04slot\x18\x06 \x01(\v2\x16.order.v1.DeliverySlotR\x04slotB\r\n" +
 	"\v_courier_idB\n" +
 	"\n" +
-	"\b_arrived*\x8b\x01\n" +
+	"\b_arrivedB\v\n" +
+	"\t_assignedJ\x04\b\x02\x10\x03\"\x90\x02\n" +
+	"\aAddress\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12\x16\n" +
+	"\x06street\x18\x03 \x01(\tR\x06street\x12\x14\n" +
+	"\x05house\x18\x04 \x01(\tR\x05house\x12\x1c\n" +
+	"\tapartment\x18\x05 \x01(\tR\tapartment\x12\x1f\n" +
+	"\vpostal_code\x18\x06 \x01(\tR\n" +
+	"postalCode\x12.\n" +
+	"\blocation\x18\a \x01(\v2\x12.order.v1.LocationR\blocation\x12\"\n" +
+	"\finstructions\x18\b \x01(\tR\finstructions\x12\x16\n" +
+	"\x06legacy\x18\t \x01(\tR\x06legacy\"D\n" +
+	"\bLocation\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\xc0\x03\n" +
+	"\x04Saga\x12\x17\n" +
+	"\asaga_id\x18\x01 \x01(\tR\x06sagaId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12&\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x12.order.v1.SagaTypeR\x04type\x12&\n" +
+	"\x04step\x18\x04 \x01(\x0e2\x12.order.v1.SagaStepR\x04step\x122\n" +
+	"\ahistory\x18\x05 \x03(\v2\x18.order.v1.SagaStepRecordR\ahistory\x129\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\v2\x15.order.v1.SagaFailureH\x00R\tlastError\x88\x01\x01\x124\n" +
+	"\acreated\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x124\n" +
+	"\aupdated\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aupdated\x12<\n" +
+	"\tresume_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x01R\bresumeAt\x88\x01\x01B\r\n" +
+	"\v_last_errorB\f\n" +
+	"\n" +
+	"_resume_at\"\x84\x01\n" +
+	"\x0eSagaStepRecord\x12&\n" +
+	"\x04step\x18\x01 \x01(\x0e2\x12.order.v1.SagaStepR\x04step\x124\n" +
+	"\aentered\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aentered\x12\x14\n" +
+	"\x05retry\x18\x03 \x01(\bR\x05retry\"\x87\x01\n" +
+	"\vSagaFailure\x12&\n" +
+	"\x04step\x18\x01 \x01(\x0e2\x12.order.v1.SagaStepR\x04step\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x126\n" +
+	"\boccurred\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\boccurred*\xc7\x01\n" +
 	"\vOrderStatus\x12\v\n" +
 	"\aCREATED\x10\x00\x12\x1e\n" +
 	"\x1aCANCELED_COURIER_NOT_FOUND\x10\x01\x12\x19\n" +
@@ -752,13 +4219,77 @@ const file_order_v1_service_proto_rawDesc = "" +
 	"\n" +
 	"DELIVERING\x10\x03\x12\r\n" +
 	"\tDELIVERED\x10\x04\x12\x15\n" +
-	"\x11CUSTOMER_CANCELED\x10\x052\xdc\x03\n" +
+	"\x11CUSTOMER_CANCELED\x10\x05\x12\x14\n" +
+	"\x10CANCELED_TIMEOUT\x10\x06\x12\r\n" +
+	"\tCANCELING\x10\a\x12\x15\n" +
+	"\x11CANCELED_BY_ADMIN\x10\b*:\n" +
+	"\x11FulfillmentPolicy\x12\x12\n" +
+	"\x0eALL_OR_NOTHING\x10\x00\x12\x11\n" +
+	"\rALLOW_PARTIAL\x10\x01*=\n" +
+	"\tActorType\x12\n" +
+	"\n" +
+	"\x06SYSTEM\x10\x00\x12\f\n" +
+	"\bCUSTOMER\x10\x01\x12\v\n" +
+	"\aCOURIER\x10\x02\x12\t\n" +
+	"\x05ADMIN\x10\x03*1\n" +
+	"\rPromotionKind\x12\x0e\n" +
+	"\n" +
+	"PERCENTAGE\x10\x00\x12\x10\n" +
+	"\fFIXED_AMOUNT\x10\x01*/\n" +
+	"\tOrderSort\x12\x10\n" +
+	"\fNEWEST_FIRST\x10\x00\x12\x10\n" +
+	"\fOLDEST_FIRST\x10\x01*.\n" +
+	"\bSagaType\x12\x10\n" +
+	"\fCREATE_ORDER\x10\x00\x12\x10\n" +
+	"\fCANCEL_ORDER\x10\x01*\x83\x03\n" +
+	"\bSagaStep\x12\x13\n" +
+	"\x0fRESERVING_ITEMS\x10\x00\x12\x15\n" +
+	"\x11ASSIGNING_COURIER\x10\x01\x12\x16\n" +
+	"\x12BEGINNING_DELIVERY\x10\x02\x12\x1a\n" +
+	"\x16CANCELING_OUT_OF_STOCK\x10\x03\x12\x13\n" +
+	"\x0fRELEASING_ITEMS\x10\x04\x12\x1f\n" +
+	"\x1bCANCELING_COURIER_NOT_FOUND\x10\x05\x12\x1e\n" +
+	"\x1aRELEASING_ITEMS_ON_TIMEOUT\x10\x06\x12\x15\n" +
+	"\x11CANCELING_TIMEOUT\x10\a\x12\x18\n" +
+	"\x14SUPERSEDED_BY_CANCEL\x10\b\x12\x1f\n" +
+	"\x1bRELEASING_ITEMS_AND_COURIER\x10\t\x12\x1c\n" +
+	"\x18AWAITING_COURIER_RELEASE\x10\n" +
+	"\x12\x1a\n" +
+	"\x16AWAITING_ITEMS_RELEASE\x10\v\x12\x19\n" +
+	"\x15CANCELING_BY_CUSTOMER\x10\f\x12\x1a\n" +
+	"\x16AWAITING_DELIVERY_SLOT\x10\r*'\n" +
+	"\x12RevenueGranularity\x12\a\n" +
+	"\x03DAY\x10\x00\x12\b\n" +
+	"\x04WEEK\x10\x01*\xa3\x01\n" +
+	"\fCancelReason\x12\x1a\n" +
+	"\x16CANCEL_REASON_CUSTOMER\x10\x00\x12\x1e\n" +
+	"\x1aCANCEL_REASON_OUT_OF_STOCK\x10\x01\x12#\n" +
+	"\x1fCANCEL_REASON_COURIER_NOT_FOUND\x10\x02\x12\x19\n" +
+	"\x15CANCEL_REASON_TIMEOUT\x10\x03\x12\x17\n" +
+	"\x13CANCEL_REASON_ADMIN\x10\x042\xaa\x0e\n" +
 	"\fOrderService\x12J\n" +
-	"\vCreateOrder\x12\x1c.order.v1.CreateOrderRequest\x1a\x1d.order.v1.CreateOrderResponse\x12W\n" +
+	"\vCreateOrder\x12\x1c.order.v1.CreateOrderRequest\x1a\x1d.order.v1.CreateOrderResponse\x12A\n" +
+	"\bGetOrder\x12\x19.order.v1.GetOrderRequest\x1a\x1a.order.v1.GetOrderResponse\x12W\n" +
 	"\x15CancelOrderByCustomer\x12&.order.v1.CancelOrderByCustomerRequest\x1a\x16.google.protobuf.Empty\x12M\n" +
 	"\x10CompleteDelivery\x12!.order.v1.CompleteDeliveryRequest\x1a\x16.google.protobuf.Empty\x12b\n" +
 	"\x13GetOrdersByCustomer\x12$.order.v1.GetOrdersByCustomerRequest\x1a%.order.v1.GetOrdersByCustomerResponse\x12t\n" +
-	"\x19GetCurrentOrdersByCourier\x12*.order.v1.GetCurrentOrdersByCourierRequest\x1a+.order.v1.GetCurrentOrdersByCourierResponseBLZJgithub.com/OlegDokuchaev/clean-ddd-app/api-gateway/proto/order/v1;order_v1b\x06proto3"
+	"\x19GetCurrentOrdersByCourier\x12*.order.v1.GetCurrentOrdersByCourierRequest\x1a+.order.v1.GetCurrentOrdersByCourierResponse\x12k\n" +
+	"\x16GetCourierOrderHistory\x12'.order.v1.GetCourierOrderHistoryRequest\x1a(.order.v1.GetCourierOrderHistoryResponse\x12M\n" +
+	"\fGetSagaState\x12\x1d.order.v1.GetSagaStateRequest\x1a\x1e.order.v1.GetSagaStateResponse\x12V\n" +
+	"\x0fCreatePromotion\x12 .order.v1.CreatePromotionRequest\x1a!.order.v1.CreatePromotionResponse\x12P\n" +
+	"\rGetPromotions\x12\x1e.order.v1.GetPromotionsRequest\x1a\x1f.order.v1.GetPromotionsResponse\x12S\n" +
+	"\x13DeactivatePromotion\x12$.order.v1.DeactivatePromotionRequest\x1a\x16.google.protobuf.Empty\x12\\\n" +
+	"\x11GetAvailableSlots\x12\".order.v1.GetAvailableSlotsRequest\x1a#.order.v1.GetAvailableSlotsResponse\x12V\n" +
+	"\x0fGetOrderHistory\x12 .order.v1.GetOrderHistoryRequest\x1a!.order.v1.GetOrderHistoryResponse\x12M\n" +
+	"\fSearchOrders\x12\x1d.order.v1.SearchOrdersRequest\x1a\x1e.order.v1.SearchOrdersResponse\x12M\n" +
+	"\x10ForceCancelOrder\x12!.order.v1.ForceCancelOrderRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\rRetrySagaStep\x12\x1e.order.v1.RetrySagaStepRequest\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\x0fReassignCourier\x12 .order.v1.ReassignCourierRequest\x1a\x16.google.protobuf.Empty\x12V\n" +
+	"\x0fGetStatusFunnel\x12 .order.v1.GetStatusFunnelRequest\x1a!.order.v1.GetStatusFunnelResponse\x12G\n" +
+	"\n" +
+	"GetRevenue\x12\x1b.order.v1.GetRevenueRequest\x1a\x1c.order.v1.GetRevenueResponse\x12V\n" +
+	"\x0fGetDeliveryTime\x12 .order.v1.GetDeliveryTimeRequest\x1a!.order.v1.GetDeliveryTimeResponse\x12k\n" +
+	"\x16GetCancellationReasons\x12'.order.v1.GetCancellationReasonsRequest\x1a(.order.v1.GetCancellationReasonsResponseBLZJgithub.com/OlegDokuchaev/clean-ddd-app/api-gateway/proto/order/v1;order_v1b\x06proto3"
 
 var (
 	file_order_v1_service_proto_rawDescOnce sync.Once
@@ -772,48 +4303,215 @@ func file_order_v1_service_proto_rawDescGZIP() []byte {
 	return file_order_v1_service_proto_rawDescData
 }
 
-var file_order_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_order_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_order_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_order_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_order_v1_service_proto_goTypes = []any{
 	(OrderStatus)(0),                          // 0: order.v1.OrderStatus
-	(*CreateOrderRequest)(nil),                // 1: order.v1.CreateOrderRequest
-	(*CreateOrderResponse)(nil),               // 2: order.v1.CreateOrderResponse
-	(*CancelOrderByCustomerRequest)(nil),      // 3: order.v1.CancelOrderByCustomerRequest
-	(*CompleteDeliveryRequest)(nil),           // 4: order.v1.CompleteDeliveryRequest
-	(*GetOrdersByCustomerRequest)(nil),        // 5: order.v1.GetOrdersByCustomerRequest
-	(*GetOrdersByCustomerResponse)(nil),       // 6: order.v1.GetOrdersByCustomerResponse
-	(*GetCurrentOrdersByCourierRequest)(nil),  // 7: order.v1.GetCurrentOrdersByCourierRequest
-	(*GetCurrentOrdersByCourierResponse)(nil), // 8: order.v1.GetCurrentOrdersByCourierResponse
-	(*Order)(nil),                             // 9: order.v1.Order
-	(*OrderItem)(nil),                         // 10: order.v1.OrderItem
-	(*Delivery)(nil),                          // 11: order.v1.Delivery
-	(*timestamppb.Timestamp)(nil),             // 12: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                     // 13: google.protobuf.Empty
+	(FulfillmentPolicy)(0),                    // 1: order.v1.FulfillmentPolicy
+	(ActorType)(0),                            // 2: order.v1.ActorType
+	(PromotionKind)(0),                        // 3: order.v1.PromotionKind
+	(OrderSort)(0),                            // 4: order.v1.OrderSort
+	(SagaType)(0),                             // 5: order.v1.SagaType
+	(SagaStep)(0),                             // 6: order.v1.SagaStep
+	(RevenueGranularity)(0),                   // 7: order.v1.RevenueGranularity
+	(CancelReason)(0),                         // 8: order.v1.CancelReason
+	(*CreateOrderRequest)(nil),                // 9: order.v1.CreateOrderRequest
+	(*CreateOrderResponse)(nil),               // 10: order.v1.CreateOrderResponse
+	(*GetOrderRequest)(nil),                   // 11: order.v1.GetOrderRequest
+	(*GetOrderResponse)(nil),                  // 12: order.v1.GetOrderResponse
+	(*CancelOrderByCustomerRequest)(nil),      // 13: order.v1.CancelOrderByCustomerRequest
+	(*CompleteDeliveryRequest)(nil),           // 14: order.v1.CompleteDeliveryRequest
+	(*GetOrdersByCustomerRequest)(nil),        // 15: order.v1.GetOrdersByCustomerRequest
+	(*GetOrdersByCustomerResponse)(nil),       // 16: order.v1.GetOrdersByCustomerResponse
+	(*GetCurrentOrdersByCourierRequest)(nil),  // 17: order.v1.GetCurrentOrdersByCourierRequest
+	(*GetCurrentOrdersByCourierResponse)(nil), // 18: order.v1.GetCurrentOrdersByCourierResponse
+	(*GetCourierOrderHistoryRequest)(nil),     // 19: order.v1.GetCourierOrderHistoryRequest
+	(*GetCourierOrderHistoryResponse)(nil),    // 20: order.v1.GetCourierOrderHistoryResponse
+	(*OrderStatusCount)(nil),                  // 21: order.v1.OrderStatusCount
+	(*GetSagaStateRequest)(nil),               // 22: order.v1.GetSagaStateRequest
+	(*GetSagaStateResponse)(nil),              // 23: order.v1.GetSagaStateResponse
+	(*Order)(nil),                             // 24: order.v1.Order
+	(*Discount)(nil),                          // 25: order.v1.Discount
+	(*OrderItem)(nil),                         // 26: order.v1.OrderItem
+	(*Money)(nil),                             // 27: order.v1.Money
+	(*CreatePromotionRequest)(nil),            // 28: order.v1.CreatePromotionRequest
+	(*CreatePromotionResponse)(nil),           // 29: order.v1.CreatePromotionResponse
+	(*GetPromotionsRequest)(nil),              // 30: order.v1.GetPromotionsRequest
+	(*GetPromotionsResponse)(nil),             // 31: order.v1.GetPromotionsResponse
+	(*DeactivatePromotionRequest)(nil),        // 32: order.v1.DeactivatePromotionRequest
+	(*Promotion)(nil),                         // 33: order.v1.Promotion
+	(*PromotionRules)(nil),                    // 34: order.v1.PromotionRules
+	(*SearchOrdersRequest)(nil),               // 35: order.v1.SearchOrdersRequest
+	(*SearchOrdersResponse)(nil),              // 36: order.v1.SearchOrdersResponse
+	(*ForceCancelOrderRequest)(nil),           // 37: order.v1.ForceCancelOrderRequest
+	(*RetrySagaStepRequest)(nil),              // 38: order.v1.RetrySagaStepRequest
+	(*ReassignCourierRequest)(nil),            // 39: order.v1.ReassignCourierRequest
+	(*GetStatusFunnelRequest)(nil),            // 40: order.v1.GetStatusFunnelRequest
+	(*GetStatusFunnelResponse)(nil),           // 41: order.v1.GetStatusFunnelResponse
+	(*GetRevenueRequest)(nil),                 // 42: order.v1.GetRevenueRequest
+	(*GetRevenueResponse)(nil),                // 43: order.v1.GetRevenueResponse
+	(*RevenueBucket)(nil),                     // 44: order.v1.RevenueBucket
+	(*GetDeliveryTimeRequest)(nil),            // 45: order.v1.GetDeliveryTimeRequest
+	(*GetDeliveryTimeResponse)(nil),           // 46: order.v1.GetDeliveryTimeResponse
+	(*GetCancellationReasonsRequest)(nil),     // 47: order.v1.GetCancellationReasonsRequest
+	(*GetCancellationReasonsResponse)(nil),    // 48: order.v1.GetCancellationReasonsResponse
+	(*CancelReasonCount)(nil),                 // 49: order.v1.CancelReasonCount
+	(*GetOrderHistoryRequest)(nil),            // 50: order.v1.GetOrderHistoryRequest
+	(*GetOrderHistoryResponse)(nil),           // 51: order.v1.GetOrderHistoryResponse
+	(*StatusChange)(nil),                      // 52: order.v1.StatusChange
+	(*Actor)(nil),                             // 53: order.v1.Actor
+	(*GetAvailableSlotsRequest)(nil),          // 54: order.v1.GetAvailableSlotsRequest
+	(*GetAvailableSlotsResponse)(nil),         // 55: order.v1.GetAvailableSlotsResponse
+	(*SlotAvailability)(nil),                  // 56: order.v1.SlotAvailability
+	(*DeliverySlot)(nil),                      // 57: order.v1.DeliverySlot
+	(*Delivery)(nil),                          // 58: order.v1.Delivery
+	(*Address)(nil),                           // 59: order.v1.Address
+	(*Location)(nil),                          // 60: order.v1.Location
+	(*Saga)(nil),                              // 61: order.v1.Saga
+	(*SagaStepRecord)(nil),                    // 62: order.v1.SagaStepRecord
+	(*SagaFailure)(nil),                       // 63: order.v1.SagaFailure
+	(*timestamppb.Timestamp)(nil),             // 64: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),               // 65: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                     // 66: google.protobuf.Empty
 }
 var file_order_v1_service_proto_depIdxs = []int32{
-	10, // 0: order.v1.CreateOrderRequest.items:type_name -> order.v1.OrderItem
-	9,  // 1: order.v1.GetOrdersByCustomerResponse.orders:type_name -> order.v1.Order
-	9,  // 2: order.v1.GetCurrentOrdersByCourierResponse.orders:type_name -> order.v1.Order
-	0,  // 3: order.v1.Order.status:type_name -> order.v1.OrderStatus
-	10, // 4: order.v1.Order.items:type_name -> order.v1.OrderItem
-	11, // 5: order.v1.Order.delivery:type_name -> order.v1.Delivery
-	12, // 6: order.v1.Order.created:type_name -> google.protobuf.Timestamp
-	12, // 7: order.v1.Delivery.arrived:type_name -> google.protobuf.Timestamp
-	1,  // 8: order.v1.OrderService.CreateOrder:input_type -> order.v1.CreateOrderRequest
-	3,  // 9: order.v1.OrderService.CancelOrderByCustomer:input_type -> order.v1.CancelOrderByCustomerRequest
-	4,  // 10: order.v1.OrderService.CompleteDelivery:input_type -> order.v1.CompleteDeliveryRequest
-	5,  // 11: order.v1.OrderService.GetOrdersByCustomer:input_type -> order.v1.GetOrdersByCustomerRequest
-	7,  // 12: order.v1.OrderService.GetCurrentOrdersByCourier:input_type -> order.v1.GetCurrentOrdersByCourierRequest
-	2,  // 13: order.v1.OrderService.CreateOrder:output_type -> order.v1.CreateOrderResponse
-	13, // 14: order.v1.OrderService.CancelOrderByCustomer:output_type -> google.protobuf.Empty
-	13, // 15: order.v1.OrderService.CompleteDelivery:output_type -> google.protobuf.Empty
-	6,  // 16: order.v1.OrderService.GetOrdersByCustomer:output_type -> order.v1.GetOrdersByCustomerResponse
-	8,  // 17: order.v1.OrderService.GetCurrentOrdersByCourier:output_type -> order.v1.GetCurrentOrdersByCourierResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	26,  // 0: order.v1.CreateOrderRequest.items:type_name -> order.v1.OrderItem
+	59,  // 1: order.v1.CreateOrderRequest.address:type_name -> order.v1.Address
+	57,  // 2: order.v1.CreateOrderRequest.delivery_slot:type_name -> order.v1.DeliverySlot
+	1,   // 3: order.v1.CreateOrderRequest.fulfillment_policy:type_name -> order.v1.FulfillmentPolicy
+	53,  // 4: order.v1.GetOrderRequest.requester:type_name -> order.v1.Actor
+	24,  // 5: order.v1.GetOrderResponse.order:type_name -> order.v1.Order
+	0,   // 6: order.v1.GetOrdersByCustomerRequest.statuses:type_name -> order.v1.OrderStatus
+	64,  // 7: order.v1.GetOrdersByCustomerRequest.created_from:type_name -> google.protobuf.Timestamp
+	64,  // 8: order.v1.GetOrdersByCustomerRequest.created_to:type_name -> google.protobuf.Timestamp
+	4,   // 9: order.v1.GetOrdersByCustomerRequest.sort:type_name -> order.v1.OrderSort
+	24,  // 10: order.v1.GetOrdersByCustomerResponse.orders:type_name -> order.v1.Order
+	24,  // 11: order.v1.GetCurrentOrdersByCourierResponse.orders:type_name -> order.v1.Order
+	0,   // 12: order.v1.GetCourierOrderHistoryRequest.statuses:type_name -> order.v1.OrderStatus
+	64,  // 13: order.v1.GetCourierOrderHistoryRequest.created_from:type_name -> google.protobuf.Timestamp
+	64,  // 14: order.v1.GetCourierOrderHistoryRequest.created_to:type_name -> google.protobuf.Timestamp
+	4,   // 15: order.v1.GetCourierOrderHistoryRequest.sort:type_name -> order.v1.OrderSort
+	24,  // 16: order.v1.GetCourierOrderHistoryResponse.orders:type_name -> order.v1.Order
+	21,  // 17: order.v1.GetCourierOrderHistoryResponse.counts:type_name -> order.v1.OrderStatusCount
+	0,   // 18: order.v1.OrderStatusCount.status:type_name -> order.v1.OrderStatus
+	61,  // 19: order.v1.GetSagaStateResponse.sagas:type_name -> order.v1.Saga
+	0,   // 20: order.v1.Order.status:type_name -> order.v1.OrderStatus
+	26,  // 21: order.v1.Order.items:type_name -> order.v1.OrderItem
+	58,  // 22: order.v1.Order.delivery:type_name -> order.v1.Delivery
+	64,  // 23: order.v1.Order.created:type_name -> google.protobuf.Timestamp
+	27,  // 24: order.v1.Order.total:type_name -> order.v1.Money
+	25,  // 25: order.v1.Order.discount:type_name -> order.v1.Discount
+	27,  // 26: order.v1.Order.subtotal:type_name -> order.v1.Money
+	1,   // 27: order.v1.Order.fulfillment_policy:type_name -> order.v1.FulfillmentPolicy
+	27,  // 28: order.v1.Discount.amount:type_name -> order.v1.Money
+	27,  // 29: order.v1.OrderItem.price:type_name -> order.v1.Money
+	27,  // 30: order.v1.OrderItem.line_total:type_name -> order.v1.Money
+	34,  // 31: order.v1.CreatePromotionRequest.rules:type_name -> order.v1.PromotionRules
+	33,  // 32: order.v1.GetPromotionsResponse.promotions:type_name -> order.v1.Promotion
+	34,  // 33: order.v1.Promotion.rules:type_name -> order.v1.PromotionRules
+	64,  // 34: order.v1.Promotion.created:type_name -> google.protobuf.Timestamp
+	3,   // 35: order.v1.PromotionRules.kind:type_name -> order.v1.PromotionKind
+	27,  // 36: order.v1.PromotionRules.amount:type_name -> order.v1.Money
+	27,  // 37: order.v1.PromotionRules.min_order_value:type_name -> order.v1.Money
+	64,  // 38: order.v1.PromotionRules.valid_from:type_name -> google.protobuf.Timestamp
+	64,  // 39: order.v1.PromotionRules.valid_to:type_name -> google.protobuf.Timestamp
+	0,   // 40: order.v1.SearchOrdersRequest.statuses:type_name -> order.v1.OrderStatus
+	64,  // 41: order.v1.SearchOrdersRequest.created_from:type_name -> google.protobuf.Timestamp
+	64,  // 42: order.v1.SearchOrdersRequest.created_to:type_name -> google.protobuf.Timestamp
+	4,   // 43: order.v1.SearchOrdersRequest.sort:type_name -> order.v1.OrderSort
+	24,  // 44: order.v1.SearchOrdersResponse.orders:type_name -> order.v1.Order
+	5,   // 45: order.v1.RetrySagaStepRequest.type:type_name -> order.v1.SagaType
+	64,  // 46: order.v1.GetStatusFunnelRequest.created_from:type_name -> google.protobuf.Timestamp
+	64,  // 47: order.v1.GetStatusFunnelRequest.created_to:type_name -> google.protobuf.Timestamp
+	21,  // 48: order.v1.GetStatusFunnelResponse.current:type_name -> order.v1.OrderStatusCount
+	21,  // 49: order.v1.GetStatusFunnelResponse.reached:type_name -> order.v1.OrderStatusCount
+	64,  // 50: order.v1.GetRevenueRequest.created_from:type_name -> google.protobuf.Timestamp
+	64,  // 51: order.v1.GetRevenueRequest.created_to:type_name -> google.protobuf.Timestamp
+	7,   // 52: order.v1.GetRevenueRequest.granularity:type_name -> order.v1.RevenueGranularity
+	44,  // 53: order.v1.GetRevenueResponse.buckets:type_name -> order.v1.RevenueBucket
+	64,  // 54: order.v1.RevenueBucket.start:type_name -> google.protobuf.Timestamp
+	27,  // 55: order.v1.RevenueBucket.revenue:type_name -> order.v1.Money
+	64,  // 56: order.v1.GetDeliveryTimeRequest.created_from:type_name -> google.protobuf.Timestamp
+	64,  // 57: order.v1.GetDeliveryTimeRequest.created_to:type_name -> google.protobuf.Timestamp
+	65,  // 58: order.v1.GetDeliveryTimeResponse.average:type_name -> google.protobuf.Duration
+	64,  // 59: order.v1.GetCancellationReasonsRequest.created_from:type_name -> google.protobuf.Timestamp
+	64,  // 60: order.v1.GetCancellationReasonsRequest.created_to:type_name -> google.protobuf.Timestamp
+	49,  // 61: order.v1.GetCancellationReasonsResponse.reasons:type_name -> order.v1.CancelReasonCount
+	8,   // 62: order.v1.CancelReasonCount.reason:type_name -> order.v1.CancelReason
+	53,  // 63: order.v1.GetOrderHistoryRequest.requester:type_name -> order.v1.Actor
+	52,  // 64: order.v1.GetOrderHistoryResponse.history:type_name -> order.v1.StatusChange
+	0,   // 65: order.v1.StatusChange.from:type_name -> order.v1.OrderStatus
+	0,   // 66: order.v1.StatusChange.to:type_name -> order.v1.OrderStatus
+	64,  // 67: order.v1.StatusChange.occurred:type_name -> google.protobuf.Timestamp
+	53,  // 68: order.v1.StatusChange.actor:type_name -> order.v1.Actor
+	2,   // 69: order.v1.Actor.type:type_name -> order.v1.ActorType
+	56,  // 70: order.v1.GetAvailableSlotsResponse.slots:type_name -> order.v1.SlotAvailability
+	57,  // 71: order.v1.SlotAvailability.slot:type_name -> order.v1.DeliverySlot
+	64,  // 72: order.v1.DeliverySlot.start:type_name -> google.protobuf.Timestamp
+	64,  // 73: order.v1.DeliverySlot.end:type_name -> google.protobuf.Timestamp
+	64,  // 74: order.v1.Delivery.arrived:type_name -> google.protobuf.Timestamp
+	64,  // 75: order.v1.Delivery.assigned:type_name -> google.protobuf.Timestamp
+	59,  // 76: order.v1.Delivery.address:type_name -> order.v1.Address
+	57,  // 77: order.v1.Delivery.slot:type_name -> order.v1.DeliverySlot
+	60,  // 78: order.v1.Address.location:type_name -> order.v1.Location
+	5,   // 79: order.v1.Saga.type:type_name -> order.v1.SagaType
+	6,   // 80: order.v1.Saga.step:type_name -> order.v1.SagaStep
+	62,  // 81: order.v1.Saga.history:type_name -> order.v1.SagaStepRecord
+	63,  // 82: order.v1.Saga.last_error:type_name -> order.v1.SagaFailure
+	64,  // 83: order.v1.Saga.created:type_name -> google.protobuf.Timestamp
+	64,  // 84: order.v1.Saga.updated:type_name -> google.protobuf.Timestamp
+	64,  // 85: order.v1.Saga.resume_at:type_name -> google.protobuf.Timestamp
+	6,   // 86: order.v1.SagaStepRecord.step:type_name -> order.v1.SagaStep
+	64,  // 87: order.v1.SagaStepRecord.entered:type_name -> google.protobuf.Timestamp
+	6,   // 88: order.v1.SagaFailure.step:type_name -> order.v1.SagaStep
+	64,  // 89: order.v1.SagaFailure.occurred:type_name -> google.protobuf.Timestamp
+	9,   // 90: order.v1.OrderService.CreateOrder:input_type -> order.v1.CreateOrderRequest
+	11,  // 91: order.v1.OrderService.GetOrder:input_type -> order.v1.GetOrderRequest
+	13,  // 92: order.v1.OrderService.CancelOrderByCustomer:input_type -> order.v1.CancelOrderByCustomerRequest
+	14,  // 93: order.v1.OrderService.CompleteDelivery:input_type -> order.v1.CompleteDeliveryRequest
+	15,  // 94: order.v1.OrderService.GetOrdersByCustomer:input_type -> order.v1.GetOrdersByCustomerRequest
+	17,  // 95: order.v1.OrderService.GetCurrentOrdersByCourier:input_type -> order.v1.GetCurrentOrdersByCourierRequest
+	19,  // 96: order.v1.OrderService.GetCourierOrderHistory:input_type -> order.v1.GetCourierOrderHistoryRequest
+	22,  // 97: order.v1.OrderService.GetSagaState:input_type -> order.v1.GetSagaStateRequest
+	28,  // 98: order.v1.OrderService.CreatePromotion:input_type -> order.v1.CreatePromotionRequest
+	30,  // 99: order.v1.OrderService.GetPromotions:input_type -> order.v1.GetPromotionsRequest
+	32,  // 100: order.v1.OrderService.DeactivatePromotion:input_type -> order.v1.DeactivatePromotionRequest
+	54,  // 101: order.v1.OrderService.GetAvailableSlots:input_type -> order.v1.GetAvailableSlotsRequest
+	50,  // 102: order.v1.OrderService.GetOrderHistory:input_type -> order.v1.GetOrderHistoryRequest
+	35,  // 103: order.v1.OrderService.SearchOrders:input_type -> order.v1.SearchOrdersRequest
+	37,  // 104: order.v1.OrderService.ForceCancelOrder:input_type -> order.v1.ForceCancelOrderRequest
+	38,  // 105: order.v1.OrderService.RetrySagaStep:input_type -> order.v1.RetrySagaStepRequest
+	39,  // 106: order.v1.OrderService.ReassignCourier:input_type -> order.v1.ReassignCourierRequest
+	40,  // 107: order.v1.OrderService.GetStatusFunnel:input_type -> order.v1.GetStatusFunnelRequest
+	42,  // 108: order.v1.OrderService.GetRevenue:input_type -> order.v1.GetRevenueRequest
+	45,  // 109: order.v1.OrderService.GetDeliveryTime:input_type -> order.v1.GetDeliveryTimeRequest
+	47,  // 110: order.v1.OrderService.GetCancellationReasons:input_type -> order.v1.GetCancellationReasonsRequest
+	10,  // 111: order.v1.OrderService.CreateOrder:output_type -> order.v1.CreateOrderResponse
+	12,  // 112: order.v1.OrderService.GetOrder:output_type -> order.v1.GetOrderResponse
+	66,  // 113: order.v1.OrderService.CancelOrderByCustomer:output_type -> google.protobuf.Empty
+	66,  // 114: order.v1.OrderService.CompleteDelivery:output_type -> google.protobuf.Empty
+	16,  // 115: order.v1.OrderService.GetOrdersByCustomer:output_type -> order.v1.GetOrdersByCustomerResponse
+	18,  // 116: order.v1.OrderService.GetCurrentOrdersByCourier:output_type -> order.v1.GetCurrentOrdersByCourierResponse
+	20,  // 117: order.v1.OrderService.GetCourierOrderHistory:output_type -> order.v1.GetCourierOrderHistoryResponse
+	23,  // 118: order.v1.OrderService.GetSagaState:output_type -> order.v1.GetSagaStateResponse
+	29,  // 119: order.v1.OrderService.CreatePromotion:output_type -> order.v1.CreatePromotionResponse
+	31,  // 120: order.v1.OrderService.GetPromotions:output_type -> order.v1.GetPromotionsResponse
+	66,  // 121: order.v1.OrderService.DeactivatePromotion:output_type -> google.protobuf.Empty
+	55,  // 122: order.v1.OrderService.GetAvailableSlots:output_type -> order.v1.GetAvailableSlotsResponse
+	51,  // 123: order.v1.OrderService.GetOrderHistory:output_type -> order.v1.GetOrderHistoryResponse
+	36,  // 124: order.v1.OrderService.SearchOrders:output_type -> order.v1.SearchOrdersResponse
+	66,  // 125: order.v1.OrderService.ForceCancelOrder:output_type -> google.protobuf.Empty
+	66,  // 126: order.v1.OrderService.RetrySagaStep:output_type -> google.protobuf.Empty
+	66,  // 127: order.v1.OrderService.ReassignCourier:output_type -> google.protobuf.Empty
+	41,  // 128: order.v1.OrderService.GetStatusFunnel:output_type -> order.v1.GetStatusFunnelResponse
+	43,  // 129: order.v1.OrderService.GetRevenue:output_type -> order.v1.GetRevenueResponse
+	46,  // 130: order.v1.OrderService.GetDeliveryTime:output_type -> order.v1.GetDeliveryTimeResponse
+	48,  // 131: order.v1.OrderService.GetCancellationReasons:output_type -> order.v1.GetCancellationReasonsResponse
+	111, // [111:132] is the sub-list for method output_type
+	90,  // [90:111] is the sub-list for method input_type
+	90,  // [90:90] is the sub-list for extension type_name
+	90,  // [90:90] is the sub-list for extension extendee
+	0,   // [0:90] is the sub-list for field type_name
 }
 
 func init() { file_order_v1_service_proto_init() }
@@ -821,14 +4519,18 @@ func file_order_v1_service_proto_init() {
 	if File_order_v1_service_proto != nil {
 		return
 	}
-	file_order_v1_service_proto_msgTypes[10].OneofWrappers = []any{}
+	file_order_v1_service_proto_msgTypes[26].OneofWrappers = []any{}
+	file_order_v1_service_proto_msgTypes[43].OneofWrappers = []any{}
+	file_order_v1_service_proto_msgTypes[44].OneofWrappers = []any{}
+	file_order_v1_service_proto_msgTypes[49].OneofWrappers = []any{}
+	file_order_v1_service_proto_msgTypes[52].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_v1_service_proto_rawDesc), len(file_order_v1_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      9,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -15,9 +15,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/couriers/login": {
-            "post": {
-                "description": "Authenticate a courier and get a JWT token",
+        "/analytics/orders/cancellations": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "Count the canceled orders created in the period per reason: customer, out_of_stock,\ncourier_not_found, timeout or admin (admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,41 +30,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "couriers"
+                    "orders"
                 ],
-                "summary": "Courier login",
+                "summary": "Cancellation reasons",
                 "parameters": [
                     {
-                        "description": "Courier login credentials",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/courier_request.LoginRequest"
-                        }
+                        "type": "string",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "created_to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Login successful",
+                        "description": "Canceled orders per reason",
                         "schema": {
-                            "$ref": "#/definitions/courier_response.LoginResponse"
+                            "$ref": "#/definitions/order_response.CancellationReasonsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request format",
+                        "description": "Invalid creation time range",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "401": {
-                        "description": "Invalid credentials",
+                        "description": "Missing or invalid access token",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
-                    "404": {
-                        "description": "Courier not found",
+                    "422": {
+                        "description": "Invalid query parameters",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -73,14 +79,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/couriers/me/orders": {
+        "/analytics/orders/delivery-time": {
             "get": {
                 "security": [
                     {
-                        "CourierBearerAuth": []
+                        "AdminAccessToken": []
                     }
                 ],
-                "description": "Get all current orders for the authenticated courier",
+                "description": "Average the time from placing an order to its arrival over the delivered orders created in the\nperiod (admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -88,32 +94,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "couriers"
+                    "orders"
                 ],
-                "summary": "Get courier orders",
+                "summary": "Average delivery time",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "limit",
+                        "type": "string",
+                        "name": "created_from",
                         "in": "query"
                     },
                     {
-                        "minimum": 0,
-                        "type": "integer",
-                        "name": "offset",
+                        "type": "string",
+                        "name": "created_to",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of orders",
+                        "description": "Average delivery time",
                         "schema": {
-                            "$ref": "#/definitions/order_response.OrdersResponse"
+                            "$ref": "#/definitions/order_response.DeliveryTimeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid creation time range",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "401": {
-                        "description": "Missing or invalid bearer token",
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid query parameters",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -127,9 +143,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/couriers/register": {
-            "post": {
-                "description": "Register a new courier with name, password and phone",
+        "/analytics/orders/funnel": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "Count the orders created in the period by the status they are in now and by every status they\nhave been in, e.g. how many of the placed orders went out for delivery (admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -137,41 +158,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "couriers"
+                    "orders"
                 ],
-                "summary": "Register new courier",
+                "summary": "Order status funnel",
                 "parameters": [
                     {
-                        "description": "Courier registration data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/courier_request.RegisterRequest"
-                        }
+                        "type": "string",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "created_to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Courier created successfully",
+                    "200": {
+                        "description": "Order counts per status",
                         "schema": {
-                            "$ref": "#/definitions/courier_response.RegisterResponse"
+                            "$ref": "#/definitions/order_response.StatusFunnelResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request format",
+                        "description": "Invalid creation time range",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
-                    "409": {
-                        "description": "Courier with this phone already exists",
+                    "401": {
+                        "description": "Missing or invalid access token",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "422": {
-                        "description": "Invalid data format",
+                        "description": "Invalid query parameters",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -185,9 +207,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/customers/auth-challenges/{challenge_id}": {
-            "patch": {
-                "description": "Verify OTP code for the authentication challenge",
+        "/analytics/orders/revenue": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "Sum the totals of the delivered orders created in the period per day or week of creation, one\nbucket per currency. Buckets are aligned in UTC and weeks start on Monday (admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -195,54 +222,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
+                    "orders"
                 ],
-                "summary": "Verify OTP code",
+                "summary": "Order revenue",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Challenge ID",
-                        "name": "challenge_id",
-                        "in": "path",
-                        "required": true
+                        "name": "created_from",
+                        "in": "query"
                     },
                     {
-                        "description": "OTP verification data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/customer_request.VerifyOtpRequest"
-                        }
+                        "type": "string",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "day",
+                            "week"
+                        ],
+                        "type": "string",
+                        "description": "Defaults to day.",
+                        "name": "granularity",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OTP verified",
+                        "description": "Revenue buckets, earliest first",
                         "schema": {
-                            "$ref": "#/definitions/customer_response.VerifyOtpResponse"
+                            "$ref": "#/definitions/order_response.RevenueResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request format",
+                        "description": "Invalid creation time range",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "401": {
-                        "description": "Invalid or expired code",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponseDetail"
-                        }
-                    },
-                    "404": {
-                        "description": "Challenge not found",
+                        "description": "Missing or invalid access token",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "422": {
-                        "description": "Invalid data format",
+                        "description": "Invalid query parameters",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -256,9 +281,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/customers/login": {
+        "/couriers/login": {
             "post": {
-                "description": "Authenticate a customer and get a JWT token",
+                "description": "Authenticate a courier and get a JWT token",
                 "consumes": [
                     "application/json"
                 ],
@@ -266,17 +291,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
+                    "couriers"
                 ],
-                "summary": "Customer login",
+                "summary": "Courier login",
                 "parameters": [
                     {
-                        "description": "Customer login credentials",
+                        "description": "Courier login credentials",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/customer_request.LoginRequest"
+                            "$ref": "#/definitions/courier_request.LoginRequest"
                         }
                     }
                 ],
@@ -284,7 +309,7 @@ const docTemplate = `{
                     "200": {
                         "description": "Login successful",
                         "schema": {
-                            "$ref": "#/definitions/customer_response.LoginResponse"
+                            "$ref": "#/definitions/courier_response.LoginResponse"
                         }
                     },
                     "400": {
@@ -300,7 +325,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Customer not found",
+                        "description": "Courier not found",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -314,9 +339,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/customers/password-resets": {
-            "post": {
-                "description": "Send password reset email to customer",
+        "/couriers/me/orders": {
+            "get": {
+                "security": [
+                    {
+                        "CourierBearerAuth": []
+                    }
+                ],
+                "description": "Get the orders the authenticated courier is delivering right now, oldest assignment first",
                 "consumes": [
                     "application/json"
                 ],
@@ -324,38 +354,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
-                ],
-                "summary": "Request password reset",
-                "parameters": [
-                    {
-                        "description": "Password reset request data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/customer_request.RequestPasswordResetRequest"
-                        }
-                    }
+                    "couriers"
                 ],
+                "summary": "Get courier orders",
                 "responses": {
-                    "204": {
-                        "description": "Password reset email sent"
-                    },
-                    "400": {
-                        "description": "Invalid request format",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponseDetail"
-                        }
-                    },
-                    "404": {
-                        "description": "Customer not found",
+                    "200": {
+                        "description": "List of orders",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                            "$ref": "#/definitions/order_response.OrdersResponse"
                         }
                     },
-                    "422": {
-                        "description": "Invalid data format",
+                    "401": {
+                        "description": "Missing or invalid bearer token",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -369,9 +379,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/customers/password-resets/{token}": {
-            "patch": {
-                "description": "Reset customer password using token",
+        "/couriers/me/orders/history": {
+            "get": {
+                "security": [
+                    {
+                        "CourierBearerAuth": []
+                    }
+                ],
+                "description": "Get a page of every order assigned to the authenticated courier with totals per status, optionally filtered by status and creation time",
                 "consumes": [
                     "application/json"
                 ],
@@ -379,51 +394,72 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
+                    "couriers"
                 ],
-                "summary": "Complete password reset",
+                "summary": "Get courier delivery history",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Password reset token",
-                        "name": "token",
-                        "in": "path",
-                        "required": true
+                        "name": "created_from",
+                        "in": "query"
                     },
                     {
-                        "description": "New password data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/customer_request.CompletePasswordReset"
-                        }
+                        "type": "string",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest_first",
+                            "oldest_first"
+                        ],
+                        "type": "string",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Password reset completed"
-                    },
-                    "400": {
-                        "description": "Invalid request format",
+                    "200": {
+                        "description": "Page of orders with counts per status",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                            "$ref": "#/definitions/order_response.CourierHistoryResponse"
                         }
                     },
-                    "401": {
-                        "description": "Invalid or expired token",
+                    "400": {
+                        "description": "Invalid cursor or creation time range",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
-                    "404": {
-                        "description": "Token not found",
+                    "401": {
+                        "description": "Missing or invalid bearer token",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "422": {
-                        "description": "Invalid data format",
+                        "description": "Invalid query parameters",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -437,9 +473,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/customers/register": {
+        "/couriers/register": {
             "post": {
-                "description": "Register a new customer with name, password and phone",
+                "description": "Register a new courier with name, password and phone",
                 "consumes": [
                     "application/json"
                 ],
@@ -447,25 +483,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "customers"
+                    "couriers"
                 ],
-                "summary": "Register new customer",
+                "summary": "Register new courier",
                 "parameters": [
                     {
-                        "description": "Customer registration data",
+                        "description": "Courier registration data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/customer_request.RegisterRequest"
+                            "$ref": "#/definitions/courier_request.RegisterRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Customer created successfully",
+                        "description": "Courier created successfully",
                         "schema": {
-                            "$ref": "#/definitions/customer_response.RegisterResponse"
+                            "$ref": "#/definitions/courier_response.RegisterResponse"
                         }
                     },
                     "400": {
@@ -475,7 +511,7 @@ const docTemplate = `{
                         }
                     },
                     "409": {
-                        "description": "Customer with this phone already exists",
+                        "description": "Courier with this phone already exists",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -495,9 +531,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/items": {
-            "get": {
-                "description": "Get a list of all items available in the warehouse",
+        "/customers/auth-challenges/{challenge_id}": {
+            "patch": {
+                "description": "Verify OTP code for the authentication challenge",
                 "consumes": [
                     "application/json"
                 ],
@@ -505,28 +541,56 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "customers"
                 ],
-                "summary": "Get all warehouse items",
+                "summary": "Verify OTP code",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Challenge ID",
+                        "name": "challenge_id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "minimum": 0,
-                        "type": "integer",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "OTP verification data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/customer_request.VerifyOtpRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of items",
+                        "description": "OTP verified",
                         "schema": {
-                            "$ref": "#/definitions/warehouse_response.ItemsResponse"
+                            "$ref": "#/definitions/customer_response.VerifyOtpResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid or expired code",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Challenge not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid data format",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "500": {
@@ -538,14 +602,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/items/decrease": {
-            "patch": {
-                "security": [
-                    {
-                        "AdminAccessToken": []
-                    }
-                ],
-                "description": "Decrease the quantity of items in the warehouse (admin only)",
+        "/customers/login": {
+            "post": {
+                "description": "Authenticate a customer and get a JWT token",
                 "consumes": [
                     "application/json"
                 ],
@@ -553,23 +612,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "customers"
                 ],
-                "summary": "Decrease items quantity",
+                "summary": "Customer login",
                 "parameters": [
                     {
-                        "description": "Items to decrease quantity",
+                        "description": "Customer login credentials",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/warehouse_request.ReleaseItemsRequest"
+                            "$ref": "#/definitions/customer_request.LoginRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": " \"Quantity decreased successfully"
+                        "description": "Login successful",
+                        "schema": {
+                            "$ref": "#/definitions/customer_response.LoginResponse"
+                        }
                     },
                     "400": {
                         "description": "Invalid request format",
@@ -578,19 +640,13 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Missing or invalid access token",
+                        "description": "Invalid credentials",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "404": {
-                        "description": "Item not found",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponseDetail"
-                        }
-                    },
-                    "422": {
-                        "description": "Invalid item data or insufficient quantity",
+                        "description": "Customer not found",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -604,14 +660,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/items/increase": {
-            "patch": {
-                "security": [
-                    {
-                        "AdminAccessToken": []
-                    }
-                ],
-                "description": "Increase the quantity of items in the warehouse (admin only)",
+        "/customers/password-resets": {
+            "post": {
+                "description": "Send password reset email to customer",
                 "consumes": [
                     "application/json"
                 ],
@@ -619,23 +670,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "customers"
                 ],
-                "summary": "Increase items quantity",
+                "summary": "Request password reset",
                 "parameters": [
                     {
-                        "description": "Items to increase quantity",
+                        "description": "Password reset request data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/warehouse_request.ReserveItemsRequest"
+                            "$ref": "#/definitions/customer_request.RequestPasswordResetRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": " \"Quantity increased successfully"
+                    "204": {
+                        "description": "Password reset email sent"
                     },
                     "400": {
                         "description": "Invalid request format",
@@ -643,20 +694,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
-                    "401": {
-                        "description": "Missing or invalid access token",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponseDetail"
-                        }
-                    },
                     "404": {
-                        "description": "Item not found",
+                        "description": "Customer not found",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "422": {
-                        "description": "Invalid item data",
+                        "description": "Invalid data format",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -670,14 +715,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders": {
-            "get": {
-                "security": [
-                    {
-                        "CustomerBearerAuth": []
-                    }
-                ],
-                "description": "Get all orders for the authenticated customer",
+        "/customers/password-resets/{token}": {
+            "patch": {
+                "description": "Reset customer password using token",
                 "consumes": [
                     "application/json"
                 ],
@@ -685,32 +725,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "customers"
                 ],
-                "summary": "Get customer orders",
+                "summary": "Complete password reset",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Password reset token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "minimum": 0,
-                        "type": "integer",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "New password data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/customer_request.CompletePasswordReset"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "List of orders",
+                    "204": {
+                        "description": "Password reset completed"
+                    },
+                    "400": {
+                        "description": "Invalid request format",
                         "schema": {
-                            "$ref": "#/definitions/order_response.OrdersResponse"
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "401": {
-                        "description": "Missing or invalid bearer token",
+                        "description": "Invalid or expired token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Token not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid data format",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -722,14 +781,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/customers/register": {
             "post": {
-                "security": [
-                    {
-                        "CustomerBearerAuth": []
-                    }
-                ],
-                "description": "Create a new order with items",
+                "description": "Register a new customer with name, password and phone",
                 "consumes": [
                     "application/json"
                 ],
@@ -737,23 +793,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "customers"
                 ],
-                "summary": "Create a new order",
+                "summary": "Register new customer",
                 "parameters": [
                     {
-                        "description": "Order details",
+                        "description": "Customer registration data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/order_request.CreateRequest"
+                            "$ref": "#/definitions/customer_request.RegisterRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": " \"Created with location header"
+                        "description": "Customer created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/customer_response.RegisterResponse"
+                        }
                     },
                     "400": {
                         "description": "Invalid request format",
@@ -761,14 +820,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
-                    "401": {
-                        "description": "Missing or invalid bearer token",
+                    "409": {
+                        "description": "Customer with this phone already exists",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "422": {
-                        "description": "Invalid item data",
+                        "description": "Invalid data format",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -782,14 +841,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders/{id}/cancel": {
-            "patch": {
-                "security": [
-                    {
-                        "CustomerBearerAuth": []
-                    }
-                ],
-                "description": "Cancel an order by its ID",
+        "/delivery-slots": {
+            "get": {
+                "description": "List the delivery slots that can still be booked, earliest first.\nPass one as delivery_slot when creating an order to schedule its delivery.",
                 "consumes": [
                     "application/json"
                 ],
@@ -799,42 +853,55 @@ const docTemplate = `{
                 "tags": [
                     "orders"
                 ],
-                "summary": "Cancel an order",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Order ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "List delivery slots",
                 "responses": {
-                    "204": {
-                        "description": " \"No Content"
+                    "200": {
+                        "description": "Bookable slots",
+                        "schema": {
+                            "$ref": "#/definitions/order_response.SlotsResponse"
+                        }
                     },
-                    "400": {
-                        "description": "Invalid request",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponseDetail"
-                        }
-                    },
-                    "401": {
-                        "description": "Missing or invalid bearer token",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponseDetail"
-                        }
-                    },
-                    "404": {
-                        "description": "Order not found",
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
+                    }
+                }
+            }
+        },
+        "/items": {
+            "get": {
+                "description": "Get a list of all items available in the warehouse",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Get all warehouse items",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
                     },
-                    "422": {
-                        "description": "Invalid order ID format",
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of items",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                            "$ref": "#/definitions/warehouse_response.ItemsResponse"
                         }
                     },
                     "500": {
@@ -846,14 +913,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders/{id}/complete": {
+        "/items/decrease": {
             "patch": {
                 "security": [
                     {
-                        "CourierBearerAuth": []
+                        "AdminAccessToken": []
                     }
                 ],
-                "description": "Mark an order as delivered (completed)",
+                "description": "Decrease the quantity of items in the warehouse (admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -861,42 +928,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "items"
                 ],
-                "summary": "Complete order",
+                "summary": "Decrease items quantity",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Order ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Items to decrease quantity",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/warehouse_request.ReleaseItemsRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": " \"OK"
+                        "description": " \"Quantity decreased successfully"
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Invalid request format",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "401": {
-                        "description": "Missing or invalid bearer token",
+                        "description": "Missing or invalid access token",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "404": {
-                        "description": "Order not found",
+                        "description": "Item not found",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "422": {
-                        "description": "Invalid order ID format",
+                        "description": "Invalid item data or insufficient quantity",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -910,14 +979,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/products": {
-            "post": {
+        "/items/increase": {
+            "patch": {
                 "security": [
                     {
                         "AdminAccessToken": []
                     }
                 ],
-                "description": "Create a new product in the warehouse (admin only)",
+                "description": "Increase the quantity of items in the warehouse (admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -925,26 +994,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "items"
                 ],
-                "summary": "Create a new product",
+                "summary": "Increase items quantity",
                 "parameters": [
                     {
-                        "description": "Product details",
+                        "description": "Items to increase quantity",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/warehouse_request.CreateProductRequest"
+                            "$ref": "#/definitions/warehouse_request.ReserveItemsRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Product created successfully",
-                        "schema": {
-                            "$ref": "#/definitions/warehouse_response.CreateProductResponse"
-                        }
+                    "200": {
+                        "description": " \"Quantity increased successfully"
                     },
                     "400": {
                         "description": "Invalid request format",
@@ -958,14 +1024,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
-                    "409": {
-                        "description": "Product already exists",
+                    "404": {
+                        "description": "Item not found",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "422": {
-                        "description": "Invalid product data",
+                        "description": "Invalid item data",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -979,52 +1045,87 @@ const docTemplate = `{
                 }
             }
         },
-        "/products/{id}/image": {
+        "/orders": {
             "get": {
                 "security": [
                     {
-                        "AdminAccessToken": []
+                        "CustomerBearerAuth": []
                     }
                 ],
-                "description": "Get the image for a specific product (admin only)",
+                "description": "Get a page of orders for the authenticated customer, optionally filtered by status and creation time",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
-                    "image/*"
+                    "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "orders"
                 ],
-                "summary": "Get product image",
+                "summary": "Get customer orders",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Product ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest_first",
+                            "oldest_first"
+                        ],
+                        "type": "string",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Product image",
+                        "description": "Page of orders",
                         "schema": {
-                            "type": "file"
+                            "$ref": "#/definitions/order_response.OrdersResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request format or invalid product ID",
+                        "description": "Invalid cursor or creation time range",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "401": {
-                        "description": "Missing or invalid access token",
+                        "description": "Missing or invalid bearer token",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
-                    "404": {
-                        "description": "Product or image not found",
+                    "422": {
+                        "description": "Invalid query parameters",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -1037,58 +1138,58 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
-                        "AdminAccessToken": []
+                        "CustomerBearerAuth": []
                     }
                 ],
-                "description": "Update the image for a specific product (admin only)",
+                "description": "Create a new order with items. Each item price must equal the current catalog price.\nAn optional promo code discounts the order total. An optional delivery slot from\nGET /delivery-slots schedules the delivery; without one the order is delivered as soon as possible.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "orders"
                 ],
-                "summary": "Update product image",
+                "summary": "Create a new order",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Product ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Product image file",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "description": "Order details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order_request.CreateRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content\" \"Image updated successfully"
+                    "201": {
+                        "description": " \"Created with location header"
                     },
                     "400": {
-                        "description": "Invalid request format or invalid product ID",
+                        "description": "Invalid request format, malformed address, unknown product, stale item price, promo code not applicable, or delivery slot not offered or fully booked",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "401": {
-                        "description": "Missing or invalid access token",
+                        "description": "Missing or invalid bearer token",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
                     },
                     "404": {
-                        "description": "Product not found",
+                        "description": "Promo code not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid item or address data",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
@@ -1098,256 +1199,2045 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponseDetail"
                         }
+                    },
+                    "503": {
+                        "description": "Product catalog unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/search": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "Search all orders by customer, courier, product, status and creation time, one page at a time.\nFilters combine; orders must match every one given (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Search orders",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "courier_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "customer_id",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "product_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest_first",
+                            "oldest_first"
+                        ],
+                        "type": "string",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of orders",
+                        "schema": {
+                            "$ref": "#/definitions/order_response.OrdersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid cursor or creation time range",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "CustomerBearerAuth": []
+                    },
+                    {
+                        "CourierBearerAuth": []
+                    },
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "Get an order with the current catalog name and image URL of each product and the name of the\ncourier delivering it. Open to the customer who placed the order, the courier assigned to it\nand admins. Admins pass X-Access-Token; customers and couriers pass their bearer token.\nProduct and courier details are left out while the catalog or the courier service is unavailable.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Get order details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Order details",
+                        "schema": {
+                            "$ref": "#/definitions/order_response.OrderDetailsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing authorization or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Order belongs to another customer or courier",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid order ID format",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "503": {
+                        "description": "Order service unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/cancel": {
+            "patch": {
+                "security": [
+                    {
+                        "CustomerBearerAuth": []
+                    }
+                ],
+                "description": "Cancel an order by its ID. Created and delivering orders can be canceled within\na configured window; the order is canceled once its items and courier are released.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Cancel an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cancellation reason",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/order_request.CancelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": " \"No Content"
+                    },
+                    "400": {
+                        "description": "Invalid request or cancellation no longer allowed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Order belongs to another customer",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid order ID format or reason too long",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/complete": {
+            "patch": {
+                "security": [
+                    {
+                        "CourierBearerAuth": []
+                    }
+                ],
+                "description": "Mark an order as delivered (completed)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Complete order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": " \"OK"
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Order is assigned to another courier",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid order ID format",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/courier": {
+            "patch": {
+                "security": [
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "Hand a delivering order over to another courier. The admin is recorded in the order history\nwith the given reason (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Reassign the courier of an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New courier and reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order_request.ReassignCourierRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": " \"No Content"
+                    },
+                    "400": {
+                        "description": "Order is not being delivered or already has this courier",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Order or courier not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid order ID format or request data",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "503": {
+                        "description": "Courier service unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/force-cancel": {
+            "patch": {
+                "security": [
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "Cancel a created or delivering order regardless of the cancellation window. The items and the\ncourier are released and the order ends canceled_by_admin. The admin is recorded in the order\nhistory with the given reason (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Force-cancel an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cancellation reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order_request.ForceCancelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": " \"No Content"
+                    },
+                    "400": {
+                        "description": "Order can no longer be canceled",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid order ID format or missing reason",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "CustomerBearerAuth": []
+                    },
+                    {
+                        "CourierBearerAuth": []
+                    },
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "Get every status change of an order, oldest first, with who made it and why.\nOpen to the customer who placed the order, the courier assigned to it and admins.\nAdmins pass X-Access-Token; customers and couriers pass their bearer token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Get order status history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status history",
+                        "schema": {
+                            "$ref": "#/definitions/order_response.HistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing authorization or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "403": {
+                        "description": "Order belongs to another customer or courier",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid order ID format",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/saga": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "Get the current step, step history and last error of the sagas driving an order (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Get order saga state",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Order sagas",
+                        "schema": {
+                            "$ref": "#/definitions/order_response.SagasResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid order ID format",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/saga/retry": {
+            "patch": {
+                "security": [
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "Send the commands of the step an order saga is waiting in once more. The commands keep their\nmessage IDs, so a service that already handled them replies again without acting twice.\nOnly steps waiting for a reply can be retried (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Retry a stalled saga step",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Saga to retry",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order_request.RetrySagaStepRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": " \"No Content"
+                    },
+                    "400": {
+                        "description": "Saga step is not waiting for a reply",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Saga not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid order ID format or saga type",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/products": {
+            "post": {
+                "security": [
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "Create a new product in the warehouse (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Create a new product",
+                "parameters": [
+                    {
+                        "description": "Product details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/warehouse_request.CreateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Product created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/warehouse_response.CreateProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "409": {
+                        "description": "Product already exists",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid product data",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/image": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "Get the image for a specific product (admin only)",
+                "produces": [
+                    "image/*"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get product image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product image",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format or invalid product ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Product or image not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "Update the image for a specific product (admin only)",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Update product image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Product image file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content\" \"Image updated successfully"
+                    },
+                    "400": {
+                        "description": "Invalid request format or invalid product ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/promotions": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "List promo codes, newest first (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promotions"
+                ],
+                "summary": "List promo codes",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only list active promo codes",
+                        "name": "active_only",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Promo codes",
+                        "schema": {
+                            "$ref": "#/definitions/order_response.PromotionsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "Create a percentage or fixed-amount promo code with optional minimum order value,\nper-customer usage limit, validity window and product scope (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promotions"
+                ],
+                "summary": "Create a promo code",
+                "parameters": [
+                    {
+                        "description": "Promo code and rules",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order_request.CreatePromotionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": " \"Created with location header"
+                    },
+                    "400": {
+                        "description": "Invalid code or rules",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "409": {
+                        "description": "Promo code already exists",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/promotions/{id}/deactivate": {
+            "patch": {
+                "security": [
+                    {
+                        "AdminAccessToken": []
+                    }
+                ],
+                "description": "Deactivate a promo code so that new orders can no longer use it (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promotions"
+                ],
+                "summary": "Deactivate a promo code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Promotion ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": " \"No Content"
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Promotion not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid promotion ID format",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseDetail"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "courier_request.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "phone"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "courier_request.RegisterRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "password",
+                "phone"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "courier_response.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "courier_response.RegisterResponse": {
+            "type": "object",
+            "properties": {
+                "courier_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "customer_request.CompletePasswordReset": {
+            "type": "object",
+            "required": [
+                "new_password"
+            ],
+            "properties": {
+                "new_password": {
+                    "type": "string"
+                }
+            }
+        },
+        "customer_request.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "phone"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "customer_request.RegisterRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "password",
+                "phone"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "customer_request.RequestPasswordResetRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "customer_request.VerifyOtpRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "customer_response.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "challenge_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "customer_response.RegisterResponse": {
+            "type": "object",
+            "properties": {
+                "customer_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "customer_response.VerifyOtpResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "order_request.AddressSchema": {
+            "type": "object",
+            "required": [
+                "city",
+                "country",
+                "house",
+                "postal_code",
+                "street"
+            ],
+            "properties": {
+                "apartment": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "city": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "country": {
+                    "type": "string"
+                },
+                "house": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "instructions": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "location": {
+                    "$ref": "#/definitions/order_request.LocationSchema"
+                },
+                "postal_code": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "minLength": 3
+                },
+                "street": {
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "order_request.CancelRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "order_request.CreatePromotionRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "rules"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 3
+                },
+                "rules": {
+                    "$ref": "#/definitions/order_request.PromotionRulesSchema"
+                }
+            }
+        },
+        "order_request.CreateRequest": {
+            "type": "object",
+            "required": [
+                "address",
+                "items"
+            ],
+            "properties": {
+                "address": {
+                    "$ref": "#/definitions/order_request.AddressSchema"
+                },
+                "delivery_slot": {
+                    "description": "Optional; one of the slots listed by GET /delivery-slots.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/order_request.DeliverySlotSchema"
+                        }
+                    ]
+                },
+                "fulfillment_policy": {
+                    "description": "Optional; allow_partial delivers what is in stock instead of canceling\nthe order when some items are short.",
+                    "type": "string",
+                    "enum": [
+                        "all_or_nothing",
+                        "allow_partial"
+                    ]
+                },
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/order_request.ItemSchema"
+                    }
+                },
+                "promo_code": {
+                    "type": "string",
+                    "maxLength": 32
+                }
+            }
+        },
+        "order_request.DeliverySlotSchema": {
+            "type": "object",
+            "required": [
+                "end",
+                "start"
+            ],
+            "properties": {
+                "end": {
+                    "type": "string"
+                },
+                "start": {
+                    "type": "string"
+                }
+            }
+        },
+        "order_request.ForceCancelRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "order_request.ItemSchema": {
+            "type": "object",
+            "required": [
+                "count",
+                "price",
+                "product_id"
+            ],
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "price": {
+                    "$ref": "#/definitions/request.MoneySchema"
+                },
+                "product_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "order_request.LocationSchema": {
+            "type": "object",
+            "properties": {
+                "latitude": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                }
+            }
+        },
+        "order_request.PromotionRulesSchema": {
+            "type": "object",
+            "required": [
+                "kind"
+            ],
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/request.MoneySchema"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "percentage",
+                        "fixed_amount"
+                    ]
+                },
+                "min_order_value": {
+                    "$ref": "#/definitions/request.MoneySchema"
+                },
+                "per_customer_limit": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "percent": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "product_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "valid_from": {
+                    "type": "string"
+                },
+                "valid_to": {
+                    "type": "string"
+                }
+            }
+        },
+        "order_request.ReassignCourierRequest": {
+            "type": "object",
+            "required": [
+                "courier_id"
+            ],
+            "properties": {
+                "courier_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "order_request.RetrySagaStepRequest": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "create_order",
+                        "cancel_order"
+                    ]
+                }
+            }
+        },
+        "order_response.ActorSchema": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "order_response.AddressSchema": {
+            "type": "object",
+            "properties": {
+                "apartment": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "house": {
+                    "type": "string"
+                },
+                "instructions": {
+                    "type": "string"
+                },
+                "legacy": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/order_response.LocationSchema"
+                },
+                "postal_code": {
+                    "type": "string"
+                },
+                "street": {
+                    "type": "string"
+                }
+            }
+        },
+        "order_response.CancellationReasonsResponse": {
+            "type": "object",
+            "properties": {
+                "reasons": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "order_response.CourierHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "counts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order_response.OrderResponse"
+                    }
+                }
+            }
+        },
+        "order_response.CourierSchema": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "order_response.DeliverySchema": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "$ref": "#/definitions/order_response.AddressSchema"
+                },
+                "arrived": {
+                    "type": "string"
+                },
+                "assigned": {
+                    "type": "string"
+                },
+                "courier_id": {
+                    "type": "string"
+                },
+                "slot": {
+                    "$ref": "#/definitions/order_response.DeliverySlotSchema"
+                }
+            }
+        },
+        "order_response.DeliverySlotSchema": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "string"
+                },
+                "start": {
+                    "type": "string"
+                }
+            }
+        },
+        "order_response.DeliveryTimeResponse": {
+            "type": "object",
+            "properties": {
+                "average_seconds": {
+                    "type": "number"
+                },
+                "orders": {
+                    "type": "integer"
+                }
+            }
+        },
+        "order_response.DiscountSchema": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/response.MoneySchema"
+                },
+                "promo_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "order_response.HistoryResponse": {
+            "type": "object",
+            "properties": {
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order_response.StatusChangeSchema"
+                    }
+                }
+            }
+        },
+        "order_response.ItemDetailsSchema": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "line_total": {
+                    "$ref": "#/definitions/response.MoneySchema"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "$ref": "#/definitions/response.MoneySchema"
+                },
+                "product": {
+                    "$ref": "#/definitions/order_response.ProductSchema"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "requested_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "order_response.ItemSchema": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "line_total": {
+                    "$ref": "#/definitions/response.MoneySchema"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "$ref": "#/definitions/response.MoneySchema"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "requested_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "order_response.LocationSchema": {
+            "type": "object",
+            "properties": {
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                }
+            }
+        },
+        "order_response.OrderDetailsResponse": {
+            "type": "object",
+            "properties": {
+                "cancel_reason": {
+                    "type": "string"
+                },
+                "courier": {
+                    "description": "Set once a courier is assigned.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/order_response.CourierSchema"
+                        }
+                    ]
+                },
+                "created": {
+                    "type": "string"
+                },
+                "customer_id": {
+                    "type": "string"
+                },
+                "delivery": {
+                    "$ref": "#/definitions/order_response.DeliverySchema"
+                },
+                "discount": {
+                    "$ref": "#/definitions/order_response.DiscountSchema"
+                },
+                "fulfillment_policy": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order_response.ItemDetailsSchema"
                     }
-                }
-            }
-        }
-    },
-    "definitions": {
-        "courier_request.LoginRequest": {
-            "type": "object",
-            "required": [
-                "password",
-                "phone"
-            ],
-            "properties": {
-                "password": {
+                },
+                "status": {
                     "type": "string"
                 },
-                "phone": {
+                "subtotal": {
+                    "$ref": "#/definitions/response.MoneySchema"
+                },
+                "total": {
+                    "$ref": "#/definitions/response.MoneySchema"
+                },
+                "version": {
                     "type": "string"
                 }
             }
         },
-        "courier_request.RegisterRequest": {
+        "order_response.OrderResponse": {
             "type": "object",
-            "required": [
-                "name",
-                "password",
-                "phone"
-            ],
             "properties": {
-                "name": {
+                "cancel_reason": {
                     "type": "string"
                 },
-                "password": {
+                "created": {
                     "type": "string"
                 },
-                "phone": {
+                "customer_id": {
                     "type": "string"
-                }
-            }
-        },
-        "courier_response.LoginResponse": {
-            "type": "object",
-            "properties": {
-                "token": {
+                },
+                "delivery": {
+                    "$ref": "#/definitions/order_response.DeliverySchema"
+                },
+                "discount": {
+                    "$ref": "#/definitions/order_response.DiscountSchema"
+                },
+                "fulfillment_policy": {
                     "type": "string"
-                }
-            }
-        },
-        "courier_response.RegisterResponse": {
-            "type": "object",
-            "properties": {
-                "courier_id": {
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order_response.ItemSchema"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                },
+                "subtotal": {
+                    "$ref": "#/definitions/response.MoneySchema"
+                },
+                "total": {
+                    "$ref": "#/definitions/response.MoneySchema"
+                },
+                "version": {
                     "type": "string"
                 }
             }
         },
-        "customer_request.CompletePasswordReset": {
+        "order_response.OrdersResponse": {
             "type": "object",
-            "required": [
-                "new_password"
-            ],
             "properties": {
-                "new_password": {
+                "next_cursor": {
                     "type": "string"
+                },
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order_response.OrderResponse"
+                    }
                 }
             }
         },
-        "customer_request.LoginRequest": {
+        "order_response.ProductSchema": {
             "type": "object",
-            "required": [
-                "password",
-                "phone"
-            ],
             "properties": {
-                "password": {
+                "image_url": {
                     "type": "string"
                 },
-                "phone": {
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "customer_request.RegisterRequest": {
+        "order_response.PromotionResponse": {
             "type": "object",
-            "required": [
-                "name",
-                "password",
-                "phone"
-            ],
             "properties": {
-                "name": {
+                "active": {
+                    "type": "boolean"
+                },
+                "code": {
                     "type": "string"
                 },
-                "password": {
+                "created": {
                     "type": "string"
                 },
-                "phone": {
+                "id": {
                     "type": "string"
+                },
+                "rules": {
+                    "$ref": "#/definitions/order_response.PromotionRulesSchema"
                 }
             }
         },
-        "customer_request.RequestPasswordResetRequest": {
+        "order_response.PromotionRulesSchema": {
             "type": "object",
-            "required": [
-                "email"
-            ],
             "properties": {
-                "email": {
+                "amount": {
+                    "$ref": "#/definitions/response.MoneySchema"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "min_order_value": {
+                    "$ref": "#/definitions/response.MoneySchema"
+                },
+                "per_customer_limit": {
+                    "type": "integer"
+                },
+                "percent": {
+                    "type": "integer"
+                },
+                "product_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "valid_from": {
+                    "type": "string"
+                },
+                "valid_to": {
                     "type": "string"
                 }
             }
         },
-        "customer_request.VerifyOtpRequest": {
+        "order_response.PromotionsResponse": {
             "type": "object",
-            "required": [
-                "code"
-            ],
             "properties": {
-                "code": {
-                    "type": "string"
+                "promotions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order_response.PromotionResponse"
+                    }
                 }
             }
         },
-        "customer_response.LoginResponse": {
+        "order_response.RevenueBucketSchema": {
             "type": "object",
             "properties": {
-                "challenge_id": {
+                "orders": {
+                    "type": "integer"
+                },
+                "revenue": {
+                    "$ref": "#/definitions/response.MoneySchema"
+                },
+                "start": {
+                    "description": "Start of the day or week in UTC; weeks start on Monday.",
                     "type": "string"
                 }
             }
         },
-        "customer_response.RegisterResponse": {
+        "order_response.RevenueResponse": {
             "type": "object",
             "properties": {
-                "customer_id": {
-                    "type": "string"
+                "buckets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order_response.RevenueBucketSchema"
+                    }
                 }
             }
         },
-        "customer_response.VerifyOtpResponse": {
+        "order_response.SagaFailureSchema": {
             "type": "object",
             "properties": {
-                "token": {
+                "message": {
+                    "type": "string"
+                },
+                "occurred": {
+                    "type": "string"
+                },
+                "step": {
                     "type": "string"
                 }
             }
         },
-        "order_request.CreateRequest": {
+        "order_response.SagaResponse": {
             "type": "object",
-            "required": [
-                "address",
-                "items"
-            ],
             "properties": {
-                "address": {
+                "created": {
                     "type": "string"
                 },
-                "items": {
+                "history": {
                     "type": "array",
-                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/order_request.ItemSchema"
+                        "$ref": "#/definitions/order_response.SagaStepSchema"
                     }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "$ref": "#/definitions/order_response.SagaFailureSchema"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "resume_at": {
+                    "type": "string"
+                },
+                "step": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated": {
+                    "type": "string"
                 }
             }
         },
-        "order_request.ItemSchema": {
+        "order_response.SagaStepSchema": {
             "type": "object",
-            "required": [
-                "count",
-                "price",
-                "product_id"
-            ],
             "properties": {
-                "count": {
-                    "type": "integer"
+                "entered": {
+                    "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "retry": {
+                    "description": "Set when an admin retried the step the saga was already in.",
+                    "type": "boolean"
                 },
-                "product_id": {
+                "step": {
                     "type": "string"
                 }
             }
         },
-        "order_response.DeliverySchema": {
+        "order_response.SagasResponse": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "arrived": {
-                    "type": "string"
-                },
-                "courier_id": {
-                    "type": "string"
+                "sagas": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order_response.SagaResponse"
+                    }
                 }
             }
         },
-        "order_response.ItemSchema": {
+        "order_response.SlotAvailabilitySchema": {
             "type": "object",
             "properties": {
-                "count": {
+                "capacity": {
                     "type": "integer"
                 },
-                "price": {
-                    "type": "number"
+                "remaining": {
+                    "type": "integer"
                 },
-                "product_id": {
-                    "type": "string"
+                "slot": {
+                    "$ref": "#/definitions/order_response.DeliverySlotSchema"
                 }
             }
         },
-        "order_response.OrderResponse": {
+        "order_response.SlotsResponse": {
             "type": "object",
             "properties": {
-                "created": {
-                    "type": "string"
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order_response.SlotAvailabilitySchema"
+                    }
+                }
+            }
+        },
+        "order_response.StatusChangeSchema": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "$ref": "#/definitions/order_response.ActorSchema"
                 },
-                "customer_id": {
+                "from": {
                     "type": "string"
                 },
-                "delivery": {
-                    "$ref": "#/definitions/order_response.DeliverySchema"
-                },
-                "id": {
+                "message_id": {
                     "type": "string"
                 },
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order_response.ItemSchema"
-                    }
+                "occurred": {
+                    "type": "string"
                 },
-                "status": {
+                "reason": {
                     "type": "string"
                 },
-                "version": {
+                "to": {
                     "type": "string"
                 }
             }
         },
-        "order_response.OrdersResponse": {
+        "order_response.StatusFunnelResponse": {
             "type": "object",
             "properties": {
-                "orders": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order_response.OrderResponse"
+                "current": {
+                    "description": "Orders per status they are in now.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
                     }
+                },
+                "reached": {
+                    "description": "Orders per status they have been in; every order counts as created.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "request.MoneySchema": {
+            "type": "object",
+            "required": [
+                "amount",
+                "currency"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
                 }
             }
         },
@@ -1359,6 +3249,17 @@ const docTemplate = `{
                 }
             }
         },
+        "response.MoneySchema": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                }
+            }
+        },
         "warehouse_request.CreateProductRequest": {
             "type": "object",
             "required": [
@@ -1370,7 +3271,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "price": {
-                    "type": "number"
+                    "$ref": "#/definitions/request.MoneySchema"
                 }
             }
         },
@@ -1465,7 +3366,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "price": {
-                    "type": "number"
+                    "$ref": "#/definitions/response.MoneySchema"
                 },
                 "product_id": {
                     "type": "string"
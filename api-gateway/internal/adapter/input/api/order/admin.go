@@ -0,0 +1,193 @@
+package order
+
+import (
+	request "api-gateway/internal/adapter/input/api/order/request"
+	response "api-gateway/internal/adapter/input/api/order/response"
+	commonRequest "api-gateway/internal/adapter/input/api/request"
+	commonResponse "api-gateway/internal/adapter/input/api/response"
+	orderDto "api-gateway/internal/domain/dtos/order"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// SearchOrders godoc
+// @Summary Search orders
+// @Description Search all orders by customer, courier, product, status and creation time, one page at a time.
+// @Description Filters combine; orders must match every one given (admin only)
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param request query order_request.SearchOrdersRequest false "Filters, pagination and sort"
+// @Success 200 {object} order_response.OrdersResponse "Page of orders"
+// @Failure 400 {object} response.ErrorResponseDetail "Invalid cursor or creation time range"
+// @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid access token"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid query parameters"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Security AdminAccessToken
+// @Router /orders/search [get]
+func (h *Handler) SearchOrders(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req request.SearchOrdersRequest
+	if err := commonRequest.ParseInput(c, &req, binding.Query); err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	token, err := commonRequest.ParseAccessToken(c)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	page, err := h.uc.Search(ctx, request.ToSearchFilterDto(&req), request.ToListQueryDto(&req.ListOrdersRequest), token)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ToOrdersResponse(page))
+}
+
+// ForceCancelOrder godoc
+// @Summary Force-cancel an order
+// @Description Cancel a created or delivering order regardless of the cancellation window. The items and the
+// @Description courier are released and the order ends canceled_by_admin. The admin is recorded in the order
+// @Description history with the given reason (admin only)
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param request body order_request.ForceCancelRequest true "Cancellation reason"
+// @Success 204 "" "No Content"
+// @Failure 400 {object} response.ErrorResponseDetail "Order can no longer be canceled"
+// @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid access token"
+// @Failure 404 {object} response.ErrorResponseDetail "Order not found"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid order ID format or missing reason"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Security AdminAccessToken
+// @Router /orders/{id}/force-cancel [patch]
+func (h *Handler) ForceCancelOrder(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	orderID, err := commonRequest.ParseParamUUID(c, "id")
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	var req request.ForceCancelRequest
+	if err = commonRequest.ParseInput(c, &req, binding.JSON); err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	token, err := commonRequest.ParseAccessToken(c)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	if err = h.uc.ForceCancel(ctx, orderID, req.Reason, token); err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RetrySagaStep godoc
+// @Summary Retry a stalled saga step
+// @Description Send the commands of the step an order saga is waiting in once more. The commands keep their
+// @Description message IDs, so a service that already handled them replies again without acting twice.
+// @Description Only steps waiting for a reply can be retried (admin only)
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param request body order_request.RetrySagaStepRequest true "Saga to retry"
+// @Success 204 "" "No Content"
+// @Failure 400 {object} response.ErrorResponseDetail "Saga step is not waiting for a reply"
+// @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid access token"
+// @Failure 404 {object} response.ErrorResponseDetail "Saga not found"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid order ID format or saga type"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Security AdminAccessToken
+// @Router /orders/{id}/saga/retry [patch]
+func (h *Handler) RetrySagaStep(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	orderID, err := commonRequest.ParseParamUUID(c, "id")
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	var req request.RetrySagaStepRequest
+	if err = commonRequest.ParseInput(c, &req, binding.JSON); err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	token, err := commonRequest.ParseAccessToken(c)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	if err = h.uc.RetrySagaStep(ctx, orderID, orderDto.SagaType(req.Type), token); err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ReassignCourier godoc
+// @Summary Reassign the courier of an order
+// @Description Hand a delivering order over to another courier. The admin is recorded in the order history
+// @Description with the given reason (admin only)
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param request body order_request.ReassignCourierRequest true "New courier and reason"
+// @Success 204 "" "No Content"
+// @Failure 400 {object} response.ErrorResponseDetail "Order is not being delivered or already has this courier"
+// @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid access token"
+// @Failure 404 {object} response.ErrorResponseDetail "Order or courier not found"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid order ID format or request data"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Failure 503 {object} response.ErrorResponseDetail "Courier service unavailable"
+// @Security AdminAccessToken
+// @Router /orders/{id}/courier [patch]
+func (h *Handler) ReassignCourier(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	orderID, err := commonRequest.ParseParamUUID(c, "id")
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	var req request.ReassignCourierRequest
+	if err = commonRequest.ParseInput(c, &req, binding.JSON); err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	token, err := commonRequest.ParseAccessToken(c)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	if err = h.uc.ReassignCourier(ctx, orderID, req.CourierID, req.Reason, token); err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,168 @@
+package order
+
+import (
+	request "api-gateway/internal/adapter/input/api/order/request"
+	response "api-gateway/internal/adapter/input/api/order/response"
+	commonRequest "api-gateway/internal/adapter/input/api/request"
+	commonResponse "api-gateway/internal/adapter/input/api/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// GetStatusFunnel godoc
+// @Summary Order status funnel
+// @Description Count the orders created in the period by the status they are in now and by every status they
+// @Description have been in, e.g. how many of the placed orders went out for delivery (admin only)
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param request query order_request.AnalyticsPeriodRequest false "Creation time range"
+// @Success 200 {object} order_response.StatusFunnelResponse "Order counts per status"
+// @Failure 400 {object} response.ErrorResponseDetail "Invalid creation time range"
+// @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid access token"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid query parameters"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Security AdminAccessToken
+// @Router /analytics/orders/funnel [get]
+func (h *Handler) GetStatusFunnel(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req request.AnalyticsPeriodRequest
+	if err := commonRequest.ParseInput(c, &req, binding.Query); err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	token, err := commonRequest.ParseAccessToken(c)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	funnel, err := h.uc.GetStatusFunnel(ctx, request.ToPeriodDto(&req), token)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ToStatusFunnelResponse(funnel))
+}
+
+// GetRevenue godoc
+// @Summary Order revenue
+// @Description Sum the totals of the delivered orders created in the period per day or week of creation, one
+// @Description bucket per currency. Buckets are aligned in UTC and weeks start on Monday (admin only)
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param request query order_request.GetRevenueRequest false "Creation time range and bucket length"
+// @Success 200 {object} order_response.RevenueResponse "Revenue buckets, earliest first"
+// @Failure 400 {object} response.ErrorResponseDetail "Invalid creation time range"
+// @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid access token"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid query parameters"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Security AdminAccessToken
+// @Router /analytics/orders/revenue [get]
+func (h *Handler) GetRevenue(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req request.GetRevenueRequest
+	if err := commonRequest.ParseInput(c, &req, binding.Query); err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	token, err := commonRequest.ParseAccessToken(c)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	buckets, err := h.uc.GetRevenue(ctx, request.ToPeriodDto(&req.AnalyticsPeriodRequest), request.ToGranularity(&req), token)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ToRevenueResponse(buckets))
+}
+
+// GetDeliveryTime godoc
+// @Summary Average delivery time
+// @Description Average the time from placing an order to its arrival over the delivered orders created in the
+// @Description period (admin only)
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param request query order_request.AnalyticsPeriodRequest false "Creation time range"
+// @Success 200 {object} order_response.DeliveryTimeResponse "Average delivery time"
+// @Failure 400 {object} response.ErrorResponseDetail "Invalid creation time range"
+// @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid access token"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid query parameters"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Security AdminAccessToken
+// @Router /analytics/orders/delivery-time [get]
+func (h *Handler) GetDeliveryTime(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req request.AnalyticsPeriodRequest
+	if err := commonRequest.ParseInput(c, &req, binding.Query); err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	token, err := commonRequest.ParseAccessToken(c)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	deliveryTime, err := h.uc.GetDeliveryTime(ctx, request.ToPeriodDto(&req), token)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ToDeliveryTimeResponse(deliveryTime))
+}
+
+// GetCancellationReasons godoc
+// @Summary Cancellation reasons
+// @Description Count the canceled orders created in the period per reason: customer, out_of_stock,
+// @Description courier_not_found, timeout or admin (admin only)
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param request query order_request.AnalyticsPeriodRequest false "Creation time range"
+// @Success 200 {object} order_response.CancellationReasonsResponse "Canceled orders per reason"
+// @Failure 400 {object} response.ErrorResponseDetail "Invalid creation time range"
+// @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid access token"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid query parameters"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Security AdminAccessToken
+// @Router /analytics/orders/cancellations [get]
+func (h *Handler) GetCancellationReasons(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req request.AnalyticsPeriodRequest
+	if err := commonRequest.ParseInput(c, &req, binding.Query); err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	token, err := commonRequest.ParseAccessToken(c)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	reasons, err := h.uc.GetCancellationReasons(ctx, request.ToPeriodDto(&req), token)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ToCancellationReasonsResponse(reasons))
+}
@@ -7,6 +7,7 @@ import (
 	commonResponse "api-gateway/internal/adapter/input/api/response"
 	orderUseCase "api-gateway/internal/domain/usecases/order"
 	"net/http"
+	"path"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
@@ -22,16 +23,20 @@ func NewHandler(orderUseCase orderUseCase.UseCase) *Handler {
 
 // Create godoc
 // @Summary Create a new order
-// @Description Create a new order with items
+// @Description Create a new order with items. Each item price must equal the current catalog price.
+// @Description An optional promo code discounts the order total. An optional delivery slot from
+// @Description GET /delivery-slots schedules the delivery; without one the order is delivered as soon as possible.
 // @Tags orders
 // @Accept json
 // @Produce json
 // @Param request body order_request.CreateRequest true "Order details"
 // @Success 201 "" "Created with location header"
-// @Failure 400 {object} response.ErrorResponseDetail "Invalid request format"
+// @Failure 400 {object} response.ErrorResponseDetail "Invalid request format, malformed address, unknown product, stale item price, promo code not applicable, or delivery slot not offered or fully booked"
 // @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid bearer token"
-// @Failure 422 {object} response.ErrorResponseDetail "Invalid item data"
+// @Failure 404 {object} response.ErrorResponseDetail "Promo code not found"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid item or address data"
 // @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Failure 503 {object} response.ErrorResponseDetail "Product catalog unavailable"
 // @Security CustomerBearerAuth
 // @Router /orders [post]
 func (h *Handler) Create(c *gin.Context) {
@@ -62,16 +67,19 @@ func (h *Handler) Create(c *gin.Context) {
 
 // CancelOrder godoc
 // @Summary Cancel an order
-// @Description Cancel an order by its ID
+// @Description Cancel an order by its ID. Created and delivering orders can be canceled within
+// @Description a configured window; the order is canceled once its items and courier are released.
 // @Tags orders
 // @Accept json
 // @Produce json
 // @Param id path string true "Order ID"
+// @Param request body order_request.CancelRequest false "Cancellation reason"
 // @Success 204 "" "No Content"
-// @Failure 400 {object} response.ErrorResponseDetail "Invalid request"
+// @Failure 400 {object} response.ErrorResponseDetail "Invalid request or cancellation no longer allowed"
 // @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid bearer token"
+// @Failure 403 {object} response.ErrorResponseDetail "Order belongs to another customer"
 // @Failure 404 {object} response.ErrorResponseDetail "Order not found"
-// @Failure 422 {object} response.ErrorResponseDetail "Invalid order ID format"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid order ID format or reason too long"
 // @Failure 500 {object} response.ErrorResponseDetail "Server error"
 // @Security CustomerBearerAuth
 // @Router /orders/{id}/cancel [patch]
@@ -90,7 +98,16 @@ func (h *Handler) CancelOrder(c *gin.Context) {
 		return
 	}
 
-	err = h.uc.CancelByCustomer(ctx, orderID, token)
+	// The body is optional: a cancellation without a reason has none.
+	var req request.CancelRequest
+	if c.Request.ContentLength != 0 {
+		if err = commonRequest.ParseInput(c, &req, binding.JSON); err != nil {
+			commonResponse.HandleError(c, err)
+			return
+		}
+	}
+
+	err = h.uc.CancelByCustomer(ctx, orderID, req.Reason, token)
 	if err != nil {
 		commonResponse.HandleError(c, err)
 		return
@@ -109,6 +126,7 @@ func (h *Handler) CancelOrder(c *gin.Context) {
 // @Success 200 "" "OK"
 // @Failure 400 {object} response.ErrorResponseDetail "Invalid request"
 // @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid bearer token"
+// @Failure 403 {object} response.ErrorResponseDetail "Order is assigned to another courier"
 // @Failure 404 {object} response.ErrorResponseDetail "Order not found"
 // @Failure 422 {object} response.ErrorResponseDetail "Invalid order ID format"
 // @Failure 500 {object} response.ErrorResponseDetail "Server error"
@@ -140,13 +158,15 @@ func (h *Handler) CompleteDelivery(c *gin.Context) {
 
 // GetCustomerOrders godoc
 // @Summary Get customer orders
-// @Description Get all orders for the authenticated customer
+// @Description Get a page of orders for the authenticated customer, optionally filtered by status and creation time
 // @Tags orders
 // @Accept json
 // @Produce json
-// @Param request query order_request.GetAllCustomerOrdersRequest true "Pagination"
-// @Success 200 {object} order_response.OrdersResponse "List of orders"
+// @Param request query order_request.GetAllCustomerOrdersRequest false "Pagination, filters and sort"
+// @Success 200 {object} order_response.OrdersResponse "Page of orders"
+// @Failure 400 {object} response.ErrorResponseDetail "Invalid cursor or creation time range"
 // @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid bearer token"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid query parameters"
 // @Failure 500 {object} response.ErrorResponseDetail "Server error"
 // @Security CustomerBearerAuth
 // @Router /orders [get]
@@ -165,22 +185,21 @@ func (h *Handler) GetCustomerOrders(c *gin.Context) {
 		return
 	}
 
-	orders, err := h.uc.GetByCustomer(ctx, req.Limit, req.Offset, token)
+	page, err := h.uc.GetByCustomer(ctx, request.ToListQueryDto(&req.ListOrdersRequest), token)
 	if err != nil {
 		commonResponse.HandleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, response.ToOrdersResponse(orders))
+	c.JSON(http.StatusOK, response.ToOrdersResponse(page))
 }
 
 // GetCourierOrders godoc
 // @Summary Get courier orders
-// @Description Get all current orders for the authenticated courier
+// @Description Get the orders the authenticated courier is delivering right now, oldest assignment first
 // @Tags couriers
 // @Accept json
 // @Produce json
-// @Param request query order_request.GetAllCourierOrdersRequest true "Pagination"
 // @Success 200 {object} order_response.OrdersResponse "List of orders"
 // @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid bearer token"
 // @Failure 500 {object} response.ErrorResponseDetail "Server error"
@@ -189,7 +208,39 @@ func (h *Handler) GetCustomerOrders(c *gin.Context) {
 func (h *Handler) GetCourierOrders(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	var req request.GetAllCourierOrdersRequest
+	token, err := commonRequest.ParseBearerToken(c)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	orders, err := h.uc.GetCurrentByCourier(ctx, token)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ToCurrentOrdersResponse(orders))
+}
+
+// GetCourierHistory godoc
+// @Summary Get courier delivery history
+// @Description Get a page of every order assigned to the authenticated courier with totals per status, optionally filtered by status and creation time
+// @Tags couriers
+// @Accept json
+// @Produce json
+// @Param request query order_request.GetCourierHistoryRequest false "Pagination, filters and sort"
+// @Success 200 {object} order_response.CourierHistoryResponse "Page of orders with counts per status"
+// @Failure 400 {object} response.ErrorResponseDetail "Invalid cursor or creation time range"
+// @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid bearer token"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid query parameters"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Security CourierBearerAuth
+// @Router /couriers/me/orders/history [get]
+func (h *Handler) GetCourierHistory(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req request.GetCourierHistoryRequest
 	if err := commonRequest.ParseInput(c, &req, binding.Query); err != nil {
 		commonResponse.HandleError(c, err)
 		return
@@ -201,11 +252,150 @@ func (h *Handler) GetCourierOrders(c *gin.Context) {
 		return
 	}
 
-	orders, err := h.uc.GetCurrentByCourier(ctx, req.Limit, req.Offset, token)
+	history, err := h.uc.GetCourierHistory(ctx, request.ToListQueryDto(&req.ListOrdersRequest), token)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ToCourierHistoryResponse(history))
+}
+
+// GetSagaState godoc
+// @Summary Get order saga state
+// @Description Get the current step, step history and last error of the sagas driving an order (admin only)
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} order_response.SagasResponse "Order sagas"
+// @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid access token"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid order ID format"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Security AdminAccessToken
+// @Router /orders/{id}/saga [get]
+func (h *Handler) GetSagaState(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	orderID, err := commonRequest.ParseParamUUID(c, "id")
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	token, err := commonRequest.ParseAccessToken(c)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	sagas, err := h.uc.GetSagaState(ctx, orderID, token)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ToSagasResponse(sagas))
+}
+
+// GetOrder godoc
+// @Summary Get order details
+// @Description Get an order with the current catalog name and image URL of each product and the name of the
+// @Description courier delivering it. Open to the customer who placed the order, the courier assigned to it
+// @Description and admins. Admins pass X-Access-Token; customers and couriers pass their bearer token.
+// @Description Product and courier details are left out while the catalog or the courier service is unavailable.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} order_response.OrderDetailsResponse "Order details"
+// @Failure 400 {object} response.ErrorResponseDetail "Invalid bearer token"
+// @Failure 401 {object} response.ErrorResponseDetail "Missing authorization or invalid access token"
+// @Failure 403 {object} response.ErrorResponseDetail "Order belongs to another customer or courier"
+// @Failure 404 {object} response.ErrorResponseDetail "Order not found"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid order ID format"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Failure 503 {object} response.ErrorResponseDetail "Order service unavailable"
+// @Security CustomerBearerAuth
+// @Security CourierBearerAuth
+// @Security AdminAccessToken
+// @Router /orders/{id} [get]
+func (h *Handler) GetOrder(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	orderID, err := commonRequest.ParseParamUUID(c, "id")
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	// An admin access token takes precedence over a bearer token.
+	var bearerToken string
+	adminToken, err := commonRequest.ParseAccessToken(c)
+	if err != nil {
+		bearerToken, err = commonRequest.ParseBearerToken(c)
+		if err != nil {
+			commonResponse.HandleError(c, err)
+			return
+		}
+	}
+
+	details, err := h.uc.GetDetails(ctx, orderID, bearerToken, adminToken)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	// The request path is <base path>/orders/{id}.
+	basePath := path.Dir(path.Dir(c.Request.URL.Path))
+	c.JSON(http.StatusOK, response.ToOrderDetailsResponse(details, basePath))
+}
+
+// GetHistory godoc
+// @Summary Get order status history
+// @Description Get every status change of an order, oldest first, with who made it and why.
+// @Description Open to the customer who placed the order, the courier assigned to it and admins.
+// @Description Admins pass X-Access-Token; customers and couriers pass their bearer token.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Success 200 {object} order_response.HistoryResponse "Status history"
+// @Failure 400 {object} response.ErrorResponseDetail "Invalid bearer token"
+// @Failure 401 {object} response.ErrorResponseDetail "Missing authorization or invalid access token"
+// @Failure 403 {object} response.ErrorResponseDetail "Order belongs to another customer or courier"
+// @Failure 404 {object} response.ErrorResponseDetail "Order not found"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid order ID format"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Security CustomerBearerAuth
+// @Security CourierBearerAuth
+// @Security AdminAccessToken
+// @Router /orders/{id}/history [get]
+func (h *Handler) GetHistory(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	orderID, err := commonRequest.ParseParamUUID(c, "id")
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	// An admin access token takes precedence over a bearer token.
+	var bearerToken string
+	adminToken, err := commonRequest.ParseAccessToken(c)
+	if err != nil {
+		bearerToken, err = commonRequest.ParseBearerToken(c)
+		if err != nil {
+			commonResponse.HandleError(c, err)
+			return
+		}
+	}
+
+	history, err := h.uc.GetHistory(ctx, orderID, bearerToken, adminToken)
 	if err != nil {
 		commonResponse.HandleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, response.ToOrdersResponse(orders))
+	c.JSON(http.StatusOK, response.ToHistoryResponse(history))
 }
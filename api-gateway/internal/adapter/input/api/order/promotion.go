@@ -0,0 +1,128 @@
+package order
+
+import (
+	request "api-gateway/internal/adapter/input/api/order/request"
+	response "api-gateway/internal/adapter/input/api/order/response"
+	commonRequest "api-gateway/internal/adapter/input/api/request"
+	commonResponse "api-gateway/internal/adapter/input/api/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// CreatePromotion godoc
+// @Summary Create a promo code
+// @Description Create a percentage or fixed-amount promo code with optional minimum order value,
+// @Description per-customer usage limit, validity window and product scope (admin only)
+// @Tags promotions
+// @Accept json
+// @Produce json
+// @Param request body order_request.CreatePromotionRequest true "Promo code and rules"
+// @Success 201 "" "Created with location header"
+// @Failure 400 {object} response.ErrorResponseDetail "Invalid code or rules"
+// @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid access token"
+// @Failure 409 {object} response.ErrorResponseDetail "Promo code already exists"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid request data"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Security AdminAccessToken
+// @Router /promotions [post]
+func (h *Handler) CreatePromotion(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req request.CreatePromotionRequest
+	if err := commonRequest.ParseInput(c, &req, binding.JSON); err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	token, err := commonRequest.ParseAccessToken(c)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	promotionID, err := h.uc.CreatePromotion(ctx, request.ToCreatePromotionDto(&req), token)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	commonResponse.AddLocationHeaderWithID(c, promotionID)
+	c.Status(http.StatusCreated)
+}
+
+// GetPromotions godoc
+// @Summary List promo codes
+// @Description List promo codes, newest first (admin only)
+// @Tags promotions
+// @Accept json
+// @Produce json
+// @Param active_only query bool false "Only list active promo codes"
+// @Success 200 {object} order_response.PromotionsResponse "Promo codes"
+// @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid access token"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid query parameters"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Security AdminAccessToken
+// @Router /promotions [get]
+func (h *Handler) GetPromotions(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req request.GetPromotionsRequest
+	if err := commonRequest.ParseInput(c, &req, binding.Query); err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	token, err := commonRequest.ParseAccessToken(c)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	promotions, err := h.uc.GetPromotions(ctx, req.ActiveOnly, token)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ToPromotionsResponse(promotions))
+}
+
+// DeactivatePromotion godoc
+// @Summary Deactivate a promo code
+// @Description Deactivate a promo code so that new orders can no longer use it (admin only)
+// @Tags promotions
+// @Accept json
+// @Produce json
+// @Param id path string true "Promotion ID"
+// @Success 204 "" "No Content"
+// @Failure 401 {object} response.ErrorResponseDetail "Missing or invalid access token"
+// @Failure 404 {object} response.ErrorResponseDetail "Promotion not found"
+// @Failure 422 {object} response.ErrorResponseDetail "Invalid promotion ID format"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Security AdminAccessToken
+// @Router /promotions/{id}/deactivate [patch]
+func (h *Handler) DeactivatePromotion(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	promotionID, err := commonRequest.ParseParamUUID(c, "id")
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	token, err := commonRequest.ParseAccessToken(c)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	err = h.uc.DeactivatePromotion(ctx, promotionID, token)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
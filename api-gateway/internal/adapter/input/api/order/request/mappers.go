@@ -1,14 +1,87 @@
 package order_request
 
-import orderDto "api-gateway/internal/domain/dtos/order"
+import (
+	"api-gateway/internal/adapter/input/api/request"
+	moneyDto "api-gateway/internal/domain/dtos/money"
+	orderDto "api-gateway/internal/domain/dtos/order"
+
+	"github.com/google/uuid"
+)
 
 func ToOrderCreateDto(request *CreateRequest) orderDto.CreateDto {
 	return orderDto.CreateDto{
-		Address: request.Address,
-		Items:   ToItemDtoList(request.Items),
+		Address:     ToAddressDto(request.Address),
+		Items:       ToItemDtoList(request.Items),
+		PromoCode:   request.PromoCode,
+		Slot:        toDeliverySlotDto(request.DeliverySlot),
+		Fulfillment: orderDto.FulfillmentPolicy(request.FulfillmentPolicy),
+	}
+}
+
+func toDeliverySlotDto(schema *DeliverySlotSchema) *orderDto.DeliverySlotDto {
+	if schema == nil {
+		return nil
+	}
+	return &orderDto.DeliverySlotDto{
+		Start: schema.Start,
+		End:   schema.End,
+	}
+}
+
+func ToAddressDto(schema AddressSchema) orderDto.AddressDto {
+	var location *orderDto.LocationDto
+	if schema.Location != nil {
+		location = &orderDto.LocationDto{
+			Latitude:  schema.Location.Latitude,
+			Longitude: schema.Location.Longitude,
+		}
+	}
+
+	return orderDto.AddressDto{
+		Country:      schema.Country,
+		City:         schema.City,
+		Street:       schema.Street,
+		House:        schema.House,
+		Apartment:    schema.Apartment,
+		PostalCode:   schema.PostalCode,
+		Location:     location,
+		Instructions: schema.Instructions,
+	}
+}
+
+func ToListQueryDto(request *ListOrdersRequest) orderDto.ListQueryDto {
+	statuses := make([]orderDto.Status, 0, len(request.Statuses))
+	for _, status := range request.Statuses {
+		statuses = append(statuses, orderDto.Status(status))
+	}
+
+	return orderDto.ListQueryDto{
+		Statuses:    statuses,
+		CreatedFrom: request.CreatedFrom,
+		CreatedTo:   request.CreatedTo,
+		Sort:        orderDto.Sort(request.Sort),
+		Limit:       request.Limit,
+		Cursor:      request.Cursor,
+	}
+}
+
+func ToSearchFilterDto(request *SearchOrdersRequest) orderDto.SearchFilterDto {
+	return orderDto.SearchFilterDto{
+		CustomerID: toOptionalUUID(request.CustomerID),
+		CourierID:  toOptionalUUID(request.CourierID),
+		ProductID:  toOptionalUUID(request.ProductID),
 	}
 }
 
+// toOptionalUUID maps an ID the binding already validated; an empty one maps to nil.
+func toOptionalUUID(value string) *uuid.UUID {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
+
 func ToItemDtoList(schemas []*ItemSchema) []orderDto.ItemDto {
 	items := make([]orderDto.ItemDto, 0, len(schemas))
 	for _, schema := range schemas {
@@ -20,7 +93,49 @@ func ToItemDtoList(schemas []*ItemSchema) []orderDto.ItemDto {
 func ToItemDto(schema *ItemSchema) orderDto.ItemDto {
 	return orderDto.ItemDto{
 		ProductID: schema.ProductID,
-		Price:     schema.Price,
+		Price:     request.ToMoneyDto(schema.Price),
 		Count:     schema.Count,
 	}
 }
+
+func ToCreatePromotionDto(request *CreatePromotionRequest) orderDto.CreatePromotionDto {
+	return orderDto.CreatePromotionDto{
+		Code:  request.Code,
+		Rules: ToPromotionRulesDto(request.Rules),
+	}
+}
+
+func ToPromotionRulesDto(schema PromotionRulesSchema) orderDto.PromotionRulesDto {
+	return orderDto.PromotionRulesDto{
+		Kind:             orderDto.PromotionKind(schema.Kind),
+		Percent:          schema.Percent,
+		Amount:           toOptionalMoneyDto(schema.Amount),
+		MinOrderValue:    toOptionalMoneyDto(schema.MinOrderValue),
+		PerCustomerLimit: schema.PerCustomerLimit,
+		ValidFrom:        schema.ValidFrom,
+		ValidTo:          schema.ValidTo,
+		ProductIDs:       schema.ProductIDs,
+	}
+}
+
+func toOptionalMoneyDto(schema *request.MoneySchema) *moneyDto.MoneyDto {
+	if schema == nil {
+		return nil
+	}
+	money := request.ToMoneyDto(*schema)
+	return &money
+}
+
+func ToPeriodDto(request *AnalyticsPeriodRequest) orderDto.PeriodDto {
+	return orderDto.PeriodDto{
+		From: request.CreatedFrom,
+		To:   request.CreatedTo,
+	}
+}
+
+func ToGranularity(request *GetRevenueRequest) orderDto.Granularity {
+	if request.Granularity == "" {
+		return orderDto.DayGranularity
+	}
+	return orderDto.Granularity(request.Granularity)
+}
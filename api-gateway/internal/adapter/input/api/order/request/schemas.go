@@ -1,27 +1,122 @@
 package order_request
 
 import (
+	"api-gateway/internal/adapter/input/api/request"
+	"time"
+
 	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
 )
 
+type ListOrdersRequest struct {
+	Limit       int        `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor      string     `form:"cursor"`
+	Statuses    []string   `form:"status" binding:"omitempty,dive,oneof=created canceled_courier_not_found canceled_out_of_stock delivering delivered customer_canceled canceled_timeout canceling canceled_by_admin"`
+	CreatedFrom *time.Time `form:"created_from" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedTo   *time.Time `form:"created_to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Sort        string     `form:"sort" binding:"omitempty,oneof=newest_first oldest_first"`
+}
+
 type GetAllCustomerOrdersRequest struct {
-	Limit  int `form:"limit" binding:"min=1"`
-	Offset int `form:"offset" binding:"min=0"`
+	ListOrdersRequest
+}
+
+type GetCourierHistoryRequest struct {
+	ListOrdersRequest
 }
 
-type GetAllCourierOrdersRequest struct {
-	Limit  int `form:"limit" binding:"min=1"`
-	Offset int `form:"offset" binding:"min=0"`
+// SearchOrdersRequest filters orders by any combination of customer, courier and
+// product on top of the usual listing parameters.
+type SearchOrdersRequest struct {
+	ListOrdersRequest
+	CustomerID string `form:"customer_id" binding:"omitempty,uuid"`
+	CourierID  string `form:"courier_id" binding:"omitempty,uuid"`
+	ProductID  string `form:"product_id" binding:"omitempty,uuid"`
 }
 
 type CreateRequest struct {
-	Address string        `json:"address" binding:"required"`
-	Items   []*ItemSchema `json:"items" binding:"required,min=1,dive"`
+	Address   AddressSchema `json:"address" binding:"required"`
+	Items     []*ItemSchema `json:"items" binding:"required,min=1,dive"`
+	PromoCode string        `json:"promo_code" binding:"max=32"`
+	// Optional; one of the slots listed by GET /delivery-slots.
+	DeliverySlot *DeliverySlotSchema `json:"delivery_slot"`
+	// Optional; allow_partial delivers what is in stock instead of canceling
+	// the order when some items are short.
+	FulfillmentPolicy string `json:"fulfillment_policy" binding:"omitempty,oneof=all_or_nothing allow_partial"`
+}
+
+type DeliverySlotSchema struct {
+	Start time.Time `json:"start" binding:"required"`
+	End   time.Time `json:"end" binding:"required"`
+}
+
+type AddressSchema struct {
+	Country      string          `json:"country" binding:"required,len=2,alpha"`
+	City         string          `json:"city" binding:"required,max=100"`
+	Street       string          `json:"street" binding:"required,max=200"`
+	House        string          `json:"house" binding:"required,max=20"`
+	Apartment    string          `json:"apartment" binding:"max=20"`
+	PostalCode   string          `json:"postal_code" binding:"required,min=3,max=10"`
+	Location     *LocationSchema `json:"location"`
+	Instructions string          `json:"instructions" binding:"max=500"`
+}
+
+type LocationSchema struct {
+	Latitude  float64 `json:"latitude" binding:"min=-90,max=90"`
+	Longitude float64 `json:"longitude" binding:"min=-180,max=180"`
+}
+
+type CancelRequest struct {
+	Reason string `json:"reason" binding:"max=500"`
+}
+
+type ForceCancelRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+type RetrySagaStepRequest struct {
+	Type string `json:"type" binding:"required,oneof=create_order cancel_order"`
+}
+
+type ReassignCourierRequest struct {
+	CourierID uuid.UUID `json:"courier_id" binding:"required"`
+	Reason    string    `json:"reason" binding:"max=500"`
 }
 
 type ItemSchema struct {
-	ProductID uuid.UUID       `json:"product_id" binding:"required"`
-	Price     decimal.Decimal `json:"price" binding:"required"`
-	Count     int             `json:"count" binding:"required"`
+	ProductID uuid.UUID           `json:"product_id" binding:"required"`
+	Price     request.MoneySchema `json:"price" binding:"required"`
+	Count     int                 `json:"count" binding:"required"`
+}
+
+type CreatePromotionRequest struct {
+	Code  string               `json:"code" binding:"required,min=3,max=32"`
+	Rules PromotionRulesSchema `json:"rules" binding:"required"`
+}
+
+type GetPromotionsRequest struct {
+	ActiveOnly bool `form:"active_only"`
+}
+
+type PromotionRulesSchema struct {
+	Kind             string               `json:"kind" binding:"required,oneof=percentage fixed_amount"`
+	Percent          int                  `json:"percent" binding:"omitempty,min=1,max=100"`
+	Amount           *request.MoneySchema `json:"amount"`
+	MinOrderValue    *request.MoneySchema `json:"min_order_value"`
+	PerCustomerLimit int                  `json:"per_customer_limit" binding:"min=0"`
+	ValidFrom        *time.Time           `json:"valid_from"`
+	ValidTo          *time.Time           `json:"valid_to"`
+	ProductIDs       []uuid.UUID          `json:"product_ids"`
+}
+
+// AnalyticsPeriodRequest selects orders by creation time, [created_from, created_to).
+// Either bound may be left out.
+type AnalyticsPeriodRequest struct {
+	CreatedFrom *time.Time `form:"created_from" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedTo   *time.Time `form:"created_to" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+type GetRevenueRequest struct {
+	AnalyticsPeriodRequest
+	// Defaults to day.
+	Granularity string `form:"granularity" binding:"omitempty,oneof=day week"`
 }
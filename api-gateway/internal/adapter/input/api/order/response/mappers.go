@@ -1,22 +1,113 @@
 package order_response
 
 import (
+	"api-gateway/internal/adapter/input/api/response"
+	courierDto "api-gateway/internal/domain/dtos/courier"
+	moneyDto "api-gateway/internal/domain/dtos/money"
 	orderDto "api-gateway/internal/domain/dtos/order"
+	warehouseDto "api-gateway/internal/domain/dtos/warehouse"
+	"path"
+
+	"github.com/google/uuid"
 )
 
 func ToOrderResponse(order *orderDto.OrderDto) OrderResponse {
 	return OrderResponse{
-		ID:         order.ID,
-		CustomerID: order.CustomerID,
-		Status:     string(order.Status),
-		Created:    order.Created,
-		Version:    order.Version.String(),
-		Delivery:   toDeliverySchema(order.Delivery),
-		Items:      toItemSchemas(order.Items),
+		ID:                order.ID,
+		CustomerID:        order.CustomerID,
+		Status:            string(order.Status),
+		Created:           order.Created,
+		Version:           order.Version.String(),
+		Delivery:          toDeliverySchema(order.Delivery),
+		Items:             toItemSchemas(order.Items),
+		CancelReason:      order.CancelReason,
+		Subtotal:          response.ToMoneySchema(order.Subtotal),
+		Discount:          toDiscountSchema(order.Discount),
+		Total:             response.ToMoneySchema(order.Total),
+		FulfillmentPolicy: string(order.Fulfillment),
+	}
+}
+
+// ToOrderDetailsResponse maps the order details; image URLs are built under
+// basePath, the path the API is served at.
+func ToOrderDetailsResponse(details *orderDto.OrderDetailsDto, basePath string) OrderDetailsResponse {
+	order := details.Order
+	return OrderDetailsResponse{
+		ID:                order.ID,
+		CustomerID:        order.CustomerID,
+		Status:            string(order.Status),
+		Created:           order.Created,
+		Version:           order.Version.String(),
+		Delivery:          toDeliverySchema(order.Delivery),
+		Items:             toItemDetailsSchemas(order.Items, details.Products, basePath),
+		CancelReason:      order.CancelReason,
+		Subtotal:          response.ToMoneySchema(order.Subtotal),
+		Discount:          toDiscountSchema(order.Discount),
+		Total:             response.ToMoneySchema(order.Total),
+		FulfillmentPolicy: string(order.Fulfillment),
+		Courier:           toCourierSchema(details.Courier),
+	}
+}
+
+func toItemDetailsSchemas(
+	items []orderDto.ItemDto,
+	products map[uuid.UUID]*warehouseDto.ProductDto,
+	basePath string,
+) []ItemDetailsSchema {
+	result := make([]ItemDetailsSchema, 0, len(items))
+	for _, item := range items {
+		result = append(result, ItemDetailsSchema{
+			ProductID:      item.ProductID,
+			Name:           item.Name,
+			Price:          response.ToMoneySchema(item.Price),
+			Count:          item.Count,
+			LineTotal:      response.ToMoneySchema(item.LineTotal),
+			RequestedCount: item.Requested,
+			Product:        toProductSchema(products[item.ProductID], basePath),
+		})
+	}
+	return result
+}
+
+func toProductSchema(product *warehouseDto.ProductDto, basePath string) *ProductSchema {
+	if product == nil {
+		return nil
+	}
+	return &ProductSchema{
+		Name:     product.Name,
+		ImageURL: path.Join("/", basePath, "products", product.ProductID.String(), "image"),
+	}
+}
+
+func toCourierSchema(courier *courierDto.CourierDto) *CourierSchema {
+	if courier == nil {
+		return nil
+	}
+	return &CourierSchema{
+		ID:   courier.CourierID,
+		Name: courier.Name,
+	}
+}
+
+func toDiscountSchema(discount *orderDto.DiscountDto) *DiscountSchema {
+	if discount == nil {
+		return nil
+	}
+	return &DiscountSchema{
+		PromoCode: discount.PromoCode,
+		Amount:    response.ToMoneySchema(discount.Amount),
+	}
+}
+
+func ToOrdersResponse(page *orderDto.OrdersPageDto) OrdersResponse {
+	result := make([]OrderResponse, 0, len(page.Orders))
+	for _, order := range page.Orders {
+		result = append(result, ToOrderResponse(order))
 	}
+	return OrdersResponse{Orders: result, NextCursor: page.NextCursor}
 }
 
-func ToOrdersResponse(orders []*orderDto.OrderDto) OrdersResponse {
+func ToCurrentOrdersResponse(orders []*orderDto.OrderDto) OrdersResponse {
 	result := make([]OrderResponse, 0, len(orders))
 	for _, order := range orders {
 		result = append(result, ToOrderResponse(order))
@@ -24,6 +115,37 @@ func ToOrdersResponse(orders []*orderDto.OrderDto) OrdersResponse {
 	return OrdersResponse{Orders: result}
 }
 
+func ToCourierHistoryResponse(history *orderDto.CourierHistoryDto) CourierHistoryResponse {
+	result := make([]OrderResponse, 0, len(history.Orders))
+	for _, order := range history.Orders {
+		result = append(result, ToOrderResponse(order))
+	}
+
+	return CourierHistoryResponse{Orders: result, NextCursor: history.NextCursor, Counts: toStatusCountsSchema(history.Counts)}
+}
+
+func toAddressSchema(address orderDto.AddressDto) AddressSchema {
+	var location *LocationSchema
+	if address.Location != nil {
+		location = &LocationSchema{
+			Latitude:  address.Location.Latitude,
+			Longitude: address.Location.Longitude,
+		}
+	}
+
+	return AddressSchema{
+		Country:      address.Country,
+		City:         address.City,
+		Street:       address.Street,
+		House:        address.House,
+		Apartment:    address.Apartment,
+		PostalCode:   address.PostalCode,
+		Location:     location,
+		Instructions: address.Instructions,
+		Legacy:       address.Legacy,
+	}
+}
+
 func toItemSchemas(items []orderDto.ItemDto) []ItemSchema {
 	result := make([]ItemSchema, 0, len(items))
 	for _, item := range items {
@@ -34,16 +156,204 @@ func toItemSchemas(items []orderDto.ItemDto) []ItemSchema {
 
 func toItemSchema(item orderDto.ItemDto) ItemSchema {
 	return ItemSchema{
-		ProductID: item.ProductID,
-		Price:     item.Price,
-		Count:     item.Count,
+		ProductID:      item.ProductID,
+		Name:           item.Name,
+		Price:          response.ToMoneySchema(item.Price),
+		Count:          item.Count,
+		LineTotal:      response.ToMoneySchema(item.LineTotal),
+		RequestedCount: item.Requested,
 	}
 }
 
 func toDeliverySchema(delivery orderDto.DeliveryDto) DeliverySchema {
 	return DeliverySchema{
 		CourierID: delivery.CourierID,
-		Address:   delivery.Address,
+		Address:   toAddressSchema(delivery.Address),
+		Assigned:  delivery.Assigned,
 		Arrived:   delivery.Arrived,
+		Slot:      toOptionalDeliverySlotSchema(delivery.Slot),
+	}
+}
+
+func toDeliverySlotSchema(slot orderDto.DeliverySlotDto) DeliverySlotSchema {
+	return DeliverySlotSchema{
+		Start: slot.Start,
+		End:   slot.End,
+	}
+}
+
+func toOptionalDeliverySlotSchema(slot *orderDto.DeliverySlotDto) *DeliverySlotSchema {
+	if slot == nil {
+		return nil
+	}
+	schema := toDeliverySlotSchema(*slot)
+	return &schema
+}
+
+func ToSlotsResponse(slots []*orderDto.SlotAvailabilityDto) SlotsResponse {
+	result := make([]SlotAvailabilitySchema, 0, len(slots))
+	for _, slot := range slots {
+		result = append(result, SlotAvailabilitySchema{
+			Slot:      toDeliverySlotSchema(slot.Slot),
+			Capacity:  slot.Capacity,
+			Remaining: slot.Remaining,
+		})
+	}
+	return SlotsResponse{Slots: result}
+}
+
+func ToSagaResponse(saga *orderDto.SagaDto) SagaResponse {
+	return SagaResponse{
+		ID:        saga.ID,
+		OrderID:   saga.OrderID,
+		Type:      string(saga.Type),
+		Step:      string(saga.Step),
+		History:   toSagaStepSchemas(saga.History),
+		LastError: toSagaFailureSchema(saga.LastError),
+		Created:   saga.Created,
+		Updated:   saga.Updated,
+		ResumeAt:  saga.ResumeAt,
+	}
+}
+
+func ToSagasResponse(sagas []*orderDto.SagaDto) SagasResponse {
+	result := make([]SagaResponse, 0, len(sagas))
+	for _, saga := range sagas {
+		result = append(result, ToSagaResponse(saga))
+	}
+	return SagasResponse{Sagas: result}
+}
+
+func toSagaStepSchemas(history []orderDto.SagaStepDto) []SagaStepSchema {
+	result := make([]SagaStepSchema, 0, len(history))
+	for _, record := range history {
+		result = append(result, SagaStepSchema{
+			Step:    string(record.Step),
+			Entered: record.Entered,
+			Retry:   record.Retry,
+		})
+	}
+	return result
+}
+
+func toSagaFailureSchema(failure *orderDto.SagaFailureDto) *SagaFailureSchema {
+	if failure == nil {
+		return nil
+	}
+
+	return &SagaFailureSchema{
+		Step:     string(failure.Step),
+		Message:  failure.Message,
+		Occurred: failure.Occurred,
+	}
+}
+
+func ToPromotionResponse(promotion *orderDto.PromotionDto) PromotionResponse {
+	return PromotionResponse{
+		ID:      promotion.ID,
+		Code:    promotion.Code,
+		Rules:   toPromotionRulesSchema(promotion.Rules),
+		Active:  promotion.Active,
+		Created: promotion.Created,
+	}
+}
+
+func ToPromotionsResponse(promotions []*orderDto.PromotionDto) PromotionsResponse {
+	result := make([]PromotionResponse, 0, len(promotions))
+	for _, promotion := range promotions {
+		result = append(result, ToPromotionResponse(promotion))
+	}
+	return PromotionsResponse{Promotions: result}
+}
+
+func toPromotionRulesSchema(rules orderDto.PromotionRulesDto) PromotionRulesSchema {
+	return PromotionRulesSchema{
+		Kind:             string(rules.Kind),
+		Percent:          rules.Percent,
+		Amount:           toOptionalMoneySchema(rules.Amount),
+		MinOrderValue:    toOptionalMoneySchema(rules.MinOrderValue),
+		PerCustomerLimit: rules.PerCustomerLimit,
+		ValidFrom:        rules.ValidFrom,
+		ValidTo:          rules.ValidTo,
+		ProductIDs:       rules.ProductIDs,
+	}
+}
+
+func toOptionalMoneySchema(money *moneyDto.MoneyDto) *response.MoneySchema {
+	if money == nil {
+		return nil
+	}
+	schema := response.ToMoneySchema(*money)
+	return &schema
+}
+
+func ToHistoryResponse(history []*orderDto.StatusChangeDto) HistoryResponse {
+	result := make([]StatusChangeSchema, 0, len(history))
+	for _, change := range history {
+		result = append(result, toStatusChangeSchema(change))
+	}
+	return HistoryResponse{History: result}
+}
+
+func toStatusChangeSchema(change *orderDto.StatusChangeDto) StatusChangeSchema {
+	var from *string
+	if change.From != nil {
+		status := string(*change.From)
+		from = &status
+	}
+
+	return StatusChangeSchema{
+		From:     from,
+		To:       string(change.To),
+		Occurred: change.Occurred,
+		Actor: ActorSchema{
+			Type: string(change.Actor.Type),
+			ID:   change.Actor.ID,
+		},
+		Reason:    change.Reason,
+		MessageID: change.MessageID,
+	}
+}
+
+func toStatusCountsSchema(counts map[orderDto.Status]int) map[string]int {
+	result := make(map[string]int, len(counts))
+	for status, count := range counts {
+		result[string(status)] = count
+	}
+	return result
+}
+
+func ToStatusFunnelResponse(funnel *orderDto.StatusFunnelDto) StatusFunnelResponse {
+	return StatusFunnelResponse{
+		Total:   funnel.Total,
+		Current: toStatusCountsSchema(funnel.Current),
+		Reached: toStatusCountsSchema(funnel.Reached),
+	}
+}
+
+func ToRevenueResponse(buckets []*orderDto.RevenueBucketDto) RevenueResponse {
+	result := make([]RevenueBucketSchema, 0, len(buckets))
+	for _, bucket := range buckets {
+		result = append(result, RevenueBucketSchema{
+			Start:   bucket.Start,
+			Revenue: response.ToMoneySchema(bucket.Revenue),
+			Orders:  bucket.Orders,
+		})
+	}
+	return RevenueResponse{Buckets: result}
+}
+
+func ToDeliveryTimeResponse(deliveryTime *orderDto.DeliveryTimeDto) DeliveryTimeResponse {
+	return DeliveryTimeResponse{
+		Orders:         deliveryTime.Orders,
+		AverageSeconds: deliveryTime.Average.Seconds(),
+	}
+}
+
+func ToCancellationReasonsResponse(reasons map[orderDto.CancelReason]int) CancellationReasonsResponse {
+	result := make(map[string]int, len(reasons))
+	for reason, count := range reasons {
+		result[string(reason)] = count
 	}
+	return CancellationReasonsResponse{Reasons: result}
 }
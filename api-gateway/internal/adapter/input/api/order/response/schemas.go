@@ -1,34 +1,232 @@
 package order_response
 
 import (
+	"api-gateway/internal/adapter/input/api/response"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
 )
 
 type OrderResponse struct {
-	ID         uuid.UUID      `json:"id"`
-	CustomerID uuid.UUID      `json:"customer_id"`
-	Status     string         `json:"status"`
-	Created    time.Time      `json:"created"`
-	Version    string         `json:"version"`
-	Delivery   DeliverySchema `json:"delivery"`
-	Items      []ItemSchema   `json:"items"`
+	ID                uuid.UUID            `json:"id"`
+	CustomerID        uuid.UUID            `json:"customer_id"`
+	Status            string               `json:"status"`
+	Created           time.Time            `json:"created"`
+	Version           string               `json:"version"`
+	Delivery          DeliverySchema       `json:"delivery"`
+	Items             []ItemSchema         `json:"items"`
+	CancelReason      string               `json:"cancel_reason,omitempty"`
+	Subtotal          response.MoneySchema `json:"subtotal"`
+	Discount          *DiscountSchema      `json:"discount,omitempty"`
+	Total             response.MoneySchema `json:"total"`
+	FulfillmentPolicy string               `json:"fulfillment_policy"`
+}
+
+// OrderDetailsResponse is an order with the current catalog entries of its
+// products and the courier delivering it.
+type OrderDetailsResponse struct {
+	ID                uuid.UUID            `json:"id"`
+	CustomerID        uuid.UUID            `json:"customer_id"`
+	Status            string               `json:"status"`
+	Created           time.Time            `json:"created"`
+	Version           string               `json:"version"`
+	Delivery          DeliverySchema       `json:"delivery"`
+	Items             []ItemDetailsSchema  `json:"items"`
+	CancelReason      string               `json:"cancel_reason,omitempty"`
+	Subtotal          response.MoneySchema `json:"subtotal"`
+	Discount          *DiscountSchema      `json:"discount,omitempty"`
+	Total             response.MoneySchema `json:"total"`
+	FulfillmentPolicy string               `json:"fulfillment_policy"`
+	// Set once a courier is assigned.
+	Courier *CourierSchema `json:"courier,omitempty"`
+}
+
+// ItemDetailsSchema is an order line. Name and Price are the snapshot taken
+// when the order was placed; Product is missing if the product left the catalog.
+type ItemDetailsSchema struct {
+	ProductID      uuid.UUID            `json:"product_id"`
+	Name           string               `json:"name"`
+	Price          response.MoneySchema `json:"price"`
+	Count          int                  `json:"count"`
+	LineTotal      response.MoneySchema `json:"line_total"`
+	RequestedCount int                  `json:"requested_count"`
+	Product        *ProductSchema       `json:"product,omitempty"`
+}
+
+type ProductSchema struct {
+	Name     string `json:"name"`
+	ImageURL string `json:"image_url"`
+}
+
+type CourierSchema struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+type DiscountSchema struct {
+	PromoCode string               `json:"promo_code"`
+	Amount    response.MoneySchema `json:"amount"`
 }
 
 type OrdersResponse struct {
-	Orders []OrderResponse `json:"orders"`
+	Orders     []OrderResponse `json:"orders"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
+
+type CourierHistoryResponse struct {
+	Orders     []OrderResponse `json:"orders"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+	Counts     map[string]int  `json:"counts"`
 }
 
 type DeliverySchema struct {
-	CourierID *uuid.UUID `json:"courier_id,omitempty"`
-	Address   string     `json:"address"`
-	Arrived   *time.Time `json:"arrived,omitempty"`
+	CourierID *uuid.UUID          `json:"courier_id,omitempty"`
+	Address   AddressSchema       `json:"address"`
+	Assigned  *time.Time          `json:"assigned,omitempty"`
+	Arrived   *time.Time          `json:"arrived,omitempty"`
+	Slot      *DeliverySlotSchema `json:"slot,omitempty"`
+}
+
+type DeliverySlotSchema struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+type SlotAvailabilitySchema struct {
+	Slot      DeliverySlotSchema `json:"slot"`
+	Capacity  int                `json:"capacity"`
+	Remaining int                `json:"remaining"`
+}
+
+type SlotsResponse struct {
+	Slots []SlotAvailabilitySchema `json:"slots"`
+}
+
+// AddressSchema carries only legacy for orders placed before addresses were
+// structured.
+type AddressSchema struct {
+	Country      string          `json:"country,omitempty"`
+	City         string          `json:"city,omitempty"`
+	Street       string          `json:"street,omitempty"`
+	House        string          `json:"house,omitempty"`
+	Apartment    string          `json:"apartment,omitempty"`
+	PostalCode   string          `json:"postal_code,omitempty"`
+	Location     *LocationSchema `json:"location,omitempty"`
+	Instructions string          `json:"instructions,omitempty"`
+	Legacy       string          `json:"legacy,omitempty"`
 }
 
+type LocationSchema struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// ItemSchema is an order line. Count is what is delivered and charged, which
+// is less than RequestedCount when the order was partially fulfilled.
 type ItemSchema struct {
-	ProductID uuid.UUID       `json:"product_id"`
-	Price     decimal.Decimal `json:"price"`
-	Count     int             `json:"count"`
+	ProductID      uuid.UUID            `json:"product_id"`
+	Name           string               `json:"name"`
+	Price          response.MoneySchema `json:"price"`
+	Count          int                  `json:"count"`
+	LineTotal      response.MoneySchema `json:"line_total"`
+	RequestedCount int                  `json:"requested_count"`
+}
+
+type SagaResponse struct {
+	ID        uuid.UUID          `json:"id"`
+	OrderID   uuid.UUID          `json:"order_id"`
+	Type      string             `json:"type"`
+	Step      string             `json:"step"`
+	History   []SagaStepSchema   `json:"history"`
+	LastError *SagaFailureSchema `json:"last_error,omitempty"`
+	Created   time.Time          `json:"created"`
+	Updated   time.Time          `json:"updated"`
+	ResumeAt  *time.Time         `json:"resume_at,omitempty"`
+}
+
+type SagasResponse struct {
+	Sagas []SagaResponse `json:"sagas"`
+}
+
+type SagaStepSchema struct {
+	Step    string    `json:"step"`
+	Entered time.Time `json:"entered"`
+	// Set when an admin retried the step the saga was already in.
+	Retry bool `json:"retry,omitempty"`
+}
+
+type SagaFailureSchema struct {
+	Step     string    `json:"step"`
+	Message  string    `json:"message"`
+	Occurred time.Time `json:"occurred"`
+}
+
+type PromotionResponse struct {
+	ID      uuid.UUID            `json:"id"`
+	Code    string               `json:"code"`
+	Rules   PromotionRulesSchema `json:"rules"`
+	Active  bool                 `json:"active"`
+	Created time.Time            `json:"created"`
+}
+
+type PromotionsResponse struct {
+	Promotions []PromotionResponse `json:"promotions"`
+}
+
+type PromotionRulesSchema struct {
+	Kind             string                `json:"kind"`
+	Percent          int                   `json:"percent,omitempty"`
+	Amount           *response.MoneySchema `json:"amount,omitempty"`
+	MinOrderValue    *response.MoneySchema `json:"min_order_value,omitempty"`
+	PerCustomerLimit int                   `json:"per_customer_limit"`
+	ValidFrom        *time.Time            `json:"valid_from,omitempty"`
+	ValidTo          *time.Time            `json:"valid_to,omitempty"`
+	ProductIDs       []uuid.UUID           `json:"product_ids"`
+}
+
+type HistoryResponse struct {
+	History []StatusChangeSchema `json:"history"`
+}
+
+// StatusChangeSchema has no from on the entry recording the creation of the order.
+type StatusChangeSchema struct {
+	From      *string     `json:"from,omitempty"`
+	To        string      `json:"to"`
+	Occurred  time.Time   `json:"occurred"`
+	Actor     ActorSchema `json:"actor"`
+	Reason    string      `json:"reason,omitempty"`
+	MessageID *uuid.UUID  `json:"message_id,omitempty"`
+}
+
+type ActorSchema struct {
+	Type string     `json:"type"`
+	ID   *uuid.UUID `json:"id,omitempty"`
+}
+
+type StatusFunnelResponse struct {
+	Total int `json:"total"`
+	// Orders per status they are in now.
+	Current map[string]int `json:"current"`
+	// Orders per status they have been in; every order counts as created.
+	Reached map[string]int `json:"reached"`
+}
+
+type RevenueBucketSchema struct {
+	// Start of the day or week in UTC; weeks start on Monday.
+	Start   time.Time            `json:"start"`
+	Revenue response.MoneySchema `json:"revenue"`
+	Orders  int                  `json:"orders"`
+}
+
+type RevenueResponse struct {
+	Buckets []RevenueBucketSchema `json:"buckets"`
+}
+
+type DeliveryTimeResponse struct {
+	Orders         int     `json:"orders"`
+	AverageSeconds float64 `json:"average_seconds"`
+}
+
+type CancellationReasonsResponse struct {
+	Reasons map[string]int `json:"reasons"`
 }
@@ -9,9 +9,34 @@ func RegisterRoutes(router gin.IRouter, handler *Handler) {
 	{
 		orders.POST("", handler.Create)
 		orders.GET("", handler.GetCustomerOrders)
+		orders.GET("/search", handler.SearchOrders)
+		orders.GET("/:id", handler.GetOrder)
 		orders.PATCH("/:id/cancel", handler.CancelOrder)
 		orders.PATCH("/:id/complete", handler.CompleteDelivery)
+		orders.GET("/:id/saga", handler.GetSagaState)
+		orders.GET("/:id/history", handler.GetHistory)
+		orders.PATCH("/:id/force-cancel", handler.ForceCancelOrder)
+		orders.PATCH("/:id/saga/retry", handler.RetrySagaStep)
+		orders.PATCH("/:id/courier", handler.ReassignCourier)
 	}
 
+	promotions := router.Group("/promotions")
+	{
+		promotions.POST("", handler.CreatePromotion)
+		promotions.GET("", handler.GetPromotions)
+		promotions.PATCH("/:id/deactivate", handler.DeactivatePromotion)
+	}
+
+	analytics := router.Group("/analytics/orders")
+	{
+		analytics.GET("/funnel", handler.GetStatusFunnel)
+		analytics.GET("/revenue", handler.GetRevenue)
+		analytics.GET("/delivery-time", handler.GetDeliveryTime)
+		analytics.GET("/cancellations", handler.GetCancellationReasons)
+	}
+
+	router.GET("/delivery-slots", handler.GetAvailableSlots)
+
 	router.GET("/couriers/me/orders", handler.GetCourierOrders)
+	router.GET("/couriers/me/orders/history", handler.GetCourierHistory)
 }
@@ -0,0 +1,31 @@
+package order
+
+import (
+	response "api-gateway/internal/adapter/input/api/order/response"
+	commonResponse "api-gateway/internal/adapter/input/api/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetAvailableSlots godoc
+// @Summary List delivery slots
+// @Description List the delivery slots that can still be booked, earliest first.
+// @Description Pass one as delivery_slot when creating an order to schedule its delivery.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Success 200 {object} order_response.SlotsResponse "Bookable slots"
+// @Failure 500 {object} response.ErrorResponseDetail "Server error"
+// @Router /delivery-slots [get]
+func (h *Handler) GetAvailableSlots(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	slots, err := h.uc.GetAvailableSlots(ctx)
+	if err != nil {
+		commonResponse.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.ToSlotsResponse(slots))
+}
@@ -0,0 +1,19 @@
+package request
+
+import (
+	moneyDto "api-gateway/internal/domain/dtos/money"
+
+	"github.com/shopspring/decimal"
+)
+
+type MoneySchema struct {
+	Amount   decimal.Decimal `json:"amount" binding:"required"`
+	Currency string          `json:"currency" binding:"required,len=3,uppercase"`
+}
+
+func ToMoneyDto(schema MoneySchema) moneyDto.MoneyDto {
+	return moneyDto.MoneyDto{
+		Amount:   schema.Amount,
+		Currency: schema.Currency,
+	}
+}
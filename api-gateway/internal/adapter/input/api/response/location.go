@@ -1,9 +1,10 @@
 package response
 
 import (
+	"net/url"
+
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"net/url"
 )
 
 func AddLocationHeader(c *gin.Context, path string) {
@@ -0,0 +1,19 @@
+package response
+
+import (
+	moneyDto "api-gateway/internal/domain/dtos/money"
+
+	"github.com/shopspring/decimal"
+)
+
+type MoneySchema struct {
+	Amount   decimal.Decimal `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+func ToMoneySchema(money moneyDto.MoneyDto) MoneySchema {
+	return MoneySchema{
+		Amount:   money.Amount,
+		Currency: money.Currency,
+	}
+}
@@ -1,6 +1,7 @@
 package warehouse_request
 
 import (
+	"api-gateway/internal/adapter/input/api/request"
 	warehouseDto "api-gateway/internal/domain/dtos/warehouse"
 )
 
@@ -22,6 +23,6 @@ func ToItemInfoDtoList(items []*ItemInfoSchema) []warehouseDto.ItemInfoDto {
 func ToCreateProductDto(req *CreateProductRequest) warehouseDto.CreateProductDto {
 	return warehouseDto.CreateProductDto{
 		Name:  req.Name,
-		Price: req.Price,
+		Price: request.ToMoneyDto(req.Price),
 	}
 }
@@ -1,8 +1,9 @@
 package warehouse_request
 
 import (
+	"api-gateway/internal/adapter/input/api/request"
+
 	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
 )
 
 type ItemInfoSchema struct {
@@ -16,8 +17,8 @@ type GetAllItemsRequest struct {
 }
 
 type CreateProductRequest struct {
-	Name  string          `json:"name" binding:"required"`
-	Price decimal.Decimal `json:"price" binding:"required"`
+	Name  string              `json:"name" binding:"required"`
+	Price request.MoneySchema `json:"price" binding:"required"`
 }
 
 type ReserveItemsRequest struct {
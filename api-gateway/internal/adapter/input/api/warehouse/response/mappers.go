@@ -1,6 +1,7 @@
 package warehouse_response
 
 import (
+	"api-gateway/internal/adapter/input/api/response"
 	warehouseDto "api-gateway/internal/domain/dtos/warehouse"
 )
 
@@ -25,7 +26,7 @@ func ToProductSchema(product warehouseDto.ProductDto) ProductSchema {
 	return ProductSchema{
 		ProductID: product.ProductID,
 		Name:      product.Name,
-		Price:     product.Price,
+		Price:     response.ToMoneySchema(product.Price),
 		Created:   product.Created,
 	}
 }
@@ -1,10 +1,10 @@
 package warehouse_response
 
 import (
+	"api-gateway/internal/adapter/input/api/response"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
 )
 
 type ItemResponse struct {
@@ -19,10 +19,10 @@ type ItemsResponse struct {
 }
 
 type ProductSchema struct {
-	ProductID uuid.UUID       `json:"product_id"`
-	Name      string          `json:"name"`
-	Price     decimal.Decimal `json:"price"`
-	Created   time.Time       `json:"created"`
+	ProductID uuid.UUID            `json:"product_id"`
+	Name      string               `json:"name"`
+	Price     response.MoneySchema `json:"price"`
+	Created   time.Time            `json:"created"`
 }
 
 type CreateProductResponse struct {
@@ -1,7 +1,6 @@
 package courier
 
 import (
-	courierGRPC "api-gateway/gen/courier/v1"
 	"api-gateway/internal/adapter/output/clients/response"
 	courierDto "api-gateway/internal/domain/dtos/courier"
 	courierClient "api-gateway/internal/port/output/clients/courier"
@@ -10,19 +9,19 @@ import (
 )
 
 type ClientImpl struct {
-	client courierGRPC.CourierAuthServiceClient
+	clients *GRPCClients
 }
 
-func NewClient(client courierGRPC.CourierAuthServiceClient) courierClient.Client {
+func NewClient(clients *GRPCClients) courierClient.Client {
 	return &ClientImpl{
-		client: client,
+		clients: clients,
 	}
 }
 
 func (c *ClientImpl) Register(ctx context.Context, data courierDto.RegisterDto) (uuid.UUID, error) {
 	request := toRegisterRequest(data)
 
-	resp, err := c.client.Register(ctx, request)
+	resp, err := c.clients.Auth.Register(ctx, request)
 	if err != nil {
 		return uuid.Nil, response.ParseGRPCError(err)
 	}
@@ -38,7 +37,7 @@ func (c *ClientImpl) Register(ctx context.Context, data courierDto.RegisterDto)
 func (c *ClientImpl) Login(ctx context.Context, data courierDto.LoginDto) (string, error) {
 	request := toLoginRequest(data)
 
-	resp, err := c.client.Login(ctx, request)
+	resp, err := c.clients.Auth.Login(ctx, request)
 	if err != nil {
 		return "", response.ParseGRPCError(err)
 	}
@@ -49,7 +48,7 @@ func (c *ClientImpl) Login(ctx context.Context, data courierDto.LoginDto) (strin
 func (c *ClientImpl) Authenticate(ctx context.Context, token string) (uuid.UUID, error) {
 	request := toAuthenticateRequest(token)
 
-	resp, err := c.client.Authenticate(ctx, request)
+	resp, err := c.clients.Auth.Authenticate(ctx, request)
 	if err != nil {
 		return uuid.Nil, response.ParseGRPCError(err)
 	}
@@ -62,4 +61,15 @@ func (c *ClientImpl) Authenticate(ctx context.Context, token string) (uuid.UUID,
 	return courierID, nil
 }
 
+func (c *ClientImpl) GetByID(ctx context.Context, courierID uuid.UUID) (*courierDto.CourierDto, error) {
+	request := toGetCourierRequest(courierID)
+
+	resp, err := c.clients.Courier.GetCourier(ctx, request)
+	if err != nil {
+		return nil, response.ParseGRPCError(err)
+	}
+
+	return toCourier(resp.Courier)
+}
+
 var _ courierClient.Client = (*ClientImpl)(nil)
@@ -11,6 +11,11 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+type GRPCClients struct {
+	Auth    courierGRPC.CourierAuthServiceClient
+	Courier courierGRPC.CourierServiceClient
+}
+
 func newConnection(config *Config) (*grpc.ClientConn, error) {
 	timeout := time.Duration(config.TimeoutSeconds) * time.Second
 	target := "passthrough:///" + config.Address
@@ -41,10 +46,13 @@ func newConnection(config *Config) (*grpc.ClientConn, error) {
 	return conn, nil
 }
 
-func NewGRPCClient(config *Config) (courierGRPC.CourierAuthServiceClient, error) {
+func NewGRPCClient(config *Config) (*GRPCClients, error) {
 	conn, err := newConnection(config)
 	if err != nil {
 		return nil, err
 	}
-	return courierGRPC.NewCourierAuthServiceClient(conn), nil
+	return &GRPCClients{
+		Auth:    courierGRPC.NewCourierAuthServiceClient(conn),
+		Courier: courierGRPC.NewCourierServiceClient(conn),
+	}, nil
 }
@@ -3,6 +3,8 @@ package courier
 import (
 	courierGRPC "api-gateway/gen/courier/v1"
 	courierDto "api-gateway/internal/domain/dtos/courier"
+
+	"github.com/google/uuid"
 )
 
 func toRegisterRequest(data courierDto.RegisterDto) *courierGRPC.RegisterRequest {
@@ -25,3 +27,9 @@ func toAuthenticateRequest(token string) *courierGRPC.AuthenticateRequest {
 		Token: token,
 	}
 }
+
+func toGetCourierRequest(courierID uuid.UUID) *courierGRPC.GetCourierRequest {
+	return &courierGRPC.GetCourierRequest{
+		CourierId: courierID.String(),
+	}
+}
@@ -0,0 +1,19 @@
+package courier
+
+import (
+	courierGRPC "api-gateway/gen/courier/v1"
+	"api-gateway/internal/adapter/output/clients/response"
+	courierDto "api-gateway/internal/domain/dtos/courier"
+)
+
+func toCourier(protoCourier *courierGRPC.Courier) (*courierDto.CourierDto, error) {
+	courierID, err := response.ToUUID(protoCourier.GetCourierId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &courierDto.CourierDto{
+		CourierID: courierID,
+		Name:      protoCourier.GetName(),
+	}, nil
+}
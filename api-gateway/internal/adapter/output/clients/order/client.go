@@ -35,8 +35,19 @@ func (c *ClientImpl) Create(ctx context.Context, data orderClient.CreateDto) (uu
 	return orderID, nil
 }
 
-func (c *ClientImpl) CancelByCustomer(ctx context.Context, orderID uuid.UUID, customerID uuid.UUID) error {
-	in := toCancelByCustomerRequest(orderID)
+func (c *ClientImpl) GetByID(ctx context.Context, orderID uuid.UUID, requester orderDto.ActorDto) (*orderDto.OrderDto, error) {
+	in := toGetOrderRequest(orderID, requester)
+
+	out, err := c.client.GetOrder(ctx, in)
+	if err != nil {
+		return nil, response.ParseGRPCError(err)
+	}
+
+	return toOrder(out.Order)
+}
+
+func (c *ClientImpl) CancelByCustomer(ctx context.Context, orderID uuid.UUID, customerID uuid.UUID, reason string) error {
+	in := toCancelByCustomerRequest(orderID, customerID, reason)
 
 	_, err := c.client.CancelOrderByCustomer(ctx, in)
 	if err != nil {
@@ -47,7 +58,7 @@ func (c *ClientImpl) CancelByCustomer(ctx context.Context, orderID uuid.UUID, cu
 }
 
 func (c *ClientImpl) Complete(ctx context.Context, orderID uuid.UUID, courierID uuid.UUID) error {
-	in := toCompleteDeliveryRequest(orderID)
+	in := toCompleteDeliveryRequest(orderID, courierID)
 
 	_, err := c.client.CompleteDelivery(ctx, in)
 	if err != nil {
@@ -60,16 +71,26 @@ func (c *ClientImpl) Complete(ctx context.Context, orderID uuid.UUID, courierID
 func (c *ClientImpl) GetByCustomer(
 	ctx context.Context,
 	customerID uuid.UUID,
-	limit int,
-	offset int,
-) ([]*orderDto.OrderDto, error) {
-	in := toGetByCustomerRequest(customerID, limit, offset)
+	query orderDto.ListQueryDto,
+) (*orderDto.OrdersPageDto, error) {
+	in := toGetByCustomerRequest(customerID, query)
 
 	out, err := c.client.GetOrdersByCustomer(ctx, in)
 	if err != nil {
 		return nil, response.ParseGRPCError(err)
 	}
 
+	return toOrdersPage(out.Orders, out.NextCursor)
+}
+
+func (c *ClientImpl) GetCurrentByCourier(ctx context.Context, courierID uuid.UUID) ([]*orderDto.OrderDto, error) {
+	in := toGetCurrentByCourierRequest(courierID)
+
+	out, err := c.client.GetCurrentOrdersByCourier(ctx, in)
+	if err != nil {
+		return nil, response.ParseGRPCError(err)
+	}
+
 	orders, err := toOrders(out.Orders)
 	if err != nil {
 		return nil, err
@@ -78,25 +99,198 @@ func (c *ClientImpl) GetByCustomer(
 	return orders, nil
 }
 
-func (c *ClientImpl) GetCurrentByCourier(
+func (c *ClientImpl) GetHistoryByCourier(
 	ctx context.Context,
 	courierID uuid.UUID,
-	limit int,
-	offset int,
-) ([]*orderDto.OrderDto, error) {
-	in := toGetCurrentByCourierRequest(courierID, limit, offset)
+	query orderDto.ListQueryDto,
+) (*orderDto.CourierHistoryDto, error) {
+	in := toGetCourierOrderHistoryRequest(courierID, query)
 
-	out, err := c.client.GetCurrentOrdersByCourier(ctx, in)
+	out, err := c.client.GetCourierOrderHistory(ctx, in)
 	if err != nil {
 		return nil, response.ParseGRPCError(err)
 	}
 
-	orders, err := toOrders(out.Orders)
+	return toCourierHistory(out)
+}
+
+func (c *ClientImpl) GetSagaState(ctx context.Context, orderID uuid.UUID) ([]*orderDto.SagaDto, error) {
+	in := toGetSagaStateRequest(orderID)
+
+	out, err := c.client.GetSagaState(ctx, in)
+	if err != nil {
+		return nil, response.ParseGRPCError(err)
+	}
+
+	sagas, err := toSagas(out.Sagas)
 	if err != nil {
 		return nil, err
 	}
 
-	return orders, nil
+	return sagas, nil
+}
+
+func (c *ClientImpl) CreatePromotion(ctx context.Context, data orderDto.CreatePromotionDto) (uuid.UUID, error) {
+	in := toCreatePromotionRequest(data)
+
+	out, err := c.client.CreatePromotion(ctx, in)
+	if err != nil {
+		return uuid.Nil, response.ParseGRPCError(err)
+	}
+
+	promotionID, err := response.ToUUID(out.PromotionId)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return promotionID, nil
+}
+
+func (c *ClientImpl) GetPromotions(ctx context.Context, activeOnly bool) ([]*orderDto.PromotionDto, error) {
+	in := toGetPromotionsRequest(activeOnly)
+
+	out, err := c.client.GetPromotions(ctx, in)
+	if err != nil {
+		return nil, response.ParseGRPCError(err)
+	}
+
+	promotions, err := toPromotions(out.Promotions)
+	if err != nil {
+		return nil, err
+	}
+
+	return promotions, nil
+}
+
+func (c *ClientImpl) DeactivatePromotion(ctx context.Context, promotionID uuid.UUID) error {
+	in := toDeactivatePromotionRequest(promotionID)
+
+	_, err := c.client.DeactivatePromotion(ctx, in)
+	if err != nil {
+		return response.ParseGRPCError(err)
+	}
+
+	return nil
+}
+
+func (c *ClientImpl) GetAvailableSlots(ctx context.Context) ([]*orderDto.SlotAvailabilityDto, error) {
+	out, err := c.client.GetAvailableSlots(ctx, &orderGRPC.GetAvailableSlotsRequest{})
+	if err != nil {
+		return nil, response.ParseGRPCError(err)
+	}
+
+	return toSlotAvailabilities(out.Slots), nil
+}
+
+func (c *ClientImpl) GetHistory(
+	ctx context.Context,
+	orderID uuid.UUID,
+	requester orderDto.ActorDto,
+) ([]*orderDto.StatusChangeDto, error) {
+	in := toGetOrderHistoryRequest(orderID, requester)
+
+	out, err := c.client.GetOrderHistory(ctx, in)
+	if err != nil {
+		return nil, response.ParseGRPCError(err)
+	}
+
+	return toStatusChanges(out.History)
+}
+
+func (c *ClientImpl) Search(
+	ctx context.Context,
+	filter orderDto.SearchFilterDto,
+	query orderDto.ListQueryDto,
+) (*orderDto.OrdersPageDto, error) {
+	in := toSearchRequest(filter, query)
+
+	out, err := c.client.SearchOrders(ctx, in)
+	if err != nil {
+		return nil, response.ParseGRPCError(err)
+	}
+
+	return toOrdersPage(out.Orders, out.NextCursor)
+}
+
+func (c *ClientImpl) ForceCancel(ctx context.Context, orderID uuid.UUID, reason string) error {
+	in := toForceCancelRequest(orderID, reason)
+
+	_, err := c.client.ForceCancelOrder(ctx, in)
+	if err != nil {
+		return response.ParseGRPCError(err)
+	}
+
+	return nil
+}
+
+func (c *ClientImpl) RetrySagaStep(ctx context.Context, orderID uuid.UUID, sagaType orderDto.SagaType) error {
+	in := toRetrySagaStepRequest(orderID, sagaType)
+
+	_, err := c.client.RetrySagaStep(ctx, in)
+	if err != nil {
+		return response.ParseGRPCError(err)
+	}
+
+	return nil
+}
+
+func (c *ClientImpl) ReassignCourier(ctx context.Context, orderID uuid.UUID, courierID uuid.UUID, reason string) error {
+	in := toReassignCourierRequest(orderID, courierID, reason)
+
+	_, err := c.client.ReassignCourier(ctx, in)
+	if err != nil {
+		return response.ParseGRPCError(err)
+	}
+
+	return nil
+}
+
+func (c *ClientImpl) GetStatusFunnel(ctx context.Context, period orderDto.PeriodDto) (*orderDto.StatusFunnelDto, error) {
+	in := toGetStatusFunnelRequest(period)
+
+	out, err := c.client.GetStatusFunnel(ctx, in)
+	if err != nil {
+		return nil, response.ParseGRPCError(err)
+	}
+
+	return toStatusFunnel(out), nil
+}
+
+func (c *ClientImpl) GetRevenue(
+	ctx context.Context,
+	period orderDto.PeriodDto,
+	granularity orderDto.Granularity,
+) ([]*orderDto.RevenueBucketDto, error) {
+	in := toGetRevenueRequest(period, granularity)
+
+	out, err := c.client.GetRevenue(ctx, in)
+	if err != nil {
+		return nil, response.ParseGRPCError(err)
+	}
+
+	return toRevenueBuckets(out.Buckets), nil
+}
+
+func (c *ClientImpl) GetDeliveryTime(ctx context.Context, period orderDto.PeriodDto) (*orderDto.DeliveryTimeDto, error) {
+	in := toGetDeliveryTimeRequest(period)
+
+	out, err := c.client.GetDeliveryTime(ctx, in)
+	if err != nil {
+		return nil, response.ParseGRPCError(err)
+	}
+
+	return toDeliveryTime(out), nil
+}
+
+func (c *ClientImpl) GetCancellationReasons(ctx context.Context, period orderDto.PeriodDto) (map[orderDto.CancelReason]int, error) {
+	in := toGetCancellationReasonsRequest(period)
+
+	out, err := c.client.GetCancellationReasons(ctx, in)
+	if err != nil {
+		return nil, response.ParseGRPCError(err)
+	}
+
+	return toCancellationReasons(out.Reasons), nil
 }
 
 var _ orderClient.Client = (*ClientImpl)(nil)
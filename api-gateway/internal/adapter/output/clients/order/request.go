@@ -2,16 +2,50 @@ package order
 
 import (
 	orderGRPC "api-gateway/gen/order/v1"
+	"api-gateway/internal/adapter/output/clients/request"
+	moneyDto "api-gateway/internal/domain/dtos/money"
 	orderDto "api-gateway/internal/domain/dtos/order"
 	orderClient "api-gateway/internal/port/output/clients/order"
+	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+func toMoney(money moneyDto.MoneyDto) *orderGRPC.Money {
+	units, nanos := request.ToUnitsAndNanos(money.Amount)
+	return &orderGRPC.Money{
+		CurrencyCode: money.Currency,
+		Units:        units,
+		Nanos:        nanos,
+	}
+}
+
+func toAddress(address orderDto.AddressDto) *orderGRPC.Address {
+	var location *orderGRPC.Location
+	if address.Location != nil {
+		location = &orderGRPC.Location{
+			Latitude:  address.Location.Latitude,
+			Longitude: address.Location.Longitude,
+		}
+	}
+
+	return &orderGRPC.Address{
+		Country:      address.Country,
+		City:         address.City,
+		Street:       address.Street,
+		House:        address.House,
+		Apartment:    address.Apartment,
+		PostalCode:   address.PostalCode,
+		Location:     location,
+		Instructions: address.Instructions,
+	}
+}
+
 func toOrderItem(item orderDto.ItemDto) *orderGRPC.OrderItem {
 	return &orderGRPC.OrderItem{
 		ProductId: item.ProductID.String(),
-		Price:     item.Price.InexactFloat64(),
+		Price:     toMoney(item.Price),
 		Count:     int32(item.Count),
 	}
 }
@@ -26,36 +60,302 @@ func toOrderItems(items []orderDto.ItemDto) []*orderGRPC.OrderItem {
 
 func toCreateRequest(data orderClient.CreateDto) *orderGRPC.CreateOrderRequest {
 	return &orderGRPC.CreateOrderRequest{
-		CustomerId: data.CustomerID.String(),
-		Address:    data.Address,
-		Items:      toOrderItems(data.Items),
+		CustomerId:        data.CustomerID.String(),
+		Address:           toAddress(data.Address),
+		Items:             toOrderItems(data.Items),
+		PromoCode:         data.PromoCode,
+		DeliverySlot:      toProtoDeliverySlot(data.Slot),
+		FulfillmentPolicy: toProtoFulfillmentPolicy(data.Fulfillment),
 	}
 }
 
-func toCancelByCustomerRequest(orderID uuid.UUID) *orderGRPC.CancelOrderByCustomerRequest {
+func toProtoDeliverySlot(slot *orderDto.DeliverySlotDto) *orderGRPC.DeliverySlot {
+	if slot == nil {
+		return nil
+	}
+	return &orderGRPC.DeliverySlot{
+		Start: timestamppb.New(slot.Start),
+		End:   timestamppb.New(slot.End),
+	}
+}
+
+func toCancelByCustomerRequest(orderID uuid.UUID, customerID uuid.UUID, reason string) *orderGRPC.CancelOrderByCustomerRequest {
 	return &orderGRPC.CancelOrderByCustomerRequest{
-		OrderId: orderID.String(),
+		OrderId:    orderID.String(),
+		CustomerId: customerID.String(),
+		Reason:     reason,
 	}
 }
 
-func toCompleteDeliveryRequest(orderID uuid.UUID) *orderGRPC.CompleteDeliveryRequest {
+func toCompleteDeliveryRequest(orderID uuid.UUID, courierID uuid.UUID) *orderGRPC.CompleteDeliveryRequest {
 	return &orderGRPC.CompleteDeliveryRequest{
-		OrderId: orderID.String(),
+		OrderId:   orderID.String(),
+		CourierId: courierID.String(),
+	}
+}
+
+func toProtoStatus(status orderDto.Status) orderGRPC.OrderStatus {
+	switch status {
+	case orderDto.CanceledCourierNotFound:
+		return orderGRPC.OrderStatus_CANCELED_COURIER_NOT_FOUND
+	case orderDto.CanceledOutOfStock:
+		return orderGRPC.OrderStatus_CANCELED_OUT_OF_STOCK
+	case orderDto.Delivering:
+		return orderGRPC.OrderStatus_DELIVERING
+	case orderDto.Delivered:
+		return orderGRPC.OrderStatus_DELIVERED
+	case orderDto.CustomerCanceled:
+		return orderGRPC.OrderStatus_CUSTOMER_CANCELED
+	case orderDto.CanceledTimeout:
+		return orderGRPC.OrderStatus_CANCELED_TIMEOUT
+	case orderDto.Canceling:
+		return orderGRPC.OrderStatus_CANCELING
+	case orderDto.CanceledByAdmin:
+		return orderGRPC.OrderStatus_CANCELED_BY_ADMIN
+	default:
+		return orderGRPC.OrderStatus_CREATED
+	}
+}
+
+func toProtoStatuses(statuses []orderDto.Status) []orderGRPC.OrderStatus {
+	protoStatuses := make([]orderGRPC.OrderStatus, 0, len(statuses))
+	for _, status := range statuses {
+		protoStatuses = append(protoStatuses, toProtoStatus(status))
+	}
+	return protoStatuses
+}
+
+func toProtoFulfillmentPolicy(policy orderDto.FulfillmentPolicy) orderGRPC.FulfillmentPolicy {
+	if policy == orderDto.AllowPartial {
+		return orderGRPC.FulfillmentPolicy_ALLOW_PARTIAL
+	}
+	return orderGRPC.FulfillmentPolicy_ALL_OR_NOTHING
+}
+
+func toProtoSort(sort orderDto.Sort) orderGRPC.OrderSort {
+	if sort == orderDto.OldestFirst {
+		return orderGRPC.OrderSort_OLDEST_FIRST
 	}
+	return orderGRPC.OrderSort_NEWEST_FIRST
 }
 
-func toGetByCustomerRequest(customerID uuid.UUID, limit int, offset int) *orderGRPC.GetOrdersByCustomerRequest {
+func toProtoTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func toGetByCustomerRequest(customerID uuid.UUID, query orderDto.ListQueryDto) *orderGRPC.GetOrdersByCustomerRequest {
 	return &orderGRPC.GetOrdersByCustomerRequest{
-		CustomerId: customerID.String(),
-		Limit:      int32(limit),
-		Offset:     int32(offset),
+		CustomerId:  customerID.String(),
+		Limit:       int32(query.Limit),
+		Cursor:      query.Cursor,
+		Statuses:    toProtoStatuses(query.Statuses),
+		CreatedFrom: toProtoTimestamp(query.CreatedFrom),
+		CreatedTo:   toProtoTimestamp(query.CreatedTo),
+		Sort:        toProtoSort(query.Sort),
 	}
 }
 
-func toGetCurrentByCourierRequest(courierID uuid.UUID, limit int, offset int) *orderGRPC.GetCurrentOrdersByCourierRequest {
+func toGetCurrentByCourierRequest(courierID uuid.UUID) *orderGRPC.GetCurrentOrdersByCourierRequest {
 	return &orderGRPC.GetCurrentOrdersByCourierRequest{
 		CourierId: courierID.String(),
-		Limit:     int32(limit),
-		Offset:    int32(offset),
+	}
+}
+
+func toGetCourierOrderHistoryRequest(courierID uuid.UUID, query orderDto.ListQueryDto) *orderGRPC.GetCourierOrderHistoryRequest {
+	return &orderGRPC.GetCourierOrderHistoryRequest{
+		CourierId:   courierID.String(),
+		Limit:       int32(query.Limit),
+		Cursor:      query.Cursor,
+		Statuses:    toProtoStatuses(query.Statuses),
+		CreatedFrom: toProtoTimestamp(query.CreatedFrom),
+		CreatedTo:   toProtoTimestamp(query.CreatedTo),
+		Sort:        toProtoSort(query.Sort),
+	}
+}
+
+func toGetSagaStateRequest(orderID uuid.UUID) *orderGRPC.GetSagaStateRequest {
+	return &orderGRPC.GetSagaStateRequest{
+		OrderId: orderID.String(),
+	}
+}
+
+func toProtoOptionalMoney(money *moneyDto.MoneyDto) *orderGRPC.Money {
+	if money == nil {
+		return nil
+	}
+	return toMoney(*money)
+}
+
+func toProtoPromotionKind(kind orderDto.PromotionKind) orderGRPC.PromotionKind {
+	if kind == orderDto.FixedAmount {
+		return orderGRPC.PromotionKind_FIXED_AMOUNT
+	}
+	return orderGRPC.PromotionKind_PERCENTAGE
+}
+
+func toProtoPromotionRules(rules orderDto.PromotionRulesDto) *orderGRPC.PromotionRules {
+	productIDs := make([]string, 0, len(rules.ProductIDs))
+	for _, productID := range rules.ProductIDs {
+		productIDs = append(productIDs, productID.String())
+	}
+
+	return &orderGRPC.PromotionRules{
+		Kind:             toProtoPromotionKind(rules.Kind),
+		Percent:          int32(rules.Percent),
+		Amount:           toProtoOptionalMoney(rules.Amount),
+		MinOrderValue:    toProtoOptionalMoney(rules.MinOrderValue),
+		PerCustomerLimit: int32(rules.PerCustomerLimit),
+		ValidFrom:        toProtoTimestamp(rules.ValidFrom),
+		ValidTo:          toProtoTimestamp(rules.ValidTo),
+		ProductIds:       productIDs,
+	}
+}
+
+func toCreatePromotionRequest(data orderDto.CreatePromotionDto) *orderGRPC.CreatePromotionRequest {
+	return &orderGRPC.CreatePromotionRequest{
+		Code:  data.Code,
+		Rules: toProtoPromotionRules(data.Rules),
+	}
+}
+
+func toGetPromotionsRequest(activeOnly bool) *orderGRPC.GetPromotionsRequest {
+	return &orderGRPC.GetPromotionsRequest{
+		ActiveOnly: activeOnly,
+	}
+}
+
+func toDeactivatePromotionRequest(promotionID uuid.UUID) *orderGRPC.DeactivatePromotionRequest {
+	return &orderGRPC.DeactivatePromotionRequest{
+		PromotionId: promotionID.String(),
+	}
+}
+
+func toProtoActorType(actorType orderDto.ActorType) orderGRPC.ActorType {
+	switch actorType {
+	case orderDto.CustomerActor:
+		return orderGRPC.ActorType_CUSTOMER
+	case orderDto.CourierActor:
+		return orderGRPC.ActorType_COURIER
+	case orderDto.AdminActor:
+		return orderGRPC.ActorType_ADMIN
+	default:
+		return orderGRPC.ActorType_SYSTEM
+	}
+}
+
+func toProtoActor(actor orderDto.ActorDto) *orderGRPC.Actor {
+	var actorID *string
+	if actor.ID != nil {
+		id := actor.ID.String()
+		actorID = &id
+	}
+
+	return &orderGRPC.Actor{
+		Type: toProtoActorType(actor.Type),
+		Id:   actorID,
+	}
+}
+
+func toGetOrderRequest(orderID uuid.UUID, requester orderDto.ActorDto) *orderGRPC.GetOrderRequest {
+	return &orderGRPC.GetOrderRequest{
+		OrderId:   orderID.String(),
+		Requester: toProtoActor(requester),
+	}
+}
+
+func toGetOrderHistoryRequest(orderID uuid.UUID, requester orderDto.ActorDto) *orderGRPC.GetOrderHistoryRequest {
+	return &orderGRPC.GetOrderHistoryRequest{
+		OrderId:   orderID.String(),
+		Requester: toProtoActor(requester),
+	}
+}
+
+func toOptionalID(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	value := id.String()
+	return &value
+}
+
+func toSearchRequest(filter orderDto.SearchFilterDto, query orderDto.ListQueryDto) *orderGRPC.SearchOrdersRequest {
+	return &orderGRPC.SearchOrdersRequest{
+		CustomerId:  toOptionalID(filter.CustomerID),
+		CourierId:   toOptionalID(filter.CourierID),
+		ProductId:   toOptionalID(filter.ProductID),
+		Limit:       int32(query.Limit),
+		Cursor:      query.Cursor,
+		Statuses:    toProtoStatuses(query.Statuses),
+		CreatedFrom: toProtoTimestamp(query.CreatedFrom),
+		CreatedTo:   toProtoTimestamp(query.CreatedTo),
+		Sort:        toProtoSort(query.Sort),
+	}
+}
+
+func toForceCancelRequest(orderID uuid.UUID, reason string) *orderGRPC.ForceCancelOrderRequest {
+	return &orderGRPC.ForceCancelOrderRequest{
+		OrderId: orderID.String(),
+		Reason:  reason,
+	}
+}
+
+func toProtoSagaType(sagaType orderDto.SagaType) orderGRPC.SagaType {
+	if sagaType == orderDto.CancelOrderSaga {
+		return orderGRPC.SagaType_CANCEL_ORDER
+	}
+	return orderGRPC.SagaType_CREATE_ORDER
+}
+
+func toRetrySagaStepRequest(orderID uuid.UUID, sagaType orderDto.SagaType) *orderGRPC.RetrySagaStepRequest {
+	return &orderGRPC.RetrySagaStepRequest{
+		OrderId: orderID.String(),
+		Type:    toProtoSagaType(sagaType),
+	}
+}
+
+func toReassignCourierRequest(orderID uuid.UUID, courierID uuid.UUID, reason string) *orderGRPC.ReassignCourierRequest {
+	return &orderGRPC.ReassignCourierRequest{
+		OrderId:   orderID.String(),
+		CourierId: courierID.String(),
+		Reason:    reason,
+	}
+}
+
+func toProtoGranularity(granularity orderDto.Granularity) orderGRPC.RevenueGranularity {
+	if granularity == orderDto.WeekGranularity {
+		return orderGRPC.RevenueGranularity_WEEK
+	}
+	return orderGRPC.RevenueGranularity_DAY
+}
+
+func toGetStatusFunnelRequest(period orderDto.PeriodDto) *orderGRPC.GetStatusFunnelRequest {
+	return &orderGRPC.GetStatusFunnelRequest{
+		CreatedFrom: toProtoTimestamp(period.From),
+		CreatedTo:   toProtoTimestamp(period.To),
+	}
+}
+
+func toGetRevenueRequest(period orderDto.PeriodDto, granularity orderDto.Granularity) *orderGRPC.GetRevenueRequest {
+	return &orderGRPC.GetRevenueRequest{
+		CreatedFrom: toProtoTimestamp(period.From),
+		CreatedTo:   toProtoTimestamp(period.To),
+		Granularity: toProtoGranularity(granularity),
+	}
+}
+
+func toGetDeliveryTimeRequest(period orderDto.PeriodDto) *orderGRPC.GetDeliveryTimeRequest {
+	return &orderGRPC.GetDeliveryTimeRequest{
+		CreatedFrom: toProtoTimestamp(period.From),
+		CreatedTo:   toProtoTimestamp(period.To),
+	}
+}
+
+func toGetCancellationReasonsRequest(period orderDto.PeriodDto) *orderGRPC.GetCancellationReasonsRequest {
+	return &orderGRPC.GetCancellationReasonsRequest{
+		CreatedFrom: toProtoTimestamp(period.From),
+		CreatedTo:   toProtoTimestamp(period.To),
 	}
 }
@@ -3,7 +3,12 @@ package order
 import (
 	orderGRPC "api-gateway/gen/order/v1"
 	"api-gateway/internal/adapter/output/clients/response"
+	moneyDto "api-gateway/internal/domain/dtos/money"
 	orderDto "api-gateway/internal/domain/dtos/order"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func toOrders(protoOrders []*orderGRPC.Order) ([]*orderDto.OrderDto, error) {
@@ -18,6 +23,39 @@ func toOrders(protoOrders []*orderGRPC.Order) ([]*orderDto.OrderDto, error) {
 	return orders, nil
 }
 
+func toOrdersPage(protoOrders []*orderGRPC.Order, nextCursor string) (*orderDto.OrdersPageDto, error) {
+	orders, err := toOrders(protoOrders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderDto.OrdersPageDto{
+		Orders:     orders,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func toCourierHistory(out *orderGRPC.GetCourierOrderHistoryResponse) (*orderDto.CourierHistoryDto, error) {
+	orders, err := toOrders(out.Orders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderDto.CourierHistoryDto{
+		Orders:     orders,
+		NextCursor: out.NextCursor,
+		Counts:     toStatusCounts(out.Counts),
+	}, nil
+}
+
+func toStatusCounts(protoCounts []*orderGRPC.OrderStatusCount) map[orderDto.Status]int {
+	counts := make(map[orderDto.Status]int, len(protoCounts))
+	for _, count := range protoCounts {
+		counts[toOrderStatus(count.Status)] = int(count.Count)
+	}
+	return counts
+}
+
 func toItem(protoItem *orderGRPC.OrderItem) (orderDto.ItemDto, error) {
 	productId, err := response.ToUUID(protoItem.ProductId)
 	if err != nil {
@@ -26,8 +64,11 @@ func toItem(protoItem *orderGRPC.OrderItem) (orderDto.ItemDto, error) {
 
 	return orderDto.ItemDto{
 		ProductID: productId,
-		Price:     response.ToDecimal(protoItem.Price),
+		Name:      protoItem.Name,
+		Price:     response.ToMoney(protoItem.Price),
 		Count:     int(protoItem.Count),
+		LineTotal: response.ToMoney(protoItem.LineTotal),
+		Requested: int(protoItem.RequestedCount),
 	}, nil
 }
 
@@ -54,16 +95,74 @@ func toDelivery(protoDelivery *orderGRPC.Delivery) (orderDto.DeliveryDto, error)
 		deliveryDto.CourierID = &courierId
 	}
 
+	if protoDelivery.Assigned != nil {
+		t := protoDelivery.Assigned.AsTime()
+		deliveryDto.Assigned = &t
+	}
+
 	if protoDelivery.Arrived != nil {
 		t := protoDelivery.Arrived.AsTime()
 		deliveryDto.Arrived = &t
 	}
 
-	deliveryDto.Address = protoDelivery.Address
+	deliveryDto.Address = toAddressDto(protoDelivery.Address)
+	deliveryDto.Slot = toDeliverySlot(protoDelivery.Slot)
 
 	return deliveryDto, nil
 }
 
+func toDeliverySlot(protoSlot *orderGRPC.DeliverySlot) *orderDto.DeliverySlotDto {
+	if protoSlot == nil {
+		return nil
+	}
+
+	return &orderDto.DeliverySlotDto{
+		Start: protoSlot.Start.AsTime(),
+		End:   protoSlot.End.AsTime(),
+	}
+}
+
+func toSlotAvailabilities(protoSlots []*orderGRPC.SlotAvailability) []*orderDto.SlotAvailabilityDto {
+	slots := make([]*orderDto.SlotAvailabilityDto, 0, len(protoSlots))
+	for _, protoSlot := range protoSlots {
+		slots = append(slots, &orderDto.SlotAvailabilityDto{
+			Slot: orderDto.DeliverySlotDto{
+				Start: protoSlot.GetSlot().GetStart().AsTime(),
+				End:   protoSlot.GetSlot().GetEnd().AsTime(),
+			},
+			Capacity:  int(protoSlot.Capacity),
+			Remaining: int(protoSlot.Remaining),
+		})
+	}
+	return slots
+}
+
+func toAddressDto(protoAddress *orderGRPC.Address) orderDto.AddressDto {
+	if protoAddress == nil {
+		return orderDto.AddressDto{}
+	}
+
+	var location *orderDto.LocationDto
+	if protoAddress.Location != nil {
+		location = &orderDto.LocationDto{
+			Latitude:  protoAddress.Location.Latitude,
+			Longitude: protoAddress.Location.Longitude,
+		}
+	}
+
+	return orderDto.AddressDto{
+		Country:      protoAddress.Country,
+		City:         protoAddress.City,
+		Street:       protoAddress.Street,
+		House:        protoAddress.House,
+		Apartment:    protoAddress.Apartment,
+		PostalCode:   protoAddress.PostalCode,
+		Location:     location,
+		Instructions: protoAddress.Instructions,
+		Legacy:       protoAddress.Legacy,
+	}
+}
+
 func toOrder(protoOrder *orderGRPC.Order) (*orderDto.OrderDto, error) {
 	orderID, err := response.ToUUID(protoOrder.OrderId)
 	if err != nil {
@@ -91,16 +190,39 @@ func toOrder(protoOrder *orderGRPC.Order) (*orderDto.OrderDto, error) {
 	}
 
 	return &orderDto.OrderDto{
-		ID:         orderID,
-		CustomerID: customerID,
-		Status:     toOrderStatus(protoOrder.Status),
-		Created:    protoOrder.Created.AsTime(),
-		Version:    versionID,
-		Delivery:   delivery,
-		Items:      items,
+		ID:           orderID,
+		CustomerID:   customerID,
+		Status:       toOrderStatus(protoOrder.Status),
+		Created:      protoOrder.Created.AsTime(),
+		Version:      versionID,
+		Delivery:     delivery,
+		Items:        items,
+		CancelReason: protoOrder.CancelReason,
+		Subtotal:     response.ToMoney(protoOrder.Subtotal),
+		Discount:     toDiscount(protoOrder.Discount),
+		Total:        response.ToMoney(protoOrder.Total),
+		Fulfillment:  toFulfillmentPolicy(protoOrder.FulfillmentPolicy),
 	}, nil
 }
 
+func toDiscount(protoDiscount *orderGRPC.Discount) *orderDto.DiscountDto {
+	if protoDiscount == nil {
+		return nil
+	}
+
+	return &orderDto.DiscountDto{
+		PromoCode: protoDiscount.PromoCode,
+		Amount:    response.ToMoney(protoDiscount.Amount),
+	}
+}
+
+func toFulfillmentPolicy(protoPolicy orderGRPC.FulfillmentPolicy) orderDto.FulfillmentPolicy {
+	if protoPolicy == orderGRPC.FulfillmentPolicy_ALLOW_PARTIAL {
+		return orderDto.AllowPartial
+	}
+	return orderDto.AllOrNothing
+}
+
 func toOrderStatus(protoStatus orderGRPC.OrderStatus) orderDto.Status {
 	switch protoStatus {
 	case orderGRPC.OrderStatus_CREATED:
@@ -115,7 +237,317 @@ func toOrderStatus(protoStatus orderGRPC.OrderStatus) orderDto.Status {
 		return orderDto.Delivered
 	case orderGRPC.OrderStatus_CUSTOMER_CANCELED:
 		return orderDto.CustomerCanceled
+	case orderGRPC.OrderStatus_CANCELED_TIMEOUT:
+		return orderDto.CanceledTimeout
+	case orderGRPC.OrderStatus_CANCELING:
+		return orderDto.Canceling
+	case orderGRPC.OrderStatus_CANCELED_BY_ADMIN:
+		return orderDto.CanceledByAdmin
 	default:
 		return orderDto.Created
 	}
 }
+
+func toSagas(protoSagas []*orderGRPC.Saga) ([]*orderDto.SagaDto, error) {
+	sagas := make([]*orderDto.SagaDto, 0, len(protoSagas))
+	for _, protoSaga := range protoSagas {
+		saga, err := toSaga(protoSaga)
+		if err != nil {
+			return nil, err
+		}
+		sagas = append(sagas, saga)
+	}
+	return sagas, nil
+}
+
+func toSaga(protoSaga *orderGRPC.Saga) (*orderDto.SagaDto, error) {
+	sagaID, err := response.ToUUID(protoSaga.SagaId)
+	if err != nil {
+		return nil, err
+	}
+
+	orderID, err := response.ToUUID(protoSaga.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]orderDto.SagaStepDto, 0, len(protoSaga.History))
+	for _, protoRecord := range protoSaga.History {
+		history = append(history, orderDto.SagaStepDto{
+			Step:    toSagaStep(protoRecord.Step),
+			Entered: protoRecord.Entered.AsTime(),
+			Retry:   protoRecord.Retry,
+		})
+	}
+
+	var lastError *orderDto.SagaFailureDto
+	if protoSaga.LastError != nil {
+		lastError = &orderDto.SagaFailureDto{
+			Step:     toSagaStep(protoSaga.LastError.Step),
+			Message:  protoSaga.LastError.Message,
+			Occurred: protoSaga.LastError.Occurred.AsTime(),
+		}
+	}
+
+	return &orderDto.SagaDto{
+		ID:        sagaID,
+		OrderID:   orderID,
+		Type:      toSagaType(protoSaga.Type),
+		Step:      toSagaStep(protoSaga.Step),
+		History:   history,
+		LastError: lastError,
+		Created:   protoSaga.Created.AsTime(),
+		Updated:   protoSaga.Updated.AsTime(),
+		ResumeAt:  toOptionalTime(protoSaga.ResumeAt),
+	}, nil
+}
+
+func toSagaType(protoType orderGRPC.SagaType) orderDto.SagaType {
+	switch protoType {
+	case orderGRPC.SagaType_CREATE_ORDER:
+		return orderDto.CreateOrderSaga
+	case orderGRPC.SagaType_CANCEL_ORDER:
+		return orderDto.CancelOrderSaga
+	default:
+		return orderDto.CreateOrderSaga
+	}
+}
+
+func toSagaStep(protoStep orderGRPC.SagaStep) orderDto.SagaStep {
+	switch protoStep {
+	case orderGRPC.SagaStep_RESERVING_ITEMS:
+		return orderDto.ReservingItems
+	case orderGRPC.SagaStep_ASSIGNING_COURIER:
+		return orderDto.AssigningCourier
+	case orderGRPC.SagaStep_BEGINNING_DELIVERY:
+		return orderDto.BeginningDelivery
+	case orderGRPC.SagaStep_CANCELING_OUT_OF_STOCK:
+		return orderDto.CancelingOutOfStock
+	case orderGRPC.SagaStep_RELEASING_ITEMS:
+		return orderDto.ReleasingItems
+	case orderGRPC.SagaStep_CANCELING_COURIER_NOT_FOUND:
+		return orderDto.CancelingCourierNotFound
+	case orderGRPC.SagaStep_RELEASING_ITEMS_ON_TIMEOUT:
+		return orderDto.ReleasingItemsOnTimeout
+	case orderGRPC.SagaStep_CANCELING_TIMEOUT:
+		return orderDto.CancelingTimeout
+	case orderGRPC.SagaStep_SUPERSEDED_BY_CANCEL:
+		return orderDto.SupersededByCancel
+	case orderGRPC.SagaStep_RELEASING_ITEMS_AND_COURIER:
+		return orderDto.ReleasingItemsAndCourier
+	case orderGRPC.SagaStep_AWAITING_COURIER_RELEASE:
+		return orderDto.AwaitingCourierRelease
+	case orderGRPC.SagaStep_AWAITING_ITEMS_RELEASE:
+		return orderDto.AwaitingItemsRelease
+	case orderGRPC.SagaStep_CANCELING_BY_CUSTOMER:
+		return orderDto.CancelingByCustomer
+	case orderGRPC.SagaStep_AWAITING_DELIVERY_SLOT:
+		return orderDto.AwaitingDeliverySlot
+	default:
+		return orderDto.ReservingItems
+	}
+}
+
+func toPromotions(protoPromotions []*orderGRPC.Promotion) ([]*orderDto.PromotionDto, error) {
+	promotions := make([]*orderDto.PromotionDto, 0, len(protoPromotions))
+	for _, protoPromotion := range protoPromotions {
+		promotion, err := toPromotion(protoPromotion)
+		if err != nil {
+			return nil, err
+		}
+		promotions = append(promotions, promotion)
+	}
+	return promotions, nil
+}
+
+func toPromotion(protoPromotion *orderGRPC.Promotion) (*orderDto.PromotionDto, error) {
+	promotionID, err := response.ToUUID(protoPromotion.PromotionId)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := toPromotionRules(protoPromotion.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderDto.PromotionDto{
+		ID:      promotionID,
+		Code:    protoPromotion.Code,
+		Rules:   rules,
+		Active:  protoPromotion.Active,
+		Created: protoPromotion.Created.AsTime(),
+	}, nil
+}
+
+func toPromotionRules(protoRules *orderGRPC.PromotionRules) (orderDto.PromotionRulesDto, error) {
+	productIDs := make([]uuid.UUID, 0, len(protoRules.GetProductIds()))
+	for _, protoProductID := range protoRules.GetProductIds() {
+		productID, err := response.ToUUID(protoProductID)
+		if err != nil {
+			return orderDto.PromotionRulesDto{}, err
+		}
+		productIDs = append(productIDs, productID)
+	}
+
+	return orderDto.PromotionRulesDto{
+		Kind:             toPromotionKind(protoRules.GetKind()),
+		Percent:          int(protoRules.GetPercent()),
+		Amount:           toOptionalMoney(protoRules.GetAmount()),
+		MinOrderValue:    toOptionalMoney(protoRules.GetMinOrderValue()),
+		PerCustomerLimit: int(protoRules.GetPerCustomerLimit()),
+		ValidFrom:        toOptionalTime(protoRules.GetValidFrom()),
+		ValidTo:          toOptionalTime(protoRules.GetValidTo()),
+		ProductIDs:       productIDs,
+	}, nil
+}
+
+func toPromotionKind(protoKind orderGRPC.PromotionKind) orderDto.PromotionKind {
+	if protoKind == orderGRPC.PromotionKind_FIXED_AMOUNT {
+		return orderDto.FixedAmount
+	}
+	return orderDto.Percentage
+}
+
+func toOptionalMoney(protoMoney *orderGRPC.Money) *moneyDto.MoneyDto {
+	if protoMoney == nil {
+		return nil
+	}
+	money := response.ToMoney(protoMoney)
+	return &money
+}
+
+func toOptionalTime(protoTime *timestamppb.Timestamp) *time.Time {
+	if protoTime == nil {
+		return nil
+	}
+	t := protoTime.AsTime()
+	return &t
+}
+
+func toStatusChanges(protoChanges []*orderGRPC.StatusChange) ([]*orderDto.StatusChangeDto, error) {
+	changes := make([]*orderDto.StatusChangeDto, 0, len(protoChanges))
+	for _, protoChange := range protoChanges {
+		change, err := toStatusChange(protoChange)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func toStatusChange(protoChange *orderGRPC.StatusChange) (*orderDto.StatusChangeDto, error) {
+	var from *orderDto.Status
+	if protoChange.From != nil {
+		status := toOrderStatus(*protoChange.From)
+		from = &status
+	}
+
+	actor, err := toActor(protoChange.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	messageID, err := toOptionalUUID(protoChange.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderDto.StatusChangeDto{
+		From:      from,
+		To:        toOrderStatus(protoChange.To),
+		Occurred:  protoChange.Occurred.AsTime(),
+		Actor:     actor,
+		Reason:    protoChange.Reason,
+		MessageID: messageID,
+	}, nil
+}
+
+func toActor(protoActor *orderGRPC.Actor) (orderDto.ActorDto, error) {
+	id, err := toOptionalUUID(protoActor.Id)
+	if err != nil {
+		return orderDto.ActorDto{}, err
+	}
+
+	return orderDto.ActorDto{
+		Type: toActorType(protoActor.GetType()),
+		ID:   id,
+	}, nil
+}
+
+func toActorType(protoType orderGRPC.ActorType) orderDto.ActorType {
+	switch protoType {
+	case orderGRPC.ActorType_CUSTOMER:
+		return orderDto.CustomerActor
+	case orderGRPC.ActorType_COURIER:
+		return orderDto.CourierActor
+	case orderGRPC.ActorType_ADMIN:
+		return orderDto.AdminActor
+	default:
+		return orderDto.SystemActor
+	}
+}
+
+func toOptionalUUID(protoID *string) (*uuid.UUID, error) {
+	if protoID == nil {
+		return nil, nil
+	}
+	id, err := response.ToUUID(*protoID)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func toStatusFunnel(out *orderGRPC.GetStatusFunnelResponse) *orderDto.StatusFunnelDto {
+	return &orderDto.StatusFunnelDto{
+		Total:   int(out.Total),
+		Current: toStatusCounts(out.Current),
+		Reached: toStatusCounts(out.Reached),
+	}
+}
+
+func toRevenueBuckets(protoBuckets []*orderGRPC.RevenueBucket) []*orderDto.RevenueBucketDto {
+	buckets := make([]*orderDto.RevenueBucketDto, 0, len(protoBuckets))
+	for _, protoBucket := range protoBuckets {
+		buckets = append(buckets, &orderDto.RevenueBucketDto{
+			Start:   protoBucket.GetStart().AsTime(),
+			Revenue: response.ToMoney(protoBucket.Revenue),
+			Orders:  int(protoBucket.Orders),
+		})
+	}
+	return buckets
+}
+
+func toDeliveryTime(out *orderGRPC.GetDeliveryTimeResponse) *orderDto.DeliveryTimeDto {
+	return &orderDto.DeliveryTimeDto{
+		Orders:  int(out.Orders),
+		Average: out.GetAverage().AsDuration(),
+	}
+}
+
+func toCancelReason(protoReason orderGRPC.CancelReason) orderDto.CancelReason {
+	switch protoReason {
+	case orderGRPC.CancelReason_CANCEL_REASON_CUSTOMER:
+		return orderDto.CancelReasonCustomer
+	case orderGRPC.CancelReason_CANCEL_REASON_OUT_OF_STOCK:
+		return orderDto.CancelReasonOutOfStock
+	case orderGRPC.CancelReason_CANCEL_REASON_COURIER_NOT_FOUND:
+		return orderDto.CancelReasonCourierNotFound
+	case orderGRPC.CancelReason_CANCEL_REASON_TIMEOUT:
+		return orderDto.CancelReasonTimeout
+	case orderGRPC.CancelReason_CANCEL_REASON_ADMIN:
+		return orderDto.CancelReasonAdmin
+	default:
+		return orderDto.CancelReasonCustomer
+	}
+}
+
+func toCancellationReasons(protoReasons []*orderGRPC.CancelReasonCount) map[orderDto.CancelReason]int {
+	reasons := make(map[orderDto.CancelReason]int, len(protoReasons))
+	for _, count := range protoReasons {
+		reasons[toCancelReason(count.Reason)] = int(count.Count)
+	}
+	return reasons
+}
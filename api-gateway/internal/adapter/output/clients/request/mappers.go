@@ -0,0 +1,13 @@
+package request
+
+import "github.com/shopspring/decimal"
+
+const nanosScale = 9
+
+// ToUnitsAndNanos splits an amount into the whole units and billionths used by
+// the Money message. Digits past the ninth decimal place are dropped.
+func ToUnitsAndNanos(amount decimal.Decimal) (int64, int32) {
+	units := amount.Truncate(0)
+	nanos := amount.Sub(units).Shift(nanosScale).IntPart()
+	return units.IntPart(), int32(nanos)
+}
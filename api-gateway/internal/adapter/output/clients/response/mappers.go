@@ -1,6 +1,8 @@
 package response
 
 import (
+	moneyDto "api-gateway/internal/domain/dtos/money"
+
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
@@ -13,6 +15,16 @@ func ToUUID(s string) (uuid.UUID, error) {
 	return id, nil
 }
 
-func ToDecimal(key float64) decimal.Decimal {
-	return decimal.NewFromFloat(key)
+// protoMoney is implemented by the Money message of every service proto.
+type protoMoney interface {
+	GetCurrencyCode() string
+	GetUnits() int64
+	GetNanos() int32
+}
+
+func ToMoney(money protoMoney) moneyDto.MoneyDto {
+	return moneyDto.MoneyDto{
+		Amount:   decimal.NewFromInt(money.GetUnits()).Add(decimal.New(int64(money.GetNanos()), -9)),
+		Currency: money.GetCurrencyCode(),
+	}
 }
@@ -62,6 +62,22 @@ func (c *ClientImpl) CreateProduct(ctx context.Context, data warehouseDto.Create
 	return productID, nil
 }
 
+func (c *ClientImpl) GetProducts(ctx context.Context, productIDs []uuid.UUID) ([]*warehouseDto.ProductDto, error) {
+	request := toGetProductsRequest(productIDs)
+
+	resp, err := c.clients.Product.GetProducts(ctx, request)
+	if err != nil {
+		return nil, response.ParseGRPCError(err)
+	}
+
+	products, err := toProducts(resp.Products)
+	if err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
 func (c *ClientImpl) GetAllItems(ctx context.Context, limit int, offset int) ([]*warehouseDto.ItemDto, error) {
 	request := toGetAllItemsRequest(limit, offset)
 
@@ -2,7 +2,11 @@ package warehouse
 
 import (
 	warehouseGRPC "api-gateway/gen/warehouse/v1"
+	"api-gateway/internal/adapter/output/clients/request"
+	moneyDto "api-gateway/internal/domain/dtos/money"
 	warehouseDto "api-gateway/internal/domain/dtos/warehouse"
+
+	"github.com/google/uuid"
 )
 
 func toItemInfo(item warehouseDto.ItemInfoDto) *warehouseGRPC.ItemInfo {
@@ -32,10 +36,19 @@ func toReleaseItemRequest(items []warehouseDto.ItemInfoDto) *warehouseGRPC.Relea
 	}
 }
 
+func toMoney(money moneyDto.MoneyDto) *warehouseGRPC.Money {
+	units, nanos := request.ToUnitsAndNanos(money.Amount)
+	return &warehouseGRPC.Money{
+		CurrencyCode: money.Currency,
+		Units:        units,
+		Nanos:        nanos,
+	}
+}
+
 func toCreateProductRequest(data warehouseDto.CreateProductDto) *warehouseGRPC.CreateProductRequest {
 	return &warehouseGRPC.CreateProductRequest{
 		Name:  data.Name,
-		Price: data.Price.InexactFloat64(),
+		Price: toMoney(data.Price),
 	}
 }
 
@@ -45,3 +58,13 @@ func toGetAllItemsRequest(limit int, offset int) *warehouseGRPC.GetAllItemsReque
 		Offset: int32(offset),
 	}
 }
+
+func toGetProductsRequest(productIDs []uuid.UUID) *warehouseGRPC.GetProductsRequest {
+	ids := make([]string, 0, len(productIDs))
+	for _, productID := range productIDs {
+		ids = append(ids, productID.String())
+	}
+	return &warehouseGRPC.GetProductsRequest{
+		ProductIds: ids,
+	}
+}
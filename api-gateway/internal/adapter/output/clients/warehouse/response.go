@@ -42,6 +42,18 @@ func toItem(protoItem *warehouseGRPC.Item) (*warehouseDto.ItemDto, error) {
 	}, nil
 }
 
+func toProducts(protoProducts []*warehouseGRPC.Product) ([]*warehouseDto.ProductDto, error) {
+	products := make([]*warehouseDto.ProductDto, 0, len(protoProducts))
+	for _, protoProduct := range protoProducts {
+		product, err := toProduct(protoProduct)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, product)
+	}
+	return products, nil
+}
+
 func toProduct(protoProduct *warehouseGRPC.Product) (*warehouseDto.ProductDto, error) {
 	productID, err := response.ToUUID(protoProduct.ProductId)
 	if err != nil {
@@ -51,7 +63,7 @@ func toProduct(protoProduct *warehouseGRPC.Product) (*warehouseDto.ProductDto, e
 	return &warehouseDto.ProductDto{
 		ProductID: productID,
 		Name:      protoProduct.Name,
-		Price:     response.ToDecimal(protoProduct.Price),
+		Price:     response.ToMoney(protoProduct.Price),
 		Created:   protoProduct.Created.AsTime(),
 	}, nil
 }
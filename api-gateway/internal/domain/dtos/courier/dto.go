@@ -1,5 +1,7 @@
 package courier
 
+import "github.com/google/uuid"
+
 type RegisterDto struct {
 	Name     string
 	Password string
@@ -10,3 +12,8 @@ type LoginDto struct {
 	Phone    string
 	Password string
 }
+
+type CourierDto struct {
+	CourierID uuid.UUID
+	Name      string
+}
@@ -0,0 +1,9 @@
+package money
+
+import "github.com/shopspring/decimal"
+
+// MoneyDto is an amount in one ISO 4217 currency.
+type MoneyDto struct {
+	Amount   decimal.Decimal
+	Currency string
+}
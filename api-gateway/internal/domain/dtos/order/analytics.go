@@ -0,0 +1,34 @@
+package order
+
+import (
+	moneyDto "api-gateway/internal/domain/dtos/money"
+	"time"
+)
+
+// PeriodDto selects orders by creation time, [From, To). An unset bound leaves
+// that side open.
+type PeriodDto struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// StatusFunnelDto counts the orders created in a period by their current
+// status and by every status they have been in.
+type StatusFunnelDto struct {
+	Total   int
+	Current map[Status]int
+	Reached map[Status]int
+}
+
+// RevenueBucketDto is the revenue of delivered orders created in one day or
+// week, in a single currency.
+type RevenueBucketDto struct {
+	Start   time.Time
+	Revenue moneyDto.MoneyDto
+	Orders  int
+}
+
+type DeliveryTimeDto struct {
+	Orders  int
+	Average time.Duration
+}
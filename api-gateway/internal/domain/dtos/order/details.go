@@ -0,0 +1,18 @@
+package order
+
+import (
+	courierDto "api-gateway/internal/domain/dtos/courier"
+	warehouseDto "api-gateway/internal/domain/dtos/warehouse"
+
+	"github.com/google/uuid"
+)
+
+// OrderDetailsDto is an order with the catalog entries of its products and the
+// courier delivering it. Products no longer in the catalog are missing from
+// Products; Courier is nil until a courier is assigned. Both are left empty
+// when the service holding them cannot be reached.
+type OrderDetailsDto struct {
+	Order    *OrderDto
+	Products map[uuid.UUID]*warehouseDto.ProductDto
+	Courier  *courierDto.CourierDto
+}
@@ -1,34 +1,126 @@
 package order
 
 import (
-	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
+	moneyDto "api-gateway/internal/domain/dtos/money"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type CreateDto struct {
-	Address string
-	Items   []ItemDto
+	Address   AddressDto
+	Items     []ItemDto
+	PromoCode string
+	Slot      *DeliverySlotDto
+	// Fulfillment is optional; empty means all items or none.
+	Fulfillment FulfillmentPolicy
 }
 
 type OrderDto struct {
-	ID         uuid.UUID
-	CustomerID uuid.UUID
-	Status     Status
-	Created    time.Time
-	Version    uuid.UUID
-	Delivery   DeliveryDto
-	Items      []ItemDto
+	ID           uuid.UUID
+	CustomerID   uuid.UUID
+	Status       Status
+	Created      time.Time
+	Version      uuid.UUID
+	Delivery     DeliveryDto
+	Items        []ItemDto
+	CancelReason string
+	Subtotal     moneyDto.MoneyDto
+	Discount     *DiscountDto
+	Total        moneyDto.MoneyDto
+	Fulfillment  FulfillmentPolicy
+}
+
+type DiscountDto struct {
+	PromoCode string
+	Amount    moneyDto.MoneyDto
+}
+
+type ListQueryDto struct {
+	Statuses    []Status
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Sort        Sort
+	Limit       int
+	Cursor      string
+}
+
+// SearchFilterDto narrows an admin order search; nil fields match any order.
+type SearchFilterDto struct {
+	CustomerID *uuid.UUID
+	CourierID  *uuid.UUID
+	ProductID  *uuid.UUID
+}
+
+type OrdersPageDto struct {
+	Orders     []*OrderDto
+	NextCursor string
+}
+
+type CourierHistoryDto struct {
+	Orders     []*OrderDto
+	NextCursor string
+	Counts     map[Status]int
 }
 
 type ItemDto struct {
 	ProductID uuid.UUID
-	Price     decimal.Decimal
+	Name      string
+	Price     moneyDto.MoneyDto
 	Count     int
+	LineTotal moneyDto.MoneyDto
+	// Requested is the count asked for; Count is less when the order was
+	// partially fulfilled.
+	Requested int
 }
 
 type DeliveryDto struct {
 	CourierID *uuid.UUID
-	Address   string
+	Address   AddressDto
+	Assigned  *time.Time
 	Arrived   *time.Time
+	Slot      *DeliverySlotDto
+}
+
+// AddressDto is a delivery address. Legacy holds the free-form address of orders
+// placed before addresses were structured; the other fields are empty then.
+type AddressDto struct {
+	Country      string
+	City         string
+	Street       string
+	House        string
+	Apartment    string
+	PostalCode   string
+	Location     *LocationDto
+	Instructions string
+	Legacy       string
+}
+
+type LocationDto struct {
+	Latitude  float64
+	Longitude float64
+}
+
+type SagaDto struct {
+	ID        uuid.UUID
+	OrderID   uuid.UUID
+	Type      SagaType
+	Step      SagaStep
+	History   []SagaStepDto
+	LastError *SagaFailureDto
+	Created   time.Time
+	Updated   time.Time
+	ResumeAt  *time.Time
+}
+
+type SagaStepDto struct {
+	Step    SagaStep
+	Entered time.Time
+	Retry   bool
+}
+
+type SagaFailureDto struct {
+	Step     SagaStep
+	Message  string
+	Occurred time.Time
 }
@@ -1,7 +1,16 @@
 package order
 
 type (
-	Status string
+	Status    string
+	SagaType  string
+	Sort      string
+	SagaStep  string
+	ActorType string
+
+	FulfillmentPolicy string
+
+	Granularity  string
+	CancelReason string
 )
 
 const (
@@ -11,4 +20,59 @@ const (
 	Delivering              Status = "delivering"
 	Delivered               Status = "delivered"
 	CustomerCanceled        Status = "customer_canceled"
+	CanceledTimeout         Status = "canceled_timeout"
+	Canceling               Status = "canceling"
+	CanceledByAdmin         Status = "canceled_by_admin"
+)
+
+const (
+	SystemActor   ActorType = "system"
+	CustomerActor ActorType = "customer"
+	CourierActor  ActorType = "courier"
+	AdminActor    ActorType = "admin"
+)
+
+const (
+	AllOrNothing FulfillmentPolicy = "all_or_nothing"
+	AllowPartial FulfillmentPolicy = "allow_partial"
+)
+
+const (
+	NewestFirst Sort = "newest_first"
+	OldestFirst Sort = "oldest_first"
+)
+
+const (
+	CreateOrderSaga SagaType = "create_order"
+	CancelOrderSaga SagaType = "cancel_order"
+)
+
+const (
+	ReservingItems           SagaStep = "reserving_items"
+	AssigningCourier         SagaStep = "assigning_courier"
+	BeginningDelivery        SagaStep = "beginning_delivery"
+	CancelingOutOfStock      SagaStep = "canceling_out_of_stock"
+	ReleasingItems           SagaStep = "releasing_items"
+	CancelingCourierNotFound SagaStep = "canceling_courier_not_found"
+	ReleasingItemsOnTimeout  SagaStep = "releasing_items_on_timeout"
+	CancelingTimeout         SagaStep = "canceling_timeout"
+	SupersededByCancel       SagaStep = "superseded_by_cancel"
+	ReleasingItemsAndCourier SagaStep = "releasing_items_and_courier"
+	AwaitingCourierRelease   SagaStep = "awaiting_courier_release"
+	AwaitingItemsRelease     SagaStep = "awaiting_items_release"
+	CancelingByCustomer      SagaStep = "canceling_by_customer"
+	AwaitingDeliverySlot     SagaStep = "awaiting_delivery_slot"
+)
+
+const (
+	DayGranularity  Granularity = "day"
+	WeekGranularity Granularity = "week"
+)
+
+const (
+	CancelReasonCustomer        CancelReason = "customer"
+	CancelReasonOutOfStock      CancelReason = "out_of_stock"
+	CancelReasonCourierNotFound CancelReason = "courier_not_found"
+	CancelReasonTimeout         CancelReason = "timeout"
+	CancelReasonAdmin           CancelReason = "admin"
 )
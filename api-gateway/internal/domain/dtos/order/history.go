@@ -0,0 +1,25 @@
+package order
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ActorDto is who changed an order status or asks for its history. ID is nil
+// for the system and for admins.
+type ActorDto struct {
+	Type ActorType
+	ID   *uuid.UUID
+}
+
+// StatusChangeDto is one entry of an order status history. From is nil on the
+// entry recording the creation of the order.
+type StatusChangeDto struct {
+	From      *Status
+	To        Status
+	Occurred  time.Time
+	Actor     ActorDto
+	Reason    string
+	MessageID *uuid.UUID
+}
@@ -0,0 +1,39 @@
+package order
+
+import (
+	moneyDto "api-gateway/internal/domain/dtos/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PromotionKind string
+
+const (
+	Percentage  PromotionKind = "percentage"
+	FixedAmount PromotionKind = "fixed_amount"
+)
+
+type PromotionRulesDto struct {
+	Kind             PromotionKind
+	Percent          int
+	Amount           *moneyDto.MoneyDto
+	MinOrderValue    *moneyDto.MoneyDto
+	PerCustomerLimit int
+	ValidFrom        *time.Time
+	ValidTo          *time.Time
+	ProductIDs       []uuid.UUID
+}
+
+type CreatePromotionDto struct {
+	Code  string
+	Rules PromotionRulesDto
+}
+
+type PromotionDto struct {
+	ID      uuid.UUID
+	Code    string
+	Rules   PromotionRulesDto
+	Active  bool
+	Created time.Time
+}
@@ -0,0 +1,15 @@
+package order
+
+import "time"
+
+// DeliverySlotDto is the delivery window [Start, End).
+type DeliverySlotDto struct {
+	Start time.Time
+	End   time.Time
+}
+
+type SlotAvailabilityDto struct {
+	Slot      DeliverySlotDto
+	Capacity  int
+	Remaining int
+}
@@ -1,10 +1,10 @@
 package warehouse
 
 import (
+	moneyDto "api-gateway/internal/domain/dtos/money"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
 )
 
 type ItemInfoDto struct {
@@ -22,11 +22,11 @@ type ItemDto struct {
 type ProductDto struct {
 	ProductID uuid.UUID
 	Name      string
-	Price     decimal.Decimal
+	Price     moneyDto.MoneyDto
 	Created   time.Time
 }
 
 type CreateProductDto struct {
 	Name  string
-	Price decimal.Decimal
+	Price moneyDto.MoneyDto
 }
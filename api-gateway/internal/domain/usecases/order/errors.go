@@ -0,0 +1,10 @@
+package order
+
+import (
+	domainErrors "api-gateway/internal/domain/errors"
+	"net/http"
+)
+
+var (
+	ErrUnauthorized = domainErrors.NewAppError(http.StatusUnauthorized, "unauthorized access", nil)
+)
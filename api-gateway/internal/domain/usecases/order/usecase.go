@@ -8,8 +8,24 @@ import (
 
 type UseCase interface {
 	Create(ctx context.Context, data orderDto.CreateDto, customerToken string) (uuid.UUID, error)
-	CancelByCustomer(ctx context.Context, orderID uuid.UUID, customerToken string) error
+	GetDetails(ctx context.Context, orderID uuid.UUID, bearerToken string, adminToken string) (*orderDto.OrderDetailsDto, error)
+	CancelByCustomer(ctx context.Context, orderID uuid.UUID, reason string, customerToken string) error
 	Complete(ctx context.Context, orderID uuid.UUID, courierToken string) error
-	GetByCustomer(ctx context.Context, limit int, offset int, customerToken string) ([]*orderDto.OrderDto, error)
-	GetCurrentByCourier(ctx context.Context, limit int, offset int, courierToken string) ([]*orderDto.OrderDto, error)
+	GetByCustomer(ctx context.Context, query orderDto.ListQueryDto, customerToken string) (*orderDto.OrdersPageDto, error)
+	GetCurrentByCourier(ctx context.Context, courierToken string) ([]*orderDto.OrderDto, error)
+	GetCourierHistory(ctx context.Context, query orderDto.ListQueryDto, courierToken string) (*orderDto.CourierHistoryDto, error)
+	GetSagaState(ctx context.Context, orderID uuid.UUID, adminToken string) ([]*orderDto.SagaDto, error)
+	CreatePromotion(ctx context.Context, data orderDto.CreatePromotionDto, adminToken string) (uuid.UUID, error)
+	GetPromotions(ctx context.Context, activeOnly bool, adminToken string) ([]*orderDto.PromotionDto, error)
+	DeactivatePromotion(ctx context.Context, promotionID uuid.UUID, adminToken string) error
+	GetAvailableSlots(ctx context.Context) ([]*orderDto.SlotAvailabilityDto, error)
+	GetHistory(ctx context.Context, orderID uuid.UUID, bearerToken string, adminToken string) ([]*orderDto.StatusChangeDto, error)
+	Search(ctx context.Context, filter orderDto.SearchFilterDto, query orderDto.ListQueryDto, adminToken string) (*orderDto.OrdersPageDto, error)
+	ForceCancel(ctx context.Context, orderID uuid.UUID, reason string, adminToken string) error
+	RetrySagaStep(ctx context.Context, orderID uuid.UUID, sagaType orderDto.SagaType, adminToken string) error
+	ReassignCourier(ctx context.Context, orderID uuid.UUID, courierID uuid.UUID, reason string, adminToken string) error
+	GetStatusFunnel(ctx context.Context, period orderDto.PeriodDto, adminToken string) (*orderDto.StatusFunnelDto, error)
+	GetRevenue(ctx context.Context, period orderDto.PeriodDto, granularity orderDto.Granularity, adminToken string) ([]*orderDto.RevenueBucketDto, error)
+	GetDeliveryTime(ctx context.Context, period orderDto.PeriodDto, adminToken string) (*orderDto.DeliveryTimeDto, error)
+	GetCancellationReasons(ctx context.Context, period orderDto.PeriodDto, adminToken string) (map[orderDto.CancelReason]int, error)
 }
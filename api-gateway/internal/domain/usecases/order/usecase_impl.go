@@ -1,30 +1,43 @@
 package order
 
 import (
+	courierDto "api-gateway/internal/domain/dtos/courier"
 	orderDto "api-gateway/internal/domain/dtos/order"
+	warehouseDto "api-gateway/internal/domain/dtos/warehouse"
+	domainErrors "api-gateway/internal/domain/errors"
+	"api-gateway/internal/port/output/auth/admin"
 	courierClient "api-gateway/internal/port/output/clients/courier"
 	customerClient "api-gateway/internal/port/output/clients/customer"
 	orderClient "api-gateway/internal/port/output/clients/order"
+	warehouseClient "api-gateway/internal/port/output/clients/warehouse"
 	"context"
+	"errors"
+	"sync"
 
 	"github.com/google/uuid"
 )
 
 type UseCaseImpl struct {
-	customerClient customerClient.Client
-	courierClient  courierClient.Client
-	orderClient    orderClient.Client
+	adminAuth       admin.Auth
+	customerClient  customerClient.Client
+	courierClient   courierClient.Client
+	orderClient     orderClient.Client
+	warehouseClient warehouseClient.Client
 }
 
 func NewUseCase(
+	adminAuth admin.Auth,
 	customerClient customerClient.Client,
 	courierClient courierClient.Client,
 	orderClient orderClient.Client,
+	warehouseClient warehouseClient.Client,
 ) UseCase {
 	return &UseCaseImpl{
-		customerClient: customerClient,
-		courierClient:  courierClient,
-		orderClient:    orderClient,
+		adminAuth:       adminAuth,
+		customerClient:  customerClient,
+		courierClient:   courierClient,
+		orderClient:     orderClient,
+		warehouseClient: warehouseClient,
 	}
 }
 
@@ -35,9 +48,12 @@ func (u *UseCaseImpl) Create(ctx context.Context, data orderDto.CreateDto, custo
 	}
 
 	dto := orderClient.CreateDto{
-		CustomerID: customerID,
-		Address:    data.Address,
-		Items:      data.Items,
+		CustomerID:  customerID,
+		Address:     data.Address,
+		Items:       data.Items,
+		PromoCode:   data.PromoCode,
+		Slot:        data.Slot,
+		Fulfillment: data.Fulfillment,
 	}
 	orderID, err := u.orderClient.Create(ctx, dto)
 	if err != nil {
@@ -47,13 +63,77 @@ func (u *UseCaseImpl) Create(ctx context.Context, data orderDto.CreateDto, custo
 	return orderID, nil
 }
 
-func (u *UseCaseImpl) CancelByCustomer(ctx context.Context, orderID uuid.UUID, customerToken string) error {
+// GetDetails returns an order together with its products and its courier to the
+// customer, the courier assigned to it or an admin, authenticated as in
+// GetHistory. The warehouse and the courier service are queried concurrently.
+// They only add to the order, which is returned without their details when
+// either of them fails.
+func (u *UseCaseImpl) GetDetails(
+	ctx context.Context,
+	orderID uuid.UUID,
+	bearerToken string,
+	adminToken string,
+) (*orderDto.OrderDetailsDto, error) {
+	requester, err := u.authenticateViewer(ctx, bearerToken, adminToken)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := u.orderClient.GetByID(ctx, orderID, requester)
+	if err != nil {
+		return nil, err
+	}
+
+	productIDs := make([]uuid.UUID, 0, len(order.Items))
+	for _, item := range order.Items {
+		productIDs = append(productIDs, item.ProductID)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		products []*warehouseDto.ProductDto
+		courier  *courierDto.CourierDto
+	)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if found, err := u.warehouseClient.GetProducts(ctx, productIDs); err == nil {
+			products = found
+		}
+	}()
+
+	if order.Delivery.CourierID != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if found, err := u.courierClient.GetByID(ctx, *order.Delivery.CourierID); err == nil {
+				courier = found
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	productsByID := make(map[uuid.UUID]*warehouseDto.ProductDto, len(products))
+	for _, product := range products {
+		productsByID[product.ProductID] = product
+	}
+
+	return &orderDto.OrderDetailsDto{
+		Order:    order,
+		Products: productsByID,
+		Courier:  courier,
+	}, nil
+}
+
+func (u *UseCaseImpl) CancelByCustomer(ctx context.Context, orderID uuid.UUID, reason string, customerToken string) error {
 	customerID, err := u.customerClient.Authenticate(ctx, customerToken)
 	if err != nil {
 		return err
 	}
 
-	err = u.orderClient.CancelByCustomer(ctx, orderID, customerID)
+	err = u.orderClient.CancelByCustomer(ctx, orderID, customerID, reason)
 	if err != nil {
 		return err
 	}
@@ -75,27 +155,31 @@ func (u *UseCaseImpl) Complete(ctx context.Context, orderID uuid.UUID, courierTo
 	return nil
 }
 
-func (u *UseCaseImpl) GetByCustomer(ctx context.Context, limit int, offset int, customerToken string) ([]*orderDto.OrderDto, error) {
+func (u *UseCaseImpl) GetByCustomer(
+	ctx context.Context,
+	query orderDto.ListQueryDto,
+	customerToken string,
+) (*orderDto.OrdersPageDto, error) {
 	customerID, err := u.customerClient.Authenticate(ctx, customerToken)
 	if err != nil {
 		return nil, err
 	}
 
-	orders, err := u.orderClient.GetByCustomer(ctx, customerID, limit, offset)
+	page, err := u.orderClient.GetByCustomer(ctx, customerID, query)
 	if err != nil {
 		return nil, err
 	}
 
-	return orders, nil
+	return page, nil
 }
 
-func (u *UseCaseImpl) GetCurrentByCourier(ctx context.Context, limit int, offset int, courierToken string) ([]*orderDto.OrderDto, error) {
+func (u *UseCaseImpl) GetCurrentByCourier(ctx context.Context, courierToken string) ([]*orderDto.OrderDto, error) {
 	courierID, err := u.courierClient.Authenticate(ctx, courierToken)
 	if err != nil {
 		return nil, err
 	}
 
-	orders, err := u.orderClient.GetCurrentByCourier(ctx, courierID, limit, offset)
+	orders, err := u.orderClient.GetCurrentByCourier(ctx, courierID)
 	if err != nil {
 		return nil, err
 	}
@@ -103,4 +187,233 @@ func (u *UseCaseImpl) GetCurrentByCourier(ctx context.Context, limit int, offset
 	return orders, nil
 }
 
+func (u *UseCaseImpl) GetCourierHistory(
+	ctx context.Context,
+	query orderDto.ListQueryDto,
+	courierToken string,
+) (*orderDto.CourierHistoryDto, error) {
+	courierID, err := u.courierClient.Authenticate(ctx, courierToken)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := u.orderClient.GetHistoryByCourier(ctx, courierID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func (u *UseCaseImpl) GetSagaState(ctx context.Context, orderID uuid.UUID, adminToken string) ([]*orderDto.SagaDto, error) {
+	if !u.adminAuth.Validate(adminToken) {
+		return nil, ErrUnauthorized
+	}
+
+	sagas, err := u.orderClient.GetSagaState(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return sagas, nil
+}
+
+func (u *UseCaseImpl) CreatePromotion(ctx context.Context, data orderDto.CreatePromotionDto, adminToken string) (uuid.UUID, error) {
+	if !u.adminAuth.Validate(adminToken) {
+		return uuid.Nil, ErrUnauthorized
+	}
+
+	promotionID, err := u.orderClient.CreatePromotion(ctx, data)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return promotionID, nil
+}
+
+func (u *UseCaseImpl) GetPromotions(ctx context.Context, activeOnly bool, adminToken string) ([]*orderDto.PromotionDto, error) {
+	if !u.adminAuth.Validate(adminToken) {
+		return nil, ErrUnauthorized
+	}
+
+	promotions, err := u.orderClient.GetPromotions(ctx, activeOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	return promotions, nil
+}
+
+func (u *UseCaseImpl) DeactivatePromotion(ctx context.Context, promotionID uuid.UUID, adminToken string) error {
+	if !u.adminAuth.Validate(adminToken) {
+		return ErrUnauthorized
+	}
+
+	return u.orderClient.DeactivatePromotion(ctx, promotionID)
+}
+
+func (u *UseCaseImpl) GetAvailableSlots(ctx context.Context) ([]*orderDto.SlotAvailabilityDto, error) {
+	slots, err := u.orderClient.GetAvailableSlots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return slots, nil
+}
+
+// GetHistory returns the status history of an order to its customer, the
+// courier assigned to it or an admin. An admin token takes precedence; a bearer
+// token is tried as a customer token first and as a courier token next.
+func (u *UseCaseImpl) GetHistory(
+	ctx context.Context,
+	orderID uuid.UUID,
+	bearerToken string,
+	adminToken string,
+) ([]*orderDto.StatusChangeDto, error) {
+	requester, err := u.authenticateViewer(ctx, bearerToken, adminToken)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := u.orderClient.GetHistory(ctx, orderID, requester)
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func (u *UseCaseImpl) authenticateViewer(ctx context.Context, bearerToken string, adminToken string) (orderDto.ActorDto, error) {
+	if adminToken != "" {
+		if !u.adminAuth.Validate(adminToken) {
+			return orderDto.ActorDto{}, ErrUnauthorized
+		}
+		return orderDto.ActorDto{Type: orderDto.AdminActor}, nil
+	}
+
+	customerID, err := u.customerClient.Authenticate(ctx, bearerToken)
+	if err == nil {
+		return orderDto.ActorDto{Type: orderDto.CustomerActor, ID: &customerID}, nil
+	}
+	if !isClientError(err) {
+		return orderDto.ActorDto{}, err
+	}
+
+	courierID, err := u.courierClient.Authenticate(ctx, bearerToken)
+	if err != nil {
+		return orderDto.ActorDto{}, err
+	}
+	return orderDto.ActorDto{Type: orderDto.CourierActor, ID: &courierID}, nil
+}
+
+// isClientError reports whether the error rejects the request itself, as an
+// invalid token does, rather than telling that the service failed.
+func isClientError(err error) bool {
+	var appErr *domainErrors.AppError
+	return errors.As(err, &appErr) && appErr.HTTPCode >= 400 && appErr.HTTPCode < 500
+}
+
+func (u *UseCaseImpl) Search(
+	ctx context.Context,
+	filter orderDto.SearchFilterDto,
+	query orderDto.ListQueryDto,
+	adminToken string,
+) (*orderDto.OrdersPageDto, error) {
+	if !u.adminAuth.Validate(adminToken) {
+		return nil, ErrUnauthorized
+	}
+
+	page, err := u.orderClient.Search(ctx, filter, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (u *UseCaseImpl) ForceCancel(ctx context.Context, orderID uuid.UUID, reason string, adminToken string) error {
+	if !u.adminAuth.Validate(adminToken) {
+		return ErrUnauthorized
+	}
+
+	return u.orderClient.ForceCancel(ctx, orderID, reason)
+}
+
+func (u *UseCaseImpl) RetrySagaStep(ctx context.Context, orderID uuid.UUID, sagaType orderDto.SagaType, adminToken string) error {
+	if !u.adminAuth.Validate(adminToken) {
+		return ErrUnauthorized
+	}
+
+	return u.orderClient.RetrySagaStep(ctx, orderID, sagaType)
+}
+
+// ReassignCourier hands a delivering order over to another courier. The courier
+// is looked up first so that an unknown one is rejected before the order changes.
+func (u *UseCaseImpl) ReassignCourier(
+	ctx context.Context,
+	orderID uuid.UUID,
+	courierID uuid.UUID,
+	reason string,
+	adminToken string,
+) error {
+	if !u.adminAuth.Validate(adminToken) {
+		return ErrUnauthorized
+	}
+
+	if _, err := u.courierClient.GetByID(ctx, courierID); err != nil {
+		return err
+	}
+
+	return u.orderClient.ReassignCourier(ctx, orderID, courierID, reason)
+}
+
+func (u *UseCaseImpl) GetStatusFunnel(
+	ctx context.Context,
+	period orderDto.PeriodDto,
+	adminToken string,
+) (*orderDto.StatusFunnelDto, error) {
+	if !u.adminAuth.Validate(adminToken) {
+		return nil, ErrUnauthorized
+	}
+
+	return u.orderClient.GetStatusFunnel(ctx, period)
+}
+
+func (u *UseCaseImpl) GetRevenue(
+	ctx context.Context,
+	period orderDto.PeriodDto,
+	granularity orderDto.Granularity,
+	adminToken string,
+) ([]*orderDto.RevenueBucketDto, error) {
+	if !u.adminAuth.Validate(adminToken) {
+		return nil, ErrUnauthorized
+	}
+
+	return u.orderClient.GetRevenue(ctx, period, granularity)
+}
+
+func (u *UseCaseImpl) GetDeliveryTime(
+	ctx context.Context,
+	period orderDto.PeriodDto,
+	adminToken string,
+) (*orderDto.DeliveryTimeDto, error) {
+	if !u.adminAuth.Validate(adminToken) {
+		return nil, ErrUnauthorized
+	}
+
+	return u.orderClient.GetDeliveryTime(ctx, period)
+}
+
+func (u *UseCaseImpl) GetCancellationReasons(
+	ctx context.Context,
+	period orderDto.PeriodDto,
+	adminToken string,
+) (map[orderDto.CancelReason]int, error) {
+	if !u.adminAuth.Validate(adminToken) {
+		return nil, ErrUnauthorized
+	}
+
+	return u.orderClient.GetCancellationReasons(ctx, period)
+}
+
 var _ UseCase = (*UseCaseImpl)(nil)
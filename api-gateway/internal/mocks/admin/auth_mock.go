@@ -0,0 +1,18 @@
+package admin
+
+import (
+	"api-gateway/internal/port/output/auth/admin"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type AuthMock struct {
+	mock.Mock
+}
+
+func (m *AuthMock) Validate(token string) bool {
+	args := m.Called(token)
+	return args.Bool(0)
+}
+
+var _ admin.Auth = (*AuthMock)(nil)
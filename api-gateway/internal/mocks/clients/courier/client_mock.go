@@ -0,0 +1,36 @@
+package courier
+
+import (
+	courierDto "api-gateway/internal/domain/dtos/courier"
+	courierClient "api-gateway/internal/port/output/clients/courier"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type ClientMock struct {
+	mock.Mock
+}
+
+func (m *ClientMock) Register(ctx context.Context, data courierDto.RegisterDto) (uuid.UUID, error) {
+	args := m.Called(ctx, data)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *ClientMock) Login(ctx context.Context, data courierDto.LoginDto) (string, error) {
+	args := m.Called(ctx, data)
+	return args.String(0), args.Error(1)
+}
+
+func (m *ClientMock) Authenticate(ctx context.Context, token string) (uuid.UUID, error) {
+	args := m.Called(ctx, token)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *ClientMock) GetByID(ctx context.Context, courierID uuid.UUID) (*courierDto.CourierDto, error) {
+	args := m.Called(ctx, courierID)
+	return args.Get(0).(*courierDto.CourierDto), args.Error(1)
+}
+
+var _ courierClient.Client = (*ClientMock)(nil)
@@ -0,0 +1,46 @@
+package customer
+
+import (
+	customerDto "api-gateway/internal/domain/dtos/customer"
+	customerClient "api-gateway/internal/port/output/clients/customer"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type ClientMock struct {
+	mock.Mock
+}
+
+func (m *ClientMock) Register(ctx context.Context, data customerDto.RegisterDto) (uuid.UUID, error) {
+	args := m.Called(ctx, data)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *ClientMock) Login(ctx context.Context, data customerDto.LoginDto) (string, error) {
+	args := m.Called(ctx, data)
+	return args.String(0), args.Error(1)
+}
+
+func (m *ClientMock) VerifyOtp(ctx context.Context, data customerDto.VerifyOtpDto) (string, error) {
+	args := m.Called(ctx, data)
+	return args.String(0), args.Error(1)
+}
+
+func (m *ClientMock) RequestPasswordReset(ctx context.Context, email string) error {
+	args := m.Called(ctx, email)
+	return args.Error(0)
+}
+
+func (m *ClientMock) CompletePasswordReset(ctx context.Context, token string, newPassword string) error {
+	args := m.Called(ctx, token, newPassword)
+	return args.Error(0)
+}
+
+func (m *ClientMock) Authenticate(ctx context.Context, token string) (uuid.UUID, error) {
+	args := m.Called(ctx, token)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+var _ customerClient.Client = (*ClientMock)(nil)
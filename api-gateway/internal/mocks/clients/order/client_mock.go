@@ -0,0 +1,121 @@
+package order
+
+import (
+	orderDto "api-gateway/internal/domain/dtos/order"
+	orderClient "api-gateway/internal/port/output/clients/order"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type ClientMock struct {
+	mock.Mock
+}
+
+func (m *ClientMock) Create(ctx context.Context, data orderClient.CreateDto) (uuid.UUID, error) {
+	args := m.Called(ctx, data)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *ClientMock) GetByID(ctx context.Context, orderID uuid.UUID, requester orderDto.ActorDto) (*orderDto.OrderDto, error) {
+	args := m.Called(ctx, orderID, requester)
+	return args.Get(0).(*orderDto.OrderDto), args.Error(1)
+}
+
+func (m *ClientMock) CancelByCustomer(ctx context.Context, orderID uuid.UUID, customerID uuid.UUID, reason string) error {
+	args := m.Called(ctx, orderID, customerID, reason)
+	return args.Error(0)
+}
+
+func (m *ClientMock) Complete(ctx context.Context, orderID uuid.UUID, courierID uuid.UUID) error {
+	args := m.Called(ctx, orderID, courierID)
+	return args.Error(0)
+}
+
+func (m *ClientMock) GetByCustomer(ctx context.Context, customerID uuid.UUID, query orderDto.ListQueryDto) (*orderDto.OrdersPageDto, error) {
+	args := m.Called(ctx, customerID, query)
+	return args.Get(0).(*orderDto.OrdersPageDto), args.Error(1)
+}
+
+func (m *ClientMock) GetCurrentByCourier(ctx context.Context, courierID uuid.UUID) ([]*orderDto.OrderDto, error) {
+	args := m.Called(ctx, courierID)
+	return args.Get(0).([]*orderDto.OrderDto), args.Error(1)
+}
+
+func (m *ClientMock) GetHistoryByCourier(ctx context.Context, courierID uuid.UUID, query orderDto.ListQueryDto) (*orderDto.CourierHistoryDto, error) {
+	args := m.Called(ctx, courierID, query)
+	return args.Get(0).(*orderDto.CourierHistoryDto), args.Error(1)
+}
+
+func (m *ClientMock) GetSagaState(ctx context.Context, orderID uuid.UUID) ([]*orderDto.SagaDto, error) {
+	args := m.Called(ctx, orderID)
+	return args.Get(0).([]*orderDto.SagaDto), args.Error(1)
+}
+
+func (m *ClientMock) CreatePromotion(ctx context.Context, data orderDto.CreatePromotionDto) (uuid.UUID, error) {
+	args := m.Called(ctx, data)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *ClientMock) GetPromotions(ctx context.Context, activeOnly bool) ([]*orderDto.PromotionDto, error) {
+	args := m.Called(ctx, activeOnly)
+	return args.Get(0).([]*orderDto.PromotionDto), args.Error(1)
+}
+
+func (m *ClientMock) DeactivatePromotion(ctx context.Context, promotionID uuid.UUID) error {
+	args := m.Called(ctx, promotionID)
+	return args.Error(0)
+}
+
+func (m *ClientMock) GetAvailableSlots(ctx context.Context) ([]*orderDto.SlotAvailabilityDto, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*orderDto.SlotAvailabilityDto), args.Error(1)
+}
+
+func (m *ClientMock) GetHistory(ctx context.Context, orderID uuid.UUID, requester orderDto.ActorDto) ([]*orderDto.StatusChangeDto, error) {
+	args := m.Called(ctx, orderID, requester)
+	return args.Get(0).([]*orderDto.StatusChangeDto), args.Error(1)
+}
+
+func (m *ClientMock) Search(ctx context.Context, filter orderDto.SearchFilterDto, query orderDto.ListQueryDto) (*orderDto.OrdersPageDto, error) {
+	args := m.Called(ctx, filter, query)
+	return args.Get(0).(*orderDto.OrdersPageDto), args.Error(1)
+}
+
+func (m *ClientMock) ForceCancel(ctx context.Context, orderID uuid.UUID, reason string) error {
+	args := m.Called(ctx, orderID, reason)
+	return args.Error(0)
+}
+
+func (m *ClientMock) RetrySagaStep(ctx context.Context, orderID uuid.UUID, sagaType orderDto.SagaType) error {
+	args := m.Called(ctx, orderID, sagaType)
+	return args.Error(0)
+}
+
+func (m *ClientMock) ReassignCourier(ctx context.Context, orderID uuid.UUID, courierID uuid.UUID, reason string) error {
+	args := m.Called(ctx, orderID, courierID, reason)
+	return args.Error(0)
+}
+
+func (m *ClientMock) GetStatusFunnel(ctx context.Context, period orderDto.PeriodDto) (*orderDto.StatusFunnelDto, error) {
+	args := m.Called(ctx, period)
+	return args.Get(0).(*orderDto.StatusFunnelDto), args.Error(1)
+}
+
+func (m *ClientMock) GetRevenue(ctx context.Context, period orderDto.PeriodDto, granularity orderDto.Granularity) ([]*orderDto.RevenueBucketDto, error) {
+	args := m.Called(ctx, period, granularity)
+	return args.Get(0).([]*orderDto.RevenueBucketDto), args.Error(1)
+}
+
+func (m *ClientMock) GetDeliveryTime(ctx context.Context, period orderDto.PeriodDto) (*orderDto.DeliveryTimeDto, error) {
+	args := m.Called(ctx, period)
+	return args.Get(0).(*orderDto.DeliveryTimeDto), args.Error(1)
+}
+
+func (m *ClientMock) GetCancellationReasons(ctx context.Context, period orderDto.PeriodDto) (map[orderDto.CancelReason]int, error) {
+	args := m.Called(ctx, period)
+	return args.Get(0).(map[orderDto.CancelReason]int), args.Error(1)
+}
+
+var _ orderClient.Client = (*ClientMock)(nil)
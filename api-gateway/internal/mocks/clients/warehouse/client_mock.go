@@ -0,0 +1,52 @@
+package warehouse
+
+import (
+	warehouseDto "api-gateway/internal/domain/dtos/warehouse"
+	warehouseClient "api-gateway/internal/port/output/clients/warehouse"
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type ClientMock struct {
+	mock.Mock
+}
+
+func (m *ClientMock) ReserveItems(ctx context.Context, items []warehouseDto.ItemInfoDto) error {
+	args := m.Called(ctx, items)
+	return args.Error(0)
+}
+
+func (m *ClientMock) ReleaseItems(ctx context.Context, items []warehouseDto.ItemInfoDto) error {
+	args := m.Called(ctx, items)
+	return args.Error(0)
+}
+
+func (m *ClientMock) CreateProduct(ctx context.Context, data warehouseDto.CreateProductDto) (uuid.UUID, error) {
+	args := m.Called(ctx, data)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *ClientMock) GetProducts(ctx context.Context, productIDs []uuid.UUID) ([]*warehouseDto.ProductDto, error) {
+	args := m.Called(ctx, productIDs)
+	return args.Get(0).([]*warehouseDto.ProductDto), args.Error(1)
+}
+
+func (m *ClientMock) GetAllItems(ctx context.Context, limit int, offset int) ([]*warehouseDto.ItemDto, error) {
+	args := m.Called(ctx, limit, offset)
+	return args.Get(0).([]*warehouseDto.ItemDto), args.Error(1)
+}
+
+func (m *ClientMock) UpdateProductImage(ctx context.Context, productID uuid.UUID, fileReader io.Reader, contentType string) error {
+	args := m.Called(ctx, productID, fileReader, contentType)
+	return args.Error(0)
+}
+
+func (m *ClientMock) GetProductImage(ctx context.Context, productID uuid.UUID) (fileReader io.Reader, contentType string, err error) {
+	args := m.Called(ctx, productID)
+	return args.Get(0).(io.Reader), args.String(1), args.Error(2)
+}
+
+var _ warehouseClient.Client = (*ClientMock)(nil)
@@ -11,4 +11,5 @@ type Client interface {
 	Register(ctx context.Context, data courierDto.RegisterDto) (uuid.UUID, error)
 	Login(ctx context.Context, data courierDto.LoginDto) (string, error)
 	Authenticate(ctx context.Context, token string) (uuid.UUID, error)
+	GetByID(ctx context.Context, courierID uuid.UUID) (*courierDto.CourierDto, error)
 }
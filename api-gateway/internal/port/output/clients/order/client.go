@@ -9,8 +9,24 @@ import (
 
 type Client interface {
 	Create(ctx context.Context, data CreateDto) (uuid.UUID, error)
-	CancelByCustomer(ctx context.Context, orderID uuid.UUID, customerID uuid.UUID) error
+	GetByID(ctx context.Context, orderID uuid.UUID, requester orderDto.ActorDto) (*orderDto.OrderDto, error)
+	CancelByCustomer(ctx context.Context, orderID uuid.UUID, customerID uuid.UUID, reason string) error
 	Complete(ctx context.Context, orderID uuid.UUID, courierID uuid.UUID) error
-	GetByCustomer(ctx context.Context, customerID uuid.UUID, limit int, offset int) ([]*orderDto.OrderDto, error)
-	GetCurrentByCourier(ctx context.Context, courierID uuid.UUID, limit int, offset int) ([]*orderDto.OrderDto, error)
+	GetByCustomer(ctx context.Context, customerID uuid.UUID, query orderDto.ListQueryDto) (*orderDto.OrdersPageDto, error)
+	GetCurrentByCourier(ctx context.Context, courierID uuid.UUID) ([]*orderDto.OrderDto, error)
+	GetHistoryByCourier(ctx context.Context, courierID uuid.UUID, query orderDto.ListQueryDto) (*orderDto.CourierHistoryDto, error)
+	GetSagaState(ctx context.Context, orderID uuid.UUID) ([]*orderDto.SagaDto, error)
+	CreatePromotion(ctx context.Context, data orderDto.CreatePromotionDto) (uuid.UUID, error)
+	GetPromotions(ctx context.Context, activeOnly bool) ([]*orderDto.PromotionDto, error)
+	DeactivatePromotion(ctx context.Context, promotionID uuid.UUID) error
+	GetAvailableSlots(ctx context.Context) ([]*orderDto.SlotAvailabilityDto, error)
+	GetHistory(ctx context.Context, orderID uuid.UUID, requester orderDto.ActorDto) ([]*orderDto.StatusChangeDto, error)
+	Search(ctx context.Context, filter orderDto.SearchFilterDto, query orderDto.ListQueryDto) (*orderDto.OrdersPageDto, error)
+	ForceCancel(ctx context.Context, orderID uuid.UUID, reason string) error
+	RetrySagaStep(ctx context.Context, orderID uuid.UUID, sagaType orderDto.SagaType) error
+	ReassignCourier(ctx context.Context, orderID uuid.UUID, courierID uuid.UUID, reason string) error
+	GetStatusFunnel(ctx context.Context, period orderDto.PeriodDto) (*orderDto.StatusFunnelDto, error)
+	GetRevenue(ctx context.Context, period orderDto.PeriodDto, granularity orderDto.Granularity) ([]*orderDto.RevenueBucketDto, error)
+	GetDeliveryTime(ctx context.Context, period orderDto.PeriodDto) (*orderDto.DeliveryTimeDto, error)
+	GetCancellationReasons(ctx context.Context, period orderDto.PeriodDto) (map[orderDto.CancelReason]int, error)
 }
@@ -7,7 +7,10 @@ import (
 )
 
 type CreateDto struct {
-	CustomerID uuid.UUID
-	Address    string
-	Items      []orderDto.ItemDto
+	CustomerID  uuid.UUID
+	Address     orderDto.AddressDto
+	Items       []orderDto.ItemDto
+	PromoCode   string
+	Slot        *orderDto.DeliverySlotDto
+	Fulfillment orderDto.FulfillmentPolicy
 }
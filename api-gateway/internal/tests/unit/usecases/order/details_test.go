@@ -0,0 +1,136 @@
+package order
+
+import (
+	courierDto "api-gateway/internal/domain/dtos/courier"
+	orderDto "api-gateway/internal/domain/dtos/order"
+	warehouseDto "api-gateway/internal/domain/dtos/warehouse"
+	orderUseCase "api-gateway/internal/domain/usecases/order"
+	adminMock "api-gateway/internal/mocks/admin"
+	courierMock "api-gateway/internal/mocks/clients/courier"
+	customerMock "api-gateway/internal/mocks/clients/customer"
+	orderMock "api-gateway/internal/mocks/clients/order"
+	warehouseMock "api-gateway/internal/mocks/clients/warehouse"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const adminToken = "admin_token"
+
+type clients struct {
+	courier   *courierMock.ClientMock
+	order     *orderMock.ClientMock
+	warehouse *warehouseMock.ClientMock
+}
+
+type GetDetailsTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *GetDetailsTestSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func (s *GetDetailsTestSuite) TestGetDetails() {
+	courierID := uuid.New()
+	productID := uuid.New()
+	order := &orderDto.OrderDto{
+		ID:       uuid.New(),
+		Delivery: orderDto.DeliveryDto{CourierID: &courierID},
+		Items:    []orderDto.ItemDto{{ProductID: productID, Count: 1, Requested: 1}},
+	}
+	product := &warehouseDto.ProductDto{ProductID: productID, Name: "Product"}
+	courier := &courierDto.CourierDto{CourierID: courierID, Name: "Courier"}
+
+	tests := []struct {
+		name             string
+		setup            func(c clients)
+		expectedProducts map[uuid.UUID]*warehouseDto.ProductDto
+		expectedCourier  *courierDto.CourierDto
+	}{
+		{
+			name: "Success",
+			setup: func(c clients) {
+				c.warehouse.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return([]*warehouseDto.ProductDto{product}, nil)
+				c.courier.On("GetByID", s.ctx, courierID).Return(courier, nil)
+			},
+			expectedProducts: map[uuid.UUID]*warehouseDto.ProductDto{productID: product},
+			expectedCourier:  courier,
+		},
+		{
+			name: "Success: warehouse unavailable",
+			setup: func(c clients) {
+				c.warehouse.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return([]*warehouseDto.ProductDto(nil), errors.New("warehouse unavailable"))
+				c.courier.On("GetByID", s.ctx, courierID).Return(courier, nil)
+			},
+			expectedProducts: map[uuid.UUID]*warehouseDto.ProductDto{},
+			expectedCourier:  courier,
+		},
+		{
+			name: "Success: courier service unavailable",
+			setup: func(c clients) {
+				c.warehouse.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return([]*warehouseDto.ProductDto{product}, nil)
+				c.courier.On("GetByID", s.ctx, courierID).
+					Return((*courierDto.CourierDto)(nil), errors.New("courier service unavailable"))
+			},
+			expectedProducts: map[uuid.UUID]*warehouseDto.ProductDto{productID: product},
+			expectedCourier:  nil,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.T().Parallel()
+			adminAuth := new(adminMock.AuthMock)
+			c := clients{
+				courier:   new(courierMock.ClientMock),
+				order:     new(orderMock.ClientMock),
+				warehouse: new(warehouseMock.ClientMock),
+			}
+			uc := orderUseCase.NewUseCase(adminAuth, new(customerMock.ClientMock), c.courier, c.order, c.warehouse)
+
+			adminAuth.On("Validate", adminToken).Return(true)
+			c.order.On("GetByID", s.ctx, order.ID, orderDto.ActorDto{Type: orderDto.AdminActor}).Return(order, nil)
+			tc.setup(c)
+
+			details, err := uc.GetDetails(s.ctx, order.ID, "", adminToken)
+
+			require.NoError(s.T(), err)
+			require.Equal(s.T(), order, details.Order)
+			require.Equal(s.T(), tc.expectedProducts, details.Products)
+			require.Equal(s.T(), tc.expectedCourier, details.Courier)
+			c.warehouse.AssertExpectations(s.T())
+			c.courier.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *GetDetailsTestSuite) TestGetDetailsOrderError() {
+	adminAuth := new(adminMock.AuthMock)
+	orders := new(orderMock.ClientMock)
+	uc := orderUseCase.NewUseCase(adminAuth, new(customerMock.ClientMock), new(courierMock.ClientMock), orders, new(warehouseMock.ClientMock))
+
+	orderID := uuid.New()
+	adminAuth.On("Validate", adminToken).Return(true)
+	orders.On("GetByID", s.ctx, orderID, mock.Anything).
+		Return((*orderDto.OrderDto)(nil), errors.New("order service unavailable"))
+
+	details, err := uc.GetDetails(s.ctx, orderID, "", adminToken)
+
+	require.EqualError(s.T(), err, "order service unavailable")
+	require.Nil(s.T(), details)
+}
+
+func TestGetDetailsTestSuite(t *testing.T) {
+	suite.Run(t, new(GetDetailsTestSuite))
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	gatewayApp "api-gateway/app"
+	courierApp "courier/app"
+	customerApp "customer/app"
+	orderApp "order/app"
+	"strconv"
+	"time"
+	warehouseApp "warehouse/app"
+)
+
+const apiBasePath = "/api/v1"
+
+// The topics and consumer groups the services talk over; each of them is
+// named the same way in every service that uses it.
+const (
+	orderCmdTopic        = "order.commands"
+	orderCmdResTopic     = "order.command-results"
+	orderEventTopic      = "order.events"
+	warehouseCmdTopic    = "warehouse.commands"
+	warehouseCmdResTopic = "warehouse.command-results"
+	courierCmdTopic      = "courier.commands"
+	courierCmdResTopic   = "courier.command-results"
+	productEventTopic    = "product.events"
+)
+
+// The settings of the message bus, shared by the services that use it.
+const (
+	retryMaxAttempts    = 3
+	retryInitialBackoff = 100 * time.Millisecond
+	retryMaxBackoff     = 2 * time.Second
+	commitInterval      = time.Second
+	consumerConcurrency = 4
+	inboxRetention      = 24 * time.Hour
+	inboxCleanup        = time.Hour
+)
+
+func warehouseConfig(cfg Config, grpcPort int) warehouseApp.Config {
+	var c warehouseApp.Config
+	c.Logger.ServiceName = "warehouse"
+	c.Logger.LogLevel = cfg.LogLevel
+	c.Telemetry.ServiceName = "warehouse"
+	c.Memory.Enabled = true
+	c.GRPC.Port = strconv.Itoa(grpcPort)
+
+	c.Messaging.WarehouseCmdTopic = warehouseCmdTopic
+	c.Messaging.WarehouseCmdResTopic = warehouseCmdResTopic
+	c.Messaging.WarehouseCmdConsumerGroupID = "warehouse"
+	c.Messaging.WarehouseCmdDLQTopic = warehouseCmdTopic + ".dlq"
+	c.Messaging.ProductEventTopic = productEventTopic
+	c.Messaging.ProductEventConsumerGroupID = "warehouse"
+
+	c.Retry.MaxAttempts = retryMaxAttempts
+	c.Retry.InitialBackoff = retryInitialBackoff
+	c.Retry.MaxBackoff = retryMaxBackoff
+	c.Commit.Interval = commitInterval
+	c.Worker.Concurrency = consumerConcurrency
+	c.Inbox.Retention = inboxRetention
+	c.Inbox.CleanupInterval = inboxCleanup
+	return c
+}
+
+func courierConfig(cfg Config, grpcPort int) courierApp.Config {
+	var c courierApp.Config
+	c.Logger.ServiceName = "courier"
+	c.Logger.LogLevel = cfg.LogLevel
+	c.Telemetry.ServiceName = "courier"
+	c.Memory.Enabled = true
+	c.GRPC.Port = strconv.Itoa(grpcPort)
+	c.Auth.SigningKey = "courier-signing-key"
+	c.Auth.TokenTTL = 24 * time.Hour
+
+	c.Messaging.CourierCmdTopic = courierCmdTopic
+	c.Messaging.CourierCmdResTopic = courierCmdResTopic
+	c.Messaging.CourierCmdConsumerGroupID = "courier"
+	c.Messaging.CourierCmdDLQTopic = courierCmdTopic + ".dlq"
+
+	c.Retry.MaxAttempts = retryMaxAttempts
+	c.Retry.InitialBackoff = retryInitialBackoff
+	c.Retry.MaxBackoff = retryMaxBackoff
+	c.Commit.Interval = commitInterval
+	c.Worker.Concurrency = consumerConcurrency
+	c.Inbox.Retention = inboxRetention
+	c.Inbox.CleanupInterval = inboxCleanup
+	return c
+}
+
+func customerConfig(cfg Config, grpcPort int) customerApp.Config {
+	var c customerApp.Config
+	c.Logger.ServiceName = "customer"
+	c.Logger.LogLevel = cfg.LogLevel
+	c.Telemetry.ServiceName = "customer"
+	c.Memory.Enabled = true
+	c.GRPC.Port = strconv.Itoa(grpcPort)
+
+	c.Auth.SigningKey = "customer-signing-key"
+	c.Auth.AccessTTL = 24 * time.Hour
+	c.Auth.ResetTTL = time.Hour
+	c.Auth.OtpTTL = 5 * time.Minute
+	c.Auth.OtpMaxAttempts = 3
+	c.Auth.LockoutMaxFailed = 5
+	c.Auth.LockoutLockFor = 15 * time.Minute
+	return c
+}
+
+func orderConfig(cfg Config, grpcPort, warehousePort int) orderApp.Config {
+	var c orderApp.Config
+	c.Logger.ServiceName = "order"
+	c.Logger.LogLevel = cfg.LogLevel
+	c.Telemetry.ServiceName = "order"
+	c.Memory.Enabled = true
+	c.GRPC.Port = strconv.Itoa(grpcPort)
+	c.Catalog.WarehouseAddress = address(warehousePort)
+	c.Catalog.Timeout = 5 * time.Second
+
+	c.Messaging.OrderCmdTopic = orderCmdTopic
+	c.Messaging.OrderCmdResTopic = orderCmdResTopic
+	c.Messaging.OrderCmdConsumerGroupID = "order"
+	c.Messaging.OrderCmdDLQTopic = orderCmdTopic + ".dlq"
+	c.Messaging.OrderEventTopic = orderEventTopic
+	c.Messaging.WarehouseCmdTopic = warehouseCmdTopic
+	c.Messaging.WarehouseCmdResTopic = warehouseCmdResTopic
+	c.Messaging.WarehouseCmdResConsumerGroupID = "order"
+	c.Messaging.WarehouseCmdResDLQTopic = warehouseCmdResTopic + ".dlq"
+	c.Messaging.CourierCmdTopic = courierCmdTopic
+	c.Messaging.CourierCmdResTopic = courierCmdResTopic
+	c.Messaging.CourierCmdResConsumerGroupID = "order"
+	c.Messaging.CourierCmdResDLQTopic = courierCmdResTopic + ".dlq"
+
+	c.Retry.MaxAttempts = retryMaxAttempts
+	c.Retry.InitialBackoff = retryInitialBackoff
+	c.Retry.MaxBackoff = retryMaxBackoff
+	c.Commit.Interval = commitInterval
+	c.Worker.Concurrency = consumerConcurrency
+	c.Inbox.Retention = inboxRetention
+	c.Inbox.CleanupInterval = inboxCleanup
+
+	c.Watchdog.StepDeadline = time.Minute
+	c.Watchdog.PollInterval = 5 * time.Second
+	c.Watchdog.LeaseDuration = 30 * time.Second
+	c.CreateSaga.CourierLeadTime = time.Hour
+	c.CancelSaga.MaxRetries = 3
+	c.Cancellation.CreatedWindow = 15 * time.Minute
+	c.Cancellation.DeliveringWindow = 5 * time.Minute
+
+	c.DeliverySlots.Capacity = 10
+	c.DeliverySlots.Length = 2 * time.Hour
+	c.DeliverySlots.DayStart = 8 * time.Hour
+	c.DeliverySlots.DayEnd = 22 * time.Hour
+	c.DeliverySlots.HorizonDays = 7
+	c.DeliverySlots.MinLeadTime = 2 * time.Hour
+	c.DeliverySlots.Timezone.Location = time.UTC
+	return c
+}
+
+func gatewayConfig(cfg Config, warehousePort, courierPort, customerPort, orderPort int) gatewayApp.Config {
+	var c gatewayApp.Config
+	c.Logger.ServiceName = "api-gateway"
+	c.Logger.LogLevel = cfg.LogLevel
+	c.Telemetry.ServiceName = "api-gateway"
+	c.API.Port = cfg.APIPort
+	c.API.BasePath = apiBasePath
+	c.Admin.Token = cfg.AdminToken
+	c.Streaming.FileChunkSizeBytes = 64 * 1024
+
+	c.Warehouse.Address = address(warehousePort)
+	c.Warehouse.TimeoutSeconds = 5
+	c.Courier.Address = address(courierPort)
+	c.Courier.TimeoutSeconds = 5
+	c.Customer.Address = address(customerPort)
+	c.Customer.TimeoutSeconds = 5
+	c.Order.Address = address(orderPort)
+	c.Order.TimeoutSeconds = 5
+	return c
+}
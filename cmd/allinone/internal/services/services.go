@@ -0,0 +1,154 @@
+// Package services boots the order, warehouse, courier and customer services
+// and the API gateway in one process, on in-memory storage and one in-memory
+// message bus.
+package services
+
+import (
+	gatewayApp "api-gateway/app"
+	"context"
+	courierApp "courier/app"
+	customerApp "customer/app"
+	"errors"
+	"fmt"
+	"net"
+	orderApp "order/app"
+	"strconv"
+	warehouseApp "warehouse/app"
+
+	"go.uber.org/fx"
+)
+
+// Config is what can be chosen about the services from outside.
+type Config struct {
+	// APIPort is the port of the gateway; a free one is picked when it is 0.
+	APIPort int
+	// AdminToken is the token the gateway accepts for the admin endpoints.
+	AdminToken string
+	// LogLevel is the level every service logs at.
+	LogLevel string
+}
+
+// Services are the running services.
+type Services struct {
+	// APIURL is the base URL of the gateway API.
+	APIURL string
+	// Mailbox holds the mails the customer service would have sent, among
+	// them the one-time login codes.
+	Mailbox customerApp.Mailbox
+	// Customers registers customers in the customer service.
+	Customers customerApp.Registrar
+
+	apps []*fx.App
+}
+
+// Start boots the services one at a time, each one once those it calls are
+// up, as some of them dial the others while they are being created. Each
+// service is given its configuration rather than reading it from the
+// environment. If a service fails to start, the ones already started are
+// stopped. As when a service runs on its own, the readers and processors it
+// starts keep running until ctx is done, so ctx must outlive the services.
+func Start(ctx context.Context, cfg Config) (*Services, error) {
+	ports, err := freePorts(4)
+	if err != nil {
+		return nil, err
+	}
+	warehousePort, courierPort, customerPort, orderPort := ports[0], ports[1], ports[2], ports[3]
+
+	if cfg.APIPort == 0 {
+		apiPorts, err := freePorts(1)
+		if err != nil {
+			return nil, err
+		}
+		cfg.APIPort = apiPorts[0]
+	}
+
+	bus := orderApp.NewBus()
+	s := &Services{APIURL: fmt.Sprintf("http://127.0.0.1:%d%s", cfg.APIPort, apiBasePath)}
+
+	boots := []struct {
+		name string
+		opts []fx.Option
+	}{
+		{
+			name: "warehouse",
+			opts: []fx.Option{
+				warehouseApp.Module,
+				warehouseApp.WithConfig(warehouseConfig(cfg, warehousePort)),
+				warehouseApp.WithBus(bus),
+			},
+		},
+		{
+			name: "courier",
+			opts: []fx.Option{
+				courierApp.Module,
+				courierApp.WithConfig(courierConfig(cfg, courierPort)),
+				courierApp.WithBus(bus),
+			},
+		},
+		{
+			name: "customer",
+			opts: []fx.Option{
+				customerApp.Module,
+				customerApp.WithConfig(customerConfig(cfg, customerPort)),
+				customerApp.WithMailbox(&s.Mailbox),
+				customerApp.WithRegistrar(&s.Customers),
+			},
+		},
+		{
+			name: "order",
+			opts: []fx.Option{
+				orderApp.Module,
+				orderApp.WithConfig(orderConfig(cfg, orderPort, warehousePort)),
+				orderApp.WithBus(bus),
+			},
+		},
+		{
+			name: "api-gateway",
+			opts: []fx.Option{
+				gatewayApp.Module,
+				gatewayApp.WithConfig(gatewayConfig(cfg, warehousePort, courierPort, customerPort, orderPort)),
+			},
+		},
+	}
+
+	for _, boot := range boots {
+		app := fx.New(append(boot.opts, fx.NopLogger)...)
+		if err := app.Start(ctx); err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to start %s: %w", boot.name, err), s.Stop(ctx))
+		}
+		s.apps = append(s.apps, app)
+	}
+	return s, nil
+}
+
+// Stop stops the services in the reverse order, the gateway first.
+func (s *Services) Stop(ctx context.Context) error {
+	return stop(ctx, s.apps)
+}
+
+func stop(ctx context.Context, apps []*fx.App) error {
+	var errs []error
+	for i := len(apps) - 1; i >= 0; i-- {
+		errs = append(errs, apps[i].Stop(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+// freePorts asks the system for n ports nothing listens on. They are released
+// before the services bind them, which is racy, but good enough for local runs.
+func freePorts(n int) ([]int, error) {
+	ports := make([]int, 0, n)
+	for range n {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return nil, fmt.Errorf("failed to find a free port: %w", err)
+		}
+		defer func() { _ = listener.Close() }()
+		ports = append(ports, listener.Addr().(*net.TCPAddr).Port)
+	}
+	return ports, nil
+}
+
+func address(port int) string {
+	return net.JoinHostPort("127.0.0.1", strconv.Itoa(port))
+}
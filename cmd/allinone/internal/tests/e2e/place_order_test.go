@@ -0,0 +1,255 @@
+package e2e
+
+import (
+	"allinone/internal/services"
+	"bytes"
+	"context"
+	customerApp "customer/app"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const adminToken = "admin-token"
+
+type PlaceOrderE2ESuite struct {
+	suite.Suite
+	ctx context.Context
+
+	services *services.Services
+	client   *http.Client
+}
+
+func (s *PlaceOrderE2ESuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.client = &http.Client{Timeout: 10 * time.Second}
+
+	var err error
+	s.services, err = services.Start(s.ctx, services.Config{AdminToken: adminToken, LogLevel: "error"})
+	require.NoError(s.T(), err)
+
+	// The gateway serves HTTP in the background once it has started.
+	require.Eventually(s.T(), func() bool {
+		status, _ := s.call(http.MethodGet, "/items?limit=1", nil, admin(), nil)
+		return status == http.StatusOK
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func (s *PlaceOrderE2ESuite) TearDownSuite() {
+	if s.services == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, 20*time.Second)
+	defer cancel()
+	require.NoError(s.T(), s.services.Stop(ctx))
+}
+
+func (s *PlaceOrderE2ESuite) TestPlaceOrderUntilDelivered() {
+	// 1) A product in stock
+	productID := s.stockProduct("Kettle", 10)
+
+	// 2) A courier to deliver it
+	courierToken := s.registerCourier("+15550000001")
+
+	// 3) A customer, signed in with the code they were mailed
+	customerToken := s.registerCustomer("+15550000002", "customer@example.com")
+
+	// 4) The order
+	order := newOrder([]map[string]any{{"product_id": productID, "price": price, "count": 2}})
+	location := s.mustCall(http.MethodPost, "/orders", order, bearer(customerToken), nil, http.StatusCreated)
+	orderPath := fmt.Sprintf("/orders/%s", location[len(location)-36:])
+
+	// 5) The saga reserves the items and assigns the courier
+	require.Eventually(s.T(), func() bool {
+		return s.orderStatus(orderPath, customerToken) == "delivering"
+	}, 20*time.Second, 100*time.Millisecond)
+
+	// 6) The courier hands it over
+	s.mustCall(http.MethodPatch, orderPath+"/complete", nil, bearer(courierToken), nil, http.StatusOK)
+	require.Equal(s.T(), "delivered", s.orderStatus(orderPath, customerToken))
+}
+
+func (s *PlaceOrderE2ESuite) TestPlaceOrderWithItemsShort() {
+	// 1) One product with too little in stock and one with none
+	shortID := s.stockProduct("Teapot", 1)
+	missingID := s.stockProduct("Cups", 0)
+
+	s.registerCourier("+15550000003")
+	customerToken := s.registerCustomer("+15550000004", "short@example.com")
+
+	// 2) The order allows partial fulfillment
+	order := newOrder([]map[string]any{
+		{"product_id": shortID, "price": price, "count": 3},
+		{"product_id": missingID, "price": price, "count": 1},
+	})
+	order["fulfillment_policy"] = "allow_partial"
+	location := s.mustCall(http.MethodPost, "/orders", order, bearer(customerToken), nil, http.StatusCreated)
+	orderPath := fmt.Sprintf("/orders/%s", location[len(location)-36:])
+
+	// 3) It goes out with what was in stock, and charges only for that
+	require.Eventually(s.T(), func() bool {
+		return s.orderStatus(orderPath, customerToken) == "delivering"
+	}, 20*time.Second, 100*time.Millisecond)
+
+	var placed struct {
+		FulfillmentPolicy string `json:"fulfillment_policy"`
+		Items             []struct {
+			ProductID      string `json:"product_id"`
+			Count          int    `json:"count"`
+			RequestedCount int    `json:"requested_count"`
+		} `json:"items"`
+		Total struct {
+			Amount string `json:"amount"`
+		} `json:"total"`
+	}
+	s.mustCall(http.MethodGet, orderPath, nil, bearer(customerToken), &placed, http.StatusOK)
+	require.Equal(s.T(), "allow_partial", placed.FulfillmentPolicy)
+	require.Len(s.T(), placed.Items, 2)
+	require.Equal(s.T(), shortID, placed.Items[0].ProductID)
+	require.Equal(s.T(), 1, placed.Items[0].Count)
+	require.Equal(s.T(), 3, placed.Items[0].RequestedCount)
+	require.Equal(s.T(), 0, placed.Items[1].Count)
+	require.Equal(s.T(), 1, placed.Items[1].RequestedCount)
+	require.Equal(s.T(), "25", placed.Total.Amount)
+}
+
+var price = map[string]any{"amount": "25", "currency": "USD"}
+
+func newOrder(items []map[string]any) map[string]any {
+	return map[string]any{
+		"address": map[string]any{
+			"country":     "DE",
+			"city":        "Berlin",
+			"street":      "Unter den Linden",
+			"house":       "1",
+			"postal_code": "10117",
+		},
+		"items": items,
+	}
+}
+
+// stockProduct adds a product to the catalog and count of it to the
+// warehouse, and returns its ID.
+func (s *PlaceOrderE2ESuite) stockProduct(name string, count int) string {
+	var product struct {
+		ProductID string `json:"product_id"`
+	}
+	s.mustCall(http.MethodPost, "/products", map[string]any{"name": name, "price": price}, admin(), &product, http.StatusCreated)
+
+	// The warehouse adds the item once it has read its own product event. It
+	// takes no empty increase, so an item kept out of stock gets one unit that
+	// is taken back.
+	items := func(count int) map[string]any {
+		return map[string]any{"items": []map[string]any{{"product_id": product.ProductID, "count": count}}}
+	}
+	require.Eventually(s.T(), func() bool {
+		status, _ := s.call(http.MethodPatch, "/items/increase", items(max(count, 1)), admin(), nil)
+		return status == http.StatusOK
+	}, 10*time.Second, 100*time.Millisecond)
+	if count == 0 {
+		s.mustCall(http.MethodPatch, "/items/decrease", items(1), admin(), nil, http.StatusOK)
+	}
+	return product.ProductID
+}
+
+// registerCourier registers a courier and returns their token.
+func (s *PlaceOrderE2ESuite) registerCourier(phone string) string {
+	courier := map[string]any{"name": "Courier", "phone": phone, "password": "courier-password"}
+	s.mustCall(http.MethodPost, "/couriers/register", courier, nil, nil, http.StatusCreated)
+
+	var login struct {
+		Token string `json:"token"`
+	}
+	s.mustCall(http.MethodPost, "/couriers/login", courier, nil, &login, http.StatusOK)
+	return login.Token
+}
+
+// registerCustomer registers a customer in the customer service, as the
+// gateway takes no email, signs them in with the code they were mailed and
+// returns their token.
+func (s *PlaceOrderE2ESuite) registerCustomer(phone, email string) string {
+	require.NotNil(s.T(), s.services.Customers)
+	_, err := s.services.Customers.Register(s.ctx, customerApp.Registration{
+		Name: "Customer", Phone: phone, Email: email, Password: "customer-password",
+	})
+	require.NoError(s.T(), err)
+
+	customer := map[string]any{"phone": phone, "password": "customer-password"}
+
+	var challenge struct {
+		ChallengeID string `json:"challenge_id"`
+	}
+	s.mustCall(http.MethodPost, "/customers/login", customer, nil, &challenge, http.StatusOK)
+
+	require.NotNil(s.T(), s.services.Mailbox)
+	mails := s.services.Mailbox.Mails()
+	require.NotEmpty(s.T(), mails)
+
+	var login struct {
+		Token string `json:"token"`
+	}
+	s.mustCall(http.MethodPatch, "/customers/auth-challenges/"+challenge.ChallengeID,
+		map[string]any{"code": mails[len(mails)-1].Secret}, nil, &login, http.StatusOK)
+	return login.Token
+}
+
+func (s *PlaceOrderE2ESuite) orderStatus(orderPath, token string) string {
+	var order struct {
+		Status string `json:"status"`
+	}
+	s.mustCall(http.MethodGet, orderPath, nil, bearer(token), &order, http.StatusOK)
+	return order.Status
+}
+
+// mustCall calls the gateway, requires the expected status and returns the
+// Location header of the response.
+func (s *PlaceOrderE2ESuite) mustCall(method, path string, body any, header http.Header, out any, expected int) string {
+	status, location := s.call(method, path, body, header, out)
+	require.Equal(s.T(), expected, status, "%s %s", method, path)
+	return location
+}
+
+func (s *PlaceOrderE2ESuite) call(method, path string, body any, header http.Header, out any) (int, string) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		require.NoError(s.T(), err)
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(s.ctx, method, s.services.APIURL+path, reader)
+	require.NoError(s.T(), err)
+	req.Header.Set("Content-Type", "application/json")
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, ""
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if out != nil && res.StatusCode < http.StatusMultipleChoices {
+		require.NoError(s.T(), json.NewDecoder(res.Body).Decode(out))
+	}
+	return res.StatusCode, res.Header.Get("Location")
+}
+
+func admin() http.Header {
+	return http.Header{"X-Access-Token": {adminToken}}
+}
+
+func bearer(token string) http.Header {
+	return http.Header{"Authorization": {"Bearer " + token}}
+}
+
+func TestPlaceOrderE2E(t *testing.T) {
+	suite.Run(t, new(PlaceOrderE2ESuite))
+}
@@ -0,0 +1,62 @@
+// Command allinone runs the order, warehouse, courier and customer services
+// and the API gateway in one process, with everything they store and every
+// message they exchange kept in memory. It needs neither Kafka nor any
+// database, and forgets everything when it exits. Build or run it with the
+// Makefile, which sets the protobuf registration conflict policy it needs.
+package main
+
+import (
+	"allinone/internal/services"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Config struct {
+	APIPort    int    `envconfig:"API_PORT" default:"8080"`
+	AdminToken string `envconfig:"ADMIN_TOKEN" default:"admin"`
+	LogLevel   string `envconfig:"LOG_LEVEL" default:"info"`
+}
+
+func main() {
+	var cfg Config
+	if err := envconfig.Process("", &cfg); err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	// Capturing termination signals
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+
+	// The services run until the context they were started with is done
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	svc, err := services.Start(ctx, services.Config{
+		APIPort:    cfg.APIPort,
+		AdminToken: cfg.AdminToken,
+		LogLevel:   cfg.LogLevel,
+	})
+	if err != nil {
+		log.Fatalf("Failed to start services: %v", err)
+	}
+	fmt.Printf("API listening on %s\n", svc.APIURL)
+
+	// Waiting for termination signal
+	sig := <-sigCh
+	log.Printf("Received signal: %v", sig)
+
+	// Graceful shutdown
+	stopCtx, stopCancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer stopCancel()
+
+	if err := svc.Stop(stopCtx); err != nil {
+		log.Fatalf("Failed to stop services: %v", err)
+	}
+}
@@ -0,0 +1,85 @@
+// Package app assembles the courier service, for cmd/main.go and for
+// binaries that boot it in one process with the other services.
+package app
+
+import (
+	appDI "courier/internal/application/di"
+	"courier/internal/infrastructure/auth"
+	infraDI "courier/internal/infrastructure/di"
+	"courier/internal/infrastructure/inbox"
+	"courier/internal/infrastructure/logger"
+	"courier/internal/infrastructure/memory"
+	"courier/internal/infrastructure/messaging"
+	"courier/internal/infrastructure/messaging/commit"
+	"courier/internal/infrastructure/messaging/retry"
+	"courier/internal/infrastructure/messaging/worker"
+	"courier/internal/infrastructure/telemetry"
+	presentationDI "courier/internal/presentation/di"
+	courierv1 "courier/internal/presentation/grpc"
+
+	"go.uber.org/fx"
+)
+
+// Module is the whole service. Its configuration is read from the
+// environment while the fx.App is being created, unless WithConfig sets it.
+var Module = fx.Options(
+	// Infrastructure modules
+	infraDI.LoggerModule,
+	infraDI.MessagingModule,
+	infraDI.DatabaseModule,
+	infraDI.RepositoryModule,
+	infraDI.InboxModule,
+	infraDI.TokenManagerModule,
+	infraDI.TelemetryModule,
+
+	// Application modules
+	appDI.UseCaseModule,
+
+	// Presentation modules
+	presentationDI.GRPCModule,
+	presentationDI.CommandConsumerModule,
+	presentationDI.TelemetryModule,
+)
+
+// Bus carries the messages of the services that run with IN_MEMORY set.
+type Bus = memory.Bus
+
+// WithBus has the service exchange its messages through bus instead of a
+// broker of its own when IN_MEMORY is set.
+func WithBus(bus Bus) fx.Option {
+	return fx.Decorate(func(memory.Bus) memory.Bus {
+		return bus
+	})
+}
+
+// Config is the configuration of the service running with IN_MEMORY set, for
+// binaries that set it up themselves rather than through the environment.
+type Config struct {
+	Logger    logger.Config
+	Telemetry telemetry.Config
+	Memory    memory.Config
+	Messaging messaging.Config
+	Retry     retry.Config
+	Commit    commit.Config
+	Worker    worker.Config
+	Inbox     inbox.Config
+	Auth      auth.Config
+	GRPC      courierv1.Config
+}
+
+// WithConfig has the service use cfg instead of reading its configuration
+// from the environment.
+func WithConfig(cfg Config) fx.Option {
+	return fx.Replace(
+		&cfg.Logger,
+		&cfg.Telemetry,
+		&cfg.Memory,
+		&cfg.Messaging,
+		&cfg.Retry,
+		&cfg.Commit,
+		&cfg.Worker,
+		&cfg.Inbox,
+		&cfg.Auth,
+		&cfg.GRPC,
+	)
+}
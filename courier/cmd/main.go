@@ -2,10 +2,8 @@ package main
 
 import (
 	"context"
-	appDI "courier/internal/application/di"
-	infraDI "courier/internal/infrastructure/di"
+	courierApp "courier/app"
 	"courier/internal/infrastructure/logger"
-	presentationDI "courier/internal/presentation/di"
 	"log"
 	"os"
 	"os/signal"
@@ -16,22 +14,12 @@ import (
 )
 
 func main() {
-	app := fx.New(
-		// Infrastructure modules
-		infraDI.LoggerModule,
-		infraDI.MessagingModule,
-		infraDI.DatabaseModule,
-		infraDI.RepositoryModule,
-		infraDI.TokenManagerModule,
-		infraDI.TelemetryModule,
-
-		// Application modules
-		appDI.UseCaseModule,
+	if len(os.Args) > 1 && os.Args[1] == "dlq" {
+		os.Exit(runDLQ(os.Args[2:]))
+	}
 
-		// Presentation modules
-		presentationDI.GRPCModule,
-		presentationDI.CommandConsumerModule,
-		presentationDI.TelemetryModule,
+	app := fx.New(
+		courierApp.Module,
 
 		// Add logging for application startup and shutdown
 		fx.Invoke(func(lc fx.Lifecycle, logger logger.Logger) {
@@ -1,8 +1,10 @@
 #!/bin/sh
 
-echo "Running migrations..."
-DATABASE_URL="postgres://${POSTGRES_USER}:${POSTGRES_PASSWORD}@${POSTGRES_HOST}:${POSTGRES_PORT}/${POSTGRES_DB}?sslmode=disable"
-migrate -source "file://${DB_MIGRATIONS_PATH}" -database "$DATABASE_URL" up
+if [ "$IN_MEMORY" != "true" ]; then
+  echo "Running migrations..."
+  DATABASE_URL="postgres://${POSTGRES_USER}:${POSTGRES_PASSWORD}@${POSTGRES_HOST}:${POSTGRES_PORT}/${POSTGRES_DB}?sslmode=disable"
+  migrate -source "file://${DB_MIGRATIONS_PATH}" -database "$DATABASE_URL" up
+fi
 
 echo "Starting the app..."
 exec ./main
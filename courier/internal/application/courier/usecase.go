@@ -2,9 +2,14 @@ package courier
 
 import (
 	"context"
+	courierDomain "courier/internal/domain/courier"
+
 	"github.com/google/uuid"
 )
 
 type UseCase interface {
 	AssignOrder(ctx context.Context, orderID uuid.UUID) (uuid.UUID, error)
+	ReleaseOrder(ctx context.Context, orderID uuid.UUID) error
+	ReassignOrder(ctx context.Context, orderID uuid.UUID, courierID uuid.UUID) error
+	GetByID(ctx context.Context, courierID uuid.UUID) (*courierDomain.Courier, error)
 }
@@ -2,34 +2,72 @@ package courier
 
 import (
 	"context"
+	assignmentDomain "courier/internal/domain/assignment"
 	courierDomain "courier/internal/domain/courier"
+	assignmentRepository "courier/internal/infrastructure/repository/assignment"
+	"errors"
 	"github.com/google/uuid"
 	"math/rand"
 )
 
 type UseCaseImpl struct {
-	repo courierDomain.Repository
+	repo           courierDomain.Repository
+	assignmentRepo assignmentDomain.Repository
 }
 
-func NewUseCase(repo courierDomain.Repository) *UseCaseImpl {
+func NewUseCase(repo courierDomain.Repository, assignmentRepo assignmentDomain.Repository) *UseCaseImpl {
 	return &UseCaseImpl{
-		repo: repo,
+		repo:           repo,
+		assignmentRepo: assignmentRepo,
 	}
 }
 
-func (u *UseCaseImpl) AssignOrder(ctx context.Context, _ uuid.UUID) (uuid.UUID, error) {
-	orders, err := u.repo.GetAll(ctx)
+// AssignOrder picks a courier for the order and records the assignment. An order
+// that already has a courier keeps it, so a repeated request gets the same answer.
+func (u *UseCaseImpl) AssignOrder(ctx context.Context, orderID uuid.UUID) (uuid.UUID, error) {
+	assignment, err := u.assignmentRepo.GetByOrderID(ctx, orderID)
+	if err == nil {
+		return assignment.CourierID, nil
+	}
+	if !errors.Is(err, assignmentRepository.ErrAssignmentNotFound) {
+		return uuid.Nil, err
+	}
+
+	couriers, err := u.repo.GetAll(ctx)
 	if err != nil {
 		return uuid.Nil, err
 	}
-	if len(orders) == 0 {
+	if len(couriers) == 0 {
 		return uuid.Nil, ErrAvailableCourierNotFound
 	}
 
-	orderIndex := rand.Intn(len(orders))
-	selectedOrder := orders[orderIndex]
+	courierIndex := rand.Intn(len(couriers))
+	selectedCourier := couriers[courierIndex]
+
+	if err = u.assignmentRepo.Create(ctx, assignmentDomain.Create(orderID, selectedCourier.ID)); err != nil {
+		return uuid.Nil, err
+	}
+
+	return selectedCourier.ID, nil
+}
+
+// ReleaseOrder frees the courier assigned to the order. Releasing an order
+// without a courier succeeds, since the order may never have been assigned.
+func (u *UseCaseImpl) ReleaseOrder(ctx context.Context, orderID uuid.UUID) error {
+	return u.assignmentRepo.DeleteByOrderID(ctx, orderID)
+}
+
+// ReassignOrder hands the order over to the given courier, replacing the
+// assignment it has, if any.
+func (u *UseCaseImpl) ReassignOrder(ctx context.Context, orderID uuid.UUID, courierID uuid.UUID) error {
+	if _, err := u.repo.GetByID(ctx, courierID); err != nil {
+		return err
+	}
+	return u.assignmentRepo.Save(ctx, assignmentDomain.Create(orderID, courierID))
+}
 
-	return selectedOrder.ID, nil
+func (u *UseCaseImpl) GetByID(ctx context.Context, courierID uuid.UUID) (*courierDomain.Courier, error) {
+	return u.repo.GetByID(ctx, courierID)
 }
 
 var _ UseCase = (*UseCaseImpl)(nil)
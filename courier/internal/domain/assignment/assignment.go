@@ -0,0 +1,15 @@
+package assignment
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Assignment records which courier delivers an order, so that repeated assignment
+// requests get the same courier and a canceled order can release it.
+type Assignment struct {
+	OrderID   uuid.UUID
+	CourierID uuid.UUID
+	Assigned  time.Time
+}
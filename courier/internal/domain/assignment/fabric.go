@@ -0,0 +1,15 @@
+package assignment
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func Create(orderID uuid.UUID, courierID uuid.UUID) *Assignment {
+	return &Assignment{
+		OrderID:   orderID,
+		CourierID: courierID,
+		Assigned:  time.Now(),
+	}
+}
@@ -0,0 +1,15 @@
+package assignment
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, assignment *Assignment) error
+	GetByOrderID(ctx context.Context, orderID uuid.UUID) (*Assignment, error)
+	// Save stores the assignment, replacing the one the order already has.
+	Save(ctx context.Context, assignment *Assignment) error
+	DeleteByOrderID(ctx context.Context, orderID uuid.UUID) error
+}
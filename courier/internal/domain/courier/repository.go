@@ -2,10 +2,13 @@ package courier
 
 import (
 	"context"
+
+	"github.com/google/uuid"
 )
 
 type Repository interface {
 	Create(ctx context.Context, courier *Courier) error
+	GetByID(ctx context.Context, courierID uuid.UUID) (*Courier, error)
 	GetByPhone(ctx context.Context, phone string) (*Courier, error)
 	GetAll(ctx context.Context) ([]*Courier, error)
 }
@@ -0,0 +1,16 @@
+package inbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Message records a consumed message together with the response produced for it,
+// so that a redelivery is answered with the same response instead of being handled again.
+type Message struct {
+	ID        uuid.UUID
+	Response  []byte
+	Processed time.Time
+	Expires   time.Time
+}
@@ -0,0 +1,5 @@
+begin;
+
+DROP TABLE IF EXISTS inbox_messages;
+
+end;
@@ -0,0 +1,11 @@
+begin;
+
+CREATE TABLE courier_assignments (
+    order_id UUID PRIMARY KEY,
+    courier_id UUID NOT NULL,
+    assigned TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX courier_assignments_courier_id_idx ON courier_assignments (courier_id);
+
+end;
@@ -0,0 +1,13 @@
+package tables
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CourierAssignment struct {
+	OrderID   uuid.UUID `gorm:"primaryKey"`
+	CourierID uuid.UUID
+	Assigned  time.Time
+}
@@ -0,0 +1,14 @@
+package tables
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InboxMessage struct {
+	ID        uuid.UUID `gorm:"primaryKey"`
+	Response  []byte
+	Processed time.Time
+	Expires   time.Time
+}
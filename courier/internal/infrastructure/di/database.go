@@ -2,14 +2,33 @@ package di
 
 import (
 	"courier/internal/infrastructure/db"
+	"courier/internal/infrastructure/memory"
 
 	"go.uber.org/fx"
+	"gorm.io/gorm"
 )
 
 var DatabaseModule = fx.Provide(
-	// Database configuration
-	db.NewConfig,
+	// In-memory configuration
+	memory.NewConfig,
+
+	// In-memory store, used when IN_MEMORY is set
+	memory.NewStore,
 
 	// Database connection
-	db.NewDB,
+	newDB,
 )
+
+// newDB connects to PostgreSQL unless IN_MEMORY is set, in which case no
+// POSTGRES_* settings are needed and it returns nil.
+func newDB(memCfg *memory.Config) (*gorm.DB, error) {
+	if memCfg.Enabled {
+		return nil, nil
+	}
+
+	cfg, err := db.NewConfig()
+	if err != nil {
+		return nil, err
+	}
+	return db.NewDB(cfg)
+}
@@ -3,10 +3,14 @@ package di
 import (
 	"context"
 	"courier/internal/infrastructure/logger"
+	"courier/internal/infrastructure/memory"
 	"courier/internal/infrastructure/messaging"
+	"courier/internal/infrastructure/messaging/commit"
+	"courier/internal/infrastructure/messaging/retry"
+	"courier/internal/infrastructure/messaging/worker"
 	"errors"
-	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
 
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/fx"
 )
 
@@ -15,6 +19,15 @@ var MessagingModule = fx.Options(
 		// Configuration
 		messaging.NewConfig,
 
+		// In-memory broker, used when IN_MEMORY is set
+		fx.Annotate(
+			memory.NewBroker,
+			fx.As(new(memory.Bus)),
+		),
+
+		// Transport the readers and writers are opened on
+		newTransport,
+
 		// Readers
 		fx.Annotate(
 			messaging.NewCourierCmdReader,
@@ -26,12 +39,41 @@ var MessagingModule = fx.Options(
 			messaging.NewCourierCmdResWriter,
 			fx.ResultTags(`name:"courierCmdResWriter"`),
 		),
+
+		// Dead-letter writers
+		fx.Annotate(
+			messaging.NewCourierCmdDLQWriter,
+			fx.ResultTags(`name:"courierCmdDLQWriter"`),
+		),
+
+		// Retry configuration
+		retry.NewConfig,
+
+		// Offset commit configuration
+		commit.NewConfig,
+
+		// Consumer worker pool configuration
+		worker.NewConfig,
 	),
 
 	// Kafka resources lifecycle management
 	fx.Invoke(setupMessagingLifecycle),
 )
 
+// newTransport opens the readers and writers on Kafka, or on the in-memory
+// broker when IN_MEMORY is set, in which case KAFKA_ADDRESS is not needed.
+func newTransport(
+	memCfg *memory.Config,
+	cfg *messaging.Config,
+	tp *sdktrace.TracerProvider,
+	bus memory.Bus,
+) (messaging.Transport, error) {
+	if memCfg.Enabled {
+		return memory.NewTransport(bus), nil
+	}
+	return messaging.NewKafkaTransport(cfg, tp)
+}
+
 func setupMessagingLifecycle(in struct {
 	fx.In
 
@@ -39,10 +81,13 @@ func setupMessagingLifecycle(in struct {
 	Logger    logger.Logger
 
 	// Readers
-	CourierCmdReader *otelkafkakonsumer.Reader `name:"courierCmdReader"`
+	CourierCmdReader messaging.Reader `name:"courierCmdReader"`
 
 	// Writers
-	CourierCmdResWriter *otelkafkakonsumer.Writer `name:"courierCmdResWriter"`
+	CourierCmdResWriter messaging.Writer `name:"courierCmdResWriter"`
+
+	// Dead-letter writers
+	CourierCmdDLQWriter messaging.Writer `name:"courierCmdDLQWriter"`
 }) {
 	in.Lifecycle.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
@@ -63,6 +108,9 @@ func setupMessagingLifecycle(in struct {
 			if err := closeWriter("courier command result writer", in.CourierCmdResWriter, in.Logger); err != nil {
 				errs = append(errs, err)
 			}
+			if err := closeWriter("courier command dlq writer", in.CourierCmdDLQWriter, in.Logger); err != nil {
+				errs = append(errs, err)
+			}
 
 			if len(errs) > 0 {
 				return errors.Join(errs...)
@@ -74,7 +122,7 @@ func setupMessagingLifecycle(in struct {
 	})
 }
 
-func closeReader(name string, reader *otelkafkakonsumer.Reader, logger logger.Logger) error {
+func closeReader(name string, reader messaging.Reader, logger logger.Logger) error {
 	if reader == nil {
 		return nil
 	}
@@ -87,7 +135,7 @@ func closeReader(name string, reader *otelkafkakonsumer.Reader, logger logger.Lo
 	return nil
 }
 
-func closeWriter(name string, writer *otelkafkakonsumer.Writer, logger logger.Logger) error {
+func closeWriter(name string, writer messaging.Writer, logger logger.Logger) error {
 	if writer == nil {
 		return nil
 	}
@@ -1,16 +1,46 @@
 package di
 
 import (
+	assignmentDomain "courier/internal/domain/assignment"
 	courierDomain "courier/internal/domain/courier"
+	inboxDomain "courier/internal/domain/inbox"
+	"courier/internal/infrastructure/memory"
+	assignmentRepository "courier/internal/infrastructure/repository/assignment"
 	courierRepository "courier/internal/infrastructure/repository/courier"
+	inboxRepository "courier/internal/infrastructure/repository/inbox"
 
 	"go.uber.org/fx"
+	"gorm.io/gorm"
 )
 
 var RepositoryModule = fx.Provide(
 	// Courier repository
-	fx.Annotate(
-		courierRepository.New,
-		fx.As(new(courierDomain.Repository)),
-	),
+	newCourierRepository,
+
+	// Courier assignment repository
+	newAssignmentRepository,
+
+	// Inbox repository
+	newInboxRepository,
 )
+
+func newCourierRepository(memCfg *memory.Config, db *gorm.DB, store *memory.Store) courierDomain.Repository {
+	if memCfg.Enabled {
+		return memory.NewCourierRepository(store)
+	}
+	return courierRepository.New(db)
+}
+
+func newAssignmentRepository(memCfg *memory.Config, db *gorm.DB, store *memory.Store) assignmentDomain.Repository {
+	if memCfg.Enabled {
+		return memory.NewAssignmentRepository(store)
+	}
+	return assignmentRepository.New(db)
+}
+
+func newInboxRepository(memCfg *memory.Config, db *gorm.DB, store *memory.Store) inboxDomain.Repository {
+	if memCfg.Enabled {
+		return memory.NewInboxRepository(store)
+	}
+	return inboxRepository.New(db)
+}
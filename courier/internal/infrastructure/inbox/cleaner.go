@@ -0,0 +1,103 @@
+package inbox
+
+import (
+	"context"
+	"courier/internal/domain/inbox"
+	"courier/internal/infrastructure/logger"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Cleaner periodically removes inbox messages whose retention window has passed.
+type Cleaner struct {
+	repository inbox.Repository
+	interval   time.Duration
+
+	cancelCtx  context.Context
+	cancelFunc context.CancelFunc
+
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	started bool
+
+	logger logger.Logger
+}
+
+func NewCleaner(repository inbox.Repository, cfg *Config, logger logger.Logger) *Cleaner {
+	return &Cleaner{
+		repository: repository,
+		interval:   cfg.CleanupInterval,
+		logger:     logger,
+	}
+}
+
+func (c *Cleaner) log(level logger.Level, action, message string, extra map[string]any) {
+	fields := map[string]any{
+		"component": "inbox_cleaner",
+		"action":    action,
+	}
+	for k, v := range extra {
+		fields[k] = v
+	}
+
+	c.logger.Log(level, message, fields)
+}
+
+func (c *Cleaner) Start(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.started {
+		return errors.New("inbox cleaner is already running; no need to start again")
+	}
+
+	c.cancelCtx, c.cancelFunc = context.WithCancel(ctx)
+	c.started = true
+
+	c.log(logger.Info, "start", "Inbox cleaner started", nil)
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.clean(c.cancelCtx)
+	}()
+
+	return nil
+}
+
+func (c *Cleaner) Stop() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.started {
+		return errors.New("inbox cleaner is not running or already stopped")
+	}
+
+	c.cancelFunc()
+	c.wg.Wait()
+	c.started = false
+
+	c.log(logger.Info, "stopped", "Inbox cleaner stopped", nil)
+
+	return nil
+}
+
+func (c *Cleaner) clean(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.log(logger.Info, "stopping", "Inbox cleaner stopping due to context cancellation", nil)
+			return
+		case <-ticker.C:
+			if err := c.repository.DeleteExpired(ctx, time.Now()); err != nil {
+				c.log(logger.Error, "clean_error", "Error deleting expired inbox messages", map[string]any{
+					"error": err.Error(),
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package inbox
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Config struct {
+	Retention       time.Duration `envconfig:"INBOX_RETENTION" required:"true"`
+	CleanupInterval time.Duration `envconfig:"INBOX_CLEANUP_INTERVAL" required:"true"`
+}
+
+func NewConfig() (*Config, error) {
+	var cfg Config
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, fmt.Errorf("failed to load inbox config: %w", err)
+	}
+	return &cfg, nil
+}
@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	assignmentDomain "courier/internal/domain/assignment"
+	assignmentRepository "courier/internal/infrastructure/repository/assignment"
+
+	"github.com/google/uuid"
+)
+
+// AssignmentRepository keeps the courier assignments in a Store, one per order.
+type AssignmentRepository struct {
+	store *Store
+}
+
+func NewAssignmentRepository(store *Store) *AssignmentRepository {
+	return &AssignmentRepository{store: store}
+}
+
+func (r *AssignmentRepository) Create(ctx context.Context, assignment *assignmentDomain.Assignment) error {
+	return r.store.run(func() error {
+		if _, ok := r.store.assignments[assignment.OrderID]; ok {
+			return assignmentRepository.ErrAssignmentAlreadyExists
+		}
+		stored := *assignment
+		r.store.assignments[assignment.OrderID] = &stored
+		return nil
+	})
+}
+
+func (r *AssignmentRepository) GetByOrderID(ctx context.Context, orderID uuid.UUID) (*assignmentDomain.Assignment, error) {
+	var assignment *assignmentDomain.Assignment
+	err := r.store.run(func() error {
+		stored, ok := r.store.assignments[orderID]
+		if !ok {
+			return assignmentRepository.ErrAssignmentNotFound
+		}
+		found := *stored
+		assignment = &found
+		return nil
+	})
+	return assignment, err
+}
+
+func (r *AssignmentRepository) Save(ctx context.Context, assignment *assignmentDomain.Assignment) error {
+	return r.store.run(func() error {
+		stored := *assignment
+		r.store.assignments[assignment.OrderID] = &stored
+		return nil
+	})
+}
+
+// DeleteByOrderID removes the assignment of the order; deleting a missing one is not an error.
+func (r *AssignmentRepository) DeleteByOrderID(ctx context.Context, orderID uuid.UUID) error {
+	return r.store.run(func() error {
+		delete(r.store.assignments, orderID)
+		return nil
+	})
+}
+
+var _ assignmentDomain.Repository = (*AssignmentRepository)(nil)
@@ -0,0 +1,22 @@
+package memory
+
+import (
+	"fmt"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+// Config switches the service to the in-memory adapters of this package. With
+// Enabled set, neither PostgreSQL nor Kafka is contacted, and everything
+// stored is lost when the process exits.
+type Config struct {
+	Enabled bool `envconfig:"IN_MEMORY" default:"false"`
+}
+
+func NewConfig() (*Config, error) {
+	var cfg Config
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, fmt.Errorf("failed to load in-memory config: %w", err)
+	}
+	return &cfg, nil
+}
@@ -0,0 +1,84 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	courierDomain "courier/internal/domain/courier"
+	courierRepository "courier/internal/infrastructure/repository/courier"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// CourierRepository keeps the couriers in a Store. Phones are unique, as the
+// constraint of the couriers table makes them.
+type CourierRepository struct {
+	store *Store
+}
+
+func NewCourierRepository(store *Store) *CourierRepository {
+	return &CourierRepository{store: store}
+}
+
+func (r *CourierRepository) Create(ctx context.Context, courier *courierDomain.Courier) error {
+	return r.store.run(func() error {
+		if _, ok := r.store.couriers[courier.ID]; ok {
+			return courierRepository.ErrCourierAlreadyExists
+		}
+		for _, stored := range r.store.couriers {
+			if stored.Phone == courier.Phone {
+				return courierRepository.ErrCourierPhoneAlreadyExists
+			}
+		}
+		r.store.couriers[courier.ID] = cloneCourier(courier)
+		return nil
+	})
+}
+
+func (r *CourierRepository) GetByID(ctx context.Context, courierID uuid.UUID) (*courierDomain.Courier, error) {
+	return r.find(func(c *courierDomain.Courier) bool { return c.ID == courierID })
+}
+
+func (r *CourierRepository) GetByPhone(ctx context.Context, phone string) (*courierDomain.Courier, error) {
+	return r.find(func(c *courierDomain.Courier) bool { return c.Phone == phone })
+}
+
+// GetAll returns the couriers ordered by creation.
+func (r *CourierRepository) GetAll(ctx context.Context) ([]*courierDomain.Courier, error) {
+	couriers := make([]*courierDomain.Courier, 0)
+	_ = r.store.run(func() error {
+		for _, courier := range r.store.couriers {
+			couriers = append(couriers, cloneCourier(courier))
+		}
+		return nil
+	})
+	slices.SortFunc(couriers, func(a, b *courierDomain.Courier) int {
+		if c := a.Created.Compare(b.Created); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID.String(), b.ID.String())
+	})
+	return couriers, nil
+}
+
+func (r *CourierRepository) find(match func(*courierDomain.Courier) bool) (*courierDomain.Courier, error) {
+	var courier *courierDomain.Courier
+	err := r.store.run(func() error {
+		for _, stored := range r.store.couriers {
+			if match(stored) {
+				courier = cloneCourier(stored)
+				return nil
+			}
+		}
+		return courierRepository.ErrCourierNotFound
+	})
+	return courier, err
+}
+
+func cloneCourier(courier *courierDomain.Courier) *courierDomain.Courier {
+	c := *courier
+	c.Password = slices.Clone(courier.Password)
+	return &c
+}
+
+var _ courierDomain.Repository = (*CourierRepository)(nil)
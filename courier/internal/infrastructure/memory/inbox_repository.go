@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	inboxDomain "courier/internal/domain/inbox"
+	inboxRepository "courier/internal/infrastructure/repository/inbox"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InboxRepository keeps the inbox messages in a Store.
+type InboxRepository struct {
+	store *Store
+}
+
+func NewInboxRepository(store *Store) *InboxRepository {
+	return &InboxRepository{store: store}
+}
+
+func (r *InboxRepository) Create(ctx context.Context, message *inboxDomain.Message) error {
+	return r.store.run(func() error {
+		if _, ok := r.store.inbox[message.ID]; ok {
+			return inboxRepository.ErrInboxMessageAlreadyExists
+		}
+		r.store.inbox[message.ID] = cloneInboxMessage(message)
+		return nil
+	})
+}
+
+func (r *InboxRepository) GetByID(ctx context.Context, messageID uuid.UUID) (*inboxDomain.Message, error) {
+	var message *inboxDomain.Message
+	err := r.store.run(func() error {
+		stored, ok := r.store.inbox[messageID]
+		if !ok {
+			return inboxRepository.ErrInboxMessageNotFound
+		}
+		message = cloneInboxMessage(stored)
+		return nil
+	})
+	return message, err
+}
+
+func (r *InboxRepository) DeleteExpired(ctx context.Context, now time.Time) error {
+	return r.store.run(func() error {
+		for id, message := range r.store.inbox {
+			if !message.Expires.After(now) {
+				delete(r.store.inbox, id)
+			}
+		}
+		return nil
+	})
+}
+
+func cloneInboxMessage(message *inboxDomain.Message) *inboxDomain.Message {
+	c := *message
+	c.Response = slices.Clone(message.Response)
+	return &c
+}
+
+var _ inboxDomain.Repository = (*InboxRepository)(nil)
@@ -0,0 +1,33 @@
+package memory
+
+import (
+	assignmentDomain "courier/internal/domain/assignment"
+	courierDomain "courier/internal/domain/courier"
+	inboxDomain "courier/internal/domain/inbox"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Store keeps the data of the service in the process. It is safe for
+// concurrent use; every repository operation holds it while it runs.
+type Store struct {
+	mu          sync.Mutex
+	couriers    map[uuid.UUID]*courierDomain.Courier
+	assignments map[uuid.UUID]*assignmentDomain.Assignment
+	inbox       map[uuid.UUID]*inboxDomain.Message
+}
+
+func NewStore() *Store {
+	return &Store{
+		couriers:    make(map[uuid.UUID]*courierDomain.Courier),
+		assignments: make(map[uuid.UUID]*assignmentDomain.Assignment),
+		inbox:       make(map[uuid.UUID]*inboxDomain.Message),
+	}
+}
+
+func (s *Store) run(fn func() error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn()
+}
@@ -0,0 +1,149 @@
+package commit
+
+import (
+	"context"
+	"courier/internal/infrastructure/logger"
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// flushTimeout bounds the final commit made while the consumer shuts down.
+const flushTimeout = 10 * time.Second
+
+// OffsetCommitter is the part of a Kafka reader that stores consumer group offsets.
+type OffsetCommitter interface {
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+// Committer batches offset commits per partition. A fetched message is committed only
+// once it and every message fetched before it from the same partition have been handled.
+type Committer struct {
+	reader   OffsetCommitter
+	interval time.Duration
+
+	mu         sync.Mutex
+	partitions map[int]*partition
+
+	logger logger.Logger
+}
+
+type partition struct {
+	inFlight []int64
+	handled  map[int64]struct{}
+	ready    *kafka.Message
+}
+
+func NewCommitter(reader OffsetCommitter, cfg *Config, logger logger.Logger) *Committer {
+	return &Committer{
+		reader:     reader,
+		interval:   cfg.Interval,
+		partitions: make(map[int]*partition),
+		logger:     logger,
+	}
+}
+
+func (c *Committer) log(level logger.Level, action, message string, extraFields map[string]any) {
+	fields := map[string]any{
+		"component": "offset_committer",
+		"action":    action,
+	}
+	for k, v := range extraFields {
+		fields[k] = v
+	}
+
+	c.logger.Log(level, message, fields)
+}
+
+// Track registers a fetched message. Messages must be tracked in fetch order.
+func (c *Committer) Track(msg *kafka.Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	p, ok := c.partitions[msg.Partition]
+	if !ok {
+		p = &partition{handled: make(map[int64]struct{})}
+		c.partitions[msg.Partition] = p
+	}
+	p.inFlight = append(p.inFlight, msg.Offset)
+}
+
+// Done marks a tracked message as handled, making it eligible for the next commit.
+func (c *Committer) Done(msg *kafka.Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	p, ok := c.partitions[msg.Partition]
+	if !ok {
+		return
+	}
+	p.handled[msg.Offset] = struct{}{}
+
+	for len(p.inFlight) > 0 {
+		offset := p.inFlight[0]
+		if _, ok := p.handled[offset]; !ok {
+			break
+		}
+		delete(p.handled, offset)
+		p.inFlight = p.inFlight[1:]
+		p.ready = &kafka.Message{Topic: msg.Topic, Partition: msg.Partition, Offset: offset}
+	}
+}
+
+// Flush commits the highest handled offset of every partition in a single request.
+func (c *Committer) Flush(ctx context.Context) error {
+	c.mu.Lock()
+	var msgs []kafka.Message
+	for _, p := range c.partitions {
+		if p.ready != nil {
+			msgs = append(msgs, *p.ready)
+		}
+	}
+	c.mu.Unlock()
+
+	if len(msgs) == 0 {
+		return nil
+	}
+	if err := c.reader.CommitMessages(ctx, msgs...); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, msg := range msgs {
+		if p := c.partitions[msg.Partition]; p.ready != nil && p.ready.Offset == msg.Offset {
+			p.ready = nil
+		}
+	}
+	return nil
+}
+
+// Run flushes pending commits every interval until ctx is cancelled, then flushes
+// once more so that everything handled before shutdown is committed.
+func (c *Committer) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+			defer cancel()
+
+			if err := c.Flush(flushCtx); err != nil {
+				c.log(logger.Error, "final_commit_error", "Failed to commit offsets on shutdown", map[string]any{
+					"error": err.Error(),
+				})
+			}
+			return
+
+		case <-ticker.C:
+			if err := c.Flush(ctx); err != nil && ctx.Err() == nil {
+				c.log(logger.Error, "commit_error", "Failed to commit offsets", map[string]any{
+					"error": err.Error(),
+				})
+			}
+		}
+	}
+}
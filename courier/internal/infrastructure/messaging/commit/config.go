@@ -0,0 +1,20 @@
+package commit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Config struct {
+	Interval time.Duration `envconfig:"KAFKA_COMMIT_INTERVAL" required:"true"`
+}
+
+func NewConfig() (*Config, error) {
+	var cfg Config
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, fmt.Errorf("failed to load kafka commit config: %w", err)
+	}
+	return &cfg, nil
+}
@@ -7,11 +7,13 @@ import (
 )
 
 type Config struct {
-	Address string `envconfig:"KAFKA_ADDRESS" required:"true"`
+	// Address is only needed when the messages go through Kafka.
+	Address string `envconfig:"KAFKA_ADDRESS"`
 
 	CourierCmdTopic           string `envconfig:"KAFKA_COURIER_COMMAND_TOPIC" required:"true"`
 	CourierCmdResTopic        string `envconfig:"KAFKA_COURIER_COMMAND_RESULT_TOPIC" required:"true"`
 	CourierCmdConsumerGroupID string `envconfig:"KAFKA_COURIER_COMMAND_CONSUMER_GROUP_ID" required:"true"`
+	CourierCmdDLQTopic        string `envconfig:"KAFKA_COURIER_COMMAND_DLQ_TOPIC" required:"true"`
 }
 
 func NewConfig() (*Config, error) {
@@ -0,0 +1,163 @@
+package dlq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/segmentio/kafka-go"
+)
+
+var ErrMessageNotFound = errors.New("dlq message not found")
+
+// Inspector reads dead-lettered messages and replays them onto their source topics.
+// It reads partitions directly, without a consumer group, so listing never moves offsets.
+type Inspector struct {
+	address string
+}
+
+func NewInspector(address string) *Inspector {
+	return &Inspector{address: address}
+}
+
+// List returns up to limit messages of the DLQ topic, oldest first per partition; limit <= 0 means all.
+func (i *Inspector) List(ctx context.Context, topic string, limit int) ([]*Message, error) {
+	partitions, err := i.readPartitions(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []*Message
+	for _, partition := range partitions {
+		if limit > 0 && len(messages) >= limit {
+			break
+		}
+
+		partitionMessages, err := i.listPartition(ctx, topic, partition.ID, limit-len(messages))
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, partitionMessages...)
+	}
+
+	return messages, nil
+}
+
+// Get returns the message at the offset of the DLQ partition. It seeks straight
+// to it, so it finds the message however many come before it, and fails with
+// ErrMessageNotFound when the partition holds none at that offset.
+func (i *Inspector) Get(ctx context.Context, topic string, partition int, offset int64) (*Message, error) {
+	first, last, err := i.readOffsets(ctx, topic, partition)
+	if err != nil {
+		return nil, err
+	}
+	if offset < first || offset >= last {
+		return nil, ErrMessageNotFound
+	}
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:   []string{i.address},
+		Topic:     topic,
+		Partition: partition,
+	})
+	defer reader.Close()
+
+	if err := reader.SetOffset(offset); err != nil {
+		return nil, fmt.Errorf("error seeking %s/%d: %w", topic, partition, err)
+	}
+
+	msg, err := reader.ReadMessage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s/%d: %w", topic, partition, err)
+	}
+	// A compacted partition may resume after the offset.
+	if msg.Offset != offset {
+		return nil, ErrMessageNotFound
+	}
+	return fromKafkaMessage(msg), nil
+}
+
+// Replay writes the messages back onto their source topics with the original key, value and headers.
+func (i *Inspector) Replay(ctx context.Context, messages []*Message) error {
+	writer := &kafka.Writer{Addr: kafka.TCP(i.address), Balancer: &kafka.Hash{}}
+	defer writer.Close()
+
+	for _, message := range messages {
+		if message.SourceTopic == "" {
+			return fmt.Errorf("dlq message %d/%d has no source topic", message.Partition, message.Offset)
+		}
+
+		err := writer.WriteMessages(ctx, kafka.Message{
+			Topic:   message.SourceTopic,
+			Key:     message.Key,
+			Value:   message.Value,
+			Headers: message.Headers,
+		})
+		if err != nil {
+			return fmt.Errorf("error replaying dlq message %d/%d: %w", message.Partition, message.Offset, err)
+		}
+	}
+
+	return nil
+}
+
+func (i *Inspector) readPartitions(topic string) ([]kafka.Partition, error) {
+	conn, err := kafka.Dial("tcp", i.address)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to kafka: %w", err)
+	}
+	defer conn.Close()
+
+	partitions, err := conn.ReadPartitions(topic)
+	if err != nil {
+		return nil, fmt.Errorf("error reading partitions of %s: %w", topic, err)
+	}
+	return partitions, nil
+}
+
+func (i *Inspector) listPartition(ctx context.Context, topic string, partition, limit int) ([]*Message, error) {
+	first, last, err := i.readOffsets(ctx, topic, partition)
+	if err != nil {
+		return nil, err
+	}
+	if first >= last {
+		return nil, nil
+	}
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:   []string{i.address},
+		Topic:     topic,
+		Partition: partition,
+	})
+	defer reader.Close()
+
+	if err := reader.SetOffset(first); err != nil {
+		return nil, fmt.Errorf("error seeking %s/%d: %w", topic, partition, err)
+	}
+
+	var messages []*Message
+	for offset := first; offset < last; {
+		if limit > 0 && len(messages) >= limit {
+			break
+		}
+
+		msg, err := reader.ReadMessage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s/%d: %w", topic, partition, err)
+		}
+		messages = append(messages, fromKafkaMessage(msg))
+		offset = msg.Offset + 1
+	}
+
+	return messages, nil
+}
+
+func (i *Inspector) readOffsets(ctx context.Context, topic string, partition int) (int64, int64, error) {
+	conn, err := kafka.DialLeader(ctx, "tcp", i.address, topic, partition)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error connecting to leader of %s/%d: %w", topic, partition, err)
+	}
+	defer conn.Close()
+
+	return conn.ReadOffsets()
+}
@@ -0,0 +1,36 @@
+package assignment
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrAssignmentAlreadyExists = errors.New("courier assignment already exists")
+	ErrAssignmentNotFound      = errors.New("courier assignment not found")
+)
+
+func ParseError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrAssignmentNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return parsePgError(pgErr)
+	}
+
+	return err
+}
+
+func parsePgError(err *pgconn.PgError) error {
+	switch err.ConstraintName {
+	case "courier_assignments_pkey":
+		return ErrAssignmentAlreadyExists
+
+	default:
+		return fmt.Errorf("courier assignment not saved: %v", err)
+	}
+}
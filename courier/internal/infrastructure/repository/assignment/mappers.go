@@ -0,0 +1,22 @@
+package assignment
+
+import (
+	assignmentDomain "courier/internal/domain/assignment"
+	"courier/internal/infrastructure/db/tables"
+)
+
+func ToDomain(model *tables.CourierAssignment) *assignmentDomain.Assignment {
+	return &assignmentDomain.Assignment{
+		OrderID:   model.OrderID,
+		CourierID: model.CourierID,
+		Assigned:  model.Assigned,
+	}
+}
+
+func ToModel(domain *assignmentDomain.Assignment) *tables.CourierAssignment {
+	return &tables.CourierAssignment{
+		OrderID:   domain.OrderID,
+		CourierID: domain.CourierID,
+		Assigned:  domain.Assigned,
+	}
+}
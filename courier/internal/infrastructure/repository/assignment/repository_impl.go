@@ -0,0 +1,44 @@
+package assignment
+
+import (
+	"context"
+	assignmentDomain "courier/internal/domain/assignment"
+	"courier/internal/infrastructure/db/tables"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RepositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) *RepositoryImpl {
+	return &RepositoryImpl{db: db}
+}
+
+func (r *RepositoryImpl) Create(ctx context.Context, assignment *assignmentDomain.Assignment) error {
+	res := r.db.WithContext(ctx).Create(ToModel(assignment))
+	return ParseError(res.Error)
+}
+
+func (r *RepositoryImpl) GetByOrderID(ctx context.Context, orderID uuid.UUID) (*assignmentDomain.Assignment, error) {
+	var model tables.CourierAssignment
+	res := r.db.WithContext(ctx).First(&model, "order_id = ?", orderID)
+	if res.Error != nil {
+		return nil, ParseError(res.Error)
+	}
+	return ToDomain(&model), nil
+}
+
+func (r *RepositoryImpl) Save(ctx context.Context, assignment *assignmentDomain.Assignment) error {
+	res := r.db.WithContext(ctx).Save(ToModel(assignment))
+	return ParseError(res.Error)
+}
+
+// DeleteByOrderID removes the assignment of the order; deleting a missing one is not an error.
+func (r *RepositoryImpl) DeleteByOrderID(ctx context.Context, orderID uuid.UUID) error {
+	res := r.db.WithContext(ctx).Delete(&tables.CourierAssignment{}, "order_id = ?", orderID)
+	return ParseError(res.Error)
+}
+
+var _ assignmentDomain.Repository = (*RepositoryImpl)(nil)
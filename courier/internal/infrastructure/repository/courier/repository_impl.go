@@ -4,6 +4,8 @@ import (
 	"context"
 	courierDomain "courier/internal/domain/courier"
 	"courier/internal/infrastructure/db/tables"
+
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -21,6 +23,15 @@ func (r *RepositoryImpl) Create(ctx context.Context, courier *courierDomain.Cour
 	return ParseError(res.Error)
 }
 
+func (r *RepositoryImpl) GetByID(ctx context.Context, courierID uuid.UUID) (*courierDomain.Courier, error) {
+	var model tables.Courier
+	res := r.db.WithContext(ctx).First(&model, "id = ?", courierID)
+	if res.Error != nil {
+		return nil, ParseError(res.Error)
+	}
+	return ToDomain(&model), nil
+}
+
 func (r *RepositoryImpl) GetByPhone(ctx context.Context, phone string) (*courierDomain.Courier, error) {
 	var model tables.Courier
 	res := r.db.WithContext(ctx).First(&model, "phone = ?", phone)
@@ -0,0 +1,36 @@
+package inbox
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInboxMessageAlreadyExists = errors.New("inbox message already exists")
+	ErrInboxMessageNotFound      = errors.New("inbox message not found")
+)
+
+func ParseError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrInboxMessageNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return parsePgError(pgErr)
+	}
+
+	return err
+}
+
+func parsePgError(err *pgconn.PgError) error {
+	switch err.ConstraintName {
+	case "inbox_messages_pkey":
+		return ErrInboxMessageAlreadyExists
+
+	default:
+		return fmt.Errorf("inbox message not saved: %v", err)
+	}
+}
@@ -0,0 +1,34 @@
+package assignment
+
+import (
+	"context"
+	assignmentDomain "courier/internal/domain/assignment"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type RepositoryMock struct {
+	mock.Mock
+}
+
+func (r *RepositoryMock) Create(ctx context.Context, assignment *assignmentDomain.Assignment) error {
+	args := r.Called(ctx, assignment)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) GetByOrderID(ctx context.Context, orderID uuid.UUID) (*assignmentDomain.Assignment, error) {
+	args := r.Called(ctx, orderID)
+	return args.Get(0).(*assignmentDomain.Assignment), args.Error(1)
+}
+
+func (r *RepositoryMock) Save(ctx context.Context, assignment *assignmentDomain.Assignment) error {
+	args := r.Called(ctx, assignment)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) DeleteByOrderID(ctx context.Context, orderID uuid.UUID) error {
+	args := r.Called(ctx, orderID)
+	return args.Error(0)
+}
+
+var _ assignmentDomain.Repository = (*RepositoryMock)(nil)
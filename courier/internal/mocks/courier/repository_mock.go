@@ -3,6 +3,8 @@ package courier
 import (
 	"context"
 	courierDomain "courier/internal/domain/courier"
+
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -15,6 +17,11 @@ func (r *RepositoryMock) Create(ctx context.Context, courier *courierDomain.Cour
 	return args.Error(0)
 }
 
+func (r *RepositoryMock) GetByID(ctx context.Context, courierID uuid.UUID) (*courierDomain.Courier, error) {
+	args := r.Called(ctx, courierID)
+	return args.Get(0).(*courierDomain.Courier), args.Error(1)
+}
+
 func (r *RepositoryMock) GetByPhone(ctx context.Context, phone string) (*courierDomain.Courier, error) {
 	args := r.Called(ctx, phone)
 	return args.Get(0).(*courierDomain.Courier), args.Error(1)
@@ -4,10 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
 )
 
 const (
-	AssignCourierCmdName CmdMessageName = "create_order.assign_courier"
+	AssignCourierCmdName   CmdMessageName = "create_order.assign_courier"
+	ReleaseCourierCmdName  CmdMessageName = "cancel_order.release_courier"
+	ReassignCourierCmdName CmdMessageName = "order.reassign_courier"
 )
 
 type (
@@ -24,9 +27,19 @@ type (
 		Msg       *CmdMessage
 		Topic     string
 		Partition int
+		Raw       *kafka.Message
 	}
 )
 
 type AssignCourierCmd struct {
 	OrderID uuid.UUID
 }
+
+type ReleaseCourierCmd struct {
+	OrderID uuid.UUID
+}
+
+type ReassignCourierCmd struct {
+	OrderID   uuid.UUID
+	CourierID uuid.UUID
+}
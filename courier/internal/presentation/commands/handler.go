@@ -3,7 +3,10 @@ package commands
 import (
 	"context"
 	courierApplication "courier/internal/application/courier"
+	"courier/internal/infrastructure/messaging/retry"
+	courierRepository "courier/internal/infrastructure/repository/courier"
 	"encoding/json"
+	"errors"
 	"fmt"
 )
 
@@ -24,21 +27,63 @@ func (h *HandlerImpl) Handle(ctx context.Context, cmdMsg *CmdMessage) (*ResMessa
 	case AssignCourierCmdName:
 		var cmd AssignCourierCmd
 		if err := json.Unmarshal(cmdMsg.Payload, &cmd); err != nil {
-			return nil, fmt.Errorf("failed to parse ReserveItemsCmd: %w", err)
+			return nil, retry.Permanent(fmt.Errorf("failed to parse ReserveItemsCmd: %w", err))
 		}
-		return h.onAssignOrder(ctx, cmd), nil
+		return h.onAssignOrder(ctx, cmd)
+
+	case ReleaseCourierCmdName:
+		var cmd ReleaseCourierCmd
+		if err := json.Unmarshal(cmdMsg.Payload, &cmd); err != nil {
+			return nil, retry.Permanent(fmt.Errorf("failed to parse ReleaseCourierCmd: %w", err))
+		}
+		return h.onReleaseOrder(ctx, cmd)
+
+	case ReassignCourierCmdName:
+		var cmd ReassignCourierCmd
+		if err := json.Unmarshal(cmdMsg.Payload, &cmd); err != nil {
+			return nil, retry.Permanent(fmt.Errorf("failed to parse ReassignCourierCmd: %w", err))
+		}
+		return nil, h.onReassignOrder(ctx, cmd)
 	}
 
-	return nil, fmt.Errorf("unknown command: %s", cmdMsg.Name)
+	return nil, retry.Permanent(fmt.Errorf("unknown command: %s", cmdMsg.Name))
 }
 
-func (h *HandlerImpl) onAssignOrder(ctx context.Context, cmd AssignCourierCmd) *ResMessage {
+// onAssignOrder replies that the assignment failed only when no courier is
+// available; other errors are returned to be retried.
+func (h *HandlerImpl) onAssignOrder(ctx context.Context, cmd AssignCourierCmd) (*ResMessage, error) {
 	courierID, err := h.usecase.AssignOrder(ctx, cmd.OrderID)
 
+	if errors.Is(err, courierApplication.ErrAvailableCourierNotFound) {
+		return toCourierAssignmentFailed(cmd.OrderID), nil
+	}
 	if err != nil {
-		return toCourierAssignmentFailed(cmd.OrderID)
+		return nil, err
+	}
+	return toCourierAssigned(cmd.OrderID, courierID), nil
+}
+
+func (h *HandlerImpl) onReleaseOrder(ctx context.Context, cmd ReleaseCourierCmd) (*ResMessage, error) {
+	if err := h.usecase.ReleaseOrder(ctx, cmd.OrderID); err != nil {
+		return nil, err
+	}
+	return toCourierReleased(cmd.OrderID), nil
+}
+
+// onReassignOrder applies a reassignment an admin made in the order service. No
+// reply is expected; a courier that does not exist is not retried.
+func (h *HandlerImpl) onReassignOrder(ctx context.Context, cmd ReassignCourierCmd) error {
+	err := h.usecase.ReassignOrder(ctx, cmd.OrderID, cmd.CourierID)
+
+	if errors.Is(err, courierRepository.ErrCourierNotFound) {
+		return retry.Permanent(err)
 	}
-	return toCourierAssigned(cmd.OrderID, courierID)
+	return err
+}
+
+// IsRetryable reports whether a failed command may succeed when handled again.
+func IsRetryable(err error) bool {
+	return !retry.IsPermanent(err)
 }
 
 var _ Handler = (*HandlerImpl)(nil)
@@ -8,6 +8,12 @@ func toCourierAssignmentFailed(orderID uuid.UUID) *ResMessage {
 	})
 }
 
+func toCourierReleased(orderID uuid.UUID) *ResMessage {
+	return newResMessage(CourierReleasedName, CourierReleased{
+		OrderID: orderID,
+	})
+}
+
 func toCourierAssigned(orderID uuid.UUID, courierID uuid.UUID) *ResMessage {
 	return newResMessage(CourierAssignedName, CourierAssigned{
 		OrderID:   orderID,
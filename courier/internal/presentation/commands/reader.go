@@ -3,6 +3,9 @@ package commands
 import (
 	"context"
 	"courier/internal/infrastructure/logger"
+	"courier/internal/infrastructure/messaging"
+	"courier/internal/infrastructure/messaging/commit"
+	"courier/internal/infrastructure/messaging/dlq"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,11 +18,14 @@ import (
 type Reader interface {
 	Start(ctx context.Context) error
 	Read(ctx context.Context) (*CmdEnvelope, error)
+	Commit(cmd *CmdEnvelope)
 	Stop() error
 }
 
 type ReaderImpl struct {
-	reader      *otelkafkakonsumer.Reader
+	reader      messaging.Reader
+	committer   *commit.Committer
+	dlqWriter   dlq.Writer
 	commandChan chan *CmdEnvelope
 	errorChan   chan error
 
@@ -33,10 +39,17 @@ type ReaderImpl struct {
 	logger logger.Logger
 }
 
-func NewReader(reader *otelkafkakonsumer.Reader, logger logger.Logger) *ReaderImpl {
+func NewReader(
+	reader messaging.Reader,
+	dlqWriter dlq.Writer,
+	commitCfg *commit.Config,
+	logger logger.Logger,
+) *ReaderImpl {
 	return &ReaderImpl{
-		reader: reader,
-		logger: logger,
+		reader:    reader,
+		committer: commit.NewCommitter(reader, commitCfg, logger),
+		dlqWriter: dlqWriter,
+		logger:    logger,
 	}
 }
 
@@ -44,7 +57,7 @@ func (r *ReaderImpl) log(level logger.Level, action, message string, extraFields
 	fields := map[string]any{
 		"component": "command_reader",
 		"action":    action,
-		"topic":     r.reader.R.Config().Topic,
+		"topic":     r.reader.Topic(),
 	}
 	for k, v := range extraFields {
 		fields[k] = v
@@ -78,11 +91,15 @@ func (r *ReaderImpl) Start(ctx context.Context) error {
 	r.started = true
 
 	r.log(logger.Info, "start", "Starting command reader", nil)
-	r.wg.Add(1)
+	r.wg.Add(2)
 	go func() {
 		defer r.wg.Done()
 		r.readCommands(r.cancelCtx)
 	}()
+	go func() {
+		defer r.wg.Done()
+		r.committer.Run(r.cancelCtx)
+	}()
 	return nil
 }
 
@@ -122,7 +139,8 @@ func (r *ReaderImpl) readCommands(ctx context.Context) {
 
 func (r *ReaderImpl) readCommand(ctx context.Context) {
 	// Read message
-	msg, err := r.reader.ReadMessage(ctx)
+	msg := &kafka.Message{}
+	err := r.reader.FetchMessage(ctx, msg)
 	if ctx.Err() != nil {
 		return
 	}
@@ -130,6 +148,7 @@ func (r *ReaderImpl) readCommand(ctx context.Context) {
 		r.sendError(err, "read_message")
 		return
 	}
+	r.committer.Track(msg)
 
 	// Parse the command message
 	cmdEnv, err := r.parseCommandEnvelope(ctx, msg)
@@ -138,6 +157,9 @@ func (r *ReaderImpl) readCommand(ctx context.Context) {
 			"error":    err.Error(),
 			"raw_data": msg.Value,
 		})
+		if r.deadLetter(ctx, msg, err) {
+			r.committer.Done(msg)
+		}
 		r.sendError(err, "parse_error")
 		return
 	}
@@ -174,22 +196,42 @@ func (r *ReaderImpl) Read(ctx context.Context) (*CmdEnvelope, error) {
 	}
 }
 
+// Commit marks the command as handled; its offset is committed with the next batch
+// once every earlier message from the same partition has been handled too.
+func (r *ReaderImpl) Commit(cmd *CmdEnvelope) {
+	r.committer.Done(cmd.Raw)
+}
+
 func (r *ReaderImpl) parseCommandEnvelope(ctx context.Context, msg *kafka.Message) (*CmdEnvelope, error) {
 	cmdMsg, err := parseCommandMessage(msg.Value)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx = r.reader.TraceConfig.Propagator.Extract(ctx, otelkafkakonsumer.NewMessageCarrier(msg))
+	ctx = r.reader.Propagator().Extract(ctx, otelkafkakonsumer.NewMessageCarrier(msg))
 
 	return &CmdEnvelope{
 		Ctx:       ctx,
 		Msg:       cmdMsg,
-		Topic:     r.reader.R.Config().Topic,
+		Topic:     r.reader.Topic(),
 		Partition: msg.Partition,
+		Raw:       msg,
 	}, nil
 }
 
+// deadLetter moves a message that cannot be parsed to the DLQ, since no retry can
+// fix it, and reports whether it got there.
+func (r *ReaderImpl) deadLetter(ctx context.Context, msg *kafka.Message, cause error) bool {
+	if err := r.dlqWriter.Write(ctx, msg, cause, 1); err != nil {
+		r.log(logger.Error, "dlq_error", "Failed to dead-letter unparsable message, leaving its offset uncommitted", map[string]any{
+			"offset": msg.Offset,
+			"error":  err.Error(),
+		})
+		return false
+	}
+	return true
+}
+
 var _ Reader = (*ReaderImpl)(nil)
 
 func parseCommandMessage(data []byte) (*CmdMessage, error) {
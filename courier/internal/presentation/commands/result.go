@@ -7,6 +7,7 @@ import (
 const (
 	CourierAssignmentFailedName ResMessageName = "courier.courier_assignment_failed"
 	CourierAssignedName         ResMessageName = "courier.courier_assigned"
+	CourierReleasedName         ResMessageName = "courier.courier_released"
 )
 
 type (
@@ -29,6 +30,10 @@ type CourierAssigned struct {
 	CourierID uuid.UUID
 }
 
+type CourierReleased struct {
+	OrderID uuid.UUID
+}
+
 func newResMessage(name ResMessageName, payload ResMessagePayload) *ResMessage {
 	return &ResMessage{
 		ID:      uuid.New(),
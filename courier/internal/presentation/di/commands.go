@@ -3,6 +3,7 @@ package di
 import (
 	"context"
 	"courier/internal/infrastructure/logger"
+	"courier/internal/infrastructure/messaging/dlq"
 	"courier/internal/presentation/commands"
 	"errors"
 	"fmt"
@@ -18,6 +19,13 @@ var CommandConsumerModule = fx.Options(
 			fx.As(new(commands.Handler)),
 		),
 
+		// Dead-letter writers
+		fx.Annotate(
+			dlq.NewWriter,
+			fx.ParamTags(`name:"courierCmdDLQWriter"`),
+			fx.As(new(dlq.Writer)),
+		),
+
 		// Readers
 		fx.Annotate(
 			commands.NewReader,
@@ -25,6 +25,10 @@ var GRPCModule = fx.Options(
 			handler.NewCourierAuthServiceHandler,
 			fx.As(new(courierv1.CourierAuthServiceServer)),
 		),
+		fx.Annotate(
+			handler.NewCourierServiceHandler,
+			fx.As(new(courierv1.CourierServiceServer)),
+		),
 
 		// GRPC server
 		newGRPCServer,
@@ -34,7 +38,11 @@ var GRPCModule = fx.Options(
 	fx.Invoke(setupGRPCLifecycle),
 )
 
-func newGRPCServer(courierAuthHandler courierv1.CourierAuthServiceServer, logger logger.Logger) *grpc.Server {
+func newGRPCServer(
+	courierAuthHandler courierv1.CourierAuthServiceServer,
+	courierHandler courierv1.CourierServiceServer,
+	logger logger.Logger,
+) *grpc.Server {
 	server := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler(
 			otelgrpc.WithMessageEvents(otelgrpc.SentEvents, otelgrpc.ReceivedEvents),
@@ -45,6 +53,7 @@ func newGRPCServer(courierAuthHandler courierv1.CourierAuthServiceServer, logger
 	)
 
 	courierv1.RegisterCourierAuthServiceServer(server, courierAuthHandler)
+	courierv1.RegisterCourierServiceServer(server, courierHandler)
 	reflection.Register(server)
 	return server
 }
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"context"
+	courierApplication "courier/internal/application/courier"
+	courierv1 "courier/internal/presentation/grpc"
+	"courier/internal/presentation/grpc/request"
+	"courier/internal/presentation/grpc/response"
+)
+
+type CourierServiceHandler struct {
+	courierv1.UnimplementedCourierServiceServer
+
+	usecase courierApplication.UseCase
+}
+
+func NewCourierServiceHandler(usecase courierApplication.UseCase) *CourierServiceHandler {
+	return &CourierServiceHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *CourierServiceHandler) GetCourier(
+	ctx context.Context,
+	req *courierv1.GetCourierRequest,
+) (*courierv1.GetCourierResponse, error) {
+	courierID, err := request.ParseUUID(req.GetCourierId())
+	if err != nil {
+		return nil, err
+	}
+
+	courier, err := h.usecase.GetByID(ctx, courierID)
+	if err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToGetCourierResponse(courier), nil
+}
+
+var _ courierv1.CourierServiceServer = (*CourierServiceHandler)(nil)
@@ -0,0 +1,15 @@
+package request
+
+import (
+	"courier/internal/presentation/grpc/response"
+
+	"github.com/google/uuid"
+)
+
+func ParseUUID(key string) (uuid.UUID, error) {
+	if id, err := uuid.Parse(key); err != nil {
+		return uuid.Nil, response.ErrInvalidID
+	} else {
+		return id, nil
+	}
+}
@@ -0,0 +1,15 @@
+package response
+
+import (
+	courierDomain "courier/internal/domain/courier"
+	courierv1 "courier/internal/presentation/grpc"
+)
+
+func ToGetCourierResponse(courier *courierDomain.Courier) *courierv1.GetCourierResponse {
+	return &courierv1.GetCourierResponse{
+		Courier: &courierv1.Courier{
+			CourierId: courier.ID.String(),
+			Name:      courier.Name,
+		},
+	}
+}
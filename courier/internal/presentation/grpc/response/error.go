@@ -41,4 +41,7 @@ func ParseError(err error) error {
 	return ErrInternalError
 }
 
-var ErrInternalError = status.Error(codes.Internal, "internal error")
+var (
+	ErrInvalidID     = status.Error(codes.InvalidArgument, "invalid id")
+	ErrInternalError = status.Error(codes.Internal, "internal error")
+)
@@ -0,0 +1,127 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	assignmentDomain "courier/internal/domain/assignment"
+	"courier/internal/infrastructure/db/migrations"
+	assignmentRepository "courier/internal/infrastructure/repository/assignment"
+	"courier/internal/tests/testutils"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type AssignmentRepositoryTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	testDB *testutils.TestDB
+}
+
+func (s *AssignmentRepositoryTestSuite) SetupSuite() {
+	config, err := migrations.NewConfig()
+	require.NoError(s.T(), err)
+
+	s.ctx = context.Background()
+
+	s.testDB, err = testutils.NewTestDB(s.ctx, config)
+	require.NoError(s.T(), err)
+}
+
+func (s *AssignmentRepositoryTestSuite) TearDownSuite() {
+	if s.testDB != nil {
+		err := s.testDB.Close(s.ctx)
+		require.NoError(s.T(), err)
+	}
+}
+
+func (s *AssignmentRepositoryTestSuite) getRepo() assignmentDomain.Repository {
+	return assignmentRepository.New(s.testDB.DB)
+}
+
+func (s *AssignmentRepositoryTestSuite) TestCreate() {
+	tests := []struct {
+		name          string
+		setup         func(repo assignmentDomain.Repository) *assignmentDomain.Assignment
+		expectedError error
+	}{
+		{
+			name: "Success",
+			setup: func(_ assignmentDomain.Repository) *assignmentDomain.Assignment {
+				return assignmentDomain.Create(uuid.New(), uuid.New())
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failure: Order already assigned",
+			setup: func(repo assignmentDomain.Repository) *assignmentDomain.Assignment {
+				assignment := assignmentDomain.Create(uuid.New(), uuid.New())
+				err := repo.Create(s.ctx, assignment)
+				require.NoError(s.T(), err)
+				return assignmentDomain.Create(assignment.OrderID, uuid.New())
+			},
+			expectedError: assignmentRepository.ErrAssignmentAlreadyExists,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			assignment := test.setup(repo)
+
+			err := repo.Create(s.ctx, assignment)
+
+			if test.expectedError != nil {
+				require.Error(s.T(), err)
+				require.Equal(s.T(), test.expectedError, err)
+			} else {
+				require.NoError(s.T(), err)
+
+				created, err := repo.GetByOrderID(s.ctx, assignment.OrderID)
+				require.NoError(s.T(), err)
+				require.Equal(s.T(), assignment.CourierID, created.CourierID)
+			}
+		})
+	}
+}
+
+func (s *AssignmentRepositoryTestSuite) TestDeleteByOrderID() {
+	repo := s.getRepo()
+
+	assignment := assignmentDomain.Create(uuid.New(), uuid.New())
+	require.NoError(s.T(), repo.Create(s.ctx, assignment))
+
+	err := repo.DeleteByOrderID(s.ctx, assignment.OrderID)
+	require.NoError(s.T(), err)
+
+	_, err = repo.GetByOrderID(s.ctx, assignment.OrderID)
+	require.Equal(s.T(), assignmentRepository.ErrAssignmentNotFound, err)
+
+	err = repo.DeleteByOrderID(s.ctx, uuid.New())
+	require.NoError(s.T(), err)
+}
+
+func (s *AssignmentRepositoryTestSuite) TestSave() {
+	repo := s.getRepo()
+
+	assignment := assignmentDomain.Create(uuid.New(), uuid.New())
+	require.NoError(s.T(), repo.Save(s.ctx, assignment))
+
+	saved, err := repo.GetByOrderID(s.ctx, assignment.OrderID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), assignment.CourierID, saved.CourierID)
+
+	reassigned := assignmentDomain.Create(assignment.OrderID, uuid.New())
+	require.NoError(s.T(), repo.Save(s.ctx, reassigned))
+
+	saved, err = repo.GetByOrderID(s.ctx, assignment.OrderID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), reassigned.CourierID, saved.CourierID)
+}
+
+func TestAssignmentRepository(t *testing.T) {
+	suite.Run(t, new(AssignmentRepositoryTestSuite))
+}
@@ -161,6 +161,47 @@ func (s *CourierRepositoryTestSuite) TestGetByPhone() {
 	}
 }
 
+func (s *CourierRepositoryTestSuite) TestGetByID() {
+	tests := []struct {
+		name          string
+		setup         func(repo courierDomain.Repository) *courierDomain.Courier
+		expectedError error
+	}{
+		{
+			name: "Success",
+			setup: func(repo courierDomain.Repository) *courierDomain.Courier {
+				return s.createTestCourierInDb(s.createRandomPhone(), repo)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failure: Courier not found",
+			setup: func(repo courierDomain.Repository) *courierDomain.Courier {
+				return s.createTestCourier(s.createRandomPhone())
+			},
+			expectedError: courierRepository.ErrCourierNotFound,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			courier := test.setup(repo)
+
+			foundCourier, err := repo.GetByID(s.ctx, courier.ID)
+
+			if test.expectedError != nil {
+				require.Error(s.T(), err)
+				require.Equal(s.T(), test.expectedError, err)
+			} else {
+				require.NoError(s.T(), err)
+				require.Equal(s.T(), courier.ID, foundCourier.ID)
+				require.Equal(s.T(), courier.Name, foundCourier.Name)
+			}
+		})
+	}
+}
+
 func (s *CourierRepositoryTestSuite) TestGetAll() {
 	tests := []struct {
 		name          string
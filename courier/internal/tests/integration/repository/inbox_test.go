@@ -0,0 +1,168 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	inboxDomain "courier/internal/domain/inbox"
+	"courier/internal/infrastructure/db/migrations"
+	inboxRepository "courier/internal/infrastructure/repository/inbox"
+	"courier/internal/tests/testutils"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type InboxRepositoryTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	testDB *testutils.TestDB
+}
+
+func (s *InboxRepositoryTestSuite) SetupSuite() {
+	config, err := migrations.NewConfig()
+	require.NoError(s.T(), err)
+
+	s.ctx = context.Background()
+
+	s.testDB, err = testutils.NewTestDB(s.ctx, config)
+	require.NoError(s.T(), err)
+}
+
+func (s *InboxRepositoryTestSuite) TearDownSuite() {
+	if s.testDB != nil {
+		err := s.testDB.Close(s.ctx)
+		require.NoError(s.T(), err)
+	}
+}
+
+func (s *InboxRepositoryTestSuite) getRepo() inboxDomain.Repository {
+	return inboxRepository.New(s.testDB.DB)
+}
+
+func (s *InboxRepositoryTestSuite) createTestInboxMessage(retention time.Duration) *inboxDomain.Message {
+	return inboxDomain.Create(uuid.New(), []byte(`{"key": "value"}`), retention)
+}
+
+func (s *InboxRepositoryTestSuite) TestCreate() {
+	tests := []struct {
+		name          string
+		setup         func(repo inboxDomain.Repository) *inboxDomain.Message
+		expectedError error
+	}{
+		{
+			name: "Success",
+			setup: func(_ inboxDomain.Repository) *inboxDomain.Message {
+				return s.createTestInboxMessage(time.Hour)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success: Without response",
+			setup: func(_ inboxDomain.Repository) *inboxDomain.Message {
+				return inboxDomain.Create(uuid.New(), nil, time.Hour)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failure: Message already exists",
+			setup: func(repo inboxDomain.Repository) *inboxDomain.Message {
+				message := s.createTestInboxMessage(time.Hour)
+				err := repo.Create(s.ctx, message)
+				require.NoError(s.T(), err)
+				return message
+			},
+			expectedError: inboxRepository.ErrInboxMessageAlreadyExists,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			message := test.setup(repo)
+
+			err := repo.Create(s.ctx, message)
+
+			if test.expectedError != nil {
+				require.Error(s.T(), err)
+				require.Equal(s.T(), test.expectedError, err)
+			} else {
+				require.NoError(s.T(), err)
+
+				createdMessage, err := repo.GetByID(s.ctx, message.ID)
+				require.NoError(s.T(), err)
+				require.Equal(s.T(), message.ID, createdMessage.ID)
+				require.Equal(s.T(), message.Response, createdMessage.Response)
+			}
+		})
+	}
+}
+
+func (s *InboxRepositoryTestSuite) TestGetByID() {
+	tests := []struct {
+		name          string
+		setup         func(repo inboxDomain.Repository) uuid.UUID
+		expectedError error
+	}{
+		{
+			name: "Success",
+			setup: func(repo inboxDomain.Repository) uuid.UUID {
+				message := s.createTestInboxMessage(time.Hour)
+				err := repo.Create(s.ctx, message)
+				require.NoError(s.T(), err)
+				return message.ID
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failure: Message not found",
+			setup: func(_ inboxDomain.Repository) uuid.UUID {
+				return uuid.New()
+			},
+			expectedError: inboxRepository.ErrInboxMessageNotFound,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			messageID := test.setup(repo)
+
+			message, err := repo.GetByID(s.ctx, messageID)
+
+			if test.expectedError != nil {
+				require.Error(s.T(), err)
+				require.Equal(s.T(), test.expectedError, err)
+			} else {
+				require.NoError(s.T(), err)
+				require.Equal(s.T(), messageID, message.ID)
+			}
+		})
+	}
+}
+
+func (s *InboxRepositoryTestSuite) TestDeleteExpired() {
+	repo := s.getRepo()
+
+	expired := s.createTestInboxMessage(-time.Minute)
+	require.NoError(s.T(), repo.Create(s.ctx, expired))
+
+	retained := s.createTestInboxMessage(time.Hour)
+	require.NoError(s.T(), repo.Create(s.ctx, retained))
+
+	err := repo.DeleteExpired(s.ctx, time.Now())
+	require.NoError(s.T(), err)
+
+	_, err = repo.GetByID(s.ctx, expired.ID)
+	require.Equal(s.T(), inboxRepository.ErrInboxMessageNotFound, err)
+
+	_, err = repo.GetByID(s.ctx, retained.ID)
+	require.NoError(s.T(), err)
+}
+
+func TestInboxRepository(t *testing.T) {
+	suite.Run(t, new(InboxRepositoryTestSuite))
+}
@@ -3,10 +3,15 @@ package courier
 import (
 	"context"
 	courierApplication "courier/internal/application/courier"
+	assignmentDomain "courier/internal/domain/assignment"
 	courierDomain "courier/internal/domain/courier"
+	assignmentRepository "courier/internal/infrastructure/repository/assignment"
+	courierRepository "courier/internal/infrastructure/repository/courier"
+	assignmentMock "courier/internal/mocks/assignment"
 	courierMock "courier/internal/mocks/courier"
 	"errors"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"testing"
@@ -39,15 +44,20 @@ func (s *CourierUseCaseTestSuite) TestAssignOrder() {
 	tests := []struct {
 		name        string
 		orderID     uuid.UUID
-		setup       func(repo *courierMock.RepositoryMock) []uuid.UUID
+		setup       func(repo *courierMock.RepositoryMock, assignmentRepo *assignmentMock.RepositoryMock, orderID uuid.UUID) []uuid.UUID
 		expectedErr error
 	}{
 		{
 			name:    "Success: Multiple couriers found",
 			orderID: uuid.New(),
-			setup: func(repo *courierMock.RepositoryMock) []uuid.UUID {
+			setup: func(repo *courierMock.RepositoryMock, assignmentRepo *assignmentMock.RepositoryMock, orderID uuid.UUID) []uuid.UUID {
+				assignmentRepo.On("GetByOrderID", s.ctx, orderID).
+					Return((*assignmentDomain.Assignment)(nil), assignmentRepository.ErrAssignmentNotFound).Once()
 				couriers := s.createTestCouriers(10)
 				repo.On("GetAll", s.ctx).Return(couriers, nil).Once()
+				assignmentRepo.On("Create", s.ctx, mock.MatchedBy(func(assignment *assignmentDomain.Assignment) bool {
+					return assignment.OrderID == orderID
+				})).Return(nil).Once()
 
 				courierIDs := make([]uuid.UUID, 0, len(couriers))
 				for _, courier := range couriers {
@@ -60,17 +70,34 @@ func (s *CourierUseCaseTestSuite) TestAssignOrder() {
 		{
 			name:    "Success: One courier found",
 			orderID: uuid.New(),
-			setup: func(repo *courierMock.RepositoryMock) []uuid.UUID {
+			setup: func(repo *courierMock.RepositoryMock, assignmentRepo *assignmentMock.RepositoryMock, orderID uuid.UUID) []uuid.UUID {
+				assignmentRepo.On("GetByOrderID", s.ctx, orderID).
+					Return((*assignmentDomain.Assignment)(nil), assignmentRepository.ErrAssignmentNotFound).Once()
 				courier := s.createTestCourier()
 				repo.On("GetAll", s.ctx).Return([]*courierDomain.Courier{courier}, nil).Once()
+				assignmentRepo.On("Create", s.ctx, mock.MatchedBy(func(assignment *assignmentDomain.Assignment) bool {
+					return assignment.OrderID == orderID && assignment.CourierID == courier.ID
+				})).Return(nil).Once()
 				return []uuid.UUID{courier.ID}
 			},
 			expectedErr: nil,
 		},
+		{
+			name:    "Success: Order already assigned",
+			orderID: uuid.New(),
+			setup: func(repo *courierMock.RepositoryMock, assignmentRepo *assignmentMock.RepositoryMock, orderID uuid.UUID) []uuid.UUID {
+				assignment := assignmentDomain.Create(orderID, uuid.New())
+				assignmentRepo.On("GetByOrderID", s.ctx, orderID).Return(assignment, nil).Once()
+				return []uuid.UUID{assignment.CourierID}
+			},
+			expectedErr: nil,
+		},
 		{
 			name:    "Failure: Available courier not found",
 			orderID: uuid.New(),
-			setup: func(repo *courierMock.RepositoryMock) []uuid.UUID {
+			setup: func(repo *courierMock.RepositoryMock, assignmentRepo *assignmentMock.RepositoryMock, orderID uuid.UUID) []uuid.UUID {
+				assignmentRepo.On("GetByOrderID", s.ctx, orderID).
+					Return((*assignmentDomain.Assignment)(nil), assignmentRepository.ErrAssignmentNotFound).Once()
 				repo.On("GetAll", s.ctx).Return([]*courierDomain.Courier{}, nil).Once()
 				return []uuid.UUID{}
 			},
@@ -79,13 +106,38 @@ func (s *CourierUseCaseTestSuite) TestAssignOrder() {
 		{
 			name:    "Failure: Courier repository get all error",
 			orderID: uuid.New(),
-			setup: func(repo *courierMock.RepositoryMock) []uuid.UUID {
+			setup: func(repo *courierMock.RepositoryMock, assignmentRepo *assignmentMock.RepositoryMock, orderID uuid.UUID) []uuid.UUID {
+				assignmentRepo.On("GetByOrderID", s.ctx, orderID).
+					Return((*assignmentDomain.Assignment)(nil), assignmentRepository.ErrAssignmentNotFound).Once()
 				repo.On("GetAll", s.ctx).
 					Return([]*courierDomain.Courier{}, errors.New("get all couriers error")).Once()
 				return []uuid.UUID{}
 			},
 			expectedErr: errors.New("get all couriers error"),
 		},
+		{
+			name:    "Failure: Assignment repository get error",
+			orderID: uuid.New(),
+			setup: func(repo *courierMock.RepositoryMock, assignmentRepo *assignmentMock.RepositoryMock, orderID uuid.UUID) []uuid.UUID {
+				assignmentRepo.On("GetByOrderID", s.ctx, orderID).
+					Return((*assignmentDomain.Assignment)(nil), errors.New("get assignment error")).Once()
+				return []uuid.UUID{}
+			},
+			expectedErr: errors.New("get assignment error"),
+		},
+		{
+			name:    "Failure: Assignment repository create error",
+			orderID: uuid.New(),
+			setup: func(repo *courierMock.RepositoryMock, assignmentRepo *assignmentMock.RepositoryMock, orderID uuid.UUID) []uuid.UUID {
+				assignmentRepo.On("GetByOrderID", s.ctx, orderID).
+					Return((*assignmentDomain.Assignment)(nil), assignmentRepository.ErrAssignmentNotFound).Once()
+				repo.On("GetAll", s.ctx).Return(s.createTestCouriers(1), nil).Once()
+				assignmentRepo.On("Create", s.ctx, mock.Anything).
+					Return(errors.New("create assignment error")).Once()
+				return []uuid.UUID{}
+			},
+			expectedErr: errors.New("create assignment error"),
+		},
 	}
 
 	for _, tc := range tests {
@@ -93,8 +145,9 @@ func (s *CourierUseCaseTestSuite) TestAssignOrder() {
 		s.Run(tc.name, func() {
 			s.T().Parallel()
 			repo := new(courierMock.RepositoryMock)
-			uc := courierApplication.NewUseCase(repo)
-			courierIDs := tc.setup(repo)
+			assignmentRepo := new(assignmentMock.RepositoryMock)
+			uc := courierApplication.NewUseCase(repo, assignmentRepo)
+			courierIDs := tc.setup(repo, assignmentRepo, tc.orderID)
 
 			courierID, err := uc.AssignOrder(s.ctx, tc.orderID)
 
@@ -108,6 +161,168 @@ func (s *CourierUseCaseTestSuite) TestAssignOrder() {
 				require.Equal(s.T(), uuid.Nil, courierID)
 			}
 
+			repo.AssertExpectations(s.T())
+			assignmentRepo.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *CourierUseCaseTestSuite) TestReleaseOrder() {
+	tests := []struct {
+		name        string
+		orderID     uuid.UUID
+		setup       func(assignmentRepo *assignmentMock.RepositoryMock, orderID uuid.UUID)
+		expectedErr error
+	}{
+		{
+			name:    "Success",
+			orderID: uuid.New(),
+			setup: func(assignmentRepo *assignmentMock.RepositoryMock, orderID uuid.UUID) {
+				assignmentRepo.On("DeleteByOrderID", s.ctx, orderID).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name:    "Failure: Assignment repository delete error",
+			orderID: uuid.New(),
+			setup: func(assignmentRepo *assignmentMock.RepositoryMock, orderID uuid.UUID) {
+				assignmentRepo.On("DeleteByOrderID", s.ctx, orderID).
+					Return(errors.New("delete assignment error")).Once()
+			},
+			expectedErr: errors.New("delete assignment error"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.T().Parallel()
+			repo := new(courierMock.RepositoryMock)
+			assignmentRepo := new(assignmentMock.RepositoryMock)
+			uc := courierApplication.NewUseCase(repo, assignmentRepo)
+			tc.setup(assignmentRepo, tc.orderID)
+
+			err := uc.ReleaseOrder(s.ctx, tc.orderID)
+
+			if tc.expectedErr == nil {
+				require.NoError(s.T(), err)
+			} else {
+				require.Error(s.T(), err)
+				require.EqualError(s.T(), err, tc.expectedErr.Error())
+			}
+
+			repo.AssertExpectations(s.T())
+			assignmentRepo.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *CourierUseCaseTestSuite) TestReassignOrder() {
+	courier := s.createTestCourier()
+
+	tests := []struct {
+		name        string
+		setup       func(repo *courierMock.RepositoryMock, assignmentRepo *assignmentMock.RepositoryMock, orderID uuid.UUID)
+		expectedErr error
+	}{
+		{
+			name: "Success",
+			setup: func(repo *courierMock.RepositoryMock, assignmentRepo *assignmentMock.RepositoryMock, orderID uuid.UUID) {
+				repo.On("GetByID", s.ctx, courier.ID).Return(courier, nil).Once()
+				assignmentRepo.On("Save", s.ctx, mock.MatchedBy(func(assignment *assignmentDomain.Assignment) bool {
+					return assignment.OrderID == orderID && assignment.CourierID == courier.ID
+				})).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: Courier not found",
+			setup: func(repo *courierMock.RepositoryMock, _ *assignmentMock.RepositoryMock, _ uuid.UUID) {
+				repo.On("GetByID", s.ctx, courier.ID).
+					Return((*courierDomain.Courier)(nil), courierRepository.ErrCourierNotFound).Once()
+			},
+			expectedErr: courierRepository.ErrCourierNotFound,
+		},
+		{
+			name: "Failure: Assignment repository save error",
+			setup: func(repo *courierMock.RepositoryMock, assignmentRepo *assignmentMock.RepositoryMock, _ uuid.UUID) {
+				repo.On("GetByID", s.ctx, courier.ID).Return(courier, nil).Once()
+				assignmentRepo.On("Save", s.ctx, mock.Anything).Return(errors.New("save assignment error")).Once()
+			},
+			expectedErr: errors.New("save assignment error"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.T().Parallel()
+			repo := new(courierMock.RepositoryMock)
+			assignmentRepo := new(assignmentMock.RepositoryMock)
+			uc := courierApplication.NewUseCase(repo, assignmentRepo)
+			orderID := uuid.New()
+			tc.setup(repo, assignmentRepo, orderID)
+
+			err := uc.ReassignOrder(s.ctx, orderID, courier.ID)
+
+			if tc.expectedErr == nil {
+				require.NoError(s.T(), err)
+			} else {
+				require.Error(s.T(), err)
+				require.EqualError(s.T(), err, tc.expectedErr.Error())
+			}
+
+			repo.AssertExpectations(s.T())
+			assignmentRepo.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *CourierUseCaseTestSuite) TestGetByID() {
+	courier := s.createTestCourier()
+
+	tests := []struct {
+		name        string
+		courierID   uuid.UUID
+		setup       func(repo *courierMock.RepositoryMock)
+		expectedErr error
+	}{
+		{
+			name:      "Success",
+			courierID: courier.ID,
+			setup: func(repo *courierMock.RepositoryMock) {
+				repo.On("GetByID", s.ctx, courier.ID).Return(courier, nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name:      "Failure: Courier not found",
+			courierID: courier.ID,
+			setup: func(repo *courierMock.RepositoryMock) {
+				repo.On("GetByID", s.ctx, courier.ID).
+					Return((*courierDomain.Courier)(nil), courierRepository.ErrCourierNotFound).Once()
+			},
+			expectedErr: courierRepository.ErrCourierNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.T().Parallel()
+			repo := new(courierMock.RepositoryMock)
+			uc := courierApplication.NewUseCase(repo, new(assignmentMock.RepositoryMock))
+			tc.setup(repo)
+
+			found, err := uc.GetByID(s.ctx, tc.courierID)
+
+			if tc.expectedErr == nil {
+				require.NoError(s.T(), err)
+				require.Equal(s.T(), courier, found)
+			} else {
+				require.ErrorIs(s.T(), err, tc.expectedErr)
+			}
+
 			repo.AssertExpectations(s.T())
 		})
 	}
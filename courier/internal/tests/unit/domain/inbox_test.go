@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"courier/internal/domain/inbox"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type InboxDomainTestSuite struct {
+	suite.Suite
+}
+
+func (s *InboxDomainTestSuite) TestCreateInbox() {
+	tests := []struct {
+		name      string
+		id        uuid.UUID
+		response  []byte
+		retention time.Duration
+	}{
+		{
+			name:      "Success: With response",
+			id:        uuid.New(),
+			response:  []byte(`{"ID":"00000000-0000-0000-0000-000000000000"}`),
+			retention: time.Hour,
+		},
+		{
+			name:      "Success: Without response",
+			id:        uuid.New(),
+			response:  nil,
+			retention: time.Minute,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.T().Parallel()
+
+			message := inbox.Create(tc.id, tc.response, tc.retention)
+
+			require.NotNil(s.T(), message)
+			require.Equal(s.T(), tc.id, message.ID)
+			require.Equal(s.T(), tc.response, message.Response)
+			require.WithinDuration(s.T(), time.Now(), message.Processed, time.Second)
+			require.Equal(s.T(), tc.retention, message.Expires.Sub(message.Processed))
+		})
+	}
+}
+
+func TestInboxDomainTestSuite(t *testing.T) {
+	suite.Run(t, new(InboxDomainTestSuite))
+}
@@ -0,0 +1,88 @@
+package infrastructure
+
+import (
+	"context"
+	"courier/internal/infrastructure/memory"
+	"testing"
+	"time"
+
+	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type BrokerTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	broker *memory.Broker
+}
+
+func (s *BrokerTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.broker = memory.NewBroker()
+}
+
+func (s *BrokerTestSuite) TestEveryGroupGetsEveryMessage() {
+	for _, value := range []string{"first", "second"} {
+		require.NoError(s.T(), s.broker.Publish(s.ctx, kafka.Message{Topic: "assignments", Value: []byte(value)}))
+	}
+
+	for _, groupID := range []string{"orders", "catalog"} {
+		for offset, value := range []string{"first", "second"} {
+			msg, err := s.broker.Fetch(s.ctx, "assignments", groupID)
+			require.NoError(s.T(), err)
+			require.Equal(s.T(), int64(offset), msg.Offset)
+			require.Equal(s.T(), value, string(msg.Value))
+		}
+	}
+}
+
+func (s *BrokerTestSuite) TestGroupMembersShareMessages() {
+	require.NoError(s.T(), s.broker.Publish(s.ctx, kafka.Message{Topic: "assignments"}))
+
+	_, err := s.broker.Fetch(s.ctx, "assignments", "orders")
+	require.NoError(s.T(), err)
+
+	ctx, cancel := context.WithTimeout(s.ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = s.broker.Fetch(ctx, "assignments", "orders")
+	require.ErrorIs(s.T(), err, context.DeadlineExceeded)
+}
+
+func (s *BrokerTestSuite) TestCommit() {
+	require.NoError(s.T(), s.broker.Publish(s.ctx, kafka.Message{Topic: "assignments"}))
+
+	msg, err := s.broker.Fetch(s.ctx, "assignments", "orders")
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), s.broker.Commit(s.ctx, "orders", msg))
+	require.Equal(s.T(), int64(1), s.broker.Committed("assignments", "orders"))
+	require.Equal(s.T(), int64(0), s.broker.Committed("assignments", "catalog"))
+}
+
+func (s *BrokerTestSuite) TestTransportPropagatesTrace() {
+	transport := memory.NewTransport(s.broker)
+	writer, err := transport.NewWriter("assignments")
+	require.NoError(s.T(), err)
+	reader, err := transport.NewReader("assignments", "orders")
+	require.NoError(s.T(), err)
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	require.NoError(s.T(), writer.WriteMessage(trace.ContextWithSpanContext(s.ctx, spanContext), kafka.Message{}))
+
+	var msg kafka.Message
+	require.NoError(s.T(), reader.FetchMessage(s.ctx, &msg))
+	require.Equal(s.T(), "assignments", msg.Topic)
+
+	ctx := reader.Propagator().Extract(s.ctx, otelkafkakonsumer.NewMessageCarrier(&msg))
+	require.Equal(s.T(), spanContext.TraceID(), trace.SpanContextFromContext(ctx).TraceID())
+}
+
+func TestBroker(t *testing.T) {
+	suite.Run(t, new(BrokerTestSuite))
+}
@@ -0,0 +1,159 @@
+package infrastructure
+
+import (
+	"context"
+	"courier/internal/infrastructure/logger"
+	"courier/internal/infrastructure/messaging/commit"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+var errCommit = errors.New("commit error")
+
+type offsetCommitterMock struct {
+	mu      sync.Mutex
+	err     error
+	commits [][]kafka.Message
+}
+
+func (m *offsetCommitterMock) CommitMessages(_ context.Context, msgs ...kafka.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.err != nil {
+		return m.err
+	}
+	m.commits = append(m.commits, msgs)
+	return nil
+}
+
+// committed returns the offsets of the last commit by partition.
+func (m *offsetCommitterMock) committed() map[int]int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.commits) == 0 {
+		return nil
+	}
+	offsets := make(map[int]int64)
+	for _, msg := range m.commits[len(m.commits)-1] {
+		offsets[msg.Partition] = msg.Offset
+	}
+	return offsets
+}
+
+type CommitterTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *CommitterTestSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func (s *CommitterTestSuite) newCommitter(reader commit.OffsetCommitter) *commit.Committer {
+	return commit.NewCommitter(reader, &commit.Config{Interval: time.Hour}, logger.NewLogger(logrus.New()))
+}
+
+func message(partition int, offset int64) *kafka.Message {
+	return &kafka.Message{Topic: "topic", Partition: partition, Offset: offset}
+}
+
+func (s *CommitterTestSuite) TestFlush() {
+	tests := []struct {
+		name              string
+		tracked           []*kafka.Message
+		handled           []*kafka.Message
+		expectedCommitted map[int]int64
+	}{
+		{
+			name:              "Nothing handled",
+			tracked:           []*kafka.Message{message(0, 0), message(0, 1)},
+			handled:           nil,
+			expectedCommitted: nil,
+		},
+		{
+			name:              "Earlier message still in flight",
+			tracked:           []*kafka.Message{message(0, 0), message(0, 1), message(0, 2)},
+			handled:           []*kafka.Message{message(0, 1), message(0, 2)},
+			expectedCommitted: nil,
+		},
+		{
+			name:              "Handled prefix is committed",
+			tracked:           []*kafka.Message{message(0, 0), message(0, 1), message(0, 2)},
+			handled:           []*kafka.Message{message(0, 1), message(0, 0)},
+			expectedCommitted: map[int]int64{0: 1},
+		},
+		{
+			name:              "Partitions are committed in one batch",
+			tracked:           []*kafka.Message{message(0, 3), message(1, 7), message(0, 4)},
+			handled:           []*kafka.Message{message(1, 7), message(0, 3)},
+			expectedCommitted: map[int]int64{0: 3, 1: 7},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			reader := &offsetCommitterMock{}
+			committer := s.newCommitter(reader)
+			for _, msg := range test.tracked {
+				committer.Track(msg)
+			}
+			for _, msg := range test.handled {
+				committer.Done(msg)
+			}
+
+			require.NoError(s.T(), committer.Flush(s.ctx))
+			require.Equal(s.T(), test.expectedCommitted, reader.committed())
+			require.LessOrEqual(s.T(), len(reader.commits), 1)
+		})
+	}
+}
+
+func (s *CommitterTestSuite) TestFlushCommitsOnlyOnce() {
+	reader := &offsetCommitterMock{}
+	committer := s.newCommitter(reader)
+	committer.Track(message(0, 0))
+	committer.Done(message(0, 0))
+
+	require.NoError(s.T(), committer.Flush(s.ctx))
+	require.NoError(s.T(), committer.Flush(s.ctx))
+	require.Len(s.T(), reader.commits, 1)
+}
+
+func (s *CommitterTestSuite) TestFlushKeepsOffsetsOnError() {
+	reader := &offsetCommitterMock{err: errCommit}
+	committer := s.newCommitter(reader)
+	committer.Track(message(0, 0))
+	committer.Done(message(0, 0))
+
+	require.ErrorIs(s.T(), committer.Flush(s.ctx), errCommit)
+
+	reader.err = nil
+	require.NoError(s.T(), committer.Flush(s.ctx))
+	require.Equal(s.T(), map[int]int64{0: 0}, reader.committed())
+}
+
+func (s *CommitterTestSuite) TestRunFlushesOnShutdown() {
+	reader := &offsetCommitterMock{}
+	committer := s.newCommitter(reader)
+	committer.Track(message(0, 0))
+	committer.Done(message(0, 0))
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	cancel()
+	committer.Run(ctx)
+
+	require.Equal(s.T(), map[int]int64{0: 0}, reader.committed())
+}
+
+func TestCommitterTestSuite(t *testing.T) {
+	suite.Run(t, new(CommitterTestSuite))
+}
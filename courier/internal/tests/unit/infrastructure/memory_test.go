@@ -0,0 +1,170 @@
+package infrastructure
+
+import (
+	"context"
+	assignmentDomain "courier/internal/domain/assignment"
+	courierDomain "courier/internal/domain/courier"
+	inboxDomain "courier/internal/domain/inbox"
+	"courier/internal/infrastructure/memory"
+	assignmentRepository "courier/internal/infrastructure/repository/assignment"
+	courierRepository "courier/internal/infrastructure/repository/courier"
+	inboxRepository "courier/internal/infrastructure/repository/inbox"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type MemoryTestSuite struct {
+	suite.Suite
+	ctx   context.Context
+	store *memory.Store
+}
+
+func (s *MemoryTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.store = memory.NewStore()
+}
+
+func newCourier(phone string) *courierDomain.Courier {
+	return &courierDomain.Courier{ID: uuid.New(), Name: "Courier", Phone: phone, Created: time.Now()}
+}
+
+func (s *MemoryTestSuite) TestCourierCreate() {
+	tests := []struct {
+		name        string
+		courier     func(stored *courierDomain.Courier) *courierDomain.Courier
+		expectedErr error
+	}{
+		{
+			name:        "Success",
+			courier:     func(_ *courierDomain.Courier) *courierDomain.Courier { return newCourier("+10000000002") },
+			expectedErr: nil,
+		},
+		{
+			name:        "Failure: Courier already exists",
+			courier:     func(stored *courierDomain.Courier) *courierDomain.Courier { return stored },
+			expectedErr: courierRepository.ErrCourierAlreadyExists,
+		},
+		{
+			name:        "Failure: Phone already exists",
+			courier:     func(stored *courierDomain.Courier) *courierDomain.Courier { return newCourier(stored.Phone) },
+			expectedErr: courierRepository.ErrCourierPhoneAlreadyExists,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			repository := memory.NewCourierRepository(memory.NewStore())
+			stored := newCourier("+10000000001")
+			require.NoError(s.T(), repository.Create(s.ctx, stored))
+
+			err := repository.Create(s.ctx, tc.courier(stored))
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(s.T(), err, tc.expectedErr)
+			} else {
+				require.NoError(s.T(), err)
+			}
+		})
+	}
+}
+
+func (s *MemoryTestSuite) TestCourierGet() {
+	repository := memory.NewCourierRepository(s.store)
+	courier := newCourier("+10000000001")
+	require.NoError(s.T(), repository.Create(s.ctx, courier))
+
+	got, err := repository.GetByPhone(s.ctx, courier.Phone)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), courier.ID, got.ID)
+
+	_, err = repository.GetByID(s.ctx, uuid.New())
+	require.ErrorIs(s.T(), err, courierRepository.ErrCourierNotFound)
+
+	couriers, err := repository.GetAll(s.ctx)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), couriers, 1)
+}
+
+func (s *MemoryTestSuite) TestAssignment() {
+	repository := memory.NewAssignmentRepository(s.store)
+	assignment := &assignmentDomain.Assignment{OrderID: uuid.New(), CourierID: uuid.New(), Assigned: time.Now()}
+
+	require.NoError(s.T(), repository.Create(s.ctx, assignment))
+	err := repository.Create(s.ctx, assignment)
+	require.ErrorIs(s.T(), err, assignmentRepository.ErrAssignmentAlreadyExists)
+
+	assignment.CourierID = uuid.New()
+	require.NoError(s.T(), repository.Save(s.ctx, assignment))
+	got, err := repository.GetByOrderID(s.ctx, assignment.OrderID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), assignment.CourierID, got.CourierID)
+
+	require.NoError(s.T(), repository.DeleteByOrderID(s.ctx, assignment.OrderID))
+	require.NoError(s.T(), repository.DeleteByOrderID(s.ctx, assignment.OrderID))
+	_, err = repository.GetByOrderID(s.ctx, assignment.OrderID)
+	require.ErrorIs(s.T(), err, assignmentRepository.ErrAssignmentNotFound)
+}
+
+func (s *MemoryTestSuite) TestAssignmentCreatedOnce() {
+	const workers = 16
+
+	repository := memory.NewAssignmentRepository(s.store)
+	orderID := uuid.New()
+
+	// Only one of the couriers racing for an order gets it.
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+		conflicts int
+	)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := repository.Create(s.ctx, &assignmentDomain.Assignment{OrderID: orderID, CourierID: uuid.New(), Assigned: time.Now()})
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				succeeded++
+			case errors.Is(err, assignmentRepository.ErrAssignmentAlreadyExists):
+				conflicts++
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(s.T(), 1, succeeded)
+	require.Equal(s.T(), workers-1, conflicts)
+}
+
+func (s *MemoryTestSuite) TestInboxDeleteExpired() {
+	repository := memory.NewInboxRepository(s.store)
+	now := time.Now()
+	expired := &inboxDomain.Message{ID: uuid.New(), Processed: now.Add(-time.Hour), Expires: now}
+	kept := &inboxDomain.Message{ID: uuid.New(), Processed: now, Expires: now.Add(time.Hour)}
+	require.NoError(s.T(), repository.Create(s.ctx, expired))
+	require.NoError(s.T(), repository.Create(s.ctx, kept))
+
+	err := repository.Create(s.ctx, kept)
+	require.ErrorIs(s.T(), err, inboxRepository.ErrInboxMessageAlreadyExists)
+
+	require.NoError(s.T(), repository.DeleteExpired(s.ctx, now))
+
+	_, err = repository.GetByID(s.ctx, expired.ID)
+	require.ErrorIs(s.T(), err, inboxRepository.ErrInboxMessageNotFound)
+	_, err = repository.GetByID(s.ctx, kept.ID)
+	require.NoError(s.T(), err)
+}
+
+func TestMemory(t *testing.T) {
+	suite.Run(t, new(MemoryTestSuite))
+}
@@ -0,0 +1,94 @@
+// Package app assembles the customer service, for cmd/main.go and for
+// binaries that boot it in one process with the other services.
+package app
+
+import (
+	"context"
+	customerApplication "customer/internal/application/customer"
+	appDI "customer/internal/application/di"
+	"customer/internal/infrastructure/auth"
+	infraDI "customer/internal/infrastructure/di"
+	"customer/internal/infrastructure/logger"
+	"customer/internal/infrastructure/memory"
+	"customer/internal/infrastructure/telemetry"
+	presentationDI "customer/internal/presentation/di"
+	customerv1 "customer/internal/presentation/grpc"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+)
+
+// Module is the whole service. Its configuration is read from the
+// environment while the fx.App is being created, unless WithConfig sets it.
+var Module = fx.Options(
+	// Infrastructure modules
+	infraDI.LoggerModule,
+	infraDI.DatabaseModule,
+	infraDI.RepositoryModule,
+	infraDI.TokenManagerModule,
+	infraDI.MailSenderModule,
+	infraDI.OtpStoreModule,
+	infraDI.AuthPoliciesModule,
+	infraDI.TelemetryModule,
+
+	// Application modules
+	appDI.UseCaseModule,
+
+	// Presentation modules
+	presentationDI.GRPCModule,
+	presentationDI.TelemetryModule,
+)
+
+// Mail is a message the service kept instead of sending it.
+type Mail = memory.Mail
+
+// Mailbox reads the mails the service kept, oldest first.
+type Mailbox interface {
+	Mails() []Mail
+}
+
+// WithMailbox sets *mailbox to the mails of the service. It stays nil unless
+// IN_MEMORY is set, as mail is sent over SMTP otherwise.
+func WithMailbox(mailbox *Mailbox) fx.Option {
+	return fx.Invoke(func(sender customerApplication.MailSender) {
+		*mailbox, _ = sender.(Mailbox)
+	})
+}
+
+// Registration is a customer to register.
+type Registration = customerApplication.RegisterDto
+
+// Registrar registers customers in the service directly. The gRPC register
+// request carries no email, which every customer needs.
+type Registrar interface {
+	Register(ctx context.Context, data Registration) (uuid.UUID, error)
+}
+
+// WithRegistrar sets *registrar to the registration of the service.
+func WithRegistrar(registrar *Registrar) fx.Option {
+	return fx.Invoke(func(auth customerApplication.AuthUseCase) {
+		*registrar = auth
+	})
+}
+
+// Config is the configuration of the service running with IN_MEMORY set, for
+// binaries that set it up themselves rather than through the environment.
+type Config struct {
+	Logger    logger.Config
+	Telemetry telemetry.Config
+	Memory    memory.Config
+	Auth      auth.Config
+	GRPC      customerv1.Config
+}
+
+// WithConfig has the service use cfg instead of reading its configuration
+// from the environment.
+func WithConfig(cfg Config) fx.Option {
+	return fx.Replace(
+		&cfg.Logger,
+		&cfg.Telemetry,
+		&cfg.Memory,
+		&cfg.Auth,
+		&cfg.GRPC,
+	)
+}
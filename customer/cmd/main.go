@@ -2,10 +2,8 @@ package main
 
 import (
 	"context"
-	appDI "customer/internal/application/di"
-	infraDI "customer/internal/infrastructure/di"
+	customerApp "customer/app"
 	"customer/internal/infrastructure/logger"
-	presentationDI "customer/internal/presentation/di"
 	"log"
 	"os"
 	"os/signal"
@@ -17,22 +15,7 @@ import (
 
 func main() {
 	app := fx.New(
-		// Infrastructure modules
-		infraDI.LoggerModule,
-		infraDI.DatabaseModule,
-		infraDI.RepositoryModule,
-		infraDI.TokenManagerModule,
-		infraDI.MailSenderModule,
-		infraDI.OtpStoreModule,
-		infraDI.AuthPoliciesModule,
-		infraDI.TelemetryModule,
-
-		// Application modules
-		appDI.UseCaseModule,
-
-		// Presentation modules
-		presentationDI.GRPCModule,
-		presentationDI.TelemetryModule,
+		customerApp.Module,
 
 		// Add logging for application startup and shutdown
 		fx.Invoke(func(lc fx.Lifecycle, logger logger.Logger) {
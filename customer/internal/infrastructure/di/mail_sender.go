@@ -2,20 +2,32 @@ package di
 
 import (
 	customerAplication "customer/internal/application/customer"
+	"customer/internal/infrastructure/logger"
 	mailSender "customer/internal/infrastructure/mail_sender"
+	"customer/internal/infrastructure/memory"
+
 	"go.uber.org/fx"
 )
 
 var MailSenderModule = fx.Provide(
-	// Config
-	mailSender.NewConfig,
+	// Mail sender
+	newMailSender,
+)
 
-	// Client
-	mailSender.NewClient,
+// newMailSender sends mail over SMTP unless IN_MEMORY is set, in which case
+// the messages are only logged.
+func newMailSender(memCfg *memory.Config, logger logger.Logger) (customerAplication.MailSender, error) {
+	if memCfg.Enabled {
+		return memory.NewMailSender(logger), nil
+	}
 
-	// Otp store
-	fx.Annotate(
-		mailSender.New,
-		fx.As(new(customerAplication.MailSender)),
-	),
-)
+	cfg, err := mailSender.NewConfig()
+	if err != nil {
+		return nil, err
+	}
+	client, err := mailSender.NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return mailSender.New(cfg, client), nil
+}
@@ -2,20 +2,26 @@ package di
 
 import (
 	customerAplication "customer/internal/application/customer"
+	"customer/internal/infrastructure/memory"
 	otpStore "customer/internal/infrastructure/otp_store"
+
 	"go.uber.org/fx"
 )
 
 var OtpStoreModule = fx.Provide(
-	// Config
-	otpStore.NewConfig,
-
-	// Client
-	otpStore.NewClient,
-
 	// Otp store
-	fx.Annotate(
-		otpStore.New,
-		fx.As(new(customerAplication.OtpStore)),
-	),
+	newOtpStore,
 )
+
+// newOtpStore keeps the codes in Redis unless IN_MEMORY is set.
+func newOtpStore(memCfg *memory.Config) (customerAplication.OtpStore, error) {
+	if memCfg.Enabled {
+		return memory.NewOtpStore(), nil
+	}
+
+	cfg, err := otpStore.NewConfig()
+	if err != nil {
+		return nil, err
+	}
+	return otpStore.New(cfg, otpStore.NewClient(cfg)), nil
+}
@@ -2,15 +2,21 @@ package di
 
 import (
 	customerDomain "customer/internal/domain/customer"
+	"customer/internal/infrastructure/memory"
 	customerRepository "customer/internal/infrastructure/repository/customer"
 
 	"go.uber.org/fx"
+	"gorm.io/gorm"
 )
 
 var RepositoryModule = fx.Provide(
 	// Customer repository
-	fx.Annotate(
-		customerRepository.New,
-		fx.As(new(customerDomain.Repository)),
-	),
+	newCustomerRepository,
 )
+
+func newCustomerRepository(memCfg *memory.Config, db *gorm.DB) customerDomain.Repository {
+	if memCfg.Enabled {
+		return memory.NewCustomerRepository()
+	}
+	return customerRepository.New(db)
+}
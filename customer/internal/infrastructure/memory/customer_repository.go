@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"context"
+	customerDomain "customer/internal/domain/customer"
+	customerRepository "customer/internal/infrastructure/repository/customer"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// CustomerRepository keeps the customers in the process. Phones and emails
+// are unique, as the constraints of the customers table make them.
+type CustomerRepository struct {
+	mu        sync.Mutex
+	customers map[uuid.UUID]*customerDomain.Customer
+}
+
+func NewCustomerRepository() *CustomerRepository {
+	return &CustomerRepository{customers: make(map[uuid.UUID]*customerDomain.Customer)}
+}
+
+func (r *CustomerRepository) Create(ctx context.Context, customer *customerDomain.Customer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.customers[customer.ID]; ok {
+		return customerRepository.ErrCustomerAlreadyExists
+	}
+	return r.put(customer)
+}
+
+// Save inserts the customer or replaces the stored one with the same ID.
+func (r *CustomerRepository) Save(ctx context.Context, customer *customerDomain.Customer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.put(customer)
+}
+
+func (r *CustomerRepository) GetByID(ctx context.Context, id uuid.UUID) (*customerDomain.Customer, error) {
+	return r.find(func(c *customerDomain.Customer) bool { return c.ID == id })
+}
+
+func (r *CustomerRepository) GetByPhone(ctx context.Context, phone string) (*customerDomain.Customer, error) {
+	return r.find(func(c *customerDomain.Customer) bool { return c.Phone == phone })
+}
+
+func (r *CustomerRepository) GetByEmail(ctx context.Context, email string) (*customerDomain.Customer, error) {
+	return r.find(func(c *customerDomain.Customer) bool { return c.Email == email })
+}
+
+// put stores a copy of the customer unless another customer has its phone or
+// email. The caller holds r.mu.
+func (r *CustomerRepository) put(customer *customerDomain.Customer) error {
+	for _, stored := range r.customers {
+		if stored.ID == customer.ID {
+			continue
+		}
+		if stored.Phone == customer.Phone {
+			return customerRepository.ErrCustomerPhoneAlreadyExists
+		}
+		if stored.Email == customer.Email {
+			return customerRepository.ErrCustomerEmailAlreadyExists
+		}
+	}
+	r.customers[customer.ID] = cloneCustomer(customer)
+	return nil
+}
+
+func (r *CustomerRepository) find(match func(*customerDomain.Customer) bool) (*customerDomain.Customer, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, customer := range r.customers {
+		if match(customer) {
+			return cloneCustomer(customer), nil
+		}
+	}
+	return nil, customerRepository.ErrCustomerNotFound
+}
+
+func cloneCustomer(customer *customerDomain.Customer) *customerDomain.Customer {
+	c := *customer
+	c.Password = slices.Clone(customer.Password)
+	if customer.LockedUntil != nil {
+		lockedUntil := *customer.LockedUntil
+		c.LockedUntil = &lockedUntil
+	}
+	return &c
+}
+
+var _ customerDomain.Repository = (*CustomerRepository)(nil)
@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+	customerAplication "customer/internal/application/customer"
+	"customer/internal/infrastructure/logger"
+	"sync"
+)
+
+// Mail is a message the MailSender would have sent. Secret is the one-time
+// code or the password reset token it carries.
+type Mail struct {
+	To      string
+	Subject string
+	Secret  string
+}
+
+// MailSender logs the messages instead of sending them and keeps them, so a
+// local run or a test can read the codes a customer would have received.
+type MailSender struct {
+	mu     sync.Mutex
+	mails  []Mail
+	logger logger.Logger
+}
+
+func NewMailSender(logger logger.Logger) *MailSender {
+	return &MailSender{logger: logger}
+}
+
+func (m *MailSender) SendOtp(ctx context.Context, toEmail string, code string) error {
+	m.send(Mail{To: toEmail, Subject: "Your OTP Code", Secret: code})
+	return nil
+}
+
+func (m *MailSender) SendPasswordResetLink(ctx context.Context, toEmail string, token string) error {
+	m.send(Mail{To: toEmail, Subject: "Password Reset Request", Secret: token})
+	return nil
+}
+
+// Mails returns the messages sent so far, oldest first.
+func (m *MailSender) Mails() []Mail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mails := make([]Mail, len(m.mails))
+	copy(mails, m.mails)
+	return mails
+}
+
+func (m *MailSender) send(mail Mail) {
+	m.mu.Lock()
+	m.mails = append(m.mails, mail)
+	m.mu.Unlock()
+
+	m.logger.Info("Mail not sent, kept in memory", map[string]any{
+		"component": "mail_sender",
+		"to":        mail.To,
+		"subject":   mail.Subject,
+		"secret":    mail.Secret,
+	})
+}
+
+var _ customerAplication.MailSender = (*MailSender)(nil)
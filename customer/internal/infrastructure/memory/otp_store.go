@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"context"
+	customerAplication "customer/internal/application/customer"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type otp struct {
+	code         string
+	consumerID   uuid.UUID
+	attemptsLeft int
+	expires      time.Time
+}
+
+// OtpStore keeps the one-time codes in the process. It answers as the Redis
+// store does: an unknown or expired challenge is reported as expired, and a
+// wrong code uses up one attempt.
+type OtpStore struct {
+	mu   sync.Mutex
+	otps map[string]otp
+	now  func() time.Time
+}
+
+func NewOtpStore() *OtpStore {
+	return &OtpStore{otps: make(map[string]otp), now: time.Now}
+}
+
+func (s *OtpStore) Issue(
+	ctx context.Context,
+	challengeID string,
+	consumerID uuid.UUID,
+	code string,
+	policy customerAplication.OtpPolicy,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.otps[challengeID] = otp{
+		code:         code,
+		consumerID:   consumerID,
+		attemptsLeft: policy.MaxAttempts,
+		expires:      s.now().Add(policy.TTL),
+	}
+	return nil
+}
+
+func (s *OtpStore) VerifyAndConsume(
+	ctx context.Context,
+	challengeID string,
+	code string,
+) (ok bool, attemptsLeft int, expired bool, consumerID uuid.UUID, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, found := s.otps[challengeID]
+	if found && !s.now().Before(stored.expires) {
+		delete(s.otps, challengeID)
+		found = false
+	}
+	if !found {
+		return false, 0, true, uuid.Nil, nil
+	}
+
+	if stored.attemptsLeft <= 0 {
+		return false, 0, false, uuid.Nil, nil
+	}
+
+	if stored.code == code {
+		delete(s.otps, challengeID)
+		return true, stored.attemptsLeft, false, stored.consumerID, nil
+	}
+
+	stored.attemptsLeft--
+	s.otps[challengeID] = stored
+	return false, stored.attemptsLeft, false, uuid.Nil, nil
+}
+
+func (s *OtpStore) Invalidate(ctx context.Context, challengeID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.otps, challengeID)
+	return nil
+}
+
+var _ customerAplication.OtpStore = (*OtpStore)(nil)
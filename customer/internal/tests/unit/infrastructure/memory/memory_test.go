@@ -0,0 +1,199 @@
+package memory
+
+import (
+	"context"
+	customerApplication "customer/internal/application/customer"
+	customerDomain "customer/internal/domain/customer"
+	"customer/internal/infrastructure/logger"
+	"customer/internal/infrastructure/memory"
+	customerRepository "customer/internal/infrastructure/repository/customer"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type MemoryTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *MemoryTestSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func newCustomer(phone, email string) *customerDomain.Customer {
+	return &customerDomain.Customer{ID: uuid.New(), Name: "Customer", Phone: phone, Email: email, Created: time.Now()}
+}
+
+func (s *MemoryTestSuite) TestCustomerCreate() {
+	tests := []struct {
+		name        string
+		customer    func(stored *customerDomain.Customer) *customerDomain.Customer
+		expectedErr error
+	}{
+		{
+			name: "Success",
+			customer: func(_ *customerDomain.Customer) *customerDomain.Customer {
+				return newCustomer("+10000000002", "other@example.com")
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "Failure: Customer already exists",
+			customer:    func(stored *customerDomain.Customer) *customerDomain.Customer { return stored },
+			expectedErr: customerRepository.ErrCustomerAlreadyExists,
+		},
+		{
+			name: "Failure: Phone already exists",
+			customer: func(stored *customerDomain.Customer) *customerDomain.Customer {
+				return newCustomer(stored.Phone, "other@example.com")
+			},
+			expectedErr: customerRepository.ErrCustomerPhoneAlreadyExists,
+		},
+		{
+			name: "Failure: Email already exists",
+			customer: func(stored *customerDomain.Customer) *customerDomain.Customer {
+				return newCustomer("+10000000002", stored.Email)
+			},
+			expectedErr: customerRepository.ErrCustomerEmailAlreadyExists,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			repository := memory.NewCustomerRepository()
+			stored := newCustomer("+10000000001", "customer@example.com")
+			require.NoError(s.T(), repository.Create(s.ctx, stored))
+
+			err := repository.Create(s.ctx, tc.customer(stored))
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(s.T(), err, tc.expectedErr)
+			} else {
+				require.NoError(s.T(), err)
+			}
+		})
+	}
+}
+
+func (s *MemoryTestSuite) TestCustomerSaveAndGet() {
+	repository := memory.NewCustomerRepository()
+	customer := newCustomer("+10000000001", "customer@example.com")
+	require.NoError(s.T(), repository.Create(s.ctx, customer))
+
+	customer.FailedCount = 2
+	require.NoError(s.T(), repository.Save(s.ctx, customer))
+	customer.FailedCount = 3
+
+	got, err := repository.GetByEmail(s.ctx, customer.Email)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 2, got.FailedCount)
+
+	got, err = repository.GetByPhone(s.ctx, customer.Phone)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), customer.ID, got.ID)
+
+	_, err = repository.GetByID(s.ctx, uuid.New())
+	require.ErrorIs(s.T(), err, customerRepository.ErrCustomerNotFound)
+}
+
+func (s *MemoryTestSuite) TestOtpVerifyAndConsume() {
+	policy := customerApplication.OtpPolicy{TTL: time.Minute, MaxAttempts: 2}
+	consumerID := uuid.New()
+
+	tests := []struct {
+		name             string
+		policy           customerApplication.OtpPolicy
+		codes            []string
+		expectedOK       bool
+		expectedAttempts int
+		expectedExpired  bool
+	}{
+		{name: "Success: Correct code", policy: policy, codes: []string{"1234"}, expectedOK: true, expectedAttempts: 2},
+		{name: "Failure: Wrong code", policy: policy, codes: []string{"0000"}, expectedAttempts: 1},
+		{name: "Failure: No attempts left", policy: policy, codes: []string{"0000", "0000", "1234"}, expectedAttempts: 0},
+		{name: "Failure: Consumed", policy: policy, codes: []string{"1234", "1234"}, expectedExpired: true},
+		{
+			name:            "Failure: Expired",
+			policy:          customerApplication.OtpPolicy{TTL: 0, MaxAttempts: 2},
+			codes:           []string{"1234"},
+			expectedExpired: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			store := memory.NewOtpStore()
+			require.NoError(s.T(), store.Issue(s.ctx, "challenge", consumerID, "1234", tc.policy))
+
+			var (
+				ok       bool
+				attempts int
+				expired  bool
+				gotID    uuid.UUID
+				err      error
+			)
+			for _, code := range tc.codes {
+				ok, attempts, expired, gotID, err = store.VerifyAndConsume(s.ctx, "challenge", code)
+				require.NoError(s.T(), err)
+			}
+
+			require.Equal(s.T(), tc.expectedOK, ok)
+			require.Equal(s.T(), tc.expectedAttempts, attempts)
+			require.Equal(s.T(), tc.expectedExpired, expired)
+			if tc.expectedOK {
+				require.Equal(s.T(), consumerID, gotID)
+			}
+		})
+	}
+}
+
+func (s *MemoryTestSuite) TestOtpConsumedOnce() {
+	const workers = 16
+
+	store := memory.NewOtpStore()
+	policy := customerApplication.OtpPolicy{TTL: time.Minute, MaxAttempts: 3}
+	require.NoError(s.T(), store.Issue(s.ctx, "challenge", uuid.New(), "1234", policy))
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+	)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ok, _, _, _, err := store.VerifyAndConsume(s.ctx, "challenge", "1234")
+			if err == nil && ok {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(s.T(), 1, succeeded)
+}
+
+func (s *MemoryTestSuite) TestMailSender() {
+	sender := memory.NewMailSender(logger.NewLogger(logrus.New()))
+
+	require.NoError(s.T(), sender.SendOtp(s.ctx, "customer@example.com", "1234"))
+	require.NoError(s.T(), sender.SendPasswordResetLink(s.ctx, "customer@example.com", "token"))
+
+	mails := sender.Mails()
+	require.Len(s.T(), mails, 2)
+	require.Equal(s.T(), "1234", mails[0].Secret)
+	require.Equal(s.T(), "token", mails[1].Secret)
+}
+
+func TestMemory(t *testing.T) {
+	suite.Run(t, new(MemoryTestSuite))
+}
@@ -0,0 +1,110 @@
+// Package app assembles the order service, for cmd/main.go and for binaries
+// that boot it in one process with the other services.
+package app
+
+import (
+	appDI "order/internal/application/di"
+	cancelOrder "order/internal/application/order/saga/cancel_order"
+	createOrder "order/internal/application/order/saga/create_order"
+	slotUsecase "order/internal/application/slot/usecase"
+	"order/internal/infrastructure/catalog"
+	infraDI "order/internal/infrastructure/di"
+	"order/internal/infrastructure/inbox"
+	"order/internal/infrastructure/logger"
+	"order/internal/infrastructure/memory"
+	"order/internal/infrastructure/messaging"
+	"order/internal/infrastructure/messaging/commit"
+	"order/internal/infrastructure/messaging/retry"
+	"order/internal/infrastructure/messaging/worker"
+	"order/internal/infrastructure/telemetry"
+	presentationDI "order/internal/presentation/di"
+	orderv1 "order/internal/presentation/grpc"
+	createOrderWatchdog "order/internal/presentation/saga/create_order"
+
+	"go.uber.org/fx"
+)
+
+// Module is the whole service. Its configuration is read from the
+// environment while the fx.App is being created, unless WithConfig sets it.
+var Module = fx.Options(
+	// Infrastructure modules
+	infraDI.LoggerModule,
+	infraDI.MessagingModule,
+	infraDI.DatabaseModule,
+	infraDI.RepositoryModule,
+	infraDI.PublisherModule,
+	infraDI.UowModule,
+	infraDI.OutboxProcessorModule,
+	infraDI.InboxModule,
+	infraDI.TelemetryModule,
+	infraDI.CatalogModule,
+
+	// Application modules
+	appDI.UseCaseModule,
+	appDI.SagaModule,
+
+	// Presentation modules
+	presentationDI.GRPCModule,
+	presentationDI.CommandConsumerModule,
+	presentationDI.SagaConsumerModule,
+	presentationDI.SagaWatchdogModule,
+	presentationDI.TelemetryModule,
+)
+
+// Bus carries the messages of the services that run with IN_MEMORY set.
+type Bus = memory.Bus
+
+// NewBus returns an empty in-process broker to share between services.
+func NewBus() Bus {
+	return memory.NewBroker()
+}
+
+// WithBus has the service exchange its messages through bus instead of a
+// broker of its own when IN_MEMORY is set.
+func WithBus(bus Bus) fx.Option {
+	return fx.Decorate(func(memory.Bus) memory.Bus {
+		return bus
+	})
+}
+
+// Config is the configuration of the service running with IN_MEMORY set, for
+// binaries that set it up themselves rather than through the environment.
+type Config struct {
+	Logger        logger.Config
+	Telemetry     telemetry.Config
+	Memory        memory.Config
+	Messaging     messaging.Config
+	Retry         retry.Config
+	Commit        commit.Config
+	Worker        worker.Config
+	Inbox         inbox.Config
+	Catalog       catalog.Config
+	CreateSaga    createOrder.Config
+	CancelSaga    cancelOrder.Config
+	Cancellation  cancelOrder.PolicyConfig
+	DeliverySlots slotUsecase.ScheduleConfig
+	Watchdog      createOrderWatchdog.WatchdogConfig
+	GRPC          orderv1.Config
+}
+
+// WithConfig has the service use cfg instead of reading its configuration
+// from the environment.
+func WithConfig(cfg Config) fx.Option {
+	return fx.Replace(
+		&cfg.Logger,
+		&cfg.Telemetry,
+		&cfg.Memory,
+		&cfg.Messaging,
+		&cfg.Retry,
+		&cfg.Commit,
+		&cfg.Worker,
+		&cfg.Inbox,
+		&cfg.Catalog,
+		&cfg.CreateSaga,
+		&cfg.CancelSaga,
+		&cfg.Cancellation,
+		&cfg.DeliverySlots,
+		&cfg.Watchdog,
+		&cfg.GRPC,
+	)
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"order/internal/infrastructure/messaging"
+	"order/internal/infrastructure/messaging/dlq"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+const dlqUsage = `Usage:
+  order dlq list   -topic <dlq topic> [-limit n]
+  order dlq replay -topic <dlq topic> [-partition p -offset o | -limit n]
+`
+
+// runDLQ implements the "dlq" subcommand for inspecting and replaying dead-lettered messages.
+func runDLQ(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, dlqUsage)
+		return 2
+	}
+
+	flags := flag.NewFlagSet("dlq "+args[0], flag.ContinueOnError)
+	topic := flags.String("topic", "", "DLQ topic to read")
+	limit := flags.Int("limit", 0, "maximum number of messages to list or replay, 0 for all")
+	partition := flags.Int("partition", -1, "replay only the message in this DLQ partition")
+	offset := flags.Int64("offset", -1, "replay only the message at this DLQ offset")
+	timeout := flags.Duration("timeout", 30*time.Second, "overall timeout")
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+	if *topic == "" {
+		fmt.Fprint(os.Stderr, dlqUsage)
+		return 2
+	}
+
+	cfg, err := messaging.NewConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	inspector := dlq.NewInspector(cfg.Address)
+
+	switch args[0] {
+	case "list":
+		messages, err := inspector.List(ctx, *topic, *limit)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		printDLQMessages(os.Stdout, messages)
+		return 0
+
+	case "replay":
+		// A single message is read at its offset; the limit only bounds a full replay.
+		var messages []*dlq.Message
+		switch {
+		case *partition >= 0 && *offset >= 0:
+			message, err := inspector.Get(ctx, *topic, *partition, *offset)
+			if errors.Is(err, dlq.ErrMessageNotFound) {
+				fmt.Fprintf(os.Stderr, "no message at %d/%d in %s\n", *partition, *offset, *topic)
+				return 1
+			}
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return 1
+			}
+			messages = []*dlq.Message{message}
+		case *partition >= 0 || *offset >= 0:
+			fmt.Fprint(os.Stderr, dlqUsage)
+			return 2
+		default:
+			messages, err = inspector.List(ctx, *topic, *limit)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return 1
+			}
+		}
+		if err := inspector.Replay(ctx, messages); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Printf("Replayed %d message(s) from %s\n", len(messages), *topic)
+		return 0
+
+	default:
+		fmt.Fprint(os.Stderr, dlqUsage)
+		return 2
+	}
+}
+
+func printDLQMessages(out io.Writer, messages []*dlq.Message) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "PARTITION\tOFFSET\tSOURCE\tATTEMPTS\tFAILED AT\tERROR")
+	for _, m := range messages {
+		fmt.Fprintf(w, "%d\t%d\t%s/%d/%d\t%d\t%s\t%s\n",
+			m.Partition, m.Offset,
+			m.SourceTopic, m.SourcePartition, m.SourceOffset,
+			m.Attempts, m.FailedAt.Format(time.RFC3339), m.Error,
+		)
+	}
+}
@@ -3,10 +3,8 @@ package main
 import (
 	"context"
 	"log"
-	appDI "order/internal/application/di"
-	infraDI "order/internal/infrastructure/di"
+	orderApp "order/app"
 	"order/internal/infrastructure/logger"
-	presentationDI "order/internal/presentation/di"
 	"os"
 	"os/signal"
 	"syscall"
@@ -16,24 +14,12 @@ import (
 )
 
 func main() {
-	app := fx.New(
-		// Infrastructure modules
-		infraDI.LoggerModule,
-		infraDI.MessagingModule,
-		infraDI.DatabaseModule,
-		infraDI.RepositoryModule,
-		infraDI.PublisherModule,
-		infraDI.TelemetryModule,
-
-		// Application modules
-		appDI.UseCaseModule,
-		appDI.SagaModule,
+	if len(os.Args) > 1 && os.Args[1] == "dlq" {
+		os.Exit(runDLQ(os.Args[2:]))
+	}
 
-		// Presentation modules
-		presentationDI.GRPCModule,
-		presentationDI.CommandConsumerModule,
-		presentationDI.SagaConsumerModule,
-		presentationDI.TelemetryModule,
+	app := fx.New(
+		orderApp.Module,
 
 		// Add logging for application startup and shutdown
 		fx.Invoke(func(lc fx.Lifecycle, logger logger.Logger) {
@@ -1,7 +1,18 @@
 #!/bin/sh
 
-echo "Running migrations..."
-migrate -source "file://${DB_MIGRATIONS_PATH}" -database "${DB_URI}/${DB_NAME}" up
+if [ "$IN_MEMORY" != "true" ]; then
+  case "${DB_ORDER_BACKEND:-mongo}" in
+    mongo)
+      echo "Running migrations..."
+      migrate -source "file://${DB_MIGRATIONS_PATH}" -database "${DB_URI}/${DB_NAME}" up
+      ;;
+    postgres)
+      echo "Running migrations..."
+      DATABASE_URL="postgres://${POSTGRES_USER}:${POSTGRES_PASSWORD}@${POSTGRES_HOST}:${POSTGRES_PORT}/${POSTGRES_DB}?sslmode=disable"
+      migrate -source "file://${DB_POSTGRES_MIGRATIONS_PATH}" -database "$DATABASE_URL" up
+      ;;
+  esac
+fi
 
 echo "Starting the app..."
 exec ./main
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+	analyticsDomain "order/internal/domain/analytics"
+)
+
+type UseCase interface {
+	GetStatusFunnel(ctx context.Context, period analyticsDomain.Period) (*analyticsDomain.StatusFunnel, error)
+	GetRevenue(ctx context.Context, period analyticsDomain.Period, granularity analyticsDomain.Granularity) ([]analyticsDomain.RevenueBucket, error)
+	GetDeliveryTime(ctx context.Context, period analyticsDomain.Period) (*analyticsDomain.DeliveryTime, error)
+	GetCancellationReasons(ctx context.Context, period analyticsDomain.Period) (analyticsDomain.CancellationReasons, error)
+}
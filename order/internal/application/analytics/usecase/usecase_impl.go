@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	analyticsDomain "order/internal/domain/analytics"
+)
+
+type UseCaseImpl struct {
+	repo analyticsDomain.Repository
+}
+
+func New(repo analyticsDomain.Repository) UseCase {
+	return &UseCaseImpl{repo: repo}
+}
+
+func (u *UseCaseImpl) GetStatusFunnel(ctx context.Context, period analyticsDomain.Period) (*analyticsDomain.StatusFunnel, error) {
+	if err := period.Validate(); err != nil {
+		return nil, err
+	}
+	return u.repo.GetStatusFunnel(ctx, period)
+}
+
+func (u *UseCaseImpl) GetRevenue(
+	ctx context.Context,
+	period analyticsDomain.Period,
+	granularity analyticsDomain.Granularity,
+) ([]analyticsDomain.RevenueBucket, error) {
+	if err := period.Validate(); err != nil {
+		return nil, err
+	}
+	if err := granularity.Validate(); err != nil {
+		return nil, err
+	}
+	return u.repo.GetRevenue(ctx, period, granularity)
+}
+
+func (u *UseCaseImpl) GetDeliveryTime(ctx context.Context, period analyticsDomain.Period) (*analyticsDomain.DeliveryTime, error) {
+	if err := period.Validate(); err != nil {
+		return nil, err
+	}
+	return u.repo.GetDeliveryTime(ctx, period)
+}
+
+func (u *UseCaseImpl) GetCancellationReasons(
+	ctx context.Context,
+	period analyticsDomain.Period,
+) (analyticsDomain.CancellationReasons, error) {
+	if err := period.Validate(); err != nil {
+		return nil, err
+	}
+	return u.repo.GetCancellationReasons(ctx, period)
+}
+
+var _ UseCase = (*UseCaseImpl)(nil)
@@ -1,6 +1,7 @@
 package di
 
 import (
+	cancelOrder "order/internal/application/order/saga/cancel_order"
 	createOrder "order/internal/application/order/saga/create_order"
 
 	"go.uber.org/fx"
@@ -11,8 +12,28 @@ var SagaModule = fx.Provide(
 		createOrder.New,
 		fx.As(new(createOrder.Saga)),
 	),
+	createOrder.NewConfig,
 	fx.Annotate(
 		createOrder.NewManager,
 		fx.As(new(createOrder.Manager)),
 	),
+	fx.Annotate(
+		createOrder.NewWatchdog,
+		fx.As(new(createOrder.Watchdog)),
+	),
+
+	fx.Annotate(
+		cancelOrder.New,
+		fx.As(new(cancelOrder.Saga)),
+	),
+	cancelOrder.NewConfig,
+	fx.Annotate(
+		cancelOrder.NewManager,
+		fx.As(new(cancelOrder.Manager)),
+	),
+	fx.Annotate(
+		cancelOrder.NewWatchdog,
+		fx.As(new(cancelOrder.Watchdog)),
+	),
+	cancelOrder.NewPolicyConfig,
 )
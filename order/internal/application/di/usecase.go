@@ -1,7 +1,11 @@
 package di
 
 import (
+	analyticsUsecase "order/internal/application/analytics/usecase"
 	orderUsecase "order/internal/application/order/usecase"
+	promotionUsecase "order/internal/application/promotion/usecase"
+	sagaUsecase "order/internal/application/saga/usecase"
+	slotUsecase "order/internal/application/slot/usecase"
 
 	"go.uber.org/fx"
 )
@@ -11,4 +15,21 @@ var UseCaseModule = fx.Provide(
 		orderUsecase.New,
 		fx.As(new(orderUsecase.UseCase)),
 	),
+	fx.Annotate(
+		sagaUsecase.New,
+		fx.As(new(sagaUsecase.UseCase)),
+	),
+	fx.Annotate(
+		promotionUsecase.New,
+		fx.As(new(promotionUsecase.UseCase)),
+	),
+	fx.Annotate(
+		slotUsecase.New,
+		fx.As(new(slotUsecase.UseCase)),
+	),
+	fx.Annotate(
+		analyticsUsecase.New,
+		fx.As(new(analyticsUsecase.UseCase)),
+	),
+	slotUsecase.NewScheduleConfig,
 )
@@ -0,0 +1,29 @@
+package cancel_order
+
+import (
+	"github.com/google/uuid"
+)
+
+const (
+	ReleaseItemsCmdName     = "cancel_order.release_items"
+	ReleaseCourierCmdName   = "cancel_order.release_courier"
+	CancelByCustomerCmdName = "cancel_order.cancel_by_customer"
+)
+
+type ReleaseItemsCmd struct {
+	OrderID uuid.UUID
+	Items   []OrderItem
+}
+
+type ReleaseCourierCmd struct {
+	OrderID uuid.UUID
+}
+
+type CancelByCustomerCmd struct {
+	OrderID uuid.UUID
+}
+
+type OrderItem struct {
+	ProductID uuid.UUID
+	Count     int
+}
@@ -0,0 +1,43 @@
+package cancel_order
+
+import (
+	"fmt"
+	orderDomain "order/internal/domain/order"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Config struct {
+	// MaxRetries is how often the watchdog sends the release commands of a
+	// stalled cancellation again before completing it without their replies.
+	MaxRetries int `envconfig:"SAGA_CANCEL_MAX_RETRIES" required:"true"`
+}
+
+func NewConfig() (*Config, error) {
+	var cfg Config
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, fmt.Errorf("failed to load cancel order saga config: %w", err)
+	}
+	return &cfg, nil
+}
+
+type PolicyConfig struct {
+	CreatedWindow    time.Duration `envconfig:"ORDER_CANCEL_WINDOW_CREATED" required:"true"`
+	DeliveringWindow time.Duration `envconfig:"ORDER_CANCEL_WINDOW_DELIVERING" required:"true"`
+}
+
+func NewPolicyConfig() (*PolicyConfig, error) {
+	var cfg PolicyConfig
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, fmt.Errorf("failed to load order cancellation policy config: %w", err)
+	}
+	return &cfg, nil
+}
+
+func (c *PolicyConfig) Policy() orderDomain.CancellationPolicy {
+	return orderDomain.CancellationPolicy{
+		CreatedWindow:    c.CreatedWindow,
+		DeliveringWindow: c.DeliveringWindow,
+	}
+}
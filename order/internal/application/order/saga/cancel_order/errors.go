@@ -0,0 +1,9 @@
+package cancel_order
+
+import "errors"
+
+// ErrItemsReleaseNotAwaited is returned for an ItemsReleased reply the saga
+// never asked for: it started after the items were released or while they were
+// still being reserved, in which case the superseded create_order saga asks for
+// the release and the reply is its own.
+var ErrItemsReleaseNotAwaited = errors.New("cancel saga awaits no items release")
@@ -0,0 +1,15 @@
+package cancel_order
+
+import "github.com/google/uuid"
+
+type ItemsReleased struct {
+	OrderID uuid.UUID
+}
+
+type CourierReleased struct {
+	OrderID uuid.UUID
+}
+
+type DeadlineExceeded struct {
+	OrderID uuid.UUID
+}
@@ -0,0 +1,11 @@
+package cancel_order
+
+import (
+	"context"
+	orderDomain "order/internal/domain/order"
+	"order/internal/domain/uow"
+)
+
+type Manager interface {
+	Create(ctx context.Context, tx uow.UoW, order *orderDomain.Order) error
+}
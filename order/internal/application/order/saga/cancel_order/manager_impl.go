@@ -0,0 +1,66 @@
+package cancel_order
+
+import (
+	"context"
+	orderDomain "order/internal/domain/order"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/domain/uow"
+)
+
+type ManagerImpl struct{}
+
+func NewManager() Manager {
+	return &ManagerImpl{}
+}
+
+// Create starts the saga for an order a customer or an admin asked to cancel,
+// within the transaction that moves the order to Canceling.
+//
+// A create_order saga still waiting for the reservation, a courier or its
+// delivery slot is superseded: its late replies no longer move it on and its
+// watchdog no longer compensates it, since this saga releases everything
+// instead. Before the reservation reply nothing is reserved, so only the
+// courier is released here; the superseded saga releases whatever that reply
+// reports once it arrives.
+func (m *ManagerImpl) Create(ctx context.Context, tx uow.UoW, order *orderDomain.Order) error {
+	createSaga, err := tx.Saga().GetByOrderID(ctx, sagaDomain.CreateOrder, order.ID)
+	if err != nil {
+		return err
+	}
+
+	reserved := createSaga.Step != sagaDomain.ReservingItems
+	switch createSaga.Step {
+	case sagaDomain.ReservingItems, sagaDomain.AssigningCourier, sagaDomain.AwaitingDeliverySlot:
+		if err = createSaga.NoteStep(sagaDomain.SupersededByCancel); err != nil {
+			return err
+		}
+		if err = tx.Saga().Update(ctx, createSaga); err != nil {
+			return err
+		}
+	case sagaDomain.BeginningDelivery:
+	default:
+		return orderDomain.ErrCancellationNotAllowed
+	}
+
+	instance, err := sagaDomain.Create(sagaDomain.CancelOrder, order.ID)
+	if err != nil {
+		return err
+	}
+	if !reserved {
+		if err = instance.NoteStep(sagaDomain.AwaitingCourierRelease); err != nil {
+			return err
+		}
+	}
+	if err = tx.Saga().Create(ctx, instance); err != nil {
+		return err
+	}
+
+	if reserved {
+		if err = releaseItems(ctx, tx, instance, order); err != nil {
+			return err
+		}
+	}
+	return releaseCourier(ctx, tx, instance)
+}
+
+var _ Manager = (*ManagerImpl)(nil)
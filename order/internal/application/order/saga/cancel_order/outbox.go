@@ -0,0 +1,22 @@
+package cancel_order
+
+import (
+	"context"
+	outboxDomain "order/internal/domain/outbox"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/domain/uow"
+)
+
+// publishCmd stores the command in the outbox of the given transaction, keyed by
+// the order ID so that it is delivered after every earlier command of the order.
+// The message ID is the saga's ID for the command, which a retry reuses, so a
+// command that is still waiting in the outbox is left there rather than queued
+// twice.
+func publishCmd(ctx context.Context, tx uow.UoW, instance *sagaDomain.Saga, name string, cmd any) error {
+	message, err := outboxDomain.Create(name, instance.OrderID.String(), cmd)
+	if err != nil {
+		return err
+	}
+	message.ID = instance.CommandID(name)
+	return tx.Outbox().CreateOnce(ctx, message)
+}
@@ -0,0 +1,14 @@
+package cancel_order
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Saga interface {
+	HandleItemsReleased(ctx context.Context, event ItemsReleased) error
+	HandleCourierReleased(ctx context.Context, event CourierReleased) error
+	HandleDeadlineExceeded(ctx context.Context, event DeadlineExceeded) error
+	RetryStep(ctx context.Context, orderID uuid.UUID) error
+}
@@ -0,0 +1,194 @@
+package cancel_order
+
+import (
+	"context"
+	"fmt"
+	orderDomain "order/internal/domain/order"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/domain/uow"
+
+	"github.com/google/uuid"
+)
+
+type SagaImpl struct {
+	uow        uow.UoW
+	maxRetries int
+}
+
+func New(uow uow.UoW, cfg *Config) Saga {
+	return &SagaImpl{
+		uow:        uow,
+		maxRetries: cfg.MaxRetries,
+	}
+}
+
+// HandleItemsReleased returns ErrItemsReleaseNotAwaited when the saga is not
+// waiting for the items, so that the reply can be handed to the saga that is.
+func (s *SagaImpl) HandleItemsReleased(ctx context.Context, event ItemsReleased) error {
+	instance, err := s.load(ctx, event.OrderID)
+	if err != nil {
+		return err
+	}
+
+	switch instance.Step {
+	case sagaDomain.AwaitingItemsRelease:
+		return s.advance(ctx, instance, sagaDomain.CancelingByCustomer, func(ctx context.Context, tx uow.UoW) error {
+			return s.cancelByCustomer(ctx, tx, instance)
+		})
+	case sagaDomain.ReleasingItemsAndCourier:
+		return s.advance(ctx, instance, sagaDomain.AwaitingCourierRelease, nil)
+	default:
+		return ErrItemsReleaseNotAwaited
+	}
+}
+
+func (s *SagaImpl) HandleCourierReleased(ctx context.Context, event CourierReleased) error {
+	instance, err := s.load(ctx, event.OrderID)
+	if err != nil {
+		return err
+	}
+
+	if instance.Step == sagaDomain.AwaitingCourierRelease {
+		return s.advance(ctx, instance, sagaDomain.CancelingByCustomer, func(ctx context.Context, tx uow.UoW) error {
+			return s.cancelByCustomer(ctx, tx, instance)
+		})
+	}
+
+	return s.advance(ctx, instance, sagaDomain.AwaitingItemsRelease, nil)
+}
+
+// HandleDeadlineExceeded handles a saga that got no reply within its deadline.
+// The release commands it awaits a reply to are sent again up to the
+// configured number of times; after that the cancellation completes without
+// the replies, and the missing release is recorded on the saga for an
+// operator to follow up.
+func (s *SagaImpl) HandleDeadlineExceeded(ctx context.Context, event DeadlineExceeded) error {
+	instance, err := s.load(ctx, event.OrderID)
+	if err != nil {
+		return err
+	}
+
+	if instance.Retries() < s.maxRetries {
+		return s.retryStep(ctx, instance)
+	}
+
+	instance.NoteFailure(fmt.Errorf("%w: %s after %d retries", sagaDomain.ErrRetriesExhausted, instance.Step, instance.Retries()))
+	return s.advance(ctx, instance, sagaDomain.CancelingByCustomer, func(ctx context.Context, tx uow.UoW) error {
+		return s.cancelByCustomer(ctx, tx, instance)
+	})
+}
+
+// RetryStep sends the release commands the saga still awaits a reply to once
+// more. They keep their message IDs, so a service that already handled them
+// replays its reply instead of acting twice.
+func (s *SagaImpl) RetryStep(ctx context.Context, orderID uuid.UUID) error {
+	instance, err := s.load(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	return s.retryStep(ctx, instance)
+}
+
+func (s *SagaImpl) retryStep(ctx context.Context, instance *sagaDomain.Saga) error {
+	if err := instance.NoteRetry(); err != nil {
+		return err
+	}
+
+	return s.uow.Transaction(ctx, func(ctx context.Context, tx uow.UoW) error {
+		if err := tx.Saga().Update(ctx, instance); err != nil {
+			return err
+		}
+
+		order, err := tx.Order().GetByID(ctx, instance.OrderID)
+		if err != nil {
+			return err
+		}
+
+		switch instance.Step {
+		case sagaDomain.ReleasingItemsAndCourier:
+			if err := releaseItems(ctx, tx, instance, order); err != nil {
+				return err
+			}
+			return releaseCourier(ctx, tx, instance)
+		case sagaDomain.AwaitingCourierRelease:
+			return releaseCourier(ctx, tx, instance)
+		case sagaDomain.AwaitingItemsRelease:
+			return releaseItems(ctx, tx, instance, order)
+		default:
+			return sagaDomain.ErrStepNotRetryable
+		}
+	})
+}
+
+// cancelByCustomer completes the cancellation. The command keeps its name from
+// when only customers could cancel; it completes admin cancellations as well.
+func (s *SagaImpl) cancelByCustomer(ctx context.Context, tx uow.UoW, instance *sagaDomain.Saga) error {
+	cmd := CancelByCustomerCmd{OrderID: instance.OrderID}
+	return publishCmd(ctx, tx, instance, CancelByCustomerCmdName, cmd)
+}
+
+func releaseItems(ctx context.Context, tx uow.UoW, instance *sagaDomain.Saga, order *orderDomain.Order) error {
+	cmd := ReleaseItemsCmd{
+		OrderID: order.ID,
+		Items:   domainItemsToOrderItems(order.ReservedItems()),
+	}
+	return publishCmd(ctx, tx, instance, ReleaseItemsCmdName, cmd)
+}
+
+func releaseCourier(ctx context.Context, tx uow.UoW, instance *sagaDomain.Saga) error {
+	cmd := ReleaseCourierCmd{OrderID: instance.OrderID}
+	return publishCmd(ctx, tx, instance, ReleaseCourierCmdName, cmd)
+}
+
+func (s *SagaImpl) load(ctx context.Context, orderID uuid.UUID) (*sagaDomain.Saga, error) {
+	return s.uow.Saga().GetByOrderID(ctx, sagaDomain.CancelOrder, orderID)
+}
+
+// advance moves the saga instance to the given step and runs the optional step
+// action in the same transaction, so the new step and the commands it emits are
+// committed together. A failed action is recorded on the instance.
+func (s *SagaImpl) advance(
+	ctx context.Context,
+	instance *sagaDomain.Saga,
+	step sagaDomain.Step,
+	action func(ctx context.Context, tx uow.UoW) error,
+) error {
+	if err := instance.NoteStep(step); err != nil {
+		return err
+	}
+
+	var actionErr error
+	err := s.uow.Transaction(ctx, func(ctx context.Context, tx uow.UoW) error {
+		if err := tx.Saga().Update(ctx, instance); err != nil {
+			return err
+		}
+		if action == nil {
+			return nil
+		}
+		actionErr = action(ctx, tx)
+		return actionErr
+	})
+	if actionErr != nil {
+		s.noteFailure(ctx, instance.OrderID, actionErr)
+	}
+
+	return err
+}
+
+// noteFailure records the failure on the persisted instance, since the step
+// change made in the aborted transaction never reached the store. When the
+// saga ran in a transaction of its caller, as it does for the results the
+// saga consumer handles, the note is rolled back with it and the caller
+// reports the failure instead.
+func (s *SagaImpl) noteFailure(ctx context.Context, orderID uuid.UUID, err error) {
+	instance, loadErr := s.load(ctx, orderID)
+	if loadErr != nil {
+		return
+	}
+
+	instance.NoteFailure(err)
+	_ = s.uow.Saga().Update(ctx, instance)
+}
+
+var _ Saga = (*SagaImpl)(nil)
@@ -0,0 +1,10 @@
+package cancel_order
+
+import (
+	"context"
+	"time"
+)
+
+type Watchdog interface {
+	RetryStalled(ctx context.Context, deadline, lease time.Duration) (int, error)
+}
@@ -0,0 +1,63 @@
+package cancel_order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/domain/uow"
+	"time"
+)
+
+type WatchdogImpl struct {
+	saga Saga
+	uow  uow.UoW
+}
+
+func NewWatchdog(saga Saga, uow uow.UoW) Watchdog {
+	return &WatchdogImpl{
+		saga: saga,
+		uow:  uow,
+	}
+}
+
+// RetryStalled claims every cancellation whose current step has been awaiting a
+// release reply for longer than the deadline and lets the saga retry the step or
+// complete without the reply. Claims are leased, so several replicas can run it
+// concurrently. It returns the number of handled sagas.
+func (w *WatchdogImpl) RetryStalled(ctx context.Context, deadline, lease time.Duration) (int, error) {
+	steps := sagaDomain.AwaitingSteps(sagaDomain.CancelOrder)
+
+	var (
+		handled int
+		errs    []error
+	)
+	for ctx.Err() == nil {
+		now := time.Now()
+		instance, err := w.uow.Saga().ClaimStalled(
+			ctx,
+			sagaDomain.CancelOrder,
+			steps,
+			now.Add(-deadline),
+			now.Add(lease),
+		)
+		if err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if instance == nil {
+			break
+		}
+
+		event := DeadlineExceeded{OrderID: instance.OrderID}
+		if err = w.saga.HandleDeadlineExceeded(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("saga %s not retried: %w", instance.ID, err))
+			continue
+		}
+		handled++
+	}
+
+	return handled, errors.Join(errs...)
+}
+
+var _ Watchdog = (*WatchdogImpl)(nil)
@@ -1,12 +1,25 @@
 package create_order
 
 import (
+	orderDomain "order/internal/domain/order"
+
 	"github.com/google/uuid"
 )
 
+const (
+	ReserveItemsCmdName          = "create_order.reserve_items"
+	ReleaseItemsCmdName          = "create_order.release_items"
+	CancelOutOfStockCmdName      = "create_order.cancel_out_of_stock"
+	AssignCourierCmdName         = "create_order.assign_courier"
+	BeginDeliveryCmdName         = "create_order.begin_delivery"
+	CancelCourierNotFoundCmdName = "create_order.cancel_courier_not_found"
+	CancelTimeoutCmdName         = "create_order.cancel_timeout"
+)
+
 type ReserveItemsCmd struct {
 	OrderID uuid.UUID
 	Items   []OrderItem
+	Policy  orderDomain.FulfillmentPolicy
 }
 
 type ReleaseItemsCmd struct {
@@ -31,6 +44,10 @@ type CancelCourierNotFoundCmd struct {
 	OrderID uuid.UUID
 }
 
+type CancelTimeoutCmd struct {
+	OrderID uuid.UUID
+}
+
 type OrderItem struct {
 	ProductID uuid.UUID
 	Count     int
@@ -0,0 +1,22 @@
+package create_order
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Config struct {
+	// CourierLeadTime is how long before a booked delivery slot starts the
+	// courier is assigned.
+	CourierLeadTime time.Duration `envconfig:"SAGA_COURIER_LEAD_TIME" required:"true"`
+}
+
+func NewConfig() (*Config, error) {
+	var cfg Config
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, fmt.Errorf("failed to load create order saga config: %w", err)
+	}
+	return &cfg, nil
+}
@@ -2,8 +2,12 @@ package create_order
 
 import "github.com/google/uuid"
 
+// ItemsReserved lists how much of every item the warehouse reserved. Replies
+// sent before orders could be partially fulfilled carry no items, and mean
+// every item was reserved in full.
 type ItemsReserved struct {
 	OrderID uuid.UUID
+	Items   []OrderItem
 }
 
 type ItemsReservationFailed struct {
@@ -22,3 +26,11 @@ type CourierAssigned struct {
 type ItemsReleased struct {
 	OrderID uuid.UUID
 }
+
+type DeadlineExceeded struct {
+	OrderID uuid.UUID
+}
+
+type DeliverySlotApproaching struct {
+	OrderID uuid.UUID
+}
@@ -3,8 +3,9 @@ package create_order
 import (
 	"context"
 	orderDomain "order/internal/domain/order"
+	"order/internal/domain/uow"
 )
 
 type Manager interface {
-	Create(ctx context.Context, order *orderDomain.Order)
+	Create(ctx context.Context, tx uow.UoW, order *orderDomain.Order) error
 }
@@ -3,24 +3,36 @@ package create_order
 import (
 	"context"
 	orderDomain "order/internal/domain/order"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/domain/uow"
 )
 
-type ManagerImpl struct {
-	publisher Publisher
-}
+type ManagerImpl struct{}
 
-func NewManager(publisher Publisher) Manager {
-	return &ManagerImpl{publisher: publisher}
+func NewManager() Manager {
+	return &ManagerImpl{}
 }
 
-func (m *ManagerImpl) Create(ctx context.Context, order *orderDomain.Order) {
-	orderItems := domainItemsToOrderItems(order.Items)
+// Create starts the saga for the order within the transaction that creates the order.
+func (m *ManagerImpl) Create(ctx context.Context, tx uow.UoW, order *orderDomain.Order) error {
+	instance, err := sagaDomain.Create(sagaDomain.CreateOrder, order.ID)
+	if err != nil {
+		return err
+	}
+	if err = tx.Saga().Create(ctx, instance); err != nil {
+		return err
+	}
+
+	return reserveItems(ctx, tx, instance, order)
+}
 
+func reserveItems(ctx context.Context, tx uow.UoW, instance *sagaDomain.Saga, order *orderDomain.Order) error {
 	cmd := ReserveItemsCmd{
 		OrderID: order.ID,
-		Items:   orderItems,
+		Items:   domainItemsToOrderItems(order.Items),
+		Policy:  order.Fulfillment,
 	}
-	_ = m.publisher.PublishReserveItemsCmd(ctx, cmd)
+	return publishCmd(ctx, tx, instance, ReserveItemsCmdName, cmd)
 }
 
 var _ Manager = (*ManagerImpl)(nil)
@@ -2,6 +2,8 @@ package create_order
 
 import (
 	orderDomain "order/internal/domain/order"
+
+	"github.com/google/uuid"
 )
 
 func domainItemToOrderItem(domainItem orderDomain.Item) OrderItem {
@@ -18,3 +20,22 @@ func domainItemsToOrderItems(domainItems []orderDomain.Item) []OrderItem {
 	}
 	return orderItems
 }
+
+func orderItemsToCounts(orderItems []OrderItem) map[uuid.UUID]int {
+	counts := make(map[uuid.UUID]int, len(orderItems))
+	for _, item := range orderItems {
+		counts[item.ProductID] += item.Count
+	}
+	return counts
+}
+
+// reservedOrderItems drops the items the warehouse reserved none of.
+func reservedOrderItems(orderItems []OrderItem) []OrderItem {
+	reserved := make([]OrderItem, 0, len(orderItems))
+	for _, item := range orderItems {
+		if item.Count > 0 {
+			reserved = append(reserved, item)
+		}
+	}
+	return reserved
+}
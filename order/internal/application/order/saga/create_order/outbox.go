@@ -0,0 +1,48 @@
+package create_order
+
+import (
+	"context"
+	orderDomain "order/internal/domain/order"
+	outboxDomain "order/internal/domain/outbox"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/domain/uow"
+)
+
+// publishCmd stores the command in the outbox of the given transaction. It is
+// relayed to the broker only once the transaction commits, keyed by the order ID
+// so that all commands of one order keep their relative order. The message ID
+// is the saga's ID for the command, which a retry reuses, so a command that is
+// still waiting in the outbox is left there rather than queued twice.
+func publishCmd(ctx context.Context, tx uow.UoW, instance *sagaDomain.Saga, name string, cmd any) error {
+	message, err := outboxDomain.Create(name, instance.OrderID.String(), cmd)
+	if err != nil {
+		return err
+	}
+	message.ID = instance.CommandID(name)
+	return tx.Outbox().CreateOnce(ctx, message)
+}
+
+// eventMessages turns the events the order raised into outbox messages keyed
+// by the order ID.
+func eventMessages(order *orderDomain.Order) ([]*outboxDomain.Message, error) {
+	events := order.PullEvents()
+	messages := make([]*outboxDomain.Message, 0, len(events))
+	for _, event := range events {
+		message, err := outboxDomain.CreateFromEvent(event, order.ID.String())
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+// publishEvents stores the messages in the outbox of the given transaction.
+func publishEvents(ctx context.Context, tx uow.UoW, messages []*outboxDomain.Message) error {
+	for _, message := range messages {
+		if err := tx.Outbox().Create(ctx, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
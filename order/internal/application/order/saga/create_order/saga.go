@@ -1,6 +1,10 @@
 package create_order
 
-import "context"
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
 
 type Saga interface {
 	HandleItemsReserved(ctx context.Context, event ItemsReserved) error
@@ -8,4 +12,7 @@ type Saga interface {
 	HandleCourierAssignmentFailed(ctx context.Context, event CourierAssignmentFailed) error
 	HandleCourierAssigned(ctx context.Context, event CourierAssigned) error
 	HandleItemsReleased(ctx context.Context, event ItemsReleased) error
+	HandleDeadlineExceeded(ctx context.Context, event DeadlineExceeded) error
+	HandleDeliverySlotApproaching(ctx context.Context, event DeliverySlotApproaching) error
+	RetryStep(ctx context.Context, orderID uuid.UUID) error
 }
@@ -2,54 +2,380 @@ package create_order
 
 import (
 	"context"
+	"errors"
 	orderDomain "order/internal/domain/order"
+	promotionDomain "order/internal/domain/promotion"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/domain/uow"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 type SagaImpl struct {
-	publisher  Publisher
-	repository orderDomain.Repository
+	uow             uow.UoW
+	courierLeadTime time.Duration
 }
 
-func New(publisher Publisher, repository orderDomain.Repository) Saga {
+func New(uow uow.UoW, cfg *Config) Saga {
 	return &SagaImpl{
-		publisher:  publisher,
-		repository: repository,
+		uow:             uow,
+		courierLeadTime: cfg.CourierLeadTime,
 	}
 }
 
+// HandleItemsReserved asks for a courier. An order booked into a delivery slot
+// gets its courier only the lead time before the slot starts; until then the
+// saga is suspended and the watchdog resumes it. When the warehouse reserved
+// less than was asked for, the order is cut down to what it reserved in the
+// same transaction as the step change, and its discount is priced again.
 func (s *SagaImpl) HandleItemsReserved(ctx context.Context, event ItemsReserved) error {
-	cmd := AssignCourierCmd(event)
-	return s.publisher.PublishAssignCourierCmd(ctx, cmd)
+	instance, err := s.load(ctx, event.OrderID)
+	if err != nil {
+		return err
+	}
+
+	// A reply arriving after the saga timed out waiting for it, or after a
+	// cancellation superseded it, comes too late for the order, which is being
+	// canceled, so what it reserved is released.
+	if instance.Step == sagaDomain.CancelingTimeout || instance.Step == sagaDomain.SupersededByCancel {
+		return s.releaseLateReservation(ctx, instance, event)
+	}
+
+	order, err := s.uow.Order().GetByID(ctx, event.OrderID)
+	if err != nil {
+		return err
+	}
+
+	recordReserved, err := s.noteItemsReserved(ctx, order, event.Items)
+	if err != nil {
+		return err
+	}
+
+	if slot := order.Delivery.Slot; slot != nil {
+		resumeAt := slot.Start.Add(-s.courierLeadTime)
+		if resumeAt.After(time.Now()) {
+			return s.suspend(ctx, instance, sagaDomain.AwaitingDeliverySlot, resumeAt, recordReserved)
+		}
+	}
+
+	return s.advance(ctx, instance, sagaDomain.AssigningCourier, func(ctx context.Context, tx uow.UoW) error {
+		if err := recordReserved(ctx, tx); err != nil {
+			return err
+		}
+		cmd := AssignCourierCmd{OrderID: event.OrderID}
+		return publishCmd(ctx, tx, instance, AssignCourierCmdName, cmd)
+	})
+}
+
+func (s *SagaImpl) HandleDeliverySlotApproaching(ctx context.Context, event DeliverySlotApproaching) error {
+	instance, err := s.load(ctx, event.OrderID)
+	if err != nil {
+		return err
+	}
+
+	return s.advance(ctx, instance, sagaDomain.AssigningCourier, func(ctx context.Context, tx uow.UoW) error {
+		cmd := AssignCourierCmd(event)
+		return publishCmd(ctx, tx, instance, AssignCourierCmdName, cmd)
+	})
 }
 
 func (s *SagaImpl) HandleItemsReservationFailed(ctx context.Context, event ItemsReservationFailed) error {
-	cmd := CancelOutOfStockCmd(event)
-	return s.publisher.PublishCancelOutOfStockCmd(ctx, cmd)
+	instance, err := s.load(ctx, event.OrderID)
+	if err != nil {
+		return err
+	}
+
+	return s.advance(ctx, instance, sagaDomain.CancelingOutOfStock, func(ctx context.Context, tx uow.UoW) error {
+		cmd := CancelOutOfStockCmd(event)
+		return publishCmd(ctx, tx, instance, CancelOutOfStockCmdName, cmd)
+	})
 }
 
 func (s *SagaImpl) HandleCourierAssignmentFailed(ctx context.Context, event CourierAssignmentFailed) error {
-	order, err := s.repository.GetByID(ctx, event.OrderID)
+	instance, err := s.load(ctx, event.OrderID)
+	if err != nil {
+		return err
+	}
+
+	return s.advance(ctx, instance, sagaDomain.ReleasingItems, func(ctx context.Context, tx uow.UoW) error {
+		return s.releaseItems(ctx, tx, instance)
+	})
+}
+
+func (s *SagaImpl) HandleItemsReleased(ctx context.Context, event ItemsReleased) error {
+	instance, err := s.load(ctx, event.OrderID)
+	if err != nil {
+		return err
+	}
+
+	// The release of a late reservation needs no follow-up; the order is
+	// already being canceled.
+	if instance.Step == sagaDomain.CancelingTimeout || instance.Step == sagaDomain.SupersededByCancel {
+		return nil
+	}
+
+	if instance.Step == sagaDomain.ReleasingItemsOnTimeout {
+		return s.advance(ctx, instance, sagaDomain.CancelingTimeout, func(ctx context.Context, tx uow.UoW) error {
+			return s.cancelTimeout(ctx, tx, instance)
+		})
+	}
+
+	return s.advance(ctx, instance, sagaDomain.CancelingCourierNotFound, func(ctx context.Context, tx uow.UoW) error {
+		cmd := CancelCourierNotFoundCmd(event)
+		return publishCmd(ctx, tx, instance, CancelCourierNotFoundCmdName, cmd)
+	})
+}
+
+func (s *SagaImpl) HandleCourierAssigned(ctx context.Context, event CourierAssigned) error {
+	instance, err := s.load(ctx, event.OrderID)
 	if err != nil {
 		return err
 	}
 
-	orderItems := domainItemsToOrderItems(order.Items)
+	return s.advance(ctx, instance, sagaDomain.BeginningDelivery, func(ctx context.Context, tx uow.UoW) error {
+		cmd := BeginDeliveryCmd(event)
+		return publishCmd(ctx, tx, instance, BeginDeliveryCmdName, cmd)
+	})
+}
+
+// HandleDeadlineExceeded compensates a saga that got no reply within its deadline.
+// Once the items have been reserved they are released first, and the order is
+// canceled when the warehouse confirms the release. A saga still waiting for
+// the reservation cancels the order at once; should the reservation arrive
+// after all, HandleItemsReserved releases it.
+func (s *SagaImpl) HandleDeadlineExceeded(ctx context.Context, event DeadlineExceeded) error {
+	instance, err := s.load(ctx, event.OrderID)
+	if err != nil {
+		return err
+	}
+
+	if instance.Step == sagaDomain.AssigningCourier {
+		return s.advance(ctx, instance, sagaDomain.ReleasingItemsOnTimeout, func(ctx context.Context, tx uow.UoW) error {
+			return s.releaseItems(ctx, tx, instance)
+		})
+	}
+
+	return s.advance(ctx, instance, sagaDomain.CancelingTimeout, func(ctx context.Context, tx uow.UoW) error {
+		return s.cancelTimeout(ctx, tx, instance)
+	})
+}
+
+// RetryStep sends the commands of the step the saga awaits a reply in once
+// more. They keep their message IDs, so a service that already handled them
+// replays its reply instead of acting twice.
+func (s *SagaImpl) RetryStep(ctx context.Context, orderID uuid.UUID) error {
+	instance, err := s.load(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	if err = instance.NoteRetry(); err != nil {
+		return err
+	}
+
+	return s.uow.Transaction(ctx, func(ctx context.Context, tx uow.UoW) error {
+		if err := tx.Saga().Update(ctx, instance); err != nil {
+			return err
+		}
+
+		switch instance.Step {
+		case sagaDomain.ReservingItems:
+			order, err := tx.Order().GetByID(ctx, orderID)
+			if err != nil {
+				return err
+			}
+			return reserveItems(ctx, tx, instance, order)
+		case sagaDomain.AssigningCourier:
+			cmd := AssignCourierCmd{OrderID: orderID}
+			return publishCmd(ctx, tx, instance, AssignCourierCmdName, cmd)
+		case sagaDomain.ReleasingItems, sagaDomain.ReleasingItemsOnTimeout:
+			return s.releaseItems(ctx, tx, instance)
+		default:
+			return sagaDomain.ErrStepNotRetryable
+		}
+	})
+}
+
+func (s *SagaImpl) releaseItems(ctx context.Context, tx uow.UoW, instance *sagaDomain.Saga) error {
+	order, err := tx.Order().GetByID(ctx, instance.OrderID)
+	if err != nil {
+		return err
+	}
+
+	// Lines the warehouse could not reserve anything of have nothing to release.
+	orderItems := domainItemsToOrderItems(order.ReservedItems())
 
 	cmd := ReleaseItemsCmd{
-		OrderID: event.OrderID,
+		OrderID: order.ID,
 		Items:   orderItems,
 	}
-	return s.publisher.PublishReleaseItemsCmd(ctx, cmd)
+	return publishCmd(ctx, tx, instance, ReleaseItemsCmdName, cmd)
 }
 
-func (s *SagaImpl) HandleItemsReleased(ctx context.Context, event ItemsReleased) error {
-	cmd := CancelCourierNotFoundCmd(event)
-	return s.publisher.PublishCancelCourierNotFoundCmd(ctx, cmd)
+// releaseLateReservation hands back the items of a reservation reply the saga
+// no longer waits for. The reply of a reservation the saga did act on, seen
+// again on redelivery, is ignored: the saga or the cancellation that
+// superseded it releases those items.
+func (s *SagaImpl) releaseLateReservation(ctx context.Context, instance *sagaDomain.Saga, event ItemsReserved) error {
+	if instance.Reached(sagaDomain.AssigningCourier) || instance.Reached(sagaDomain.AwaitingDeliverySlot) {
+		return nil
+	}
+
+	items := reservedOrderItems(event.Items)
+	if len(event.Items) == 0 {
+		order, err := s.uow.Order().GetByID(ctx, event.OrderID)
+		if err != nil {
+			return err
+		}
+		items = domainItemsToOrderItems(order.ReservedItems())
+	}
+
+	cmd := ReleaseItemsCmd{
+		OrderID: event.OrderID,
+		Items:   items,
+	}
+	return publishCmd(ctx, s.uow, instance, ReleaseItemsCmdName, cmd)
 }
 
-func (s *SagaImpl) HandleCourierAssigned(ctx context.Context, event CourierAssigned) error {
-	cmd := BeginDeliveryCmd(event)
-	return s.publisher.PublishBeginDeliveryCmd(ctx, cmd)
+// noteItemsReserved cuts the order down to what the warehouse reserved, and
+// returns the action that stores the order and its events. When everything was
+// reserved there is nothing to store.
+func (s *SagaImpl) noteItemsReserved(
+	ctx context.Context,
+	order *orderDomain.Order,
+	items []OrderItem,
+) (func(ctx context.Context, tx uow.UoW) error, error) {
+	noop := func(context.Context, uow.UoW) error { return nil }
+	if len(items) == 0 {
+		return noop, nil
+	}
+
+	short, err := order.NoteItemsReserved(orderItemsToCounts(items), time.Now())
+	if err != nil || !short {
+		return noop, err
+	}
+	if err = s.repriceDiscount(ctx, order); err != nil {
+		return nil, err
+	}
+
+	messages, err := eventMessages(order)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, tx uow.UoW) error {
+		if err := tx.Order().Update(ctx, order); err != nil {
+			return err
+		}
+		return publishEvents(ctx, tx, messages)
+	}, nil
+}
+
+// repriceDiscount runs the rules of the promotion behind the discount of a
+// cut down order again. The discount is dropped when the order no longer
+// qualifies for it, e.g. when it fell below the promotion minimum.
+func (s *SagaImpl) repriceDiscount(ctx context.Context, order *orderDomain.Order) error {
+	if order.Discount == nil {
+		return nil
+	}
+
+	promotion, err := s.uow.Promotion().GetByCode(ctx, order.Discount.Code)
+	if err != nil {
+		return err
+	}
+
+	amount, err := promotion.Reprice(order)
+	switch {
+	case err == nil:
+		return order.ReviseDiscount(&amount)
+	case errors.Is(err, promotionDomain.ErrMinOrderValueNotMet),
+		errors.Is(err, promotionDomain.ErrPromotionNotApplicable):
+		return order.ReviseDiscount(nil)
+	default:
+		return err
+	}
+}
+
+func (s *SagaImpl) cancelTimeout(ctx context.Context, tx uow.UoW, instance *sagaDomain.Saga) error {
+	cmd := CancelTimeoutCmd{OrderID: instance.OrderID}
+	return publishCmd(ctx, tx, instance, CancelTimeoutCmdName, cmd)
+}
+
+func (s *SagaImpl) load(ctx context.Context, orderID uuid.UUID) (*sagaDomain.Saga, error) {
+	return s.uow.Saga().GetByOrderID(ctx, sagaDomain.CreateOrder, orderID)
+}
+
+// advance moves the saga instance to the given step and runs the step action in
+// the same transaction, so the new step and the commands it emits are committed
+// together. When several handlers race for the same instance only the one that
+// wins the version check commits. A failed action is recorded on the instance.
+func (s *SagaImpl) advance(
+	ctx context.Context,
+	instance *sagaDomain.Saga,
+	step sagaDomain.Step,
+	action func(ctx context.Context, tx uow.UoW) error,
+) error {
+	// A saga superseded by a customer cancellation ignores its late replies.
+	if instance.Step == sagaDomain.SupersededByCancel {
+		return nil
+	}
+
+	if err := instance.NoteStep(step); err != nil {
+		return err
+	}
+
+	var actionErr error
+	err := s.uow.Transaction(ctx, func(ctx context.Context, tx uow.UoW) error {
+		if err := tx.Saga().Update(ctx, instance); err != nil {
+			return err
+		}
+		actionErr = action(ctx, tx)
+		return actionErr
+	})
+	if actionErr != nil {
+		s.noteFailure(ctx, instance.OrderID, actionErr)
+	}
+
+	return err
+}
+
+// suspend moves the saga instance to a step in which it waits until resumeAt,
+// and runs the action in the same transaction.
+func (s *SagaImpl) suspend(
+	ctx context.Context,
+	instance *sagaDomain.Saga,
+	step sagaDomain.Step,
+	resumeAt time.Time,
+	action func(ctx context.Context, tx uow.UoW) error,
+) error {
+	if instance.Step == sagaDomain.SupersededByCancel {
+		return nil
+	}
+
+	if err := instance.Suspend(step, resumeAt); err != nil {
+		return err
+	}
+	return s.uow.Transaction(ctx, func(ctx context.Context, tx uow.UoW) error {
+		if err := tx.Saga().Update(ctx, instance); err != nil {
+			return err
+		}
+		return action(ctx, tx)
+	})
+}
+
+// noteFailure records the failure on the persisted instance, since the step
+// change made in the aborted transaction never reached the store. When the
+// saga ran in a transaction of its caller, as it does for the results the
+// saga consumer handles, the note is rolled back with it and the caller
+// reports the failure instead.
+func (s *SagaImpl) noteFailure(ctx context.Context, orderID uuid.UUID, err error) {
+	instance, loadErr := s.load(ctx, orderID)
+	if loadErr != nil {
+		return
+	}
+
+	instance.NoteFailure(err)
+	_ = s.uow.Saga().Update(ctx, instance)
 }
 
 var _ Saga = (*SagaImpl)(nil)
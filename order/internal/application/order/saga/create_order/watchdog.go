@@ -0,0 +1,11 @@
+package create_order
+
+import (
+	"context"
+	"time"
+)
+
+type Watchdog interface {
+	CompensateStalled(ctx context.Context, deadline, lease time.Duration) (int, error)
+	ResumeSuspended(ctx context.Context, lease time.Duration) (int, error)
+}
@@ -0,0 +1,92 @@
+package create_order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/domain/uow"
+	"time"
+)
+
+type WatchdogImpl struct {
+	saga Saga
+	uow  uow.UoW
+}
+
+func NewWatchdog(saga Saga, uow uow.UoW) Watchdog {
+	return &WatchdogImpl{
+		saga: saga,
+		uow:  uow,
+	}
+}
+
+// CompensateStalled claims every saga whose current step has been awaiting a reply
+// for longer than the deadline and drives its compensation. Claims are leased, so
+// several replicas can run it concurrently, and a saga whose compensation failed
+// is retried once its lease expires. It returns the number of compensated sagas.
+func (w *WatchdogImpl) CompensateStalled(ctx context.Context, deadline, lease time.Duration) (int, error) {
+	steps := sagaDomain.AwaitingSteps(sagaDomain.CreateOrder)
+
+	var (
+		compensated int
+		errs        []error
+	)
+	for ctx.Err() == nil {
+		now := time.Now()
+		instance, err := w.uow.Saga().ClaimStalled(
+			ctx,
+			sagaDomain.CreateOrder,
+			steps,
+			now.Add(-deadline),
+			now.Add(lease),
+		)
+		if err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if instance == nil {
+			break
+		}
+
+		event := DeadlineExceeded{OrderID: instance.OrderID}
+		if err = w.saga.HandleDeadlineExceeded(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("saga %s not compensated: %w", instance.ID, err))
+			continue
+		}
+		compensated++
+	}
+
+	return compensated, errors.Join(errs...)
+}
+
+// ResumeSuspended claims every saga whose resume time has come and resumes it.
+// Claims are leased as in CompensateStalled. It returns the number of resumed sagas.
+func (w *WatchdogImpl) ResumeSuspended(ctx context.Context, lease time.Duration) (int, error) {
+	var (
+		resumed int
+		errs    []error
+	)
+	for ctx.Err() == nil {
+		now := time.Now()
+		instance, err := w.uow.Saga().ClaimSuspended(ctx, sagaDomain.CreateOrder, now, now.Add(lease))
+		if err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if instance == nil {
+			break
+		}
+
+		event := DeliverySlotApproaching{OrderID: instance.OrderID}
+		if err = w.saga.HandleDeliverySlotApproaching(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("saga %s not resumed: %w", instance.ID, err))
+			continue
+		}
+		resumed++
+	}
+
+	return resumed, errors.Join(errs...)
+}
+
+var _ Watchdog = (*WatchdogImpl)(nil)
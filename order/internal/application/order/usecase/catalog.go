@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+	orderDomain "order/internal/domain/order"
+
+	"github.com/google/uuid"
+)
+
+// CatalogProduct is the warehouse's current name and price for a product.
+type CatalogProduct struct {
+	ID    uuid.UUID
+	Name  string
+	Price orderDomain.Money
+}
+
+// Catalog looks up authoritative product prices. Products the catalog does
+// not know are missing from the returned map.
+type Catalog interface {
+	GetProducts(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]CatalogProduct, error)
+}
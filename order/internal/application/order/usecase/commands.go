@@ -0,0 +1,12 @@
+package usecase
+
+import "github.com/google/uuid"
+
+// ReassignCourierCmdName tells the courier service that an admin handed the
+// order over to another courier.
+const ReassignCourierCmdName = "order.reassign_courier"
+
+type ReassignCourierCmd struct {
+	OrderID   uuid.UUID
+	CourierID uuid.UUID
+}
@@ -8,11 +8,44 @@ import (
 
 type CreateDto struct {
 	CustomerID uuid.UUID
-	Address    string
+	Address    orderDomain.Address
 	Items      []orderDomain.Item
+	// PromoCode is optional; empty means no promotion.
+	PromoCode string
+	// Slot is optional; nil means delivery as soon as possible.
+	Slot *orderDomain.DeliverySlot
+	// Fulfillment is optional; empty means all items or none.
+	Fulfillment orderDomain.FulfillmentPolicy
+}
+
+// CourierHistoryDto is a page of a courier's orders together with per-status
+// totals over the same creation time range.
+type CourierHistoryDto struct {
+	Page   *orderDomain.Page
+	Counts orderDomain.StatusCounts
+}
+
+type CancelByCustomerDto struct {
+	OrderID    uuid.UUID
+	CustomerID uuid.UUID
+	Reason     string
+}
+
+type ForceCancelDto struct {
+	OrderID uuid.UUID
+	Reason  string
+}
+
+type ReassignCourierDto struct {
+	OrderID   uuid.UUID
+	CourierID uuid.UUID
+	// Reason is optional.
+	Reason string
 }
 
 type BeginDeliveryDto struct {
 	OrderID   uuid.UUID
 	CourierID uuid.UUID
+	// MessageID is the saga command that assigned the courier.
+	MessageID uuid.UUID
 }
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	orderDomain "order/internal/domain/order"
+	outboxDomain "order/internal/domain/outbox"
+	"order/internal/domain/uow"
+)
+
+// eventMessages takes the events the order raised and turns them into outbox
+// messages keyed by the order ID, so that the events of one order keep their
+// relative order. It runs before the transaction because a retried transaction
+// would find the events already taken.
+func eventMessages(order *orderDomain.Order) ([]*outboxDomain.Message, error) {
+	events := order.PullEvents()
+	messages := make([]*outboxDomain.Message, 0, len(events))
+	for _, event := range events {
+		message, err := outboxDomain.CreateFromEvent(event, order.ID.String())
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+// publishEvents stores the messages in the outbox of the given transaction.
+func publishEvents(ctx context.Context, tx uow.UoW, messages []*outboxDomain.Message) error {
+	for _, message := range messages {
+		if err := tx.Outbox().Create(ctx, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -9,11 +9,19 @@ import (
 
 type UseCase interface {
 	Create(ctx context.Context, data CreateDto) (uuid.UUID, error)
-	CancelByCustomer(ctx context.Context, orderID uuid.UUID) error
-	CancelOutOfStock(ctx context.Context, orderID uuid.UUID) error
-	CancelCourierNotFound(ctx context.Context, orderID uuid.UUID) error
+	CancelByCustomer(ctx context.Context, data CancelByCustomerDto) error
+	ForceCancel(ctx context.Context, data ForceCancelDto) error
+	CompleteCancellation(ctx context.Context, orderID uuid.UUID, messageID uuid.UUID) error
+	CancelOutOfStock(ctx context.Context, orderID uuid.UUID, messageID uuid.UUID) error
+	CancelCourierNotFound(ctx context.Context, orderID uuid.UUID, messageID uuid.UUID) error
+	CancelTimeout(ctx context.Context, orderID uuid.UUID, messageID uuid.UUID) error
 	BeginDelivery(ctx context.Context, data BeginDeliveryDto) error
-	CompleteDelivery(ctx context.Context, orderID uuid.UUID) error
-	GetAllByCustomer(ctx context.Context, customerID uuid.UUID) ([]*orderDomain.Order, error)
+	CompleteDelivery(ctx context.Context, orderID uuid.UUID, courierID uuid.UUID) error
+	ReassignCourier(ctx context.Context, data ReassignCourierDto) error
+	GetByID(ctx context.Context, orderID uuid.UUID, requester orderDomain.Actor) (*orderDomain.Order, error)
+	GetAllByCustomer(ctx context.Context, customerID uuid.UUID, query orderDomain.ListQuery) (*orderDomain.Page, error)
 	GetCurrentByCourier(ctx context.Context, courierID uuid.UUID) ([]*orderDomain.Order, error)
+	GetHistoryByCourier(ctx context.Context, courierID uuid.UUID, query orderDomain.ListQuery) (*CourierHistoryDto, error)
+	Search(ctx context.Context, filter orderDomain.SearchFilter, query orderDomain.ListQuery) (*orderDomain.Page, error)
+	GetStatusHistory(ctx context.Context, orderID uuid.UUID, requester orderDomain.Actor) ([]orderDomain.StatusChange, error)
 }
@@ -2,124 +2,481 @@ package usecase
 
 import (
 	"context"
+	cancelOrderSaga "order/internal/application/order/saga/cancel_order"
 	createOrderSaga "order/internal/application/order/saga/create_order"
+	slotUsecase "order/internal/application/slot/usecase"
 	orderDomain "order/internal/domain/order"
+	outboxDomain "order/internal/domain/outbox"
+	promotionDomain "order/internal/domain/promotion"
+	slotDomain "order/internal/domain/slot"
+	"order/internal/domain/uow"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type UseCaseImpl struct {
-	repo                   orderDomain.Repository
+	uow                    uow.UoW
 	createOrderSagaManager createOrderSaga.Manager
+	cancelOrderSagaManager cancelOrderSaga.Manager
+	cancellationPolicy     orderDomain.CancellationPolicy
+	schedule               slotDomain.Schedule
+	catalog                Catalog
 }
 
-func New(repo orderDomain.Repository, createOrderSagaManager createOrderSaga.Manager) UseCase {
+func New(
+	uow uow.UoW,
+	createOrderSagaManager createOrderSaga.Manager,
+	cancelOrderSagaManager cancelOrderSaga.Manager,
+	policyCfg *cancelOrderSaga.PolicyConfig,
+	scheduleCfg *slotUsecase.ScheduleConfig,
+	catalog Catalog,
+) UseCase {
 	return &UseCaseImpl{
-		repo:                   repo,
+		uow:                    uow,
 		createOrderSagaManager: createOrderSagaManager,
+		cancelOrderSagaManager: cancelOrderSagaManager,
+		cancellationPolicy:     policyCfg.Policy(),
+		schedule:               scheduleCfg.Schedule(),
+		catalog:                catalog,
 	}
 }
 
+// Create places the order at catalog prices. Every item must name a known
+// product and carry its current price; the stored items take the catalog name
+// and price as a snapshot. A promo code, if given, is redeemed and a delivery
+// slot, if given, is reserved in the same transaction that stores the order.
 func (u *UseCaseImpl) Create(ctx context.Context, data CreateDto) (uuid.UUID, error) {
-	order, err := orderDomain.Create(data.CustomerID, data.Address, data.Items)
+	items, err := u.priceItems(ctx, data.Items)
 	if err != nil {
 		return uuid.Nil, err
 	}
 
-	if err = u.repo.Create(ctx, order); err != nil {
+	order, err := orderDomain.Create(data.CustomerID, data.Address, items)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if data.Fulfillment != "" {
+		if err = order.ChooseFulfillment(data.Fulfillment); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	promotion, err := u.applyPromotion(ctx, order, data.PromoCode)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err = u.scheduleDelivery(order, data.Slot); err != nil {
+		return uuid.Nil, err
+	}
+
+	messages, err := eventMessages(order)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	err = u.uow.Transaction(ctx, func(ctx context.Context, tx uow.UoW) error {
+		if promotion != nil {
+			if err := tx.Promotion().Redeem(ctx, promotion, order.CustomerID); err != nil {
+				return err
+			}
+		}
+		if slot := order.Delivery.Slot; slot != nil {
+			if err := tx.Slot().Reserve(ctx, *slot, u.schedule.CapacityOf(*slot)); err != nil {
+				return err
+			}
+		}
+		if err := tx.Order().Create(ctx, order); err != nil {
+			return err
+		}
+		if err := publishEvents(ctx, tx, messages); err != nil {
+			return err
+		}
+		return u.createOrderSagaManager.Create(ctx, tx, order)
+	})
+	if err != nil {
 		return uuid.Nil, err
 	}
-	u.createOrderSagaManager.Create(ctx, order)
 
 	return order.ID, nil
 }
 
-func (u *UseCaseImpl) CancelByCustomer(ctx context.Context, orderID uuid.UUID) error {
-	order, err := u.repo.GetByID(ctx, orderID)
+func (u *UseCaseImpl) priceItems(ctx context.Context, items []orderDomain.Item) ([]orderDomain.Item, error) {
+	if len(items) == 0 {
+		return nil, orderDomain.ErrInvalidItems
+	}
+
+	productIDs := make([]uuid.UUID, 0, len(items))
+	for _, item := range items {
+		productIDs = append(productIDs, item.ProductID)
+	}
+
+	products, err := u.catalog.GetProducts(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	priced := make([]orderDomain.Item, 0, len(items))
+	for _, item := range items {
+		product, ok := products[item.ProductID]
+		if !ok {
+			return nil, orderDomain.ErrUnknownProduct
+		}
+		if !item.Price.Equal(product.Price) {
+			return nil, orderDomain.ErrPriceMismatch
+		}
+
+		item.Name = product.Name
+		item.Price = product.Price
+		priced = append(priced, item)
+	}
+
+	return priced, nil
+}
+
+// applyPromotion discounts the order by the promotion behind the code. It
+// returns nil when no code was given.
+func (u *UseCaseImpl) applyPromotion(
+	ctx context.Context,
+	order *orderDomain.Order,
+	code string,
+) (*promotionDomain.Promotion, error) {
+	if code == "" {
+		return nil, nil
+	}
+
+	promotion, err := u.uow.Promotion().GetByCode(ctx, code)
 	if err != nil {
+		return nil, err
+	}
+
+	discount, err := promotion.Discount(order, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err = order.ApplyDiscount(promotion.Code, discount); err != nil {
+		return nil, err
+	}
+
+	return promotion, nil
+}
+
+// scheduleDelivery books the slot on the order if it is one the schedule offers
+// right now. It does nothing when no slot was given.
+func (u *UseCaseImpl) scheduleDelivery(order *orderDomain.Order, slot *orderDomain.DeliverySlot) error {
+	if slot == nil {
+		return nil
+	}
+
+	if err := order.ScheduleDelivery(*slot); err != nil {
 		return err
 	}
+	return u.schedule.Check(*slot, time.Now())
+}
 
-	if err = order.NoteCanceledByCustomer(); err != nil {
+// CancelByCustomer moves the order to Canceling and starts the saga that releases
+// its items and courier. The order is canceled once both have been released.
+func (u *UseCaseImpl) CancelByCustomer(ctx context.Context, data CancelByCustomerDto) error {
+	order, err := u.uow.Order().GetByID(ctx, data.OrderID)
+	if err != nil {
 		return err
 	}
-	if err = u.repo.Update(ctx, order); err != nil {
+
+	if err = order.RequestCancellation(data.CustomerID, data.Reason, u.cancellationPolicy, time.Now()); err != nil {
 		return err
 	}
 
-	return nil
+	return u.uow.Transaction(ctx, func(ctx context.Context, tx uow.UoW) error {
+		if err := tx.Order().Update(ctx, order); err != nil {
+			return err
+		}
+		return u.cancelOrderSagaManager.Create(ctx, tx, order)
+	})
 }
 
-func (u *UseCaseImpl) CancelOutOfStock(ctx context.Context, orderID uuid.UUID) error {
-	order, err := u.repo.GetByID(ctx, orderID)
+// ForceCancel cancels the order at an admin's request regardless of the
+// cancellation policy. Like a customer cancellation it moves the order to
+// Canceling and starts the saga that releases its items and courier.
+func (u *UseCaseImpl) ForceCancel(ctx context.Context, data ForceCancelDto) error {
+	order, err := u.uow.Order().GetByID(ctx, data.OrderID)
 	if err != nil {
 		return err
 	}
 
-	if err = order.NoteCanceledOutOfStock(); err != nil {
+	if err = order.ForceCancel(data.Reason); err != nil {
+		return err
+	}
+
+	return u.uow.Transaction(ctx, func(ctx context.Context, tx uow.UoW) error {
+		if err := tx.Order().Update(ctx, order); err != nil {
+			return err
+		}
+		return u.cancelOrderSagaManager.Create(ctx, tx, order)
+	})
+}
+
+func (u *UseCaseImpl) CompleteCancellation(ctx context.Context, orderID uuid.UUID, messageID uuid.UUID) error {
+	order, err := u.uow.Order().GetByID(ctx, orderID)
+	if err != nil {
 		return err
 	}
-	if err = u.repo.Update(ctx, order); err != nil {
+	if order.Noted(messageID) {
+		return nil
+	}
+
+	if err = order.NoteCancellationCompleted(messageID); err != nil {
 		return err
 	}
 
-	return nil
+	return u.saveCanceled(ctx, order)
 }
 
-func (u *UseCaseImpl) CancelCourierNotFound(ctx context.Context, orderID uuid.UUID) error {
-	order, err := u.repo.GetByID(ctx, orderID)
+func (u *UseCaseImpl) CancelOutOfStock(ctx context.Context, orderID uuid.UUID, messageID uuid.UUID) error {
+	order, err := u.uow.Order().GetByID(ctx, orderID)
 	if err != nil {
 		return err
 	}
+	if order.Noted(messageID) {
+		return nil
+	}
+
+	if err = order.NoteCanceledOutOfStock(messageID); err != nil {
+		return err
+	}
 
-	if err = order.NoteCanceledCourierNotFound(); err != nil {
+	return u.saveCanceled(ctx, order)
+}
+
+func (u *UseCaseImpl) CancelCourierNotFound(ctx context.Context, orderID uuid.UUID, messageID uuid.UUID) error {
+	order, err := u.uow.Order().GetByID(ctx, orderID)
+	if err != nil {
 		return err
 	}
-	if err = u.repo.Update(ctx, order); err != nil {
+	if order.Noted(messageID) {
+		return nil
+	}
+
+	if err = order.NoteCanceledCourierNotFound(messageID); err != nil {
 		return err
 	}
 
-	return nil
+	return u.saveCanceled(ctx, order)
+}
+
+func (u *UseCaseImpl) CancelTimeout(ctx context.Context, orderID uuid.UUID, messageID uuid.UUID) error {
+	order, err := u.uow.Order().GetByID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if order.Noted(messageID) {
+		return nil
+	}
+
+	if err = order.NoteCanceledTimeout(messageID); err != nil {
+		return err
+	}
+
+	return u.saveCanceled(ctx, order)
+}
+
+// saveCanceled stores a canceled order with its events and gives back its
+// delivery slot and the use of its promo code.
+func (u *UseCaseImpl) saveCanceled(ctx context.Context, order *orderDomain.Order) error {
+	messages, err := eventMessages(order)
+	if err != nil {
+		return err
+	}
+
+	return u.uow.Transaction(ctx, func(ctx context.Context, tx uow.UoW) error {
+		if err := tx.Order().Update(ctx, order); err != nil {
+			return err
+		}
+		if err := publishEvents(ctx, tx, messages); err != nil {
+			return err
+		}
+		if order.Discount != nil {
+			promotion, err := tx.Promotion().GetByCode(ctx, order.Discount.Code)
+			if err != nil {
+				return err
+			}
+			if err := tx.Promotion().Release(ctx, promotion, order.CustomerID); err != nil {
+				return err
+			}
+		}
+		if order.Delivery.Slot == nil {
+			return nil
+		}
+		return tx.Slot().Release(ctx, *order.Delivery.Slot)
+	})
+}
+
+// saveWithEvents stores the order and the events it raised.
+func (u *UseCaseImpl) saveWithEvents(ctx context.Context, order *orderDomain.Order) error {
+	messages, err := eventMessages(order)
+	if err != nil {
+		return err
+	}
+
+	return u.uow.Transaction(ctx, func(ctx context.Context, tx uow.UoW) error {
+		if err := tx.Order().Update(ctx, order); err != nil {
+			return err
+		}
+		return publishEvents(ctx, tx, messages)
+	})
 }
 
 func (u *UseCaseImpl) BeginDelivery(ctx context.Context, data BeginDeliveryDto) error {
-	order, err := u.repo.GetByID(ctx, data.OrderID)
+	order, err := u.uow.Order().GetByID(ctx, data.OrderID)
 	if err != nil {
 		return err
 	}
+	if order.Noted(data.MessageID) {
+		return nil
+	}
+
+	if err = order.NoteDelivering(data.CourierID, data.MessageID); err != nil {
+		return err
+	}
+
+	return u.saveWithEvents(ctx, order)
+}
 
-	if err = order.NoteDelivering(data.CourierID); err != nil {
+func (u *UseCaseImpl) CompleteDelivery(ctx context.Context, orderID uuid.UUID, courierID uuid.UUID) error {
+	order, err := u.uow.Order().GetByID(ctx, orderID)
+	if err != nil {
 		return err
 	}
-	if err = u.repo.Update(ctx, order); err != nil {
+
+	if err = order.NoteDelivered(courierID); err != nil {
 		return err
 	}
 
-	return nil
+	return u.saveWithEvents(ctx, order)
 }
 
-func (u *UseCaseImpl) CompleteDelivery(ctx context.Context, orderID uuid.UUID) error {
-	order, err := u.repo.GetByID(ctx, orderID)
+// ReassignCourier hands a delivering order over to another courier at an
+// admin's request and tells the courier service in the same transaction.
+func (u *UseCaseImpl) ReassignCourier(ctx context.Context, data ReassignCourierDto) error {
+	order, err := u.uow.Order().GetByID(ctx, data.OrderID)
 	if err != nil {
 		return err
 	}
 
-	if err = order.NoteDelivered(); err != nil {
+	if err = order.ReassignCourier(data.CourierID, data.Reason); err != nil {
+		return err
+	}
+
+	messages, err := eventMessages(order)
+	if err != nil {
 		return err
 	}
-	if err = u.repo.Update(ctx, order); err != nil {
+
+	cmd := ReassignCourierCmd{OrderID: order.ID, CourierID: data.CourierID}
+	cmdMessage, err := outboxDomain.Create(ReassignCourierCmdName, order.ID.String(), cmd)
+	if err != nil {
 		return err
 	}
 
-	return nil
+	return u.uow.Transaction(ctx, func(ctx context.Context, tx uow.UoW) error {
+		if err := tx.Order().Update(ctx, order); err != nil {
+			return err
+		}
+		if err := publishEvents(ctx, tx, messages); err != nil {
+			return err
+		}
+		return tx.Outbox().Create(ctx, cmdMessage)
+	})
+}
+
+// GetByID returns the order to anyone allowed to see it.
+func (u *UseCaseImpl) GetByID(
+	ctx context.Context,
+	orderID uuid.UUID,
+	requester orderDomain.Actor,
+) (*orderDomain.Order, error) {
+	order, err := u.uow.Order().GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = order.AuthorizeView(requester); err != nil {
+		return nil, err
+	}
+
+	return order, nil
 }
 
-func (u *UseCaseImpl) GetAllByCustomer(ctx context.Context, customerID uuid.UUID) ([]*orderDomain.Order, error) {
-	return u.repo.GetAllByCustomer(ctx, customerID)
+func (u *UseCaseImpl) GetAllByCustomer(
+	ctx context.Context,
+	customerID uuid.UUID,
+	query orderDomain.ListQuery,
+) (*orderDomain.Page, error) {
+	query, err := query.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	return u.uow.Order().GetAllByCustomer(ctx, customerID, query)
 }
 
 func (u *UseCaseImpl) GetCurrentByCourier(ctx context.Context, courierID uuid.UUID) ([]*orderDomain.Order, error) {
-	return u.repo.GetCurrentByCourier(ctx, courierID)
+	return u.uow.Order().GetCurrentByCourier(ctx, courierID)
+}
+
+func (u *UseCaseImpl) GetHistoryByCourier(
+	ctx context.Context,
+	courierID uuid.UUID,
+	query orderDomain.ListQuery,
+) (*CourierHistoryDto, error) {
+	query, err := query.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.uow.Order().GetHistoryByCourier(ctx, courierID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := u.uow.Order().CountByCourier(ctx, courierID, query.CreatedFrom, query.CreatedTo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CourierHistoryDto{Page: page, Counts: counts}, nil
+}
+
+// Search lists the orders matching the filter for admins.
+func (u *UseCaseImpl) Search(
+	ctx context.Context,
+	filter orderDomain.SearchFilter,
+	query orderDomain.ListQuery,
+) (*orderDomain.Page, error) {
+	query, err := query.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	return u.uow.Order().Search(ctx, filter, query)
+}
+
+// GetStatusHistory returns the status changes of the order, oldest first, to
+// anyone allowed to see the order.
+func (u *UseCaseImpl) GetStatusHistory(
+	ctx context.Context,
+	orderID uuid.UUID,
+	requester orderDomain.Actor,
+) ([]orderDomain.StatusChange, error) {
+	order, err := u.uow.Order().GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = order.AuthorizeView(requester); err != nil {
+		return nil, err
+	}
+
+	return order.History, nil
 }
 
 var _ UseCase = (*UseCaseImpl)(nil)
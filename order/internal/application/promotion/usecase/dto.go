@@ -0,0 +1,8 @@
+package usecase
+
+import promotionDomain "order/internal/domain/promotion"
+
+type CreateDto struct {
+	Code  string
+	Rules promotionDomain.Rules
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+	promotionDomain "order/internal/domain/promotion"
+
+	"github.com/google/uuid"
+)
+
+type UseCase interface {
+	Create(ctx context.Context, data CreateDto) (uuid.UUID, error)
+	GetAll(ctx context.Context, activeOnly bool) ([]*promotionDomain.Promotion, error)
+	Deactivate(ctx context.Context, promotionID uuid.UUID) error
+}
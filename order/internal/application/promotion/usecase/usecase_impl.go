@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	promotionDomain "order/internal/domain/promotion"
+
+	"github.com/google/uuid"
+)
+
+type UseCaseImpl struct {
+	repo promotionDomain.Repository
+}
+
+func New(repo promotionDomain.Repository) UseCase {
+	return &UseCaseImpl{repo: repo}
+}
+
+func (u *UseCaseImpl) Create(ctx context.Context, data CreateDto) (uuid.UUID, error) {
+	promotion, err := promotionDomain.Create(data.Code, data.Rules)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err = u.repo.Create(ctx, promotion); err != nil {
+		return uuid.Nil, err
+	}
+
+	return promotion.ID, nil
+}
+
+func (u *UseCaseImpl) GetAll(ctx context.Context, activeOnly bool) ([]*promotionDomain.Promotion, error) {
+	return u.repo.GetAll(ctx, activeOnly)
+}
+
+// Deactivate stops the promotion from being applied to new orders. Orders
+// already placed keep their discount.
+func (u *UseCaseImpl) Deactivate(ctx context.Context, promotionID uuid.UUID) error {
+	promotion, err := u.repo.GetByID(ctx, promotionID)
+	if err != nil {
+		return err
+	}
+
+	promotion.Deactivate()
+	return u.repo.Update(ctx, promotion)
+}
+
+var _ UseCase = (*UseCaseImpl)(nil)
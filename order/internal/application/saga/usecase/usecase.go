@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+	sagaDomain "order/internal/domain/saga"
+
+	"github.com/google/uuid"
+)
+
+type UseCase interface {
+	GetAllByOrder(ctx context.Context, orderID uuid.UUID) ([]*sagaDomain.Saga, error)
+	RetryStep(ctx context.Context, orderID uuid.UUID, sagaType sagaDomain.Type) error
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	cancelOrderSaga "order/internal/application/order/saga/cancel_order"
+	createOrderSaga "order/internal/application/order/saga/create_order"
+	sagaDomain "order/internal/domain/saga"
+
+	"github.com/google/uuid"
+)
+
+type UseCaseImpl struct {
+	repo            sagaDomain.Repository
+	createOrderSaga createOrderSaga.Saga
+	cancelOrderSaga cancelOrderSaga.Saga
+}
+
+func New(
+	repo sagaDomain.Repository,
+	createOrderSaga createOrderSaga.Saga,
+	cancelOrderSaga cancelOrderSaga.Saga,
+) UseCase {
+	return &UseCaseImpl{
+		repo:            repo,
+		createOrderSaga: createOrderSaga,
+		cancelOrderSaga: cancelOrderSaga,
+	}
+}
+
+func (u *UseCaseImpl) GetAllByOrder(ctx context.Context, orderID uuid.UUID) ([]*sagaDomain.Saga, error) {
+	return u.repo.GetAllByOrderID(ctx, orderID)
+}
+
+// RetryStep re-sends the commands of the step the order's saga of the given
+// type is stuck in.
+func (u *UseCaseImpl) RetryStep(ctx context.Context, orderID uuid.UUID, sagaType sagaDomain.Type) error {
+	switch sagaType {
+	case sagaDomain.CreateOrder:
+		return u.createOrderSaga.RetryStep(ctx, orderID)
+	case sagaDomain.CancelOrder:
+		return u.cancelOrderSaga.RetryStep(ctx, orderID)
+	default:
+		return sagaDomain.ErrUnsupportedType
+	}
+}
+
+var _ UseCase = (*UseCaseImpl)(nil)
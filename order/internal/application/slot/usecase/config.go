@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"fmt"
+	slotDomain "order/internal/domain/slot"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type ScheduleConfig struct {
+	DayStart    time.Duration `envconfig:"DELIVERY_SLOT_DAY_START" required:"true"`
+	DayEnd      time.Duration `envconfig:"DELIVERY_SLOT_DAY_END" required:"true"`
+	Length      time.Duration `envconfig:"DELIVERY_SLOT_LENGTH" required:"true"`
+	Capacity    int           `envconfig:"DELIVERY_SLOT_CAPACITY" required:"true"`
+	HorizonDays int           `envconfig:"DELIVERY_SLOT_HORIZON_DAYS" required:"true"`
+	MinLeadTime time.Duration `envconfig:"DELIVERY_SLOT_MIN_LEAD_TIME" required:"true"`
+	Timezone    Timezone      `envconfig:"DELIVERY_SLOT_TIMEZONE" default:"UTC"`
+	// CapacityByWeekday overrides Capacity on some weekdays, e.g. "saturday:5,sunday:0".
+	CapacityByWeekday WeekdayCapacity `envconfig:"DELIVERY_SLOT_CAPACITY_BY_WEEKDAY"`
+}
+
+func NewScheduleConfig() (*ScheduleConfig, error) {
+	var cfg ScheduleConfig
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, fmt.Errorf("failed to load delivery slot schedule config: %w", err)
+	}
+	if err := cfg.Schedule().Validate(); err != nil {
+		return nil, fmt.Errorf("failed to load delivery slot schedule config: %w", err)
+	}
+	return &cfg, nil
+}
+
+func (c *ScheduleConfig) Schedule() slotDomain.Schedule {
+	return slotDomain.Schedule{
+		DayStart:          c.DayStart,
+		DayEnd:            c.DayEnd,
+		Length:            c.Length,
+		Capacity:          c.Capacity,
+		CapacityByWeekday: c.CapacityByWeekday,
+		Horizon:           c.HorizonDays,
+		MinLeadTime:       c.MinLeadTime,
+		Location:          c.Timezone.Location,
+	}
+}
+
+// Timezone decodes an IANA time zone name such as "Europe/Berlin".
+type Timezone struct {
+	*time.Location
+}
+
+func (t *Timezone) Decode(value string) error {
+	location, err := time.LoadLocation(value)
+	if err != nil {
+		return err
+	}
+
+	t.Location = location
+	return nil
+}
+
+// WeekdayCapacity decodes a comma-separated list of weekday:capacity pairs.
+// Weekday names are case-insensitive.
+type WeekdayCapacity map[time.Weekday]int
+
+func (w *WeekdayCapacity) Decode(value string) error {
+	capacities := WeekdayCapacity{}
+	for _, pair := range strings.Split(value, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		name, count, ok := strings.Cut(pair, ":")
+		if !ok {
+			return fmt.Errorf("invalid weekday capacity %q", pair)
+		}
+		weekday, err := parseWeekday(name)
+		if err != nil {
+			return err
+		}
+		capacity, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil {
+			return fmt.Errorf("invalid weekday capacity %q: %w", pair, err)
+		}
+		capacities[weekday] = capacity
+	}
+
+	*w = capacities
+	return nil
+}
+
+func parseWeekday(name string) (time.Weekday, error) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(weekday.String(), strings.TrimSpace(name)) {
+			return weekday, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown weekday %q", name)
+}
@@ -0,0 +1,10 @@
+package usecase
+
+import (
+	"context"
+	slotDomain "order/internal/domain/slot"
+)
+
+type UseCase interface {
+	GetAvailable(ctx context.Context) ([]slotDomain.Availability, error)
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	slotDomain "order/internal/domain/slot"
+	"time"
+)
+
+type UseCaseImpl struct {
+	repo     slotDomain.Repository
+	schedule slotDomain.Schedule
+}
+
+func New(repo slotDomain.Repository, cfg *ScheduleConfig) UseCase {
+	return &UseCaseImpl{
+		repo:     repo,
+		schedule: cfg.Schedule(),
+	}
+}
+
+// GetAvailable lists the slots that can be booked now and still have room,
+// earliest first.
+func (u *UseCaseImpl) GetAvailable(ctx context.Context) ([]slotDomain.Availability, error) {
+	slots := u.schedule.Slots(time.Now())
+	if len(slots) == 0 {
+		return []slotDomain.Availability{}, nil
+	}
+
+	reserved, err := u.repo.GetReserved(ctx, slots[0].Start, slots[len(slots)-1].End)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make([]slotDomain.Availability, 0, len(slots))
+	for _, slot := range slots {
+		availability := slotDomain.Availability{
+			Slot:     slot,
+			Capacity: u.schedule.CapacityOf(slot),
+			Reserved: reserved[slot.Start.UTC()],
+		}
+		if availability.Remaining() > 0 {
+			available = append(available, availability)
+		}
+	}
+
+	return available, nil
+}
+
+var _ UseCase = (*UseCaseImpl)(nil)
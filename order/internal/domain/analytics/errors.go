@@ -0,0 +1,8 @@
+package analytics
+
+import "errors"
+
+var (
+	ErrInvalidPeriod      = errors.New("invalid analytics period")
+	ErrInvalidGranularity = errors.New("invalid analytics granularity")
+)
@@ -0,0 +1,67 @@
+package analytics
+
+import (
+	orderDomain "order/internal/domain/order"
+	"time"
+)
+
+// Period selects orders by creation time. The range is half-open: [From, To);
+// an unset bound leaves that side open.
+type Period struct {
+	From *time.Time
+	To   *time.Time
+}
+
+func (p Period) Validate() error {
+	if p.From != nil && p.To != nil && !p.From.Before(*p.To) {
+		return ErrInvalidPeriod
+	}
+	return nil
+}
+
+// Granularity is the length of a revenue bucket. Buckets are aligned in UTC;
+// weeks start on Monday.
+type Granularity string
+
+const (
+	Day  Granularity = "day"
+	Week Granularity = "week"
+)
+
+func (g Granularity) Validate() error {
+	switch g {
+	case Day, Week:
+		return nil
+	default:
+		return ErrInvalidGranularity
+	}
+}
+
+// StatusFunnel describes the orders created in a period. Current counts them
+// by the status they are in now; Reached counts, for every status, the orders
+// that have been in it at some point, so Reached[Delivering] includes orders
+// delivered or canceled since.
+type StatusFunnel struct {
+	Total   int
+	Current orderDomain.StatusCounts
+	Reached orderDomain.StatusCounts
+}
+
+// RevenueBucket is the revenue of the delivered orders created in one day or
+// week, in a single currency. Revenue is the sum of the order totals after
+// discounts.
+type RevenueBucket struct {
+	Start   time.Time
+	Revenue orderDomain.Money
+	Orders  int
+}
+
+// DeliveryTime is the average time from placing an order to its arrival, over
+// the delivered orders created in a period. Average is zero when Orders is.
+type DeliveryTime struct {
+	Orders  int
+	Average time.Duration
+}
+
+// CancellationReasons holds the number of canceled orders per reason.
+type CancellationReasons map[orderDomain.CancelReason]int
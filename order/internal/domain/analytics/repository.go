@@ -0,0 +1,12 @@
+package analytics
+
+import "context"
+
+type Repository interface {
+	GetStatusFunnel(ctx context.Context, period Period) (*StatusFunnel, error)
+	// GetRevenue returns one bucket per start and currency, earliest first.
+	// Buckets without delivered orders are left out.
+	GetRevenue(ctx context.Context, period Period, granularity Granularity) ([]RevenueBucket, error)
+	GetDeliveryTime(ctx context.Context, period Period) (*DeliveryTime, error)
+	GetCancellationReasons(ctx context.Context, period Period) (CancellationReasons, error)
+}
@@ -0,0 +1,17 @@
+package inbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func Create(ID uuid.UUID, Response []byte, Retention time.Duration) *Message {
+	now := time.Now()
+	return &Message{
+		ID:        ID,
+		Response:  Response,
+		Processed: now,
+		Expires:   now.Add(Retention),
+	}
+}
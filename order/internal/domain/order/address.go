@@ -0,0 +1,53 @@
+package order
+
+import "strings"
+
+// Address is where an order is delivered. Orders placed before addresses were
+// structured carry only Legacy, the free-form text the customer entered; all
+// other fields are empty for them.
+type Address struct {
+	// Country is an ISO 3166-1 alpha-2 code, e.g. "DE".
+	Country    string
+	City       string
+	Street     string
+	House      string
+	Apartment  string
+	PostalCode string
+	Location   *Location
+	// Instructions are free-form notes for the courier, e.g. a door code.
+	Instructions string
+	Legacy       string
+}
+
+// Location is a WGS 84 coordinate pair.
+type Location struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// IsLegacy reports whether the address predates structured addresses.
+func (a Address) IsLegacy() bool {
+	return a.Legacy != ""
+}
+
+// Normalize trims the fields, upper-cases the country and postal code and
+// validates the result. Legacy addresses are rejected: new orders must be
+// placed with a structured address.
+func (a Address) Normalize() (Address, error) {
+	if a.IsLegacy() {
+		return a, ErrInvalidAddress
+	}
+
+	a.Country = strings.ToUpper(strings.TrimSpace(a.Country))
+	a.City = strings.TrimSpace(a.City)
+	a.Street = strings.TrimSpace(a.Street)
+	a.House = strings.TrimSpace(a.House)
+	a.Apartment = strings.TrimSpace(a.Apartment)
+	a.PostalCode = strings.ToUpper(strings.TrimSpace(a.PostalCode))
+	a.Instructions = strings.TrimSpace(a.Instructions)
+
+	if err := validateAddress(a); err != nil {
+		return a, err
+	}
+	return a, nil
+}
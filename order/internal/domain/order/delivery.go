@@ -8,6 +8,15 @@ import (
 
 type Delivery struct {
 	CourierID *uuid.UUID
-	Address   string
+	Address   Address
+	Slot      *DeliverySlot
+	Assigned  *time.Time
 	Arrived   *time.Time
 }
+
+// DeliverySlot is the time window [Start, End) the customer booked for the
+// delivery. Orders without one are delivered as soon as possible.
+type DeliverySlot struct {
+	Start time.Time
+	End   time.Time
+}
@@ -0,0 +1,7 @@
+package order
+
+// Discount is the reduction a promo code earned the order when it was placed.
+type Discount struct {
+	Code   string
+	Amount Money
+}
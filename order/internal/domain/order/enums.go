@@ -2,6 +2,10 @@ package order
 
 type (
 	Status string
+
+	// FulfillmentPolicy is what the customer wants done with the order when
+	// only some of its items are in stock.
+	FulfillmentPolicy string
 )
 
 const (
@@ -11,4 +15,14 @@ const (
 	Delivering              Status = "delivering"
 	Delivered               Status = "delivered"
 	CustomerCanceled        Status = "customer_canceled"
+	CanceledTimeout         Status = "canceled_timeout"
+	Canceling               Status = "canceling"
+	CanceledByAdmin         Status = "canceled_by_admin"
+)
+
+const (
+	// AllOrNothing cancels the order unless every item is in stock.
+	AllOrNothing FulfillmentPolicy = "all_or_nothing"
+	// AllowPartial delivers what is in stock and charges only for that.
+	AllowPartial FulfillmentPolicy = "allow_partial"
 )
@@ -1,9 +1,39 @@
 package order
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrUnsupportedStatusTransition = errors.New("unsupported order status transition")
 	ErrInvalidAddress              = errors.New("invalid order address")
 	ErrInvalidItems                = errors.New("invalid order items")
+	ErrPermissionDenied            = errors.New("order permission denied")
+	ErrInvalidListQuery            = errors.New("invalid order list query")
+	ErrInvalidCancelReason         = errors.New("invalid order cancel reason")
+	ErrInvalidReason               = errors.New("invalid order status change reason")
+	ErrInvalidCourier              = errors.New("invalid order courier")
+	ErrCancellationNotAllowed      = errors.New("order cancellation not allowed")
+	ErrUnknownProduct              = errors.New("unknown order product")
+	ErrPriceMismatch               = errors.New("order item price does not match the catalog")
+	ErrInvalidMoney                = errors.New("invalid money amount")
+	ErrInvalidCurrency             = errors.New("invalid currency code")
+	ErrCurrencyMismatch            = errors.New("currency mismatch")
+	ErrInvalidDiscount             = errors.New("invalid order discount")
+	ErrInvalidDeliverySlot         = errors.New("invalid order delivery slot")
+	ErrInvalidFulfillmentPolicy    = errors.New("invalid order fulfillment policy")
+	ErrInvalidFulfillment          = errors.New("invalid order fulfillment")
+)
+
+// Address field errors match ErrInvalidAddress and name the offending field.
+var (
+	ErrInvalidAddressCountry      = fmt.Errorf("%w: country must be an ISO 3166-1 alpha-2 code", ErrInvalidAddress)
+	ErrInvalidAddressCity         = fmt.Errorf("%w: city is required and at most 100 characters", ErrInvalidAddress)
+	ErrInvalidAddressStreet       = fmt.Errorf("%w: street is required and at most 200 characters", ErrInvalidAddress)
+	ErrInvalidAddressHouse        = fmt.Errorf("%w: house is required and at most 20 characters", ErrInvalidAddress)
+	ErrInvalidAddressApartment    = fmt.Errorf("%w: apartment is at most 20 characters", ErrInvalidAddress)
+	ErrInvalidAddressPostalCode   = fmt.Errorf("%w: malformed postal code", ErrInvalidAddress)
+	ErrInvalidAddressLocation     = fmt.Errorf("%w: latitude or longitude out of range", ErrInvalidAddress)
+	ErrInvalidAddressInstructions = fmt.Errorf("%w: instructions are at most 500 characters", ErrInvalidAddress)
 )
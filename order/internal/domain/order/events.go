@@ -0,0 +1,264 @@
+package order
+
+import (
+	domain "order/internal/domain/common"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventSchemaVersion is the version of the payloads below. It is bumped on any
+// change a subscriber could notice; fields are only ever added within a version.
+const EventSchemaVersion = 1
+
+const (
+	CreatedEventName            = "order.OrderCreated"
+	PartiallyFulfilledEventName = "order.OrderPartiallyFulfilled"
+	DeliveryStartedEventName    = "order.OrderDeliveryStarted"
+	DeliveredEventName          = "order.OrderDelivered"
+	CanceledEventName           = "order.OrderCanceled"
+	CourierReassignedEventName  = "order.CourierReassigned"
+)
+
+type CancelReason string
+
+const (
+	CancelReasonCustomer        CancelReason = "customer"
+	CancelReasonOutOfStock      CancelReason = "out_of_stock"
+	CancelReasonCourierNotFound CancelReason = "courier_not_found"
+	CancelReasonTimeout         CancelReason = "timeout"
+	CancelReasonAdmin           CancelReason = "admin"
+)
+
+type CreatedEvent struct {
+	domain.EventBase[CreatedPayload]
+}
+
+func (e CreatedEvent) Name() string {
+	return CreatedEventName
+}
+
+// CreatedPayload describes a placed order. Prices are the catalog snapshot;
+// discounts are applied after the order is created and are not included.
+type CreatedPayload struct {
+	SchemaVersion int
+	OrderID       uuid.UUID
+	CustomerID    uuid.UUID
+	Items         []EventItem
+	Occurred      time.Time
+}
+
+type EventItem struct {
+	ProductID uuid.UUID
+	Count     int
+	Price     Money
+}
+
+type PartiallyFulfilledEvent struct {
+	domain.EventBase[PartiallyFulfilledPayload]
+}
+
+func (e PartiallyFulfilledEvent) Name() string {
+	return PartiallyFulfilledEventName
+}
+
+// PartiallyFulfilledPayload describes an order that goes ahead with only the
+// items that were in stock. Items lists every line, including those of which
+// nothing was in stock.
+type PartiallyFulfilledPayload struct {
+	SchemaVersion int
+	OrderID       uuid.UUID
+	CustomerID    uuid.UUID
+	Items         []FulfilledItem
+	Occurred      time.Time
+}
+
+type FulfilledItem struct {
+	ProductID uuid.UUID
+	Requested int
+	Count     int
+}
+
+type DeliveryStartedEvent struct {
+	domain.EventBase[DeliveryStartedPayload]
+}
+
+func (e DeliveryStartedEvent) Name() string {
+	return DeliveryStartedEventName
+}
+
+type DeliveryStartedPayload struct {
+	SchemaVersion int
+	OrderID       uuid.UUID
+	CustomerID    uuid.UUID
+	CourierID     uuid.UUID
+	Occurred      time.Time
+}
+
+type DeliveredEvent struct {
+	domain.EventBase[DeliveredPayload]
+}
+
+func (e DeliveredEvent) Name() string {
+	return DeliveredEventName
+}
+
+type DeliveredPayload struct {
+	SchemaVersion int
+	OrderID       uuid.UUID
+	CustomerID    uuid.UUID
+	CourierID     uuid.UUID
+	Occurred      time.Time
+}
+
+type CourierReassignedEvent struct {
+	domain.EventBase[CourierReassignedPayload]
+}
+
+func (e CourierReassignedEvent) Name() string {
+	return CourierReassignedEventName
+}
+
+// CourierReassignedPayload describes a delivering order handed over to another
+// courier by an admin.
+type CourierReassignedPayload struct {
+	SchemaVersion     int
+	OrderID           uuid.UUID
+	CustomerID        uuid.UUID
+	PreviousCourierID *uuid.UUID
+	CourierID         uuid.UUID
+	Reason            string
+	Occurred          time.Time
+}
+
+type CanceledEvent struct {
+	domain.EventBase[CanceledPayload]
+}
+
+func (e CanceledEvent) Name() string {
+	return CanceledEventName
+}
+
+// CanceledPayload describes an order that reached a canceled status. Comment is
+// the free-form reason the customer or the admin gave, if any.
+type CanceledPayload struct {
+	SchemaVersion int
+	OrderID       uuid.UUID
+	CustomerID    uuid.UUID
+	Status        Status
+	Reason        CancelReason
+	Comment       string
+	Occurred      time.Time
+}
+
+// PullEvents returns the events raised since the last call and forgets them.
+func (o *Order) PullEvents() []domain.Event {
+	events := o.events
+	o.events = nil
+	return events
+}
+
+func (o *Order) raise(event domain.Event) {
+	o.events = append(o.events, event)
+}
+
+// raiseStatusEvent raises the event subscribers expect for the status change,
+// if there is one.
+func (o *Order) raiseStatusEvent(change StatusChange) {
+	switch change.To {
+	case Delivering:
+		o.raise(domain.NewEvent[DeliveryStartedPayload, DeliveryStartedEvent](DeliveryStartedPayload{
+			SchemaVersion: EventSchemaVersion,
+			OrderID:       o.ID,
+			CustomerID:    o.CustomerID,
+			CourierID:     *o.Delivery.CourierID,
+			Occurred:      change.Occurred,
+		}))
+
+	case Delivered:
+		o.raise(domain.NewEvent[DeliveredPayload, DeliveredEvent](DeliveredPayload{
+			SchemaVersion: EventSchemaVersion,
+			OrderID:       o.ID,
+			CustomerID:    o.CustomerID,
+			CourierID:     *o.Delivery.CourierID,
+			Occurred:      change.Occurred,
+		}))
+
+	case CustomerCanceled, CanceledOutOfStock, CanceledCourierNotFound, CanceledTimeout, CanceledByAdmin:
+		o.raise(domain.NewEvent[CanceledPayload, CanceledEvent](CanceledPayload{
+			SchemaVersion: EventSchemaVersion,
+			OrderID:       o.ID,
+			CustomerID:    o.CustomerID,
+			Status:        change.To,
+			Reason:        cancelReasons[change.To],
+			Comment:       o.CancelReason,
+			Occurred:      change.Occurred,
+		}))
+	}
+}
+
+var cancelReasons = map[Status]CancelReason{
+	CustomerCanceled:        CancelReasonCustomer,
+	CanceledOutOfStock:      CancelReasonOutOfStock,
+	CanceledCourierNotFound: CancelReasonCourierNotFound,
+	CanceledTimeout:         CancelReasonTimeout,
+	CanceledByAdmin:         CancelReasonAdmin,
+}
+
+// CancelReasonOf returns why an order in the status was canceled; ok is false
+// for statuses that are not canceled ones.
+func CancelReasonOf(status Status) (reason CancelReason, ok bool) {
+	reason, ok = cancelReasons[status]
+	return reason, ok
+}
+
+func newCreatedEvent(order *Order) CreatedEvent {
+	items := make([]EventItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, EventItem{ProductID: item.ProductID, Count: item.Count, Price: item.Price})
+	}
+
+	return domain.NewEvent[CreatedPayload, CreatedEvent](CreatedPayload{
+		SchemaVersion: EventSchemaVersion,
+		OrderID:       order.ID,
+		CustomerID:    order.CustomerID,
+		Items:         items,
+		Occurred:      order.Created,
+	})
+}
+
+func newPartiallyFulfilledEvent(order *Order, occurred time.Time) PartiallyFulfilledEvent {
+	items := make([]FulfilledItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, FulfilledItem{ProductID: item.ProductID, Requested: item.Requested, Count: item.Count})
+	}
+
+	return domain.NewEvent[PartiallyFulfilledPayload, PartiallyFulfilledEvent](PartiallyFulfilledPayload{
+		SchemaVersion: EventSchemaVersion,
+		OrderID:       order.ID,
+		CustomerID:    order.CustomerID,
+		Items:         items,
+		Occurred:      occurred,
+	})
+}
+
+func newCourierReassignedEvent(order *Order, previous *uuid.UUID, change StatusChange) CourierReassignedEvent {
+	return domain.NewEvent[CourierReassignedPayload, CourierReassignedEvent](CourierReassignedPayload{
+		SchemaVersion:     EventSchemaVersion,
+		OrderID:           order.ID,
+		CustomerID:        order.CustomerID,
+		PreviousCourierID: previous,
+		CourierID:         *order.Delivery.CourierID,
+		Reason:            change.Reason,
+		Occurred:          change.Occurred,
+	})
+}
+
+var (
+	_ domain.Event = (*CreatedEvent)(nil)
+	_ domain.Event = (*PartiallyFulfilledEvent)(nil)
+	_ domain.Event = (*DeliveryStartedEvent)(nil)
+	_ domain.Event = (*DeliveredEvent)(nil)
+	_ domain.Event = (*CanceledEvent)(nil)
+	_ domain.Event = (*CourierReassignedEvent)(nil)
+)
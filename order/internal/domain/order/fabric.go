@@ -6,25 +6,42 @@ import (
 	"github.com/google/uuid"
 )
 
-func Create(CustomerID uuid.UUID, Address string, Items []Item) (*Order, error) {
-	if !validateAddress(Address) {
-		return nil, ErrInvalidAddress
+func Create(CustomerID uuid.UUID, Address Address, Items []Item) (*Order, error) {
+	address, err := Address.Normalize()
+	if err != nil {
+		return nil, err
 	}
 	if !validateItems(Items) {
 		return nil, ErrInvalidItems
 	}
+	if !validateItemsCurrency(Items) {
+		return nil, ErrCurrencyMismatch
+	}
+
+	items := make([]Item, len(Items))
+	for i, item := range Items {
+		item.Requested = item.Count
+		items[i] = item
+	}
 
-	return &Order{
+	now := time.Now()
+	order := &Order{
 		ID:         uuid.New(),
 		CustomerID: CustomerID,
 		Status:     Created,
-		Created:    time.Now(),
+		Created:    now,
 		Version:    uuid.New(),
 		Delivery: Delivery{
 			CourierID: nil,
-			Address:   Address,
+			Address:   address,
 			Arrived:   nil,
 		},
-		Items: Items,
-	}, nil
+		Items:       items,
+		Fulfillment: AllOrNothing,
+		History: []StatusChange{
+			{To: Created, Occurred: now, Actor: CustomerActor(CustomerID)},
+		},
+	}
+	order.raise(newCreatedEvent(order))
+	return order, nil
 }
@@ -0,0 +1,110 @@
+package order
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ActorType string
+
+const (
+	ActorSystem   ActorType = "system"
+	ActorCustomer ActorType = "customer"
+	ActorCourier  ActorType = "courier"
+	ActorAdmin    ActorType = "admin"
+)
+
+// Actor is who caused a status change or asks to see an order. ID is nil for
+// the system and for admins.
+type Actor struct {
+	Type ActorType
+	ID   *uuid.UUID
+}
+
+func SystemActor() Actor {
+	return Actor{Type: ActorSystem}
+}
+
+func CustomerActor(ID uuid.UUID) Actor {
+	return Actor{Type: ActorCustomer, ID: &ID}
+}
+
+func CourierActor(ID uuid.UUID) Actor {
+	return Actor{Type: ActorCourier, ID: &ID}
+}
+
+func AdminActor() Actor {
+	return Actor{Type: ActorAdmin}
+}
+
+// StatusChange is one entry of the order status history. From is empty for the
+// entry recording the creation of the order. MessageID names the saga command
+// that caused the change, if any. CourierID is the courier a change to
+// Delivering handed the order to, so the history keeps every courier the order
+// had.
+type StatusChange struct {
+	From      Status
+	To        Status
+	Occurred  time.Time
+	Actor     Actor
+	Reason    string
+	MessageID *uuid.UUID
+	CourierID *uuid.UUID
+}
+
+// Noted reports whether the order already changed status on the saga command
+// with the given message ID, so that a redelivered command is not applied twice.
+func (o *Order) Noted(MessageID uuid.UUID) bool {
+	return slices.ContainsFunc(o.History, func(change StatusChange) bool {
+		return change.MessageID != nil && *change.MessageID == MessageID
+	})
+}
+
+// AuthorizeView checks that the actor may see the order: its customer, the
+// courier assigned to it and admins may.
+func (o *Order) AuthorizeView(Actor Actor) error {
+	switch Actor.Type {
+	case ActorAdmin:
+		return nil
+
+	case ActorCustomer:
+		if Actor.ID != nil && *Actor.ID == o.CustomerID {
+			return nil
+		}
+
+	case ActorCourier:
+		if Actor.ID != nil && o.Delivery.CourierID != nil && *Actor.ID == *o.Delivery.CourierID {
+			return nil
+		}
+	}
+
+	return ErrPermissionDenied
+}
+
+// transition moves the order to the status, records the change and raises the
+// matching event.
+func (o *Order) transition(To Status, Actor Actor, Reason string, MessageID *uuid.UUID) {
+	change := o.record(To, Actor, Reason, MessageID)
+	o.raiseStatusEvent(change)
+}
+
+// record moves the order to the status and appends the change to the history.
+func (o *Order) record(To Status, Actor Actor, Reason string, MessageID *uuid.UUID) StatusChange {
+	change := StatusChange{
+		From:      o.Status,
+		To:        To,
+		Occurred:  time.Now(),
+		Actor:     Actor,
+		Reason:    Reason,
+		MessageID: MessageID,
+	}
+	if To == Delivering && o.Delivery.CourierID != nil {
+		courierID := *o.Delivery.CourierID
+		change.CourierID = &courierID
+	}
+	o.History = append(o.History, change)
+	o.Status = To
+	return change
+}
@@ -1,12 +1,20 @@
 package order
 
-import (
-	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
-)
+import "github.com/google/uuid"
 
+// Item is a line of the order. Name and Price are a snapshot of the catalog
+// taken when the order was placed. Count is what the order delivers and
+// charges for, and Requested is what the customer asked for; Count is lower
+// only when the order was partially fulfilled.
 type Item struct {
 	ProductID uuid.UUID
-	Price     decimal.Decimal
+	Name      string
+	Price     Money
 	Count     int
+	Requested int
+}
+
+// Total is the line total: the unit price times the count.
+func (i Item) Total() Money {
+	return i.Price.Mul(i.Count)
 }
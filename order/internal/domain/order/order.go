@@ -1,25 +1,231 @@
 package order
 
 import (
+	"maps"
+	domain "order/internal/domain/common"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type Order struct {
-	ID         uuid.UUID
-	CustomerID uuid.UUID
-	Status     Status
-	Created    time.Time
-	Version    uuid.UUID
-	Delivery   Delivery
-	Items      []Item
+	ID           uuid.UUID
+	CustomerID   uuid.UUID
+	Status       Status
+	Created      time.Time
+	Version      uuid.UUID
+	Delivery     Delivery
+	Items        []Item
+	CancelReason string
+	Discount     *Discount
+	Fulfillment  FulfillmentPolicy
+	// History lists every status change, oldest first.
+	History []StatusChange
+
+	// events are raised by the methods below until PullEvents takes them.
+	events []domain.Event
+}
+
+// Subtotal is the sum of the line totals. Create guarantees that all items
+// share one currency; an order without items totals zero with no currency.
+func (o *Order) Subtotal() Money {
+	total := decimal.Zero
+	currency := ""
+	for _, item := range o.Items {
+		line := item.Total()
+		total = total.Add(line.Amount)
+		currency = line.Currency
+	}
+	return Money{Amount: total, Currency: currency}
+}
+
+// Total is the subtotal less the discount.
+func (o *Order) Total() Money {
+	subtotal := o.Subtotal()
+	if o.Discount == nil {
+		return subtotal
+	}
+	return Money{Amount: subtotal.Amount.Sub(o.Discount.Amount.Amount), Currency: subtotal.Currency}
+}
+
+// ReservedItems are the items of which anything is reserved. A partially
+// fulfilled order keeps the lines the warehouse had none of, with no count.
+func (o *Order) ReservedItems() []Item {
+	items := make([]Item, 0, len(o.Items))
+	for _, item := range o.Items {
+		if item.Count > 0 {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// ApplyDiscount takes the amount a promo code earned off a new order. The
+// discount is in the order currency and never exceeds the subtotal.
+func (o *Order) ApplyDiscount(Code string, Amount Money) error {
+	if o.Status != Created || o.Discount != nil {
+		return ErrInvalidDiscount
+	}
+
+	subtotal := o.Subtotal()
+	if Amount.Currency != subtotal.Currency {
+		return ErrCurrencyMismatch
+	}
+	if !Amount.IsPositive() || Amount.Amount.GreaterThan(subtotal.Amount) {
+		return ErrInvalidDiscount
+	}
+
+	o.Discount = &Discount{Code: Code, Amount: Amount}
+	return nil
+}
+
+// ReviseDiscount sets the amount the promo code takes off a new order whose
+// items changed. A nil amount drops the discount, as the code no longer
+// applies to the order.
+func (o *Order) ReviseDiscount(Amount *Money) error {
+	if o.Status != Created || o.Discount == nil {
+		return ErrInvalidDiscount
+	}
+	if Amount == nil {
+		o.Discount = nil
+		return nil
+	}
+
+	subtotal := o.Subtotal()
+	if Amount.Currency != subtotal.Currency {
+		return ErrCurrencyMismatch
+	}
+	if !Amount.IsPositive() || Amount.Amount.GreaterThan(subtotal.Amount) {
+		return ErrInvalidDiscount
+	}
+
+	o.Discount.Amount = *Amount
+	return nil
+}
+
+// ScheduleDelivery books a delivery slot for a new order.
+func (o *Order) ScheduleDelivery(Slot DeliverySlot) error {
+	if o.Status != Created || o.Delivery.Slot != nil {
+		return ErrInvalidDeliverySlot
+	}
+	if !Slot.End.After(Slot.Start) {
+		return ErrInvalidDeliverySlot
+	}
+
+	o.Delivery.Slot = &Slot
+	return nil
+}
+
+// ChooseFulfillment sets what happens to a new order when only some of its
+// items are in stock. Orders are fulfilled all or nothing unless told otherwise.
+func (o *Order) ChooseFulfillment(Policy FulfillmentPolicy) error {
+	if o.Status != Created || !validateFulfillmentPolicy(Policy) {
+		return ErrInvalidFulfillmentPolicy
+	}
+
+	o.Fulfillment = Policy
+	return nil
+}
+
+// NoteItemsReserved takes how much of every product the warehouse reserved
+// and reports whether any item fell short. An order that allows partial
+// fulfillment then delivers and charges only for what was reserved. Its
+// discount has to be revised for the reduced subtotal with ReviseDiscount.
+func (o *Order) NoteItemsReserved(Reserved map[uuid.UUID]int, Now time.Time) (bool, error) {
+	if o.Status != Created {
+		return false, ErrUnsupportedStatusTransition
+	}
+
+	// A product listed on several lines has its reserved count spread over
+	// them in order.
+	remaining := maps.Clone(Reserved)
+	items := slices.Clone(o.Items)
+	short, fulfilled := false, false
+	for i, item := range items {
+		left, ok := remaining[item.ProductID]
+		if !ok || left < 0 {
+			return false, ErrInvalidFulfillment
+		}
+		count := min(left, item.Requested)
+		remaining[item.ProductID] = left - count
+		short = short || count != item.Count
+		fulfilled = fulfilled || count > 0
+		items[i].Count = count
+	}
+	for _, left := range remaining {
+		if left != 0 {
+			return false, ErrInvalidFulfillment
+		}
+	}
+	if !short {
+		return false, nil
+	}
+	if o.Fulfillment != AllowPartial || !fulfilled {
+		return false, ErrInvalidFulfillment
+	}
+
+	o.Items = items
+	o.raise(newPartiallyFulfilledEvent(o, Now))
+	return true, nil
 }
 
-func (o *Order) NoteCanceledByCustomer() error {
+// RequestCancellation starts a customer cancellation. The order stays in
+// Canceling until the reserved items and the courier have been released.
+func (o *Order) RequestCancellation(CustomerID uuid.UUID, Reason string, Policy CancellationPolicy, Now time.Time) error {
+	if o.CustomerID != CustomerID {
+		return ErrPermissionDenied
+	}
+	if !validateReason(Reason) {
+		return ErrInvalidCancelReason
+	}
+
 	switch o.Status {
-	case Delivering:
-		o.Status = CustomerCanceled
+	case Created, Delivering:
+		if !Policy.Allows(o, Now) {
+			return ErrCancellationNotAllowed
+		}
+		o.transition(Canceling, CustomerActor(CustomerID), Reason, nil)
+		o.CancelReason = Reason
+		return nil
+
+	default:
+		return ErrUnsupportedStatusTransition
+	}
+}
+
+// ForceCancel starts a cancellation an admin ordered. Unlike a customer, an
+// admin is not bound by the cancellation policy but has to give a reason. The
+// order then proceeds through Canceling as a customer cancellation does.
+func (o *Order) ForceCancel(Reason string) error {
+	if strings.TrimSpace(Reason) == "" || !validateReason(Reason) {
+		return ErrInvalidCancelReason
+	}
+
+	switch o.Status {
+	case Created, Delivering:
+		o.transition(Canceling, AdminActor(), Reason, nil)
+		o.CancelReason = Reason
+		return nil
+
+	default:
+		return ErrUnsupportedStatusTransition
+	}
+}
+
+// NoteCancellationCompleted ends a cancellation once the items and the courier
+// have been released. The order is CanceledByAdmin if an admin requested the
+// cancellation and CustomerCanceled otherwise.
+func (o *Order) NoteCancellationCompleted(MessageID uuid.UUID) error {
+	switch o.Status {
+	case Canceling:
+		to := CustomerCanceled
+		if o.cancellationRequester().Type == ActorAdmin {
+			to = CanceledByAdmin
+		}
+		o.transition(to, SystemActor(), "", &MessageID)
 		return nil
 
 	default:
@@ -27,10 +233,31 @@ func (o *Order) NoteCanceledByCustomer() error {
 	}
 }
 
-func (o *Order) NoteCanceledOutOfStock() error {
+// cancellationRequester returns who moved the order to Canceling.
+func (o *Order) cancellationRequester() Actor {
+	for i := len(o.History) - 1; i >= 0; i-- {
+		if o.History[i].To == Canceling {
+			return o.History[i].Actor
+		}
+	}
+	return Actor{Type: ActorCustomer, ID: &o.CustomerID}
+}
+
+func (o *Order) NoteCanceledOutOfStock(MessageID uuid.UUID) error {
+	switch o.Status {
+	case Created:
+		o.transition(CanceledOutOfStock, SystemActor(), "", &MessageID)
+		return nil
+
+	default:
+		return ErrUnsupportedStatusTransition
+	}
+}
+
+func (o *Order) NoteCanceledCourierNotFound(MessageID uuid.UUID) error {
 	switch o.Status {
 	case Created:
-		o.Status = CanceledOutOfStock
+		o.transition(CanceledCourierNotFound, SystemActor(), "", &MessageID)
 		return nil
 
 	default:
@@ -38,10 +265,10 @@ func (o *Order) NoteCanceledOutOfStock() error {
 	}
 }
 
-func (o *Order) NoteCanceledCourierNotFound() error {
+func (o *Order) NoteCanceledTimeout(MessageID uuid.UUID) error {
 	switch o.Status {
 	case Created:
-		o.Status = CanceledCourierNotFound
+		o.transition(CanceledTimeout, SystemActor(), "", &MessageID)
 		return nil
 
 	default:
@@ -49,11 +276,13 @@ func (o *Order) NoteCanceledCourierNotFound() error {
 	}
 }
 
-func (o *Order) NoteDelivering(CourierID uuid.UUID) error {
+func (o *Order) NoteDelivering(CourierID uuid.UUID, MessageID uuid.UUID) error {
 	switch o.Status {
 	case Created:
-		o.Status = Delivering
+		now := time.Now()
 		o.Delivery.CourierID = &CourierID
+		o.Delivery.Assigned = &now
+		o.transition(Delivering, SystemActor(), "", &MessageID)
 		return nil
 
 	default:
@@ -61,12 +290,39 @@ func (o *Order) NoteDelivering(CourierID uuid.UUID) error {
 	}
 }
 
-func (o *Order) NoteDelivered() error {
+// ReassignCourier hands a delivering order over to another courier at an
+// admin's request. The handover is recorded in the history as a change from
+// Delivering to Delivering.
+func (o *Order) ReassignCourier(CourierID uuid.UUID, Reason string) error {
+	if !validateReason(Reason) {
+		return ErrInvalidReason
+	}
+	if o.Status != Delivering {
+		return ErrUnsupportedStatusTransition
+	}
+	if CourierID == uuid.Nil || (o.Delivery.CourierID != nil && *o.Delivery.CourierID == CourierID) {
+		return ErrInvalidCourier
+	}
+
+	previous := o.Delivery.CourierID
+	now := time.Now()
+	o.Delivery.CourierID = &CourierID
+	o.Delivery.Assigned = &now
+	change := o.record(Delivering, AdminActor(), Reason, nil)
+	o.raise(newCourierReassignedEvent(o, previous, change))
+	return nil
+}
+
+func (o *Order) NoteDelivered(CourierID uuid.UUID) error {
+	if o.Delivery.CourierID == nil || *o.Delivery.CourierID != CourierID {
+		return ErrPermissionDenied
+	}
+
 	switch o.Status {
 	case Delivering:
 		now := time.Now()
-		o.Status = Delivered
 		o.Delivery.Arrived = &now
+		o.transition(Delivered, CourierActor(CourierID), "", nil)
 		return nil
 
 	default:
@@ -0,0 +1,26 @@
+package order
+
+import "time"
+
+// CancellationPolicy bounds how long after an order enters a state the
+// customer may still cancel it. A zero window forbids cancellation in that state.
+type CancellationPolicy struct {
+	CreatedWindow    time.Duration
+	DeliveringWindow time.Duration
+}
+
+// Allows reports whether the order may be canceled at the given moment. Created
+// orders are measured from their creation, delivering ones from courier assignment.
+func (p CancellationPolicy) Allows(order *Order, now time.Time) bool {
+	switch order.Status {
+	case Created:
+		return now.Before(order.Created.Add(p.CreatedWindow))
+	case Delivering:
+		if order.Delivery.Assigned == nil {
+			return now.Before(order.Created.Add(p.DeliveringWindow))
+		}
+		return now.Before(order.Delivery.Assigned.Add(p.DeliveringWindow))
+	default:
+		return false
+	}
+}
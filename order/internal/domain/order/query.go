@@ -0,0 +1,73 @@
+package order
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SortOrder string
+
+const (
+	NewestFirst SortOrder = "newest_first"
+	OldestFirst SortOrder = "oldest_first"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// ListQuery narrows and pages an order listing. The created range is
+// half-open: [CreatedFrom, CreatedTo). Cursor is an opaque token returned as
+// Page.NextCursor by the previous call; empty means the first page.
+type ListQuery struct {
+	Statuses    []Status
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Sort        SortOrder
+	Limit       int
+	Cursor      string
+}
+
+// SearchFilter narrows an order search to orders matching every field set.
+// ProductID matches orders with a line of that product.
+type SearchFilter struct {
+	CustomerID *uuid.UUID
+	CourierID  *uuid.UUID
+	ProductID  *uuid.UUID
+}
+
+// StatusCounts holds the number of orders per status.
+type StatusCounts map[Status]int
+
+// Page is a single slice of an order listing. NextCursor is empty on the last page.
+type Page struct {
+	Orders     []*Order
+	NextCursor string
+}
+
+// Normalize validates the query and fills in the default sort order and page size.
+func (q ListQuery) Normalize() (ListQuery, error) {
+	if q.Limit < 0 {
+		return q, ErrInvalidListQuery
+	}
+	if q.CreatedFrom != nil && q.CreatedTo != nil && q.CreatedFrom.After(*q.CreatedTo) {
+		return q, ErrInvalidListQuery
+	}
+
+	switch q.Sort {
+	case "":
+		q.Sort = NewestFirst
+	case NewestFirst, OldestFirst:
+	default:
+		return q, ErrInvalidListQuery
+	}
+
+	if q.Limit == 0 {
+		q.Limit = DefaultPageSize
+	}
+	q.Limit = min(q.Limit, MaxPageSize)
+
+	return q, nil
+}
@@ -2,6 +2,7 @@ package order
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -10,6 +11,9 @@ type Repository interface {
 	Create(ctx context.Context, order *Order) error
 	Update(ctx context.Context, order *Order) error
 	GetByID(ctx context.Context, orderID uuid.UUID) (*Order, error)
-	GetAllByCustomer(ctx context.Context, customerID uuid.UUID) ([]*Order, error)
+	GetAllByCustomer(ctx context.Context, customerID uuid.UUID, query ListQuery) (*Page, error)
 	GetCurrentByCourier(ctx context.Context, courierID uuid.UUID) ([]*Order, error)
+	GetHistoryByCourier(ctx context.Context, courierID uuid.UUID, query ListQuery) (*Page, error)
+	Search(ctx context.Context, filter SearchFilter, query ListQuery) (*Page, error)
+	CountByCourier(ctx context.Context, courierID uuid.UUID, createdFrom, createdTo *time.Time) (StatusCounts, error)
 }
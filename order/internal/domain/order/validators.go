@@ -1,11 +1,102 @@
 package order
 
-func validateAddress(address string) bool {
-	return address != ""
+import "unicode/utf8"
+
+const (
+	maxReasonLength = 500
+
+	maxCityLength         = 100
+	maxStreetLength       = 200
+	maxHouseLength        = 20
+	maxApartmentLength    = 20
+	minPostalCodeLength   = 3
+	maxPostalCodeLength   = 10
+	maxInstructionsLength = 500
+)
+
+func validateAddress(address Address) error {
+	if !validateCountry(address.Country) {
+		return ErrInvalidAddressCountry
+	}
+	if !validateAddressLine(address.City, maxCityLength) {
+		return ErrInvalidAddressCity
+	}
+	if !validateAddressLine(address.Street, maxStreetLength) {
+		return ErrInvalidAddressStreet
+	}
+	if !validateAddressLine(address.House, maxHouseLength) {
+		return ErrInvalidAddressHouse
+	}
+	if utf8.RuneCountInString(address.Apartment) > maxApartmentLength {
+		return ErrInvalidAddressApartment
+	}
+	if !validatePostalCode(address.PostalCode) {
+		return ErrInvalidAddressPostalCode
+	}
+	if address.Location != nil && !validateLocation(*address.Location) {
+		return ErrInvalidAddressLocation
+	}
+	if utf8.RuneCountInString(address.Instructions) > maxInstructionsLength {
+		return ErrInvalidAddressInstructions
+	}
+	return nil
+}
+
+// validateCountry checks the ISO 3166-1 alpha-2 shape: two upper-case letters.
+func validateCountry(country string) bool {
+	return len(country) == 2 && isUpperLetters(country)
+}
+
+func validateAddressLine(line string, maxLength int) bool {
+	length := utf8.RuneCountInString(line)
+	return length > 0 && length <= maxLength
+}
+
+// validatePostalCode accepts upper-case letters and digits separated by single
+// spaces or hyphens, which covers the formats in use worldwide.
+func validatePostalCode(code string) bool {
+	if len(code) < minPostalCodeLength || len(code) > maxPostalCodeLength {
+		return false
+	}
+	for i, r := range code {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case (r == ' ' || r == '-') && i > 0 && i < len(code)-1:
+			if prev := code[i-1]; prev == ' ' || prev == '-' {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func validateLocation(location Location) bool {
+	return location.Latitude >= -90 && location.Latitude <= 90 &&
+		location.Longitude >= -180 && location.Longitude <= 180
+}
+
+func validateReason(reason string) bool {
+	return utf8.RuneCountInString(reason) <= maxReasonLength
+}
+
+// validateCurrency checks the ISO 4217 shape: three upper-case letters.
+func validateCurrency(currency string) bool {
+	return len(currency) == 3 && isUpperLetters(currency)
+}
+
+func isUpperLetters(s string) bool {
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
 }
 
 func validateItem(item Item) bool {
-	if item.Price.Sign() <= 0 {
+	if !item.Price.IsPositive() || !validateCurrency(item.Price.Currency) {
 		return false
 	}
 	if item.Count <= 0 {
@@ -25,3 +116,16 @@ func validateItems(items []Item) bool {
 	}
 	return true
 }
+
+func validateFulfillmentPolicy(policy FulfillmentPolicy) bool {
+	return policy == AllOrNothing || policy == AllowPartial
+}
+
+func validateItemsCurrency(items []Item) bool {
+	for _, item := range items {
+		if item.Price.Currency != items[0].Price.Currency {
+			return false
+		}
+	}
+	return true
+}
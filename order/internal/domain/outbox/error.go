@@ -0,0 +1,7 @@
+package outbox
+
+import "errors"
+
+var (
+	ErrInvalidOutboxPayload = errors.New("invalid outbox payload")
+)
@@ -0,0 +1,47 @@
+package outbox
+
+import (
+	"encoding/json"
+	domain "order/internal/domain/common"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func Create(Name string, Key string, Payload any) (*Message, error) {
+	payload, err := parsePayload(Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Message{
+		ID:          uuid.New(),
+		Name:        Name,
+		Key:         Key,
+		Payload:     payload,
+		Metadata:    map[string]any{},
+		Attempts:    0,
+		NextAttempt: now,
+		Created:     now,
+	}, nil
+}
+
+// CreateFromEvent stores a domain event. The message takes the event ID so that
+// subscribers can drop duplicates.
+func CreateFromEvent(Event domain.Event, Key string) (*Message, error) {
+	message, err := Create(Event.Name(), Key, Event.Payload())
+	if err != nil {
+		return nil, err
+	}
+	message.ID = Event.ID()
+	return message, nil
+}
+
+func parsePayload(payload any) ([]byte, error) {
+	buf, err := json.Marshal(payload)
+	if err != nil {
+		return nil, ErrInvalidOutboxPayload
+	}
+	return buf, nil
+}
@@ -0,0 +1,36 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Message struct {
+	ID          uuid.UUID
+	Name        string
+	Key         string
+	Payload     []byte
+	Metadata    map[string]any
+	Attempts    int
+	NextAttempt time.Time
+	Created     time.Time
+	// Failed is when the message was given up on as it can never be published.
+	// A failed message is kept for inspection, but is no longer claimed and no
+	// longer holds back the later messages with its key.
+	Failed *time.Time
+	// Error is why the message failed.
+	Error string
+}
+
+func (m *Message) NoteFailedAttempt(RetryAt time.Time) {
+	m.Attempts++
+	m.NextAttempt = RetryAt
+}
+
+// NoteFailed gives the message up after an attempt that can never succeed.
+func (m *Message) NoteFailed(Cause error, Now time.Time) {
+	m.Attempts++
+	m.Failed = &Now
+	m.Error = Cause.Error()
+}
@@ -0,0 +1,7 @@
+package outbox
+
+import "context"
+
+type Publisher interface {
+	Publish(ctx context.Context, message *Message) error
+}
@@ -0,0 +1,23 @@
+package outbox
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	Create(ctx context.Context, message *Message) error
+	// CreateOnce stores the message unless one with its ID is stored already,
+	// which is then left as it is. A command sent again under the ID it was
+	// first sent with is thus queued only once.
+	CreateOnce(ctx context.Context, message *Message) error
+	Update(ctx context.Context, message *Message) error
+	Delete(ctx context.Context, message *Message) error
+
+	// ClaimPending atomically leases the oldest message that is due for delivery
+	// and not leased by anyone else. Only the oldest message of a key may be
+	// claimed, so that messages with the same key are published in order. The
+	// lease is held until leaseUntil or until the message is updated. It returns
+	// nil when there is nothing to claim.
+	ClaimPending(ctx context.Context, leaseUntil time.Time) (*Message, error)
+}
@@ -0,0 +1,10 @@
+package promotion
+
+type (
+	Kind string
+)
+
+const (
+	Percentage  Kind = "percentage"
+	FixedAmount Kind = "fixed_amount"
+)
@@ -0,0 +1,12 @@
+package promotion
+
+import "errors"
+
+var (
+	ErrInvalidCode            = errors.New("invalid promo code")
+	ErrInvalidRules           = errors.New("invalid promotion rules")
+	ErrPromotionInactive      = errors.New("promotion is not active")
+	ErrMinOrderValueNotMet    = errors.New("order is below the promotion minimum")
+	ErrPromotionNotApplicable = errors.New("promotion does not apply to the order")
+	ErrUsageLimitReached      = errors.New("promotion usage limit reached")
+)
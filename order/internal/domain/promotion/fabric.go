@@ -0,0 +1,26 @@
+package promotion
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func Create(Code string, Rules Rules) (*Promotion, error) {
+	code := NormalizeCode(Code)
+	if !validateCode(code) {
+		return nil, ErrInvalidCode
+	}
+	if !validateRules(Rules) {
+		return nil, ErrInvalidRules
+	}
+
+	return &Promotion{
+		ID:      uuid.New(),
+		Code:    code,
+		Rules:   Rules,
+		Active:  true,
+		Created: time.Now(),
+		Version: uuid.New(),
+	}, nil
+}
@@ -0,0 +1,122 @@
+package promotion
+
+import (
+	orderDomain "order/internal/domain/order"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Rules decide whether a promotion applies to an order and how much it takes off.
+type Rules struct {
+	Kind Kind
+	// Percent of the eligible subtotal taken off by Percentage promotions, 1 to 100.
+	Percent int
+	// Amount taken off by FixedAmount promotions, capped at the eligible subtotal.
+	Amount orderDomain.Money
+	// MinOrderValue is compared with the order subtotal; nil means no minimum.
+	MinOrderValue *orderDomain.Money
+	// PerCustomerLimit caps how many orders one customer may place with the
+	// code; zero means unlimited.
+	PerCustomerLimit int
+	// The promotion is valid in [ValidFrom, ValidTo); nil bounds are open.
+	ValidFrom *time.Time
+	ValidTo   *time.Time
+	// ProductIDs restricts the discount to lines of these products; empty
+	// means the whole order.
+	ProductIDs []uuid.UUID
+}
+
+type Promotion struct {
+	ID      uuid.UUID
+	Code    string
+	Rules   Rules
+	Active  bool
+	Created time.Time
+	Version uuid.UUID
+}
+
+func (p *Promotion) Deactivate() {
+	p.Active = false
+}
+
+// Discount works out how much the promotion takes off the order at the given
+// time. Percentage discounts are rounded down to the cent. The per-customer
+// limit is not checked here: it is enforced when the use is redeemed.
+func (p *Promotion) Discount(order *orderDomain.Order, now time.Time) (orderDomain.Money, error) {
+	if !p.isActiveAt(now) {
+		return orderDomain.Money{}, ErrPromotionInactive
+	}
+	return p.Reprice(order)
+}
+
+// Reprice works out the discount again for an order the promotion was already
+// applied to, after its items changed. The promotion was active when the order
+// was placed, so only the order rules are checked.
+func (p *Promotion) Reprice(order *orderDomain.Order) (orderDomain.Money, error) {
+	subtotal := order.Subtotal()
+	if minimum := p.Rules.MinOrderValue; minimum != nil {
+		if minimum.Currency != subtotal.Currency {
+			return orderDomain.Money{}, ErrPromotionNotApplicable
+		}
+		if subtotal.Amount.LessThan(minimum.Amount) {
+			return orderDomain.Money{}, ErrMinOrderValueNotMet
+		}
+	}
+
+	eligible := p.eligibleSubtotal(order.Items)
+	if !eligible.IsPositive() {
+		return orderDomain.Money{}, ErrPromotionNotApplicable
+	}
+
+	switch p.Rules.Kind {
+	case Percentage:
+		amount := eligible.Amount.
+			Mul(decimal.NewFromInt(int64(p.Rules.Percent))).
+			Div(decimal.NewFromInt(100)).
+			Truncate(2)
+		if !amount.IsPositive() {
+			return orderDomain.Money{}, ErrPromotionNotApplicable
+		}
+		return orderDomain.Money{Amount: amount, Currency: eligible.Currency}, nil
+
+	case FixedAmount:
+		if p.Rules.Amount.Currency != eligible.Currency {
+			return orderDomain.Money{}, ErrPromotionNotApplicable
+		}
+		amount := decimal.Min(p.Rules.Amount.Amount, eligible.Amount)
+		return orderDomain.Money{Amount: amount, Currency: eligible.Currency}, nil
+
+	default:
+		return orderDomain.Money{}, ErrInvalidRules
+	}
+}
+
+func (p *Promotion) isActiveAt(now time.Time) bool {
+	if !p.Active {
+		return false
+	}
+	if p.Rules.ValidFrom != nil && now.Before(*p.Rules.ValidFrom) {
+		return false
+	}
+	if p.Rules.ValidTo != nil && !now.Before(*p.Rules.ValidTo) {
+		return false
+	}
+	return true
+}
+
+func (p *Promotion) eligibleSubtotal(items []orderDomain.Item) orderDomain.Money {
+	total := decimal.Zero
+	currency := ""
+	for _, item := range items {
+		if len(p.Rules.ProductIDs) > 0 && !slices.Contains(p.Rules.ProductIDs, item.ProductID) {
+			continue
+		}
+		line := item.Total()
+		total = total.Add(line.Amount)
+		currency = line.Currency
+	}
+	return orderDomain.Money{Amount: total, Currency: currency}
+}
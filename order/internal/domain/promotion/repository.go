@@ -0,0 +1,23 @@
+package promotion
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, promotion *Promotion) error
+	Update(ctx context.Context, promotion *Promotion) error
+	GetByID(ctx context.Context, promotionID uuid.UUID) (*Promotion, error)
+	GetByCode(ctx context.Context, code string) (*Promotion, error)
+	GetAll(ctx context.Context, activeOnly bool) ([]*Promotion, error)
+
+	// Redeem atomically records one use of the promotion by the customer and
+	// fails with ErrUsageLimitReached once the customer has used it
+	// PerCustomerLimit times.
+	Redeem(ctx context.Context, promotion *Promotion, customerID uuid.UUID) error
+	// Release gives back one use of the promotion by the customer, as when the
+	// order that used it is canceled. A customer with no uses left is not changed.
+	Release(ctx context.Context, promotion *Promotion, customerID uuid.UUID) error
+}
@@ -0,0 +1,60 @@
+package promotion
+
+import (
+	orderDomain "order/internal/domain/order"
+	"strings"
+)
+
+const (
+	minCodeLength = 3
+	maxCodeLength = 32
+)
+
+// NormalizeCode makes codes case-insensitive: they are stored and looked up
+// in upper case.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func validateCode(code string) bool {
+	if len(code) < minCodeLength || len(code) > maxCodeLength {
+		return false
+	}
+	for _, r := range code {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+func validateMoney(money orderDomain.Money) bool {
+	_, err := orderDomain.NewMoney(money.Amount, money.Currency)
+	return err == nil && money.IsPositive()
+}
+
+func validateRules(rules Rules) bool {
+	switch rules.Kind {
+	case Percentage:
+		if rules.Percent < 1 || rules.Percent > 100 {
+			return false
+		}
+	case FixedAmount:
+		if !validateMoney(rules.Amount) {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if rules.MinOrderValue != nil && !validateMoney(*rules.MinOrderValue) {
+		return false
+	}
+	if rules.PerCustomerLimit < 0 {
+		return false
+	}
+	if rules.ValidFrom != nil && rules.ValidTo != nil && !rules.ValidFrom.Before(*rules.ValidTo) {
+		return false
+	}
+	return true
+}
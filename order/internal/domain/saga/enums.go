@@ -0,0 +1,29 @@
+package saga
+
+type (
+	Type string
+	Step string
+)
+
+const (
+	CreateOrder Type = "create_order"
+	CancelOrder Type = "cancel_order"
+)
+
+const (
+	ReservingItems           Step = "reserving_items"
+	AssigningCourier         Step = "assigning_courier"
+	BeginningDelivery        Step = "beginning_delivery"
+	CancelingOutOfStock      Step = "canceling_out_of_stock"
+	ReleasingItems           Step = "releasing_items"
+	CancelingCourierNotFound Step = "canceling_courier_not_found"
+	ReleasingItemsOnTimeout  Step = "releasing_items_on_timeout"
+	CancelingTimeout         Step = "canceling_timeout"
+	SupersededByCancel       Step = "superseded_by_cancel"
+	AwaitingDeliverySlot     Step = "awaiting_delivery_slot"
+
+	ReleasingItemsAndCourier Step = "releasing_items_and_courier"
+	AwaitingCourierRelease   Step = "awaiting_courier_release"
+	AwaitingItemsRelease     Step = "awaiting_items_release"
+	CancelingByCustomer      Step = "canceling_by_customer"
+)
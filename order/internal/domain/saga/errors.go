@@ -0,0 +1,10 @@
+package saga
+
+import "errors"
+
+var (
+	ErrUnsupportedType           = errors.New("unsupported saga type")
+	ErrUnsupportedStepTransition = errors.New("unsupported saga step transition")
+	ErrStepNotRetryable          = errors.New("saga step not retryable")
+	ErrRetriesExhausted          = errors.New("saga step got no reply despite retries")
+)
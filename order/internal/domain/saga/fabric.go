@@ -0,0 +1,29 @@
+package saga
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func Create(Type Type, OrderID uuid.UUID) (*Saga, error) {
+	step, ok := initialSteps[Type]
+	if !ok {
+		return nil, ErrUnsupportedType
+	}
+
+	now := time.Now()
+	return &Saga{
+		ID:      uuid.New(),
+		Type:    Type,
+		OrderID: OrderID,
+		Step:    step,
+		History: []StepRecord{
+			{Step: step, Entered: now},
+		},
+		LastError: nil,
+		Created:   now,
+		Updated:   now,
+		Version:   uuid.New(),
+	}, nil
+}
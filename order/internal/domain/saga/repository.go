@@ -0,0 +1,38 @@
+package saga
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, saga *Saga) error
+	Update(ctx context.Context, saga *Saga) error
+	GetByID(ctx context.Context, sagaID uuid.UUID) (*Saga, error)
+	GetByOrderID(ctx context.Context, sagaType Type, orderID uuid.UUID) (*Saga, error)
+	GetAllByOrderID(ctx context.Context, orderID uuid.UUID) ([]*Saga, error)
+
+	// ClaimStalled atomically leases one saga of the given type that entered one of
+	// the steps before enteredBefore and is not leased by anyone else, so that
+	// concurrent callers never receive the same saga. The lease is held until
+	// leaseUntil or until the saga is updated. It returns nil when there is nothing to claim.
+	ClaimStalled(
+		ctx context.Context,
+		sagaType Type,
+		steps []Step,
+		enteredBefore time.Time,
+		leaseUntil time.Time,
+	) (*Saga, error)
+
+	// ClaimSuspended atomically leases one saga of the given type that is due to
+	// resume at or before resumeBefore and is not leased by anyone else. Leases work
+	// as in ClaimStalled. It returns nil when there is nothing to claim.
+	ClaimSuspended(
+		ctx context.Context,
+		sagaType Type,
+		resumeBefore time.Time,
+		leaseUntil time.Time,
+	) (*Saga, error)
+}
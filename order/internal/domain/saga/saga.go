@@ -0,0 +1,92 @@
+package saga
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Saga struct {
+	ID        uuid.UUID
+	Type      Type
+	OrderID   uuid.UUID
+	Step      Step
+	History   []StepRecord
+	LastError *Failure
+	// ResumeAt is set while the saga is suspended in its current step.
+	ResumeAt *time.Time
+	Created  time.Time
+	Updated  time.Time
+	Version  uuid.UUID
+}
+
+func (s *Saga) NoteStep(step Step) error {
+	if !canTransition(s.Step, step) {
+		return ErrUnsupportedStepTransition
+	}
+
+	now := time.Now()
+	s.Step = step
+	s.History = append(s.History, StepRecord{Step: step, Entered: now})
+	s.ResumeAt = nil
+	s.Updated = now
+	return nil
+}
+
+// Suspend moves the saga to a step in which it does nothing until resumeAt.
+func (s *Saga) Suspend(step Step, resumeAt time.Time) error {
+	if err := s.NoteStep(step); err != nil {
+		return err
+	}
+
+	s.ResumeAt = &resumeAt
+	return nil
+}
+
+// NoteRetry records that the commands of the current step are sent again. Only
+// a step awaiting a reply can be retried; the retry restarts its deadline.
+func (s *Saga) NoteRetry() error {
+	if !slices.Contains(AwaitingSteps(s.Type), s.Step) {
+		return ErrStepNotRetryable
+	}
+
+	now := time.Now()
+	s.History = append(s.History, StepRecord{Step: s.Step, Entered: now, Retry: true})
+	s.Updated = now
+	return nil
+}
+
+// Retries returns how often the commands of the current step have been sent
+// again since the saga entered it.
+func (s *Saga) Retries() int {
+	retries := 0
+	for i := len(s.History) - 1; i >= 0 && s.History[i].Retry; i-- {
+		retries++
+	}
+	return retries
+}
+
+// Reached reports whether the saga has ever been in the step.
+func (s *Saga) Reached(step Step) bool {
+	return slices.ContainsFunc(s.History, func(record StepRecord) bool {
+		return record.Step == step
+	})
+}
+
+// CommandID is the message ID of the named command sent by the saga. A saga
+// sends each command at most once, so a command sent again keeps its ID and
+// the receiving service recognizes it as a duplicate.
+func (s *Saga) CommandID(Name string) uuid.UUID {
+	return uuid.NewSHA1(s.ID, []byte(Name))
+}
+
+func (s *Saga) NoteFailure(err error) {
+	now := time.Now()
+	s.LastError = &Failure{
+		Step:     s.Step,
+		Message:  err.Error(),
+		Occurred: now,
+	}
+	s.Updated = now
+}
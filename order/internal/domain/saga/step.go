@@ -0,0 +1,17 @@
+package saga
+
+import "time"
+
+// StepRecord notes when the saga entered a step. Retry marks a record added
+// when an admin re-sent the commands of the step it was already in.
+type StepRecord struct {
+	Step    Step
+	Entered time.Time
+	Retry   bool
+}
+
+type Failure struct {
+	Step     Step
+	Message  string
+	Occurred time.Time
+}
@@ -0,0 +1,42 @@
+package saga
+
+var initialSteps = map[Type]Step{
+	CreateOrder: ReservingItems,
+	CancelOrder: ReleasingItemsAndCourier,
+}
+
+var transitions = map[Step][]Step{
+	ReservingItems:          {AssigningCourier, AwaitingDeliverySlot, CancelingOutOfStock, CancelingTimeout, SupersededByCancel},
+	AssigningCourier:        {BeginningDelivery, ReleasingItems, ReleasingItemsOnTimeout, SupersededByCancel},
+	AwaitingDeliverySlot:    {AssigningCourier, SupersededByCancel},
+	ReleasingItems:          {CancelingCourierNotFound, CancelingTimeout},
+	ReleasingItemsOnTimeout: {CancelingTimeout},
+
+	// A cancellation waits for both the warehouse and the courier, in either
+	// order. When the replies do not come despite retries, it completes without
+	// them.
+	ReleasingItemsAndCourier: {AwaitingCourierRelease, AwaitingItemsRelease, CancelingByCustomer},
+	AwaitingCourierRelease:   {CancelingByCustomer},
+	AwaitingItemsRelease:     {CancelingByCustomer},
+}
+
+// awaitingSteps lists, per saga type, the steps in which the saga waits for
+// a reply from another service and can therefore get stuck. A suspended saga
+// waits for its own resume time instead and is not listed.
+var awaitingSteps = map[Type][]Step{
+	CreateOrder: {ReservingItems, AssigningCourier, ReleasingItems, ReleasingItemsOnTimeout},
+	CancelOrder: {ReleasingItemsAndCourier, AwaitingCourierRelease, AwaitingItemsRelease},
+}
+
+func canTransition(from, to Step) bool {
+	for _, step := range transitions[from] {
+		if step == to {
+			return true
+		}
+	}
+	return false
+}
+
+func AwaitingSteps(Type Type) []Step {
+	return awaitingSteps[Type]
+}
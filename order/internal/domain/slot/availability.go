@@ -0,0 +1,15 @@
+package slot
+
+import orderDomain "order/internal/domain/order"
+
+// Availability is a slot on offer together with how many orders it takes and
+// how many are already booked into it.
+type Availability struct {
+	Slot     orderDomain.DeliverySlot
+	Capacity int
+	Reserved int
+}
+
+func (a Availability) Remaining() int {
+	return max(a.Capacity-a.Reserved, 0)
+}
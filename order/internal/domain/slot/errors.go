@@ -0,0 +1,9 @@
+package slot
+
+import "errors"
+
+var (
+	ErrInvalidSchedule = errors.New("invalid delivery slot schedule")
+	ErrSlotUnavailable = errors.New("delivery slot is not offered")
+	ErrSlotFull        = errors.New("delivery slot is fully booked")
+)
@@ -0,0 +1,18 @@
+package slot
+
+import (
+	"context"
+	orderDomain "order/internal/domain/order"
+	"time"
+)
+
+type Repository interface {
+	// Reserve atomically takes one place in the slot and fails with ErrSlotFull
+	// once capacity places are taken.
+	Reserve(ctx context.Context, slot orderDomain.DeliverySlot, capacity int) error
+	// Release gives back a place taken by Reserve.
+	Release(ctx context.Context, slot orderDomain.DeliverySlot) error
+	// GetReserved returns the number of places taken in every booked slot that
+	// starts in [from, to), keyed by the slot start in UTC.
+	GetReserved(ctx context.Context, from, to time.Time) (map[time.Time]int, error)
+}
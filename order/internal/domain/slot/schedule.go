@@ -0,0 +1,80 @@
+package slot
+
+import (
+	orderDomain "order/internal/domain/order"
+	"time"
+)
+
+// Schedule describes the delivery slots on offer. Every day, back-to-back slots
+// of Length run from DayStart to DayEnd, both measured from midnight in
+// Location. A slot takes Capacity orders unless CapacityByWeekday overrides
+// it for its weekday; a zero capacity closes the day. Slots can be booked from
+// MinLeadTime before they start and at most Horizon days ahead, today included.
+type Schedule struct {
+	DayStart          time.Duration
+	DayEnd            time.Duration
+	Length            time.Duration
+	Capacity          int
+	CapacityByWeekday map[time.Weekday]int
+	Horizon           int
+	MinLeadTime       time.Duration
+	Location          *time.Location
+}
+
+func (s Schedule) Validate() error {
+	if s.Location == nil || s.Length <= 0 || s.Horizon <= 0 || s.MinLeadTime < 0 {
+		return ErrInvalidSchedule
+	}
+	if s.DayStart < 0 || s.DayEnd > 24*time.Hour || s.DayStart+s.Length > s.DayEnd {
+		return ErrInvalidSchedule
+	}
+	if s.Capacity < 0 {
+		return ErrInvalidSchedule
+	}
+	for _, capacity := range s.CapacityByWeekday {
+		if capacity < 0 {
+			return ErrInvalidSchedule
+		}
+	}
+	return nil
+}
+
+// CapacityOf returns how many orders the slot takes.
+func (s Schedule) CapacityOf(slot orderDomain.DeliverySlot) int {
+	if capacity, ok := s.CapacityByWeekday[slot.Start.In(s.Location).Weekday()]; ok {
+		return capacity
+	}
+	return s.Capacity
+}
+
+// Slots lists the slots that can be booked at the given moment, earliest first.
+func (s Schedule) Slots(now time.Time) []orderDomain.DeliverySlot {
+	earliest := now.Add(s.MinLeadTime)
+	local := now.In(s.Location)
+
+	var slots []orderDomain.DeliverySlot
+	for day := 0; day < s.Horizon; day++ {
+		midnight := time.Date(local.Year(), local.Month(), local.Day()+day, 0, 0, 0, 0, s.Location)
+		for offset := s.DayStart; offset+s.Length <= s.DayEnd; offset += s.Length {
+			slot := orderDomain.DeliverySlot{
+				Start: midnight.Add(offset),
+				End:   midnight.Add(offset + s.Length),
+			}
+			if slot.Start.Before(earliest) || s.CapacityOf(slot) == 0 {
+				continue
+			}
+			slots = append(slots, slot)
+		}
+	}
+	return slots
+}
+
+// Check fails with ErrSlotUnavailable unless the slot can be booked at the given moment.
+func (s Schedule) Check(slot orderDomain.DeliverySlot, now time.Time) error {
+	for _, offered := range s.Slots(now) {
+		if offered.Start.Equal(slot.Start) && offered.End.Equal(slot.End) {
+			return nil
+		}
+	}
+	return ErrSlotUnavailable
+}
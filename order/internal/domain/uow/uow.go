@@ -0,0 +1,25 @@
+package uow
+
+import (
+	"context"
+	inboxDomain "order/internal/domain/inbox"
+	orderDomain "order/internal/domain/order"
+	outboxDomain "order/internal/domain/outbox"
+	promotionDomain "order/internal/domain/promotion"
+	sagaDomain "order/internal/domain/saga"
+	slotDomain "order/internal/domain/slot"
+)
+
+type UoW interface {
+	Order() orderDomain.Repository
+	Saga() sagaDomain.Repository
+	Outbox() outboxDomain.Repository
+	Promotion() promotionDomain.Repository
+	Slot() slotDomain.Repository
+	Inbox() inboxDomain.Repository
+
+	// Transaction runs fn in a single transaction. Repository calls made with
+	// the ctx passed to fn take part in it, and a transaction started with
+	// that ctx joins it instead of opening another.
+	Transaction(ctx context.Context, fn func(ctx context.Context, u UoW) error) error
+}
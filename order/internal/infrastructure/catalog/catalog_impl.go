@@ -0,0 +1,66 @@
+package catalog
+
+import (
+	"context"
+	"order/internal/application/order/usecase"
+	orderDomain "order/internal/domain/order"
+	"order/internal/infrastructure/catalog/warehousev1"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CatalogImpl struct {
+	client  warehousev1.ProductServiceClient
+	timeout time.Duration
+}
+
+func New(client warehousev1.ProductServiceClient, cfg *Config) *CatalogImpl {
+	return &CatalogImpl{
+		client:  client,
+		timeout: cfg.Timeout,
+	}
+}
+
+func (c *CatalogImpl) GetProducts(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]usecase.CatalogProduct, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req := &warehousev1.GetProductsRequest{ProductIds: make([]string, 0, len(productIDs))}
+	for _, productID := range productIDs {
+		req.ProductIds = append(req.ProductIds, productID.String())
+	}
+
+	res, err := c.client.GetProducts(ctx, req)
+	if err != nil {
+		return nil, parseError(err)
+	}
+
+	products := make(map[uuid.UUID]usecase.CatalogProduct, len(res.Products))
+	for _, product := range res.Products {
+		productID, err := uuid.Parse(product.ProductId)
+		if err != nil {
+			return nil, parseError(err)
+		}
+		price, err := toMoney(product.Price)
+		if err != nil {
+			return nil, parseError(err)
+		}
+		products[productID] = usecase.CatalogProduct{
+			ID:    productID,
+			Name:  product.Name,
+			Price: price,
+		}
+	}
+
+	return products, nil
+}
+
+func toMoney(money *warehousev1.Money) (orderDomain.Money, error) {
+	if money == nil {
+		return orderDomain.Money{}, orderDomain.ErrInvalidMoney
+	}
+	return orderDomain.MoneyFromUnits(money.Units, money.Nanos, money.CurrencyCode)
+}
+
+var _ usecase.Catalog = (*CatalogImpl)(nil)
@@ -0,0 +1,23 @@
+package catalog
+
+import (
+	"order/internal/infrastructure/catalog/warehousev1"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// NewClient dials the warehouse lazily, so the order service starts even while
+// the warehouse is down; lookups fail with ErrCatalogUnavailable until it is up.
+func NewClient(cfg *Config) (warehousev1.ProductServiceClient, error) {
+	conn, err := grpc.NewClient(
+		"passthrough:///"+cfg.WarehouseAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return warehousev1.NewProductServiceClient(conn), nil
+}
@@ -0,0 +1,21 @@
+package catalog
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Config struct {
+	WarehouseAddress string        `envconfig:"WAREHOUSE_ADDRESS" required:"true"`
+	Timeout          time.Duration `envconfig:"WAREHOUSE_TIMEOUT" required:"true"`
+}
+
+func NewConfig() (*Config, error) {
+	var cfg Config
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, fmt.Errorf("failed to load catalog config: %w", err)
+	}
+	return &cfg, nil
+}
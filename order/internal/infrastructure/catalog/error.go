@@ -0,0 +1,15 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrCatalogUnavailable = errors.New("product catalog unavailable")
+
+func parseError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%w: %v", ErrCatalogUnavailable, err)
+}
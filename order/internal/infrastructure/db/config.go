@@ -7,11 +7,30 @@ import (
 	"github.com/kelseyhightower/envconfig"
 )
 
+// Backend is the database the orders are stored in, together with the sagas,
+// outbox, inbox, promotions and delivery slots, so that a unit of work commits
+// in one transaction. BackendMemory keeps them in the process and is meant for
+// tests and local runs.
+type Backend string
+
+const (
+	BackendMongo    Backend = "mongo"
+	BackendPostgres Backend = "postgres"
+	BackendMemory   Backend = "memory"
+)
+
 type Config struct {
-	URI             string        `envconfig:"DB_URI" required:"true"`
-	Database        string        `envconfig:"DB_NAME" required:"true"`
-	OrderCollection string        `envconfig:"DB_ORDER_COLLECTION" required:"true"`
-	ConnectTimeout  time.Duration `envconfig:"DB_CONNECT_TIMEOUT" required:"true"`
+	URI                      string        `envconfig:"DB_URI" required:"true"`
+	Database                 string        `envconfig:"DB_NAME" required:"true"`
+	OrderCollection          string        `envconfig:"DB_ORDER_COLLECTION" required:"true"`
+	SagaCollection           string        `envconfig:"DB_SAGA_COLLECTION" required:"true"`
+	OutboxCollection         string        `envconfig:"DB_OUTBOX_COLLECTION" required:"true"`
+	InboxCollection          string        `envconfig:"DB_INBOX_COLLECTION" required:"true"`
+	PromotionCollection      string        `envconfig:"DB_PROMOTION_COLLECTION" required:"true"`
+	PromotionUsageCollection string        `envconfig:"DB_PROMOTION_USAGE_COLLECTION" required:"true"`
+	DeliverySlotCollection   string        `envconfig:"DB_DELIVERY_SLOT_COLLECTION" required:"true"`
+	ConnectTimeout           time.Duration `envconfig:"DB_CONNECT_TIMEOUT" required:"true"`
+	OrderBackend             Backend       `envconfig:"DB_ORDER_BACKEND" default:"mongo"`
 }
 
 func NewConfig() (*Config, error) {
@@ -19,5 +38,12 @@ func NewConfig() (*Config, error) {
 	if err := envconfig.Process("", &cfg); err != nil {
 		return nil, fmt.Errorf("failed to load db config: %w", err)
 	}
+	switch cfg.OrderBackend {
+	case "":
+		cfg.OrderBackend = BackendMongo
+	case BackendMongo, BackendPostgres, BackendMemory:
+	default:
+		return nil, fmt.Errorf("unknown order backend %q", cfg.OrderBackend)
+	}
 	return &cfg, nil
 }
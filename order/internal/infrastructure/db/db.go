@@ -32,3 +32,27 @@ func NewDB(cfg *Config, client *mongo.Client) *mongo.Database {
 func NewOrderCollection(db *mongo.Database, cfg *Config) *mongo.Collection {
 	return db.Collection(cfg.OrderCollection)
 }
+
+func NewSagaCollection(db *mongo.Database, cfg *Config) *mongo.Collection {
+	return db.Collection(cfg.SagaCollection)
+}
+
+func NewOutboxCollection(db *mongo.Database, cfg *Config) *mongo.Collection {
+	return db.Collection(cfg.OutboxCollection)
+}
+
+func NewInboxCollection(db *mongo.Database, cfg *Config) *mongo.Collection {
+	return db.Collection(cfg.InboxCollection)
+}
+
+func NewPromotionCollection(db *mongo.Database, cfg *Config) *mongo.Collection {
+	return db.Collection(cfg.PromotionCollection)
+}
+
+func NewPromotionUsageCollection(db *mongo.Database, cfg *Config) *mongo.Collection {
+	return db.Collection(cfg.PromotionUsageCollection)
+}
+
+func NewDeliverySlotCollection(db *mongo.Database, cfg *Config) *mongo.Collection {
+	return db.Collection(cfg.DeliverySlotCollection)
+}
@@ -0,0 +1,60 @@
+package documents
+
+import (
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// Address is stored as an embedded document. Orders placed before addresses
+// were structured hold a plain string instead; it is read into and written
+// back from Legacy.
+type Address struct {
+	Country      string    `bson:"country"`
+	City         string    `bson:"city"`
+	Street       string    `bson:"street"`
+	House        string    `bson:"house"`
+	Apartment    string    `bson:"apartment,omitempty"`
+	PostalCode   string    `bson:"postal_code"`
+	Location     *Location `bson:"location,omitempty"`
+	Instructions string    `bson:"instructions,omitempty"`
+	Legacy       string    `bson:"-"`
+}
+
+type Location struct {
+	Latitude  float64 `bson:"latitude"`
+	Longitude float64 `bson:"longitude"`
+}
+
+// addressFields has the fields of Address without its BSON methods.
+type addressFields Address
+
+func (a Address) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if a.Legacy != "" {
+		return bson.MarshalValue(a.Legacy)
+	}
+	return bson.MarshalValue(addressFields(a))
+}
+
+func (a *Address) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	switch t {
+	case bsontype.String:
+		legacy, ok := bson.RawValue{Type: t, Value: data}.StringValueOK()
+		if !ok {
+			return errors.New("malformed legacy address")
+		}
+		*a = Address{Legacy: legacy}
+		return nil
+	case bsontype.EmbeddedDocument:
+		var fields addressFields
+		if err := bson.Unmarshal(data, &fields); err != nil {
+			return err
+		}
+		*a = Address(fields)
+		return nil
+	default:
+		return fmt.Errorf("cannot decode %s into an address", t)
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 type Delivery struct {
 	CourierID *string    `bson:"courier_id,omitempty"`
-	Address   string     `bson:"address"`
+	Address   Address    `bson:"address"`
+	Slot      *Slot      `bson:"slot,omitempty"`
+	Assigned  *time.Time `bson:"assigned,omitempty"`
 	Arrived   *time.Time `bson:"arrived,omitempty"`
 }
@@ -0,0 +1,12 @@
+package documents
+
+import "time"
+
+// DeliverySlot counts the orders booked into one slot. It is keyed by the slot
+// start in UTC.
+type DeliverySlot struct {
+	ID       string    `bson:"_id"`
+	Start    time.Time `bson:"start"`
+	End      time.Time `bson:"end"`
+	Reserved int       `bson:"reserved"`
+}
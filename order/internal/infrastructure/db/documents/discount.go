@@ -0,0 +1,6 @@
+package documents
+
+type Discount struct {
+	Code   string `bson:"code"`
+	Amount Money  `bson:"amount"`
+}
@@ -0,0 +1,10 @@
+package documents
+
+import "time"
+
+type InboxMessage struct {
+	ID        string    `bson:"_id"`
+	Response  string    `bson:"response"`
+	Processed time.Time `bson:"processed"`
+	Expires   time.Time `bson:"expires"`
+}
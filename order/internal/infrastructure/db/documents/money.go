@@ -0,0 +1,6 @@
+package documents
+
+type Money struct {
+	Amount   string `bson:"amount"`
+	Currency string `bson:"currency"`
+}
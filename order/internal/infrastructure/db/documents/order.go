@@ -6,11 +6,16 @@ import (
 )
 
 type Order struct {
-	ID         string             `bson:"_id"`
-	CustomerID string             `bson:"customer_id"`
-	Status     orderDomain.Status `bson:"status"`
-	Created    time.Time          `bson:"created"`
-	Version    string             `bson:"version"`
-	Delivery   Delivery           `bson:"delivery"`
-	Items      []OrderItem        `bson:"items"`
+	ID           string                        `bson:"_id"`
+	CustomerID   string                        `bson:"customer_id"`
+	Status       orderDomain.Status            `bson:"status"`
+	Created      time.Time                     `bson:"created"`
+	Version      string                        `bson:"version"`
+	Delivery     Delivery                      `bson:"delivery"`
+	Items        []OrderItem                   `bson:"items"`
+	CancelReason string                        `bson:"cancel_reason,omitempty"`
+	Discount     *Discount                     `bson:"discount,omitempty"`
+	Fulfillment  orderDomain.FulfillmentPolicy `bson:"fulfillment"`
+	Total        Money                         `bson:"total"`
+	History      []StatusChange                `bson:"history,omitempty"`
 }
@@ -2,6 +2,9 @@ package documents
 
 type OrderItem struct {
 	ProductID string `bson:"product_id"`
-	Price     string `bson:"price"`
+	Name      string `bson:"name"`
+	Price     Money  `bson:"price"`
 	Count     int    `bson:"count"`
+	Requested int    `bson:"requested"`
+	LineTotal Money  `bson:"line_total"`
 }
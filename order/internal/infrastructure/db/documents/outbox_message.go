@@ -0,0 +1,17 @@
+package documents
+
+import "time"
+
+type OutboxMessage struct {
+	ID          string         `bson:"_id"`
+	Name        string         `bson:"name"`
+	Key         string         `bson:"key,omitempty"`
+	Payload     string         `bson:"payload"`
+	Metadata    map[string]any `bson:"metadata"`
+	Attempts    int            `bson:"attempts"`
+	NextAttempt time.Time      `bson:"next_attempt"`
+	LeaseUntil  *time.Time     `bson:"lease_until,omitempty"`
+	Created     time.Time      `bson:"created"`
+	Failed      *time.Time     `bson:"failed,omitempty"`
+	Error       string         `bson:"error,omitempty"`
+}
@@ -0,0 +1,22 @@
+package documents
+
+import (
+	promotionDomain "order/internal/domain/promotion"
+	"time"
+)
+
+type Promotion struct {
+	ID               string               `bson:"_id"`
+	Code             string               `bson:"code"`
+	Kind             promotionDomain.Kind `bson:"kind"`
+	Percent          int                  `bson:"percent,omitempty"`
+	Amount           *Money               `bson:"amount,omitempty"`
+	MinOrderValue    *Money               `bson:"min_order_value,omitempty"`
+	PerCustomerLimit int                  `bson:"per_customer_limit"`
+	ValidFrom        *time.Time           `bson:"valid_from,omitempty"`
+	ValidTo          *time.Time           `bson:"valid_to,omitempty"`
+	ProductIDs       []string             `bson:"product_ids"`
+	Active           bool                 `bson:"active"`
+	Created          time.Time            `bson:"created"`
+	Version          string               `bson:"version"`
+}
@@ -0,0 +1,11 @@
+package documents
+
+// PromotionUsage counts the orders one customer placed with a promotion. The
+// id joins the promotion and customer ids so that a customer has one counter
+// per promotion.
+type PromotionUsage struct {
+	ID          string `bson:"_id"`
+	PromotionID string `bson:"promotion_id"`
+	CustomerID  string `bson:"customer_id"`
+	Uses        int    `bson:"uses"`
+}
@@ -0,0 +1,21 @@
+package documents
+
+import (
+	sagaDomain "order/internal/domain/saga"
+	"time"
+)
+
+type Saga struct {
+	ID          string          `bson:"_id"`
+	Type        sagaDomain.Type `bson:"type"`
+	OrderID     string          `bson:"order_id"`
+	Step        sagaDomain.Step `bson:"step"`
+	StepEntered time.Time       `bson:"step_entered"`
+	History     []SagaStep      `bson:"history"`
+	LastError   *SagaFailure    `bson:"last_error,omitempty"`
+	ResumeAt    *time.Time      `bson:"resume_at,omitempty"`
+	LeaseUntil  *time.Time      `bson:"lease_until,omitempty"`
+	Created     time.Time       `bson:"created"`
+	Updated     time.Time       `bson:"updated"`
+	Version     string          `bson:"version"`
+}
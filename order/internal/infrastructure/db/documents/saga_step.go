@@ -0,0 +1,18 @@
+package documents
+
+import (
+	sagaDomain "order/internal/domain/saga"
+	"time"
+)
+
+type SagaStep struct {
+	Step    sagaDomain.Step `bson:"step"`
+	Entered time.Time       `bson:"entered"`
+	Retry   bool            `bson:"retry,omitempty"`
+}
+
+type SagaFailure struct {
+	Step     sagaDomain.Step `bson:"step"`
+	Message  string          `bson:"message"`
+	Occurred time.Time       `bson:"occurred"`
+}
@@ -0,0 +1,8 @@
+package documents
+
+import "time"
+
+type Slot struct {
+	Start time.Time `bson:"start"`
+	End   time.Time `bson:"end"`
+}
@@ -0,0 +1,21 @@
+package documents
+
+import (
+	orderDomain "order/internal/domain/order"
+	"time"
+)
+
+type StatusChange struct {
+	From      orderDomain.Status `bson:"from,omitempty"`
+	To        orderDomain.Status `bson:"to"`
+	Occurred  time.Time          `bson:"occurred"`
+	Actor     Actor              `bson:"actor"`
+	Reason    string             `bson:"reason,omitempty"`
+	MessageID *string            `bson:"message_id,omitempty"`
+	CourierID *string            `bson:"courier_id,omitempty"`
+}
+
+type Actor struct {
+	Type orderDomain.ActorType `bson:"type"`
+	ID   *string               `bson:"id,omitempty"`
+}
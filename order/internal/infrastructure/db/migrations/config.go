@@ -8,6 +8,8 @@ import (
 
 type Config struct {
 	MigrationsPath string `envconfig:"DB_MIGRATIONS_PATH" required:"true"`
+	// PostgresMigrationsPath is only needed when the orders are stored in PostgreSQL.
+	PostgresMigrationsPath string `envconfig:"DB_POSTGRES_MIGRATIONS_PATH"`
 }
 
 func NewConfig() (*Config, error) {
@@ -0,0 +1,23 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Config struct {
+	Host     string `envconfig:"POSTGRES_HOST" required:"true"`
+	Port     string `envconfig:"POSTGRES_PORT" required:"true"`
+	Database string `envconfig:"POSTGRES_DB" required:"true"`
+	Username string `envconfig:"POSTGRES_USER" required:"true"`
+	Password string `envconfig:"POSTGRES_PASSWORD" required:"true"`
+}
+
+func NewConfig() (*Config, error) {
+	var cfg Config
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, fmt.Errorf("failed to load postgres config: %w", err)
+	}
+	return &cfg, nil
+}
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	gormPostgres "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func createDSN(c *Config) string {
+	return fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		c.Host,
+		c.Port,
+		c.Username,
+		c.Password,
+		c.Database,
+	)
+}
+
+func NewDB(cfg *Config) (*gorm.DB, error) {
+	db, err := gorm.Open(gormPostgres.Open(createDSN(cfg)), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to PostgreSQL: %w", err)
+	}
+	return db, nil
+}
+
+type txKey struct{}
+
+// WithTx returns a copy of ctx carrying tx. Repositories given that ctx run
+// their statements in tx, the way MongoDB repositories join the session of a
+// session context.
+func WithTx(ctx context.Context, tx *gorm.DB) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// Conn returns the transaction ctx carries, or db if it carries none.
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// Transaction runs fn in a transaction of db; repositories given the ctx fn
+// gets join it. Called with a ctx that already carries a transaction, fn
+// joins that one.
+func Transaction(ctx context.Context, db *gorm.DB, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(WithTx(ctx, tx))
+	})
+}
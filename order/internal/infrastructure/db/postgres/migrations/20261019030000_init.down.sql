@@ -0,0 +1,7 @@
+begin;
+
+DROP TABLE IF EXISTS deliveries;
+DROP TABLE IF EXISTS order_items;
+DROP TABLE IF EXISTS orders;
+
+end;
@@ -0,0 +1,56 @@
+begin;
+
+CREATE TABLE orders (
+    id UUID PRIMARY KEY,
+    customer_id UUID NOT NULL,
+    status TEXT NOT NULL,
+    created TIMESTAMPTZ NOT NULL,
+    version UUID NOT NULL,
+    cancel_reason TEXT NOT NULL DEFAULT '',
+    discount_code TEXT,
+    discount_amount NUMERIC,
+    discount_currency TEXT,
+    total_amount NUMERIC NOT NULL,
+    total_currency TEXT NOT NULL,
+    history JSONB NOT NULL DEFAULT '[]'
+);
+
+CREATE INDEX orders_customer_id_created_idx ON orders (customer_id, created, id);
+CREATE INDEX orders_created_idx ON orders (created, id);
+CREATE INDEX orders_status_created_idx ON orders (status, created);
+
+CREATE TABLE order_items (
+    order_id UUID NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    product_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    price_amount NUMERIC NOT NULL,
+    price_currency TEXT NOT NULL,
+    count INTEGER NOT NULL,
+    PRIMARY KEY (order_id, position)
+);
+
+CREATE INDEX order_items_product_id_idx ON order_items (product_id);
+
+CREATE TABLE deliveries (
+    order_id UUID PRIMARY KEY REFERENCES orders (id) ON DELETE CASCADE,
+    courier_id UUID,
+    address_country TEXT NOT NULL,
+    address_city TEXT NOT NULL,
+    address_street TEXT NOT NULL,
+    address_house TEXT NOT NULL,
+    address_apartment TEXT NOT NULL,
+    address_postal_code TEXT NOT NULL,
+    address_latitude DOUBLE PRECISION,
+    address_longitude DOUBLE PRECISION,
+    address_instructions TEXT NOT NULL,
+    address_legacy TEXT NOT NULL,
+    slot_start TIMESTAMPTZ,
+    slot_end TIMESTAMPTZ,
+    assigned TIMESTAMPTZ,
+    arrived TIMESTAMPTZ
+);
+
+CREATE INDEX deliveries_courier_id_idx ON deliveries (courier_id);
+
+end;
@@ -0,0 +1,6 @@
+begin;
+
+ALTER TABLE order_items DROP COLUMN IF EXISTS requested;
+ALTER TABLE orders DROP COLUMN IF EXISTS fulfillment;
+
+end;
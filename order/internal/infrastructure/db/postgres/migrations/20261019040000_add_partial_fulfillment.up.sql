@@ -0,0 +1,9 @@
+begin;
+
+ALTER TABLE orders ADD COLUMN fulfillment TEXT NOT NULL DEFAULT 'all_or_nothing';
+
+ALTER TABLE order_items ADD COLUMN requested INTEGER;
+UPDATE order_items SET requested = count;
+ALTER TABLE order_items ALTER COLUMN requested SET NOT NULL;
+
+end;
@@ -0,0 +1,10 @@
+begin;
+
+DROP TABLE IF EXISTS delivery_slots;
+DROP TABLE IF EXISTS promotion_usages;
+DROP TABLE IF EXISTS promotions;
+DROP TABLE IF EXISTS inbox_messages;
+DROP TABLE IF EXISTS outbox_messages;
+DROP TABLE IF EXISTS sagas;
+
+end;
@@ -0,0 +1,83 @@
+begin;
+
+CREATE TABLE sagas (
+    id UUID PRIMARY KEY,
+    type TEXT NOT NULL,
+    order_id UUID NOT NULL,
+    step TEXT NOT NULL,
+    step_entered TIMESTAMPTZ NOT NULL,
+    history JSONB NOT NULL DEFAULT '[]',
+    last_error_step TEXT,
+    last_error_message TEXT,
+    last_error_occurred TIMESTAMPTZ,
+    resume_at TIMESTAMPTZ,
+    lease_until TIMESTAMPTZ,
+    created TIMESTAMPTZ NOT NULL,
+    updated TIMESTAMPTZ NOT NULL,
+    version UUID NOT NULL,
+    CONSTRAINT sagas_type_order_id_key UNIQUE (type, order_id)
+);
+
+CREATE INDEX sagas_order_id_created_idx ON sagas (order_id, created);
+CREATE INDEX sagas_type_step_step_entered_idx ON sagas (type, step, step_entered);
+CREATE INDEX sagas_type_resume_at_idx ON sagas (type, resume_at) WHERE resume_at IS NOT NULL;
+
+CREATE TABLE outbox_messages (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    key TEXT,
+    payload BYTEA NOT NULL,
+    metadata JSONB NOT NULL DEFAULT '{}',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt TIMESTAMPTZ NOT NULL,
+    lease_until TIMESTAMPTZ,
+    created TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX outbox_messages_next_attempt_created_idx ON outbox_messages (next_attempt, created);
+CREATE INDEX outbox_messages_key_created_idx ON outbox_messages (key, created, id);
+
+CREATE TABLE inbox_messages (
+    id UUID PRIMARY KEY,
+    response BYTEA,
+    processed TIMESTAMPTZ NOT NULL,
+    expires TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX inbox_messages_expires_idx ON inbox_messages (expires);
+
+CREATE TABLE promotions (
+    id UUID PRIMARY KEY,
+    code TEXT NOT NULL,
+    kind TEXT NOT NULL,
+    percent INTEGER NOT NULL DEFAULT 0,
+    amount NUMERIC,
+    amount_currency TEXT,
+    min_order_value NUMERIC,
+    min_order_value_currency TEXT,
+    per_customer_limit INTEGER NOT NULL CHECK (per_customer_limit >= 0),
+    valid_from TIMESTAMPTZ,
+    valid_to TIMESTAMPTZ,
+    product_ids JSONB NOT NULL DEFAULT '[]',
+    active BOOLEAN NOT NULL,
+    created TIMESTAMPTZ NOT NULL,
+    version UUID NOT NULL,
+    CONSTRAINT promotions_code_key UNIQUE (code)
+);
+
+CREATE INDEX promotions_created_idx ON promotions (created DESC, id);
+
+CREATE TABLE promotion_usages (
+    promotion_id UUID NOT NULL,
+    customer_id UUID NOT NULL,
+    uses INTEGER NOT NULL CHECK (uses >= 1),
+    PRIMARY KEY (promotion_id, customer_id)
+);
+
+CREATE TABLE delivery_slots (
+    start TIMESTAMPTZ PRIMARY KEY,
+    "end" TIMESTAMPTZ NOT NULL,
+    reserved INTEGER NOT NULL CHECK (reserved >= 0)
+);
+
+end;
@@ -0,0 +1,6 @@
+begin;
+
+ALTER TABLE outbox_messages DROP COLUMN IF EXISTS error;
+ALTER TABLE outbox_messages DROP COLUMN IF EXISTS failed;
+
+end;
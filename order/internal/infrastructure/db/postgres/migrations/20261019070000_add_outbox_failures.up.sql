@@ -0,0 +1,6 @@
+begin;
+
+ALTER TABLE outbox_messages ADD COLUMN failed TIMESTAMPTZ;
+ALTER TABLE outbox_messages ADD COLUMN error TEXT;
+
+end;
@@ -0,0 +1,5 @@
+begin;
+
+DROP INDEX IF EXISTS orders_history_idx;
+
+end;
@@ -0,0 +1,5 @@
+begin;
+
+CREATE INDEX orders_history_idx ON orders USING gin (history jsonb_path_ops);
+
+end;
@@ -0,0 +1,26 @@
+package tables
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Delivery struct {
+	OrderID             uuid.UUID `gorm:"primaryKey"`
+	CourierID           *uuid.UUID
+	AddressCountry      string
+	AddressCity         string
+	AddressStreet       string
+	AddressHouse        string
+	AddressApartment    string
+	AddressPostalCode   string
+	AddressLatitude     *float64
+	AddressLongitude    *float64
+	AddressInstructions string
+	AddressLegacy       string
+	SlotStart           *time.Time
+	SlotEnd             *time.Time
+	Assigned            *time.Time
+	Arrived             *time.Time
+}
@@ -0,0 +1,11 @@
+package tables
+
+import "time"
+
+// DeliverySlot counts the orders booked into one slot. It is keyed by the
+// slot start.
+type DeliverySlot struct {
+	Start    time.Time `gorm:"primaryKey"`
+	End      time.Time
+	Reserved int
+}
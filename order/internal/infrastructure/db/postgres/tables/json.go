@@ -0,0 +1,18 @@
+package tables
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// scanJSON decodes a JSONB column into dst.
+func scanJSON(value any, dst any, what string) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, dst)
+	case string:
+		return json.Unmarshal([]byte(v), dst)
+	default:
+		return fmt.Errorf("cannot scan %s", what)
+	}
+}
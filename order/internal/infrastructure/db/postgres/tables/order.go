@@ -0,0 +1,30 @@
+package tables
+
+import (
+	orderDomain "order/internal/domain/order"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Order is a row of orders. Items and Delivery live in their own tables and
+// are written by the repository, not through gorm associations.
+type Order struct {
+	ID               uuid.UUID `gorm:"primaryKey"`
+	CustomerID       uuid.UUID
+	Status           orderDomain.Status
+	Created          time.Time
+	Version          uuid.UUID
+	CancelReason     string
+	Fulfillment      orderDomain.FulfillmentPolicy
+	DiscountCode     *string
+	DiscountAmount   decimal.NullDecimal
+	DiscountCurrency *string
+	TotalAmount      decimal.Decimal
+	TotalCurrency    string
+	History          StatusChanges `gorm:"type:jsonb"`
+
+	Items    []OrderItem `gorm:"foreignKey:OrderID"`
+	Delivery *Delivery   `gorm:"foreignKey:OrderID"`
+}
@@ -0,0 +1,19 @@
+package tables
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// OrderItem is a row of order_items. Position keeps the items in the order
+// they were placed in.
+type OrderItem struct {
+	OrderID       uuid.UUID `gorm:"primaryKey"`
+	Position      int       `gorm:"primaryKey"`
+	ProductID     uuid.UUID
+	Name          string
+	PriceAmount   decimal.Decimal
+	PriceCurrency string
+	Count         int
+	Requested     int
+}
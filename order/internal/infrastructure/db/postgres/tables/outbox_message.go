@@ -0,0 +1,44 @@
+package tables
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxMessage is a row of outbox_messages. A message without a key has a
+// NULL key. LeaseUntil is only written by the claims and cleared by every
+// update.
+type OutboxMessage struct {
+	ID          uuid.UUID `gorm:"primaryKey"`
+	Name        string
+	Key         *string
+	Payload     []byte
+	Metadata    Metadata `gorm:"type:jsonb"`
+	Attempts    int
+	NextAttempt time.Time
+	LeaseUntil  *time.Time
+	Created     time.Time
+	Failed      *time.Time
+	Error       *string
+}
+
+// Metadata is the metadata of an outbox message, stored as a JSONB object.
+type Metadata map[string]any
+
+func (m Metadata) Value() (driver.Value, error) {
+	if m == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (m *Metadata) Scan(value any) error {
+	return scanJSON(value, m, "outbox metadata")
+}
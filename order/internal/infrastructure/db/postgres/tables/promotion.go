@@ -0,0 +1,57 @@
+package tables
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	promotionDomain "order/internal/domain/promotion"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Promotion is a row of promotions. Amount is set for fixed amount
+// promotions only.
+type Promotion struct {
+	ID                    uuid.UUID `gorm:"primaryKey"`
+	Code                  string
+	Kind                  promotionDomain.Kind
+	Percent               int
+	Amount                decimal.NullDecimal
+	AmountCurrency        *string
+	MinOrderValue         decimal.NullDecimal
+	MinOrderValueCurrency *string
+	PerCustomerLimit      int
+	ValidFrom             *time.Time
+	ValidTo               *time.Time
+	ProductIDs            ProductIDs `gorm:"column:product_ids;type:jsonb"`
+	Active                bool
+	Created               time.Time
+	Version               uuid.UUID
+}
+
+// ProductIDs are the products a promotion is restricted to, stored as a JSONB
+// array.
+type ProductIDs []uuid.UUID
+
+func (p ProductIDs) Value() (driver.Value, error) {
+	if p == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (p *ProductIDs) Scan(value any) error {
+	return scanJSON(value, p, "promotion products")
+}
+
+// PromotionUsage counts the orders one customer placed with a promotion.
+type PromotionUsage struct {
+	PromotionID uuid.UUID `gorm:"primaryKey"`
+	CustomerID  uuid.UUID `gorm:"primaryKey"`
+	Uses        int
+}
@@ -0,0 +1,55 @@
+package tables
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	sagaDomain "order/internal/domain/saga"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Saga is a row of sagas. StepEntered denormalizes the time the current step
+// was entered, so stalled sagas can be found through an index. The LastError
+// columns are all set or all NULL. LeaseUntil is only written by the claims and
+// cleared by every update.
+type Saga struct {
+	ID                uuid.UUID `gorm:"primaryKey"`
+	Type              sagaDomain.Type
+	OrderID           uuid.UUID
+	Step              sagaDomain.Step
+	StepEntered       time.Time
+	History           SagaSteps `gorm:"type:jsonb"`
+	LastErrorStep     *sagaDomain.Step
+	LastErrorMessage  *string
+	LastErrorOccurred *time.Time
+	ResumeAt          *time.Time
+	LeaseUntil        *time.Time
+	Created           time.Time
+	Updated           time.Time
+	Version           uuid.UUID
+}
+
+// SagaSteps is the step history of a saga, stored as a JSONB array.
+type SagaSteps []SagaStep
+
+type SagaStep struct {
+	Step    sagaDomain.Step `json:"step"`
+	Entered time.Time       `json:"entered"`
+	Retry   bool            `json:"retry,omitempty"`
+}
+
+func (s SagaSteps) Value() (driver.Value, error) {
+	if s == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (s *SagaSteps) Scan(value any) error {
+	return scanJSON(value, s, "saga history")
+}
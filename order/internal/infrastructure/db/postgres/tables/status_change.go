@@ -0,0 +1,43 @@
+package tables
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	orderDomain "order/internal/domain/order"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusChanges is the status history of an order, stored as a JSONB array.
+type StatusChanges []StatusChange
+
+type StatusChange struct {
+	From      orderDomain.Status `json:"from,omitempty"`
+	To        orderDomain.Status `json:"to"`
+	Occurred  time.Time          `json:"occurred"`
+	Actor     Actor              `json:"actor"`
+	Reason    string             `json:"reason,omitempty"`
+	MessageID *uuid.UUID         `json:"message_id,omitempty"`
+	CourierID *uuid.UUID         `json:"courier_id,omitempty"`
+}
+
+type Actor struct {
+	Type orderDomain.ActorType `json:"type"`
+	ID   *uuid.UUID            `json:"id,omitempty"`
+}
+
+func (s StatusChanges) Value() (driver.Value, error) {
+	if s == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (s *StatusChanges) Scan(value any) error {
+	return scanJSON(value, s, "status history")
+}
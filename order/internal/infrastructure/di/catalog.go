@@ -0,0 +1,22 @@
+package di
+
+import (
+	orderUsecase "order/internal/application/order/usecase"
+	"order/internal/infrastructure/catalog"
+
+	"go.uber.org/fx"
+)
+
+var CatalogModule = fx.Provide(
+	// Config
+	catalog.NewConfig,
+
+	// Warehouse client
+	catalog.NewClient,
+
+	// Catalog
+	fx.Annotate(
+		catalog.New,
+		fx.As(new(orderUsecase.Catalog)),
+	),
+)
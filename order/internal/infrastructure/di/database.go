@@ -2,20 +2,122 @@ package di
 
 import (
 	"order/internal/infrastructure/db"
+	"order/internal/infrastructure/db/postgres"
+	"order/internal/infrastructure/memory"
 
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/fx"
+	"gorm.io/gorm"
 )
 
 var DatabaseModule = fx.Provide(
+	// In-memory configuration
+	memory.NewConfig,
+
 	// Database configuration
-	db.NewConfig,
+	newDBConfig,
 
 	// Database connection
-	db.NewConnection,
+	newConnection,
 
 	// Database
-	db.NewDB,
+	newDB,
 
 	// Order collection
-	db.NewOrderCollection,
+	fx.Annotate(
+		mongoCollection(db.NewOrderCollection),
+		fx.ResultTags(`name:"orderCollection"`),
+	),
+
+	// Saga collection
+	fx.Annotate(
+		mongoCollection(db.NewSagaCollection),
+		fx.ResultTags(`name:"sagaCollection"`),
+	),
+
+	// Outbox collection
+	fx.Annotate(
+		mongoCollection(db.NewOutboxCollection),
+		fx.ResultTags(`name:"outboxCollection"`),
+	),
+
+	// Inbox collection
+	fx.Annotate(
+		mongoCollection(db.NewInboxCollection),
+		fx.ResultTags(`name:"inboxCollection"`),
+	),
+
+	// Promotion collections
+	fx.Annotate(
+		mongoCollection(db.NewPromotionCollection),
+		fx.ResultTags(`name:"promotionCollection"`),
+	),
+	fx.Annotate(
+		mongoCollection(db.NewPromotionUsageCollection),
+		fx.ResultTags(`name:"promotionUsageCollection"`),
+	),
+
+	// Delivery slot collection
+	fx.Annotate(
+		mongoCollection(db.NewDeliverySlotCollection),
+		fx.ResultTags(`name:"deliverySlotCollection"`),
+	),
+
+	// PostgreSQL, when it stores the orders
+	newPostgresDB,
+
+	// In-memory store, used when DB_ORDER_BACKEND is memory or IN_MEMORY is set
+	memory.NewStore,
 )
+
+// newDBConfig loads the DB_* settings unless IN_MEMORY is set, in which case
+// none are needed and the orders are kept in memory like everything else.
+func newDBConfig(memCfg *memory.Config) (*db.Config, error) {
+	if memCfg.Enabled {
+		return &db.Config{OrderBackend: db.BackendMemory}, nil
+	}
+	return db.NewConfig()
+}
+
+// newConnection connects to MongoDB only when DB_ORDER_BACKEND selects it,
+// which IN_MEMORY never does. It returns nil otherwise, as do newDB and the
+// collection constructors after it.
+func newConnection(cfg *db.Config) (*mongo.Client, error) {
+	if cfg.OrderBackend != db.BackendMongo {
+		return nil, nil
+	}
+	return db.NewConnection(cfg)
+}
+
+func newDB(cfg *db.Config, client *mongo.Client) *mongo.Database {
+	if client == nil {
+		return nil
+	}
+	return db.NewDB(cfg, client)
+}
+
+func mongoCollection(
+	newCollection func(*mongo.Database, *db.Config) *mongo.Collection,
+) func(*mongo.Database, *db.Config) *mongo.Collection {
+	return func(database *mongo.Database, cfg *db.Config) *mongo.Collection {
+		if database == nil {
+			return nil
+		}
+		return newCollection(database, cfg)
+	}
+}
+
+// newPostgresDB connects to PostgreSQL only when DB_ORDER_BACKEND selects it,
+// so deployments on MongoDB need no POSTGRES_* settings. It returns nil
+// otherwise.
+func newPostgresDB(cfg *db.Config) (*gorm.DB, error) {
+	if cfg.OrderBackend != db.BackendPostgres {
+		return nil, nil
+	}
+
+	pgCfg, err := postgres.NewConfig()
+	if err != nil {
+		return nil, err
+	}
+	return postgres.NewDB(pgCfg)
+}
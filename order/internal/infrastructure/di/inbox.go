@@ -0,0 +1,35 @@
+package di
+
+import (
+	"context"
+	"order/internal/infrastructure/inbox"
+	"order/internal/infrastructure/logger"
+
+	"go.uber.org/fx"
+)
+
+var InboxModule = fx.Options(
+	fx.Provide(
+		// Inbox configuration
+		inbox.NewConfig,
+
+		// Inbox cleaner
+		inbox.NewCleaner,
+	),
+
+	// Lifecycle
+	fx.Invoke(setupInboxCleaner),
+)
+
+func setupInboxCleaner(lc fx.Lifecycle, cleaner *inbox.Cleaner, logger logger.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Println("Starting inbox cleaner...")
+			return cleaner.Start(ctx)
+		},
+		OnStop: func(ctx context.Context) error {
+			logger.Println("Stopping inbox cleaner...")
+			return cleaner.Stop()
+		},
+	})
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"order/internal/infrastructure/logger"
+	"order/internal/infrastructure/memory"
 	"order/internal/infrastructure/messaging"
+	"order/internal/infrastructure/messaging/commit"
+	"order/internal/infrastructure/messaging/retry"
+	"order/internal/infrastructure/messaging/worker"
 
-	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
-
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/fx"
 )
 
@@ -16,6 +19,15 @@ var MessagingModule = fx.Options(
 		// General Kafka configuration
 		messaging.NewConfig,
 
+		// In-memory broker, used when IN_MEMORY is set
+		fx.Annotate(
+			memory.NewBroker,
+			fx.As(new(memory.Bus)),
+		),
+
+		// Transport the readers and writers are opened on
+		newTransport,
+
 		// Message readers
 		fx.Annotate(
 			messaging.NewOrderCommandReader,
@@ -47,12 +59,53 @@ var MessagingModule = fx.Options(
 			messaging.NewOrderCommandResWriter,
 			fx.ResultTags(`name:"orderCommandResWriter"`),
 		),
+		fx.Annotate(
+			messaging.NewOrderEventWriter,
+			fx.ResultTags(`name:"orderEventWriter"`),
+		),
+
+		// Dead-letter writers
+		fx.Annotate(
+			messaging.NewOrderCommandDLQWriter,
+			fx.ResultTags(`name:"orderCommandDLQWriter"`),
+		),
+		fx.Annotate(
+			messaging.NewWarehouseCommandResultDLQWriter,
+			fx.ResultTags(`name:"warehouseCommandResultDLQWriter"`),
+		),
+		fx.Annotate(
+			messaging.NewCourierCommandResultDLQWriter,
+			fx.ResultTags(`name:"courierCommandResultDLQWriter"`),
+		),
+
+		// Retry configuration
+		retry.NewConfig,
+
+		// Offset commit configuration
+		commit.NewConfig,
+
+		// Consumer worker pool configuration
+		worker.NewConfig,
 	),
 
 	// Kafka resources lifecycle management
 	fx.Invoke(setupMessagingLifecycle),
 )
 
+// newTransport opens the readers and writers on Kafka, or on the in-memory
+// broker when IN_MEMORY is set, in which case KAFKA_ADDRESS is not needed.
+func newTransport(
+	memCfg *memory.Config,
+	cfg *messaging.Config,
+	tp *sdktrace.TracerProvider,
+	bus memory.Bus,
+) (messaging.Transport, error) {
+	if memCfg.Enabled {
+		return memory.NewTransport(bus), nil
+	}
+	return messaging.NewKafkaTransport(cfg, tp)
+}
+
 // setupMessagingLifecycle configures centralized closing of Kafka resources
 func setupMessagingLifecycle(in struct {
 	fx.In
@@ -61,15 +114,21 @@ func setupMessagingLifecycle(in struct {
 	Logger    logger.Logger
 
 	// Readers
-	OrderCommandReader        *otelkafkakonsumer.Reader `name:"orderCommandReader"`
-	WarehouseCommandResReader *otelkafkakonsumer.Reader `name:"warehouseCommandResultReader"`
-	CourierCommandResReader   *otelkafkakonsumer.Reader `name:"courierCommandResultReader"`
+	OrderCommandReader        messaging.Reader `name:"orderCommandReader"`
+	WarehouseCommandResReader messaging.Reader `name:"warehouseCommandResultReader"`
+	CourierCommandResReader   messaging.Reader `name:"courierCommandResultReader"`
 
 	// Writers
-	OrderCommandWriter     *otelkafkakonsumer.Writer `name:"orderCommandWriter"`
-	WarehouseCommandWriter *otelkafkakonsumer.Writer `name:"warehouseCommandWriter"`
-	CourierCommandWriter   *otelkafkakonsumer.Writer `name:"courierCommandWriter"`
-	OrderCommandResWriter  *otelkafkakonsumer.Writer `name:"orderCommandResWriter"`
+	OrderCommandWriter     messaging.Writer `name:"orderCommandWriter"`
+	WarehouseCommandWriter messaging.Writer `name:"warehouseCommandWriter"`
+	CourierCommandWriter   messaging.Writer `name:"courierCommandWriter"`
+	OrderCommandResWriter  messaging.Writer `name:"orderCommandResWriter"`
+	OrderEventWriter       messaging.Writer `name:"orderEventWriter"`
+
+	// Dead-letter writers
+	OrderCommandDLQWriter           messaging.Writer `name:"orderCommandDLQWriter"`
+	WarehouseCommandResultDLQWriter messaging.Writer `name:"warehouseCommandResultDLQWriter"`
+	CourierCommandResultDLQWriter   messaging.Writer `name:"courierCommandResultDLQWriter"`
 }) {
 	in.Lifecycle.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
@@ -105,6 +164,18 @@ func setupMessagingLifecycle(in struct {
 			if err := closeWriter("order command response writer", in.OrderCommandResWriter, in.Logger); err != nil {
 				hasErrors = true
 			}
+			if err := closeWriter("order event writer", in.OrderEventWriter, in.Logger); err != nil {
+				hasErrors = true
+			}
+			if err := closeWriter("order command dlq writer", in.OrderCommandDLQWriter, in.Logger); err != nil {
+				hasErrors = true
+			}
+			if err := closeWriter("warehouse command result dlq writer", in.WarehouseCommandResultDLQWriter, in.Logger); err != nil {
+				hasErrors = true
+			}
+			if err := closeWriter("courier command result dlq writer", in.CourierCommandResultDLQWriter, in.Logger); err != nil {
+				hasErrors = true
+			}
 
 			if hasErrors {
 				return fmt.Errorf("errors occurred while closing Kafka resources")
@@ -115,7 +186,7 @@ func setupMessagingLifecycle(in struct {
 	})
 }
 
-func closeReader(name string, reader *otelkafkakonsumer.Reader, logger logger.Logger) error {
+func closeReader(name string, reader messaging.Reader, logger logger.Logger) error {
 	if reader == nil {
 		return nil
 	}
@@ -128,7 +199,7 @@ func closeReader(name string, reader *otelkafkakonsumer.Reader, logger logger.Lo
 	return nil
 }
 
-func closeWriter(name string, writer *otelkafkakonsumer.Writer, logger logger.Logger) error {
+func closeWriter(name string, writer messaging.Writer, logger logger.Logger) error {
 	if writer == nil {
 		return nil
 	}
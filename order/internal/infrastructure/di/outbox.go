@@ -0,0 +1,32 @@
+package di
+
+import (
+	"context"
+	"order/internal/infrastructure/logger"
+	outboxProcessor "order/internal/infrastructure/outbox"
+
+	"go.uber.org/fx"
+)
+
+var OutboxProcessorModule = fx.Options(
+	fx.Provide(
+		// Outbox processor
+		outboxProcessor.NewProcessor,
+	),
+
+	// Lifecycle
+	fx.Invoke(setupOutboxProcessor),
+)
+
+func setupOutboxProcessor(lc fx.Lifecycle, processor *outboxProcessor.Processor, logger logger.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Println("Starting outbox processor...")
+			return processor.Start(ctx)
+		},
+		OnStop: func(ctx context.Context) error {
+			logger.Println("Stopping outbox processor...")
+			return processor.Stop()
+		},
+	})
+}
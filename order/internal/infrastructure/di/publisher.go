@@ -1,17 +1,17 @@
 package di
 
 import (
-	createOrder "order/internal/application/order/saga/create_order"
-	createOrderPublisher "order/internal/infrastructure/publisher/saga/create_order"
+	"order/internal/domain/outbox"
+	outboxPublisher "order/internal/infrastructure/publisher/outbox"
 
 	"go.uber.org/fx"
 )
 
 var PublisherModule = fx.Provide(
-	// Saga publishers
+	// Outbox publisher
 	fx.Annotate(
-		createOrderPublisher.NewPublisher,
-		fx.ParamTags(`name:"warehouseCommandWriter"`, `name:"orderCommandWriter"`, `name:"courierCommandWriter"`),
-		fx.As(new(createOrder.Publisher)),
+		outboxPublisher.NewPublisher,
+		fx.ParamTags(`name:"warehouseCommandWriter"`, `name:"orderCommandWriter"`, `name:"courierCommandWriter"`, `name:"orderEventWriter"`),
+		fx.As(new(outbox.Publisher)),
 	),
 )
@@ -1,16 +1,194 @@
 package di
 
 import (
+	"order/internal/domain/analytics"
+	"order/internal/domain/inbox"
 	"order/internal/domain/order"
+	"order/internal/domain/outbox"
+	"order/internal/domain/promotion"
+	"order/internal/domain/saga"
+	"order/internal/domain/slot"
+	"order/internal/infrastructure/db"
+	"order/internal/infrastructure/memory"
+	analyticsRepository "order/internal/infrastructure/repository/analytics"
+	analyticsPostgres "order/internal/infrastructure/repository/analytics/postgres"
+	inboxRepository "order/internal/infrastructure/repository/inbox"
+	inboxPostgres "order/internal/infrastructure/repository/inbox/postgres"
 	orderRepository "order/internal/infrastructure/repository/order"
+	orderPostgres "order/internal/infrastructure/repository/order/postgres"
+	outboxRepository "order/internal/infrastructure/repository/outbox"
+	outboxPostgres "order/internal/infrastructure/repository/outbox/postgres"
+	promotionRepository "order/internal/infrastructure/repository/promotion"
+	promotionPostgres "order/internal/infrastructure/repository/promotion/postgres"
+	sagaRepository "order/internal/infrastructure/repository/saga"
+	sagaPostgres "order/internal/infrastructure/repository/saga/postgres"
+	slotRepository "order/internal/infrastructure/repository/slot"
+	slotPostgres "order/internal/infrastructure/repository/slot/postgres"
 
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/fx"
+	"gorm.io/gorm"
 )
 
 var RepositoryModule = fx.Provide(
 	// Order repository
 	fx.Annotate(
-		orderRepository.New,
-		fx.As(new(order.Repository)),
+		newOrderRepository,
+		fx.ParamTags(``, `name:"orderCollection"`),
+	),
+
+	// Saga repository
+	fx.Annotate(
+		newSagaRepository,
+		fx.ParamTags(``, `name:"sagaCollection"`),
+	),
+
+	// Outbox repository
+	fx.Annotate(
+		newOutboxRepository,
+		fx.ParamTags(``, `name:"outboxCollection"`),
+	),
+
+	// Inbox repository
+	fx.Annotate(
+		newInboxRepository,
+		fx.ParamTags(``, `name:"inboxCollection"`),
+	),
+
+	// Promotion repository
+	fx.Annotate(
+		newPromotionRepository,
+		fx.ParamTags(``, `name:"promotionCollection"`, `name:"promotionUsageCollection"`),
+	),
+
+	// Delivery slot repository
+	fx.Annotate(
+		newSlotRepository,
+		fx.ParamTags(``, `name:"deliverySlotCollection"`),
+	),
+
+	// Order analytics repository
+	fx.Annotate(
+		newAnalyticsRepository,
+		fx.ParamTags(``, `name:"orderCollection"`),
 	),
 )
+
+// newOrderRepository stores the orders in the database DB_ORDER_BACKEND selects.
+func newOrderRepository(
+	cfg *db.Config,
+	orderCollection *mongo.Collection,
+	postgresDB *gorm.DB,
+	store *memory.Store,
+) order.Repository {
+	switch cfg.OrderBackend {
+	case db.BackendPostgres:
+		return orderPostgres.New(postgresDB)
+	case db.BackendMemory:
+		return memory.NewOrderRepository(store)
+	default:
+		return orderRepository.New(orderCollection)
+	}
+}
+
+// newAnalyticsRepository reports on the orders where newOrderRepository stores them.
+func newAnalyticsRepository(
+	cfg *db.Config,
+	orderCollection *mongo.Collection,
+	postgresDB *gorm.DB,
+	store *memory.Store,
+) analytics.Repository {
+	switch cfg.OrderBackend {
+	case db.BackendPostgres:
+		return analyticsPostgres.New(postgresDB)
+	case db.BackendMemory:
+		return memory.NewAnalyticsRepository(store)
+	default:
+		return analyticsRepository.New(orderCollection)
+	}
+}
+
+// newSagaRepository keeps the sagas where newOrderRepository stores the orders.
+func newSagaRepository(
+	cfg *db.Config,
+	collection *mongo.Collection,
+	postgresDB *gorm.DB,
+	store *memory.Store,
+) saga.Repository {
+	switch cfg.OrderBackend {
+	case db.BackendPostgres:
+		return sagaPostgres.New(postgresDB)
+	case db.BackendMemory:
+		return memory.NewSagaRepository(store)
+	default:
+		return sagaRepository.New(collection)
+	}
+}
+
+// newOutboxRepository keeps the outbox where newOrderRepository stores the orders.
+func newOutboxRepository(
+	cfg *db.Config,
+	collection *mongo.Collection,
+	postgresDB *gorm.DB,
+	store *memory.Store,
+) outbox.Repository {
+	switch cfg.OrderBackend {
+	case db.BackendPostgres:
+		return outboxPostgres.New(postgresDB)
+	case db.BackendMemory:
+		return memory.NewOutboxRepository(store)
+	default:
+		return outboxRepository.New(collection)
+	}
+}
+
+// newInboxRepository keeps the inbox where newOrderRepository stores the orders.
+func newInboxRepository(
+	cfg *db.Config,
+	collection *mongo.Collection,
+	postgresDB *gorm.DB,
+	store *memory.Store,
+) inbox.Repository {
+	switch cfg.OrderBackend {
+	case db.BackendPostgres:
+		return inboxPostgres.New(postgresDB)
+	case db.BackendMemory:
+		return memory.NewInboxRepository(store)
+	default:
+		return inboxRepository.New(collection)
+	}
+}
+
+// newPromotionRepository keeps the promotions where newOrderRepository stores the orders.
+func newPromotionRepository(
+	cfg *db.Config,
+	collection, usageCollection *mongo.Collection,
+	postgresDB *gorm.DB,
+	store *memory.Store,
+) promotion.Repository {
+	switch cfg.OrderBackend {
+	case db.BackendPostgres:
+		return promotionPostgres.New(postgresDB)
+	case db.BackendMemory:
+		return memory.NewPromotionRepository(store)
+	default:
+		return promotionRepository.New(collection, usageCollection)
+	}
+}
+
+// newSlotRepository keeps the delivery slots where newOrderRepository stores the orders.
+func newSlotRepository(
+	cfg *db.Config,
+	collection *mongo.Collection,
+	postgresDB *gorm.DB,
+	store *memory.Store,
+) slot.Repository {
+	switch cfg.OrderBackend {
+	case db.BackendPostgres:
+		return slotPostgres.New(postgresDB)
+	case db.BackendMemory:
+		return memory.NewSlotRepository(store)
+	default:
+		return slotRepository.New(collection)
+	}
+}
@@ -0,0 +1,60 @@
+package di
+
+import (
+	"order/internal/domain/uow"
+	"order/internal/infrastructure/db"
+	"order/internal/infrastructure/memory"
+	uowImpl "order/internal/infrastructure/uow"
+	uowPostgres "order/internal/infrastructure/uow/postgres"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/fx"
+	"gorm.io/gorm"
+)
+
+var UowModule = fx.Provide(
+	// UoW
+	fx.Annotate(
+		newUoW,
+		fx.ParamTags(
+			``,
+			``,
+			``,
+			`name:"orderCollection"`,
+			`name:"sagaCollection"`,
+			`name:"outboxCollection"`,
+			`name:"promotionCollection"`,
+			`name:"promotionUsageCollection"`,
+			`name:"deliverySlotCollection"`,
+			`name:"inboxCollection"`,
+		),
+	),
+)
+
+// newUoW runs transactions of the database DB_ORDER_BACKEND selects, which
+// keeps every store of the unit of work, so they all commit at once.
+func newUoW(
+	cfg *db.Config,
+	postgresDB *gorm.DB,
+	store *memory.Store,
+	orderCollection, sagaCollection, outboxCollection *mongo.Collection,
+	promotionCollection, promotionUsageCollection *mongo.Collection,
+	deliverySlotCollection, inboxCollection *mongo.Collection,
+) uow.UoW {
+	switch cfg.OrderBackend {
+	case db.BackendPostgres:
+		return uowPostgres.New(postgresDB)
+	case db.BackendMemory:
+		return memory.NewUoW(store)
+	default:
+		return uowImpl.New(
+			orderCollection,
+			sagaCollection,
+			outboxCollection,
+			promotionCollection,
+			promotionUsageCollection,
+			deliverySlotCollection,
+			inboxCollection,
+		)
+	}
+}
@@ -0,0 +1,154 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	analyticsDomain "order/internal/domain/analytics"
+	orderDomain "order/internal/domain/order"
+
+	"github.com/shopspring/decimal"
+)
+
+// AnalyticsRepository computes the reports over the orders of a Store. It
+// only reads, so it is not part of the unit of work.
+type AnalyticsRepository struct {
+	orders *OrderRepository
+}
+
+func NewAnalyticsRepository(store *Store) *AnalyticsRepository {
+	return &AnalyticsRepository{orders: NewOrderRepository(store)}
+}
+
+// GetStatusFunnel counts the orders by current status and by every status
+// recorded in their history. Every order counts as having reached Created.
+func (r *AnalyticsRepository) GetStatusFunnel(ctx context.Context, period analyticsDomain.Period) (*analyticsDomain.StatusFunnel, error) {
+	funnel := &analyticsDomain.StatusFunnel{
+		Current: make(orderDomain.StatusCounts),
+		Reached: make(orderDomain.StatusCounts),
+	}
+	for _, order := range r.created(ctx, period) {
+		funnel.Total++
+		funnel.Current[order.Status]++
+
+		reached := map[orderDomain.Status]struct{}{order.Status: {}, orderDomain.Created: {}}
+		for _, change := range order.History {
+			reached[change.To] = struct{}{}
+		}
+		for status := range reached {
+			funnel.Reached[status]++
+		}
+	}
+	return funnel, nil
+}
+
+// GetRevenue sums the totals of delivered orders by bucket and currency.
+func (r *AnalyticsRepository) GetRevenue(
+	ctx context.Context,
+	period analyticsDomain.Period,
+	granularity analyticsDomain.Granularity,
+) ([]analyticsDomain.RevenueBucket, error) {
+	type key struct {
+		start    time.Time
+		currency string
+	}
+	type sum struct {
+		revenue decimal.Decimal
+		orders  int
+	}
+
+	sums := make(map[key]*sum)
+	for _, order := range r.created(ctx, period) {
+		if order.Status != orderDomain.Delivered {
+			continue
+		}
+
+		total := order.Total()
+		k := key{start: truncate(order.Created, granularity), currency: total.Currency}
+		if sums[k] == nil {
+			sums[k] = &sum{revenue: decimal.Zero}
+		}
+		sums[k].revenue = sums[k].revenue.Add(total.Amount)
+		sums[k].orders++
+	}
+
+	keys := make([]key, 0, len(sums))
+	for k := range sums {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b key) int {
+		if c := a.start.Compare(b.start); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.currency, b.currency)
+	})
+
+	buckets := make([]analyticsDomain.RevenueBucket, 0, len(keys))
+	for _, k := range keys {
+		revenue, err := orderDomain.NewMoney(sums[k].revenue, k.currency)
+		if err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, analyticsDomain.RevenueBucket{
+			Start:   k.start,
+			Revenue: revenue,
+			Orders:  sums[k].orders,
+		})
+	}
+	return buckets, nil
+}
+
+func (r *AnalyticsRepository) GetDeliveryTime(ctx context.Context, period analyticsDomain.Period) (*analyticsDomain.DeliveryTime, error) {
+	var (
+		orders int
+		total  time.Duration
+	)
+	for _, order := range r.created(ctx, period) {
+		if order.Status != orderDomain.Delivered || order.Delivery.Arrived == nil {
+			continue
+		}
+		orders++
+		total += order.Delivery.Arrived.Sub(order.Created)
+	}
+
+	delivery := &analyticsDomain.DeliveryTime{Orders: orders}
+	if orders > 0 {
+		delivery.Average = total / time.Duration(orders)
+	}
+	return delivery, nil
+}
+
+func (r *AnalyticsRepository) GetCancellationReasons(
+	ctx context.Context,
+	period analyticsDomain.Period,
+) (analyticsDomain.CancellationReasons, error) {
+	reasons := make(analyticsDomain.CancellationReasons)
+	for _, order := range r.created(ctx, period) {
+		if reason, ok := orderDomain.CancelReasonOf(order.Status); ok {
+			reasons[reason]++
+		}
+	}
+	return reasons, nil
+}
+
+func (r *AnalyticsRepository) created(ctx context.Context, period analyticsDomain.Period) []*orderDomain.Order {
+	return r.orders.filter(ctx, func(o *orderDomain.Order) bool {
+		return createdBetween(o, period.From, period.To)
+	})
+}
+
+// truncate returns the start of the UTC day or week, starting on Monday, the
+// time falls in.
+func truncate(t time.Time, granularity analyticsDomain.Granularity) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if granularity == analyticsDomain.Week {
+		sinceMonday := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -sinceMonday)
+	}
+	return day
+}
+
+var _ analyticsDomain.Repository = (*AnalyticsRepository)(nil)
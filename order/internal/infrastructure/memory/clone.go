@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"maps"
+	inboxDomain "order/internal/domain/inbox"
+	orderDomain "order/internal/domain/order"
+	outboxDomain "order/internal/domain/outbox"
+	promotionDomain "order/internal/domain/promotion"
+	sagaDomain "order/internal/domain/saga"
+	"slices"
+)
+
+// cloneOrder copies everything the order points to, so the store and its
+// callers never share state. Raised events are not kept.
+func cloneOrder(o *orderDomain.Order) *orderDomain.Order {
+	c := &orderDomain.Order{
+		ID:           o.ID,
+		CustomerID:   o.CustomerID,
+		Status:       o.Status,
+		Created:      o.Created,
+		Version:      o.Version,
+		Delivery:     cloneDelivery(o.Delivery),
+		Items:        slices.Clone(o.Items),
+		CancelReason: o.CancelReason,
+		Fulfillment:  o.Fulfillment,
+		History:      make([]orderDomain.StatusChange, 0, len(o.History)),
+	}
+	if c.Items == nil {
+		c.Items = []orderDomain.Item{}
+	}
+	if o.Discount != nil {
+		discount := *o.Discount
+		c.Discount = &discount
+	}
+	for _, change := range o.History {
+		change.Actor.ID = clonePtr(change.Actor.ID)
+		change.MessageID = clonePtr(change.MessageID)
+		change.CourierID = clonePtr(change.CourierID)
+		c.History = append(c.History, change)
+	}
+	return c
+}
+
+func cloneDelivery(d orderDomain.Delivery) orderDomain.Delivery {
+	d.CourierID = clonePtr(d.CourierID)
+	d.Address.Location = clonePtr(d.Address.Location)
+	d.Slot = clonePtr(d.Slot)
+	d.Assigned = clonePtr(d.Assigned)
+	d.Arrived = clonePtr(d.Arrived)
+	return d
+}
+
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func cloneSaga(s *sagaDomain.Saga) *sagaDomain.Saga {
+	c := *s
+	c.History = slices.Clone(s.History)
+	c.LastError = clonePtr(s.LastError)
+	c.ResumeAt = clonePtr(s.ResumeAt)
+	return &c
+}
+
+func cloneOutboxMessage(m *outboxDomain.Message) *outboxDomain.Message {
+	c := *m
+	c.Payload = slices.Clone(m.Payload)
+	c.Metadata = maps.Clone(m.Metadata)
+	if c.Metadata == nil {
+		c.Metadata = map[string]any{}
+	}
+	c.Failed = clonePtr(m.Failed)
+	return &c
+}
+
+func cloneInboxMessage(m *inboxDomain.Message) *inboxDomain.Message {
+	c := *m
+	c.Response = slices.Clone(m.Response)
+	return &c
+}
+
+func clonePromotion(p *promotionDomain.Promotion) *promotionDomain.Promotion {
+	c := *p
+	c.Rules.MinOrderValue = clonePtr(p.Rules.MinOrderValue)
+	c.Rules.ValidFrom = clonePtr(p.Rules.ValidFrom)
+	c.Rules.ValidTo = clonePtr(p.Rules.ValidTo)
+	c.Rules.ProductIDs = slices.Clone(p.Rules.ProductIDs)
+	return &c
+}
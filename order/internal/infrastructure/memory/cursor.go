@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	orderDomain "order/internal/domain/order"
+	orderRepository "order/internal/infrastructure/repository/order"
+)
+
+// cursor points just past the last order of a page. Orders are ordered by
+// (created, id); created keeps its full precision here.
+type cursor struct {
+	Created int64  `json:"c"`
+	ID      string `json:"i"`
+}
+
+func encodeCursor(order *orderDomain.Order) string {
+	data, _ := json.Marshal(cursor{
+		Created: order.Created.UnixNano(),
+		ID:      order.ID.String(),
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(token string) (time.Time, string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, "", orderRepository.ErrInvalidCursor
+	}
+
+	var c cursor
+	if err = json.Unmarshal(data, &c); err != nil || c.ID == "" {
+		return time.Time{}, "", orderRepository.ErrInvalidCursor
+	}
+
+	return time.Unix(0, c.Created).UTC(), c.ID, nil
+}
@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	inboxDomain "order/internal/domain/inbox"
+	inboxRepository "order/internal/infrastructure/repository/inbox"
+
+	"github.com/google/uuid"
+)
+
+// InboxRepository keeps the inbox in a Store. Expired messages are kept until
+// DeleteExpired removes them.
+type InboxRepository struct {
+	store *Store
+}
+
+func NewInboxRepository(store *Store) *InboxRepository {
+	return &InboxRepository{store: store}
+}
+
+func (r *InboxRepository) Create(ctx context.Context, message *inboxDomain.Message) error {
+	return r.store.run(ctx, func(t *tables) error {
+		if _, ok := t.inbox[message.ID]; ok {
+			return inboxRepository.ErrInboxMessageAlreadyExists
+		}
+		t.inbox[message.ID] = cloneInboxMessage(message)
+		return nil
+	})
+}
+
+func (r *InboxRepository) GetByID(ctx context.Context, messageID uuid.UUID) (*inboxDomain.Message, error) {
+	var message *inboxDomain.Message
+	err := r.store.run(ctx, func(t *tables) error {
+		stored, ok := t.inbox[messageID]
+		if !ok {
+			return inboxRepository.ErrInboxMessageNotFound
+		}
+		message = cloneInboxMessage(stored)
+		return nil
+	})
+	return message, err
+}
+
+func (r *InboxRepository) DeleteExpired(ctx context.Context, now time.Time) error {
+	return r.store.run(ctx, func(t *tables) error {
+		for id, message := range t.inbox {
+			if !message.Expires.After(now) {
+				delete(t.inbox, id)
+			}
+		}
+		return nil
+	})
+}
+
+var _ inboxDomain.Repository = (*InboxRepository)(nil)
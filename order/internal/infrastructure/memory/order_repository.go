@@ -0,0 +1,225 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	orderDomain "order/internal/domain/order"
+	orderRepository "order/internal/infrastructure/repository/order"
+
+	"github.com/google/uuid"
+)
+
+// OrderRepository keeps the orders in a Store. It returns the errors of the
+// MongoDB repository, so callers cannot tell the two apart.
+type OrderRepository struct {
+	store *Store
+}
+
+func NewOrderRepository(store *Store) *OrderRepository {
+	return &OrderRepository{store: store}
+}
+
+func (r *OrderRepository) Create(ctx context.Context, order *orderDomain.Order) error {
+	return r.store.run(ctx, func(t *tables) error {
+		if _, ok := t.orders[order.ID]; ok {
+			return orderRepository.ErrOrderAlreadyExists
+		}
+		t.orders[order.ID] = cloneOrder(order)
+		return nil
+	})
+}
+
+func (r *OrderRepository) Update(ctx context.Context, order *orderDomain.Order) error {
+	return r.store.run(ctx, func(t *tables) error {
+		stored, ok := t.orders[order.ID]
+		if !ok || stored.Version != order.Version {
+			return orderRepository.ErrOrderNotFound
+		}
+		order.Version = uuid.New()
+		t.orders[order.ID] = cloneOrder(order)
+		return nil
+	})
+}
+
+func (r *OrderRepository) GetByID(ctx context.Context, orderID uuid.UUID) (*orderDomain.Order, error) {
+	var order *orderDomain.Order
+	err := r.store.run(ctx, func(t *tables) error {
+		stored, ok := t.orders[orderID]
+		if !ok {
+			return orderRepository.ErrOrderNotFound
+		}
+		order = cloneOrder(stored)
+		return nil
+	})
+	return order, err
+}
+
+func (r *OrderRepository) GetAllByCustomer(
+	ctx context.Context,
+	customerID uuid.UUID,
+	query orderDomain.ListQuery,
+) (*orderDomain.Page, error) {
+	return r.list(ctx, func(o *orderDomain.Order) bool { return o.CustomerID == customerID }, query)
+}
+
+func (r *OrderRepository) GetCurrentByCourier(ctx context.Context, courierID uuid.UUID) ([]*orderDomain.Order, error) {
+	orders := r.filter(ctx, func(o *orderDomain.Order) bool {
+		return assignedTo(o, courierID) && o.Status == orderDomain.Delivering
+	})
+
+	// Orders without an assignment time sort first, as they do in MongoDB.
+	slices.SortFunc(orders, func(a, b *orderDomain.Order) int {
+		switch {
+		case a.Delivery.Assigned == nil && b.Delivery.Assigned != nil:
+			return -1
+		case a.Delivery.Assigned != nil && b.Delivery.Assigned == nil:
+			return 1
+		case a.Delivery.Assigned != nil && b.Delivery.Assigned != nil:
+			if c := a.Delivery.Assigned.Compare(*b.Delivery.Assigned); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(a.ID.String(), b.ID.String())
+	})
+	return orders, nil
+}
+
+func (r *OrderRepository) GetHistoryByCourier(
+	ctx context.Context,
+	courierID uuid.UUID,
+	query orderDomain.ListQuery,
+) (*orderDomain.Page, error) {
+	return r.list(ctx, func(o *orderDomain.Order) bool { return deliveredBy(o, courierID) }, query)
+}
+
+func (r *OrderRepository) Search(
+	ctx context.Context,
+	filter orderDomain.SearchFilter,
+	query orderDomain.ListQuery,
+) (*orderDomain.Page, error) {
+	return r.list(ctx, func(o *orderDomain.Order) bool {
+		if filter.CustomerID != nil && o.CustomerID != *filter.CustomerID {
+			return false
+		}
+		if filter.CourierID != nil && !assignedTo(o, *filter.CourierID) {
+			return false
+		}
+		if filter.ProductID != nil && !slices.ContainsFunc(o.Items, func(item orderDomain.Item) bool {
+			return item.ProductID == *filter.ProductID
+		}) {
+			return false
+		}
+		return true
+	}, query)
+}
+
+func (r *OrderRepository) CountByCourier(
+	ctx context.Context,
+	courierID uuid.UUID,
+	createdFrom, createdTo *time.Time,
+) (orderDomain.StatusCounts, error) {
+	orders := r.filter(ctx, func(o *orderDomain.Order) bool {
+		return deliveredBy(o, courierID) && createdBetween(o, createdFrom, createdTo)
+	})
+
+	counts := make(orderDomain.StatusCounts)
+	for _, order := range orders {
+		counts[order.Status]++
+	}
+	return counts, nil
+}
+
+func (r *OrderRepository) list(
+	ctx context.Context,
+	match func(*orderDomain.Order) bool,
+	query orderDomain.ListQuery,
+) (*orderDomain.Page, error) {
+	direction := -1
+	if query.Sort == orderDomain.OldestFirst {
+		direction = 1
+	}
+
+	compare := func(a, b *orderDomain.Order) int {
+		if c := a.Created.Compare(b.Created); c != 0 {
+			return c * direction
+		}
+		return cmp.Compare(a.ID.String(), b.ID.String()) * direction
+	}
+
+	after := func(*orderDomain.Order) bool { return true }
+	if query.Cursor != "" {
+		lastCreated, lastID, err := decodeCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = func(o *orderDomain.Order) bool {
+			if c := o.Created.Compare(lastCreated); c != 0 {
+				return c*direction > 0
+			}
+			return cmp.Compare(o.ID.String(), lastID)*direction > 0
+		}
+	}
+
+	orders := r.filter(ctx, func(o *orderDomain.Order) bool {
+		if !match(o) || !after(o) {
+			return false
+		}
+		if len(query.Statuses) > 0 && !slices.Contains(query.Statuses, o.Status) {
+			return false
+		}
+		return createdBetween(o, query.CreatedFrom, query.CreatedTo)
+	})
+	slices.SortFunc(orders, compare)
+
+	hasMore := len(orders) > query.Limit
+	if hasMore {
+		orders = orders[:query.Limit]
+	}
+
+	page := &orderDomain.Page{Orders: orders}
+	if hasMore && len(orders) > 0 {
+		page.NextCursor = encodeCursor(orders[len(orders)-1])
+	}
+	return page, nil
+}
+
+// filter returns copies of the orders that match, in no particular order.
+func (r *OrderRepository) filter(ctx context.Context, match func(*orderDomain.Order) bool) []*orderDomain.Order {
+	orders := make([]*orderDomain.Order, 0)
+	_ = r.store.run(ctx, func(t *tables) error {
+		for _, order := range t.orders {
+			if match(order) {
+				orders = append(orders, cloneOrder(order))
+			}
+		}
+		return nil
+	})
+	return orders
+}
+
+func assignedTo(order *orderDomain.Order, courierID uuid.UUID) bool {
+	return order.Delivery.CourierID != nil && *order.Delivery.CourierID == courierID
+}
+
+// deliveredBy reports whether the courier was ever assigned to the order, as
+// the history tells.
+func deliveredBy(order *orderDomain.Order, courierID uuid.UUID) bool {
+	return assignedTo(order, courierID) || slices.ContainsFunc(order.History, func(change orderDomain.StatusChange) bool {
+		return change.CourierID != nil && *change.CourierID == courierID
+	})
+}
+
+func createdBetween(order *orderDomain.Order, from, to *time.Time) bool {
+	if from != nil && order.Created.Before(*from) {
+		return false
+	}
+	if to != nil && !order.Created.Before(*to) {
+		return false
+	}
+	return true
+}
+
+var _ orderDomain.Repository = (*OrderRepository)(nil)
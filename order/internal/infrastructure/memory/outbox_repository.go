@@ -0,0 +1,120 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"time"
+
+	outboxDomain "order/internal/domain/outbox"
+	outboxRepository "order/internal/infrastructure/repository/outbox"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// outboxRow is a stored message and the lease the outbox processor holds on it.
+type outboxRow struct {
+	message    *outboxDomain.Message
+	leaseUntil *time.Time
+}
+
+// OutboxRepository keeps the outbox in a Store. It returns the errors of the
+// MongoDB repository, so callers cannot tell the two apart.
+type OutboxRepository struct {
+	store *Store
+}
+
+func NewOutboxRepository(store *Store) *OutboxRepository {
+	return &OutboxRepository{store: store}
+}
+
+func (r *OutboxRepository) Create(ctx context.Context, message *outboxDomain.Message) error {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	for k, v := range carrier {
+		message.Metadata[k] = v
+	}
+
+	return r.store.run(ctx, func(t *tables) error {
+		if _, ok := t.outbox[message.ID]; ok {
+			return outboxRepository.ErrOutboxMessageAlreadyExists
+		}
+		t.outbox[message.ID] = &outboxRow{message: cloneOutboxMessage(message)}
+		return nil
+	})
+}
+
+func (r *OutboxRepository) CreateOnce(ctx context.Context, message *outboxDomain.Message) error {
+	err := r.Create(ctx, message)
+	if errors.Is(err, outboxRepository.ErrOutboxMessageAlreadyExists) {
+		return nil
+	}
+	return err
+}
+
+func (r *OutboxRepository) Update(ctx context.Context, message *outboxDomain.Message) error {
+	return r.store.run(ctx, func(t *tables) error {
+		if _, ok := t.outbox[message.ID]; !ok {
+			return outboxRepository.ErrOutboxMessageNotFound
+		}
+		t.outbox[message.ID] = &outboxRow{message: cloneOutboxMessage(message)}
+		return nil
+	})
+}
+
+func (r *OutboxRepository) Delete(ctx context.Context, message *outboxDomain.Message) error {
+	return r.store.run(ctx, func(t *tables) error {
+		if _, ok := t.outbox[message.ID]; !ok {
+			return outboxRepository.ErrOutboxMessageNotFound
+		}
+		delete(t.outbox, message.ID)
+		return nil
+	})
+}
+
+func (r *OutboxRepository) ClaimPending(ctx context.Context, leaseUntil time.Time) (*outboxDomain.Message, error) {
+	var message *outboxDomain.Message
+	err := r.store.run(ctx, func(t *tables) error {
+		now := time.Now()
+
+		// Only the oldest message of a key may be claimed, failed ones aside
+		heads := make(map[string]*outboxRow)
+		for _, row := range t.outbox {
+			if row.message.Failed != nil {
+				continue
+			}
+			key := cmp.Or(row.message.Key, row.message.ID.String())
+			if head, ok := heads[key]; !ok || createdBefore(row, head) {
+				heads[key] = row
+			}
+		}
+
+		var claimed *outboxRow
+		for _, row := range heads {
+			if leased(row.leaseUntil, now) || row.message.NextAttempt.After(now) {
+				continue
+			}
+			if claimed == nil || createdBefore(row, claimed) {
+				claimed = row
+			}
+		}
+		if claimed == nil {
+			return nil
+		}
+
+		t.outbox[claimed.message.ID] = &outboxRow{message: claimed.message, leaseUntil: &leaseUntil}
+		message = cloneOutboxMessage(claimed.message)
+		return nil
+	})
+	return message, err
+}
+
+// createdBefore orders the outbox by creation time, breaking ties by ID.
+func createdBefore(row, other *outboxRow) bool {
+	return cmp.Or(
+		row.message.Created.Compare(other.message.Created),
+		cmp.Compare(row.message.ID.String(), other.message.ID.String()),
+	) < 0
+}
+
+var _ outboxDomain.Repository = (*OutboxRepository)(nil)
@@ -0,0 +1,132 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	promotionDomain "order/internal/domain/promotion"
+	promotionRepository "order/internal/infrastructure/repository/promotion"
+
+	"github.com/google/uuid"
+)
+
+// promotionUsage identifies the counter of one customer's uses of a promotion.
+type promotionUsage struct {
+	promotionID uuid.UUID
+	customerID  uuid.UUID
+}
+
+// PromotionRepository keeps the promotions and their uses in a Store. It
+// returns the errors of the MongoDB repository, so callers cannot tell the two apart.
+type PromotionRepository struct {
+	store *Store
+}
+
+func NewPromotionRepository(store *Store) *PromotionRepository {
+	return &PromotionRepository{store: store}
+}
+
+func (r *PromotionRepository) Create(ctx context.Context, promotion *promotionDomain.Promotion) error {
+	return r.store.run(ctx, func(t *tables) error {
+		for _, stored := range t.promotions {
+			if stored.ID == promotion.ID || stored.Code == promotion.Code {
+				return promotionRepository.ErrPromotionAlreadyExists
+			}
+		}
+		t.promotions[promotion.ID] = clonePromotion(promotion)
+		return nil
+	})
+}
+
+func (r *PromotionRepository) Update(ctx context.Context, promotion *promotionDomain.Promotion) error {
+	return r.store.run(ctx, func(t *tables) error {
+		stored, ok := t.promotions[promotion.ID]
+		if !ok || stored.Version != promotion.Version {
+			return promotionRepository.ErrPromotionNotFound
+		}
+		for _, other := range t.promotions {
+			if other.ID != promotion.ID && other.Code == promotion.Code {
+				return promotionRepository.ErrPromotionAlreadyExists
+			}
+		}
+		promotion.Version = uuid.New()
+		t.promotions[promotion.ID] = clonePromotion(promotion)
+		return nil
+	})
+}
+
+func (r *PromotionRepository) GetByID(ctx context.Context, promotionID uuid.UUID) (*promotionDomain.Promotion, error) {
+	return r.find(ctx, func(p *promotionDomain.Promotion) bool { return p.ID == promotionID })
+}
+
+func (r *PromotionRepository) GetByCode(ctx context.Context, code string) (*promotionDomain.Promotion, error) {
+	code = promotionDomain.NormalizeCode(code)
+	return r.find(ctx, func(p *promotionDomain.Promotion) bool { return p.Code == code })
+}
+
+func (r *PromotionRepository) GetAll(ctx context.Context, activeOnly bool) ([]*promotionDomain.Promotion, error) {
+	promotions := []*promotionDomain.Promotion{}
+	err := r.store.run(ctx, func(t *tables) error {
+		for _, stored := range t.promotions {
+			if !activeOnly || stored.Active {
+				promotions = append(promotions, clonePromotion(stored))
+			}
+		}
+		return nil
+	})
+
+	// Newest first, as in MongoDB.
+	slices.SortFunc(promotions, func(a, b *promotionDomain.Promotion) int {
+		return cmp.Or(b.Created.Compare(a.Created), cmp.Compare(a.ID.String(), b.ID.String()))
+	})
+	return promotions, err
+}
+
+func (r *PromotionRepository) Redeem(
+	ctx context.Context,
+	promotion *promotionDomain.Promotion,
+	customerID uuid.UUID,
+) error {
+	return r.store.run(ctx, func(t *tables) error {
+		usage := promotionUsage{promotionID: promotion.ID, customerID: customerID}
+		if limit := promotion.Rules.PerCustomerLimit; limit > 0 && t.promotionUsages[usage] >= limit {
+			return promotionDomain.ErrUsageLimitReached
+		}
+		t.promotionUsages[usage]++
+		return nil
+	})
+}
+
+func (r *PromotionRepository) Release(
+	ctx context.Context,
+	promotion *promotionDomain.Promotion,
+	customerID uuid.UUID,
+) error {
+	return r.store.run(ctx, func(t *tables) error {
+		usage := promotionUsage{promotionID: promotion.ID, customerID: customerID}
+		if t.promotionUsages[usage] > 0 {
+			t.promotionUsages[usage]--
+		}
+		return nil
+	})
+}
+
+func (r *PromotionRepository) find(
+	ctx context.Context,
+	match func(p *promotionDomain.Promotion) bool,
+) (*promotionDomain.Promotion, error) {
+	var promotion *promotionDomain.Promotion
+	err := r.store.run(ctx, func(t *tables) error {
+		for _, stored := range t.promotions {
+			if match(stored) {
+				promotion = clonePromotion(stored)
+				return nil
+			}
+		}
+		return promotionRepository.ErrPromotionNotFound
+	})
+	return promotion, err
+}
+
+var _ promotionDomain.Repository = (*PromotionRepository)(nil)
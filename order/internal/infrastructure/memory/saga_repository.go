@@ -0,0 +1,167 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	sagaDomain "order/internal/domain/saga"
+	sagaRepository "order/internal/infrastructure/repository/saga"
+
+	"github.com/google/uuid"
+)
+
+// sagaRow is a stored saga and the lease a watchdog holds on it. Updating the
+// saga drops the lease, as replacing the MongoDB document does.
+type sagaRow struct {
+	saga       *sagaDomain.Saga
+	leaseUntil *time.Time
+}
+
+// SagaRepository keeps the sagas in a Store. It returns the errors of the
+// MongoDB repository, so callers cannot tell the two apart.
+type SagaRepository struct {
+	store *Store
+}
+
+func NewSagaRepository(store *Store) *SagaRepository {
+	return &SagaRepository{store: store}
+}
+
+func (r *SagaRepository) Create(ctx context.Context, saga *sagaDomain.Saga) error {
+	return r.store.run(ctx, func(t *tables) error {
+		if _, ok := t.sagas[saga.ID]; ok {
+			return sagaRepository.ErrSagaAlreadyExists
+		}
+		for _, row := range t.sagas {
+			if row.saga.Type == saga.Type && row.saga.OrderID == saga.OrderID {
+				return sagaRepository.ErrSagaAlreadyExists
+			}
+		}
+		t.sagas[saga.ID] = &sagaRow{saga: cloneSaga(saga)}
+		return nil
+	})
+}
+
+func (r *SagaRepository) Update(ctx context.Context, saga *sagaDomain.Saga) error {
+	return r.store.run(ctx, func(t *tables) error {
+		row, ok := t.sagas[saga.ID]
+		if !ok || row.saga.Version != saga.Version {
+			return sagaRepository.ErrSagaNotFound
+		}
+		saga.Version = uuid.New()
+		t.sagas[saga.ID] = &sagaRow{saga: cloneSaga(saga)}
+		return nil
+	})
+}
+
+func (r *SagaRepository) GetByID(ctx context.Context, sagaID uuid.UUID) (*sagaDomain.Saga, error) {
+	return r.find(ctx, func(s *sagaDomain.Saga) bool { return s.ID == sagaID })
+}
+
+func (r *SagaRepository) GetByOrderID(
+	ctx context.Context,
+	sagaType sagaDomain.Type,
+	orderID uuid.UUID,
+) (*sagaDomain.Saga, error) {
+	return r.find(ctx, func(s *sagaDomain.Saga) bool { return s.Type == sagaType && s.OrderID == orderID })
+}
+
+func (r *SagaRepository) GetAllByOrderID(ctx context.Context, orderID uuid.UUID) ([]*sagaDomain.Saga, error) {
+	var sagas []*sagaDomain.Saga
+	err := r.store.run(ctx, func(t *tables) error {
+		for _, row := range t.sagas {
+			if row.saga.OrderID == orderID {
+				sagas = append(sagas, cloneSaga(row.saga))
+			}
+		}
+		return nil
+	})
+	slices.SortFunc(sagas, func(a, b *sagaDomain.Saga) int { return a.Created.Compare(b.Created) })
+	return sagas, err
+}
+
+func (r *SagaRepository) ClaimStalled(
+	ctx context.Context,
+	sagaType sagaDomain.Type,
+	steps []sagaDomain.Step,
+	enteredBefore time.Time,
+	leaseUntil time.Time,
+) (*sagaDomain.Saga, error) {
+	return r.claim(ctx, leaseUntil, stepEntered, func(s *sagaDomain.Saga) bool {
+		return s.Type == sagaType && slices.Contains(steps, s.Step) && stepEntered(s).Before(enteredBefore)
+	})
+}
+
+func (r *SagaRepository) ClaimSuspended(
+	ctx context.Context,
+	sagaType sagaDomain.Type,
+	resumeBefore time.Time,
+	leaseUntil time.Time,
+) (*sagaDomain.Saga, error) {
+	resumeAt := func(s *sagaDomain.Saga) time.Time { return *s.ResumeAt }
+	return r.claim(ctx, leaseUntil, resumeAt, func(s *sagaDomain.Saga) bool {
+		return s.Type == sagaType && s.ResumeAt != nil && !s.ResumeAt.After(resumeBefore)
+	})
+}
+
+// claim leases the unleased saga matching match that sorts first by key.
+func (r *SagaRepository) claim(
+	ctx context.Context,
+	leaseUntil time.Time,
+	key func(s *sagaDomain.Saga) time.Time,
+	match func(s *sagaDomain.Saga) bool,
+) (*sagaDomain.Saga, error) {
+	var saga *sagaDomain.Saga
+	err := r.store.run(ctx, func(t *tables) error {
+		now := time.Now()
+
+		var claimed *sagaRow
+		for _, row := range t.sagas {
+			if leased(row.leaseUntil, now) || !match(row.saga) {
+				continue
+			}
+			if claimed == nil || compareBy(row.saga, claimed.saga, key) < 0 {
+				claimed = row
+			}
+		}
+		if claimed == nil {
+			return nil
+		}
+
+		t.sagas[claimed.saga.ID] = &sagaRow{saga: claimed.saga, leaseUntil: &leaseUntil}
+		saga = cloneSaga(claimed.saga)
+		return nil
+	})
+	return saga, err
+}
+
+func (r *SagaRepository) find(ctx context.Context, match func(s *sagaDomain.Saga) bool) (*sagaDomain.Saga, error) {
+	var saga *sagaDomain.Saga
+	err := r.store.run(ctx, func(t *tables) error {
+		for _, row := range t.sagas {
+			if match(row.saga) {
+				saga = cloneSaga(row.saga)
+				return nil
+			}
+		}
+		return sagaRepository.ErrSagaNotFound
+	})
+	return saga, err
+}
+
+// compareBy orders sagas by key, breaking ties by id so that claims are deterministic.
+func compareBy(a, b *sagaDomain.Saga, key func(s *sagaDomain.Saga) time.Time) int {
+	return cmp.Or(key(a).Compare(key(b)), cmp.Compare(a.ID.String(), b.ID.String()))
+}
+
+// stepEntered is when the saga entered its current step.
+func stepEntered(s *sagaDomain.Saga) time.Time {
+	if len(s.History) == 0 {
+		return time.Time{}
+	}
+	return s.History[len(s.History)-1].Entered
+}
+
+var _ sagaDomain.Repository = (*SagaRepository)(nil)
@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	orderDomain "order/internal/domain/order"
+	slotDomain "order/internal/domain/slot"
+)
+
+// slotRow counts the places taken in a delivery slot.
+type slotRow struct {
+	start    time.Time
+	reserved int
+}
+
+// SlotRepository keeps the delivery slot counters in a Store.
+type SlotRepository struct {
+	store *Store
+}
+
+func NewSlotRepository(store *Store) *SlotRepository {
+	return &SlotRepository{store: store}
+}
+
+func (r *SlotRepository) Reserve(ctx context.Context, slot orderDomain.DeliverySlot, capacity int) error {
+	return r.store.run(ctx, func(t *tables) error {
+		row := t.slots[slotID(slot)]
+		if row.reserved >= capacity {
+			return slotDomain.ErrSlotFull
+		}
+		t.slots[slotID(slot)] = slotRow{start: slot.Start.UTC(), reserved: row.reserved + 1}
+		return nil
+	})
+}
+
+func (r *SlotRepository) Release(ctx context.Context, slot orderDomain.DeliverySlot) error {
+	return r.store.run(ctx, func(t *tables) error {
+		if row, ok := t.slots[slotID(slot)]; ok && row.reserved > 0 {
+			row.reserved--
+			t.slots[slotID(slot)] = row
+		}
+		return nil
+	})
+}
+
+func (r *SlotRepository) GetReserved(ctx context.Context, from, to time.Time) (map[time.Time]int, error) {
+	reserved := make(map[time.Time]int)
+	err := r.store.run(ctx, func(t *tables) error {
+		for _, row := range t.slots {
+			if !row.start.Before(from) && row.start.Before(to) {
+				reserved[row.start] = row.reserved
+			}
+		}
+		return nil
+	})
+	return reserved, err
+}
+
+// slotID identifies a slot by its start, as the MongoDB repository does.
+func slotID(slot orderDomain.DeliverySlot) string {
+	return slot.Start.UTC().Format(time.RFC3339)
+}
+
+var _ slotDomain.Repository = (*SlotRepository)(nil)
@@ -0,0 +1,120 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	inboxDomain "order/internal/domain/inbox"
+	orderDomain "order/internal/domain/order"
+	promotionDomain "order/internal/domain/promotion"
+
+	"github.com/google/uuid"
+)
+
+// tables holds what the store keeps. Values are never changed in place, so a
+// shallow copy of the maps is a snapshot.
+type tables struct {
+	orders          map[uuid.UUID]*orderDomain.Order
+	sagas           map[uuid.UUID]*sagaRow
+	outbox          map[uuid.UUID]*outboxRow
+	inbox           map[uuid.UUID]*inboxDomain.Message
+	promotions      map[uuid.UUID]*promotionDomain.Promotion
+	promotionUsages map[promotionUsage]int
+	slots           map[string]slotRow
+}
+
+func newTables() *tables {
+	return &tables{
+		orders:          make(map[uuid.UUID]*orderDomain.Order),
+		sagas:           make(map[uuid.UUID]*sagaRow),
+		outbox:          make(map[uuid.UUID]*outboxRow),
+		inbox:           make(map[uuid.UUID]*inboxDomain.Message),
+		promotions:      make(map[uuid.UUID]*promotionDomain.Promotion),
+		promotionUsages: make(map[promotionUsage]int),
+		slots:           make(map[string]slotRow),
+	}
+}
+
+func (t *tables) clone() *tables {
+	return &tables{
+		orders:          maps.Clone(t.orders),
+		sagas:           maps.Clone(t.sagas),
+		outbox:          maps.Clone(t.outbox),
+		inbox:           maps.Clone(t.inbox),
+		promotions:      maps.Clone(t.promotions),
+		promotionUsages: maps.Clone(t.promotionUsages),
+		slots:           maps.Clone(t.slots),
+	}
+}
+
+// leased reports whether a lease taken until leaseUntil still holds at now.
+func leased(leaseUntil *time.Time, now time.Time) bool {
+	return leaseUntil != nil && !leaseUntil.Before(now)
+}
+
+// Store keeps everything the service stores in the process when
+// DB_ORDER_BACKEND is memory or IN_MEMORY is set. It is safe for concurrent
+// use. A
+// transaction holds the store until it ends, so transactions run one at a
+// time and calls made with a ctx other than the one fn gets wait for it.
+type Store struct {
+	mu     sync.Mutex
+	tables *tables
+}
+
+func NewStore() *Store {
+	return &Store{tables: newTables()}
+}
+
+// Clear removes everything the store keeps.
+func (s *Store) Clear(ctx context.Context) error {
+	return s.run(ctx, func(t *tables) error {
+		*t = *newTables()
+		return nil
+	})
+}
+
+type txKey struct{}
+
+type storeTx struct {
+	store  *Store
+	tables *tables
+}
+
+// Transaction runs fn on a copy of the store and keeps the copy if fn
+// succeeds. Repositories given the ctx fn gets work on that copy; a nested
+// transaction joins the outer one.
+func (s *Store) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := s.tx(ctx); ok {
+		return fn(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx := &storeTx{store: s, tables: s.tables.clone()}
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+	s.tables = tx.tables
+	return nil
+}
+
+// run calls fn with the tables ctx sees: those of its transaction, or the
+// committed ones.
+func (s *Store) run(ctx context.Context, fn func(t *tables) error) error {
+	if tx, ok := s.tx(ctx); ok {
+		return fn(tx.tables)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(s.tables)
+}
+
+func (s *Store) tx(ctx context.Context) (*storeTx, bool) {
+	tx, ok := ctx.Value(txKey{}).(*storeTx)
+	return tx, ok && tx.store == s
+}
@@ -0,0 +1,103 @@
+package memory
+
+import (
+	"context"
+	"order/internal/infrastructure/messaging"
+
+	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// Bus is the broker a Transport runs on. Broker implements it; services
+// booted into the same process are given the same one, so that what one of
+// them writes the others read.
+type Bus interface {
+	Publish(ctx context.Context, msg kafka.Message) error
+	Fetch(ctx context.Context, topic, groupID string) (kafka.Message, error)
+	Commit(ctx context.Context, groupID string, msgs ...kafka.Message) error
+}
+
+// Transport opens readers and writers on a Bus instead of Kafka. Trace
+// context travels in the message headers as it does through Kafka.
+type Transport struct {
+	bus Bus
+}
+
+func NewTransport(bus Bus) *Transport {
+	return &Transport{bus: bus}
+}
+
+func (t *Transport) NewReader(topic, groupID string) (messaging.Reader, error) {
+	return &reader{bus: t.bus, topic: topic, groupID: groupID}, nil
+}
+
+func (t *Transport) NewWriter(topic string) (messaging.Writer, error) {
+	return &writer{bus: t.bus, topic: topic}, nil
+}
+
+type reader struct {
+	bus     Bus
+	topic   string
+	groupID string
+}
+
+func (r *reader) FetchMessage(ctx context.Context, msg *kafka.Message) error {
+	m, err := r.bus.Fetch(ctx, r.topic, r.groupID)
+	if err != nil {
+		return err
+	}
+	*msg = m
+	return nil
+}
+
+func (r *reader) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	return r.bus.Commit(ctx, r.groupID, msgs...)
+}
+
+func (r *reader) Topic() string {
+	return r.topic
+}
+
+func (r *reader) Propagator() propagation.TextMapPropagator {
+	return propagation.TraceContext{}
+}
+
+func (r *reader) Close() error {
+	return nil
+}
+
+type writer struct {
+	bus   Bus
+	topic string
+}
+
+// WriteMessage keeps the trace context already in the headers, as outbox
+// messages carry the one they were created in, and adds the one of ctx otherwise.
+func (w *writer) WriteMessage(ctx context.Context, msg kafka.Message) error {
+	msg.Topic = w.topic
+	msg.Headers = append([]kafka.Header(nil), msg.Headers...)
+
+	carrier := otelkafkakonsumer.NewMessageCarrier(&msg)
+	ctx = w.Propagator().Extract(ctx, carrier)
+	w.Propagator().Inject(ctx, carrier)
+
+	return w.bus.Publish(ctx, msg)
+}
+
+func (w *writer) Topic() string {
+	return w.topic
+}
+
+func (w *writer) Propagator() propagation.TextMapPropagator {
+	return propagation.TraceContext{}
+}
+
+func (w *writer) Close() error {
+	return nil
+}
+
+var (
+	_ Bus                 = (*Broker)(nil)
+	_ messaging.Transport = (*Transport)(nil)
+)
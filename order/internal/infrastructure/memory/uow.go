@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"context"
+	inboxDomain "order/internal/domain/inbox"
+	orderDomain "order/internal/domain/order"
+	outboxDomain "order/internal/domain/outbox"
+	promotionDomain "order/internal/domain/promotion"
+	sagaDomain "order/internal/domain/saga"
+	slotDomain "order/internal/domain/slot"
+	"order/internal/domain/uow"
+)
+
+// UoW runs transactions of a Store, which every repository it hands out
+// keeps its data in.
+type UoW struct {
+	orderRepository     *OrderRepository
+	sagaRepository      *SagaRepository
+	outboxRepository    *OutboxRepository
+	promotionRepository *PromotionRepository
+	slotRepository      *SlotRepository
+	inboxRepository     *InboxRepository
+
+	store *Store
+}
+
+func NewUoW(store *Store) *UoW {
+	return &UoW{
+		orderRepository:     NewOrderRepository(store),
+		sagaRepository:      NewSagaRepository(store),
+		outboxRepository:    NewOutboxRepository(store),
+		promotionRepository: NewPromotionRepository(store),
+		slotRepository:      NewSlotRepository(store),
+		inboxRepository:     NewInboxRepository(store),
+		store:               store,
+	}
+}
+
+// Transaction runs fn in a transaction of the store; see Store.Transaction.
+func (u *UoW) Transaction(ctx context.Context, fn func(ctx context.Context, u uow.UoW) error) error {
+	return u.store.Transaction(ctx, func(ctx context.Context) error {
+		return fn(ctx, u)
+	})
+}
+
+func (u *UoW) Order() orderDomain.Repository {
+	return u.orderRepository
+}
+
+func (u *UoW) Saga() sagaDomain.Repository {
+	return u.sagaRepository
+}
+
+func (u *UoW) Outbox() outboxDomain.Repository {
+	return u.outboxRepository
+}
+
+func (u *UoW) Promotion() promotionDomain.Repository {
+	return u.promotionRepository
+}
+
+func (u *UoW) Slot() slotDomain.Repository {
+	return u.slotRepository
+}
+
+func (u *UoW) Inbox() inboxDomain.Repository {
+	return u.inboxRepository
+}
+
+var _ uow.UoW = (*UoW)(nil)
@@ -7,19 +7,26 @@ import (
 )
 
 type Config struct {
-	Address string `envconfig:"KAFKA_ADDRESS" required:"true"`
+	// Address is only needed when the messages go through Kafka.
+	Address string `envconfig:"KAFKA_ADDRESS"`
 
 	OrderCmdTopic           string `envconfig:"KAFKA_ORDER_COMMAND_TOPIC" required:"true"`
 	OrderCmdResTopic        string `envconfig:"KAFKA_ORDER_COMMAND_RESULT_TOPIC" required:"true"`
 	OrderCmdConsumerGroupID string `envconfig:"KAFKA_ORDER_COMMAND_CONSUMER_GROUP_ID" required:"true"`
+	OrderCmdDLQTopic        string `envconfig:"KAFKA_ORDER_COMMAND_DLQ_TOPIC" required:"true"`
+
+	// OrderEventTopic carries the order domain events other services subscribe to.
+	OrderEventTopic string `envconfig:"KAFKA_ORDER_EVENT_TOPIC" default:"order.events"`
 
 	WarehouseCmdTopic              string `envconfig:"KAFKA_WAREHOUSE_COMMAND_TOPIC" required:"true"`
 	WarehouseCmdResTopic           string `envconfig:"KAFKA_WAREHOUSE_COMMAND_RESULT_TOPIC" required:"true"`
 	WarehouseCmdResConsumerGroupID string `envconfig:"KAFKA_WAREHOUSE_COMMAND_RESULT_CONSUMER_GROUP_ID" required:"true"`
+	WarehouseCmdResDLQTopic        string `envconfig:"KAFKA_WAREHOUSE_COMMAND_RESULT_DLQ_TOPIC" required:"true"`
 
 	CourierCmdTopic              string `envconfig:"KAFKA_COURIER_COMMAND_TOPIC" required:"true"`
 	CourierCmdResTopic           string `envconfig:"KAFKA_COURIER_COMMAND_RESULT_TOPIC" required:"true"`
 	CourierCmdResConsumerGroupID string `envconfig:"KAFKA_COURIER_COMMAND_RESULT_CONSUMER_GROUP_ID" required:"true"`
+	CourierCmdResDLQTopic        string `envconfig:"KAFKA_COURIER_COMMAND_RESULT_DLQ_TOPIC" required:"true"`
 }
 
 func NewConfig() (*Config, error) {
@@ -0,0 +1,85 @@
+package dlq
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	HeaderError           = "dlq-error"
+	HeaderAttempts        = "dlq-attempts"
+	HeaderSourceTopic     = "dlq-source-topic"
+	HeaderSourcePartition = "dlq-source-partition"
+	HeaderSourceOffset    = "dlq-source-offset"
+	HeaderFailedAt        = "dlq-failed-at"
+)
+
+// Message is a dead-lettered Kafka message as stored on a DLQ topic.
+type Message struct {
+	Partition int
+	Offset    int64
+
+	SourceTopic     string
+	SourcePartition int
+	SourceOffset    int64
+
+	Error    string
+	Attempts int
+	FailedAt time.Time
+
+	Key     []byte
+	Value   []byte
+	Headers []kafka.Header
+}
+
+func toKafkaMessage(source *kafka.Message, cause error, attempts int) kafka.Message {
+	headers := make([]kafka.Header, 0, len(source.Headers)+6)
+	headers = append(headers, source.Headers...)
+	headers = append(headers,
+		kafka.Header{Key: HeaderError, Value: []byte(cause.Error())},
+		kafka.Header{Key: HeaderAttempts, Value: []byte(strconv.Itoa(attempts))},
+		kafka.Header{Key: HeaderSourceTopic, Value: []byte(source.Topic)},
+		kafka.Header{Key: HeaderSourcePartition, Value: []byte(strconv.Itoa(source.Partition))},
+		kafka.Header{Key: HeaderSourceOffset, Value: []byte(strconv.FormatInt(source.Offset, 10))},
+		kafka.Header{Key: HeaderFailedAt, Value: []byte(time.Now().UTC().Format(time.RFC3339Nano))},
+	)
+
+	return kafka.Message{
+		Key:     source.Key,
+		Value:   source.Value,
+		Headers: headers,
+	}
+}
+
+func fromKafkaMessage(msg kafka.Message) *Message {
+	message := &Message{
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Key:       msg.Key,
+		Value:     msg.Value,
+	}
+
+	for _, header := range msg.Headers {
+		value := string(header.Value)
+		switch header.Key {
+		case HeaderError:
+			message.Error = value
+		case HeaderAttempts:
+			message.Attempts, _ = strconv.Atoi(value)
+		case HeaderSourceTopic:
+			message.SourceTopic = value
+		case HeaderSourcePartition:
+			message.SourcePartition, _ = strconv.Atoi(value)
+		case HeaderSourceOffset:
+			message.SourceOffset, _ = strconv.ParseInt(value, 10, 64)
+		case HeaderFailedAt:
+			message.FailedAt, _ = time.Parse(time.RFC3339Nano, value)
+		default:
+			message.Headers = append(message.Headers, header)
+		}
+	}
+
+	return message
+}
@@ -0,0 +1,63 @@
+package dlq
+
+import (
+	"context"
+	"fmt"
+	"order/internal/infrastructure/logger"
+	"order/internal/infrastructure/messaging"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type Writer interface {
+	Write(ctx context.Context, source *kafka.Message, cause error, attempts int) error
+}
+
+type WriterImpl struct {
+	writer messaging.Writer
+	logger logger.Logger
+}
+
+func NewWriter(writer messaging.Writer, logger logger.Logger) *WriterImpl {
+	return &WriterImpl{
+		writer: writer,
+		logger: logger,
+	}
+}
+
+func (w *WriterImpl) log(level logger.Level, action, message string, extraFields map[string]any) {
+	fields := map[string]any{
+		"component": "dlq_writer",
+		"action":    action,
+		"topic":     w.writer.Topic(),
+	}
+	for k, v := range extraFields {
+		fields[k] = v
+	}
+
+	w.logger.Log(level, message, fields)
+}
+
+func (w *WriterImpl) Write(ctx context.Context, source *kafka.Message, cause error, attempts int) error {
+	kafkaMsg := toKafkaMessage(source, cause, attempts)
+
+	if err := w.writer.WriteMessage(ctx, kafkaMsg); err != nil {
+		w.log(logger.Error, "kafka_write_error", "Failed to send message to DLQ", map[string]any{
+			"source_topic":  source.Topic,
+			"source_offset": source.Offset,
+			"error":         err.Error(),
+		})
+		return fmt.Errorf("error sending message to dlq: %w", err)
+	}
+
+	w.log(logger.Warn, "dead_lettered", "Message sent to DLQ", map[string]any{
+		"source_topic":     source.Topic,
+		"source_partition": source.Partition,
+		"source_offset":    source.Offset,
+		"attempts":         attempts,
+		"error":            cause.Error(),
+	})
+	return nil
+}
+
+var _ Writer = (*WriterImpl)(nil)
@@ -0,0 +1,101 @@
+package messaging
+
+import (
+	"errors"
+
+	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+)
+
+// KafkaTransport opens traced readers and writers on the Kafka cluster at KAFKA_ADDRESS.
+type KafkaTransport struct {
+	address string
+	tp      *sdktrace.TracerProvider
+}
+
+func NewKafkaTransport(config *Config, tp *sdktrace.TracerProvider) (*KafkaTransport, error) {
+	if config.Address == "" {
+		return nil, errors.New("failed to load kafka config: KAFKA_ADDRESS is required")
+	}
+	return &KafkaTransport{address: config.Address, tp: tp}, nil
+}
+
+func (t *KafkaTransport) NewReader(topic, groupID string) (Reader, error) {
+	reader, err := otelkafkakonsumer.NewReader(
+		kafka.NewReader(kafka.ReaderConfig{
+			Brokers: []string{t.address},
+			GroupID: groupID,
+			Topic:   topic,
+		}),
+		otelkafkakonsumer.WithTracerProvider(t.tp),
+		otelkafkakonsumer.WithPropagator(propagation.TraceContext{}),
+		otelkafkakonsumer.WithAttributes(
+			[]attribute.KeyValue{
+				semconv.MessagingDestinationKindTopic,
+				semconv.MessagingKafkaClientIDKey.String(topic),
+			},
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaReader{Reader: reader}, nil
+}
+
+func (t *KafkaTransport) NewWriter(topic string) (Writer, error) {
+	writer, err := otelkafkakonsumer.NewWriter(
+		&kafka.Writer{
+			Addr:     kafka.TCP(t.address),
+			Topic:    topic,
+			Balancer: &kafka.Hash{},
+		},
+		otelkafkakonsumer.WithTracerProvider(t.tp),
+		otelkafkakonsumer.WithPropagator(propagation.TraceContext{}),
+		otelkafkakonsumer.WithAttributes(
+			[]attribute.KeyValue{
+				semconv.MessagingDestinationKindTopic,
+				semconv.MessagingKafkaClientIDKey.String(topic),
+			},
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaWriter{Writer: writer}, nil
+}
+
+// KafkaReader is a traced Kafka consumer group reader.
+type KafkaReader struct {
+	*otelkafkakonsumer.Reader
+}
+
+func (r *KafkaReader) Topic() string {
+	return r.R.Config().Topic
+}
+
+func (r *KafkaReader) Propagator() propagation.TextMapPropagator {
+	return r.TraceConfig.Propagator
+}
+
+// KafkaWriter is a traced Kafka writer.
+type KafkaWriter struct {
+	*otelkafkakonsumer.Writer
+}
+
+func (w *KafkaWriter) Topic() string {
+	return w.W.Topic
+}
+
+func (w *KafkaWriter) Propagator() propagation.TextMapPropagator {
+	return w.TraceConfig.Propagator
+}
+
+var (
+	_ Transport = (*KafkaTransport)(nil)
+	_ Reader    = (*KafkaReader)(nil)
+	_ Writer    = (*KafkaWriter)(nil)
+)
@@ -0,0 +1,22 @@
+package retry
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Config struct {
+	MaxAttempts    int           `envconfig:"KAFKA_RETRY_MAX_ATTEMPTS" required:"true"`
+	InitialBackoff time.Duration `envconfig:"KAFKA_RETRY_INITIAL_BACKOFF" required:"true"`
+	MaxBackoff     time.Duration `envconfig:"KAFKA_RETRY_MAX_BACKOFF" required:"true"`
+}
+
+func NewConfig() (*Config, error) {
+	var cfg Config
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, fmt.Errorf("failed to load kafka retry config: %w", err)
+	}
+	return &cfg, nil
+}
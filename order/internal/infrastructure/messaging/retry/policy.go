@@ -0,0 +1,58 @@
+package retry
+
+import (
+	"context"
+	"time"
+)
+
+// Classifier reports whether a failed attempt may succeed when repeated.
+type Classifier func(err error) bool
+
+// Policy repeats a failing operation with exponential backoff until it succeeds,
+// fails with an error the classifier rejects, or runs out of attempts.
+type Policy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Retryable      Classifier
+}
+
+func NewPolicy(cfg *Config, retryable Classifier) *Policy {
+	return &Policy{
+		MaxAttempts:    cfg.MaxAttempts,
+		InitialBackoff: cfg.InitialBackoff,
+		MaxBackoff:     cfg.MaxBackoff,
+		Retryable:      retryable,
+	}
+}
+
+// Do runs fn and returns the number of attempts made together with the last error.
+func (p *Policy) Do(ctx context.Context, fn func(ctx context.Context) error) (int, error) {
+	attempt := 0
+	for {
+		attempt++
+
+		err := fn(ctx)
+		if err == nil {
+			return attempt, nil
+		}
+		if attempt >= p.MaxAttempts || !p.Retryable(err) {
+			return attempt, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return attempt, err
+		case <-time.After(p.Backoff(attempt)):
+		}
+	}
+}
+
+// Backoff doubles the delay after every failed attempt up to MaxBackoff.
+func (p *Policy) Backoff(attempt int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxBackoff)
+}
@@ -0,0 +1,73 @@
+package worker
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// queueSize is the number of tasks a worker buffers before Submit blocks.
+const queueSize = 16
+
+// Pool handles messages on a fixed number of workers. Messages with the same key,
+// or unkeyed messages from the same partition, always go to the same worker, so
+// they are handled one at a time and in the order they were submitted.
+type Pool struct {
+	concurrency int
+	queues      []chan func()
+	wg          sync.WaitGroup
+}
+
+func NewPool(cfg *Config) *Pool {
+	return &Pool{
+		concurrency: max(cfg.Concurrency, 1),
+	}
+}
+
+func (p *Pool) Start() {
+	p.queues = make([]chan func(), p.concurrency)
+	for i := range p.queues {
+		queue := make(chan func(), queueSize)
+		p.queues[i] = queue
+
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			for task := range queue {
+				task()
+			}
+		}()
+	}
+}
+
+// Submit queues the task on the worker that owns the message. It blocks while that
+// worker is saturated and reports false if ctx is cancelled before the task is queued.
+func (p *Pool) Submit(ctx context.Context, msg *kafka.Message, task func()) bool {
+	select {
+	case p.queues[p.worker(msg)] <- task:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Stop waits until every queued task has been handled. Submit must not be called
+// once Stop has started.
+func (p *Pool) Stop() {
+	for _, queue := range p.queues {
+		close(queue)
+	}
+	p.wg.Wait()
+}
+
+func (p *Pool) worker(msg *kafka.Message) int {
+	if msg.Key == nil {
+		return msg.Partition % len(p.queues)
+	}
+
+	hasher := fnv.New32a()
+	_, _ = hasher.Write(msg.Key)
+	return int(hasher.Sum32() % uint32(len(p.queues)))
+}
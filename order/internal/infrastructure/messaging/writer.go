@@ -1,78 +1,33 @@
 package messaging
 
-import (
-	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
-	"github.com/segmentio/kafka-go"
-	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/propagation"
-	sdktrace "go.opentelemetry.io/otel/sdk/trace"
-	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
-)
+func NewOrderCommandWriter(config *Config, transport Transport) (Writer, error) {
+	return transport.NewWriter(config.OrderCmdTopic)
+}
+
+func NewWarehouseCommandWriter(config *Config, transport Transport) (Writer, error) {
+	return transport.NewWriter(config.WarehouseCmdTopic)
+}
+
+func NewCourierCommandWriter(config *Config, transport Transport) (Writer, error) {
+	return transport.NewWriter(config.CourierCmdTopic)
+}
+
+func NewOrderCommandResWriter(config *Config, transport Transport) (Writer, error) {
+	return transport.NewWriter(config.OrderCmdResTopic)
+}
 
-func NewOrderCommandWriter(config *Config, tp *sdktrace.TracerProvider) (*otelkafkakonsumer.Writer, error) {
-	return otelkafkakonsumer.NewWriter(
-		&kafka.Writer{
-			Addr:  kafka.TCP(config.Address),
-			Topic: config.OrderCmdTopic,
-		},
-		otelkafkakonsumer.WithTracerProvider(tp),
-		otelkafkakonsumer.WithPropagator(propagation.TraceContext{}),
-		otelkafkakonsumer.WithAttributes(
-			[]attribute.KeyValue{
-				semconv.MessagingDestinationKindTopic,
-				semconv.MessagingKafkaClientIDKey.String(config.OrderCmdTopic),
-			},
-		),
-	)
+func NewOrderEventWriter(config *Config, transport Transport) (Writer, error) {
+	return transport.NewWriter(config.OrderEventTopic)
 }
 
-func NewWarehouseCommandWriter(config *Config, tp *sdktrace.TracerProvider) (*otelkafkakonsumer.Writer, error) {
-	return otelkafkakonsumer.NewWriter(
-		&kafka.Writer{
-			Addr:  kafka.TCP(config.Address),
-			Topic: config.WarehouseCmdTopic,
-		},
-		otelkafkakonsumer.WithTracerProvider(tp),
-		otelkafkakonsumer.WithPropagator(propagation.TraceContext{}),
-		otelkafkakonsumer.WithAttributes(
-			[]attribute.KeyValue{
-				semconv.MessagingDestinationKindTopic,
-				semconv.MessagingKafkaClientIDKey.String(config.WarehouseCmdTopic),
-			},
-		),
-	)
+func NewOrderCommandDLQWriter(config *Config, transport Transport) (Writer, error) {
+	return transport.NewWriter(config.OrderCmdDLQTopic)
 }
 
-func NewCourierCommandWriter(config *Config, tp *sdktrace.TracerProvider) (*otelkafkakonsumer.Writer, error) {
-	return otelkafkakonsumer.NewWriter(
-		&kafka.Writer{
-			Addr:  kafka.TCP(config.Address),
-			Topic: config.CourierCmdTopic,
-		},
-		otelkafkakonsumer.WithTracerProvider(tp),
-		otelkafkakonsumer.WithPropagator(propagation.TraceContext{}),
-		otelkafkakonsumer.WithAttributes(
-			[]attribute.KeyValue{
-				semconv.MessagingDestinationKindTopic,
-				semconv.MessagingKafkaClientIDKey.String(config.CourierCmdTopic),
-			},
-		),
-	)
+func NewWarehouseCommandResultDLQWriter(config *Config, transport Transport) (Writer, error) {
+	return transport.NewWriter(config.WarehouseCmdResDLQTopic)
 }
 
-func NewOrderCommandResWriter(config *Config, tp *sdktrace.TracerProvider) (*otelkafkakonsumer.Writer, error) {
-	return otelkafkakonsumer.NewWriter(
-		&kafka.Writer{
-			Addr:  kafka.TCP(config.Address),
-			Topic: config.OrderCmdResTopic,
-		},
-		otelkafkakonsumer.WithTracerProvider(tp),
-		otelkafkakonsumer.WithPropagator(propagation.TraceContext{}),
-		otelkafkakonsumer.WithAttributes(
-			[]attribute.KeyValue{
-				semconv.MessagingDestinationKindTopic,
-				semconv.MessagingKafkaClientIDKey.String(config.OrderCmdResTopic),
-			},
-		),
-	)
+func NewCourierCommandResultDLQWriter(config *Config, transport Transport) (Writer, error) {
+	return transport.NewWriter(config.CourierCmdResDLQTopic)
 }
@@ -0,0 +1,196 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"order/internal/domain/outbox"
+	"order/internal/infrastructure/logger"
+	"order/internal/infrastructure/messaging/retry"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultPollDelay     = 1 * time.Second
+	DefaultLeaseDuration = 30 * time.Second
+	DefaultRetryDelay    = 1 * time.Second
+	DefaultMaxRetryDelay = 1 * time.Minute
+)
+
+type Processor struct {
+	repository    outbox.Repository
+	publisher     outbox.Publisher
+	pollDelay     time.Duration
+	leaseDuration time.Duration
+	retryDelay    time.Duration
+	maxRetryDelay time.Duration
+
+	cancelCtx  context.Context
+	cancelFunc context.CancelFunc
+
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	started bool
+
+	logger logger.Logger
+}
+
+func NewProcessor(repository outbox.Repository, publisher outbox.Publisher, logger logger.Logger) *Processor {
+	return &Processor{
+		repository:    repository,
+		publisher:     publisher,
+		pollDelay:     DefaultPollDelay,
+		leaseDuration: DefaultLeaseDuration,
+		retryDelay:    DefaultRetryDelay,
+		maxRetryDelay: DefaultMaxRetryDelay,
+		logger:        logger,
+	}
+}
+
+func (p *Processor) log(level logger.Level, action, message string, extra map[string]any) {
+	fields := map[string]any{
+		"component": "outbox_processor",
+		"action":    action,
+	}
+	for k, v := range extra {
+		fields[k] = v
+	}
+
+	p.logger.Log(level, message, fields)
+}
+
+func (p *Processor) Start(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.started {
+		return errors.New("outbox processor is already running; no need to start again")
+	}
+
+	p.cancelCtx, p.cancelFunc = context.WithCancel(ctx)
+	p.started = true
+
+	p.log(logger.Info, "start", "Outbox processor started", nil)
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.processOutbox(p.cancelCtx)
+	}()
+
+	return nil
+}
+
+func (p *Processor) Stop() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.started {
+		return errors.New("outbox processor is not running or already stopped")
+	}
+
+	p.cancelFunc()
+	p.wg.Wait()
+	p.started = false
+
+	p.log(logger.Info, "stopped", "Outbox processor stopped", nil)
+
+	return nil
+}
+
+func (p *Processor) processOutbox(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			p.log(logger.Info, "stopping", "Outbox processor stopping due to context cancellation", nil)
+			return
+		default:
+			if err := p.processBatch(ctx); err != nil {
+				p.log(logger.Error, "batch_error", "Error processing outbox batch", map[string]any{
+					"error": err.Error(),
+				})
+			}
+			time.Sleep(p.pollDelay)
+		}
+	}
+}
+
+// processBatch claims and publishes due messages until none are left. Claims are
+// leased, so several replicas can relay the same outbox without double delivery
+// within the lease, and a message left behind by a crashed relay is picked up
+// again once its lease expires.
+func (p *Processor) processBatch(ctx context.Context) error {
+	for ctx.Err() == nil {
+		message, err := p.repository.ClaimPending(ctx, time.Now().Add(p.leaseDuration))
+		if err != nil {
+			return err
+		}
+		if message == nil {
+			return nil
+		}
+
+		if err = p.processMessage(ctx, message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Processor) processMessage(ctx context.Context, message *outbox.Message) error {
+	if err := p.publisher.Publish(ctx, message); err != nil {
+		if retry.IsPermanent(err) {
+			return p.fail(ctx, message, err)
+		}
+		return p.scheduleRetry(ctx, message, err)
+	}
+	if err := p.repository.Delete(ctx, message); err != nil {
+		return err
+	}
+
+	p.log(logger.Info, "processed", "Message processed", map[string]any{"message": message})
+	return nil
+}
+
+func (p *Processor) scheduleRetry(ctx context.Context, message *outbox.Message, publishErr error) error {
+	retryAt := time.Now().Add(p.backoff(message.Attempts))
+	message.NoteFailedAttempt(retryAt)
+	if err := p.repository.Update(ctx, message); err != nil {
+		return err
+	}
+
+	p.log(logger.Warn, "retry_scheduled", "Message not published, retry scheduled", map[string]any{
+		"message_id": message.ID.String(),
+		"name":       message.Name,
+		"attempts":   message.Attempts,
+		"retry_at":   retryAt,
+		"error":      publishErr.Error(),
+	})
+	return nil
+}
+
+// fail gives up a message that can never be published, e.g. one with a name no
+// topic is known for, so that it stops holding back the messages after it.
+func (p *Processor) fail(ctx context.Context, message *outbox.Message, publishErr error) error {
+	message.NoteFailed(publishErr, time.Now())
+	if err := p.repository.Update(ctx, message); err != nil {
+		return err
+	}
+
+	p.log(logger.Error, "failed", "Message cannot be published, given up", map[string]any{
+		"message_id": message.ID.String(),
+		"name":       message.Name,
+		"attempts":   message.Attempts,
+		"error":      publishErr.Error(),
+	})
+	return nil
+}
+
+// backoff doubles the retry delay with every failed attempt up to maxRetryDelay.
+func (p *Processor) backoff(attempts int) time.Duration {
+	delay := p.retryDelay
+	for i := 0; i < attempts && delay < p.maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.maxRetryDelay)
+}
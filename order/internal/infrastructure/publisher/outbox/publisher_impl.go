@@ -0,0 +1,137 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	cancelOrder "order/internal/application/order/saga/cancel_order"
+	createOrder "order/internal/application/order/saga/create_order"
+	orderUsecase "order/internal/application/order/usecase"
+	orderDomain "order/internal/domain/order"
+	outboxDomain "order/internal/domain/outbox"
+	"order/internal/infrastructure/messaging"
+	"order/internal/infrastructure/messaging/retry"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type PublisherImpl struct {
+	warehouseWriter messaging.Writer
+	orderWriter     messaging.Writer
+	courierWriter   messaging.Writer
+	eventWriter     messaging.Writer
+}
+
+func NewPublisher(
+	warehouseWriter messaging.Writer,
+	orderWriter messaging.Writer,
+	courierWriter messaging.Writer,
+	eventWriter messaging.Writer,
+) *PublisherImpl {
+	return &PublisherImpl{
+		warehouseWriter: warehouseWriter,
+		orderWriter:     orderWriter,
+		courierWriter:   courierWriter,
+		eventWriter:     eventWriter,
+	}
+}
+
+func (p *PublisherImpl) Publish(ctx context.Context, message *outboxDomain.Message) error {
+	writer, err := p.getWriterByMessage(message)
+	if err != nil {
+		return err
+	}
+	return publishMessage(ctx, writer, message)
+}
+
+func (p *PublisherImpl) getWriterByMessage(message *outboxDomain.Message) (messaging.Writer, error) {
+	switch message.Name {
+	case createOrder.ReserveItemsCmdName,
+		createOrder.ReleaseItemsCmdName,
+		cancelOrder.ReleaseItemsCmdName:
+		return p.warehouseWriter, nil
+
+	case createOrder.AssignCourierCmdName,
+		cancelOrder.ReleaseCourierCmdName,
+		orderUsecase.ReassignCourierCmdName:
+		return p.courierWriter, nil
+
+	case createOrder.CancelOutOfStockCmdName,
+		createOrder.BeginDeliveryCmdName,
+		createOrder.CancelCourierNotFoundCmdName,
+		createOrder.CancelTimeoutCmdName,
+		cancelOrder.CancelByCustomerCmdName:
+		return p.orderWriter, nil
+
+	case orderDomain.CreatedEventName,
+		orderDomain.PartiallyFulfilledEventName,
+		orderDomain.DeliveryStartedEventName,
+		orderDomain.DeliveredEventName,
+		orderDomain.CanceledEventName,
+		orderDomain.CourierReassignedEventName:
+		return p.eventWriter, nil
+
+	default:
+		return nil, retry.Permanent(ErrInvalidOutboxMessage)
+	}
+}
+
+func encodeMessage(message *outboxDomain.Message) ([]byte, error) {
+	value := KafkaMessageValue{
+		ID:      message.ID,
+		Name:    message.Name,
+		Payload: json.RawMessage(message.Payload),
+	}
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return nil, parseError(err)
+	}
+	return buf, nil
+}
+
+func publishMessage(ctx context.Context, writer messaging.Writer, message *outboxDomain.Message) error {
+	value, err := encodeMessage(message)
+	if err != nil {
+		return err
+	}
+
+	headers, err := parseHeadersFromMessage(message)
+	if err != nil {
+		return err
+	}
+	kafkaMsg := kafka.Message{Key: encodeKey(message), Value: value, Headers: headers}
+
+	err = writer.WriteMessage(ctx, kafkaMsg)
+	return parseError(err)
+}
+
+// encodeKey returns the partition key of the message. Messages stored before keys
+// were introduced have none and are spread across partitions by the writer.
+func encodeKey(message *outboxDomain.Message) []byte {
+	if message.Key == "" {
+		return nil
+	}
+	return []byte(message.Key)
+}
+
+func parseHeadersFromMessage(message *outboxDomain.Message) ([]kafka.Header, error) {
+	var headers []kafka.Header
+
+	for k, v := range message.Metadata {
+		switch vv := v.(type) {
+		case string:
+			headers = append(headers, kafka.Header{Key: k, Value: []byte(vv)})
+		case []byte:
+			headers = append(headers, kafka.Header{Key: k, Value: vv})
+		default:
+			if b, mErr := json.Marshal(v); mErr == nil {
+				headers = append(headers, kafka.Header{Key: k, Value: b})
+			} else {
+				return nil, parseError(mErr)
+			}
+		}
+	}
+
+	return headers, nil
+}
+
+var _ outboxDomain.Publisher = (*PublisherImpl)(nil)
@@ -0,0 +1,74 @@
+package analytics
+
+import (
+	analyticsDomain "order/internal/domain/analytics"
+	orderDomain "order/internal/domain/order"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type statusCountRow struct {
+	Status orderDomain.Status `bson:"_id"`
+	Count  int                `bson:"count"`
+}
+
+type funnelRow struct {
+	Current []statusCountRow `bson:"current"`
+	Reached []statusCountRow `bson:"reached"`
+}
+
+type revenueRow struct {
+	ID struct {
+		Start    time.Time `bson:"start"`
+		Currency string    `bson:"currency"`
+	} `bson:"_id"`
+	Revenue primitive.Decimal128 `bson:"revenue"`
+	Orders  int                  `bson:"orders"`
+}
+
+type deliveryTimeRow struct {
+	Orders        int     `bson:"orders"`
+	AverageMillis float64 `bson:"average"`
+}
+
+func toStatusCounts(rows []statusCountRow) orderDomain.StatusCounts {
+	counts := make(orderDomain.StatusCounts, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts
+}
+
+func toStatusFunnel(row funnelRow) *analyticsDomain.StatusFunnel {
+	funnel := &analyticsDomain.StatusFunnel{
+		Current: toStatusCounts(row.Current),
+		Reached: toStatusCounts(row.Reached),
+	}
+	for _, count := range funnel.Current {
+		funnel.Total += count
+	}
+	return funnel
+}
+
+func toRevenueBuckets(rows []revenueRow) ([]analyticsDomain.RevenueBucket, error) {
+	buckets := make([]analyticsDomain.RevenueBucket, 0, len(rows))
+	for _, row := range rows {
+		amount, err := decimal.NewFromString(row.Revenue.String())
+		if err != nil {
+			return nil, err
+		}
+		revenue, err := orderDomain.NewMoney(amount, row.ID.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		buckets = append(buckets, analyticsDomain.RevenueBucket{
+			Start:   row.ID.Start.UTC(),
+			Revenue: revenue,
+			Orders:  row.Orders,
+		})
+	}
+	return buckets, nil
+}
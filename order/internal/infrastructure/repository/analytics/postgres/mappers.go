@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	analyticsDomain "order/internal/domain/analytics"
+	orderDomain "order/internal/domain/order"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type statusCountRow struct {
+	Status orderDomain.Status
+	Count  int
+}
+
+type revenueRow struct {
+	Start    time.Time
+	Currency string
+	Revenue  decimal.Decimal
+	Orders   int
+}
+
+type deliveryTimeRow struct {
+	Orders         int
+	AverageSeconds float64
+}
+
+func toStatusCounts(rows []statusCountRow) orderDomain.StatusCounts {
+	counts := make(orderDomain.StatusCounts, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts
+}
+
+func toRevenueBuckets(rows []revenueRow) ([]analyticsDomain.RevenueBucket, error) {
+	buckets := make([]analyticsDomain.RevenueBucket, 0, len(rows))
+	for _, row := range rows {
+		revenue, err := orderDomain.NewMoney(row.Revenue, row.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		buckets = append(buckets, analyticsDomain.RevenueBucket{
+			Start:   row.Start.UTC(),
+			Revenue: revenue,
+			Orders:  row.Orders,
+		})
+	}
+	return buckets, nil
+}
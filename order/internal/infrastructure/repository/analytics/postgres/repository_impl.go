@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+	analyticsDomain "order/internal/domain/analytics"
+	orderDomain "order/internal/domain/order"
+	orderPostgres "order/internal/infrastructure/repository/order/postgres"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RepositoryImpl answers the reports with SQL over the orders stored in
+// PostgreSQL. It only reads, so it is not part of the unit of work.
+type RepositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) *RepositoryImpl {
+	return &RepositoryImpl{db: db}
+}
+
+// GetStatusFunnel counts the orders by current status and by every status
+// recorded in their history. Every order counts as having reached Created.
+func (r *RepositoryImpl) GetStatusFunnel(ctx context.Context, period analyticsDomain.Period) (*analyticsDomain.StatusFunnel, error) {
+	var current []statusCountRow
+	err := r.db.WithContext(ctx).
+		Table("orders").
+		Scopes(createdIn(period)).
+		Select("orders.status, count(*) AS count").
+		Group("orders.status").
+		Scan(&current).Error
+	if err != nil {
+		return nil, orderPostgres.ParseError(err)
+	}
+
+	var reached []statusCountRow
+	err = r.db.WithContext(ctx).
+		Table(`orders, LATERAL (
+			SELECT orders.status
+			UNION SELECT ?::text
+			UNION SELECT change->>'to' FROM jsonb_array_elements(orders.history) AS change
+		) AS reached (status)`, orderDomain.Created).
+		Scopes(createdIn(period)).
+		Select("reached.status, count(*) AS count").
+		Group("reached.status").
+		Scan(&reached).Error
+	if err != nil {
+		return nil, orderPostgres.ParseError(err)
+	}
+
+	funnel := &analyticsDomain.StatusFunnel{
+		Current: toStatusCounts(current),
+		Reached: toStatusCounts(reached),
+	}
+	for _, count := range funnel.Current {
+		funnel.Total += count
+	}
+	return funnel, nil
+}
+
+// GetRevenue sums the totals of delivered orders. date_trunc starts weeks on
+// Monday, as the report expects.
+func (r *RepositoryImpl) GetRevenue(
+	ctx context.Context,
+	period analyticsDomain.Period,
+	granularity analyticsDomain.Granularity,
+) ([]analyticsDomain.RevenueBucket, error) {
+	var rows []revenueRow
+	err := r.db.WithContext(ctx).
+		Table("orders").
+		Scopes(createdIn(period)).
+		Where("orders.status = ?", orderDomain.Delivered).
+		Select(
+			"date_trunc(?::text, orders.created, 'UTC') AS start, orders.total_currency AS currency, "+
+				"sum(orders.total_amount) AS revenue, count(*) AS orders",
+			string(granularity),
+		).
+		Group("start, currency").
+		Order("start, currency").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, orderPostgres.ParseError(err)
+	}
+	return toRevenueBuckets(rows)
+}
+
+func (r *RepositoryImpl) GetDeliveryTime(ctx context.Context, period analyticsDomain.Period) (*analyticsDomain.DeliveryTime, error) {
+	var row deliveryTimeRow
+	err := r.db.WithContext(ctx).
+		Table("orders").
+		Joins("JOIN deliveries ON deliveries.order_id = orders.id").
+		Scopes(createdIn(period)).
+		Where("orders.status = ? AND deliveries.arrived IS NOT NULL", orderDomain.Delivered).
+		Select("count(*) AS orders, " +
+			"coalesce(avg(extract(epoch FROM deliveries.arrived - orders.created)), 0) AS average_seconds").
+		Scan(&row).Error
+	if err != nil {
+		return nil, orderPostgres.ParseError(err)
+	}
+
+	return &analyticsDomain.DeliveryTime{
+		Orders:  row.Orders,
+		Average: time.Duration(row.AverageSeconds * float64(time.Second)),
+	}, nil
+}
+
+func (r *RepositoryImpl) GetCancellationReasons(
+	ctx context.Context,
+	period analyticsDomain.Period,
+) (analyticsDomain.CancellationReasons, error) {
+	var rows []statusCountRow
+	err := r.db.WithContext(ctx).
+		Table("orders").
+		Scopes(createdIn(period)).
+		Select("orders.status, count(*) AS count").
+		Group("orders.status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, orderPostgres.ParseError(err)
+	}
+
+	reasons := make(analyticsDomain.CancellationReasons)
+	for _, row := range rows {
+		if reason, ok := orderDomain.CancelReasonOf(row.Status); ok {
+			reasons[reason] += row.Count
+		}
+	}
+	return reasons, nil
+}
+
+func createdIn(period analyticsDomain.Period) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if period.From != nil {
+			db = db.Where("orders.created >= ?", *period.From)
+		}
+		if period.To != nil {
+			db = db.Where("orders.created < ?", *period.To)
+		}
+		return db
+	}
+}
+
+var _ analyticsDomain.Repository = (*RepositoryImpl)(nil)
@@ -0,0 +1,167 @@
+package analytics
+
+import (
+	"context"
+	analyticsDomain "order/internal/domain/analytics"
+	orderDomain "order/internal/domain/order"
+	orderRepository "order/internal/infrastructure/repository/order"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// RepositoryImpl answers the reports with aggregation pipelines over the
+// orders collection. It only reads, so it is not part of the unit of work.
+type RepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func New(collection *mongo.Collection) *RepositoryImpl {
+	return &RepositoryImpl{collection: collection}
+}
+
+// GetStatusFunnel counts the orders by current status and by every status
+// recorded in their history. Every order counts as having reached Created,
+// which keeps orders placed before the history was recorded in the funnel.
+func (r *RepositoryImpl) GetStatusFunnel(ctx context.Context, period analyticsDomain.Period) (*analyticsDomain.StatusFunnel, error) {
+	reached := bson.M{"$setUnion": bson.A{
+		bson.A{"$status", string(orderDomain.Created)},
+		bson.M{"$ifNull": bson.A{"$history.to", bson.A{}}},
+	}}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: periodMatch(period)}},
+		{{Key: "$facet", Value: bson.M{
+			"current": bson.A{
+				bson.M{"$group": bson.M{"_id": "$status", "count": bson.M{"$sum": 1}}},
+			},
+			"reached": bson.A{
+				bson.M{"$project": bson.M{"statuses": reached}},
+				bson.M{"$unwind": "$statuses"},
+				bson.M{"$group": bson.M{"_id": "$statuses", "count": bson.M{"$sum": 1}}},
+			},
+		}}},
+	}
+
+	var rows []funnelRow
+	if err := r.aggregate(ctx, pipeline, &rows); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return toStatusFunnel(funnelRow{}), nil
+	}
+	return toStatusFunnel(rows[0]), nil
+}
+
+// GetRevenue sums the totals of delivered orders. Amounts are stored as
+// decimal strings and summed as Decimal128, so no precision is lost.
+func (r *RepositoryImpl) GetRevenue(
+	ctx context.Context,
+	period analyticsDomain.Period,
+	granularity analyticsDomain.Granularity,
+) ([]analyticsDomain.RevenueBucket, error) {
+	match := periodMatch(period)
+	match["status"] = orderDomain.Delivered
+
+	trunc := bson.M{"date": "$created", "unit": string(granularity), "timezone": "UTC"}
+	if granularity == analyticsDomain.Week {
+		trunc["startOfWeek"] = "monday"
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id":     bson.M{"start": bson.M{"$dateTrunc": trunc}, "currency": "$total.currency"},
+			"revenue": bson.M{"$sum": bson.M{"$toDecimal": "$total.amount"}},
+			"orders":  bson.M{"$sum": 1},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id.start", Value: 1}, {Key: "_id.currency", Value: 1}}}},
+	}
+
+	var rows []revenueRow
+	if err := r.aggregate(ctx, pipeline, &rows); err != nil {
+		return nil, err
+	}
+	return toRevenueBuckets(rows)
+}
+
+func (r *RepositoryImpl) GetDeliveryTime(ctx context.Context, period analyticsDomain.Period) (*analyticsDomain.DeliveryTime, error) {
+	match := periodMatch(period)
+	match["status"] = orderDomain.Delivered
+	match["delivery.arrived"] = bson.M{"$type": "date"}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id":     nil,
+			"orders":  bson.M{"$sum": 1},
+			"average": bson.M{"$avg": bson.M{"$subtract": bson.A{"$delivery.arrived", "$created"}}},
+		}}},
+	}
+
+	var rows []deliveryTimeRow
+	if err := r.aggregate(ctx, pipeline, &rows); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return &analyticsDomain.DeliveryTime{}, nil
+	}
+	return &analyticsDomain.DeliveryTime{
+		Orders:  rows[0].Orders,
+		Average: time.Duration(rows[0].AverageMillis * float64(time.Millisecond)),
+	}, nil
+}
+
+func (r *RepositoryImpl) GetCancellationReasons(
+	ctx context.Context,
+	period analyticsDomain.Period,
+) (analyticsDomain.CancellationReasons, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: periodMatch(period)}},
+		{{Key: "$group", Value: bson.M{"_id": "$status", "count": bson.M{"$sum": 1}}}},
+	}
+
+	var rows []statusCountRow
+	if err := r.aggregate(ctx, pipeline, &rows); err != nil {
+		return nil, err
+	}
+
+	reasons := make(analyticsDomain.CancellationReasons)
+	for _, row := range rows {
+		if reason, ok := orderDomain.CancelReasonOf(row.Status); ok {
+			reasons[reason] += row.Count
+		}
+	}
+	return reasons, nil
+}
+
+// aggregate runs the pipeline over the orders collection, whose errors it
+// reports as the order repository does.
+func (r *RepositoryImpl) aggregate(ctx context.Context, pipeline mongo.Pipeline, rows any) error {
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return orderRepository.ParseError(err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	return orderRepository.ParseError(cursor.All(ctx, rows))
+}
+
+func periodMatch(period analyticsDomain.Period) bson.M {
+	created := bson.M{}
+	if period.From != nil {
+		created["$gte"] = *period.From
+	}
+	if period.To != nil {
+		created["$lt"] = *period.To
+	}
+
+	match := bson.M{}
+	if len(created) > 0 {
+		match["created"] = created
+	}
+	return match
+}
+
+var _ analyticsDomain.Repository = (*RepositoryImpl)(nil)
@@ -0,0 +1,34 @@
+package inbox
+
+import (
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var (
+	ErrInboxMessageAlreadyExists = errors.New("inbox message already exists")
+	ErrInboxMessageNotFound      = errors.New("inbox message not found")
+)
+
+func ParseError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return ErrInboxMessageNotFound
+	}
+
+	var we mongo.WriteException
+	if errors.As(err, &we) {
+		for _, writeErr := range we.WriteErrors {
+			if writeErr.Code == 11000 {
+				return ErrInboxMessageAlreadyExists
+			}
+		}
+		return fmt.Errorf("inbox message not saved: %w", err)
+	}
+
+	return err
+}
@@ -0,0 +1,36 @@
+package inbox
+
+import (
+	inboxDomain "order/internal/domain/inbox"
+	"order/internal/infrastructure/db/documents"
+
+	"github.com/google/uuid"
+)
+
+func toDoc(m *inboxDomain.Message) *documents.InboxMessage {
+	return &documents.InboxMessage{
+		ID:        m.ID.String(),
+		Response:  string(m.Response),
+		Processed: m.Processed,
+		Expires:   m.Expires,
+	}
+}
+
+func toDomain(doc *documents.InboxMessage) (*inboxDomain.Message, error) {
+	id, err := uuid.Parse(doc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []byte
+	if doc.Response != "" {
+		response = []byte(doc.Response)
+	}
+
+	return &inboxDomain.Message{
+		ID:        id,
+		Response:  response,
+		Processed: doc.Processed,
+		Expires:   doc.Expires,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	inboxRepository "order/internal/infrastructure/repository/inbox"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// ParseError maps database errors to the errors of the MongoDB repository, so
+// callers do not depend on the backend.
+func ParseError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return inboxRepository.ErrInboxMessageNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return parsePgError(pgErr)
+	}
+
+	return err
+}
+
+func parsePgError(err *pgconn.PgError) error {
+	switch err.ConstraintName {
+	case "inbox_messages_pkey":
+		return inboxRepository.ErrInboxMessageAlreadyExists
+
+	default:
+		return fmt.Errorf("inbox message not saved: %v", err)
+	}
+}
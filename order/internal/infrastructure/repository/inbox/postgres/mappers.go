@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	inboxDomain "order/internal/domain/inbox"
+	"order/internal/infrastructure/db/postgres/tables"
+)
+
+func toModel(m *inboxDomain.Message) *tables.InboxMessage {
+	return &tables.InboxMessage{
+		ID:        m.ID,
+		Response:  m.Response,
+		Processed: m.Processed,
+		Expires:   m.Expires,
+	}
+}
+
+func toDomain(model *tables.InboxMessage) *inboxDomain.Message {
+	var response []byte
+	if len(model.Response) > 0 {
+		response = model.Response
+	}
+
+	return &inboxDomain.Message{
+		ID:        model.ID,
+		Response:  response,
+		Processed: model.Processed.UTC(),
+		Expires:   model.Expires.UTC(),
+	}
+}
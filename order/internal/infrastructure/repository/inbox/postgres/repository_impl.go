@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	inboxDomain "order/internal/domain/inbox"
+	"order/internal/infrastructure/db/postgres"
+	"order/internal/infrastructure/db/postgres/tables"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RepositoryImpl stores the inbox in the inbox_messages table and joins the
+// transaction of a unit of work through the context.
+type RepositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) *RepositoryImpl {
+	return &RepositoryImpl{db: db}
+}
+
+func (r *RepositoryImpl) Create(ctx context.Context, message *inboxDomain.Message) error {
+	err := postgres.Conn(ctx, r.db).Create(toModel(message)).Error
+	return ParseError(err)
+}
+
+func (r *RepositoryImpl) GetByID(ctx context.Context, messageID uuid.UUID) (*inboxDomain.Message, error) {
+	var model tables.InboxMessage
+	if err := postgres.Conn(ctx, r.db).First(&model, "id = ?", messageID).Error; err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomain(&model), nil
+}
+
+func (r *RepositoryImpl) DeleteExpired(ctx context.Context, now time.Time) error {
+	err := postgres.Conn(ctx, r.db).Delete(&tables.InboxMessage{}, "expires <= ?", now).Error
+	return ParseError(err)
+}
+
+var _ inboxDomain.Repository = (*RepositoryImpl)(nil)
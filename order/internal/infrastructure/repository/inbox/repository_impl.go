@@ -0,0 +1,46 @@
+package inbox
+
+import (
+	"context"
+	inboxDomain "order/internal/domain/inbox"
+	"order/internal/infrastructure/db/documents"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type RepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func New(collection *mongo.Collection) *RepositoryImpl {
+	return &RepositoryImpl{collection: collection}
+}
+
+func (r *RepositoryImpl) Create(ctx context.Context, message *inboxDomain.Message) error {
+	doc := toDoc(message)
+	_, err := r.collection.InsertOne(ctx, doc)
+	return ParseError(err)
+}
+
+func (r *RepositoryImpl) GetByID(ctx context.Context, messageID uuid.UUID) (*inboxDomain.Message, error) {
+	filter := bson.M{"_id": messageID.String()}
+
+	var doc documents.InboxMessage
+	if err := r.collection.FindOne(ctx, filter).Decode(&doc); err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomain(&doc)
+}
+
+// DeleteExpired removes the messages whose retention has passed. MongoDB also
+// expires them through a TTL index, which only runs once a minute.
+func (r *RepositoryImpl) DeleteExpired(ctx context.Context, now time.Time) error {
+	filter := bson.M{"expires": bson.M{"$lte": now}}
+	_, err := r.collection.DeleteMany(ctx, filter)
+	return ParseError(err)
+}
+
+var _ inboxDomain.Repository = (*RepositoryImpl)(nil)
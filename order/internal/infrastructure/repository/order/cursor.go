@@ -0,0 +1,38 @@
+package order
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	orderDomain "order/internal/domain/order"
+)
+
+// cursor points just past the last order of a page. Orders are ordered by
+// (created, _id), so the pair is a stable position even when timestamps collide.
+type cursor struct {
+	Created int64  `json:"c"`
+	ID      string `json:"i"`
+}
+
+func encodeCursor(order *orderDomain.Order) string {
+	data, _ := json.Marshal(cursor{
+		Created: order.Created.UnixMilli(),
+		ID:      order.ID.String(),
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(token string) (time.Time, string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, "", ErrInvalidCursor
+	}
+
+	var c cursor
+	if err = json.Unmarshal(data, &c); err != nil || c.ID == "" {
+		return time.Time{}, "", ErrInvalidCursor
+	}
+
+	return time.UnixMilli(c.Created).UTC(), c.ID, nil
+}
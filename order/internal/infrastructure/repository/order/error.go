@@ -10,6 +10,7 @@ import (
 var (
 	ErrOrderAlreadyExists = errors.New("order already exists")
 	ErrOrderNotFound      = errors.New("order not found")
+	ErrInvalidCursor      = errors.New("invalid order list cursor")
 )
 
 func ParseError(err error) error {
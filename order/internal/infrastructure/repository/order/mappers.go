@@ -10,21 +10,78 @@ import (
 
 func toDoc(o *orderDomain.Order) *documents.Order {
 	return &documents.Order{
-		ID:         o.ID.String(),
-		CustomerID: o.CustomerID.String(),
-		Status:     o.Status,
-		Created:    o.Created,
-		Version:    o.Version.String(),
-		Delivery:   toDeliveryDoc(o.Delivery),
-		Items:      toItemsDoc(o.Items),
+		ID:           o.ID.String(),
+		CustomerID:   o.CustomerID.String(),
+		Status:       o.Status,
+		Created:      o.Created,
+		Version:      o.Version.String(),
+		Delivery:     toDeliveryDoc(o.Delivery),
+		Items:        toItemsDoc(o.Items),
+		CancelReason: o.CancelReason,
+		Discount:     toDiscountDoc(o.Discount),
+		Fulfillment:  o.Fulfillment,
+		Total:        toMoneyDoc(o.Total()),
+		History:      toHistoryDoc(o.History),
 	}
 }
 
+func toHistoryDoc(domains []orderDomain.StatusChange) []documents.StatusChange {
+	history := make([]documents.StatusChange, 0, len(domains))
+	for _, domain := range domains {
+		history = append(history, documents.StatusChange{
+			From:      domain.From,
+			To:        domain.To,
+			Occurred:  domain.Occurred,
+			Actor:     toActorDoc(domain.Actor),
+			Reason:    domain.Reason,
+			MessageID: toOptionalIDDoc(domain.MessageID),
+			CourierID: toOptionalIDDoc(domain.CourierID),
+		})
+	}
+	return history
+}
+
+func toActorDoc(domain orderDomain.Actor) documents.Actor {
+	return documents.Actor{
+		Type: domain.Type,
+		ID:   toOptionalIDDoc(domain.ID),
+	}
+}
+
+func toOptionalIDDoc(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
+
 func toItemDoc(domain orderDomain.Item) documents.OrderItem {
 	return documents.OrderItem{
 		ProductID: domain.ProductID.String(),
-		Price:     domain.Price.String(),
+		Name:      domain.Name,
+		Price:     toMoneyDoc(domain.Price),
 		Count:     domain.Count,
+		Requested: domain.Requested,
+		LineTotal: toMoneyDoc(domain.Total()),
+	}
+}
+
+func toMoneyDoc(domain orderDomain.Money) documents.Money {
+	return documents.Money{
+		Amount:   domain.Amount.String(),
+		Currency: domain.Currency,
+	}
+}
+
+func toDiscountDoc(domain *orderDomain.Discount) *documents.Discount {
+	if domain == nil {
+		return nil
+	}
+
+	return &documents.Discount{
+		Code:   domain.Code,
+		Amount: toMoneyDoc(domain.Amount),
 	}
 }
 
@@ -37,11 +94,46 @@ func toDeliveryDoc(domain orderDomain.Delivery) documents.Delivery {
 
 	return documents.Delivery{
 		CourierID: courierID,
-		Address:   domain.Address,
+		Address:   toAddressDoc(domain.Address),
+		Slot:      toSlotDoc(domain.Slot),
+		Assigned:  domain.Assigned,
 		Arrived:   domain.Arrived,
 	}
 }
 
+func toAddressDoc(domain orderDomain.Address) documents.Address {
+	var location *documents.Location
+	if domain.Location != nil {
+		location = &documents.Location{
+			Latitude:  domain.Location.Latitude,
+			Longitude: domain.Location.Longitude,
+		}
+	}
+
+	return documents.Address{
+		Country:      domain.Country,
+		City:         domain.City,
+		Street:       domain.Street,
+		House:        domain.House,
+		Apartment:    domain.Apartment,
+		PostalCode:   domain.PostalCode,
+		Location:     location,
+		Instructions: domain.Instructions,
+		Legacy:       domain.Legacy,
+	}
+}
+
+func toSlotDoc(domain *orderDomain.DeliverySlot) *documents.Slot {
+	if domain == nil {
+		return nil
+	}
+
+	return &documents.Slot{
+		Start: domain.Start,
+		End:   domain.End,
+	}
+}
+
 func toItemsDoc(domains []orderDomain.Item) []documents.OrderItem {
 	items := make([]documents.OrderItem, 0, len(domains))
 	for _, domain := range domains {
@@ -74,31 +166,111 @@ func toDomain(doc *documents.Order) (*orderDomain.Order, error) {
 		return nil, err
 	}
 
+	discount, err := toDiscountDomain(doc.Discount)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := toHistoryDomain(doc.History)
+	if err != nil {
+		return nil, err
+	}
+
 	return &orderDomain.Order{
-		ID:         id,
-		CustomerID: customerID,
-		Status:     doc.Status,
-		Created:    doc.Created,
-		Version:    version,
-		Delivery:   delivery,
-		Items:      items,
+		ID:           id,
+		CustomerID:   customerID,
+		Status:       doc.Status,
+		Created:      doc.Created,
+		Version:      version,
+		Delivery:     delivery,
+		Items:        items,
+		CancelReason: doc.CancelReason,
+		Discount:     discount,
+		Fulfillment:  doc.Fulfillment,
+		History:      history,
 	}, nil
 }
 
+func toHistoryDomain(docs []documents.StatusChange) ([]orderDomain.StatusChange, error) {
+	history := make([]orderDomain.StatusChange, 0, len(docs))
+	for _, doc := range docs {
+		actorID, err := toOptionalIDDomain(doc.Actor.ID)
+		if err != nil {
+			return nil, err
+		}
+		messageID, err := toOptionalIDDomain(doc.MessageID)
+		if err != nil {
+			return nil, err
+		}
+		courierID, err := toOptionalIDDomain(doc.CourierID)
+		if err != nil {
+			return nil, err
+		}
+
+		history = append(history, orderDomain.StatusChange{
+			From:      doc.From,
+			To:        doc.To,
+			Occurred:  doc.Occurred,
+			Actor:     orderDomain.Actor{Type: doc.Actor.Type, ID: actorID},
+			Reason:    doc.Reason,
+			MessageID: messageID,
+			CourierID: courierID,
+		})
+	}
+	return history, nil
+}
+
+func toOptionalIDDomain(doc *string) (*uuid.UUID, error) {
+	if doc == nil {
+		return nil, nil
+	}
+	id, err := uuid.Parse(*doc)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
 func toItemDomain(doc documents.OrderItem) (orderDomain.Item, error) {
 	prodID, err := uuid.Parse(doc.ProductID)
 	if err != nil {
 		return orderDomain.Item{}, err
 	}
-	price, err := decimal.NewFromString(doc.Price)
+	price, err := toMoneyDomain(doc.Price)
 	if err != nil {
 		return orderDomain.Item{}, err
 	}
 
 	return orderDomain.Item{
 		ProductID: prodID,
+		Name:      doc.Name,
 		Price:     price,
 		Count:     doc.Count,
+		Requested: doc.Requested,
+	}, nil
+}
+
+func toMoneyDomain(doc documents.Money) (orderDomain.Money, error) {
+	amount, err := decimal.NewFromString(doc.Amount)
+	if err != nil {
+		return orderDomain.Money{}, err
+	}
+	return orderDomain.NewMoney(amount, doc.Currency)
+}
+
+func toDiscountDomain(doc *documents.Discount) (*orderDomain.Discount, error) {
+	if doc == nil {
+		return nil, nil
+	}
+
+	amount, err := toMoneyDomain(doc.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderDomain.Discount{
+		Code:   doc.Code,
+		Amount: amount,
 	}, nil
 }
 
@@ -114,11 +286,46 @@ func toDeliveryDomain(doc documents.Delivery) (orderDomain.Delivery, error) {
 
 	return orderDomain.Delivery{
 		CourierID: courierID,
-		Address:   doc.Address,
+		Address:   toAddressDomain(doc.Address),
+		Slot:      toSlotDomain(doc.Slot),
+		Assigned:  doc.Assigned,
 		Arrived:   doc.Arrived,
 	}, nil
 }
 
+func toAddressDomain(doc documents.Address) orderDomain.Address {
+	var location *orderDomain.Location
+	if doc.Location != nil {
+		location = &orderDomain.Location{
+			Latitude:  doc.Location.Latitude,
+			Longitude: doc.Location.Longitude,
+		}
+	}
+
+	return orderDomain.Address{
+		Country:      doc.Country,
+		City:         doc.City,
+		Street:       doc.Street,
+		House:        doc.House,
+		Apartment:    doc.Apartment,
+		PostalCode:   doc.PostalCode,
+		Location:     location,
+		Instructions: doc.Instructions,
+		Legacy:       doc.Legacy,
+	}
+}
+
+func toSlotDomain(doc *documents.Slot) *orderDomain.DeliverySlot {
+	if doc == nil {
+		return nil
+	}
+
+	return &orderDomain.DeliverySlot{
+		Start: doc.Start,
+		End:   doc.End,
+	}
+}
+
 func toDomains(docs []documents.Order) ([]*orderDomain.Order, error) {
 	orders := make([]*orderDomain.Order, 0, len(docs))
 	for _, doc := range docs {
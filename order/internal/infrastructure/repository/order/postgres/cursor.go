@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	orderDomain "order/internal/domain/order"
+	orderRepository "order/internal/infrastructure/repository/order"
+
+	"github.com/google/uuid"
+)
+
+// cursor points just past the last order of a page. Orders are ordered by
+// (created, id); created is kept to the microsecond PostgreSQL stores.
+type cursor struct {
+	Created int64  `json:"c"`
+	ID      string `json:"i"`
+}
+
+func encodeCursor(order *orderDomain.Order) string {
+	data, _ := json.Marshal(cursor{
+		Created: order.Created.UnixMicro(),
+		ID:      order.ID.String(),
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(token string) (time.Time, uuid.UUID, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, uuid.Nil, orderRepository.ErrInvalidCursor
+	}
+
+	var c cursor
+	if err = json.Unmarshal(data, &c); err != nil {
+		return time.Time{}, uuid.Nil, orderRepository.ErrInvalidCursor
+	}
+	id, err := uuid.Parse(c.ID)
+	if err != nil {
+		return time.Time{}, uuid.Nil, orderRepository.ErrInvalidCursor
+	}
+
+	return time.UnixMicro(c.Created).UTC(), id, nil
+}
@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	orderRepository "order/internal/infrastructure/repository/order"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// ParseError maps database errors to the errors of the MongoDB repository, so
+// callers do not depend on the backend.
+func ParseError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return orderRepository.ErrOrderNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return parsePgError(pgErr)
+	}
+
+	return err
+}
+
+func parsePgError(err *pgconn.PgError) error {
+	switch err.ConstraintName {
+	case "orders_pkey":
+		return orderRepository.ErrOrderAlreadyExists
+
+	default:
+		return fmt.Errorf("order not saved: %v", err)
+	}
+}
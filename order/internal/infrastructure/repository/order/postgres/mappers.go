@@ -0,0 +1,218 @@
+package postgres
+
+import (
+	orderDomain "order/internal/domain/order"
+	"order/internal/infrastructure/db/postgres/tables"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func toModel(o *orderDomain.Order) *tables.Order {
+	total := o.Total()
+	model := &tables.Order{
+		ID:            o.ID,
+		CustomerID:    o.CustomerID,
+		Status:        o.Status,
+		Created:       o.Created,
+		Version:       o.Version,
+		CancelReason:  o.CancelReason,
+		Fulfillment:   o.Fulfillment,
+		TotalAmount:   total.Amount,
+		TotalCurrency: total.Currency,
+		History:       toHistoryModel(o.History),
+		Items:         toItemsModel(o.ID, o.Items),
+		Delivery:      toDeliveryModel(o.ID, o.Delivery),
+	}
+	if o.Discount != nil {
+		model.DiscountCode = &o.Discount.Code
+		model.DiscountAmount = decimal.NewNullDecimal(o.Discount.Amount.Amount)
+		model.DiscountCurrency = &o.Discount.Amount.Currency
+	}
+	return model
+}
+
+func toHistoryModel(domains []orderDomain.StatusChange) tables.StatusChanges {
+	history := make(tables.StatusChanges, 0, len(domains))
+	for _, domain := range domains {
+		history = append(history, tables.StatusChange{
+			From:      domain.From,
+			To:        domain.To,
+			Occurred:  domain.Occurred,
+			Actor:     tables.Actor{Type: domain.Actor.Type, ID: domain.Actor.ID},
+			Reason:    domain.Reason,
+			MessageID: domain.MessageID,
+			CourierID: domain.CourierID,
+		})
+	}
+	return history
+}
+
+func toItemsModel(orderID uuid.UUID, domains []orderDomain.Item) []tables.OrderItem {
+	items := make([]tables.OrderItem, 0, len(domains))
+	for i, domain := range domains {
+		items = append(items, tables.OrderItem{
+			OrderID:       orderID,
+			Position:      i,
+			ProductID:     domain.ProductID,
+			Name:          domain.Name,
+			PriceAmount:   domain.Price.Amount,
+			PriceCurrency: domain.Price.Currency,
+			Count:         domain.Count,
+			Requested:     domain.Requested,
+		})
+	}
+	return items
+}
+
+func toDeliveryModel(orderID uuid.UUID, domain orderDomain.Delivery) *tables.Delivery {
+	model := &tables.Delivery{
+		OrderID:             orderID,
+		CourierID:           domain.CourierID,
+		AddressCountry:      domain.Address.Country,
+		AddressCity:         domain.Address.City,
+		AddressStreet:       domain.Address.Street,
+		AddressHouse:        domain.Address.House,
+		AddressApartment:    domain.Address.Apartment,
+		AddressPostalCode:   domain.Address.PostalCode,
+		AddressInstructions: domain.Address.Instructions,
+		AddressLegacy:       domain.Address.Legacy,
+		Assigned:            domain.Assigned,
+		Arrived:             domain.Arrived,
+	}
+	if location := domain.Address.Location; location != nil {
+		model.AddressLatitude = &location.Latitude
+		model.AddressLongitude = &location.Longitude
+	}
+	if slot := domain.Slot; slot != nil {
+		model.SlotStart = &slot.Start
+		model.SlotEnd = &slot.End
+	}
+	return model
+}
+
+func toDomain(model *tables.Order) (*orderDomain.Order, error) {
+	items, err := toItemsDomain(model.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	discount, err := toDiscountDomain(model)
+	if err != nil {
+		return nil, err
+	}
+
+	var delivery orderDomain.Delivery
+	if model.Delivery != nil {
+		delivery = toDeliveryDomain(model.Delivery)
+	}
+
+	return &orderDomain.Order{
+		ID:           model.ID,
+		CustomerID:   model.CustomerID,
+		Status:       model.Status,
+		Created:      model.Created.UTC(),
+		Version:      model.Version,
+		Delivery:     delivery,
+		Items:        items,
+		CancelReason: model.CancelReason,
+		Discount:     discount,
+		Fulfillment:  model.Fulfillment,
+		History:      toHistoryDomain(model.History),
+	}, nil
+}
+
+func toHistoryDomain(models tables.StatusChanges) []orderDomain.StatusChange {
+	history := make([]orderDomain.StatusChange, 0, len(models))
+	for _, model := range models {
+		history = append(history, orderDomain.StatusChange{
+			From:      model.From,
+			To:        model.To,
+			Occurred:  model.Occurred,
+			Actor:     orderDomain.Actor{Type: model.Actor.Type, ID: model.Actor.ID},
+			Reason:    model.Reason,
+			MessageID: model.MessageID,
+			CourierID: model.CourierID,
+		})
+	}
+	return history
+}
+
+func toItemsDomain(models []tables.OrderItem) ([]orderDomain.Item, error) {
+	items := make([]orderDomain.Item, 0, len(models))
+	for _, model := range models {
+		price, err := orderDomain.NewMoney(model.PriceAmount, model.PriceCurrency)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, orderDomain.Item{
+			ProductID: model.ProductID,
+			Name:      model.Name,
+			Price:     price,
+			Count:     model.Count,
+			Requested: model.Requested,
+		})
+	}
+	return items, nil
+}
+
+func toDiscountDomain(model *tables.Order) (*orderDomain.Discount, error) {
+	if model.DiscountCode == nil || !model.DiscountAmount.Valid || model.DiscountCurrency == nil {
+		return nil, nil
+	}
+
+	amount, err := orderDomain.NewMoney(model.DiscountAmount.Decimal, *model.DiscountCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderDomain.Discount{
+		Code:   *model.DiscountCode,
+		Amount: amount,
+	}, nil
+}
+
+func toDeliveryDomain(model *tables.Delivery) orderDomain.Delivery {
+	address := orderDomain.Address{
+		Country:      model.AddressCountry,
+		City:         model.AddressCity,
+		Street:       model.AddressStreet,
+		House:        model.AddressHouse,
+		Apartment:    model.AddressApartment,
+		PostalCode:   model.AddressPostalCode,
+		Instructions: model.AddressInstructions,
+		Legacy:       model.AddressLegacy,
+	}
+	if model.AddressLatitude != nil && model.AddressLongitude != nil {
+		address.Location = &orderDomain.Location{
+			Latitude:  *model.AddressLatitude,
+			Longitude: *model.AddressLongitude,
+		}
+	}
+
+	var slot *orderDomain.DeliverySlot
+	if model.SlotStart != nil && model.SlotEnd != nil {
+		slot = &orderDomain.DeliverySlot{Start: *model.SlotStart, End: *model.SlotEnd}
+	}
+
+	return orderDomain.Delivery{
+		CourierID: model.CourierID,
+		Address:   address,
+		Slot:      slot,
+		Assigned:  model.Assigned,
+		Arrived:   model.Arrived,
+	}
+}
+
+func toDomains(models []tables.Order) ([]*orderDomain.Order, error) {
+	orders := make([]*orderDomain.Order, 0, len(models))
+	for i := range models {
+		o, err := toDomain(&models[i])
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, o)
+	}
+	return orders, nil
+}
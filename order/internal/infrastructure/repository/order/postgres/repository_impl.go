@@ -0,0 +1,245 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	orderDomain "order/internal/domain/order"
+	"order/internal/infrastructure/db/postgres"
+	"order/internal/infrastructure/db/postgres/tables"
+	orderRepository "order/internal/infrastructure/repository/order"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RepositoryImpl stores orders in the orders, order_items and deliveries
+// tables. It behaves like the MongoDB repository, errors included, and joins
+// the transaction of a unit of work through the context.
+type RepositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) *RepositoryImpl {
+	return &RepositoryImpl{db: db}
+}
+
+func (r *RepositoryImpl) Create(ctx context.Context, order *orderDomain.Order) error {
+	model := toModel(order)
+	err := postgres.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(model).Error; err != nil {
+			return err
+		}
+		return saveChildren(tx, model)
+	})
+	return ParseError(err)
+}
+
+// Update replaces the order if it still has the version it was read with and
+// gives it a new one, as the MongoDB repository does.
+func (r *RepositoryImpl) Update(ctx context.Context, order *orderDomain.Order) error {
+	oldVersion := order.Version
+	order.Version = uuid.New()
+	model := toModel(order)
+
+	err := postgres.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(model).
+			Where("version = ?", oldVersion).
+			Select("*").
+			Omit(clause.Associations).
+			Updates(model)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return orderRepository.ErrOrderNotFound
+		}
+
+		if err := tx.Delete(&tables.OrderItem{}, "order_id = ?", model.ID).Error; err != nil {
+			return err
+		}
+		return saveChildren(tx, model)
+	})
+	return ParseError(err)
+}
+
+// saveChildren writes the items and the delivery of the order; items are
+// expected to be gone already.
+func saveChildren(tx *gorm.DB, model *tables.Order) error {
+	if len(model.Items) > 0 {
+		if err := tx.Create(&model.Items).Error; err != nil {
+			return err
+		}
+	}
+	return tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(model.Delivery).Error
+}
+
+func (r *RepositoryImpl) GetByID(ctx context.Context, orderID uuid.UUID) (*orderDomain.Order, error) {
+	var model tables.Order
+	if err := r.query(ctx).First(&model, "orders.id = ?", orderID).Error; err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomain(&model)
+}
+
+func (r *RepositoryImpl) GetAllByCustomer(
+	ctx context.Context,
+	customerID uuid.UUID,
+	query orderDomain.ListQuery,
+) (*orderDomain.Page, error) {
+	return r.list(r.query(ctx).Where("orders.customer_id = ?", customerID), query)
+}
+
+func (r *RepositoryImpl) GetCurrentByCourier(ctx context.Context, courierID uuid.UUID) ([]*orderDomain.Order, error) {
+	var models []tables.Order
+	err := r.query(ctx).
+		Joins("JOIN deliveries ON deliveries.order_id = orders.id").
+		Where("deliveries.courier_id = ? AND orders.status = ?", courierID, orderDomain.Delivering).
+		Order("deliveries.assigned ASC NULLS FIRST, orders.id ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomains(models)
+}
+
+func (r *RepositoryImpl) GetHistoryByCourier(
+	ctx context.Context,
+	courierID uuid.UUID,
+	query orderDomain.ListQuery,
+) (*orderDomain.Page, error) {
+	return r.list(r.deliveredBy(r.query(ctx), courierID), query)
+}
+
+func (r *RepositoryImpl) Search(
+	ctx context.Context,
+	filter orderDomain.SearchFilter,
+	query orderDomain.ListQuery,
+) (*orderDomain.Page, error) {
+	db := r.query(ctx)
+	if filter.CustomerID != nil {
+		db = db.Where("orders.customer_id = ?", *filter.CustomerID)
+	}
+	if filter.CourierID != nil {
+		db = r.byCourier(db, *filter.CourierID)
+	}
+	if filter.ProductID != nil {
+		db = db.Where(
+			"EXISTS (SELECT 1 FROM order_items WHERE order_items.order_id = orders.id AND order_items.product_id = ?)",
+			*filter.ProductID,
+		)
+	}
+	return r.list(db, query)
+}
+
+func (r *RepositoryImpl) CountByCourier(
+	ctx context.Context,
+	courierID uuid.UUID,
+	createdFrom, createdTo *time.Time,
+) (orderDomain.StatusCounts, error) {
+	var rows []struct {
+		Status orderDomain.Status
+		Count  int
+	}
+	err := r.deliveredBy(postgres.Conn(ctx, r.db).Table("orders"), courierID).
+		Scopes(createdIn(createdFrom, createdTo)).
+		Select("orders.status, count(*) AS count").
+		Group("orders.status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, ParseError(err)
+	}
+
+	counts := make(orderDomain.StatusCounts, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
+// query selects orders with their items and delivery.
+func (r *RepositoryImpl) query(ctx context.Context) *gorm.DB {
+	return postgres.Conn(ctx, r.db).
+		Model(&tables.Order{}).
+		Preload("Items", func(db *gorm.DB) *gorm.DB { return db.Order("position") }).
+		Preload("Delivery")
+}
+
+func (r *RepositoryImpl) byCourier(db *gorm.DB, courierID uuid.UUID) *gorm.DB {
+	return db.Where(
+		"EXISTS (SELECT 1 FROM deliveries WHERE deliveries.order_id = orders.id AND deliveries.courier_id = ?)",
+		courierID,
+	)
+}
+
+// deliveredBy keeps the orders the courier was ever assigned to, found in the
+// history as the MongoDB repository does, or through the current courier for
+// the orders assigned before the history named couriers.
+func (r *RepositoryImpl) deliveredBy(db *gorm.DB, courierID uuid.UUID) *gorm.DB {
+	return db.Where(
+		"(EXISTS (SELECT 1 FROM deliveries WHERE deliveries.order_id = orders.id AND deliveries.courier_id = ?)"+
+			" OR orders.history @> ?)",
+		courierID, fmt.Sprintf(`[{"courier_id": %q}]`, courierID),
+	)
+}
+
+func (r *RepositoryImpl) list(db *gorm.DB, query orderDomain.ListQuery) (*orderDomain.Page, error) {
+	if len(query.Statuses) > 0 {
+		db = db.Where("orders.status IN ?", query.Statuses)
+	}
+	db = db.Scopes(createdIn(query.CreatedFrom, query.CreatedTo))
+
+	direction, op := "DESC", "<"
+	if query.Sort == orderDomain.OldestFirst {
+		direction, op = "ASC", ">"
+	}
+
+	if query.Cursor != "" {
+		lastCreated, lastID, err := decodeCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where("(orders.created, orders.id) "+op+" (?, ?)", lastCreated, lastID)
+	}
+
+	// One extra row tells whether another page follows.
+	var models []tables.Order
+	err := db.
+		Order("orders.created " + direction + ", orders.id " + direction).
+		Limit(query.Limit + 1).
+		Find(&models).Error
+	if err != nil {
+		return nil, ParseError(err)
+	}
+
+	hasMore := len(models) > query.Limit
+	if hasMore {
+		models = models[:query.Limit]
+	}
+
+	orders, err := toDomains(models)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &orderDomain.Page{Orders: orders}
+	if hasMore && len(orders) > 0 {
+		page.NextCursor = encodeCursor(orders[len(orders)-1])
+	}
+	return page, nil
+}
+
+func createdIn(from, to *time.Time) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if from != nil {
+			db = db.Where("orders.created >= ?", *from)
+		}
+		if to != nil {
+			db = db.Where("orders.created < ?", *to)
+		}
+		return db
+	}
+}
+
+var _ orderDomain.Repository = (*RepositoryImpl)(nil)
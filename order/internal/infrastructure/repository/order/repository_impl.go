@@ -3,12 +3,14 @@ package order
 import (
 	"context"
 	"order/internal/infrastructure/db/documents"
+	"time"
 
 	orderDomain "order/internal/domain/order"
 
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type RepositoryImpl struct {
@@ -52,9 +54,19 @@ func (r *RepositoryImpl) GetByID(ctx context.Context, orderID uuid.UUID) (*order
 	return toDomain(&doc)
 }
 
-func (r *RepositoryImpl) GetAllByCustomer(ctx context.Context, customerID uuid.UUID) ([]*orderDomain.Order, error) {
-	filter := bson.M{"customer_id": customerID.String()}
-	cursor, err := r.collection.Find(ctx, filter)
+func (r *RepositoryImpl) GetAllByCustomer(
+	ctx context.Context,
+	customerID uuid.UUID,
+	query orderDomain.ListQuery,
+) (*orderDomain.Page, error) {
+	return r.list(ctx, bson.M{"customer_id": customerID.String()}, query)
+}
+
+func (r *RepositoryImpl) GetCurrentByCourier(ctx context.Context, courierID uuid.UUID) ([]*orderDomain.Order, error) {
+	filter := bson.M{"delivery.courier_id": courierID.String(), "status": orderDomain.Delivering}
+	opts := options.Find().SetSort(bson.D{{Key: "delivery.assigned", Value: 1}, {Key: "_id", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, ParseError(err)
 	}
@@ -67,9 +79,110 @@ func (r *RepositoryImpl) GetAllByCustomer(ctx context.Context, customerID uuid.U
 	return toDomains(docs)
 }
 
-func (r *RepositoryImpl) GetCurrentByCourier(ctx context.Context, courierID uuid.UUID) ([]*orderDomain.Order, error) {
-	filter := bson.M{"delivery.courier_id": courierID.String()}
-	cursor, err := r.collection.Find(ctx, filter)
+func (r *RepositoryImpl) GetHistoryByCourier(
+	ctx context.Context,
+	courierID uuid.UUID,
+	query orderDomain.ListQuery,
+) (*orderDomain.Page, error) {
+	return r.list(ctx, deliveredBy(courierID), query)
+}
+
+func (r *RepositoryImpl) Search(
+	ctx context.Context,
+	filter orderDomain.SearchFilter,
+	query orderDomain.ListQuery,
+) (*orderDomain.Page, error) {
+	match := bson.M{}
+	if filter.CustomerID != nil {
+		match["customer_id"] = filter.CustomerID.String()
+	}
+	if filter.CourierID != nil {
+		match["delivery.courier_id"] = filter.CourierID.String()
+	}
+	if filter.ProductID != nil {
+		match["items.product_id"] = filter.ProductID.String()
+	}
+	return r.list(ctx, match, query)
+}
+
+func (r *RepositoryImpl) CountByCourier(
+	ctx context.Context,
+	courierID uuid.UUID,
+	createdFrom, createdTo *time.Time,
+) (orderDomain.StatusCounts, error) {
+	match := deliveredBy(courierID)
+	if created := createdRange(createdFrom, createdTo); len(created) > 0 {
+		match["created"] = created
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{"_id": "$status", "count": bson.M{"$sum": 1}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var rows []struct {
+		Status orderDomain.Status `bson:"_id"`
+		Count  int                `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, ParseError(err)
+	}
+
+	counts := make(orderDomain.StatusCounts, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
+// deliveredBy matches the orders the courier was ever assigned to. The history
+// names every courier since it records the handovers; the current courier
+// covers the orders assigned before it did. The conditions sit under $and, as
+// list takes $or for its cursor.
+func deliveredBy(courierID uuid.UUID) bson.M {
+	return bson.M{"$and": bson.A{bson.M{"$or": bson.A{
+		bson.M{"delivery.courier_id": courierID.String()},
+		bson.M{"history.courier_id": courierID.String()},
+	}}}}
+}
+
+func (r *RepositoryImpl) list(ctx context.Context, filter bson.M, query orderDomain.ListQuery) (*orderDomain.Page, error) {
+	if len(query.Statuses) > 0 {
+		filter["status"] = bson.M{"$in": query.Statuses}
+	}
+
+	if created := createdRange(query.CreatedFrom, query.CreatedTo); len(created) > 0 {
+		filter["created"] = created
+	}
+
+	direction, op := -1, "$lt"
+	if query.Sort == orderDomain.OldestFirst {
+		direction, op = 1, "$gt"
+	}
+
+	if query.Cursor != "" {
+		lastCreated, lastID, err := decodeCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter["$or"] = bson.A{
+			bson.M{"created": bson.M{op: lastCreated}},
+			bson.M{"created": lastCreated, "_id": bson.M{op: lastID}},
+		}
+	}
+
+	// One extra document tells whether another page follows.
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created", Value: direction}, {Key: "_id", Value: direction}}).
+		SetLimit(int64(query.Limit) + 1)
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, ParseError(err)
 	}
@@ -79,7 +192,33 @@ func (r *RepositoryImpl) GetCurrentByCourier(ctx context.Context, courierID uuid
 	if err := cursor.All(ctx, &docs); err != nil {
 		return nil, ParseError(err)
 	}
-	return toDomains(docs)
+
+	hasMore := len(docs) > query.Limit
+	if hasMore {
+		docs = docs[:query.Limit]
+	}
+
+	orders, err := toDomains(docs)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &orderDomain.Page{Orders: orders}
+	if hasMore && len(orders) > 0 {
+		page.NextCursor = encodeCursor(orders[len(orders)-1])
+	}
+	return page, nil
+}
+
+func createdRange(from, to *time.Time) bson.M {
+	created := bson.M{}
+	if from != nil {
+		created["$gte"] = *from
+	}
+	if to != nil {
+		created["$lt"] = *to
+	}
+	return created
 }
 
 var _ orderDomain.Repository = (*RepositoryImpl)(nil)
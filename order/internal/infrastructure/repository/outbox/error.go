@@ -0,0 +1,34 @@
+package outbox
+
+import (
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var (
+	ErrOutboxMessageAlreadyExists = errors.New("outbox message already exists")
+	ErrOutboxMessageNotFound      = errors.New("outbox message not found")
+)
+
+func ParseError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return ErrOutboxMessageNotFound
+	}
+
+	var we mongo.WriteException
+	if errors.As(err, &we) {
+		for _, writeErr := range we.WriteErrors {
+			if writeErr.Code == 11000 {
+				return ErrOutboxMessageAlreadyExists
+			}
+		}
+		return fmt.Errorf("outbox message not saved: %w", err)
+	}
+
+	return err
+}
@@ -0,0 +1,48 @@
+package outbox
+
+import (
+	outboxDomain "order/internal/domain/outbox"
+	"order/internal/infrastructure/db/documents"
+
+	"github.com/google/uuid"
+)
+
+func toDoc(m *outboxDomain.Message) *documents.OutboxMessage {
+	return &documents.OutboxMessage{
+		ID:          m.ID.String(),
+		Name:        m.Name,
+		Key:         m.Key,
+		Payload:     string(m.Payload),
+		Metadata:    m.Metadata,
+		Attempts:    m.Attempts,
+		NextAttempt: m.NextAttempt,
+		Created:     m.Created,
+		Failed:      m.Failed,
+		Error:       m.Error,
+	}
+}
+
+func toDomain(doc *documents.OutboxMessage) (*outboxDomain.Message, error) {
+	id, err := uuid.Parse(doc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := doc.Metadata
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+
+	return &outboxDomain.Message{
+		ID:          id,
+		Name:        doc.Name,
+		Key:         doc.Key,
+		Payload:     []byte(doc.Payload),
+		Metadata:    metadata,
+		Attempts:    doc.Attempts,
+		NextAttempt: doc.NextAttempt,
+		Created:     doc.Created,
+		Failed:      doc.Failed,
+		Error:       doc.Error,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	outboxRepository "order/internal/infrastructure/repository/outbox"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// ParseError maps database errors to the errors of the MongoDB repository, so
+// callers do not depend on the backend.
+func ParseError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return outboxRepository.ErrOutboxMessageNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return parsePgError(pgErr)
+	}
+
+	return err
+}
+
+func parsePgError(err *pgconn.PgError) error {
+	switch err.ConstraintName {
+	case "outbox_messages_pkey":
+		return outboxRepository.ErrOutboxMessageAlreadyExists
+
+	default:
+		return fmt.Errorf("outbox message not saved: %v", err)
+	}
+}
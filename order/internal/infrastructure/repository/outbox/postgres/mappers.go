@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	outboxDomain "order/internal/domain/outbox"
+	"order/internal/infrastructure/db/postgres/tables"
+	"time"
+)
+
+func toModel(m *outboxDomain.Message) *tables.OutboxMessage {
+	model := &tables.OutboxMessage{
+		ID:          m.ID,
+		Name:        m.Name,
+		Payload:     m.Payload,
+		Metadata:    m.Metadata,
+		Attempts:    m.Attempts,
+		NextAttempt: m.NextAttempt,
+		Created:     m.Created,
+		Failed:      m.Failed,
+	}
+	if m.Key != "" {
+		model.Key = &m.Key
+	}
+	if m.Error != "" {
+		model.Error = &m.Error
+	}
+	return model
+}
+
+func toDomain(model *tables.OutboxMessage) *outboxDomain.Message {
+	var key, failure string
+	if model.Key != nil {
+		key = *model.Key
+	}
+	if model.Error != nil {
+		failure = *model.Error
+	}
+	var failed *time.Time
+	if model.Failed != nil {
+		utc := model.Failed.UTC()
+		failed = &utc
+	}
+
+	metadata := map[string]any(model.Metadata)
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+
+	return &outboxDomain.Message{
+		ID:          model.ID,
+		Name:        model.Name,
+		Key:         key,
+		Payload:     model.Payload,
+		Metadata:    metadata,
+		Attempts:    model.Attempts,
+		NextAttempt: model.NextAttempt.UTC(),
+		Created:     model.Created.UTC(),
+		Failed:      failed,
+		Error:       failure,
+	}
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	outboxDomain "order/internal/domain/outbox"
+	"order/internal/infrastructure/db/postgres"
+	"order/internal/infrastructure/db/postgres/tables"
+	outboxRepository "order/internal/infrastructure/repository/outbox"
+	"time"
+
+	"go.opentelemetry.io/otel/propagation"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RepositoryImpl stores outbox messages in the outbox_messages table. It
+// behaves like the MongoDB repository, errors included, and joins the
+// transaction of a unit of work through the context.
+type RepositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) *RepositoryImpl {
+	return &RepositoryImpl{db: db}
+}
+
+func (r *RepositoryImpl) Create(ctx context.Context, message *outboxDomain.Message) error {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	for k, v := range carrier {
+		message.Metadata[k] = v
+	}
+
+	err := postgres.Conn(ctx, r.db).Create(toModel(message)).Error
+	return ParseError(err)
+}
+
+// CreateOnce inserts the message unless its ID is taken, without the unique
+// violation that would abort the transaction it runs in.
+func (r *RepositoryImpl) CreateOnce(ctx context.Context, message *outboxDomain.Message) error {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	for k, v := range carrier {
+		message.Metadata[k] = v
+	}
+
+	err := postgres.Conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(toModel(message)).Error
+	return ParseError(err)
+}
+
+// Update writes every column of the message, which also drops its lease.
+func (r *RepositoryImpl) Update(ctx context.Context, message *outboxDomain.Message) error {
+	model := toModel(message)
+
+	res := postgres.Conn(ctx, r.db).Model(model).Select("*").Updates(model)
+	if res.Error != nil {
+		return ParseError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return outboxRepository.ErrOutboxMessageNotFound
+	}
+
+	return nil
+}
+
+func (r *RepositoryImpl) Delete(ctx context.Context, message *outboxDomain.Message) error {
+	res := postgres.Conn(ctx, r.db).Delete(&tables.OutboxMessage{}, "id = ?", message.ID)
+	if res.Error != nil {
+		return ParseError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return outboxRepository.ErrOutboxMessageNotFound
+	}
+
+	return nil
+}
+
+// ClaimPending leases the oldest message that is due among the oldest messages
+// of every key, as the MongoDB repository does, leaving the failed ones out. A
+// message without a key is a key of its own. Rows another claim has locked are skipped, so concurrent
+// relays never receive the same message.
+func (r *RepositoryImpl) ClaimPending(ctx context.Context, leaseUntil time.Time) (*outboxDomain.Message, error) {
+	const query = `UPDATE outbox_messages SET lease_until = ? WHERE id = (
+		SELECT m.id FROM outbox_messages m
+		WHERE m.failed IS NULL
+			AND m.next_attempt <= ?
+			AND (m.lease_until IS NULL OR m.lease_until < ?)
+			AND (m.key IS NULL OR NOT EXISTS (
+				SELECT 1 FROM outbox_messages e
+				WHERE e.key = m.key AND e.failed IS NULL AND (e.created, e.id) < (m.created, m.id)
+			))
+		ORDER BY m.created, m.id
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	) RETURNING *`
+
+	now := time.Now()
+	var models []tables.OutboxMessage
+	if err := postgres.Conn(ctx, r.db).Raw(query, leaseUntil, now, now).Scan(&models).Error; err != nil {
+		return nil, ParseError(err)
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+	return toDomain(&models[0]), nil
+}
+
+var _ outboxDomain.Repository = (*RepositoryImpl)(nil)
@@ -0,0 +1,156 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	outboxDomain "order/internal/domain/outbox"
+	"order/internal/infrastructure/db/documents"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+type RepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func New(collection *mongo.Collection) *RepositoryImpl {
+	return &RepositoryImpl{collection: collection}
+}
+
+func (r *RepositoryImpl) Create(ctx context.Context, message *outboxDomain.Message) error {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	for k, v := range carrier {
+		message.Metadata[k] = v
+	}
+
+	doc := toDoc(message)
+	_, err := r.collection.InsertOne(ctx, doc)
+	return ParseError(err)
+}
+
+// CreateOnce inserts the message with an upsert that sets nothing on a match,
+// since a duplicate key error would abort the transaction it runs in.
+func (r *RepositoryImpl) CreateOnce(ctx context.Context, message *outboxDomain.Message) error {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	for k, v := range carrier {
+		message.Metadata[k] = v
+	}
+
+	filter := bson.M{"_id": message.ID.String()}
+	update := bson.M{"$setOnInsert": toDoc(message)}
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return ParseError(err)
+}
+
+func (r *RepositoryImpl) Update(ctx context.Context, message *outboxDomain.Message) error {
+	doc := toDoc(message)
+
+	filter := bson.M{"_id": message.ID.String()}
+	result, err := r.collection.ReplaceOne(ctx, filter, doc)
+	if err != nil {
+		return ParseError(err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrOutboxMessageNotFound
+	}
+
+	return nil
+}
+
+func (r *RepositoryImpl) Delete(ctx context.Context, message *outboxDomain.Message) error {
+	filter := bson.M{"_id": message.ID.String()}
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return ParseError(err)
+	}
+	if result.DeletedCount == 0 {
+		return ErrOutboxMessageNotFound
+	}
+
+	return nil
+}
+
+// ClaimPending leases the oldest message that is due among the oldest messages
+// of every key. A message waiting for its retry or leased to a relay thus holds
+// back the later messages with its key, which are published in the order they
+// were created. Failed messages are left out.
+func (r *RepositoryImpl) ClaimPending(ctx context.Context, leaseUntil time.Time) (*outboxDomain.Message, error) {
+	for {
+		id, err := r.nextPending(ctx)
+		if err != nil || id == "" {
+			return nil, err
+		}
+
+		filter := pendingFilter(time.Now())
+		filter["_id"] = id
+		update := bson.M{"$set": bson.M{"lease_until": leaseUntil}}
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+		var doc documents.OutboxMessage
+		err = r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// Another relay claimed the message first
+			continue
+		}
+		if err != nil {
+			return nil, ParseError(err)
+		}
+		return toDomain(&doc)
+	}
+}
+
+// nextPending returns the ID of the message ClaimPending should lease next, or
+// an empty string if there is none. A message without a key is a key of its own.
+func (r *RepositoryImpl) nextPending(ctx context.Context) (string, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"failed": nil}}},
+		{{Key: "$sort", Value: bson.D{{Key: "key", Value: 1}, {Key: "created", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$key", "$_id"}}}},
+			{Key: "head", Value: bson.D{{Key: "$first", Value: "$$ROOT"}}},
+		}}},
+		{{Key: "$replaceWith", Value: "$head"}},
+		{{Key: "$match", Value: pendingFilter(time.Now())}},
+		{{Key: "$sort", Value: bson.D{{Key: "created", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: 1}},
+		{{Key: "$project", Value: bson.D{{Key: "_id", Value: 1}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return "", ParseError(err)
+	}
+	defer cursor.Close(ctx)
+
+	if !cursor.Next(ctx) {
+		return "", ParseError(cursor.Err())
+	}
+	var head struct {
+		ID string `bson:"_id"`
+	}
+	if err := cursor.Decode(&head); err != nil {
+		return "", ParseError(err)
+	}
+	return head.ID, nil
+}
+
+// pendingFilter matches the messages that are due, not leased at now and not
+// failed.
+func pendingFilter(now time.Time) bson.M {
+	return bson.M{
+		"failed":       nil,
+		"next_attempt": bson.M{"$lte": now},
+		"$or": bson.A{
+			bson.M{"lease_until": bson.M{"$exists": false}},
+			bson.M{"lease_until": bson.M{"$lt": now}},
+		},
+	}
+}
+
+var _ outboxDomain.Repository = (*RepositoryImpl)(nil)
@@ -0,0 +1,34 @@
+package promotion
+
+import (
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var (
+	ErrPromotionAlreadyExists = errors.New("promotion already exists")
+	ErrPromotionNotFound      = errors.New("promotion not found")
+)
+
+func ParseError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return ErrPromotionNotFound
+	}
+
+	var we mongo.WriteException
+	if errors.As(err, &we) {
+		for _, writeErr := range we.WriteErrors {
+			if writeErr.Code == 11000 {
+				return ErrPromotionAlreadyExists
+			}
+		}
+		return fmt.Errorf("promotion not saved: %w", err)
+	}
+
+	return err
+}
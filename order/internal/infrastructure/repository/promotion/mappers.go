@@ -0,0 +1,136 @@
+package promotion
+
+import (
+	orderDomain "order/internal/domain/order"
+	promotionDomain "order/internal/domain/promotion"
+	"order/internal/infrastructure/db/documents"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func toDoc(p *promotionDomain.Promotion) *documents.Promotion {
+	doc := &documents.Promotion{
+		ID:               p.ID.String(),
+		Code:             p.Code,
+		Kind:             p.Rules.Kind,
+		Percent:          p.Rules.Percent,
+		MinOrderValue:    toMoneyDoc(p.Rules.MinOrderValue),
+		PerCustomerLimit: p.Rules.PerCustomerLimit,
+		ValidFrom:        p.Rules.ValidFrom,
+		ValidTo:          p.Rules.ValidTo,
+		ProductIDs:       toProductIDsDoc(p.Rules.ProductIDs),
+		Active:           p.Active,
+		Created:          p.Created,
+		Version:          p.Version.String(),
+	}
+	if p.Rules.Kind == promotionDomain.FixedAmount {
+		doc.Amount = toMoneyDoc(&p.Rules.Amount)
+	}
+	return doc
+}
+
+func toMoneyDoc(domain *orderDomain.Money) *documents.Money {
+	if domain == nil {
+		return nil
+	}
+
+	return &documents.Money{
+		Amount:   domain.Amount.String(),
+		Currency: domain.Currency,
+	}
+}
+
+func toProductIDsDoc(domains []uuid.UUID) []string {
+	ids := make([]string, 0, len(domains))
+	for _, id := range domains {
+		ids = append(ids, id.String())
+	}
+	return ids
+}
+
+func toDomain(doc *documents.Promotion) (*promotionDomain.Promotion, error) {
+	id, err := uuid.Parse(doc.ID)
+	if err != nil {
+		return nil, err
+	}
+	version, err := uuid.Parse(doc.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	minOrderValue, err := toMoneyDomain(doc.MinOrderValue)
+	if err != nil {
+		return nil, err
+	}
+	productIDs, err := toProductIDsDomain(doc.ProductIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := promotionDomain.Rules{
+		Kind:             doc.Kind,
+		Percent:          doc.Percent,
+		MinOrderValue:    minOrderValue,
+		PerCustomerLimit: doc.PerCustomerLimit,
+		ValidFrom:        doc.ValidFrom,
+		ValidTo:          doc.ValidTo,
+		ProductIDs:       productIDs,
+	}
+	if doc.Amount != nil {
+		amount, err := toMoneyDomain(doc.Amount)
+		if err != nil {
+			return nil, err
+		}
+		rules.Amount = *amount
+	}
+
+	return &promotionDomain.Promotion{
+		ID:      id,
+		Code:    doc.Code,
+		Rules:   rules,
+		Active:  doc.Active,
+		Created: doc.Created,
+		Version: version,
+	}, nil
+}
+
+func toMoneyDomain(doc *documents.Money) (*orderDomain.Money, error) {
+	if doc == nil {
+		return nil, nil
+	}
+
+	amount, err := decimal.NewFromString(doc.Amount)
+	if err != nil {
+		return nil, err
+	}
+	money, err := orderDomain.NewMoney(amount, doc.Currency)
+	if err != nil {
+		return nil, err
+	}
+	return &money, nil
+}
+
+func toProductIDsDomain(docs []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(docs))
+	for _, doc := range docs {
+		id, err := uuid.Parse(doc)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func toDomains(docs []documents.Promotion) ([]*promotionDomain.Promotion, error) {
+	promotions := make([]*promotionDomain.Promotion, 0, len(docs))
+	for _, doc := range docs {
+		p, err := toDomain(&doc)
+		if err != nil {
+			return nil, err
+		}
+		promotions = append(promotions, p)
+	}
+	return promotions, nil
+}
@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	promotionRepository "order/internal/infrastructure/repository/promotion"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// ParseError maps database errors to the errors of the MongoDB repository, so
+// callers do not depend on the backend.
+func ParseError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return promotionRepository.ErrPromotionNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return parsePgError(pgErr)
+	}
+
+	return err
+}
+
+func parsePgError(err *pgconn.PgError) error {
+	switch err.ConstraintName {
+	case "promotions_pkey", "promotions_code_key":
+		return promotionRepository.ErrPromotionAlreadyExists
+
+	default:
+		return fmt.Errorf("promotion not saved: %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	orderDomain "order/internal/domain/order"
+	promotionDomain "order/internal/domain/promotion"
+	"order/internal/infrastructure/db/postgres/tables"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func toModel(p *promotionDomain.Promotion) *tables.Promotion {
+	model := &tables.Promotion{
+		ID:               p.ID,
+		Code:             p.Code,
+		Kind:             p.Rules.Kind,
+		Percent:          p.Rules.Percent,
+		PerCustomerLimit: p.Rules.PerCustomerLimit,
+		ValidFrom:        p.Rules.ValidFrom,
+		ValidTo:          p.Rules.ValidTo,
+		ProductIDs:       tables.ProductIDs(p.Rules.ProductIDs),
+		Active:           p.Active,
+		Created:          p.Created,
+		Version:          p.Version,
+	}
+	if p.Rules.Kind == promotionDomain.FixedAmount {
+		model.Amount = decimal.NewNullDecimal(p.Rules.Amount.Amount)
+		model.AmountCurrency = &p.Rules.Amount.Currency
+	}
+	if minimum := p.Rules.MinOrderValue; minimum != nil {
+		model.MinOrderValue = decimal.NewNullDecimal(minimum.Amount)
+		model.MinOrderValueCurrency = &minimum.Currency
+	}
+	return model
+}
+
+func toDomain(model *tables.Promotion) (*promotionDomain.Promotion, error) {
+	minOrderValue, err := toMoneyDomain(model.MinOrderValue, model.MinOrderValueCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	productIDs := []uuid.UUID(model.ProductIDs)
+	if productIDs == nil {
+		productIDs = []uuid.UUID{}
+	}
+
+	rules := promotionDomain.Rules{
+		Kind:             model.Kind,
+		Percent:          model.Percent,
+		MinOrderValue:    minOrderValue,
+		PerCustomerLimit: model.PerCustomerLimit,
+		ValidFrom:        toUTC(model.ValidFrom),
+		ValidTo:          toUTC(model.ValidTo),
+		ProductIDs:       productIDs,
+	}
+	amount, err := toMoneyDomain(model.Amount, model.AmountCurrency)
+	if err != nil {
+		return nil, err
+	}
+	if amount != nil {
+		rules.Amount = *amount
+	}
+
+	return &promotionDomain.Promotion{
+		ID:      model.ID,
+		Code:    model.Code,
+		Rules:   rules,
+		Active:  model.Active,
+		Created: model.Created.UTC(),
+		Version: model.Version,
+	}, nil
+}
+
+func toMoneyDomain(amount decimal.NullDecimal, currency *string) (*orderDomain.Money, error) {
+	if !amount.Valid || currency == nil {
+		return nil, nil
+	}
+
+	money, err := orderDomain.NewMoney(amount.Decimal, *currency)
+	if err != nil {
+		return nil, err
+	}
+	return &money, nil
+}
+
+func toUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+func toDomains(models []tables.Promotion) ([]*promotionDomain.Promotion, error) {
+	promotions := make([]*promotionDomain.Promotion, 0, len(models))
+	for i := range models {
+		p, err := toDomain(&models[i])
+		if err != nil {
+			return nil, err
+		}
+		promotions = append(promotions, p)
+	}
+	return promotions, nil
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	promotionDomain "order/internal/domain/promotion"
+	"order/internal/infrastructure/db/postgres"
+	"order/internal/infrastructure/db/postgres/tables"
+	promotionRepository "order/internal/infrastructure/repository/promotion"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RepositoryImpl stores promotions in the promotions table and their uses in
+// promotion_usages. It behaves like the MongoDB repository, errors included,
+// and joins the transaction of a unit of work through the context.
+type RepositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) *RepositoryImpl {
+	return &RepositoryImpl{db: db}
+}
+
+func (r *RepositoryImpl) Create(ctx context.Context, promotion *promotionDomain.Promotion) error {
+	err := postgres.Conn(ctx, r.db).Create(toModel(promotion)).Error
+	return ParseError(err)
+}
+
+func (r *RepositoryImpl) Update(ctx context.Context, promotion *promotionDomain.Promotion) error {
+	oldVersion := promotion.Version
+	promotion.Version = uuid.New()
+	model := toModel(promotion)
+
+	res := postgres.Conn(ctx, r.db).
+		Model(model).
+		Where("version = ?", oldVersion).
+		Select("*").
+		Updates(model)
+	if res.Error != nil {
+		return ParseError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return promotionRepository.ErrPromotionNotFound
+	}
+
+	return nil
+}
+
+func (r *RepositoryImpl) GetByID(ctx context.Context, promotionID uuid.UUID) (*promotionDomain.Promotion, error) {
+	return r.findOne(ctx, "id = ?", promotionID)
+}
+
+func (r *RepositoryImpl) GetByCode(ctx context.Context, code string) (*promotionDomain.Promotion, error) {
+	return r.findOne(ctx, "code = ?", promotionDomain.NormalizeCode(code))
+}
+
+func (r *RepositoryImpl) GetAll(ctx context.Context, activeOnly bool) ([]*promotionDomain.Promotion, error) {
+	query := postgres.Conn(ctx, r.db)
+	if activeOnly {
+		query = query.Where("active")
+	}
+
+	var models []tables.Promotion
+	if err := query.Order("created DESC, id ASC").Find(&models).Error; err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomains(models)
+}
+
+// Redeem increments the customer's usage counter only while it is below the
+// limit. When the counter has reached the limit the conflicting insert updates
+// nothing, which is reported as ErrUsageLimitReached.
+func (r *RepositoryImpl) Redeem(ctx context.Context, promotion *promotionDomain.Promotion, customerID uuid.UUID) error {
+	query := `INSERT INTO promotion_usages (promotion_id, customer_id, uses) VALUES (?, ?, 1)
+		ON CONFLICT (promotion_id, customer_id) DO UPDATE SET uses = promotion_usages.uses + 1`
+	args := []any{promotion.ID, customerID}
+	if limit := promotion.Rules.PerCustomerLimit; limit > 0 {
+		query += ` WHERE promotion_usages.uses < ?`
+		args = append(args, limit)
+	}
+
+	res := postgres.Conn(ctx, r.db).Exec(query, args...)
+	if res.Error != nil {
+		return ParseError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return promotionDomain.ErrUsageLimitReached
+	}
+
+	return nil
+}
+
+func (r *RepositoryImpl) Release(ctx context.Context, promotion *promotionDomain.Promotion, customerID uuid.UUID) error {
+	err := postgres.Conn(ctx, r.db).Exec(
+		`UPDATE promotion_usages SET uses = uses - 1 WHERE promotion_id = ? AND customer_id = ? AND uses > 0`,
+		promotion.ID, customerID,
+	).Error
+	return ParseError(err)
+}
+
+func (r *RepositoryImpl) findOne(ctx context.Context, condition string, arg any) (*promotionDomain.Promotion, error) {
+	var model tables.Promotion
+	if err := postgres.Conn(ctx, r.db).First(&model, condition, arg).Error; err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomain(&model)
+}
+
+var _ promotionDomain.Repository = (*RepositoryImpl)(nil)
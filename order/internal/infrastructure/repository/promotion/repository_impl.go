@@ -0,0 +1,120 @@
+package promotion
+
+import (
+	"context"
+	"errors"
+	"order/internal/infrastructure/db/documents"
+
+	promotionDomain "order/internal/domain/promotion"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RepositoryImpl struct {
+	collection      *mongo.Collection
+	usageCollection *mongo.Collection
+}
+
+func New(collection, usageCollection *mongo.Collection) *RepositoryImpl {
+	return &RepositoryImpl{
+		collection:      collection,
+		usageCollection: usageCollection,
+	}
+}
+
+func (r *RepositoryImpl) Create(ctx context.Context, promotion *promotionDomain.Promotion) error {
+	doc := toDoc(promotion)
+	_, err := r.collection.InsertOne(ctx, doc)
+	return ParseError(err)
+}
+
+func (r *RepositoryImpl) Update(ctx context.Context, promotion *promotionDomain.Promotion) error {
+	oldVersion := promotion.Version
+	newVersion := uuid.New()
+	promotion.Version = newVersion
+	doc := toDoc(promotion)
+
+	filter := bson.M{"_id": promotion.ID.String(), "version": oldVersion.String()}
+	result, err := r.collection.ReplaceOne(ctx, filter, doc)
+	if err != nil {
+		return ParseError(err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrPromotionNotFound
+	}
+
+	return nil
+}
+
+func (r *RepositoryImpl) GetByID(ctx context.Context, promotionID uuid.UUID) (*promotionDomain.Promotion, error) {
+	return r.findOne(ctx, bson.M{"_id": promotionID.String()})
+}
+
+func (r *RepositoryImpl) GetByCode(ctx context.Context, code string) (*promotionDomain.Promotion, error) {
+	return r.findOne(ctx, bson.M{"code": promotionDomain.NormalizeCode(code)})
+}
+
+func (r *RepositoryImpl) GetAll(ctx context.Context, activeOnly bool) ([]*promotionDomain.Promotion, error) {
+	filter := bson.M{}
+	if activeOnly {
+		filter["active"] = true
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "created", Value: -1}, {Key: "_id", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var docs []documents.Promotion
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomains(docs)
+}
+
+// Redeem increments the customer's usage counter only while it is below the
+// limit. When the counter has reached the limit the filter matches nothing,
+// the upsert tries to insert a second counter with the same id and the
+// duplicate key error is reported as ErrUsageLimitReached.
+func (r *RepositoryImpl) Redeem(ctx context.Context, promotion *promotionDomain.Promotion, customerID uuid.UUID) error {
+	filter := bson.M{"_id": promotion.ID.String() + ":" + customerID.String()}
+	if limit := promotion.Rules.PerCustomerLimit; limit > 0 {
+		filter["uses"] = bson.M{"$lt": limit}
+	}
+	update := bson.M{
+		"$inc": bson.M{"uses": 1},
+		"$setOnInsert": bson.M{
+			"promotion_id": promotion.ID.String(),
+			"customer_id":  customerID.String(),
+		},
+	}
+
+	_, err := r.usageCollection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err = ParseError(err); errors.Is(err, ErrPromotionAlreadyExists) {
+		return promotionDomain.ErrUsageLimitReached
+	}
+	return err
+}
+
+func (r *RepositoryImpl) Release(ctx context.Context, promotion *promotionDomain.Promotion, customerID uuid.UUID) error {
+	filter := bson.M{"_id": promotion.ID.String() + ":" + customerID.String(), "uses": bson.M{"$gt": 0}}
+	update := bson.M{"$inc": bson.M{"uses": -1}}
+
+	_, err := r.usageCollection.UpdateOne(ctx, filter, update)
+	return ParseError(err)
+}
+
+func (r *RepositoryImpl) findOne(ctx context.Context, filter bson.M) (*promotionDomain.Promotion, error) {
+	var doc documents.Promotion
+	if err := r.collection.FindOne(ctx, filter).Decode(&doc); err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomain(&doc)
+}
+
+var _ promotionDomain.Repository = (*RepositoryImpl)(nil)
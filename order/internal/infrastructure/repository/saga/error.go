@@ -0,0 +1,34 @@
+package saga
+
+import (
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var (
+	ErrSagaAlreadyExists = errors.New("saga already exists")
+	ErrSagaNotFound      = errors.New("saga not found")
+)
+
+func ParseError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return ErrSagaNotFound
+	}
+
+	var we mongo.WriteException
+	if errors.As(err, &we) {
+		for _, writeErr := range we.WriteErrors {
+			if writeErr.Code == 11000 {
+				return ErrSagaAlreadyExists
+			}
+		}
+		return fmt.Errorf("saga not saved: %w", err)
+	}
+
+	return err
+}
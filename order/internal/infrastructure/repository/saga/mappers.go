@@ -0,0 +1,122 @@
+package saga
+
+import (
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/infrastructure/db/documents"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func toDoc(s *sagaDomain.Saga) *documents.Saga {
+	return &documents.Saga{
+		ID:          s.ID.String(),
+		Type:        s.Type,
+		OrderID:     s.OrderID.String(),
+		Step:        s.Step,
+		StepEntered: toStepEntered(s.History),
+		History:     toHistoryDoc(s.History),
+		LastError:   toFailureDoc(s.LastError),
+		ResumeAt:    s.ResumeAt,
+		Created:     s.Created,
+		Updated:     s.Updated,
+		Version:     s.Version.String(),
+	}
+}
+
+// toStepEntered denormalizes the time the current step was entered so that
+// stalled sagas can be found through an index.
+func toStepEntered(history []sagaDomain.StepRecord) time.Time {
+	if len(history) == 0 {
+		return time.Time{}
+	}
+	return history[len(history)-1].Entered
+}
+
+func toHistoryDoc(domains []sagaDomain.StepRecord) []documents.SagaStep {
+	history := make([]documents.SagaStep, 0, len(domains))
+	for _, domain := range domains {
+		history = append(history, documents.SagaStep{
+			Step:    domain.Step,
+			Entered: domain.Entered,
+			Retry:   domain.Retry,
+		})
+	}
+	return history
+}
+
+func toFailureDoc(domain *sagaDomain.Failure) *documents.SagaFailure {
+	if domain == nil {
+		return nil
+	}
+
+	return &documents.SagaFailure{
+		Step:     domain.Step,
+		Message:  domain.Message,
+		Occurred: domain.Occurred,
+	}
+}
+
+func toDomain(doc *documents.Saga) (*sagaDomain.Saga, error) {
+	id, err := uuid.Parse(doc.ID)
+	if err != nil {
+		return nil, err
+	}
+	orderID, err := uuid.Parse(doc.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	version, err := uuid.Parse(doc.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sagaDomain.Saga{
+		ID:        id,
+		Type:      doc.Type,
+		OrderID:   orderID,
+		Step:      doc.Step,
+		History:   toHistoryDomain(doc.History),
+		LastError: toFailureDomain(doc.LastError),
+		ResumeAt:  doc.ResumeAt,
+		Created:   doc.Created,
+		Updated:   doc.Updated,
+		Version:   version,
+	}, nil
+}
+
+func toHistoryDomain(docs []documents.SagaStep) []sagaDomain.StepRecord {
+	history := make([]sagaDomain.StepRecord, 0, len(docs))
+	for _, doc := range docs {
+		history = append(history, sagaDomain.StepRecord{
+			Step:    doc.Step,
+			Entered: doc.Entered,
+			Retry:   doc.Retry,
+		})
+	}
+	return history
+}
+
+func toFailureDomain(doc *documents.SagaFailure) *sagaDomain.Failure {
+	if doc == nil {
+		return nil
+	}
+
+	return &sagaDomain.Failure{
+		Step:     doc.Step,
+		Message:  doc.Message,
+		Occurred: doc.Occurred,
+	}
+}
+
+func toDomains(docs []documents.Saga) ([]*sagaDomain.Saga, error) {
+	sagas := make([]*sagaDomain.Saga, 0, len(docs))
+	for _, doc := range docs {
+		s, err := toDomain(&doc)
+		if err != nil {
+			return nil, err
+		}
+		sagas = append(sagas, s)
+	}
+	return sagas, nil
+}
@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	sagaRepository "order/internal/infrastructure/repository/saga"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// ParseError maps database errors to the errors of the MongoDB repository, so
+// callers do not depend on the backend.
+func ParseError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return sagaRepository.ErrSagaNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return parsePgError(pgErr)
+	}
+
+	return err
+}
+
+func parsePgError(err *pgconn.PgError) error {
+	switch err.ConstraintName {
+	case "sagas_pkey", "sagas_type_order_id_key":
+		return sagaRepository.ErrSagaAlreadyExists
+
+	default:
+		return fmt.Errorf("saga not saved: %v", err)
+	}
+}
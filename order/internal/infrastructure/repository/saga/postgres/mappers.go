@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/infrastructure/db/postgres/tables"
+	"time"
+)
+
+func toModel(s *sagaDomain.Saga) *tables.Saga {
+	model := &tables.Saga{
+		ID:          s.ID,
+		Type:        s.Type,
+		OrderID:     s.OrderID,
+		Step:        s.Step,
+		StepEntered: toStepEntered(s.History),
+		History:     toHistoryModel(s.History),
+		ResumeAt:    s.ResumeAt,
+		Created:     s.Created,
+		Updated:     s.Updated,
+		Version:     s.Version,
+	}
+	if failure := s.LastError; failure != nil {
+		model.LastErrorStep = &failure.Step
+		model.LastErrorMessage = &failure.Message
+		model.LastErrorOccurred = &failure.Occurred
+	}
+	return model
+}
+
+// toStepEntered denormalizes the time the current step was entered so that
+// stalled sagas can be found through an index.
+func toStepEntered(history []sagaDomain.StepRecord) time.Time {
+	if len(history) == 0 {
+		return time.Time{}
+	}
+	return history[len(history)-1].Entered
+}
+
+func toHistoryModel(domains []sagaDomain.StepRecord) tables.SagaSteps {
+	history := make(tables.SagaSteps, 0, len(domains))
+	for _, domain := range domains {
+		history = append(history, tables.SagaStep{
+			Step:    domain.Step,
+			Entered: domain.Entered,
+			Retry:   domain.Retry,
+		})
+	}
+	return history
+}
+
+func toDomain(model *tables.Saga) *sagaDomain.Saga {
+	return &sagaDomain.Saga{
+		ID:        model.ID,
+		Type:      model.Type,
+		OrderID:   model.OrderID,
+		Step:      model.Step,
+		History:   toHistoryDomain(model.History),
+		LastError: toFailureDomain(model),
+		ResumeAt:  toUTC(model.ResumeAt),
+		Created:   model.Created.UTC(),
+		Updated:   model.Updated.UTC(),
+		Version:   model.Version,
+	}
+}
+
+func toHistoryDomain(models tables.SagaSteps) []sagaDomain.StepRecord {
+	history := make([]sagaDomain.StepRecord, 0, len(models))
+	for _, model := range models {
+		history = append(history, sagaDomain.StepRecord{
+			Step:    model.Step,
+			Entered: model.Entered,
+			Retry:   model.Retry,
+		})
+	}
+	return history
+}
+
+func toFailureDomain(model *tables.Saga) *sagaDomain.Failure {
+	if model.LastErrorStep == nil || model.LastErrorMessage == nil || model.LastErrorOccurred == nil {
+		return nil
+	}
+
+	return &sagaDomain.Failure{
+		Step:     *model.LastErrorStep,
+		Message:  *model.LastErrorMessage,
+		Occurred: model.LastErrorOccurred.UTC(),
+	}
+}
+
+func toUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+func toDomains(models []tables.Saga) []*sagaDomain.Saga {
+	sagas := make([]*sagaDomain.Saga, 0, len(models))
+	for i := range models {
+		sagas = append(sagas, toDomain(&models[i]))
+	}
+	return sagas
+}
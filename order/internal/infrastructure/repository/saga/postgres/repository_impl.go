@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/infrastructure/db/postgres"
+	"order/internal/infrastructure/db/postgres/tables"
+	sagaRepository "order/internal/infrastructure/repository/saga"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RepositoryImpl stores sagas in the sagas table. It behaves like the MongoDB
+// repository, errors included, and joins the transaction of a unit of work
+// through the context.
+type RepositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) *RepositoryImpl {
+	return &RepositoryImpl{db: db}
+}
+
+func (r *RepositoryImpl) Create(ctx context.Context, saga *sagaDomain.Saga) error {
+	err := postgres.Conn(ctx, r.db).Create(toModel(saga)).Error
+	return ParseError(err)
+}
+
+// Update replaces the saga if it still has the version it was read with and
+// gives it a new one. Writing every column also drops a lease.
+func (r *RepositoryImpl) Update(ctx context.Context, saga *sagaDomain.Saga) error {
+	oldVersion := saga.Version
+	saga.Version = uuid.New()
+	model := toModel(saga)
+
+	res := postgres.Conn(ctx, r.db).
+		Model(model).
+		Where("version = ?", oldVersion).
+		Select("*").
+		Updates(model)
+	if res.Error != nil {
+		return ParseError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return sagaRepository.ErrSagaNotFound
+	}
+
+	return nil
+}
+
+func (r *RepositoryImpl) GetByID(ctx context.Context, sagaID uuid.UUID) (*sagaDomain.Saga, error) {
+	var model tables.Saga
+	if err := postgres.Conn(ctx, r.db).First(&model, "id = ?", sagaID).Error; err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomain(&model), nil
+}
+
+func (r *RepositoryImpl) GetByOrderID(
+	ctx context.Context,
+	sagaType sagaDomain.Type,
+	orderID uuid.UUID,
+) (*sagaDomain.Saga, error) {
+	var model tables.Saga
+	err := postgres.Conn(ctx, r.db).First(&model, "type = ? AND order_id = ?", sagaType, orderID).Error
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomain(&model), nil
+}
+
+func (r *RepositoryImpl) GetAllByOrderID(ctx context.Context, orderID uuid.UUID) ([]*sagaDomain.Saga, error) {
+	var models []tables.Saga
+	err := postgres.Conn(ctx, r.db).
+		Where("order_id = ?", orderID).
+		Order("created ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomains(models), nil
+}
+
+func (r *RepositoryImpl) ClaimStalled(
+	ctx context.Context,
+	sagaType sagaDomain.Type,
+	steps []sagaDomain.Step,
+	enteredBefore time.Time,
+	leaseUntil time.Time,
+) (*sagaDomain.Saga, error) {
+	return r.claim(ctx, leaseUntil,
+		"type = ? AND step IN ? AND step_entered < ?", []any{sagaType, steps, enteredBefore},
+		"step_entered",
+	)
+}
+
+func (r *RepositoryImpl) ClaimSuspended(
+	ctx context.Context,
+	sagaType sagaDomain.Type,
+	resumeBefore time.Time,
+	leaseUntil time.Time,
+) (*sagaDomain.Saga, error) {
+	return r.claim(ctx, leaseUntil,
+		"type = ? AND resume_at <= ?", []any{sagaType, resumeBefore},
+		"resume_at",
+	)
+}
+
+// claim leases the first saga in orderBy that matches the condition and is not
+// leased. Rows another claim has locked are skipped, so concurrent callers
+// never receive the same saga.
+func (r *RepositoryImpl) claim(
+	ctx context.Context,
+	leaseUntil time.Time,
+	condition string,
+	args []any,
+	orderBy string,
+) (*sagaDomain.Saga, error) {
+	query := `UPDATE sagas SET lease_until = ? WHERE id = (
+		SELECT id FROM sagas
+		WHERE ` + condition + ` AND (lease_until IS NULL OR lease_until < ?)
+		ORDER BY ` + orderBy + `
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	) RETURNING *`
+
+	values := append([]any{leaseUntil}, args...)
+	values = append(values, time.Now())
+
+	var models []tables.Saga
+	if err := postgres.Conn(ctx, r.db).Raw(query, values...).Scan(&models).Error; err != nil {
+		return nil, ParseError(err)
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+	return toDomain(&models[0]), nil
+}
+
+var _ sagaDomain.Repository = (*RepositoryImpl)(nil)
@@ -0,0 +1,149 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"order/internal/infrastructure/db/documents"
+	"time"
+
+	sagaDomain "order/internal/domain/saga"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func New(collection *mongo.Collection) *RepositoryImpl {
+	return &RepositoryImpl{collection: collection}
+}
+
+func (r *RepositoryImpl) Create(ctx context.Context, saga *sagaDomain.Saga) error {
+	doc := toDoc(saga)
+	_, err := r.collection.InsertOne(ctx, doc)
+	return ParseError(err)
+}
+
+func (r *RepositoryImpl) Update(ctx context.Context, saga *sagaDomain.Saga) error {
+	oldVersion := saga.Version
+	newVersion := uuid.New()
+	saga.Version = newVersion
+	doc := toDoc(saga)
+
+	filter := bson.M{"_id": saga.ID.String(), "version": oldVersion.String()}
+	result, err := r.collection.ReplaceOne(ctx, filter, doc)
+	if err != nil {
+		return ParseError(err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrSagaNotFound
+	}
+
+	return nil
+}
+
+func (r *RepositoryImpl) GetByID(ctx context.Context, sagaID uuid.UUID) (*sagaDomain.Saga, error) {
+	filter := bson.M{"_id": sagaID.String()}
+	var doc documents.Saga
+	if err := r.collection.FindOne(ctx, filter).Decode(&doc); err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomain(&doc)
+}
+
+func (r *RepositoryImpl) GetByOrderID(
+	ctx context.Context,
+	sagaType sagaDomain.Type,
+	orderID uuid.UUID,
+) (*sagaDomain.Saga, error) {
+	filter := bson.M{"type": sagaType, "order_id": orderID.String()}
+	var doc documents.Saga
+	if err := r.collection.FindOne(ctx, filter).Decode(&doc); err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomain(&doc)
+}
+
+func (r *RepositoryImpl) GetAllByOrderID(ctx context.Context, orderID uuid.UUID) ([]*sagaDomain.Saga, error) {
+	filter := bson.M{"order_id": orderID.String()}
+	opts := options.Find().SetSort(bson.D{{Key: "created", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var docs []documents.Saga
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomains(docs)
+}
+
+func (r *RepositoryImpl) ClaimStalled(
+	ctx context.Context,
+	sagaType sagaDomain.Type,
+	steps []sagaDomain.Step,
+	enteredBefore time.Time,
+	leaseUntil time.Time,
+) (*sagaDomain.Saga, error) {
+	filter := bson.M{
+		"type":         sagaType,
+		"step":         bson.M{"$in": steps},
+		"step_entered": bson.M{"$lt": enteredBefore},
+		"$or": bson.A{
+			bson.M{"lease_until": bson.M{"$exists": false}},
+			bson.M{"lease_until": bson.M{"$lt": time.Now()}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"lease_until": leaseUntil}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "step_entered", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var doc documents.Saga
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomain(&doc)
+}
+
+func (r *RepositoryImpl) ClaimSuspended(
+	ctx context.Context,
+	sagaType sagaDomain.Type,
+	resumeBefore time.Time,
+	leaseUntil time.Time,
+) (*sagaDomain.Saga, error) {
+	filter := bson.M{
+		"type":      sagaType,
+		"resume_at": bson.M{"$lte": resumeBefore},
+		"$or": bson.A{
+			bson.M{"lease_until": bson.M{"$exists": false}},
+			bson.M{"lease_until": bson.M{"$lt": time.Now()}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"lease_until": leaseUntil}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "resume_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var doc documents.Saga
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return toDomain(&doc)
+}
+
+var _ sagaDomain.Repository = (*RepositoryImpl)(nil)
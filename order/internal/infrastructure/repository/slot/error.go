@@ -0,0 +1,28 @@
+package slot
+
+import (
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var ErrDeliverySlotAlreadyExists = errors.New("delivery slot already exists")
+
+func ParseError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var we mongo.WriteException
+	if errors.As(err, &we) {
+		for _, writeErr := range we.WriteErrors {
+			if writeErr.Code == 11000 {
+				return ErrDeliverySlotAlreadyExists
+			}
+		}
+		return fmt.Errorf("delivery slot not saved: %w", err)
+	}
+
+	return err
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	slotRepository "order/internal/infrastructure/repository/slot"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ParseError maps database errors to the errors of the MongoDB repository, so
+// callers do not depend on the backend.
+func ParseError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return parsePgError(pgErr)
+	}
+
+	return err
+}
+
+func parsePgError(err *pgconn.PgError) error {
+	switch err.ConstraintName {
+	case "delivery_slots_pkey":
+		return slotRepository.ErrDeliverySlotAlreadyExists
+
+	default:
+		return fmt.Errorf("delivery slot not saved: %v", err)
+	}
+}
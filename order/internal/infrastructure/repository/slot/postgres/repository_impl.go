@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	orderDomain "order/internal/domain/order"
+	slotDomain "order/internal/domain/slot"
+	"order/internal/infrastructure/db/postgres"
+	"order/internal/infrastructure/db/postgres/tables"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RepositoryImpl counts the orders of every delivery slot in the
+// delivery_slots table and joins the transaction of a unit of work through the
+// context.
+type RepositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) *RepositoryImpl {
+	return &RepositoryImpl{db: db}
+}
+
+// Reserve increments the slot counter only while it is below the capacity.
+// When the slot is full the conflicting insert updates nothing, which is
+// reported as ErrSlotFull.
+func (r *RepositoryImpl) Reserve(ctx context.Context, slot orderDomain.DeliverySlot, capacity int) error {
+	if capacity <= 0 {
+		return slotDomain.ErrSlotFull
+	}
+
+	const query = `INSERT INTO delivery_slots (start, "end", reserved) VALUES (?, ?, 1)
+		ON CONFLICT (start) DO UPDATE SET reserved = delivery_slots.reserved + 1
+		WHERE delivery_slots.reserved < ?`
+
+	res := postgres.Conn(ctx, r.db).Exec(query, slot.Start.UTC(), slot.End.UTC(), capacity)
+	if res.Error != nil {
+		return ParseError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return slotDomain.ErrSlotFull
+	}
+
+	return nil
+}
+
+func (r *RepositoryImpl) Release(ctx context.Context, slot orderDomain.DeliverySlot) error {
+	err := postgres.Conn(ctx, r.db).
+		Model(&tables.DeliverySlot{}).
+		Where("start = ? AND reserved > 0", slot.Start.UTC()).
+		Update("reserved", gorm.Expr("reserved - 1")).Error
+	return ParseError(err)
+}
+
+func (r *RepositoryImpl) GetReserved(ctx context.Context, from, to time.Time) (map[time.Time]int, error) {
+	var models []tables.DeliverySlot
+	err := postgres.Conn(ctx, r.db).
+		Where("start >= ? AND start < ?", from, to).
+		Find(&models).Error
+	if err != nil {
+		return nil, ParseError(err)
+	}
+
+	reserved := make(map[time.Time]int, len(models))
+	for _, model := range models {
+		reserved[model.Start.UTC()] = model.Reserved
+	}
+	return reserved, nil
+}
+
+var _ slotDomain.Repository = (*RepositoryImpl)(nil)
@@ -0,0 +1,81 @@
+package slot
+
+import (
+	"context"
+	"errors"
+	orderDomain "order/internal/domain/order"
+	slotDomain "order/internal/domain/slot"
+	"order/internal/infrastructure/db/documents"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func New(collection *mongo.Collection) *RepositoryImpl {
+	return &RepositoryImpl{collection: collection}
+}
+
+// Reserve increments the slot counter only while it is below the capacity.
+// When the slot is full the filter matches nothing, the upsert tries to insert
+// a second counter with the same id and the duplicate key error is reported
+// as ErrSlotFull.
+func (r *RepositoryImpl) Reserve(ctx context.Context, slot orderDomain.DeliverySlot, capacity int) error {
+	if capacity <= 0 {
+		return slotDomain.ErrSlotFull
+	}
+
+	filter := bson.M{"_id": toID(slot), "reserved": bson.M{"$lt": capacity}}
+	update := bson.M{
+		"$inc": bson.M{"reserved": 1},
+		"$setOnInsert": bson.M{
+			"start": slot.Start.UTC(),
+			"end":   slot.End.UTC(),
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err = ParseError(err); errors.Is(err, ErrDeliverySlotAlreadyExists) {
+		return slotDomain.ErrSlotFull
+	}
+	return err
+}
+
+func (r *RepositoryImpl) Release(ctx context.Context, slot orderDomain.DeliverySlot) error {
+	filter := bson.M{"_id": toID(slot), "reserved": bson.M{"$gt": 0}}
+	update := bson.M{"$inc": bson.M{"reserved": -1}}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return ParseError(err)
+}
+
+func (r *RepositoryImpl) GetReserved(ctx context.Context, from, to time.Time) (map[time.Time]int, error) {
+	filter := bson.M{"start": bson.M{"$gte": from, "$lt": to}}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var docs []documents.DeliverySlot
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, ParseError(err)
+	}
+
+	reserved := make(map[time.Time]int, len(docs))
+	for _, doc := range docs {
+		reserved[doc.Start.UTC()] = doc.Reserved
+	}
+	return reserved, nil
+}
+
+func toID(slot orderDomain.DeliverySlot) string {
+	return slot.Start.UTC().Format(time.RFC3339)
+}
+
+var _ slotDomain.Repository = (*RepositoryImpl)(nil)
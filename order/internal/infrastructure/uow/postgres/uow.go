@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	inboxDomain "order/internal/domain/inbox"
+	orderDomain "order/internal/domain/order"
+	outboxDomain "order/internal/domain/outbox"
+	promotionDomain "order/internal/domain/promotion"
+	sagaDomain "order/internal/domain/saga"
+	slotDomain "order/internal/domain/slot"
+	"order/internal/domain/uow"
+	"order/internal/infrastructure/db/postgres"
+	inboxPostgres "order/internal/infrastructure/repository/inbox/postgres"
+	orderPostgres "order/internal/infrastructure/repository/order/postgres"
+	outboxPostgres "order/internal/infrastructure/repository/outbox/postgres"
+	promotionPostgres "order/internal/infrastructure/repository/promotion/postgres"
+	sagaPostgres "order/internal/infrastructure/repository/saga/postgres"
+	slotPostgres "order/internal/infrastructure/repository/slot/postgres"
+
+	"gorm.io/gorm"
+)
+
+// UoWImpl runs transactions of PostgreSQL, which every repository it hands
+// out keeps its data in, so the orders commit together with their sagas,
+// outbox and inbox messages, promotion uses and delivery slots.
+type UoWImpl struct {
+	orderRepository     orderDomain.Repository
+	sagaRepository      sagaDomain.Repository
+	outboxRepository    outboxDomain.Repository
+	promotionRepository promotionDomain.Repository
+	slotRepository      slotDomain.Repository
+	inboxRepository     inboxDomain.Repository
+
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) uow.UoW {
+	return &UoWImpl{
+		orderRepository:     orderPostgres.New(db),
+		sagaRepository:      sagaPostgres.New(db),
+		outboxRepository:    outboxPostgres.New(db),
+		promotionRepository: promotionPostgres.New(db),
+		slotRepository:      slotPostgres.New(db),
+		inboxRepository:     inboxPostgres.New(db),
+		db:                  db,
+	}
+}
+
+// Transaction runs fn in a transaction of the database, committed once and
+// never retried, so fn is free to mutate in-memory state.
+//
+// Called with the ctx of a running transaction, fn joins that transaction.
+func (u *UoWImpl) Transaction(ctx context.Context, fn func(ctx context.Context, u uow.UoW) error) error {
+	return postgres.Transaction(ctx, u.db, func(ctx context.Context) error {
+		return fn(ctx, u)
+	})
+}
+
+func (u *UoWImpl) Order() orderDomain.Repository {
+	return u.orderRepository
+}
+
+func (u *UoWImpl) Saga() sagaDomain.Repository {
+	return u.sagaRepository
+}
+
+func (u *UoWImpl) Outbox() outboxDomain.Repository {
+	return u.outboxRepository
+}
+
+func (u *UoWImpl) Promotion() promotionDomain.Repository {
+	return u.promotionRepository
+}
+
+func (u *UoWImpl) Slot() slotDomain.Repository {
+	return u.slotRepository
+}
+
+func (u *UoWImpl) Inbox() inboxDomain.Repository {
+	return u.inboxRepository
+}
+
+var _ uow.UoW = (*UoWImpl)(nil)
@@ -0,0 +1,103 @@
+package uow
+
+import (
+	"context"
+	inboxDomain "order/internal/domain/inbox"
+	orderDomain "order/internal/domain/order"
+	outboxDomain "order/internal/domain/outbox"
+	promotionDomain "order/internal/domain/promotion"
+	sagaDomain "order/internal/domain/saga"
+	slotDomain "order/internal/domain/slot"
+	"order/internal/domain/uow"
+	inboxRepository "order/internal/infrastructure/repository/inbox"
+	orderRepository "order/internal/infrastructure/repository/order"
+	outboxRepository "order/internal/infrastructure/repository/outbox"
+	promotionRepository "order/internal/infrastructure/repository/promotion"
+	sagaRepository "order/internal/infrastructure/repository/saga"
+	slotRepository "order/internal/infrastructure/repository/slot"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type UoWImpl struct {
+	orderRepository     orderDomain.Repository
+	sagaRepository      sagaDomain.Repository
+	outboxRepository    outboxDomain.Repository
+	promotionRepository promotionDomain.Repository
+	slotRepository      slotDomain.Repository
+	inboxRepository     inboxDomain.Repository
+
+	client *mongo.Client
+}
+
+// New builds the unit of work over MongoDB. With DB_ORDER_BACKEND set to
+// postgres the unit of work of the postgres package is used instead, so that
+// every store commits in one transaction.
+func New(
+	orderCollection, sagaCollection, outboxCollection *mongo.Collection,
+	promotionCollection, promotionUsageCollection *mongo.Collection,
+	deliverySlotCollection, inboxCollection *mongo.Collection,
+) uow.UoW {
+	return &UoWImpl{
+		orderRepository:     orderRepository.New(orderCollection),
+		sagaRepository:      sagaRepository.New(sagaCollection),
+		outboxRepository:    outboxRepository.New(outboxCollection),
+		promotionRepository: promotionRepository.New(promotionCollection, promotionUsageCollection),
+		slotRepository:      slotRepository.New(deliverySlotCollection),
+		inboxRepository:     inboxRepository.New(inboxCollection),
+		client:              orderCollection.Database().Client(),
+	}
+}
+
+// Transaction runs fn inside a MongoDB multi-document transaction. The transaction
+// is committed once and never retried, so fn is free to mutate in-memory state.
+//
+// Called with the ctx of a running transaction, fn joins that transaction.
+func (u *UoWImpl) Transaction(ctx context.Context, fn func(ctx context.Context, u uow.UoW) error) error {
+	if mongo.SessionFromContext(ctx) != nil {
+		return fn(ctx, u)
+	}
+
+	session, err := u.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	return mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		if err := session.StartTransaction(); err != nil {
+			return err
+		}
+		if err := fn(sc, u); err != nil {
+			_ = session.AbortTransaction(sc)
+			return err
+		}
+		return session.CommitTransaction(sc)
+	})
+}
+
+func (u *UoWImpl) Order() orderDomain.Repository {
+	return u.orderRepository
+}
+
+func (u *UoWImpl) Saga() sagaDomain.Repository {
+	return u.sagaRepository
+}
+
+func (u *UoWImpl) Outbox() outboxDomain.Repository {
+	return u.outboxRepository
+}
+
+func (u *UoWImpl) Promotion() promotionDomain.Repository {
+	return u.promotionRepository
+}
+
+func (u *UoWImpl) Slot() slotDomain.Repository {
+	return u.slotRepository
+}
+
+func (u *UoWImpl) Inbox() inboxDomain.Repository {
+	return u.inboxRepository
+}
+
+var _ uow.UoW = (*UoWImpl)(nil)
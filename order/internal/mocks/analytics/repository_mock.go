@@ -0,0 +1,41 @@
+package analytics
+
+import (
+	"context"
+	analyticsDomain "order/internal/domain/analytics"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type RepositoryMock struct {
+	mock.Mock
+}
+
+func (r *RepositoryMock) GetStatusFunnel(ctx context.Context, period analyticsDomain.Period) (*analyticsDomain.StatusFunnel, error) {
+	args := r.Called(ctx, period)
+	return args.Get(0).(*analyticsDomain.StatusFunnel), args.Error(1)
+}
+
+func (r *RepositoryMock) GetRevenue(
+	ctx context.Context,
+	period analyticsDomain.Period,
+	granularity analyticsDomain.Granularity,
+) ([]analyticsDomain.RevenueBucket, error) {
+	args := r.Called(ctx, period, granularity)
+	return args.Get(0).([]analyticsDomain.RevenueBucket), args.Error(1)
+}
+
+func (r *RepositoryMock) GetDeliveryTime(ctx context.Context, period analyticsDomain.Period) (*analyticsDomain.DeliveryTime, error) {
+	args := r.Called(ctx, period)
+	return args.Get(0).(*analyticsDomain.DeliveryTime), args.Error(1)
+}
+
+func (r *RepositoryMock) GetCancellationReasons(
+	ctx context.Context,
+	period analyticsDomain.Period,
+) (analyticsDomain.CancellationReasons, error) {
+	args := r.Called(ctx, period)
+	return args.Get(0).(analyticsDomain.CancellationReasons), args.Error(1)
+}
+
+var _ analyticsDomain.Repository = (*RepositoryMock)(nil)
@@ -0,0 +1,31 @@
+package inbox
+
+import (
+	"context"
+	inboxDomain "order/internal/domain/inbox"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type RepositoryMock struct {
+	mock.Mock
+}
+
+func (r *RepositoryMock) Create(ctx context.Context, message *inboxDomain.Message) error {
+	args := r.Called(ctx, message)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) GetByID(ctx context.Context, messageID uuid.UUID) (*inboxDomain.Message, error) {
+	args := r.Called(ctx, messageID)
+	return args.Get(0).(*inboxDomain.Message), args.Error(1)
+}
+
+func (r *RepositoryMock) DeleteExpired(ctx context.Context, now time.Time) error {
+	args := r.Called(ctx, now)
+	return args.Error(0)
+}
+
+var _ inboxDomain.Repository = (*RepositoryMock)(nil)
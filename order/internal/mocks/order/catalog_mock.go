@@ -0,0 +1,20 @@
+package order
+
+import (
+	"context"
+	"order/internal/application/order/usecase"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type CatalogMock struct {
+	mock.Mock
+}
+
+func (c *CatalogMock) GetProducts(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]usecase.CatalogProduct, error) {
+	args := c.Called(ctx, productIDs)
+	return args.Get(0).(map[uuid.UUID]usecase.CatalogProduct), args.Error(1)
+}
+
+var _ usecase.Catalog = (*CatalogMock)(nil)
@@ -3,6 +3,7 @@ package order
 import (
 	"context"
 	orderDomain "order/internal/domain/order"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -27,9 +28,13 @@ func (r *RepositoryMock) GetByID(ctx context.Context, orderID uuid.UUID) (*order
 	return args.Get(0).(*orderDomain.Order), args.Error(1)
 }
 
-func (r *RepositoryMock) GetAllByCustomer(ctx context.Context, customerID uuid.UUID) ([]*orderDomain.Order, error) {
-	args := r.Called(ctx, customerID)
-	return args.Get(0).([]*orderDomain.Order), args.Error(1)
+func (r *RepositoryMock) GetAllByCustomer(
+	ctx context.Context,
+	customerID uuid.UUID,
+	query orderDomain.ListQuery,
+) (*orderDomain.Page, error) {
+	args := r.Called(ctx, customerID, query)
+	return args.Get(0).(*orderDomain.Page), args.Error(1)
 }
 
 func (r *RepositoryMock) GetCurrentByCourier(ctx context.Context, courierID uuid.UUID) ([]*orderDomain.Order, error) {
@@ -37,4 +42,31 @@ func (r *RepositoryMock) GetCurrentByCourier(ctx context.Context, courierID uuid
 	return args.Get(0).([]*orderDomain.Order), args.Error(1)
 }
 
+func (r *RepositoryMock) GetHistoryByCourier(
+	ctx context.Context,
+	courierID uuid.UUID,
+	query orderDomain.ListQuery,
+) (*orderDomain.Page, error) {
+	args := r.Called(ctx, courierID, query)
+	return args.Get(0).(*orderDomain.Page), args.Error(1)
+}
+
+func (r *RepositoryMock) Search(
+	ctx context.Context,
+	filter orderDomain.SearchFilter,
+	query orderDomain.ListQuery,
+) (*orderDomain.Page, error) {
+	args := r.Called(ctx, filter, query)
+	return args.Get(0).(*orderDomain.Page), args.Error(1)
+}
+
+func (r *RepositoryMock) CountByCourier(
+	ctx context.Context,
+	courierID uuid.UUID,
+	createdFrom, createdTo *time.Time,
+) (orderDomain.StatusCounts, error) {
+	args := r.Called(ctx, courierID, createdFrom, createdTo)
+	return args.Get(0).(orderDomain.StatusCounts), args.Error(1)
+}
+
 var _ orderDomain.Repository = (*RepositoryMock)(nil)
@@ -0,0 +1,21 @@
+package cancel_order
+
+import (
+	"context"
+	cancelOrder "order/internal/application/order/saga/cancel_order"
+	orderDomain "order/internal/domain/order"
+	"order/internal/domain/uow"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type ManagerMock struct {
+	mock.Mock
+}
+
+func (m *ManagerMock) Create(ctx context.Context, tx uow.UoW, order *orderDomain.Order) error {
+	args := m.Called(ctx, tx, order)
+	return args.Error(0)
+}
+
+var _ cancelOrder.Manager = (*ManagerMock)(nil)
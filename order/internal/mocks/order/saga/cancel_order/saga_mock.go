@@ -0,0 +1,35 @@
+package cancel_order
+
+import (
+	"context"
+	cancelOrder "order/internal/application/order/saga/cancel_order"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type SagaMock struct {
+	mock.Mock
+}
+
+func (s *SagaMock) HandleItemsReleased(ctx context.Context, event cancelOrder.ItemsReleased) error {
+	args := s.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (s *SagaMock) HandleCourierReleased(ctx context.Context, event cancelOrder.CourierReleased) error {
+	args := s.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (s *SagaMock) HandleDeadlineExceeded(ctx context.Context, event cancelOrder.DeadlineExceeded) error {
+	args := s.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (s *SagaMock) RetryStep(ctx context.Context, orderID uuid.UUID) error {
+	args := s.Called(ctx, orderID)
+	return args.Error(0)
+}
+
+var _ cancelOrder.Saga = (*SagaMock)(nil)
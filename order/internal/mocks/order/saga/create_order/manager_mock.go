@@ -4,6 +4,7 @@ import (
 	"context"
 	createOrder "order/internal/application/order/saga/create_order"
 	orderDomain "order/internal/domain/order"
+	"order/internal/domain/uow"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -12,8 +13,9 @@ type ManagerMock struct {
 	mock.Mock
 }
 
-func (m *ManagerMock) Create(ctx context.Context, order *orderDomain.Order) {
-	m.Called(ctx, order)
+func (m *ManagerMock) Create(ctx context.Context, tx uow.UoW, order *orderDomain.Order) error {
+	args := m.Called(ctx, tx, order)
+	return args.Error(0)
 }
 
 var _ createOrder.Manager = (*ManagerMock)(nil)
@@ -0,0 +1,55 @@
+package create_order
+
+import (
+	"context"
+	createOrder "order/internal/application/order/saga/create_order"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type SagaMock struct {
+	mock.Mock
+}
+
+func (s *SagaMock) HandleItemsReserved(ctx context.Context, event createOrder.ItemsReserved) error {
+	args := s.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (s *SagaMock) HandleItemsReservationFailed(ctx context.Context, event createOrder.ItemsReservationFailed) error {
+	args := s.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (s *SagaMock) HandleCourierAssignmentFailed(ctx context.Context, event createOrder.CourierAssignmentFailed) error {
+	args := s.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (s *SagaMock) HandleCourierAssigned(ctx context.Context, event createOrder.CourierAssigned) error {
+	args := s.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (s *SagaMock) HandleItemsReleased(ctx context.Context, event createOrder.ItemsReleased) error {
+	args := s.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (s *SagaMock) HandleDeadlineExceeded(ctx context.Context, event createOrder.DeadlineExceeded) error {
+	args := s.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (s *SagaMock) HandleDeliverySlotApproaching(ctx context.Context, event createOrder.DeliverySlotApproaching) error {
+	args := s.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (s *SagaMock) RetryStep(ctx context.Context, orderID uuid.UUID) error {
+	args := s.Called(ctx, orderID)
+	return args.Error(0)
+}
+
+var _ createOrder.Saga = (*SagaMock)(nil)
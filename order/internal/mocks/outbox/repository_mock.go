@@ -0,0 +1,40 @@
+package outbox
+
+import (
+	"context"
+	outboxDomain "order/internal/domain/outbox"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type RepositoryMock struct {
+	mock.Mock
+}
+
+func (r *RepositoryMock) Create(ctx context.Context, message *outboxDomain.Message) error {
+	args := r.Called(ctx, message)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) CreateOnce(ctx context.Context, message *outboxDomain.Message) error {
+	args := r.Called(ctx, message)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) Update(ctx context.Context, message *outboxDomain.Message) error {
+	args := r.Called(ctx, message)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) Delete(ctx context.Context, message *outboxDomain.Message) error {
+	args := r.Called(ctx, message)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) ClaimPending(ctx context.Context, leaseUntil time.Time) (*outboxDomain.Message, error) {
+	args := r.Called(ctx, leaseUntil)
+	return args.Get(0).(*outboxDomain.Message), args.Error(1)
+}
+
+var _ outboxDomain.Repository = (*RepositoryMock)(nil)
@@ -0,0 +1,50 @@
+package promotion
+
+import (
+	"context"
+	promotionDomain "order/internal/domain/promotion"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type RepositoryMock struct {
+	mock.Mock
+}
+
+func (r *RepositoryMock) Create(ctx context.Context, promotion *promotionDomain.Promotion) error {
+	args := r.Called(ctx, promotion)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) Update(ctx context.Context, promotion *promotionDomain.Promotion) error {
+	args := r.Called(ctx, promotion)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) GetByID(ctx context.Context, promotionID uuid.UUID) (*promotionDomain.Promotion, error) {
+	args := r.Called(ctx, promotionID)
+	return args.Get(0).(*promotionDomain.Promotion), args.Error(1)
+}
+
+func (r *RepositoryMock) GetByCode(ctx context.Context, code string) (*promotionDomain.Promotion, error) {
+	args := r.Called(ctx, code)
+	return args.Get(0).(*promotionDomain.Promotion), args.Error(1)
+}
+
+func (r *RepositoryMock) GetAll(ctx context.Context, activeOnly bool) ([]*promotionDomain.Promotion, error) {
+	args := r.Called(ctx, activeOnly)
+	return args.Get(0).([]*promotionDomain.Promotion), args.Error(1)
+}
+
+func (r *RepositoryMock) Redeem(ctx context.Context, promotion *promotionDomain.Promotion, customerID uuid.UUID) error {
+	args := r.Called(ctx, promotion, customerID)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) Release(ctx context.Context, promotion *promotionDomain.Promotion, customerID uuid.UUID) error {
+	args := r.Called(ctx, promotion, customerID)
+	return args.Error(0)
+}
+
+var _ promotionDomain.Repository = (*RepositoryMock)(nil)
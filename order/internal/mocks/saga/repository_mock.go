@@ -0,0 +1,66 @@
+package saga
+
+import (
+	"context"
+	sagaDomain "order/internal/domain/saga"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type RepositoryMock struct {
+	mock.Mock
+}
+
+func (r *RepositoryMock) Create(ctx context.Context, saga *sagaDomain.Saga) error {
+	args := r.Called(ctx, saga)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) Update(ctx context.Context, saga *sagaDomain.Saga) error {
+	args := r.Called(ctx, saga)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) GetByID(ctx context.Context, sagaID uuid.UUID) (*sagaDomain.Saga, error) {
+	args := r.Called(ctx, sagaID)
+	return args.Get(0).(*sagaDomain.Saga), args.Error(1)
+}
+
+func (r *RepositoryMock) GetByOrderID(
+	ctx context.Context,
+	sagaType sagaDomain.Type,
+	orderID uuid.UUID,
+) (*sagaDomain.Saga, error) {
+	args := r.Called(ctx, sagaType, orderID)
+	return args.Get(0).(*sagaDomain.Saga), args.Error(1)
+}
+
+func (r *RepositoryMock) GetAllByOrderID(ctx context.Context, orderID uuid.UUID) ([]*sagaDomain.Saga, error) {
+	args := r.Called(ctx, orderID)
+	return args.Get(0).([]*sagaDomain.Saga), args.Error(1)
+}
+
+func (r *RepositoryMock) ClaimStalled(
+	ctx context.Context,
+	sagaType sagaDomain.Type,
+	steps []sagaDomain.Step,
+	enteredBefore time.Time,
+	leaseUntil time.Time,
+) (*sagaDomain.Saga, error) {
+	args := r.Called(ctx, sagaType, steps, enteredBefore, leaseUntil)
+	return args.Get(0).(*sagaDomain.Saga), args.Error(1)
+}
+
+func (r *RepositoryMock) ClaimSuspended(
+	ctx context.Context,
+	sagaType sagaDomain.Type,
+	resumeBefore time.Time,
+	leaseUntil time.Time,
+) (*sagaDomain.Saga, error) {
+	args := r.Called(ctx, sagaType, resumeBefore, leaseUntil)
+	return args.Get(0).(*sagaDomain.Saga), args.Error(1)
+}
+
+var _ sagaDomain.Repository = (*RepositoryMock)(nil)
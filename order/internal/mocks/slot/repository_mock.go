@@ -0,0 +1,31 @@
+package slot
+
+import (
+	"context"
+	orderDomain "order/internal/domain/order"
+	slotDomain "order/internal/domain/slot"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type RepositoryMock struct {
+	mock.Mock
+}
+
+func (r *RepositoryMock) Reserve(ctx context.Context, slot orderDomain.DeliverySlot, capacity int) error {
+	args := r.Called(ctx, slot, capacity)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) Release(ctx context.Context, slot orderDomain.DeliverySlot) error {
+	args := r.Called(ctx, slot)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) GetReserved(ctx context.Context, from, to time.Time) (map[time.Time]int, error) {
+	args := r.Called(ctx, from, to)
+	return args.Get(0).(map[time.Time]int), args.Error(1)
+}
+
+var _ slotDomain.Repository = (*RepositoryMock)(nil)
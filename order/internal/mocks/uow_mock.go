@@ -0,0 +1,92 @@
+package mocks
+
+import (
+	"context"
+	inboxDomain "order/internal/domain/inbox"
+	orderDomain "order/internal/domain/order"
+	outboxDomain "order/internal/domain/outbox"
+	promotionDomain "order/internal/domain/promotion"
+	sagaDomain "order/internal/domain/saga"
+	slotDomain "order/internal/domain/slot"
+	"order/internal/domain/uow"
+	inboxMock "order/internal/mocks/inbox"
+	orderMock "order/internal/mocks/order"
+	outboxMock "order/internal/mocks/outbox"
+	promotionMock "order/internal/mocks/promotion"
+	sagaMock "order/internal/mocks/saga"
+	slotMock "order/internal/mocks/slot"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type UoWMock struct {
+	OrderMock     *orderMock.RepositoryMock
+	SagaMock      *sagaMock.RepositoryMock
+	OutboxMock    *outboxMock.RepositoryMock
+	PromotionMock *promotionMock.RepositoryMock
+	SlotMock      *slotMock.RepositoryMock
+	InboxMock     *inboxMock.RepositoryMock
+
+	mock.Mock
+}
+
+func NewUowMock() *UoWMock {
+	order := &orderMock.RepositoryMock{}
+	saga := &sagaMock.RepositoryMock{}
+	outbox := &outboxMock.RepositoryMock{}
+	promotion := &promotionMock.RepositoryMock{}
+	slot := &slotMock.RepositoryMock{}
+	inbox := &inboxMock.RepositoryMock{}
+	return &UoWMock{
+		OrderMock:     order,
+		SagaMock:      saga,
+		OutboxMock:    outbox,
+		PromotionMock: promotion,
+		SlotMock:      slot,
+		InboxMock:     inbox,
+	}
+}
+
+func (u *UoWMock) Order() orderDomain.Repository {
+	return u.OrderMock
+}
+
+func (u *UoWMock) Saga() sagaDomain.Repository {
+	return u.SagaMock
+}
+
+func (u *UoWMock) Outbox() outboxDomain.Repository {
+	return u.OutboxMock
+}
+
+func (u *UoWMock) Promotion() promotionDomain.Repository {
+	return u.PromotionMock
+}
+
+func (u *UoWMock) Slot() slotDomain.Repository {
+	return u.SlotMock
+}
+
+func (u *UoWMock) Inbox() inboxDomain.Repository {
+	return u.InboxMock
+}
+
+func (u *UoWMock) Transaction(ctx context.Context, fn func(ctx context.Context, u uow.UoW) error) error {
+	args := u.Called(ctx, fn)
+	if len(args) == 0 {
+		return fn(ctx, u)
+	}
+	return args.Error(0)
+}
+
+func (u *UoWMock) AssertExpectations(t mock.TestingT) bool {
+	ok := u.OrderMock.AssertExpectations(t)
+	ok = u.SagaMock.AssertExpectations(t) && ok
+	ok = u.OutboxMock.AssertExpectations(t) && ok
+	ok = u.PromotionMock.AssertExpectations(t) && ok
+	ok = u.SlotMock.AssertExpectations(t) && ok
+	ok = u.InboxMock.AssertExpectations(t) && ok
+	return u.Mock.AssertExpectations(t) && ok
+}
+
+var _ uow.UoW = (*UoWMock)(nil)
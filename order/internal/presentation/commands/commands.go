@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 
 	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
 )
 
 const (
 	CancelOutOfStockCmdName      CmdMessageName = "create_order.cancel_out_of_stock"
 	BeginDeliveryCmdName         CmdMessageName = "create_order.begin_delivery"
 	CancelCourierNotFoundCmdName CmdMessageName = "create_order.cancel_courier_not_found"
+	CancelTimeoutCmdName         CmdMessageName = "create_order.cancel_timeout"
+	CancelByCustomerCmdName      CmdMessageName = "cancel_order.cancel_by_customer"
 )
 
 type (
@@ -27,6 +30,7 @@ type (
 		Msg       *CmdMessage
 		Topic     string
 		Partition int
+		Raw       *kafka.Message
 	}
 )
 
@@ -38,6 +42,14 @@ type CancelCourierNotFoundCmd struct {
 	OrderID uuid.UUID
 }
 
+type CancelTimeoutCmd struct {
+	OrderID uuid.UUID
+}
+
+type CancelByCustomerCmd struct {
+	OrderID uuid.UUID
+}
+
 type BeginDeliveryCmd struct {
 	OrderID   uuid.UUID
 	CourierID uuid.UUID
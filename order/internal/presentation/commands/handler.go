@@ -3,10 +3,16 @@ package commands
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	cancelOrder "order/internal/application/order/saga/cancel_order"
 	createOrder "order/internal/application/order/saga/create_order"
 	orderUsecase "order/internal/application/order/usecase"
+	orderDomain "order/internal/domain/order"
+	"order/internal/infrastructure/messaging/retry"
 	createOrderConsumer "order/internal/presentation/saga/create_order"
+
+	"github.com/google/uuid"
 )
 
 type Handler interface {
@@ -26,54 +32,100 @@ func (h *HandlerImpl) Handle(ctx context.Context, cmdMsg *CmdMessage) (*createOr
 	case CancelOutOfStockCmdName:
 		var cmd createOrder.CancelOutOfStockCmd
 		if err := json.Unmarshal(cmdMsg.Payload, &cmd); err != nil {
-			return nil, fmt.Errorf("failed to parse CancelOutOfStockCmd: %w", err)
+			return nil, retry.Permanent(fmt.Errorf("failed to parse CancelOutOfStockCmd: %w", err))
 		}
-		return h.onCancelOutOfStock(ctx, cmd), nil
+		return nil, h.onCancelOutOfStock(ctx, cmdMsg.ID, cmd)
 
 	case CancelCourierNotFoundCmdName:
 		var cmd createOrder.CancelCourierNotFoundCmd
 		if err := json.Unmarshal(cmdMsg.Payload, &cmd); err != nil {
-			return nil, fmt.Errorf("failed to parse CancelCourierNotFoundCmd: %w", err)
+			return nil, retry.Permanent(fmt.Errorf("failed to parse CancelCourierNotFoundCmd: %w", err))
 		}
-		return h.onCancelCourierNotFoundCmd(ctx, cmd), nil
+		return nil, h.onCancelCourierNotFoundCmd(ctx, cmdMsg.ID, cmd)
+
+	case CancelTimeoutCmdName:
+		var cmd createOrder.CancelTimeoutCmd
+		if err := json.Unmarshal(cmdMsg.Payload, &cmd); err != nil {
+			return nil, retry.Permanent(fmt.Errorf("failed to parse CancelTimeoutCmd: %w", err))
+		}
+		return nil, h.onCancelTimeout(ctx, cmdMsg.ID, cmd)
 
 	case BeginDeliveryCmdName:
 		var cmd createOrder.BeginDeliveryCmd
 		if err := json.Unmarshal(cmdMsg.Payload, &cmd); err != nil {
-			return nil, fmt.Errorf("failed to parse BeginDeliveryCmd: %w", err)
+			return nil, retry.Permanent(fmt.Errorf("failed to parse BeginDeliveryCmd: %w", err))
 		}
-		return h.onBeginDelivery(ctx, cmd), nil
+		return nil, h.onBeginDelivery(ctx, cmdMsg.ID, cmd)
+
+	case CancelByCustomerCmdName:
+		var cmd cancelOrder.CancelByCustomerCmd
+		if err := json.Unmarshal(cmdMsg.Payload, &cmd); err != nil {
+			return nil, retry.Permanent(fmt.Errorf("failed to parse CancelByCustomerCmd: %w", err))
+		}
+		return nil, h.onCancelByCustomer(ctx, cmdMsg.ID, cmd)
 	}
 
-	return nil, fmt.Errorf("unknown command: %s", cmdMsg.Name)
+	return nil, retry.Permanent(fmt.Errorf("unknown command: %s", cmdMsg.Name))
 }
 
 func (h *HandlerImpl) onCancelOutOfStock(
 	ctx context.Context,
+	messageID uuid.UUID,
 	cmd createOrder.CancelOutOfStockCmd,
-) *createOrderConsumer.ResMessage {
-	_ = h.usecase.CancelOutOfStock(ctx, cmd.OrderID)
-	return nil
+) error {
+	return rejected(h.usecase.CancelOutOfStock(ctx, cmd.OrderID, messageID))
 }
 
 func (h *HandlerImpl) onCancelCourierNotFoundCmd(
 	ctx context.Context,
+	messageID uuid.UUID,
 	cmd createOrder.CancelCourierNotFoundCmd,
-) *createOrderConsumer.ResMessage {
-	_ = h.usecase.CancelCourierNotFound(ctx, cmd.OrderID)
-	return nil
+) error {
+	return rejected(h.usecase.CancelCourierNotFound(ctx, cmd.OrderID, messageID))
+}
+
+func (h *HandlerImpl) onCancelTimeout(
+	ctx context.Context,
+	messageID uuid.UUID,
+	cmd createOrder.CancelTimeoutCmd,
+) error {
+	return rejected(h.usecase.CancelTimeout(ctx, cmd.OrderID, messageID))
+}
+
+func (h *HandlerImpl) onCancelByCustomer(
+	ctx context.Context,
+	messageID uuid.UUID,
+	cmd cancelOrder.CancelByCustomerCmd,
+) error {
+	return rejected(h.usecase.CompleteCancellation(ctx, cmd.OrderID, messageID))
 }
 
 func (h *HandlerImpl) onBeginDelivery(
 	ctx context.Context,
+	messageID uuid.UUID,
 	cmd createOrder.BeginDeliveryCmd,
-) *createOrderConsumer.ResMessage {
+) error {
 	data := orderUsecase.BeginDeliveryDto{
 		OrderID:   cmd.OrderID,
 		CourierID: cmd.CourierID,
+		MessageID: messageID,
 	}
-	_ = h.usecase.BeginDelivery(ctx, data)
-	return nil
+	return rejected(h.usecase.BeginDelivery(ctx, data))
+}
+
+// rejected marks the error of an order that refuses the command as permanent,
+// since handling the command again would not change the answer. Other errors,
+// including a lost race on the order version, are left to the retry policy.
+func rejected(err error) error {
+	if errors.Is(err, orderDomain.ErrUnsupportedStatusTransition) {
+		return retry.Permanent(err)
+	}
+	return err
+}
+
+// IsRetryable reports whether a failed command may succeed when handled again.
+func IsRetryable(err error) bool {
+	return !retry.IsPermanent(err)
 }
 
 var _ Handler = (*HandlerImpl)(nil)
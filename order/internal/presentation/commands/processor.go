@@ -2,8 +2,16 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	inboxDomain "order/internal/domain/inbox"
+	inboxConfig "order/internal/infrastructure/inbox"
 	"order/internal/infrastructure/logger"
+	"order/internal/infrastructure/messaging/dlq"
+	"order/internal/infrastructure/messaging/retry"
+	"order/internal/infrastructure/messaging/worker"
+	inboxRepository "order/internal/infrastructure/repository/inbox"
+	createOrderConsumer "order/internal/presentation/saga/create_order"
 	"sync"
 	"time"
 
@@ -18,6 +26,13 @@ type Processor struct {
 	reader  Reader
 	writer  Writer
 
+	inbox    inboxDomain.Repository
+	inboxCfg *inboxConfig.Config
+
+	retry     *retry.Policy
+	pool      *worker.Pool
+	dlqWriter dlq.Writer
+
 	cancelCtx  context.Context
 	cancelFunc context.CancelFunc
 
@@ -28,12 +43,27 @@ type Processor struct {
 	logger logger.Logger
 }
 
-func NewProcessor(handler Handler, reader Reader, writer Writer, logger logger.Logger) *Processor {
+func NewProcessor(
+	handler Handler,
+	reader Reader,
+	writer Writer,
+	inbox inboxDomain.Repository,
+	inboxCfg *inboxConfig.Config,
+	retryCfg *retry.Config,
+	workerCfg *worker.Config,
+	dlqWriter dlq.Writer,
+	logger logger.Logger,
+) *Processor {
 	return &Processor{
-		handler: handler,
-		reader:  reader,
-		writer:  writer,
-		logger:  logger,
+		handler:   handler,
+		reader:    reader,
+		writer:    writer,
+		inbox:     inbox,
+		inboxCfg:  inboxCfg,
+		retry:     retry.NewPolicy(retryCfg, IsRetryable),
+		pool:      worker.NewPool(workerCfg),
+		dlqWriter: dlqWriter,
+		logger:    logger,
 	}
 }
 
@@ -61,6 +91,7 @@ func (p *Processor) Start(ctx context.Context) error {
 	p.started = true
 
 	p.log(logger.Info, "start", "Starting command processor", nil)
+	p.pool.Start()
 	p.wg.Add(1)
 	go p.processCommands(p.cancelCtx)
 	return nil
@@ -86,42 +117,148 @@ func (p *Processor) processCommands(ctx context.Context) {
 				continue
 			}
 
-			// Handle the command
-			sCtx, span := startProcessSpan(cmd)
-			startTime := time.Now()
+			// Handle the command on the worker owning its key and commit its offset once it is
+			// handled or dead-lettered; otherwise it is redelivered after a restart
+			p.pool.Submit(ctx, cmd.Raw, func() {
+				if p.processCommand(cmd) {
+					p.reader.Commit(cmd)
+				}
+			})
+		}
+	}
+}
 
-			res, err := p.handler.Handle(sCtx, cmd.Msg)
+// processCommand reports whether the command is done with: handled, or moved to the DLQ
+// once its attempts ran out.
+func (p *Processor) processCommand(cmd *CmdEnvelope) bool {
+	// Replay the stored response for an already processed command
+	if p.replay(cmd) {
+		return true
+	}
 
-			duration := time.Since(startTime)
-			span.End()
+	// Handle the command, retrying transient failures
+	sCtx, span := startProcessSpan(cmd)
+	startTime := time.Now()
 
-			if err != nil {
-				p.log(logger.Error, "process_error", "Command processing failed", map[string]any{
-					"command_id":  cmd.Msg.ID,
-					"error":       err.Error(),
-					"duration_ms": duration.Milliseconds(),
-				})
-				continue
-			}
+	var res *createOrderConsumer.ResMessage
+	attempts, err := p.retry.Do(sCtx, func(ctx context.Context) error {
+		var err error
+		res, err = p.handler.Handle(ctx, cmd.Msg)
+		return err
+	})
+
+	duration := time.Since(startTime)
+	span.End()
+
+	if err != nil {
+		p.log(logger.Error, "process_error", "Command processing failed", map[string]any{
+			"command_id":  cmd.Msg.ID,
+			"error":       err.Error(),
+			"attempts":    attempts,
+			"duration_ms": duration.Milliseconds(),
+		})
+		return p.deadLetter(cmd, err, attempts)
+	}
+
+	p.log(logger.Info, "process_success", "Command processed successfully", map[string]any{
+		"command_id":   cmd.Msg.ID,
+		"duration_ms":  duration.Milliseconds(),
+		"has_response": res != nil,
+	})
 
-			p.log(logger.Info, "process_success", "Command processed successfully", map[string]any{
-				"command_id":   cmd.Msg.ID,
-				"duration_ms":  duration.Milliseconds(),
-				"has_response": res != nil,
+	// Record the command with its response
+	p.record(cmd, res)
+
+	// Write the response
+	if res != nil {
+		if err := p.writer.Write(cmd.Ctx, cmd.Raw.Key, res); err != nil {
+			p.log(logger.Error, "write_error", "Error sending response", map[string]any{
+				"command_id":  cmd.Msg.ID,
+				"response_id": res.ID,
+				"error":       err.Error(),
 			})
+		}
+	}
+	return true
+}
 
-			// Write the response
-			if res != nil {
-				if err := p.writer.Write(cmd.Ctx, res); err != nil {
-					p.log(logger.Error, "write_error", "Error sending response", map[string]any{
-						"command_id":  cmd.Msg.ID,
-						"response_id": res.ID,
-						"error":       err.Error(),
-					})
-				}
-			}
+// replay reports whether the command has already been processed, resending its stored response if so.
+func (p *Processor) replay(cmd *CmdEnvelope) bool {
+	message, err := p.inbox.GetByID(cmd.Ctx, cmd.Msg.ID)
+	if errors.Is(err, inboxRepository.ErrInboxMessageNotFound) {
+		return false
+	}
+	if err != nil {
+		p.log(logger.Error, "inbox_read_error", "Error checking command in inbox", map[string]any{
+			"command_id": cmd.Msg.ID,
+			"error":      err.Error(),
+		})
+		return false
+	}
+
+	p.log(logger.Info, "duplicate", "Command already processed, replaying response", map[string]any{
+		"command_id":   cmd.Msg.ID,
+		"processed_at": message.Processed,
+		"has_response": message.Response != nil,
+	})
+	if message.Response == nil {
+		return true
+	}
+
+	var res createOrderConsumer.ResMessage
+	if err := json.Unmarshal(message.Response, &res); err != nil {
+		p.log(logger.Error, "replay_error", "Error decoding stored response", map[string]any{
+			"command_id": cmd.Msg.ID,
+			"error":      err.Error(),
+		})
+		return true
+	}
+	if err := p.writer.Write(cmd.Ctx, cmd.Raw.Key, &res); err != nil {
+		p.log(logger.Error, "write_error", "Error sending response", map[string]any{
+			"command_id":  cmd.Msg.ID,
+			"response_id": res.ID,
+			"error":       err.Error(),
+		})
+	}
+	return true
+}
+
+func (p *Processor) record(cmd *CmdEnvelope, res *createOrderConsumer.ResMessage) {
+	var response []byte
+	if res != nil {
+		var err error
+		if response, err = json.Marshal(res); err != nil {
+			p.log(logger.Error, "inbox_write_error", "Error encoding response for inbox", map[string]any{
+				"command_id": cmd.Msg.ID,
+				"error":      err.Error(),
+			})
+			return
 		}
 	}
+
+	message := inboxDomain.Create(cmd.Msg.ID, response, p.inboxCfg.Retention)
+	if err := p.inbox.Create(cmd.Ctx, message); err != nil {
+		p.log(logger.Error, "inbox_write_error", "Error recording command in inbox", map[string]any{
+			"command_id": cmd.Msg.ID,
+			"error":      err.Error(),
+		})
+	}
+}
+
+// deadLetter moves the command to the DLQ, retrying the write as the handler is
+// retried, and reports whether it got there.
+func (p *Processor) deadLetter(cmd *CmdEnvelope, cause error, attempts int) bool {
+	_, err := p.retry.Do(cmd.Ctx, func(ctx context.Context) error {
+		return p.dlqWriter.Write(ctx, cmd.Raw, cause, attempts)
+	})
+	if err != nil {
+		p.log(logger.Error, "dlq_error", "Error sending command to DLQ, leaving its offset uncommitted", map[string]any{
+			"command_id": cmd.Msg.ID,
+			"error":      err.Error(),
+		})
+		return false
+	}
+	return true
 }
 
 func (p *Processor) Stop() error {
@@ -135,6 +272,7 @@ func (p *Processor) Stop() error {
 	p.log(logger.Info, "stop_request", "Stopping command processor", nil)
 	p.cancelFunc()
 	p.wg.Wait()
+	p.pool.Stop()
 	p.started = false
 
 	p.log(logger.Info, "stopped", "Command processor stopped", nil)
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"order/internal/infrastructure/logger"
+	"order/internal/infrastructure/messaging"
 	createOrderConsumer "order/internal/presentation/saga/create_order"
 
 	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
@@ -13,15 +14,15 @@ import (
 )
 
 type Writer interface {
-	Write(ctx context.Context, res *createOrderConsumer.ResMessage) error
+	Write(ctx context.Context, key []byte, res *createOrderConsumer.ResMessage) error
 }
 
 type WriterImpl struct {
-	writer *otelkafkakonsumer.Writer
+	writer messaging.Writer
 	logger logger.Logger
 }
 
-func NewWriter(writer *otelkafkakonsumer.Writer, logger logger.Logger) *WriterImpl {
+func NewWriter(writer messaging.Writer, logger logger.Logger) *WriterImpl {
 	return &WriterImpl{
 		writer: writer,
 		logger: logger,
@@ -32,7 +33,7 @@ func (w *WriterImpl) log(level logger.Level, action, message string, extraFields
 	fields := map[string]any{
 		"component": "command_writer",
 		"action":    action,
-		"topic":     w.writer.W.Topic,
+		"topic":     w.writer.Topic(),
 	}
 	for k, v := range extraFields {
 		fields[k] = v
@@ -41,7 +42,9 @@ func (w *WriterImpl) log(level logger.Level, action, message string, extraFields
 	w.logger.Log(level, message, fields)
 }
 
-func (w *WriterImpl) Write(ctx context.Context, res *createOrderConsumer.ResMessage) error {
+// Write sends the response keyed like the command it answers, so that it lands on
+// the same partition as every other message of that order.
+func (w *WriterImpl) Write(ctx context.Context, key []byte, res *createOrderConsumer.ResMessage) error {
 	if res == nil {
 		return nil
 	}
@@ -56,10 +59,10 @@ func (w *WriterImpl) Write(ctx context.Context, res *createOrderConsumer.ResMess
 		return fmt.Errorf("error serializing response: %w", err)
 	}
 
-	kafkaMsg := kafka.Message{Value: msg}
+	kafkaMsg := kafka.Message{Key: key, Value: msg}
 
 	// Write the message to Kafka
-	ctx = w.writer.TraceConfig.Propagator.Extract(ctx, otelkafkakonsumer.NewMessageCarrier(&kafkaMsg))
+	ctx = w.writer.Propagator().Extract(ctx, otelkafkakonsumer.NewMessageCarrier(&kafkaMsg))
 
 	if err = w.writer.WriteMessage(ctx, kafkaMsg); err != nil {
 		w.log(logger.Error, "kafka_write_error", "Failed to send response to Kafka", map[string]any{
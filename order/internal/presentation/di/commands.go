@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"order/internal/infrastructure/logger"
+	"order/internal/infrastructure/messaging/dlq"
 	"order/internal/presentation/commands"
 
 	"go.uber.org/fx"
@@ -18,6 +19,13 @@ var CommandConsumerModule = fx.Options(
 			fx.As(new(commands.Handler)),
 		),
 
+		// Dead-letter writers
+		fx.Annotate(
+			dlq.NewWriter,
+			fx.ParamTags(`name:"orderCommandDLQWriter"`),
+			fx.As(new(dlq.Writer)),
+		),
+
 		// Readers
 		fx.Annotate(
 			commands.NewReader,
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"order/internal/infrastructure/logger"
+	"order/internal/infrastructure/messaging/dlq"
 	"order/internal/presentation/saga/create_order"
 
 	"go.uber.org/fx"
@@ -12,16 +13,30 @@ import (
 
 var SagaConsumerModule = fx.Options(
 	fx.Provide(
+		// Dead-letter writers
+		fx.Annotate(
+			dlq.NewWriter,
+			fx.ParamTags(`name:"warehouseCommandResultDLQWriter"`),
+			fx.ResultTags(`name:"warehouseDLQWriter"`),
+			fx.As(new(dlq.Writer)),
+		),
+		fx.Annotate(
+			dlq.NewWriter,
+			fx.ParamTags(`name:"courierCommandResultDLQWriter"`),
+			fx.ResultTags(`name:"courierDLQWriter"`),
+			fx.As(new(dlq.Writer)),
+		),
+
 		// Saga event readers
 		fx.Annotate(
 			create_order.NewReader,
-			fx.ParamTags(`name:"warehouseCommandResultReader"`),
+			fx.ParamTags(`name:"warehouseCommandResultReader"`, `name:"warehouseDLQWriter"`),
 			fx.ResultTags(`name:"warehouseReader"`),
 			fx.As(new(create_order.Reader)),
 		),
 		fx.Annotate(
 			create_order.NewReader,
-			fx.ParamTags(`name:"courierCommandResultReader"`),
+			fx.ParamTags(`name:"courierCommandResultReader"`, `name:"courierDLQWriter"`),
 			fx.ResultTags(`name:"courierReader"`),
 			fx.As(new(create_order.Reader)),
 		),
@@ -33,7 +48,10 @@ var SagaConsumerModule = fx.Options(
 		),
 		fx.Annotate(
 			create_order.NewProcessor,
-			fx.ParamTags(``, `name:"warehouseReader"`, `name:"courierReader"`),
+			fx.ParamTags(
+				``, `name:"warehouseReader"`, `name:"courierReader"`, ``, ``, ``, ``,
+				`name:"warehouseDLQWriter"`, `name:"courierDLQWriter"`,
+			),
 		),
 	),
 	fx.Invoke(runProcessor),
@@ -89,3 +107,27 @@ func runProcessor(in struct {
 		},
 	})
 }
+
+var SagaWatchdogModule = fx.Options(
+	fx.Provide(
+		// Configuration
+		create_order.NewWatchdogConfig,
+
+		// Watchdog
+		create_order.NewWatchdog,
+	),
+	fx.Invoke(runWatchdog),
+)
+
+func runWatchdog(lc fx.Lifecycle, watchdog *create_order.Watchdog, logger logger.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Println("Starting saga watchdog...")
+			return watchdog.Start(ctx)
+		},
+		OnStop: func(ctx context.Context) error {
+			logger.Println("Stopping saga watchdog...")
+			return watchdog.Stop()
+		},
+	})
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	orderUsecase "order/internal/application/order/usecase"
+	orderv1 "order/internal/presentation/grpc"
+	"order/internal/presentation/grpc/request"
+	"order/internal/presentation/grpc/response"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *OrderServiceHandler) SearchOrders(ctx context.Context, req *orderv1.SearchOrdersRequest) (*orderv1.SearchOrdersResponse, error) {
+	filter, err := request.ToSearchFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := request.ToListQuery(req.Limit, req.Cursor, req.Statuses, req.CreatedFrom, req.CreatedTo, req.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.usecase.Search(ctx, filter, query)
+	if err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToSearchOrdersResponse(page)
+}
+
+func (h *OrderServiceHandler) ForceCancelOrder(ctx context.Context, req *orderv1.ForceCancelOrderRequest) (*emptypb.Empty, error) {
+	orderID, err := request.ParseUUID(req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	data := orderUsecase.ForceCancelDto{
+		OrderID: orderID,
+		Reason:  req.Reason,
+	}
+	if err = h.usecase.ForceCancel(ctx, data); err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToEmptyResponse(), nil
+}
+
+func (h *OrderServiceHandler) RetrySagaStep(ctx context.Context, req *orderv1.RetrySagaStepRequest) (*emptypb.Empty, error) {
+	orderID, err := request.ParseUUID(req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	sagaType, err := request.ParseSagaType(req.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.sagaUsecase.RetryStep(ctx, orderID, sagaType); err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToEmptyResponse(), nil
+}
+
+func (h *OrderServiceHandler) ReassignCourier(ctx context.Context, req *orderv1.ReassignCourierRequest) (*emptypb.Empty, error) {
+	orderID, err := request.ParseUUID(req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	courierID, err := request.ParseUUID(req.CourierId)
+	if err != nil {
+		return nil, err
+	}
+
+	data := orderUsecase.ReassignCourierDto{
+		OrderID:   orderID,
+		CourierID: courierID,
+		Reason:    req.Reason,
+	}
+	if err = h.usecase.ReassignCourier(ctx, data); err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToEmptyResponse(), nil
+}
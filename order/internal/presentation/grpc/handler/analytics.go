@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+	orderv1 "order/internal/presentation/grpc"
+	"order/internal/presentation/grpc/request"
+	"order/internal/presentation/grpc/response"
+)
+
+func (h *OrderServiceHandler) GetStatusFunnel(
+	ctx context.Context,
+	req *orderv1.GetStatusFunnelRequest,
+) (*orderv1.GetStatusFunnelResponse, error) {
+	funnel, err := h.analyticsUsecase.GetStatusFunnel(ctx, request.ToPeriod(req.CreatedFrom, req.CreatedTo))
+	if err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToGetStatusFunnelResponse(funnel)
+}
+
+func (h *OrderServiceHandler) GetRevenue(ctx context.Context, req *orderv1.GetRevenueRequest) (*orderv1.GetRevenueResponse, error) {
+	granularity, err := request.ParseGranularity(req.Granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets, err := h.analyticsUsecase.GetRevenue(ctx, request.ToPeriod(req.CreatedFrom, req.CreatedTo), granularity)
+	if err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToGetRevenueResponse(buckets)
+}
+
+func (h *OrderServiceHandler) GetDeliveryTime(
+	ctx context.Context,
+	req *orderv1.GetDeliveryTimeRequest,
+) (*orderv1.GetDeliveryTimeResponse, error) {
+	deliveryTime, err := h.analyticsUsecase.GetDeliveryTime(ctx, request.ToPeriod(req.CreatedFrom, req.CreatedTo))
+	if err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToGetDeliveryTimeResponse(deliveryTime)
+}
+
+func (h *OrderServiceHandler) GetCancellationReasons(
+	ctx context.Context,
+	req *orderv1.GetCancellationReasonsRequest,
+) (*orderv1.GetCancellationReasonsResponse, error) {
+	reasons, err := h.analyticsUsecase.GetCancellationReasons(ctx, request.ToPeriod(req.CreatedFrom, req.CreatedTo))
+	if err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToGetCancellationReasonsResponse(reasons)
+}
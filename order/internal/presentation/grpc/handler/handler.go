@@ -2,7 +2,11 @@ package handler
 
 import (
 	"context"
+	analyticsUsecase "order/internal/application/analytics/usecase"
 	orderUsecase "order/internal/application/order/usecase"
+	promotionUsecase "order/internal/application/promotion/usecase"
+	sagaUsecase "order/internal/application/saga/usecase"
+	slotUsecase "order/internal/application/slot/usecase"
 	orderv1 "order/internal/presentation/grpc"
 	"order/internal/presentation/grpc/request"
 	"order/internal/presentation/grpc/response"
@@ -13,12 +17,26 @@ import (
 type OrderServiceHandler struct {
 	orderv1.UnimplementedOrderServiceServer
 
-	usecase orderUsecase.UseCase
+	usecase          orderUsecase.UseCase
+	sagaUsecase      sagaUsecase.UseCase
+	promotionUsecase promotionUsecase.UseCase
+	slotUsecase      slotUsecase.UseCase
+	analyticsUsecase analyticsUsecase.UseCase
 }
 
-func NewOrderServiceHandler(usecase orderUsecase.UseCase) *OrderServiceHandler {
+func NewOrderServiceHandler(
+	usecase orderUsecase.UseCase,
+	sagaUsecase sagaUsecase.UseCase,
+	promotionUsecase promotionUsecase.UseCase,
+	slotUsecase slotUsecase.UseCase,
+	analyticsUsecase analyticsUsecase.UseCase,
+) *OrderServiceHandler {
 	return &OrderServiceHandler{
-		usecase: usecase,
+		usecase:          usecase,
+		sagaUsecase:      sagaUsecase,
+		promotionUsecase: promotionUsecase,
+		slotUsecase:      slotUsecase,
+		analyticsUsecase: analyticsUsecase,
 	}
 }
 
@@ -39,13 +57,43 @@ func (h *OrderServiceHandler) CreateOrder(
 	return response.ToCreateOrderResponse(orderID), nil
 }
 
+func (h *OrderServiceHandler) GetOrder(
+	ctx context.Context,
+	req *orderv1.GetOrderRequest,
+) (*orderv1.GetOrderResponse, error) {
+	orderID, err := request.ParseUUID(req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	requester, err := request.ToActor(req.Requester)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := h.usecase.GetByID(ctx, orderID, requester)
+	if err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToGetOrderResponse(order)
+}
+
 func (h *OrderServiceHandler) CancelOrderByCustomer(ctx context.Context, req *orderv1.CancelOrderByCustomerRequest) (*emptypb.Empty, error) {
 	orderID, err := request.ParseUUID(req.OrderId)
 	if err != nil {
 		return nil, err
 	}
+	customerID, err := request.ParseUUID(req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
 
-	if err = h.usecase.CancelByCustomer(ctx, orderID); err != nil {
+	data := orderUsecase.CancelByCustomerDto{
+		OrderID:    orderID,
+		CustomerID: customerID,
+		Reason:     req.Reason,
+	}
+	if err = h.usecase.CancelByCustomer(ctx, data); err != nil {
 		return nil, response.ParseError(err)
 	}
 
@@ -57,8 +105,12 @@ func (h *OrderServiceHandler) CompleteDelivery(ctx context.Context, req *orderv1
 	if err != nil {
 		return nil, err
 	}
+	courierID, err := request.ParseUUID(req.CourierId)
+	if err != nil {
+		return nil, err
+	}
 
-	if err = h.usecase.CompleteDelivery(ctx, orderID); err != nil {
+	if err = h.usecase.CompleteDelivery(ctx, orderID, courierID); err != nil {
 		return nil, response.ParseError(err)
 	}
 
@@ -71,12 +123,17 @@ func (h *OrderServiceHandler) GetOrdersByCustomer(ctx context.Context, req *orde
 		return nil, err
 	}
 
-	orders, err := h.usecase.GetAllByCustomer(ctx, customerID)
+	query, err := request.ToListQuery(req.Limit, req.Cursor, req.Statuses, req.CreatedFrom, req.CreatedTo, req.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.usecase.GetAllByCustomer(ctx, customerID, query)
 	if err != nil {
 		return nil, response.ParseError(err)
 	}
 
-	return response.ToGetOrdersByCustomerResponse(orders)
+	return response.ToGetOrdersByCustomerResponse(page)
 }
 
 func (h *OrderServiceHandler) GetCurrentOrdersByCourier(ctx context.Context, req *orderv1.GetCurrentOrdersByCourierRequest) (*orderv1.GetCurrentOrdersByCourierResponse, error) {
@@ -93,4 +150,37 @@ func (h *OrderServiceHandler) GetCurrentOrdersByCourier(ctx context.Context, req
 	return response.ToGetCurrentOrdersByCourierResponse(orders)
 }
 
+func (h *OrderServiceHandler) GetCourierOrderHistory(ctx context.Context, req *orderv1.GetCourierOrderHistoryRequest) (*orderv1.GetCourierOrderHistoryResponse, error) {
+	courierID, err := request.ParseUUID(req.CourierId)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := request.ToListQuery(req.Limit, req.Cursor, req.Statuses, req.CreatedFrom, req.CreatedTo, req.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := h.usecase.GetHistoryByCourier(ctx, courierID, query)
+	if err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToGetCourierOrderHistoryResponse(history)
+}
+
+func (h *OrderServiceHandler) GetSagaState(ctx context.Context, req *orderv1.GetSagaStateRequest) (*orderv1.GetSagaStateResponse, error) {
+	orderID, err := request.ParseUUID(req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	sagas, err := h.sagaUsecase.GetAllByOrder(ctx, orderID)
+	if err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToGetSagaStateResponse(sagas), nil
+}
+
 var _ orderv1.OrderServiceServer = (*OrderServiceHandler)(nil)
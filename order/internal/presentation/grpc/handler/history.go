@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+	orderv1 "order/internal/presentation/grpc"
+	"order/internal/presentation/grpc/request"
+	"order/internal/presentation/grpc/response"
+)
+
+func (h *OrderServiceHandler) GetOrderHistory(
+	ctx context.Context,
+	req *orderv1.GetOrderHistoryRequest,
+) (*orderv1.GetOrderHistoryResponse, error) {
+	orderID, err := request.ParseUUID(req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	requester, err := request.ToActor(req.Requester)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := h.usecase.GetStatusHistory(ctx, orderID, requester)
+	if err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToGetOrderHistoryResponse(history), nil
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	orderv1 "order/internal/presentation/grpc"
+	"order/internal/presentation/grpc/request"
+	"order/internal/presentation/grpc/response"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *OrderServiceHandler) CreatePromotion(
+	ctx context.Context,
+	req *orderv1.CreatePromotionRequest,
+) (*orderv1.CreatePromotionResponse, error) {
+	data, err := request.ToCreatePromotionDto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	promotionID, err := h.promotionUsecase.Create(ctx, data)
+	if err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToCreatePromotionResponse(promotionID), nil
+}
+
+func (h *OrderServiceHandler) GetPromotions(ctx context.Context, req *orderv1.GetPromotionsRequest) (*orderv1.GetPromotionsResponse, error) {
+	promotions, err := h.promotionUsecase.GetAll(ctx, req.ActiveOnly)
+	if err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToGetPromotionsResponse(promotions)
+}
+
+func (h *OrderServiceHandler) DeactivatePromotion(ctx context.Context, req *orderv1.DeactivatePromotionRequest) (*emptypb.Empty, error) {
+	promotionID, err := request.ParseUUID(req.PromotionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.promotionUsecase.Deactivate(ctx, promotionID); err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToEmptyResponse(), nil
+}
@@ -0,0 +1,19 @@
+package handler
+
+import (
+	"context"
+	orderv1 "order/internal/presentation/grpc"
+	"order/internal/presentation/grpc/response"
+)
+
+func (h *OrderServiceHandler) GetAvailableSlots(
+	ctx context.Context,
+	_ *orderv1.GetAvailableSlotsRequest,
+) (*orderv1.GetAvailableSlotsResponse, error) {
+	slots, err := h.slotUsecase.GetAvailable(ctx)
+	if err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToGetAvailableSlotsResponse(slots)
+}
@@ -0,0 +1,27 @@
+package request
+
+import (
+	analyticsDomain "order/internal/domain/analytics"
+	orderv1 "order/internal/presentation/grpc"
+	"order/internal/presentation/grpc/response"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToPeriod(createdFrom, createdTo *timestamppb.Timestamp) analyticsDomain.Period {
+	return analyticsDomain.Period{
+		From: ParseTimestamp(createdFrom),
+		To:   ParseTimestamp(createdTo),
+	}
+}
+
+func ParseGranularity(granularity orderv1.RevenueGranularity) (analyticsDomain.Granularity, error) {
+	switch granularity {
+	case orderv1.RevenueGranularity_DAY:
+		return analyticsDomain.Day, nil
+	case orderv1.RevenueGranularity_WEEK:
+		return analyticsDomain.Week, nil
+	default:
+		return "", response.ErrInvalidGranularity
+	}
+}
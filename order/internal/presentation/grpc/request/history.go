@@ -0,0 +1,31 @@
+package request
+
+import (
+	orderDomain "order/internal/domain/order"
+	orderv1 "order/internal/presentation/grpc"
+	"order/internal/presentation/grpc/response"
+)
+
+// ToActor maps the requester of a call. Customers and couriers must carry an ID.
+func ToActor(actor *orderv1.Actor) (orderDomain.Actor, error) {
+	switch actor.GetType() {
+	case orderv1.ActorType_SYSTEM:
+		return orderDomain.SystemActor(), nil
+	case orderv1.ActorType_ADMIN:
+		return orderDomain.AdminActor(), nil
+	case orderv1.ActorType_CUSTOMER, orderv1.ActorType_COURIER:
+		if actor.Id == nil {
+			return orderDomain.Actor{}, response.ErrInvalidActor
+		}
+		id, err := ParseUUID(*actor.Id)
+		if err != nil {
+			return orderDomain.Actor{}, err
+		}
+		if actor.GetType() == orderv1.ActorType_CUSTOMER {
+			return orderDomain.CustomerActor(id), nil
+		}
+		return orderDomain.CourierActor(id), nil
+	default:
+		return orderDomain.Actor{}, response.ErrInvalidActor
+	}
+}
@@ -4,6 +4,8 @@ import (
 	orderUsecase "order/internal/application/order/usecase"
 	orderDomain "order/internal/domain/order"
 	orderv1 "order/internal/presentation/grpc"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ToItem(item *orderv1.OrderItem) (orderDomain.Item, error) {
@@ -14,8 +16,13 @@ func ToItem(item *orderv1.OrderItem) (orderDomain.Item, error) {
 		return data, err
 	}
 
+	price, err := ParseMoney(item.Price)
+	if err != nil {
+		return data, err
+	}
+
 	data.ProductID = productID
-	data.Price = ParseDecimal(item.Price)
+	data.Price = price
 	data.Count = int(item.Count)
 
 	return data, nil
@@ -33,6 +40,33 @@ func ToItems(items []*orderv1.OrderItem) ([]orderDomain.Item, error) {
 	return orderItems, nil
 }
 
+// ToAddress maps a requested address; a missing one maps to an empty address,
+// which the domain rejects.
+func ToAddress(address *orderv1.Address) orderDomain.Address {
+	if address == nil {
+		return orderDomain.Address{}
+	}
+
+	var location *orderDomain.Location
+	if address.Location != nil {
+		location = &orderDomain.Location{
+			Latitude:  address.Location.Latitude,
+			Longitude: address.Location.Longitude,
+		}
+	}
+
+	return orderDomain.Address{
+		Country:      address.Country,
+		City:         address.City,
+		Street:       address.Street,
+		House:        address.House,
+		Apartment:    address.Apartment,
+		PostalCode:   address.PostalCode,
+		Location:     location,
+		Instructions: address.Instructions,
+	}
+}
+
 func ToCreateDto(req *orderv1.CreateOrderRequest) (orderUsecase.CreateDto, error) {
 	var data orderUsecase.CreateDto
 
@@ -46,9 +80,87 @@ func ToCreateDto(req *orderv1.CreateOrderRequest) (orderUsecase.CreateDto, error
 		return data, err
 	}
 
+	fulfillment, err := ParseFulfillmentPolicy(req.FulfillmentPolicy)
+	if err != nil {
+		return data, err
+	}
+
 	data.CustomerID = customerID
-	data.Address = req.Address
+	data.Address = ToAddress(req.Address)
 	data.Items = items
+	data.PromoCode = req.PromoCode
+	data.Slot = ToDeliverySlot(req.DeliverySlot)
+	data.Fulfillment = fulfillment
 
 	return data, nil
 }
+
+// ToDeliverySlot maps a requested slot; a slot missing either bound maps to an
+// empty window, which the domain rejects.
+func ToDeliverySlot(slot *orderv1.DeliverySlot) *orderDomain.DeliverySlot {
+	if slot == nil {
+		return nil
+	}
+
+	var data orderDomain.DeliverySlot
+	if slot.Start != nil && slot.End != nil {
+		data.Start = slot.Start.AsTime()
+		data.End = slot.End.AsTime()
+	}
+	return &data
+}
+
+func ToListQuery(
+	limit int32,
+	cursor string,
+	statuses []orderv1.OrderStatus,
+	createdFrom *timestamppb.Timestamp,
+	createdTo *timestamppb.Timestamp,
+	sort orderv1.OrderSort,
+) (orderDomain.ListQuery, error) {
+	var query orderDomain.ListQuery
+
+	for _, s := range statuses {
+		st, err := ParseStatus(s)
+		if err != nil {
+			return query, err
+		}
+		query.Statuses = append(query.Statuses, st)
+	}
+
+	sortOrder, err := ParseSort(sort)
+	if err != nil {
+		return query, err
+	}
+
+	query.Limit = int(limit)
+	query.Cursor = cursor
+	query.CreatedFrom = ParseTimestamp(createdFrom)
+	query.CreatedTo = ParseTimestamp(createdTo)
+	query.Sort = sortOrder
+
+	return query, nil
+}
+
+func ToSearchFilter(req *orderv1.SearchOrdersRequest) (orderDomain.SearchFilter, error) {
+	var filter orderDomain.SearchFilter
+
+	customerID, err := ParseOptionalUUID(req.CustomerId)
+	if err != nil {
+		return filter, err
+	}
+	courierID, err := ParseOptionalUUID(req.CourierId)
+	if err != nil {
+		return filter, err
+	}
+	productID, err := ParseOptionalUUID(req.ProductId)
+	if err != nil {
+		return filter, err
+	}
+
+	filter.CustomerID = customerID
+	filter.CourierID = courierID
+	filter.ProductID = productID
+
+	return filter, nil
+}
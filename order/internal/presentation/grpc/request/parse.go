@@ -1,10 +1,14 @@
 package request
 
 import (
+	orderDomain "order/internal/domain/order"
+	sagaDomain "order/internal/domain/saga"
+	orderv1 "order/internal/presentation/grpc"
 	"order/internal/presentation/grpc/response"
+	"time"
 
 	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ParseUUID(key string) (uuid.UUID, error) {
@@ -15,6 +19,91 @@ func ParseUUID(key string) (uuid.UUID, error) {
 	}
 }
 
-func ParseDecimal(key float64) decimal.Decimal {
-	return decimal.NewFromFloat(key)
+func ParseMoney(money *orderv1.Money) (orderDomain.Money, error) {
+	if money == nil {
+		return orderDomain.Money{}, response.ErrInvalidMoney
+	}
+	m, err := orderDomain.MoneyFromUnits(money.Units, money.Nanos, money.CurrencyCode)
+	if err != nil {
+		return orderDomain.Money{}, response.ErrInvalidMoney
+	}
+	return m, nil
+}
+
+func ParseFulfillmentPolicy(policy orderv1.FulfillmentPolicy) (orderDomain.FulfillmentPolicy, error) {
+	switch policy {
+	case orderv1.FulfillmentPolicy_ALL_OR_NOTHING:
+		return orderDomain.AllOrNothing, nil
+	case orderv1.FulfillmentPolicy_ALLOW_PARTIAL:
+		return orderDomain.AllowPartial, nil
+	default:
+		return "", response.ErrInvalidFulfillmentPolicy
+	}
+}
+
+func ParseStatus(status orderv1.OrderStatus) (orderDomain.Status, error) {
+	switch status {
+	case orderv1.OrderStatus_CREATED:
+		return orderDomain.Created, nil
+	case orderv1.OrderStatus_CANCELED_COURIER_NOT_FOUND:
+		return orderDomain.CanceledCourierNotFound, nil
+	case orderv1.OrderStatus_CANCELED_OUT_OF_STOCK:
+		return orderDomain.CanceledOutOfStock, nil
+	case orderv1.OrderStatus_DELIVERING:
+		return orderDomain.Delivering, nil
+	case orderv1.OrderStatus_DELIVERED:
+		return orderDomain.Delivered, nil
+	case orderv1.OrderStatus_CUSTOMER_CANCELED:
+		return orderDomain.CustomerCanceled, nil
+	case orderv1.OrderStatus_CANCELED_TIMEOUT:
+		return orderDomain.CanceledTimeout, nil
+	case orderv1.OrderStatus_CANCELING:
+		return orderDomain.Canceling, nil
+	case orderv1.OrderStatus_CANCELED_BY_ADMIN:
+		return orderDomain.CanceledByAdmin, nil
+	default:
+		return "", response.ErrInvalidStatus
+	}
+}
+
+func ParseSagaType(sagaType orderv1.SagaType) (sagaDomain.Type, error) {
+	switch sagaType {
+	case orderv1.SagaType_CREATE_ORDER:
+		return sagaDomain.CreateOrder, nil
+	case orderv1.SagaType_CANCEL_ORDER:
+		return sagaDomain.CancelOrder, nil
+	default:
+		return "", response.ErrInvalidSagaType
+	}
+}
+
+// ParseOptionalUUID parses an optional ID; an unset one maps to nil.
+func ParseOptionalUUID(key *string) (*uuid.UUID, error) {
+	if key == nil {
+		return nil, nil
+	}
+	id, err := ParseUUID(*key)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func ParseSort(sort orderv1.OrderSort) (orderDomain.SortOrder, error) {
+	switch sort {
+	case orderv1.OrderSort_NEWEST_FIRST:
+		return orderDomain.NewestFirst, nil
+	case orderv1.OrderSort_OLDEST_FIRST:
+		return orderDomain.OldestFirst, nil
+	default:
+		return "", response.ErrInvalidSort
+	}
+}
+
+func ParseTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
 }
@@ -0,0 +1,80 @@
+package request
+
+import (
+	promotionUsecase "order/internal/application/promotion/usecase"
+	promotionDomain "order/internal/domain/promotion"
+	orderv1 "order/internal/presentation/grpc"
+	"order/internal/presentation/grpc/response"
+
+	"github.com/google/uuid"
+)
+
+func ParsePromotionKind(kind orderv1.PromotionKind) (promotionDomain.Kind, error) {
+	switch kind {
+	case orderv1.PromotionKind_PERCENTAGE:
+		return promotionDomain.Percentage, nil
+	case orderv1.PromotionKind_FIXED_AMOUNT:
+		return promotionDomain.FixedAmount, nil
+	default:
+		return "", response.ErrInvalidPromotionKind
+	}
+}
+
+func ToPromotionRules(rules *orderv1.PromotionRules) (promotionDomain.Rules, error) {
+	var data promotionDomain.Rules
+	if rules == nil {
+		return data, response.ErrInvalidPromotionRules
+	}
+
+	kind, err := ParsePromotionKind(rules.Kind)
+	if err != nil {
+		return data, err
+	}
+
+	if kind == promotionDomain.FixedAmount {
+		data.Amount, err = ParseMoney(rules.Amount)
+		if err != nil {
+			return data, err
+		}
+	}
+
+	if rules.MinOrderValue != nil {
+		minOrderValue, err := ParseMoney(rules.MinOrderValue)
+		if err != nil {
+			return data, err
+		}
+		data.MinOrderValue = &minOrderValue
+	}
+
+	productIDs := make([]uuid.UUID, 0, len(rules.ProductIds))
+	for _, id := range rules.ProductIds {
+		productID, err := ParseUUID(id)
+		if err != nil {
+			return data, err
+		}
+		productIDs = append(productIDs, productID)
+	}
+
+	data.Kind = kind
+	data.Percent = int(rules.Percent)
+	data.PerCustomerLimit = int(rules.PerCustomerLimit)
+	data.ValidFrom = ParseTimestamp(rules.ValidFrom)
+	data.ValidTo = ParseTimestamp(rules.ValidTo)
+	data.ProductIDs = productIDs
+
+	return data, nil
+}
+
+func ToCreatePromotionDto(req *orderv1.CreatePromotionRequest) (promotionUsecase.CreateDto, error) {
+	var data promotionUsecase.CreateDto
+
+	rules, err := ToPromotionRules(req.Rules)
+	if err != nil {
+		return data, err
+	}
+
+	data.Code = req.Code
+	data.Rules = rules
+
+	return data, nil
+}
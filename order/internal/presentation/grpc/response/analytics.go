@@ -0,0 +1,97 @@
+package response
+
+import (
+	analyticsDomain "order/internal/domain/analytics"
+	orderDomain "order/internal/domain/order"
+	orderv1 "order/internal/presentation/grpc"
+	"slices"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MapCancelReason(reason orderDomain.CancelReason) orderv1.CancelReason {
+	switch reason {
+	case orderDomain.CancelReasonCustomer:
+		return orderv1.CancelReason_CANCEL_REASON_CUSTOMER
+	case orderDomain.CancelReasonOutOfStock:
+		return orderv1.CancelReason_CANCEL_REASON_OUT_OF_STOCK
+	case orderDomain.CancelReasonCourierNotFound:
+		return orderv1.CancelReason_CANCEL_REASON_COURIER_NOT_FOUND
+	case orderDomain.CancelReasonTimeout:
+		return orderv1.CancelReason_CANCEL_REASON_TIMEOUT
+	case orderDomain.CancelReasonAdmin:
+		return orderv1.CancelReason_CANCEL_REASON_ADMIN
+	default:
+		return orderv1.CancelReason_CANCEL_REASON_CUSTOMER
+	}
+}
+
+func ToGetStatusFunnelResponse(funnel *analyticsDomain.StatusFunnel) (*orderv1.GetStatusFunnelResponse, error) {
+	total, err := safeIntToInt32(funnel.Total)
+	if err != nil {
+		return nil, err
+	}
+	current, err := ToStatusCountsResponse(funnel.Current)
+	if err != nil {
+		return nil, err
+	}
+	reached, err := ToStatusCountsResponse(funnel.Reached)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderv1.GetStatusFunnelResponse{
+		Total:   total,
+		Current: current,
+		Reached: reached,
+	}, nil
+}
+
+func ToGetRevenueResponse(buckets []analyticsDomain.RevenueBucket) (*orderv1.GetRevenueResponse, error) {
+	resp := make([]*orderv1.RevenueBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		orders, err := safeIntToInt32(bucket.Orders)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, &orderv1.RevenueBucket{
+			Start:   timestamppb.New(bucket.Start),
+			Revenue: ToMoneyResponse(bucket.Revenue),
+			Orders:  orders,
+		})
+	}
+	return &orderv1.GetRevenueResponse{Buckets: resp}, nil
+}
+
+func ToGetDeliveryTimeResponse(deliveryTime *analyticsDomain.DeliveryTime) (*orderv1.GetDeliveryTimeResponse, error) {
+	orders, err := safeIntToInt32(deliveryTime.Orders)
+	if err != nil {
+		return nil, err
+	}
+	return &orderv1.GetDeliveryTimeResponse{
+		Orders:  orders,
+		Average: durationpb.New(deliveryTime.Average),
+	}, nil
+}
+
+func ToGetCancellationReasonsResponse(reasons analyticsDomain.CancellationReasons) (*orderv1.GetCancellationReasonsResponse, error) {
+	keys := make([]orderDomain.CancelReason, 0, len(reasons))
+	for reason := range reasons {
+		keys = append(keys, reason)
+	}
+	slices.Sort(keys)
+
+	resp := make([]*orderv1.CancelReasonCount, 0, len(reasons))
+	for _, reason := range keys {
+		count, err := safeIntToInt32(reasons[reason])
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, &orderv1.CancelReasonCount{
+			Reason: MapCancelReason(reason),
+			Count:  count,
+		})
+	}
+	return &orderv1.GetCancellationReasonsResponse{Reasons: resp}, nil
+}
@@ -2,8 +2,15 @@ package response
 
 import (
 	"errors"
+	analyticsDomain "order/internal/domain/analytics"
 	orderDomain "order/internal/domain/order"
+	promotionDomain "order/internal/domain/promotion"
+	sagaDomain "order/internal/domain/saga"
+	slotDomain "order/internal/domain/slot"
+	"order/internal/infrastructure/catalog"
 	orderRepository "order/internal/infrastructure/repository/order"
+	promotionRepository "order/internal/infrastructure/repository/promotion"
+	sagaRepository "order/internal/infrastructure/repository/saga"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,12 +24,51 @@ var orderErrorMap = []struct {
 	{orderDomain.ErrInvalidItems, codes.InvalidArgument},
 	{orderDomain.ErrInvalidAddress, codes.InvalidArgument},
 	{orderDomain.ErrUnsupportedStatusTransition, codes.InvalidArgument},
+	{orderDomain.ErrInvalidListQuery, codes.InvalidArgument},
+	{orderDomain.ErrInvalidCancelReason, codes.InvalidArgument},
+	{orderDomain.ErrInvalidReason, codes.InvalidArgument},
+	{orderDomain.ErrInvalidCourier, codes.InvalidArgument},
+	{orderDomain.ErrUnknownProduct, codes.InvalidArgument},
+	{orderDomain.ErrInvalidMoney, codes.InvalidArgument},
+	{orderDomain.ErrInvalidCurrency, codes.InvalidArgument},
+	{orderDomain.ErrCurrencyMismatch, codes.InvalidArgument},
+	{orderDomain.ErrInvalidDiscount, codes.InvalidArgument},
+	{orderDomain.ErrInvalidDeliverySlot, codes.InvalidArgument},
+	{orderDomain.ErrInvalidFulfillmentPolicy, codes.InvalidArgument},
+	{promotionDomain.ErrInvalidCode, codes.InvalidArgument},
+	{promotionDomain.ErrInvalidRules, codes.InvalidArgument},
+	{orderRepository.ErrInvalidCursor, codes.InvalidArgument},
+	{sagaDomain.ErrUnsupportedStepTransition, codes.InvalidArgument},
+	{sagaDomain.ErrUnsupportedType, codes.InvalidArgument},
+	{analyticsDomain.ErrInvalidPeriod, codes.InvalidArgument},
+	{analyticsDomain.ErrInvalidGranularity, codes.InvalidArgument},
+
+	// FailedPrecondition
+	{orderDomain.ErrCancellationNotAllowed, codes.FailedPrecondition},
+	{orderDomain.ErrPriceMismatch, codes.FailedPrecondition},
+	{promotionDomain.ErrPromotionInactive, codes.FailedPrecondition},
+	{promotionDomain.ErrMinOrderValueNotMet, codes.FailedPrecondition},
+	{promotionDomain.ErrPromotionNotApplicable, codes.FailedPrecondition},
+	{promotionDomain.ErrUsageLimitReached, codes.FailedPrecondition},
+	{slotDomain.ErrSlotUnavailable, codes.FailedPrecondition},
+	{slotDomain.ErrSlotFull, codes.FailedPrecondition},
+	{sagaDomain.ErrStepNotRetryable, codes.FailedPrecondition},
+
+	// PermissionDenied
+	{orderDomain.ErrPermissionDenied, codes.PermissionDenied},
 
 	// NotFound
 	{orderRepository.ErrOrderNotFound, codes.NotFound},
+	{sagaRepository.ErrSagaNotFound, codes.NotFound},
+	{promotionRepository.ErrPromotionNotFound, codes.NotFound},
 
 	// AlreadyExists
 	{orderRepository.ErrOrderAlreadyExists, codes.AlreadyExists},
+	{sagaRepository.ErrSagaAlreadyExists, codes.AlreadyExists},
+	{promotionRepository.ErrPromotionAlreadyExists, codes.AlreadyExists},
+
+	// Unavailable
+	{catalog.ErrCatalogUnavailable, codes.Unavailable},
 }
 
 func ParseError(err error) error {
@@ -36,5 +82,18 @@ func ParseError(err error) error {
 
 var (
 	ErrInvalidID     = status.Error(codes.InvalidArgument, "invalid id")
-	ErrInternalError = status.Error(codes.Internal, "internal error")
+	ErrInvalidStatus = status.Error(codes.InvalidArgument, "invalid order status")
+	ErrInvalidSort   = status.Error(codes.InvalidArgument, "invalid order sort")
+	ErrInvalidMoney  = status.Error(codes.InvalidArgument, "invalid money")
+	ErrInvalidActor  = status.Error(codes.InvalidArgument, "invalid requester")
+
+	ErrInvalidFulfillmentPolicy = status.Error(codes.InvalidArgument, "invalid fulfillment policy")
+
+	ErrInvalidSagaType = status.Error(codes.InvalidArgument, "invalid saga type")
+
+	ErrInvalidGranularity = status.Error(codes.InvalidArgument, "invalid revenue granularity")
+
+	ErrInvalidPromotionKind  = status.Error(codes.InvalidArgument, "invalid promotion kind")
+	ErrInvalidPromotionRules = status.Error(codes.InvalidArgument, "invalid promotion rules")
+	ErrInternalError         = status.Error(codes.Internal, "internal error")
 )
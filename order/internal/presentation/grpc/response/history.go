@@ -0,0 +1,65 @@
+package response
+
+import (
+	orderDomain "order/internal/domain/order"
+	orderv1 "order/internal/presentation/grpc"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MapActorType(actorType orderDomain.ActorType) orderv1.ActorType {
+	switch actorType {
+	case orderDomain.ActorCustomer:
+		return orderv1.ActorType_CUSTOMER
+	case orderDomain.ActorCourier:
+		return orderv1.ActorType_COURIER
+	case orderDomain.ActorAdmin:
+		return orderv1.ActorType_ADMIN
+	default:
+		return orderv1.ActorType_SYSTEM
+	}
+}
+
+func ToActorResponse(actor orderDomain.Actor) *orderv1.Actor {
+	return &orderv1.Actor{
+		Type: MapActorType(actor.Type),
+		Id:   toOptionalIDResponse(actor.ID),
+	}
+}
+
+func ToStatusChangeResponse(change orderDomain.StatusChange) *orderv1.StatusChange {
+	var from *orderv1.OrderStatus
+	if change.From != "" {
+		status := MapStatus(change.From)
+		from = &status
+	}
+
+	return &orderv1.StatusChange{
+		From:      from,
+		To:        MapStatus(change.To),
+		Occurred:  timestamppb.New(change.Occurred),
+		Actor:     ToActorResponse(change.Actor),
+		Reason:    change.Reason,
+		MessageId: toOptionalIDResponse(change.MessageID),
+	}
+}
+
+func ToGetOrderHistoryResponse(history []orderDomain.StatusChange) *orderv1.GetOrderHistoryResponse {
+	resp := make([]*orderv1.StatusChange, 0, len(history))
+	for _, change := range history {
+		resp = append(resp, ToStatusChangeResponse(change))
+	}
+
+	return &orderv1.GetOrderHistoryResponse{
+		History: resp,
+	}
+}
+
+func toOptionalIDResponse(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
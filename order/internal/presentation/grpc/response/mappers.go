@@ -3,8 +3,10 @@ package response
 import (
 	"fmt"
 	"math"
+	orderUsecase "order/internal/application/order/usecase"
 	orderDomain "order/internal/domain/order"
 	orderv1 "order/internal/presentation/grpc"
+	"slices"
 
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -32,11 +34,26 @@ func MapStatus(status orderDomain.Status) orderv1.OrderStatus {
 		return orderv1.OrderStatus_DELIVERED
 	case orderDomain.CustomerCanceled:
 		return orderv1.OrderStatus_CUSTOMER_CANCELED
+	case orderDomain.CanceledTimeout:
+		return orderv1.OrderStatus_CANCELED_TIMEOUT
+	case orderDomain.Canceling:
+		return orderv1.OrderStatus_CANCELING
+	case orderDomain.CanceledByAdmin:
+		return orderv1.OrderStatus_CANCELED_BY_ADMIN
 	default:
 		return orderv1.OrderStatus_CREATED
 	}
 }
 
+func MapFulfillmentPolicy(policy orderDomain.FulfillmentPolicy) orderv1.FulfillmentPolicy {
+	switch policy {
+	case orderDomain.AllowPartial:
+		return orderv1.FulfillmentPolicy_ALLOW_PARTIAL
+	default:
+		return orderv1.FulfillmentPolicy_ALL_OR_NOTHING
+	}
+}
+
 func ToCreateOrderResponse(orderID uuid.UUID) *orderv1.CreateOrderResponse {
 	return &orderv1.CreateOrderResponse{
 		OrderId: orderID.String(),
@@ -47,16 +64,32 @@ func ToEmptyResponse() *emptypb.Empty {
 	return &emptypb.Empty{}
 }
 
+func ToMoneyResponse(money orderDomain.Money) *orderv1.Money {
+	return &orderv1.Money{
+		CurrencyCode: money.Currency,
+		Units:        money.Units(),
+		Nanos:        money.Nanos(),
+	}
+}
+
 func ToOrderItemResponse(item orderDomain.Item) (*orderv1.OrderItem, error) {
 	count32, err := safeIntToInt32(item.Count)
 	if err != nil {
 		return nil, err
 	}
 
+	requested32, err := safeIntToInt32(item.Requested)
+	if err != nil {
+		return nil, err
+	}
+
 	return &orderv1.OrderItem{
-		ProductId: item.ProductID.String(),
-		Price:     item.Price.InexactFloat64(),
-		Count:     count32,
+		ProductId:      item.ProductID.String(),
+		Price:          ToMoneyResponse(item.Price),
+		Count:          count32,
+		Name:           item.Name,
+		LineTotal:      ToMoneyResponse(item.Total()),
+		RequestedCount: requested32,
 	}, nil
 }
 
@@ -84,6 +117,11 @@ func ToOrderResponse(order *orderDomain.Order) (*orderv1.Order, error) {
 		courierID = &courierId
 	}
 
+	var assigned *timestamppb.Timestamp
+	if order.Delivery.Assigned != nil {
+		assigned = timestamppb.New(*order.Delivery.Assigned)
+	}
+
 	var arrived *timestamppb.Timestamp
 	if order.Delivery.Arrived != nil {
 		arrived = timestamppb.New(*order.Delivery.Arrived)
@@ -97,13 +135,72 @@ func ToOrderResponse(order *orderDomain.Order) (*orderv1.Order, error) {
 		Items:      items,
 		Delivery: &orderv1.Delivery{
 			CourierId: courierID,
-			Address:   order.Delivery.Address,
+			Address:   ToAddressResponse(order.Delivery.Address),
+			Slot:      ToDeliverySlotResponse(order.Delivery.Slot),
+			Assigned:  assigned,
 			Arrived:   arrived,
 		},
-		Created: timestamppb.New(order.Created),
+		Created:           timestamppb.New(order.Created),
+		CancelReason:      order.CancelReason,
+		Total:             ToMoneyResponse(order.Total()),
+		Discount:          ToDiscountResponse(order.Discount),
+		Subtotal:          ToMoneyResponse(order.Subtotal()),
+		FulfillmentPolicy: MapFulfillmentPolicy(order.Fulfillment),
 	}, nil
 }
 
+func ToGetOrderResponse(order *orderDomain.Order) (*orderv1.GetOrderResponse, error) {
+	mapped, err := ToOrderResponse(order)
+	if err != nil {
+		return nil, err
+	}
+	return &orderv1.GetOrderResponse{Order: mapped}, nil
+}
+
+func ToDeliverySlotResponse(slot *orderDomain.DeliverySlot) *orderv1.DeliverySlot {
+	if slot == nil {
+		return nil
+	}
+
+	return &orderv1.DeliverySlot{
+		Start: timestamppb.New(slot.Start),
+		End:   timestamppb.New(slot.End),
+	}
+}
+
+func ToAddressResponse(address orderDomain.Address) *orderv1.Address {
+	var location *orderv1.Location
+	if address.Location != nil {
+		location = &orderv1.Location{
+			Latitude:  address.Location.Latitude,
+			Longitude: address.Location.Longitude,
+		}
+	}
+
+	return &orderv1.Address{
+		Country:      address.Country,
+		City:         address.City,
+		Street:       address.Street,
+		House:        address.House,
+		Apartment:    address.Apartment,
+		PostalCode:   address.PostalCode,
+		Location:     location,
+		Instructions: address.Instructions,
+		Legacy:       address.Legacy,
+	}
+}
+
+func ToDiscountResponse(discount *orderDomain.Discount) *orderv1.Discount {
+	if discount == nil {
+		return nil
+	}
+
+	return &orderv1.Discount{
+		PromoCode: discount.Code,
+		Amount:    ToMoneyResponse(discount.Amount),
+	}
+}
+
 func ToOrdersResponse(orders []*orderDomain.Order) ([]*orderv1.Order, error) {
 	resp := make([]*orderv1.Order, 0, len(orders))
 	for _, order := range orders {
@@ -116,14 +213,27 @@ func ToOrdersResponse(orders []*orderDomain.Order) ([]*orderv1.Order, error) {
 	return resp, nil
 }
 
-func ToGetOrdersByCustomerResponse(orders []*orderDomain.Order) (*orderv1.GetOrdersByCustomerResponse, error) {
-	mappedOrders, err := ToOrdersResponse(orders)
+func ToGetOrdersByCustomerResponse(page *orderDomain.Page) (*orderv1.GetOrdersByCustomerResponse, error) {
+	mappedOrders, err := ToOrdersResponse(page.Orders)
 	if err != nil {
 		return nil, err
 	}
 
 	return &orderv1.GetOrdersByCustomerResponse{
-		Orders: mappedOrders,
+		Orders:     mappedOrders,
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+func ToSearchOrdersResponse(page *orderDomain.Page) (*orderv1.SearchOrdersResponse, error) {
+	mappedOrders, err := ToOrdersResponse(page.Orders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderv1.SearchOrdersResponse{
+		Orders:     mappedOrders,
+		NextCursor: page.NextCursor,
 	}, nil
 }
 
@@ -137,3 +247,42 @@ func ToGetCurrentOrdersByCourierResponse(orders []*orderDomain.Order) (*orderv1.
 		Orders: mappedOrders,
 	}, nil
 }
+
+func ToStatusCountsResponse(counts orderDomain.StatusCounts) ([]*orderv1.OrderStatusCount, error) {
+	statuses := make([]orderDomain.Status, 0, len(counts))
+	for status := range counts {
+		statuses = append(statuses, status)
+	}
+	slices.Sort(statuses)
+
+	resp := make([]*orderv1.OrderStatusCount, 0, len(counts))
+	for _, status := range statuses {
+		count, err := safeIntToInt32(counts[status])
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, &orderv1.OrderStatusCount{
+			Status: MapStatus(status),
+			Count:  count,
+		})
+	}
+	return resp, nil
+}
+
+func ToGetCourierOrderHistoryResponse(history *orderUsecase.CourierHistoryDto) (*orderv1.GetCourierOrderHistoryResponse, error) {
+	mappedOrders, err := ToOrdersResponse(history.Page.Orders)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := ToStatusCountsResponse(history.Counts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderv1.GetCourierOrderHistoryResponse{
+		Orders:     mappedOrders,
+		NextCursor: history.Page.NextCursor,
+		Counts:     counts,
+	}, nil
+}
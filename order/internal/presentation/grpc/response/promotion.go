@@ -0,0 +1,88 @@
+package response
+
+import (
+	promotionDomain "order/internal/domain/promotion"
+	orderv1 "order/internal/presentation/grpc"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MapPromotionKind(kind promotionDomain.Kind) orderv1.PromotionKind {
+	switch kind {
+	case promotionDomain.Percentage:
+		return orderv1.PromotionKind_PERCENTAGE
+	case promotionDomain.FixedAmount:
+		return orderv1.PromotionKind_FIXED_AMOUNT
+	default:
+		return orderv1.PromotionKind_PERCENTAGE
+	}
+}
+
+func ToCreatePromotionResponse(promotionID uuid.UUID) *orderv1.CreatePromotionResponse {
+	return &orderv1.CreatePromotionResponse{
+		PromotionId: promotionID.String(),
+	}
+}
+
+func ToPromotionRulesResponse(rules promotionDomain.Rules) (*orderv1.PromotionRules, error) {
+	percent, err := safeIntToInt32(rules.Percent)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := safeIntToInt32(rules.PerCustomerLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &orderv1.PromotionRules{
+		Kind:             MapPromotionKind(rules.Kind),
+		Percent:          percent,
+		PerCustomerLimit: limit,
+		ProductIds:       make([]string, 0, len(rules.ProductIDs)),
+	}
+	if rules.Kind == promotionDomain.FixedAmount {
+		resp.Amount = ToMoneyResponse(rules.Amount)
+	}
+	if rules.MinOrderValue != nil {
+		resp.MinOrderValue = ToMoneyResponse(*rules.MinOrderValue)
+	}
+	if rules.ValidFrom != nil {
+		resp.ValidFrom = timestamppb.New(*rules.ValidFrom)
+	}
+	if rules.ValidTo != nil {
+		resp.ValidTo = timestamppb.New(*rules.ValidTo)
+	}
+	for _, productID := range rules.ProductIDs {
+		resp.ProductIds = append(resp.ProductIds, productID.String())
+	}
+
+	return resp, nil
+}
+
+func ToPromotionResponse(promotion *promotionDomain.Promotion) (*orderv1.Promotion, error) {
+	rules, err := ToPromotionRulesResponse(promotion.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderv1.Promotion{
+		PromotionId: promotion.ID.String(),
+		Code:        promotion.Code,
+		Rules:       rules,
+		Active:      promotion.Active,
+		Created:     timestamppb.New(promotion.Created),
+	}, nil
+}
+
+func ToGetPromotionsResponse(promotions []*promotionDomain.Promotion) (*orderv1.GetPromotionsResponse, error) {
+	resp := make([]*orderv1.Promotion, 0, len(promotions))
+	for _, promotion := range promotions {
+		mapped, err := ToPromotionResponse(promotion)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, mapped)
+	}
+	return &orderv1.GetPromotionsResponse{Promotions: resp}, nil
+}
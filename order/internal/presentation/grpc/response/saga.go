@@ -0,0 +1,109 @@
+package response
+
+import (
+	sagaDomain "order/internal/domain/saga"
+	orderv1 "order/internal/presentation/grpc"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MapSagaType(sagaType sagaDomain.Type) orderv1.SagaType {
+	switch sagaType {
+	case sagaDomain.CreateOrder:
+		return orderv1.SagaType_CREATE_ORDER
+	case sagaDomain.CancelOrder:
+		return orderv1.SagaType_CANCEL_ORDER
+	default:
+		return orderv1.SagaType_CREATE_ORDER
+	}
+}
+
+func MapSagaStep(step sagaDomain.Step) orderv1.SagaStep {
+	switch step {
+	case sagaDomain.ReservingItems:
+		return orderv1.SagaStep_RESERVING_ITEMS
+	case sagaDomain.AssigningCourier:
+		return orderv1.SagaStep_ASSIGNING_COURIER
+	case sagaDomain.BeginningDelivery:
+		return orderv1.SagaStep_BEGINNING_DELIVERY
+	case sagaDomain.CancelingOutOfStock:
+		return orderv1.SagaStep_CANCELING_OUT_OF_STOCK
+	case sagaDomain.ReleasingItems:
+		return orderv1.SagaStep_RELEASING_ITEMS
+	case sagaDomain.CancelingCourierNotFound:
+		return orderv1.SagaStep_CANCELING_COURIER_NOT_FOUND
+	case sagaDomain.ReleasingItemsOnTimeout:
+		return orderv1.SagaStep_RELEASING_ITEMS_ON_TIMEOUT
+	case sagaDomain.CancelingTimeout:
+		return orderv1.SagaStep_CANCELING_TIMEOUT
+	case sagaDomain.SupersededByCancel:
+		return orderv1.SagaStep_SUPERSEDED_BY_CANCEL
+	case sagaDomain.ReleasingItemsAndCourier:
+		return orderv1.SagaStep_RELEASING_ITEMS_AND_COURIER
+	case sagaDomain.AwaitingCourierRelease:
+		return orderv1.SagaStep_AWAITING_COURIER_RELEASE
+	case sagaDomain.AwaitingItemsRelease:
+		return orderv1.SagaStep_AWAITING_ITEMS_RELEASE
+	case sagaDomain.CancelingByCustomer:
+		return orderv1.SagaStep_CANCELING_BY_CUSTOMER
+	case sagaDomain.AwaitingDeliverySlot:
+		return orderv1.SagaStep_AWAITING_DELIVERY_SLOT
+	default:
+		return orderv1.SagaStep_RESERVING_ITEMS
+	}
+}
+
+func ToSagaStepRecordResponse(record sagaDomain.StepRecord) *orderv1.SagaStepRecord {
+	return &orderv1.SagaStepRecord{
+		Step:    MapSagaStep(record.Step),
+		Entered: timestamppb.New(record.Entered),
+		Retry:   record.Retry,
+	}
+}
+
+func ToSagaFailureResponse(failure *sagaDomain.Failure) *orderv1.SagaFailure {
+	if failure == nil {
+		return nil
+	}
+
+	return &orderv1.SagaFailure{
+		Step:     MapSagaStep(failure.Step),
+		Message:  failure.Message,
+		Occurred: timestamppb.New(failure.Occurred),
+	}
+}
+
+func ToSagaResponse(saga *sagaDomain.Saga) *orderv1.Saga {
+	history := make([]*orderv1.SagaStepRecord, 0, len(saga.History))
+	for _, record := range saga.History {
+		history = append(history, ToSagaStepRecordResponse(record))
+	}
+
+	var resumeAt *timestamppb.Timestamp
+	if saga.ResumeAt != nil {
+		resumeAt = timestamppb.New(*saga.ResumeAt)
+	}
+
+	return &orderv1.Saga{
+		SagaId:    saga.ID.String(),
+		OrderId:   saga.OrderID.String(),
+		Type:      MapSagaType(saga.Type),
+		Step:      MapSagaStep(saga.Step),
+		History:   history,
+		LastError: ToSagaFailureResponse(saga.LastError),
+		Created:   timestamppb.New(saga.Created),
+		Updated:   timestamppb.New(saga.Updated),
+		ResumeAt:  resumeAt,
+	}
+}
+
+func ToGetSagaStateResponse(sagas []*sagaDomain.Saga) *orderv1.GetSagaStateResponse {
+	resp := make([]*orderv1.Saga, 0, len(sagas))
+	for _, saga := range sagas {
+		resp = append(resp, ToSagaResponse(saga))
+	}
+
+	return &orderv1.GetSagaStateResponse{
+		Sagas: resp,
+	}
+}
@@ -0,0 +1,38 @@
+package response
+
+import (
+	slotDomain "order/internal/domain/slot"
+	orderv1 "order/internal/presentation/grpc"
+)
+
+func ToSlotAvailabilityResponse(availability slotDomain.Availability) (*orderv1.SlotAvailability, error) {
+	capacity, err := safeIntToInt32(availability.Capacity)
+	if err != nil {
+		return nil, err
+	}
+	remaining, err := safeIntToInt32(availability.Remaining())
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderv1.SlotAvailability{
+		Slot:      ToDeliverySlotResponse(&availability.Slot),
+		Capacity:  capacity,
+		Remaining: remaining,
+	}, nil
+}
+
+func ToGetAvailableSlotsResponse(slots []slotDomain.Availability) (*orderv1.GetAvailableSlotsResponse, error) {
+	resp := make([]*orderv1.SlotAvailability, 0, len(slots))
+	for _, slot := range slots {
+		availability, err := ToSlotAvailabilityResponse(slot)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, availability)
+	}
+
+	return &orderv1.GetAvailableSlotsResponse{
+		Slots: resp,
+	}, nil
+}
@@ -22,10 +22,26 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	OrderService_CreateOrder_FullMethodName               = "/order.v1.OrderService/CreateOrder"
+	OrderService_GetOrder_FullMethodName                  = "/order.v1.OrderService/GetOrder"
 	OrderService_CancelOrderByCustomer_FullMethodName     = "/order.v1.OrderService/CancelOrderByCustomer"
 	OrderService_CompleteDelivery_FullMethodName          = "/order.v1.OrderService/CompleteDelivery"
 	OrderService_GetOrdersByCustomer_FullMethodName       = "/order.v1.OrderService/GetOrdersByCustomer"
 	OrderService_GetCurrentOrdersByCourier_FullMethodName = "/order.v1.OrderService/GetCurrentOrdersByCourier"
+	OrderService_GetCourierOrderHistory_FullMethodName    = "/order.v1.OrderService/GetCourierOrderHistory"
+	OrderService_GetSagaState_FullMethodName              = "/order.v1.OrderService/GetSagaState"
+	OrderService_CreatePromotion_FullMethodName           = "/order.v1.OrderService/CreatePromotion"
+	OrderService_GetPromotions_FullMethodName             = "/order.v1.OrderService/GetPromotions"
+	OrderService_DeactivatePromotion_FullMethodName       = "/order.v1.OrderService/DeactivatePromotion"
+	OrderService_GetAvailableSlots_FullMethodName         = "/order.v1.OrderService/GetAvailableSlots"
+	OrderService_GetOrderHistory_FullMethodName           = "/order.v1.OrderService/GetOrderHistory"
+	OrderService_SearchOrders_FullMethodName              = "/order.v1.OrderService/SearchOrders"
+	OrderService_ForceCancelOrder_FullMethodName          = "/order.v1.OrderService/ForceCancelOrder"
+	OrderService_RetrySagaStep_FullMethodName             = "/order.v1.OrderService/RetrySagaStep"
+	OrderService_ReassignCourier_FullMethodName           = "/order.v1.OrderService/ReassignCourier"
+	OrderService_GetStatusFunnel_FullMethodName           = "/order.v1.OrderService/GetStatusFunnel"
+	OrderService_GetRevenue_FullMethodName                = "/order.v1.OrderService/GetRevenue"
+	OrderService_GetDeliveryTime_FullMethodName           = "/order.v1.OrderService/GetDeliveryTime"
+	OrderService_GetCancellationReasons_FullMethodName    = "/order.v1.OrderService/GetCancellationReasons"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -36,10 +52,41 @@ const (
 // API Version: v1
 type OrderServiceClient interface {
 	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderResponse, error)
+	// A single order. Only its customer, the courier assigned to it and admins
+	// may read it.
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error)
 	CancelOrderByCustomer(ctx context.Context, in *CancelOrderByCustomerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CompleteDelivery(ctx context.Context, in *CompleteDeliveryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetOrdersByCustomer(ctx context.Context, in *GetOrdersByCustomerRequest, opts ...grpc.CallOption) (*GetOrdersByCustomerResponse, error)
 	GetCurrentOrdersByCourier(ctx context.Context, in *GetCurrentOrdersByCourierRequest, opts ...grpc.CallOption) (*GetCurrentOrdersByCourierResponse, error)
+	GetCourierOrderHistory(ctx context.Context, in *GetCourierOrderHistoryRequest, opts ...grpc.CallOption) (*GetCourierOrderHistoryResponse, error)
+	GetSagaState(ctx context.Context, in *GetSagaStateRequest, opts ...grpc.CallOption) (*GetSagaStateResponse, error)
+	CreatePromotion(ctx context.Context, in *CreatePromotionRequest, opts ...grpc.CallOption) (*CreatePromotionResponse, error)
+	GetPromotions(ctx context.Context, in *GetPromotionsRequest, opts ...grpc.CallOption) (*GetPromotionsResponse, error)
+	DeactivatePromotion(ctx context.Context, in *DeactivatePromotionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest, opts ...grpc.CallOption) (*GetAvailableSlotsResponse, error)
+	// Status changes of an order, oldest first. Only its customer, the courier
+	// assigned to it and admins may read them.
+	GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error)
+	// Admin operations. The caller is trusted to have authenticated an admin;
+	// status changes they cause are recorded with an ADMIN actor.
+	SearchOrders(ctx context.Context, in *SearchOrdersRequest, opts ...grpc.CallOption) (*SearchOrdersResponse, error)
+	// Cancels a CREATED or DELIVERING order regardless of the cancellation
+	// policy, releasing its items and courier. The order ends CANCELED_BY_ADMIN.
+	ForceCancelOrder(ctx context.Context, in *ForceCancelOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Sends the commands of the step a saga awaits a reply in once more, under
+	// their original message IDs.
+	RetrySagaStep(ctx context.Context, in *RetrySagaStepRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Hands a DELIVERING order over to another courier.
+	ReassignCourier(ctx context.Context, in *ReassignCourierRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Admin reports over the orders created in [created_from, created_to); an
+	// unset bound leaves that side open.
+	GetStatusFunnel(ctx context.Context, in *GetStatusFunnelRequest, opts ...grpc.CallOption) (*GetStatusFunnelResponse, error)
+	// Revenue of delivered orders per day or week of their creation.
+	GetRevenue(ctx context.Context, in *GetRevenueRequest, opts ...grpc.CallOption) (*GetRevenueResponse, error)
+	// Average time from placing an order to its arrival.
+	GetDeliveryTime(ctx context.Context, in *GetDeliveryTimeRequest, opts ...grpc.CallOption) (*GetDeliveryTimeResponse, error)
+	GetCancellationReasons(ctx context.Context, in *GetCancellationReasonsRequest, opts ...grpc.CallOption) (*GetCancellationReasonsResponse, error)
 }
 
 type orderServiceClient struct {
@@ -60,6 +107,16 @@ func (c *orderServiceClient) CreateOrder(ctx context.Context, in *CreateOrderReq
 	return out, nil
 }
 
+func (c *orderServiceClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) CancelOrderByCustomer(ctx context.Context, in *CancelOrderByCustomerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -100,6 +157,156 @@ func (c *orderServiceClient) GetCurrentOrdersByCourier(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *orderServiceClient) GetCourierOrderHistory(ctx context.Context, in *GetCourierOrderHistoryRequest, opts ...grpc.CallOption) (*GetCourierOrderHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourierOrderHistoryResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetCourierOrderHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetSagaState(ctx context.Context, in *GetSagaStateRequest, opts ...grpc.CallOption) (*GetSagaStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSagaStateResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetSagaState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreatePromotion(ctx context.Context, in *CreatePromotionRequest, opts ...grpc.CallOption) (*CreatePromotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromotionResponse)
+	err := c.cc.Invoke(ctx, OrderService_CreatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetPromotions(ctx context.Context, in *GetPromotionsRequest, opts ...grpc.CallOption) (*GetPromotionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPromotionsResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetPromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) DeactivatePromotion(ctx context.Context, in *DeactivatePromotionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_DeactivatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest, opts ...grpc.CallOption) (*GetAvailableSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAvailableSlotsResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetAvailableSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderHistoryResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SearchOrders(ctx context.Context, in *SearchOrdersRequest, opts ...grpc.CallOption) (*SearchOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_SearchOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ForceCancelOrder(ctx context.Context, in *ForceCancelOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_ForceCancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RetrySagaStep(ctx context.Context, in *RetrySagaStepRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_RetrySagaStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ReassignCourier(ctx context.Context, in *ReassignCourierRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_ReassignCourier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetStatusFunnel(ctx context.Context, in *GetStatusFunnelRequest, opts ...grpc.CallOption) (*GetStatusFunnelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatusFunnelResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetStatusFunnel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetRevenue(ctx context.Context, in *GetRevenueRequest, opts ...grpc.CallOption) (*GetRevenueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRevenueResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetRevenue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetDeliveryTime(ctx context.Context, in *GetDeliveryTimeRequest, opts ...grpc.CallOption) (*GetDeliveryTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeliveryTimeResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetDeliveryTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetCancellationReasons(ctx context.Context, in *GetCancellationReasonsRequest, opts ...grpc.CallOption) (*GetCancellationReasonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCancellationReasonsResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetCancellationReasons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -108,10 +315,41 @@ func (c *orderServiceClient) GetCurrentOrdersByCourier(ctx context.Context, in *
 // API Version: v1
 type OrderServiceServer interface {
 	CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error)
+	// A single order. Only its customer, the courier assigned to it and admins
+	// may read it.
+	GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error)
 	CancelOrderByCustomer(context.Context, *CancelOrderByCustomerRequest) (*emptypb.Empty, error)
 	CompleteDelivery(context.Context, *CompleteDeliveryRequest) (*emptypb.Empty, error)
 	GetOrdersByCustomer(context.Context, *GetOrdersByCustomerRequest) (*GetOrdersByCustomerResponse, error)
 	GetCurrentOrdersByCourier(context.Context, *GetCurrentOrdersByCourierRequest) (*GetCurrentOrdersByCourierResponse, error)
+	GetCourierOrderHistory(context.Context, *GetCourierOrderHistoryRequest) (*GetCourierOrderHistoryResponse, error)
+	GetSagaState(context.Context, *GetSagaStateRequest) (*GetSagaStateResponse, error)
+	CreatePromotion(context.Context, *CreatePromotionRequest) (*CreatePromotionResponse, error)
+	GetPromotions(context.Context, *GetPromotionsRequest) (*GetPromotionsResponse, error)
+	DeactivatePromotion(context.Context, *DeactivatePromotionRequest) (*emptypb.Empty, error)
+	GetAvailableSlots(context.Context, *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error)
+	// Status changes of an order, oldest first. Only its customer, the courier
+	// assigned to it and admins may read them.
+	GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error)
+	// Admin operations. The caller is trusted to have authenticated an admin;
+	// status changes they cause are recorded with an ADMIN actor.
+	SearchOrders(context.Context, *SearchOrdersRequest) (*SearchOrdersResponse, error)
+	// Cancels a CREATED or DELIVERING order regardless of the cancellation
+	// policy, releasing its items and courier. The order ends CANCELED_BY_ADMIN.
+	ForceCancelOrder(context.Context, *ForceCancelOrderRequest) (*emptypb.Empty, error)
+	// Sends the commands of the step a saga awaits a reply in once more, under
+	// their original message IDs.
+	RetrySagaStep(context.Context, *RetrySagaStepRequest) (*emptypb.Empty, error)
+	// Hands a DELIVERING order over to another courier.
+	ReassignCourier(context.Context, *ReassignCourierRequest) (*emptypb.Empty, error)
+	// Admin reports over the orders created in [created_from, created_to); an
+	// unset bound leaves that side open.
+	GetStatusFunnel(context.Context, *GetStatusFunnelRequest) (*GetStatusFunnelResponse, error)
+	// Revenue of delivered orders per day or week of their creation.
+	GetRevenue(context.Context, *GetRevenueRequest) (*GetRevenueResponse, error)
+	// Average time from placing an order to its arrival.
+	GetDeliveryTime(context.Context, *GetDeliveryTimeRequest) (*GetDeliveryTimeResponse, error)
+	GetCancellationReasons(context.Context, *GetCancellationReasonsRequest) (*GetCancellationReasonsResponse, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -125,6 +363,9 @@ type UnimplementedOrderServiceServer struct{}
 func (UnimplementedOrderServiceServer) CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
 func (UnimplementedOrderServiceServer) CancelOrderByCustomer(context.Context, *CancelOrderByCustomerRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOrderByCustomer not implemented")
 }
@@ -137,6 +378,51 @@ func (UnimplementedOrderServiceServer) GetOrdersByCustomer(context.Context, *Get
 func (UnimplementedOrderServiceServer) GetCurrentOrdersByCourier(context.Context, *GetCurrentOrdersByCourierRequest) (*GetCurrentOrdersByCourierResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentOrdersByCourier not implemented")
 }
+func (UnimplementedOrderServiceServer) GetCourierOrderHistory(context.Context, *GetCourierOrderHistoryRequest) (*GetCourierOrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourierOrderHistory not implemented")
+}
+func (UnimplementedOrderServiceServer) GetSagaState(context.Context, *GetSagaStateRequest) (*GetSagaStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSagaState not implemented")
+}
+func (UnimplementedOrderServiceServer) CreatePromotion(context.Context, *CreatePromotionRequest) (*CreatePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromotion not implemented")
+}
+func (UnimplementedOrderServiceServer) GetPromotions(context.Context, *GetPromotionsRequest) (*GetPromotionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromotions not implemented")
+}
+func (UnimplementedOrderServiceServer) DeactivatePromotion(context.Context, *DeactivatePromotionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivatePromotion not implemented")
+}
+func (UnimplementedOrderServiceServer) GetAvailableSlots(context.Context, *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableSlots not implemented")
+}
+func (UnimplementedOrderServiceServer) GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderHistory not implemented")
+}
+func (UnimplementedOrderServiceServer) SearchOrders(context.Context, *SearchOrdersRequest) (*SearchOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) ForceCancelOrder(context.Context, *ForceCancelOrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceCancelOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) RetrySagaStep(context.Context, *RetrySagaStepRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrySagaStep not implemented")
+}
+func (UnimplementedOrderServiceServer) ReassignCourier(context.Context, *ReassignCourierRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignCourier not implemented")
+}
+func (UnimplementedOrderServiceServer) GetStatusFunnel(context.Context, *GetStatusFunnelRequest) (*GetStatusFunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusFunnel not implemented")
+}
+func (UnimplementedOrderServiceServer) GetRevenue(context.Context, *GetRevenueRequest) (*GetRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevenue not implemented")
+}
+func (UnimplementedOrderServiceServer) GetDeliveryTime(context.Context, *GetDeliveryTimeRequest) (*GetDeliveryTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeliveryTime not implemented")
+}
+func (UnimplementedOrderServiceServer) GetCancellationReasons(context.Context, *GetCancellationReasonsRequest) (*GetCancellationReasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCancellationReasons not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -176,6 +462,24 @@ func _OrderService_CreateOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_CancelOrderByCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelOrderByCustomerRequest)
 	if err := dec(in); err != nil {
@@ -248,6 +552,276 @@ func _OrderService_GetCurrentOrdersByCourier_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_GetCourierOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourierOrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetCourierOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetCourierOrderHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetCourierOrderHistory(ctx, req.(*GetCourierOrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetSagaState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSagaStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetSagaState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetSagaState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetSagaState(ctx, req.(*GetSagaStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreatePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreatePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreatePromotion(ctx, req.(*CreatePromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPromotionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetPromotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetPromotions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetPromotions(ctx, req.(*GetPromotionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_DeactivatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivatePromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).DeactivatePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_DeactivatePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).DeactivatePromotion(ctx, req.(*DeactivatePromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetAvailableSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetAvailableSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetAvailableSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetAvailableSlots(ctx, req.(*GetAvailableSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderHistory(ctx, req.(*GetOrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SearchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SearchOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SearchOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SearchOrders(ctx, req.(*SearchOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ForceCancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceCancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ForceCancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ForceCancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ForceCancelOrder(ctx, req.(*ForceCancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RetrySagaStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrySagaStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RetrySagaStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RetrySagaStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RetrySagaStep(ctx, req.(*RetrySagaStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ReassignCourier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignCourierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ReassignCourier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ReassignCourier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ReassignCourier(ctx, req.(*ReassignCourierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetStatusFunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusFunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetStatusFunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetStatusFunnel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetStatusFunnel(ctx, req.(*GetStatusFunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetRevenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetRevenue(ctx, req.(*GetRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetDeliveryTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeliveryTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetDeliveryTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetDeliveryTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetDeliveryTime(ctx, req.(*GetDeliveryTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetCancellationReasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCancellationReasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetCancellationReasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetCancellationReasons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetCancellationReasons(ctx, req.(*GetCancellationReasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -259,6 +833,10 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateOrder",
 			Handler:    _OrderService_CreateOrder_Handler,
 		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _OrderService_GetOrder_Handler,
+		},
 		{
 			MethodName: "CancelOrderByCustomer",
 			Handler:    _OrderService_CancelOrderByCustomer_Handler,
@@ -275,6 +853,66 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrentOrdersByCourier",
 			Handler:    _OrderService_GetCurrentOrdersByCourier_Handler,
 		},
+		{
+			MethodName: "GetCourierOrderHistory",
+			Handler:    _OrderService_GetCourierOrderHistory_Handler,
+		},
+		{
+			MethodName: "GetSagaState",
+			Handler:    _OrderService_GetSagaState_Handler,
+		},
+		{
+			MethodName: "CreatePromotion",
+			Handler:    _OrderService_CreatePromotion_Handler,
+		},
+		{
+			MethodName: "GetPromotions",
+			Handler:    _OrderService_GetPromotions_Handler,
+		},
+		{
+			MethodName: "DeactivatePromotion",
+			Handler:    _OrderService_DeactivatePromotion_Handler,
+		},
+		{
+			MethodName: "GetAvailableSlots",
+			Handler:    _OrderService_GetAvailableSlots_Handler,
+		},
+		{
+			MethodName: "GetOrderHistory",
+			Handler:    _OrderService_GetOrderHistory_Handler,
+		},
+		{
+			MethodName: "SearchOrders",
+			Handler:    _OrderService_SearchOrders_Handler,
+		},
+		{
+			MethodName: "ForceCancelOrder",
+			Handler:    _OrderService_ForceCancelOrder_Handler,
+		},
+		{
+			MethodName: "RetrySagaStep",
+			Handler:    _OrderService_RetrySagaStep_Handler,
+		},
+		{
+			MethodName: "ReassignCourier",
+			Handler:    _OrderService_ReassignCourier_Handler,
+		},
+		{
+			MethodName: "GetStatusFunnel",
+			Handler:    _OrderService_GetStatusFunnel_Handler,
+		},
+		{
+			MethodName: "GetRevenue",
+			Handler:    _OrderService_GetRevenue_Handler,
+		},
+		{
+			MethodName: "GetDeliveryTime",
+			Handler:    _OrderService_GetDeliveryTime_Handler,
+		},
+		{
+			MethodName: "GetCancellationReasons",
+			Handler:    _OrderService_GetCancellationReasons_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order/internal/presentation/grpc/service.proto",
@@ -0,0 +1,22 @@
+package create_order
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type WatchdogConfig struct {
+	StepDeadline  time.Duration `envconfig:"SAGA_STEP_DEADLINE" required:"true"`
+	PollInterval  time.Duration `envconfig:"SAGA_WATCHDOG_POLL_INTERVAL" required:"true"`
+	LeaseDuration time.Duration `envconfig:"SAGA_WATCHDOG_LEASE_DURATION" required:"true"`
+}
+
+func NewWatchdogConfig() (*WatchdogConfig, error) {
+	var cfg WatchdogConfig
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, fmt.Errorf("failed to load saga watchdog config: %w", err)
+	}
+	return &cfg, nil
+}
@@ -3,8 +3,14 @@ package create_order
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	cancelOrder "order/internal/application/order/saga/cancel_order"
 	createOrder "order/internal/application/order/saga/create_order"
+	orderDomain "order/internal/domain/order"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/infrastructure/messaging/retry"
+	sagaRepository "order/internal/infrastructure/repository/saga"
 )
 
 type Handler interface {
@@ -12,11 +18,15 @@ type Handler interface {
 }
 
 type HandlerImpl struct {
-	saga createOrder.Saga
+	saga       createOrder.Saga
+	cancelSaga cancelOrder.Saga
 }
 
-func NewHandler(saga createOrder.Saga) *HandlerImpl {
-	return &HandlerImpl{saga: saga}
+func NewHandler(saga createOrder.Saga, cancelSaga cancelOrder.Saga) *HandlerImpl {
+	return &HandlerImpl{
+		saga:       saga,
+		cancelSaga: cancelSaga,
+	}
 }
 
 func (h *HandlerImpl) Handle(ctx context.Context, cmdMsg *ResMessage) error {
@@ -31,19 +41,21 @@ func (h *HandlerImpl) Handle(ctx context.Context, cmdMsg *ResMessage) error {
 		return h.handleCourierAssigned(ctx, cmdMsg)
 	case CourierAssignmentFailedName:
 		return h.handleCourierAssignmentFailed(ctx, cmdMsg)
+	case CourierReleasedName:
+		return h.handleCourierReleased(ctx, cmdMsg)
 	default:
-		return fmt.Errorf("unknown command: %s", cmdMsg.Name)
+		return retry.Permanent(fmt.Errorf("unknown command: %s", cmdMsg.Name))
 	}
 }
 
 func (h *HandlerImpl) handleItemsReserved(ctx context.Context, cmdMsg *ResMessage) error {
 	if cmdMsg.Name != ItemsReservedName {
-		return fmt.Errorf("unexpected command: %s", cmdMsg.Name)
+		return retry.Permanent(fmt.Errorf("unexpected command: %s", cmdMsg.Name))
 	}
 
 	var res createOrder.ItemsReserved
 	if err := json.Unmarshal(cmdMsg.Payload, &res); err != nil {
-		return fmt.Errorf("failed to parse ItemsReserved: %w", err)
+		return retry.Permanent(fmt.Errorf("failed to parse ItemsReserved: %w", err))
 	}
 
 	return h.onItemsReserved(ctx, res)
@@ -51,12 +63,12 @@ func (h *HandlerImpl) handleItemsReserved(ctx context.Context, cmdMsg *ResMessag
 
 func (h *HandlerImpl) handleItemsReservationFailed(ctx context.Context, cmdMsg *ResMessage) error {
 	if cmdMsg.Name != ItemsReservationFailedName {
-		return fmt.Errorf("unexpected command: %s", cmdMsg.Name)
+		return retry.Permanent(fmt.Errorf("unexpected command: %s", cmdMsg.Name))
 	}
 
 	var res createOrder.ItemsReservationFailed
 	if err := json.Unmarshal(cmdMsg.Payload, &res); err != nil {
-		return fmt.Errorf("failed to parse ItemsReservationFailed: %w", err)
+		return retry.Permanent(fmt.Errorf("failed to parse ItemsReservationFailed: %w", err))
 	}
 
 	return h.onItemsReservationFailed(ctx, res)
@@ -64,12 +76,12 @@ func (h *HandlerImpl) handleItemsReservationFailed(ctx context.Context, cmdMsg *
 
 func (h *HandlerImpl) handleItemsReleased(ctx context.Context, cmdMsg *ResMessage) error {
 	if cmdMsg.Name != ItemsReleasedName {
-		return fmt.Errorf("unexpected command: %s", cmdMsg.Name)
+		return retry.Permanent(fmt.Errorf("unexpected command: %s", cmdMsg.Name))
 	}
 
 	var res createOrder.ItemsReleased
 	if err := json.Unmarshal(cmdMsg.Payload, &res); err != nil {
-		return fmt.Errorf("failed to parse ItemsReleased: %w", err)
+		return retry.Permanent(fmt.Errorf("failed to parse ItemsReleased: %w", err))
 	}
 
 	return h.onItemsReleased(ctx, res)
@@ -77,12 +89,12 @@ func (h *HandlerImpl) handleItemsReleased(ctx context.Context, cmdMsg *ResMessag
 
 func (h *HandlerImpl) handleCourierAssigned(ctx context.Context, cmdMsg *ResMessage) error {
 	if cmdMsg.Name != CourierAssignedName {
-		return fmt.Errorf("unexpected command: %s", cmdMsg.Name)
+		return retry.Permanent(fmt.Errorf("unexpected command: %s", cmdMsg.Name))
 	}
 
 	var res createOrder.CourierAssigned
 	if err := json.Unmarshal(cmdMsg.Payload, &res); err != nil {
-		return fmt.Errorf("failed to parse CourierAssigned: %w", err)
+		return retry.Permanent(fmt.Errorf("failed to parse CourierAssigned: %w", err))
 	}
 
 	return h.onCourierAssigned(ctx, res)
@@ -90,17 +102,30 @@ func (h *HandlerImpl) handleCourierAssigned(ctx context.Context, cmdMsg *ResMess
 
 func (h *HandlerImpl) handleCourierAssignmentFailed(ctx context.Context, cmdMsg *ResMessage) error {
 	if cmdMsg.Name != CourierAssignmentFailedName {
-		return fmt.Errorf("unexpected command: %s", cmdMsg.Name)
+		return retry.Permanent(fmt.Errorf("unexpected command: %s", cmdMsg.Name))
 	}
 
 	var res createOrder.CourierAssignmentFailed
 	if err := json.Unmarshal(cmdMsg.Payload, &res); err != nil {
-		return fmt.Errorf("failed to parse CourierAssignmentFailed: %w", err)
+		return retry.Permanent(fmt.Errorf("failed to parse CourierAssignmentFailed: %w", err))
 	}
 
 	return h.onCourierAssignmentFailed(ctx, res)
 }
 
+func (h *HandlerImpl) handleCourierReleased(ctx context.Context, cmdMsg *ResMessage) error {
+	if cmdMsg.Name != CourierReleasedName {
+		return retry.Permanent(fmt.Errorf("unexpected command: %s", cmdMsg.Name))
+	}
+
+	var res cancelOrder.CourierReleased
+	if err := json.Unmarshal(cmdMsg.Payload, &res); err != nil {
+		return retry.Permanent(fmt.Errorf("failed to parse CourierReleased: %w", err))
+	}
+
+	return h.onCourierReleased(ctx, res)
+}
+
 func (h *HandlerImpl) onItemsReserved(ctx context.Context, res createOrder.ItemsReserved) error {
 	return h.saga.HandleItemsReserved(ctx, res)
 }
@@ -109,7 +134,15 @@ func (h *HandlerImpl) onItemsReservationFailed(ctx context.Context, res createOr
 	return h.saga.HandleItemsReservationFailed(ctx, res)
 }
 
+// onItemsReleased routes the reply to the customer cancellation saga when the order
+// has one waiting for it, since its items are then released on the customer's
+// behalf. Otherwise the create_order saga asked for the release: to compensate,
+// or to hand back a reservation that arrived after a cancellation superseded it.
 func (h *HandlerImpl) onItemsReleased(ctx context.Context, res createOrder.ItemsReleased) error {
+	err := h.cancelSaga.HandleItemsReleased(ctx, cancelOrder.ItemsReleased{OrderID: res.OrderID})
+	if !errors.Is(err, sagaRepository.ErrSagaNotFound) && !errors.Is(err, cancelOrder.ErrItemsReleaseNotAwaited) {
+		return err
+	}
 	return h.saga.HandleItemsReleased(ctx, res)
 }
 
@@ -121,4 +154,22 @@ func (h *HandlerImpl) onCourierAssignmentFailed(ctx context.Context, res createO
 	return h.saga.HandleCourierAssignmentFailed(ctx, res)
 }
 
+func (h *HandlerImpl) onCourierReleased(ctx context.Context, res cancelOrder.CourierReleased) error {
+	return h.cancelSaga.HandleCourierReleased(ctx, res)
+}
+
+// IsRetryable reports whether a failed result may succeed when handled again.
+// Results for unknown sagas or out-of-order steps fail the same way on every attempt.
+func IsRetryable(err error) bool {
+	switch {
+	case retry.IsPermanent(err),
+		errors.Is(err, sagaRepository.ErrSagaNotFound),
+		errors.Is(err, sagaDomain.ErrUnsupportedStepTransition),
+		errors.Is(err, orderDomain.ErrUnsupportedStatusTransition):
+		return false
+	default:
+		return true
+	}
+}
+
 var _ Handler = (*HandlerImpl)(nil)
@@ -2,7 +2,14 @@ package create_order
 
 import (
 	"context"
+	inboxDomain "order/internal/domain/inbox"
+	"order/internal/domain/uow"
+	inboxConfig "order/internal/infrastructure/inbox"
 	"order/internal/infrastructure/logger"
+	"order/internal/infrastructure/messaging/dlq"
+	"order/internal/infrastructure/messaging/retry"
+	"order/internal/infrastructure/messaging/worker"
+	inboxRepository "order/internal/infrastructure/repository/inbox"
 	"sync"
 	"time"
 
@@ -18,6 +25,14 @@ type Processor struct {
 	warehouseReader Reader
 	courierReader   Reader
 
+	uow      uow.UoW
+	inboxCfg *inboxConfig.Config
+
+	retry              *retry.Policy
+	pool               *worker.Pool
+	warehouseDLQWriter dlq.Writer
+	courierDLQWriter   dlq.Writer
+
 	cancelCtx  context.Context
 	cancelFunc context.CancelFunc
 
@@ -28,12 +43,29 @@ type Processor struct {
 	logger logger.Logger
 }
 
-func NewProcessor(handler Handler, warehouseReader Reader, courierReader Reader, logger logger.Logger) *Processor {
+func NewProcessor(
+	handler Handler,
+	warehouseReader Reader,
+	courierReader Reader,
+	uow uow.UoW,
+	inboxCfg *inboxConfig.Config,
+	retryCfg *retry.Config,
+	workerCfg *worker.Config,
+	warehouseDLQWriter dlq.Writer,
+	courierDLQWriter dlq.Writer,
+	logger logger.Logger,
+) *Processor {
 	return &Processor{
-		handler:         handler,
-		warehouseReader: warehouseReader,
-		courierReader:   courierReader,
-		logger:          logger,
+		handler:            handler,
+		warehouseReader:    warehouseReader,
+		courierReader:      courierReader,
+		uow:                uow,
+		inboxCfg:           inboxCfg,
+		retry:              retry.NewPolicy(retryCfg, IsRetryable),
+		pool:               worker.NewPool(workerCfg),
+		warehouseDLQWriter: warehouseDLQWriter,
+		courierDLQWriter:   courierDLQWriter,
+		logger:             logger,
 	}
 }
 
@@ -61,13 +93,14 @@ func (p *Processor) Start(ctx context.Context) error {
 	p.started = true
 
 	p.log(logger.Info, "start", "Starting create order saga processor", nil)
+	p.pool.Start()
 	p.wg.Add(2)
-	go p.processMessages(p.cancelCtx, "warehouse", p.warehouseReader)
-	go p.processMessages(p.cancelCtx, "courier", p.courierReader)
+	go p.processMessages(p.cancelCtx, "warehouse", p.warehouseReader, p.warehouseDLQWriter)
+	go p.processMessages(p.cancelCtx, "courier", p.courierReader, p.courierDLQWriter)
 	return nil
 }
 
-func (p *Processor) processMessages(ctx context.Context, source string, receiver Reader) {
+func (p *Processor) processMessages(ctx context.Context, source string, receiver Reader, dlqWriter dlq.Writer) {
 	defer p.wg.Done()
 
 	for {
@@ -92,32 +125,102 @@ func (p *Processor) processMessages(ctx context.Context, source string, receiver
 				continue
 			}
 
-			// Handle the command
-			sCtx, span := startProcessSpan(res)
-			startTime := time.Now()
+			// Handle the result on the worker owning its key and commit its offset once it is
+			// handled or dead-lettered; otherwise it is redelivered after a restart
+			p.pool.Submit(ctx, res.Raw, func() {
+				if p.processResult(res, source, dlqWriter) {
+					receiver.Commit(res)
+				}
+			})
+		}
+	}
+}
 
-			err = p.handler.Handle(sCtx, res.Msg)
+// processResult reports whether the result is done with: handled, or moved to the DLQ
+// once its attempts ran out.
+func (p *Processor) processResult(res *ResEnvelope, source string, dlqWriter dlq.Writer) bool {
+	// Handle the result, retrying transient failures
+	sCtx, span := startProcessSpan(res)
+	startTime := time.Now()
 
-			duration := time.Since(startTime)
-			span.End()
+	var processed *inboxDomain.Message
+	attempts, err := p.retry.Do(sCtx, func(ctx context.Context) error {
+		var err error
+		processed, err = p.handle(ctx, res)
+		return err
+	})
 
-			if err != nil {
-				p.log(logger.Error, "process_error", "Result processing failed", map[string]any{
-					"result_id":   res.Msg.ID,
-					"source":      source,
-					"error":       err.Error(),
-					"duration_ms": duration.Milliseconds(),
-				})
-				continue
-			}
+	duration := time.Since(startTime)
+	span.End()
 
-			p.log(logger.Info, "process_success", "Result processed successfully", map[string]any{
-				"result_id":   res.Msg.ID,
-				"source":      source,
-				"duration_ms": duration.Milliseconds(),
-			})
+	if err != nil {
+		p.log(logger.Error, "process_error", "Result processing failed", map[string]any{
+			"result_id":   res.Msg.ID,
+			"source":      source,
+			"error":       err.Error(),
+			"attempts":    attempts,
+			"duration_ms": duration.Milliseconds(),
+		})
+		return p.deadLetter(res, source, dlqWriter, err, attempts)
+	}
+
+	// Skip an already processed result
+	if processed != nil {
+		p.log(logger.Info, "duplicate", "Result already processed, skipping", map[string]any{
+			"result_id":    res.Msg.ID,
+			"source":       source,
+			"processed_at": processed.Processed,
+		})
+		return true
+	}
+
+	p.log(logger.Info, "process_success", "Result processed successfully", map[string]any{
+		"result_id":   res.Msg.ID,
+		"source":      source,
+		"duration_ms": duration.Milliseconds(),
+	})
+	return true
+}
+
+// handle runs the handler and records the result in the inbox in one
+// transaction, which the transactions of the saga join, so the result counts
+// as processed exactly when what the saga did for it is committed. A result
+// found in the inbox is not handled again; its inbox record is returned.
+func (p *Processor) handle(ctx context.Context, res *ResEnvelope) (*inboxDomain.Message, error) {
+	var processed *inboxDomain.Message
+	err := p.uow.Transaction(ctx, func(ctx context.Context, tx uow.UoW) error {
+		message, err := tx.Inbox().GetByID(ctx, res.Msg.ID)
+		if err == nil {
+			processed = message
+			return nil
 		}
+		if !errors.Is(err, inboxRepository.ErrInboxMessageNotFound) {
+			return err
+		}
+
+		if err := p.handler.Handle(ctx, res.Msg); err != nil {
+			return err
+		}
+		return tx.Inbox().Create(ctx, inboxDomain.Create(res.Msg.ID, nil, p.inboxCfg.Retention))
+	})
+	return processed, err
+}
+
+// deadLetter moves the result to the DLQ, retrying the write as the handler is
+// retried, and reports whether it got there.
+func (p *Processor) deadLetter(res *ResEnvelope, source string, dlqWriter dlq.Writer, cause error, attempts int) bool {
+	_, err := p.retry.Do(res.Ctx, func(ctx context.Context) error {
+		return dlqWriter.Write(ctx, res.Raw, cause, attempts)
+	})
+	if err != nil {
+		p.log(logger.Error, "dlq_error", "Error sending result to DLQ, leaving its offset uncommitted", map[string]any{
+			"result_id": res.Msg.ID,
+			"source":    source,
+			"error":     err.Error(),
+		})
+		return false
 	}
+	return true
 }
 
 func (p *Processor) Stop() error {
@@ -131,6 +234,7 @@ func (p *Processor) Stop() error {
 	p.log(logger.Info, "stop_request", "Stopping create order saga processor", nil)
 	p.cancelFunc()
 	p.wg.Wait()
+	p.pool.Stop()
 	p.started = false
 
 	p.log(logger.Info, "stopped", "Create order saga processor stopped", nil)
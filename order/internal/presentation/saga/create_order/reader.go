@@ -6,6 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"order/internal/infrastructure/logger"
+	"order/internal/infrastructure/messaging"
+	"order/internal/infrastructure/messaging/commit"
+	"order/internal/infrastructure/messaging/dlq"
 	"sync"
 
 	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
@@ -15,11 +18,14 @@ import (
 type Reader interface {
 	Start(ctx context.Context) error
 	Read(ctx context.Context) (*ResEnvelope, error)
+	Commit(res *ResEnvelope)
 	Stop() error
 }
 
 type ReaderImpl struct {
-	reader     *otelkafkakonsumer.Reader
+	reader     messaging.Reader
+	committer  *commit.Committer
+	dlqWriter  dlq.Writer
 	resultChan chan *ResEnvelope
 	errorChan  chan error
 
@@ -33,10 +39,17 @@ type ReaderImpl struct {
 	logger logger.Logger
 }
 
-func NewReader(reader *otelkafkakonsumer.Reader, logger logger.Logger) *ReaderImpl {
+func NewReader(
+	reader messaging.Reader,
+	dlqWriter dlq.Writer,
+	commitCfg *commit.Config,
+	logger logger.Logger,
+) *ReaderImpl {
 	return &ReaderImpl{
-		reader: reader,
-		logger: logger,
+		reader:    reader,
+		committer: commit.NewCommitter(reader, commitCfg, logger),
+		dlqWriter: dlqWriter,
+		logger:    logger,
 	}
 }
 
@@ -44,7 +57,7 @@ func (r *ReaderImpl) log(level logger.Level, action, message string, extraFields
 	fields := map[string]any{
 		"component": "create_order_saga_reader",
 		"action":    action,
-		"topic":     r.reader.R.Config().Topic,
+		"topic":     r.reader.Topic(),
 	}
 	for k, v := range extraFields {
 		fields[k] = v
@@ -78,11 +91,15 @@ func (r *ReaderImpl) Start(ctx context.Context) error {
 	r.started = true
 
 	r.log(logger.Info, "start", "Starting create order saga reader", nil)
-	r.wg.Add(1)
+	r.wg.Add(2)
 	go func() {
 		defer r.wg.Done()
 		r.readResults(r.cancelCtx)
 	}()
+	go func() {
+		defer r.wg.Done()
+		r.committer.Run(r.cancelCtx)
+	}()
 	return nil
 }
 
@@ -99,7 +116,8 @@ func (r *ReaderImpl) readResults(ctx context.Context) {
 
 		default:
 			// Read message
-			msg, err := r.reader.ReadMessage(ctx)
+			msg := &kafka.Message{}
+			err := r.reader.FetchMessage(ctx, msg)
 			if ctx.Err() != nil {
 				continue
 			}
@@ -107,6 +125,7 @@ func (r *ReaderImpl) readResults(ctx context.Context) {
 				r.sendError(err, "read_message")
 				continue
 			}
+			r.committer.Track(msg)
 
 			// Parse the result message
 			res, err := r.parseResultEnvelope(ctx, msg)
@@ -115,6 +134,9 @@ func (r *ReaderImpl) readResults(ctx context.Context) {
 					"error":    err.Error(),
 					"raw_data": msg.Value,
 				})
+				if r.deadLetter(ctx, msg, err) {
+					r.committer.Done(msg)
+				}
 				r.sendError(err, "parse_error")
 				continue
 			}
@@ -173,22 +195,42 @@ func (r *ReaderImpl) Stop() error {
 	return nil
 }
 
+// Commit marks the result as handled; its offset is committed with the next batch
+// once every earlier message from the same partition has been handled too.
+func (r *ReaderImpl) Commit(res *ResEnvelope) {
+	r.committer.Done(res.Raw)
+}
+
 func (r *ReaderImpl) parseResultEnvelope(ctx context.Context, msg *kafka.Message) (*ResEnvelope, error) {
 	cmdMsg, err := parseResultMessage(msg.Value)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx = r.reader.TraceConfig.Propagator.Extract(ctx, otelkafkakonsumer.NewMessageCarrier(msg))
+	ctx = r.reader.Propagator().Extract(ctx, otelkafkakonsumer.NewMessageCarrier(msg))
 
 	return &ResEnvelope{
 		Ctx:       ctx,
 		Msg:       cmdMsg,
-		Topic:     r.reader.R.Config().Topic,
+		Topic:     r.reader.Topic(),
 		Partition: msg.Partition,
+		Raw:       msg,
 	}, nil
 }
 
+// deadLetter moves a message that cannot be parsed to the DLQ, since no retry can
+// fix it, and reports whether it got there.
+func (r *ReaderImpl) deadLetter(ctx context.Context, msg *kafka.Message, cause error) bool {
+	if err := r.dlqWriter.Write(ctx, msg, cause, 1); err != nil {
+		r.log(logger.Error, "dlq_error", "Failed to dead-letter unparsable message, leaving its offset uncommitted", map[string]any{
+			"offset": msg.Offset,
+			"error":  err.Error(),
+		})
+		return false
+	}
+	return true
+}
+
 func parseResultMessage(data []byte) (*ResMessage, error) {
 	var msg ResMessage
 	if err := json.Unmarshal(data, &msg); err != nil {
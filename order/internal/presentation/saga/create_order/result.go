@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
 )
 
 const (
@@ -13,6 +14,7 @@ const (
 	ItemsReleasedName           ResMessageName = "warehouse.items_released"
 	CourierAssignedName         ResMessageName = "courier.courier_assigned"
 	CourierAssignmentFailedName ResMessageName = "courier.courier_assignment_failed"
+	CourierReleasedName         ResMessageName = "courier.courier_released"
 )
 
 type (
@@ -29,5 +31,6 @@ type (
 		Msg       *ResMessage
 		Topic     string
 		Partition int
+		Raw       *kafka.Message
 	}
 )
@@ -0,0 +1,183 @@
+package create_order
+
+import (
+	"context"
+	cancelOrder "order/internal/application/order/saga/cancel_order"
+	createOrder "order/internal/application/order/saga/create_order"
+	"order/internal/infrastructure/logger"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type Watchdog struct {
+	watchdog       createOrder.Watchdog
+	cancelWatchdog cancelOrder.Watchdog
+	cfg            *WatchdogConfig
+
+	cancelCtx  context.Context
+	cancelFunc context.CancelFunc
+
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	started bool
+
+	logger logger.Logger
+}
+
+// NewWatchdog builds the watchdog of the order sagas. Besides the create order
+// sagas it drives the cancellations stalled waiting for a release.
+func NewWatchdog(
+	watchdog createOrder.Watchdog,
+	cancelWatchdog cancelOrder.Watchdog,
+	cfg *WatchdogConfig,
+	logger logger.Logger,
+) *Watchdog {
+	return &Watchdog{
+		watchdog:       watchdog,
+		cancelWatchdog: cancelWatchdog,
+		cfg:            cfg,
+		logger:         logger,
+	}
+}
+
+func (w *Watchdog) log(level logger.Level, action, message string, extraFields map[string]any) {
+	fields := map[string]any{
+		"component": "create_order_saga_watchdog",
+		"action":    action,
+	}
+	for k, v := range extraFields {
+		fields[k] = v
+	}
+
+	w.logger.Log(level, message, fields)
+}
+
+func (w *Watchdog) Start(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.started {
+		return errors.New("watchdog is already running, no need to start again.")
+	}
+
+	w.cancelCtx, w.cancelFunc = context.WithCancel(ctx)
+	w.started = true
+
+	w.log(logger.Info, "start", "Starting create order saga watchdog", map[string]any{
+		"step_deadline": w.cfg.StepDeadline.String(),
+		"poll_interval": w.cfg.PollInterval.String(),
+	})
+	w.wg.Add(1)
+	go w.watch(w.cancelCtx)
+	return nil
+}
+
+func (w *Watchdog) watch(ctx context.Context) {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.log(logger.Info, "stop", "Create order saga watchdog stopping", map[string]any{
+				"reason": ctx.Err().Error(),
+			})
+			return
+
+		case <-ticker.C:
+			w.resume(ctx)
+			w.compensate(ctx)
+			w.retryCancellations(ctx)
+		}
+	}
+}
+
+func (w *Watchdog) resume(ctx context.Context) {
+	startTime := time.Now()
+
+	resumed, err := w.watchdog.ResumeSuspended(ctx, w.cfg.LeaseDuration)
+
+	duration := time.Since(startTime)
+
+	if err != nil {
+		w.log(logger.Error, "resume_error", "Suspended saga resumption failed", map[string]any{
+			"resumed":     resumed,
+			"error":       err.Error(),
+			"duration_ms": duration.Milliseconds(),
+		})
+		return
+	}
+	if resumed > 0 {
+		w.log(logger.Info, "resume_success", "Suspended sagas resumed", map[string]any{
+			"resumed":     resumed,
+			"duration_ms": duration.Milliseconds(),
+		})
+	}
+}
+
+func (w *Watchdog) compensate(ctx context.Context) {
+	startTime := time.Now()
+
+	compensated, err := w.watchdog.CompensateStalled(ctx, w.cfg.StepDeadline, w.cfg.LeaseDuration)
+
+	duration := time.Since(startTime)
+
+	if err != nil {
+		w.log(logger.Error, "compensate_error", "Stalled saga compensation failed", map[string]any{
+			"compensated": compensated,
+			"error":       err.Error(),
+			"duration_ms": duration.Milliseconds(),
+		})
+		return
+	}
+	if compensated > 0 {
+		w.log(logger.Info, "compensate_success", "Stalled sagas compensated", map[string]any{
+			"compensated": compensated,
+			"duration_ms": duration.Milliseconds(),
+		})
+	}
+}
+
+func (w *Watchdog) retryCancellations(ctx context.Context) {
+	startTime := time.Now()
+
+	handled, err := w.cancelWatchdog.RetryStalled(ctx, w.cfg.StepDeadline, w.cfg.LeaseDuration)
+
+	duration := time.Since(startTime)
+
+	if err != nil {
+		w.log(logger.Error, "retry_cancellation_error", "Stalled cancellation retry failed", map[string]any{
+			"handled":     handled,
+			"error":       err.Error(),
+			"duration_ms": duration.Milliseconds(),
+		})
+		return
+	}
+	if handled > 0 {
+		w.log(logger.Info, "retry_cancellation_success", "Stalled cancellations retried", map[string]any{
+			"handled":     handled,
+			"duration_ms": duration.Milliseconds(),
+		})
+	}
+}
+
+func (w *Watchdog) Stop() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.started {
+		return errors.New("watchdog is not running or already stopped")
+	}
+
+	w.log(logger.Info, "stop_request", "Stopping create order saga watchdog", nil)
+	w.cancelFunc()
+	w.wg.Wait()
+	w.started = false
+
+	w.log(logger.Info, "stopped", "Create order saga watchdog stopped", nil)
+	return nil
+}
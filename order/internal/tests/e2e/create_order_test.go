@@ -8,13 +8,15 @@ import (
 	"net"
 	appDI "order/internal/application/di"
 	createOrder "order/internal/application/order/saga/create_order"
+	"order/internal/application/order/usecase"
 	"order/internal/infrastructure/db/migrations"
 	infraDI "order/internal/infrastructure/di"
 	"order/internal/infrastructure/logger"
-	createOrderPublisher "order/internal/infrastructure/publisher/saga/create_order"
+	outboxPublisher "order/internal/infrastructure/publisher/outbox"
 	presentationDI "order/internal/presentation/di"
 	orderv1 "order/internal/presentation/grpc"
 	"order/internal/tests/testutils"
+	"order/internal/tests/testutils/mothers"
 	"testing"
 	"time"
 
@@ -90,11 +92,16 @@ func (s *CreateOrderE2ESuite) BeforeAll(t provider.T) {
 		infraDI.DatabaseModule,
 		infraDI.RepositoryModule,
 		infraDI.PublisherModule,
+		infraDI.UowModule,
+		infraDI.OutboxProcessorModule,
 		infraDI.TelemetryModule,
 		appDI.UseCaseModule,
 		appDI.SagaModule,
 		presentationDI.GRPCModule,
 		presentationDI.TelemetryModule,
+		fx.Provide(func() usecase.Catalog {
+			return &testutils.StaticCatalog{Price: mothers.USD("100")}
+		}),
 		fx.Replace(log),
 		fx.Replace(tp),
 		fx.Replace(s.messaging.Cfg),
@@ -155,6 +162,16 @@ func (s *CreateOrderE2ESuite) clear(t provider.T) {
 	t.Require().NoError(err)
 }
 
+func testAddress() *orderv1.Address {
+	return &orderv1.Address{
+		Country:    "de",
+		City:       "Berlin",
+		Street:     "Unter den Linden",
+		House:      "1",
+		PostalCode: "10117",
+	}
+}
+
 func (s *CreateOrderE2ESuite) Test_CreateOrder_Success(t provider.T) {
 	// 1) Dial gRPC client
 	conn, err := grpc.NewClient(s.grpcURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -168,9 +185,9 @@ func (s *CreateOrderE2ESuite) Test_CreateOrder_Success(t provider.T) {
 	productID := uuid.New().String()
 	req := &orderv1.CreateOrderRequest{
 		CustomerId: customerID,
-		Address:    "Some Address",
+		Address:    testAddress(),
 		Items: []*orderv1.OrderItem{
-			{ProductId: productID, Price: 100.0, Count: 1},
+			{ProductId: productID, Price: &orderv1.Money{CurrencyCode: "USD", Units: 100}, Count: 1},
 		},
 	}
 
@@ -190,30 +207,80 @@ func (s *CreateOrderE2ESuite) Test_CreateOrder_Success(t provider.T) {
 	defer findCancel()
 	err = orders.FindOne(findCtx, bson.M{"_id": res.GetOrderId()}).Decode(&doc)
 	t.Require().NoError(err)
+	address := doc["delivery"].(bson.M)["address"].(bson.M)
+	t.Require().Equal("DE", address["country"])
+	t.Require().Equal("10117", address["postal_code"])
 
-	// 6) Assert Kafka saga message (ReserveItemsCmd) published to warehouse-topic
+	// 6) Assert Kafka saga message (ReserveItemsCmd) relayed from the outbox to warehouse-topic
 	reader, err := s.messaging.CreateReader(s.messaging.Cfg.WarehouseCmdTopic)
 	t.Require().NoError(err)
 	defer func() { _ = reader.Close() }()
 
-	readCtx, readCancel := context.WithTimeout(s.ctx, 5*time.Second)
+	readCtx, readCancel := context.WithTimeout(s.ctx, 10*time.Second)
 	defer readCancel()
 	msg, err := reader.ReadMessage(readCtx)
 	t.Require().NoError(err)
 
-	var cmdMessage createOrderPublisher.CmdMessage
+	var cmdMessage outboxPublisher.KafkaMessageValue
 	t.Require().NoError(json.Unmarshal(msg.Value, &cmdMessage))
-	t.Require().Equal(createOrderPublisher.ReserveItemsCmdName, cmdMessage.Name)
+	t.Require().Equal(createOrder.ReserveItemsCmdName, cmdMessage.Name)
 
-	payloadRaw, err := json.Marshal(cmdMessage.Payload)
-	t.Require().NoError(err)
 	var payload createOrder.ReserveItemsCmd
-	t.Require().NoError(json.Unmarshal(payloadRaw, &payload))
+	t.Require().NoError(json.Unmarshal(cmdMessage.Payload, &payload))
 
 	createdID := uuid.MustParse(res.GetOrderId())
 	t.Require().Equal(createdID, payload.OrderID)
 }
 
+func (s *CreateOrderE2ESuite) Test_CreateOrder_WithPromoCode(t provider.T) {
+	// 1) Dial gRPC client
+	conn, err := grpc.NewClient(s.grpcURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	t.Require().NoError(err)
+	defer func() { _ = conn.Close() }()
+
+	client := orderv1.NewOrderServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(s.ctx, 10*time.Second)
+	defer cancel()
+
+	// 2) Create a single-use 10% promotion
+	_, err = client.CreatePromotion(ctx, &orderv1.CreatePromotionRequest{
+		Code: "WELCOME10",
+		Rules: &orderv1.PromotionRules{
+			Kind:             orderv1.PromotionKind_PERCENTAGE,
+			Percent:          10,
+			PerCustomerLimit: 1,
+		},
+	})
+	t.Require().NoError(err)
+
+	// 3) Place an order with the code
+	req := &orderv1.CreateOrderRequest{
+		CustomerId: uuid.New().String(),
+		Address:    testAddress(),
+		Items: []*orderv1.OrderItem{
+			{ProductId: uuid.New().String(), Price: &orderv1.Money{CurrencyCode: "USD", Units: 100}, Count: 1},
+		},
+		PromoCode: "welcome10",
+	}
+	res, err := client.CreateOrder(ctx, req)
+	t.Require().NoError(err)
+
+	// 4) Assert the discount is stored on the order
+	orders := s.db.DB.Collection(s.db.Cfg.OrderCollection)
+	var doc bson.M
+	err = orders.FindOne(ctx, bson.M{"_id": res.GetOrderId()}).Decode(&doc)
+	t.Require().NoError(err)
+	t.Require().Equal("WELCOME10", doc["discount"].(bson.M)["code"])
+	t.Require().Equal("90", doc["total"].(bson.M)["amount"])
+
+	// 5) The same customer cannot use the code twice
+	_, err = client.CreateOrder(ctx, req)
+	t.Require().Error(err)
+	st, _ := status.FromError(err)
+	t.Require().Equal(codes.FailedPrecondition, st.Code())
+}
+
 func (s *CreateOrderE2ESuite) Test_CreateOrder_InvalidData(t provider.T) {
 	// 1) Dial gRPC client
 	conn, err := grpc.NewClient(s.grpcURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -222,14 +289,14 @@ func (s *CreateOrderE2ESuite) Test_CreateOrder_InvalidData(t provider.T) {
 
 	client := orderv1.NewOrderServiceClient(conn)
 
-	// 2) Build invalid request (empty address)
+	// 2) Build invalid request (address without a postal code)
 	customerID := uuid.New().String()
 	productID := uuid.New().String()
 	req := &orderv1.CreateOrderRequest{
 		CustomerId: customerID,
-		Address:    "", // invalid
+		Address:    &orderv1.Address{Country: "DE", City: "Berlin", Street: "Unter den Linden", House: "1"}, // invalid
 		Items: []*orderv1.OrderItem{
-			{ProductId: productID, Price: 100.0, Count: 1},
+			{ProductId: productID, Price: &orderv1.Money{CurrencyCode: "USD", Units: 100}, Count: 1},
 		},
 	}
 
@@ -0,0 +1,158 @@
+//go:build integration
+
+package messaging
+
+import (
+	"context"
+	"errors"
+	"order/internal/infrastructure/logger"
+	"order/internal/infrastructure/messaging"
+	"order/internal/infrastructure/messaging/dlq"
+	"order/internal/tests/testutils"
+	"testing"
+	"time"
+
+	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+)
+
+type DLQTestSuite struct {
+	suite.Suite
+	ctx context.Context
+
+	messaging *testutils.TestMessaging
+
+	dlqWriter    messaging.Writer
+	sourceReader *otelkafkakonsumer.Reader
+}
+
+func (s *DLQTestSuite) BeforeAll(t provider.T) {
+	tCfg, err := testutils.NewConfig()
+	t.Require().NoError(err)
+
+	s.ctx = context.Background()
+
+	s.messaging, err = testutils.NewTestMessaging(s.ctx, tCfg)
+	t.Require().NoError(err)
+}
+
+func (s *DLQTestSuite) AfterAll(t provider.T) {
+	if s.messaging != nil {
+		err := s.messaging.Close(s.ctx)
+		t.Require().NoError(err)
+	}
+}
+
+func (s *DLQTestSuite) BeforeEach(t provider.T) {
+	var err error
+
+	s.dlqWriter, err = s.messaging.CreateWriter(s.messaging.Cfg.OrderCmdDLQTopic)
+	t.Require().NoError(err)
+	s.sourceReader, err = s.messaging.CreateReader(s.messaging.Cfg.OrderCmdTopic)
+	t.Require().NoError(err)
+}
+
+func (s *DLQTestSuite) AfterEach(t provider.T) {
+	t.Require().NoError(s.dlqWriter.Close())
+	t.Require().NoError(s.sourceReader.Close())
+
+	err := s.messaging.Clear(s.ctx)
+	t.Require().NoError(err)
+}
+
+func (s *DLQTestSuite) sourceMessage() *kafka.Message {
+	return &kafka.Message{
+		Topic:     s.messaging.Cfg.OrderCmdTopic,
+		Partition: 0,
+		Offset:    42,
+		Key:       []byte("key"),
+		Value:     []byte(`{"ID":"00000000-0000-0000-0000-000000000000"}`),
+		Headers:   []kafka.Header{{Key: "origin", Value: []byte("test")}},
+	}
+}
+
+func (s *DLQTestSuite) TestWriteAndList(t provider.T) {
+	writer := dlq.NewWriter(s.dlqWriter, logger.NewLogger(logrus.New()))
+	source := s.sourceMessage()
+
+	err := writer.Write(s.ctx, source, errors.New("handler failed"), 3)
+	t.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(s.ctx, 10*time.Second)
+	defer cancel()
+
+	messages, err := dlq.NewInspector(s.messaging.Cfg.Address).List(ctx, s.messaging.Cfg.OrderCmdDLQTopic, 0)
+	t.Require().NoError(err)
+	t.Require().Len(messages, 1)
+
+	message := messages[0]
+	t.Require().Equal(source.Topic, message.SourceTopic)
+	t.Require().Equal(source.Partition, message.SourcePartition)
+	t.Require().Equal(source.Offset, message.SourceOffset)
+	t.Require().Equal("handler failed", message.Error)
+	t.Require().Equal(3, message.Attempts)
+	t.Require().WithinDuration(time.Now(), message.FailedAt, time.Minute)
+	t.Require().Equal(source.Key, message.Key)
+	t.Require().Equal(source.Value, message.Value)
+	t.Require().Contains(message.Headers, source.Headers[0])
+}
+
+func (s *DLQTestSuite) TestReplay(t provider.T) {
+	writer := dlq.NewWriter(s.dlqWriter, logger.NewLogger(logrus.New()))
+	source := s.sourceMessage()
+
+	err := writer.Write(s.ctx, source, errors.New("handler failed"), 3)
+	t.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(s.ctx, 10*time.Second)
+	defer cancel()
+
+	inspector := dlq.NewInspector(s.messaging.Cfg.Address)
+	messages, err := inspector.List(ctx, s.messaging.Cfg.OrderCmdDLQTopic, 0)
+	t.Require().NoError(err)
+
+	err = inspector.Replay(ctx, messages)
+	t.Require().NoError(err)
+
+	replayed, err := s.sourceReader.ReadMessage(ctx)
+	t.Require().NoError(err)
+	t.Require().Equal(source.Value, replayed.Value)
+	t.Require().Contains(replayed.Headers, source.Headers[0])
+	for _, header := range replayed.Headers {
+		t.Require().NotEqual(dlq.HeaderError, header.Key)
+	}
+}
+
+func (s *DLQTestSuite) TestGet(t provider.T) {
+	writer := dlq.NewWriter(s.dlqWriter, logger.NewLogger(logrus.New()))
+	for i := 0; i < 3; i++ {
+		source := s.sourceMessage()
+		source.Offset += int64(i)
+		err := writer.Write(s.ctx, source, errors.New("handler failed"), 3)
+		t.Require().NoError(err)
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx, 10*time.Second)
+	defer cancel()
+
+	inspector := dlq.NewInspector(s.messaging.Cfg.Address)
+	messages, err := inspector.List(ctx, s.messaging.Cfg.OrderCmdDLQTopic, 0)
+	t.Require().NoError(err)
+	t.Require().Len(messages, 3)
+	last := messages[2]
+
+	message, err := inspector.Get(ctx, s.messaging.Cfg.OrderCmdDLQTopic, last.Partition, last.Offset)
+	t.Require().NoError(err)
+	t.Require().Equal(last.Offset, message.Offset)
+	t.Require().Equal(last.SourceOffset, message.SourceOffset)
+
+	_, err = inspector.Get(ctx, s.messaging.Cfg.OrderCmdDLQTopic, last.Partition, last.Offset+1)
+	t.Require().ErrorIs(err, dlq.ErrMessageNotFound)
+}
+
+func TestDLQ(t *testing.T) {
+	suite.RunSuite(t, new(DLQTestSuite))
+}
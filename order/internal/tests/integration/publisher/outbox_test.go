@@ -0,0 +1,266 @@
+//go:build integration
+
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	createOrder "order/internal/application/order/saga/create_order"
+	orderUsecase "order/internal/application/order/usecase"
+	orderDomain "order/internal/domain/order"
+	outboxDomain "order/internal/domain/outbox"
+	"order/internal/infrastructure/messaging"
+	outboxPublisher "order/internal/infrastructure/publisher/outbox"
+	"order/internal/tests/testutils"
+	"testing"
+	"time"
+
+	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type OutboxPublisherTestSuite struct {
+	suite.Suite
+	ctx context.Context
+
+	messaging *testutils.TestMessaging
+
+	warehouseWriter messaging.Writer
+	warehouseReader *otelkafkakonsumer.Reader
+
+	orderWriter messaging.Writer
+	orderReader *otelkafkakonsumer.Reader
+
+	courierWriter messaging.Writer
+	courierReader *otelkafkakonsumer.Reader
+
+	eventWriter messaging.Writer
+	eventReader *otelkafkakonsumer.Reader
+}
+
+func (s *OutboxPublisherTestSuite) BeforeAll(t provider.T) {
+	tCfg, err := testutils.NewConfig()
+	t.Require().NoError(err)
+
+	s.ctx = context.Background()
+
+	testMessaging, err := testutils.NewTestMessaging(s.ctx, tCfg)
+	t.Require().NoError(err)
+	s.messaging = testMessaging
+
+	s.clear(t)
+}
+
+func (s *OutboxPublisherTestSuite) AfterAll(t provider.T) {
+	if s.messaging != nil {
+		err := s.messaging.Close(s.ctx)
+		t.Require().NoError(err)
+	}
+}
+
+func (s *OutboxPublisherTestSuite) BeforeEach(t provider.T) {
+	var err error
+
+	s.warehouseWriter, err = s.messaging.CreateWriter(s.messaging.Cfg.WarehouseCmdTopic)
+	t.Require().NoError(err)
+	s.warehouseReader, err = s.messaging.CreateReader(s.messaging.Cfg.WarehouseCmdTopic)
+	t.Require().NoError(err)
+
+	s.orderWriter, err = s.messaging.CreateWriter(s.messaging.Cfg.OrderCmdTopic)
+	t.Require().NoError(err)
+	s.orderReader, err = s.messaging.CreateReader(s.messaging.Cfg.OrderCmdTopic)
+	t.Require().NoError(err)
+
+	s.courierWriter, err = s.messaging.CreateWriter(s.messaging.Cfg.CourierCmdTopic)
+	t.Require().NoError(err)
+	s.courierReader, err = s.messaging.CreateReader(s.messaging.Cfg.CourierCmdTopic)
+	t.Require().NoError(err)
+
+	s.eventWriter, err = s.messaging.CreateWriter(s.messaging.Cfg.OrderEventTopic)
+	t.Require().NoError(err)
+	s.eventReader, err = s.messaging.CreateReader(s.messaging.Cfg.OrderEventTopic)
+	t.Require().NoError(err)
+}
+
+func (s *OutboxPublisherTestSuite) AfterEach(t provider.T) {
+	s.clear(t)
+}
+
+func (s *OutboxPublisherTestSuite) clear(t provider.T) {
+	if s.warehouseWriter != nil {
+		err := s.warehouseWriter.Close()
+		t.Require().NoError(err)
+		err = s.warehouseReader.Close()
+		t.Require().NoError(err)
+	}
+
+	if s.orderWriter != nil {
+		err := s.orderWriter.Close()
+		t.Require().NoError(err)
+		err = s.orderReader.Close()
+		t.Require().NoError(err)
+	}
+
+	if s.courierWriter != nil {
+		err := s.courierWriter.Close()
+		t.Require().NoError(err)
+		err = s.courierReader.Close()
+		t.Require().NoError(err)
+	}
+
+	if s.eventWriter != nil {
+		err := s.eventWriter.Close()
+		t.Require().NoError(err)
+		err = s.eventReader.Close()
+		t.Require().NoError(err)
+	}
+
+	err := s.messaging.Clear(s.ctx)
+	t.Require().NoError(err)
+}
+
+func (s *OutboxPublisherTestSuite) createTestPublisher() outboxDomain.Publisher {
+	return outboxPublisher.NewPublisher(s.warehouseWriter, s.orderWriter, s.courierWriter, s.eventWriter)
+}
+
+func (s *OutboxPublisherTestSuite) createMessage(t provider.T, name string, cmd any) *outboxDomain.Message {
+	message, err := outboxDomain.Create(name, uuid.NewString(), cmd)
+	t.Require().NoError(err)
+	return message
+}
+
+func (s *OutboxPublisherTestSuite) TestPublish(t provider.T) {
+	tests := []struct {
+		name          string
+		message       func(t provider.T) *outboxDomain.Message
+		reader        func() *otelkafkakonsumer.Reader
+		expectedError error
+	}{
+		{
+			name: "Success: Reserve items routed to warehouse",
+			message: func(t provider.T) *outboxDomain.Message {
+				return s.createMessage(t, createOrder.ReserveItemsCmdName, createOrder.ReserveItemsCmd{
+					OrderID: uuid.New(),
+					Items: []createOrder.OrderItem{
+						{
+							ProductID: uuid.New(),
+							Count:     1,
+						},
+					},
+				})
+			},
+			reader: func() *otelkafkakonsumer.Reader { return s.warehouseReader },
+		},
+		{
+			name: "Success: Release items routed to warehouse",
+			message: func(t provider.T) *outboxDomain.Message {
+				return s.createMessage(t, createOrder.ReleaseItemsCmdName, createOrder.ReleaseItemsCmd{
+					OrderID: uuid.New(),
+				})
+			},
+			reader: func() *otelkafkakonsumer.Reader { return s.warehouseReader },
+		},
+		{
+			name: "Success: Assign courier routed to courier",
+			message: func(t provider.T) *outboxDomain.Message {
+				return s.createMessage(t, createOrder.AssignCourierCmdName, createOrder.AssignCourierCmd{
+					OrderID: uuid.New(),
+				})
+			},
+			reader: func() *otelkafkakonsumer.Reader { return s.courierReader },
+		},
+		{
+			name: "Success: Reassign courier routed to courier",
+			message: func(t provider.T) *outboxDomain.Message {
+				return s.createMessage(t, orderUsecase.ReassignCourierCmdName, orderUsecase.ReassignCourierCmd{
+					OrderID:   uuid.New(),
+					CourierID: uuid.New(),
+				})
+			},
+			reader: func() *otelkafkakonsumer.Reader { return s.courierReader },
+		},
+		{
+			name: "Success: Begin delivery routed to order",
+			message: func(t provider.T) *outboxDomain.Message {
+				return s.createMessage(t, createOrder.BeginDeliveryCmdName, createOrder.BeginDeliveryCmd{
+					OrderID:   uuid.New(),
+					CourierID: uuid.New(),
+				})
+			},
+			reader: func() *otelkafkakonsumer.Reader { return s.orderReader },
+		},
+		{
+			name: "Success: Cancel timeout routed to order",
+			message: func(t provider.T) *outboxDomain.Message {
+				return s.createMessage(t, createOrder.CancelTimeoutCmdName, createOrder.CancelTimeoutCmd{
+					OrderID: uuid.New(),
+				})
+			},
+			reader: func() *otelkafkakonsumer.Reader { return s.orderReader },
+		},
+		{
+			name: "Success: Order canceled event routed to order events",
+			message: func(t provider.T) *outboxDomain.Message {
+				return s.createMessage(t, orderDomain.CanceledEventName, orderDomain.CanceledPayload{
+					SchemaVersion: orderDomain.EventSchemaVersion,
+					OrderID:       uuid.New(),
+					CustomerID:    uuid.New(),
+					Status:        orderDomain.CanceledTimeout,
+					Reason:        orderDomain.CancelReasonTimeout,
+					Occurred:      time.Now(),
+				})
+			},
+			reader: func() *otelkafkakonsumer.Reader { return s.eventReader },
+		},
+		{
+			name: "Failure: Invalid message name",
+			message: func(_ provider.T) *outboxDomain.Message {
+				return &outboxDomain.Message{
+					ID:      uuid.New(),
+					Name:    "unknown.command",
+					Payload: []byte(`{"test": "data"}`),
+				}
+			},
+			expectedError: outboxPublisher.ErrInvalidOutboxMessage,
+		},
+	}
+
+	publisher := s.createTestPublisher()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t provider.T) {
+			message := tt.message(t)
+
+			err := publisher.Publish(s.ctx, message)
+
+			if tt.expectedError != nil {
+				t.Require().Error(err)
+				t.Require().ErrorIs(err, tt.expectedError)
+			} else {
+				t.Require().NoError(err)
+
+				ctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
+				defer cancel()
+
+				kafkaMsg, err := tt.reader().ReadMessage(ctx)
+				t.Require().NoError(err)
+
+				var value outboxPublisher.KafkaMessageValue
+				err = json.Unmarshal(kafkaMsg.Value, &value)
+				t.Require().NoError(err)
+
+				t.Require().Equal(message.Key, string(kafkaMsg.Key))
+				t.Require().Equal(message.ID, value.ID)
+				t.Require().Equal(message.Name, value.Name)
+				t.Require().JSONEq(string(message.Payload), string(value.Payload))
+			}
+		})
+	}
+}
+
+func TestOutboxPublisherTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(OutboxPublisherTestSuite))
+}
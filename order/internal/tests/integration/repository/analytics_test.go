@@ -0,0 +1,253 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	analyticsDomain "order/internal/domain/analytics"
+	orderDomain "order/internal/domain/order"
+	"order/internal/infrastructure/db"
+	"order/internal/tests/testutils/builders"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+// The seeded period is the week starting on Monday periodStart.
+var (
+	periodStart = time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+	periodEnd   = periodStart.AddDate(0, 0, 7)
+	week        = analyticsDomain.Period{From: &periodStart, To: &periodEnd}
+)
+
+type AnalyticsRepositoryTestSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	backend db.Backend
+	store   *orderStore
+}
+
+func (s *AnalyticsRepositoryTestSuite) BeforeAll(t provider.T) {
+	s.ctx = context.Background()
+
+	var err error
+	s.store, err = newOrderStore(s.ctx, s.backend)
+	t.Require().NoError(err)
+
+	s.clear(t)
+	s.seed(t)
+}
+
+func (s *AnalyticsRepositoryTestSuite) AfterAll(t provider.T) {
+	if s.store != nil {
+		s.clear(t)
+		err := s.store.close(s.ctx)
+		t.Require().NoError(err)
+	}
+}
+
+func (s *AnalyticsRepositoryTestSuite) clear(t provider.T) {
+	ctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
+	defer cancel()
+
+	err := s.store.clear(ctx)
+	t.Require().NoError(err)
+}
+
+func (s *AnalyticsRepositoryTestSuite) getRepo() analyticsDomain.Repository {
+	return s.store.analytics()
+}
+
+// seed stores the orders every test reads:
+//   - Monday: two USD orders delivered in 30 and 90 minutes;
+//   - Tuesday: one order canceled by its customer while delivering, one out of stock;
+//   - Wednesday: one EUR order delivered in an hour;
+//   - Thursday: one order still placed;
+//   - the Monday after: one delivered order outside the period.
+func (s *AnalyticsRepositoryTestSuite) seed(t provider.T) {
+	repo := s.store.orders()
+	at := func(days, hours int) time.Time {
+		return periodStart.AddDate(0, 0, days).Add(time.Duration(hours) * time.Hour)
+	}
+
+	orders := []*orderDomain.Order{
+		delivered(at(0, 10), 30*time.Minute, mothers.USD("100")),
+		delivered(at(0, 12), 90*time.Minute, mothers.USD("50.25")),
+		withHistory(at(1, 9), orderDomain.CustomerCanceled, orderDomain.Delivering, orderDomain.Canceling),
+		withHistory(at(1, 11), orderDomain.CanceledOutOfStock),
+		delivered(at(2, 9), time.Hour, mothers.EUR("20")),
+		withHistory(at(3, 9), orderDomain.Created),
+		delivered(at(7, 9), time.Hour, mothers.USD("1000")),
+	}
+	for _, order := range orders {
+		t.Require().NoError(repo.Create(s.ctx, order))
+	}
+}
+
+func (s *AnalyticsRepositoryTestSuite) TestGetStatusFunnel(t provider.T) {
+	tests := []struct {
+		name     string
+		period   analyticsDomain.Period
+		expected *analyticsDomain.StatusFunnel
+	}{
+		{
+			name:   "Success: Period",
+			period: week,
+			expected: &analyticsDomain.StatusFunnel{
+				Total: 6,
+				Current: orderDomain.StatusCounts{
+					orderDomain.Created:            1,
+					orderDomain.Delivered:          3,
+					orderDomain.CustomerCanceled:   1,
+					orderDomain.CanceledOutOfStock: 1,
+				},
+				Reached: orderDomain.StatusCounts{
+					orderDomain.Created:            6,
+					orderDomain.Delivering:         4,
+					orderDomain.Delivered:          3,
+					orderDomain.Canceling:          1,
+					orderDomain.CustomerCanceled:   1,
+					orderDomain.CanceledOutOfStock: 1,
+				},
+			},
+		},
+		{
+			name:   "Success: Empty period",
+			period: analyticsDomain.Period{To: &periodStart},
+			expected: &analyticsDomain.StatusFunnel{
+				Current: orderDomain.StatusCounts{},
+				Reached: orderDomain.StatusCounts{},
+			},
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			funnel, err := repo.GetStatusFunnel(s.ctx, tc.period)
+
+			t.Require().NoError(err)
+			t.Require().Equal(tc.expected, funnel)
+		})
+	}
+}
+
+func (s *AnalyticsRepositoryTestSuite) TestGetRevenue(t provider.T) {
+	tests := []struct {
+		name        string
+		granularity analyticsDomain.Granularity
+		period      analyticsDomain.Period
+		expected    []analyticsDomain.RevenueBucket
+	}{
+		{
+			name:        "Success: By day",
+			granularity: analyticsDomain.Day,
+			period:      week,
+			expected: []analyticsDomain.RevenueBucket{
+				{Start: periodStart, Revenue: mothers.USD("150.25"), Orders: 2},
+				{Start: periodStart.AddDate(0, 0, 2), Revenue: mothers.EUR("20"), Orders: 1},
+			},
+		},
+		{
+			name:        "Success: By week",
+			granularity: analyticsDomain.Week,
+			period:      analyticsDomain.Period{From: week.From},
+			expected: []analyticsDomain.RevenueBucket{
+				{Start: periodStart, Revenue: mothers.EUR("20"), Orders: 1},
+				{Start: periodStart, Revenue: mothers.USD("150.25"), Orders: 2},
+				{Start: periodStart.AddDate(0, 0, 7), Revenue: mothers.USD("1000"), Orders: 1},
+			},
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			buckets, err := repo.GetRevenue(s.ctx, tc.period, tc.granularity)
+
+			t.Require().NoError(err)
+			t.Require().Len(buckets, len(tc.expected))
+			for i, bucket := range buckets {
+				t.Require().True(tc.expected[i].Start.Equal(bucket.Start))
+				t.Require().True(tc.expected[i].Revenue.Equal(bucket.Revenue), bucket.Revenue.String())
+				t.Require().Equal(tc.expected[i].Orders, bucket.Orders)
+			}
+		})
+	}
+}
+
+func (s *AnalyticsRepositoryTestSuite) TestGetDeliveryTime(t provider.T) {
+	repo := s.getRepo()
+
+	deliveryTime, err := repo.GetDeliveryTime(s.ctx, week)
+
+	t.Require().NoError(err)
+	t.Require().Equal(&analyticsDomain.DeliveryTime{Orders: 3, Average: time.Hour}, deliveryTime)
+}
+
+func (s *AnalyticsRepositoryTestSuite) TestGetCancellationReasons(t provider.T) {
+	repo := s.getRepo()
+
+	reasons, err := repo.GetCancellationReasons(s.ctx, week)
+
+	t.Require().NoError(err)
+	t.Require().Equal(analyticsDomain.CancellationReasons{
+		orderDomain.CancelReasonCustomer:   1,
+		orderDomain.CancelReasonOutOfStock: 1,
+	}, reasons)
+}
+
+// delivered is an order with a single line at the price, delivered after the
+// given time.
+func delivered(created time.Time, after time.Duration, price orderDomain.Money) *orderDomain.Order {
+	order := withHistory(created, orderDomain.Delivered, orderDomain.Delivering)
+	courierID := uuid.New()
+	arrived := created.Add(after)
+	order.Delivery.CourierID = &courierID
+	order.Delivery.Assigned = &created
+	order.Delivery.Arrived = &arrived
+	order.Items = []orderDomain.Item{{ProductID: uuid.New(), Name: "Product", Price: price, Count: 1}}
+	return order
+}
+
+// withHistory is an order in the status that went through the given statuses
+// after it was placed.
+func withHistory(created time.Time, status orderDomain.Status, through ...orderDomain.Status) *orderDomain.Order {
+	order := builders.NewOrderBuilder().
+		WithCreated(created).
+		WithStatus(status).
+		WithItems([]orderDomain.Item{{ProductID: uuid.New(), Name: "Product", Price: mothers.USD("1"), Count: 1}}).
+		Build()
+
+	order.History = []orderDomain.StatusChange{
+		{To: orderDomain.Created, Occurred: created, Actor: orderDomain.CustomerActor(order.CustomerID)},
+	}
+	previous := orderDomain.Created
+	for _, next := range append(through, status) {
+		if next == previous {
+			continue
+		}
+		order.History = append(order.History, orderDomain.StatusChange{
+			From:     previous,
+			To:       next,
+			Occurred: created,
+			Actor:    orderDomain.SystemActor(),
+		})
+		previous = next
+	}
+	return order
+}
+
+func TestAnalyticsRepositoryTestSuite(t *testing.T) {
+	for _, backend := range orderBackends {
+		suite.RunSuite(t, &AnalyticsRepositoryTestSuite{backend: backend})
+	}
+}
@@ -0,0 +1,173 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	analyticsDomain "order/internal/domain/analytics"
+	inboxDomain "order/internal/domain/inbox"
+	orderDomain "order/internal/domain/order"
+	outboxDomain "order/internal/domain/outbox"
+	promotionDomain "order/internal/domain/promotion"
+	sagaDomain "order/internal/domain/saga"
+	slotDomain "order/internal/domain/slot"
+	"order/internal/infrastructure/db"
+	"order/internal/infrastructure/db/migrations"
+	"order/internal/infrastructure/memory"
+	analyticsRepository "order/internal/infrastructure/repository/analytics"
+	analyticsPostgres "order/internal/infrastructure/repository/analytics/postgres"
+	inboxRepository "order/internal/infrastructure/repository/inbox"
+	inboxPostgres "order/internal/infrastructure/repository/inbox/postgres"
+	orderRepository "order/internal/infrastructure/repository/order"
+	orderPostgres "order/internal/infrastructure/repository/order/postgres"
+	outboxRepository "order/internal/infrastructure/repository/outbox"
+	outboxPostgres "order/internal/infrastructure/repository/outbox/postgres"
+	promotionRepository "order/internal/infrastructure/repository/promotion"
+	promotionPostgres "order/internal/infrastructure/repository/promotion/postgres"
+	sagaRepository "order/internal/infrastructure/repository/saga"
+	sagaPostgres "order/internal/infrastructure/repository/saga/postgres"
+	slotRepository "order/internal/infrastructure/repository/slot"
+	slotPostgres "order/internal/infrastructure/repository/slot/postgres"
+	"order/internal/tests/testutils"
+)
+
+// orderBackends are the databases the suites of the stores DB_ORDER_BACKEND
+// selects run against.
+var orderBackends = []db.Backend{db.BackendMongo, db.BackendPostgres, db.BackendMemory}
+
+// orderStore is the database of one order backend.
+type orderStore struct {
+	backend  db.Backend
+	mongo    *testutils.TestDB
+	postgres *testutils.TestPostgres
+	memory   *memory.Store
+}
+
+func newOrderStore(ctx context.Context, backend db.Backend) (*orderStore, error) {
+	if backend == db.BackendMemory {
+		return &orderStore{backend: backend, memory: memory.NewStore()}, nil
+	}
+
+	tCfg, err := testutils.NewConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	mCfg, err := migrations.NewConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	store := &orderStore{backend: backend}
+	if backend == db.BackendPostgres {
+		store.postgres, err = testutils.NewTestPostgres(ctx, tCfg, mCfg)
+	} else {
+		store.mongo, err = testutils.NewTestDB(ctx, tCfg, mCfg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func (s *orderStore) orders() orderDomain.Repository {
+	switch s.backend {
+	case db.BackendPostgres:
+		return orderPostgres.New(s.postgres.DB)
+	case db.BackendMemory:
+		return memory.NewOrderRepository(s.memory)
+	default:
+		return orderRepository.New(s.mongo.DB.Collection(s.mongo.Cfg.OrderCollection))
+	}
+}
+
+func (s *orderStore) analytics() analyticsDomain.Repository {
+	switch s.backend {
+	case db.BackendPostgres:
+		return analyticsPostgres.New(s.postgres.DB)
+	case db.BackendMemory:
+		return memory.NewAnalyticsRepository(s.memory)
+	default:
+		return analyticsRepository.New(s.mongo.DB.Collection(s.mongo.Cfg.OrderCollection))
+	}
+}
+
+func (s *orderStore) sagas() sagaDomain.Repository {
+	switch s.backend {
+	case db.BackendPostgres:
+		return sagaPostgres.New(s.postgres.DB)
+	case db.BackendMemory:
+		return memory.NewSagaRepository(s.memory)
+	default:
+		return sagaRepository.New(s.mongo.DB.Collection(s.mongo.Cfg.SagaCollection))
+	}
+}
+
+func (s *orderStore) outbox() outboxDomain.Repository {
+	switch s.backend {
+	case db.BackendPostgres:
+		return outboxPostgres.New(s.postgres.DB)
+	case db.BackendMemory:
+		return memory.NewOutboxRepository(s.memory)
+	default:
+		return outboxRepository.New(s.mongo.DB.Collection(s.mongo.Cfg.OutboxCollection))
+	}
+}
+
+func (s *orderStore) inbox() inboxDomain.Repository {
+	switch s.backend {
+	case db.BackendPostgres:
+		return inboxPostgres.New(s.postgres.DB)
+	case db.BackendMemory:
+		return memory.NewInboxRepository(s.memory)
+	default:
+		return inboxRepository.New(s.mongo.DB.Collection(s.mongo.Cfg.InboxCollection))
+	}
+}
+
+func (s *orderStore) promotions() promotionDomain.Repository {
+	switch s.backend {
+	case db.BackendPostgres:
+		return promotionPostgres.New(s.postgres.DB)
+	case db.BackendMemory:
+		return memory.NewPromotionRepository(s.memory)
+	default:
+		return promotionRepository.New(
+			s.mongo.DB.Collection(s.mongo.Cfg.PromotionCollection),
+			s.mongo.DB.Collection(s.mongo.Cfg.PromotionUsageCollection),
+		)
+	}
+}
+
+func (s *orderStore) slots() slotDomain.Repository {
+	switch s.backend {
+	case db.BackendPostgres:
+		return slotPostgres.New(s.postgres.DB)
+	case db.BackendMemory:
+		return memory.NewSlotRepository(s.memory)
+	default:
+		return slotRepository.New(s.mongo.DB.Collection(s.mongo.Cfg.DeliverySlotCollection))
+	}
+}
+
+func (s *orderStore) clear(ctx context.Context) error {
+	switch s.backend {
+	case db.BackendPostgres:
+		return s.postgres.Clear(ctx)
+	case db.BackendMemory:
+		return s.memory.Clear(ctx)
+	default:
+		return s.mongo.Clear(ctx)
+	}
+}
+
+func (s *orderStore) close(ctx context.Context) error {
+	switch s.backend {
+	case db.BackendPostgres:
+		return s.postgres.Close(ctx)
+	case db.BackendMemory:
+		return nil
+	default:
+		return s.mongo.Close(ctx)
+	}
+}
@@ -0,0 +1,185 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	inboxDomain "order/internal/domain/inbox"
+	"order/internal/infrastructure/db"
+	inboxRepository "order/internal/infrastructure/repository/inbox"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type InboxRepositoryTestSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	backend db.Backend
+	store   *orderStore
+}
+
+func (s *InboxRepositoryTestSuite) BeforeAll(t provider.T) {
+	s.ctx = context.Background()
+
+	var err error
+	s.store, err = newOrderStore(s.ctx, s.backend)
+	t.Require().NoError(err)
+
+	s.clear(t)
+}
+
+func (s *InboxRepositoryTestSuite) AfterAll(t provider.T) {
+	if s.store != nil {
+		err := s.store.close(s.ctx)
+		t.Require().NoError(err)
+	}
+}
+
+func (s *InboxRepositoryTestSuite) AfterEach(t provider.T) {
+	s.clear(t)
+}
+
+func (s *InboxRepositoryTestSuite) clear(t provider.T) {
+	ctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
+	defer cancel()
+
+	err := s.store.clear(ctx)
+	t.Require().NoError(err)
+}
+
+func (s *InboxRepositoryTestSuite) getRepo() inboxDomain.Repository {
+	return s.store.inbox()
+}
+
+func (s *InboxRepositoryTestSuite) TestCreate(t provider.T) {
+	tests := []struct {
+		name          string
+		setup         func(repo inboxDomain.Repository) *inboxDomain.Message
+		expectedError error
+	}{
+		{
+			name: "Success",
+			setup: func(_ inboxDomain.Repository) *inboxDomain.Message {
+				return mothers.InboxMessage()
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success: Without response",
+			setup: func(_ inboxDomain.Repository) *inboxDomain.Message {
+				message := mothers.InboxMessage()
+				message.Response = nil
+				return message
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failure: Message already exists",
+			setup: func(repo inboxDomain.Repository) *inboxDomain.Message {
+				message := mothers.InboxMessage()
+				err := repo.Create(s.ctx, message)
+				t.Require().NoError(err)
+				return message
+			},
+			expectedError: inboxRepository.ErrInboxMessageAlreadyExists,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			message := tc.setup(repo)
+			err := repo.Create(s.ctx, message)
+
+			if tc.expectedError != nil {
+				t.Require().Error(err)
+				t.Require().Equal(tc.expectedError, err)
+			} else {
+				t.Require().NoError(err)
+
+				createdMessage, err := repo.GetByID(s.ctx, message.ID)
+				t.Require().NoError(err)
+				t.Require().Equal(message.ID, createdMessage.ID)
+				t.Require().Equal(message.Response, createdMessage.Response)
+			}
+		})
+	}
+}
+
+func (s *InboxRepositoryTestSuite) TestGetByID(t provider.T) {
+	tests := []struct {
+		name          string
+		setup         func(repo inboxDomain.Repository) uuid.UUID
+		expectedError error
+	}{
+		{
+			name: "Success",
+			setup: func(repo inboxDomain.Repository) uuid.UUID {
+				message := mothers.InboxMessage()
+				err := repo.Create(s.ctx, message)
+				t.Require().NoError(err)
+				return message.ID
+			},
+		},
+		{
+			name: "Failure: Message not found",
+			setup: func(_ inboxDomain.Repository) uuid.UUID {
+				return uuid.New()
+			},
+			expectedError: inboxRepository.ErrInboxMessageNotFound,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			messageID := tc.setup(repo)
+
+			message, err := repo.GetByID(s.ctx, messageID)
+
+			if tc.expectedError != nil {
+				t.Require().Error(err)
+				t.Require().Equal(tc.expectedError, err)
+			} else {
+				t.Require().NoError(err)
+				t.Require().NotNil(message)
+				t.Require().Equal(messageID, message.ID)
+			}
+		})
+	}
+}
+
+func (s *InboxRepositoryTestSuite) TestDeleteExpired(t provider.T) {
+	repo := s.getRepo()
+
+	expired := mothers.InboxMessage()
+	expired.Expires = time.Now().Add(-time.Minute)
+	t.Require().NoError(repo.Create(s.ctx, expired))
+
+	retained := mothers.InboxMessage()
+	t.Require().NoError(repo.Create(s.ctx, retained))
+
+	err := repo.DeleteExpired(s.ctx, time.Now())
+	t.Require().NoError(err)
+
+	_, err = repo.GetByID(s.ctx, expired.ID)
+	t.Require().Equal(inboxRepository.ErrInboxMessageNotFound, err)
+
+	_, err = repo.GetByID(s.ctx, retained.ID)
+	t.Require().NoError(err)
+}
+
+func TestInboxRepository(t *testing.T) {
+	for _, backend := range orderBackends {
+		suite.RunSuite(t, &InboxRepositoryTestSuite{backend: backend})
+	}
+}
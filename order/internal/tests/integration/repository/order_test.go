@@ -5,9 +5,8 @@ package repository
 import (
 	"context"
 	orderDomain "order/internal/domain/order"
-	"order/internal/infrastructure/db/migrations"
+	"order/internal/infrastructure/db"
 	orderRepository "order/internal/infrastructure/repository/order"
-	"order/internal/tests/testutils"
 	"order/internal/tests/testutils/mothers"
 	"testing"
 	"time"
@@ -15,38 +14,34 @@ import (
 	"github.com/google/uuid"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/suite"
-	"go.mongodb.org/mongo-driver/mongo"
 )
 
+var firstPage = orderDomain.ListQuery{Sort: orderDomain.NewestFirst, Limit: orderDomain.DefaultPageSize}
+
+// OrderRepositoryTestSuite runs against the order backend it is given, so
+// both repositories are held to the same behavior.
 type OrderRepositoryTestSuite struct {
 	suite.Suite
 
 	ctx context.Context
 
-	db              *testutils.TestDB
-	orderCollection *mongo.Collection
+	backend db.Backend
+	store   *orderStore
 }
 
 func (s *OrderRepositoryTestSuite) BeforeAll(t provider.T) {
-	tCfg, err := testutils.NewConfig()
-	t.Require().NoError(err)
-
-	mCfg, err := migrations.NewConfig()
-	t.Require().NoError(err)
-
 	s.ctx = context.Background()
 
-	s.db, err = testutils.NewTestDB(s.ctx, tCfg, mCfg)
+	var err error
+	s.store, err = newOrderStore(s.ctx, s.backend)
 	t.Require().NoError(err)
 
 	s.clear(t)
-
-	s.orderCollection = s.db.DB.Collection(s.db.Cfg.OrderCollection)
 }
 
 func (s *OrderRepositoryTestSuite) AfterAll(t provider.T) {
-	if s.db != nil {
-		err := s.db.Close(s.ctx)
+	if s.store != nil {
+		err := s.store.close(s.ctx)
 		t.Require().NoError(err)
 	}
 }
@@ -59,12 +54,12 @@ func (s *OrderRepositoryTestSuite) clear(t provider.T) {
 	ctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
 	defer cancel()
 
-	err := s.db.Clear(ctx)
+	err := s.store.clear(ctx)
 	t.Require().NoError(err)
 }
 
 func (s *OrderRepositoryTestSuite) getRepo() orderDomain.Repository {
-	return orderRepository.New(s.orderCollection)
+	return s.store.orders()
 }
 
 func (s *OrderRepositoryTestSuite) TestCreate(t provider.T) {
@@ -80,6 +75,42 @@ func (s *OrderRepositoryTestSuite) TestCreate(t provider.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name: "Success: Price snapshot",
+			setup: func(_ orderDomain.Repository) *orderDomain.Order {
+				return mothers.OrderWithItems()
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success: Discount",
+			setup: func(_ orderDomain.Repository) *orderDomain.Order {
+				order := mothers.OrderWithItems()
+				t.Require().NoError(order.ApplyDiscount("SAVE", mothers.USD("1.25")))
+				return order
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success: Address with location and instructions",
+			setup: func(_ orderDomain.Repository) *orderDomain.Order {
+				order := mothers.DefaultOrder()
+				order.Delivery.Address.Apartment = "12"
+				order.Delivery.Address.Location = &orderDomain.Location{Latitude: 52.5170365, Longitude: 13.3888599}
+				order.Delivery.Address.Instructions = "Ring twice"
+				return order
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success: Legacy address",
+			setup: func(_ orderDomain.Repository) *orderDomain.Order {
+				order := mothers.DefaultOrder()
+				order.Delivery.Address = mothers.LegacyAddress()
+				return order
+			},
+			expectedError: nil,
+		},
 		{
 			name: "Failure: Order already exists",
 			setup: func(repo orderDomain.Repository) *orderDomain.Order {
@@ -109,6 +140,14 @@ func (s *OrderRepositoryTestSuite) TestCreate(t provider.T) {
 				t.Require().NoError(err)
 				t.Require().NotNil(createdOrder)
 				t.Require().Equal(order.ID, createdOrder.ID)
+				t.Require().Len(createdOrder.Items, len(order.Items))
+				for i, item := range order.Items {
+					t.Require().Equal(item.Name, createdOrder.Items[i].Name)
+					t.Require().True(item.Price.Equal(createdOrder.Items[i].Price))
+				}
+				t.Require().True(order.Total().Equal(createdOrder.Total()))
+				t.Require().Equal(order.Discount, createdOrder.Discount)
+				t.Require().Equal(order.Delivery.Address, createdOrder.Delivery.Address)
 			}
 		})
 	}
@@ -131,7 +170,7 @@ func (s *OrderRepositoryTestSuite) TestUpdate(t provider.T) {
 				return order
 			},
 			update: func(order *orderDomain.Order) {
-				err := order.NoteDelivering(uuid.New())
+				err := order.NoteDelivering(uuid.New(), uuid.New())
 				t.Require().NoError(err)
 			},
 			verify: func(updated, expected *orderDomain.Order) {
@@ -140,6 +179,29 @@ func (s *OrderRepositoryTestSuite) TestUpdate(t provider.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name: "Success: Update history",
+			setup: func(repo orderDomain.Repository) *orderDomain.Order {
+				order := mothers.DefaultOrder()
+				err := repo.Create(s.ctx, order)
+				t.Require().NoError(err)
+				return order
+			},
+			update: func(order *orderDomain.Order) {
+				err := order.NoteDelivering(uuid.New(), uuid.New())
+				t.Require().NoError(err)
+			},
+			verify: func(updated, expected *orderDomain.Order) {
+				t.Require().Len(updated.History, 1)
+				change, expectedChange := updated.History[0], expected.History[0]
+				t.Require().Equal(orderDomain.Created, change.From)
+				t.Require().Equal(orderDomain.Delivering, change.To)
+				t.Require().Equal(expectedChange.Actor, change.Actor)
+				t.Require().Equal(expectedChange.MessageID, change.MessageID)
+				t.Require().WithinDuration(expectedChange.Occurred, change.Occurred, time.Millisecond)
+			},
+			expectedError: nil,
+		},
 		{
 			name: "Success: Update delivery",
 			setup: func(repo orderDomain.Repository) *orderDomain.Order {
@@ -149,7 +211,7 @@ func (s *OrderRepositoryTestSuite) TestUpdate(t provider.T) {
 				return order
 			},
 			update: func(order *orderDomain.Order) {
-				err := order.NoteDelivering(uuid.New())
+				err := order.NoteDelivering(uuid.New(), uuid.New())
 				t.Require().NoError(err)
 			},
 			verify: func(updated, expected *orderDomain.Order) {
@@ -287,15 +349,16 @@ func (s *OrderRepositoryTestSuite) TestGetAllByCustomer(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			orderIDs := tc.setup(repo, tc.customerID)
 
-			orders, err := repo.GetAllByCustomer(s.ctx, tc.customerID)
+			page, err := repo.GetAllByCustomer(s.ctx, tc.customerID, firstPage)
 
 			if tc.expectedError != nil {
 				t.Require().Error(err)
 				t.Require().Equal(tc.expectedError, err)
 			} else {
 				t.Require().NoError(err)
-				t.Require().Equal(len(orderIDs), len(orders))
-				for _, order := range orders {
+				t.Require().Equal(len(orderIDs), len(page.Orders))
+				t.Require().Empty(page.NextCursor)
+				for _, order := range page.Orders {
 					t.Require().Contains(orderIDs, order.ID)
 				}
 			}
@@ -305,48 +368,51 @@ func (s *OrderRepositoryTestSuite) TestGetAllByCustomer(t provider.T) {
 
 func (s *OrderRepositoryTestSuite) TestGetCurrentByCourier(t provider.T) {
 	tests := []struct {
-		name          string
-		courierID     uuid.UUID
-		setup         func(repo orderDomain.Repository, courierID uuid.UUID) []uuid.UUID
-		expectedError error
+		name      string
+		courierID uuid.UUID
+		setup     func(repo orderDomain.Repository, courierID uuid.UUID) []uuid.UUID
 	}{
 		{
-			name:      "Success: One order",
-			courierID: uuid.New(),
-			setup: func(repo orderDomain.Repository, courierID uuid.UUID) []uuid.UUID {
-				order := mothers.OrderDelivering()
-				order.Delivery.CourierID = &courierID
-
-				err := repo.Create(s.ctx, order)
-				t.Require().NoError(err)
-
-				return []uuid.UUID{order.ID}
-			},
-		},
-		{
-			name:      "Success: Multiple orders",
+			name:      "Success: Delivering orders by assignment time",
 			courierID: uuid.New(),
 			setup: func(repo orderDomain.Repository, courierID uuid.UUID) []uuid.UUID {
+				base := time.Now().UTC().Truncate(time.Millisecond)
 				var orderIDs []uuid.UUID
-				for i := 0; i < 3; i++ {
+				for i := 2; i >= 0; i-- {
 					order := mothers.OrderDelivering()
 					order.Delivery.CourierID = &courierID
+					assigned := base.Add(time.Duration(i) * time.Minute)
+					order.Delivery.Assigned = &assigned
 
 					err := repo.Create(s.ctx, order)
 					t.Require().NoError(err)
 
-					orderIDs = append(orderIDs, order.ID)
+					orderIDs = append([]uuid.UUID{order.ID}, orderIDs...)
 				}
 				return orderIDs
 			},
 		},
+		{
+			name:      "Success: Finished orders are excluded",
+			courierID: uuid.New(),
+			setup: func(repo orderDomain.Repository, courierID uuid.UUID) []uuid.UUID {
+				delivering := mothers.OrderDelivering()
+				delivering.Delivery.CourierID = &courierID
+				t.Require().NoError(repo.Create(s.ctx, delivering))
+
+				delivered := mothers.OrderDelivered()
+				delivered.Delivery.CourierID = &courierID
+				t.Require().NoError(repo.Create(s.ctx, delivered))
+
+				return []uuid.UUID{delivering.ID}
+			},
+		},
 		{
 			name:      "Success: No orders",
 			courierID: uuid.New(),
 			setup: func(_ orderDomain.Repository, _ uuid.UUID) []uuid.UUID {
 				return []uuid.UUID{}
 			},
-			expectedError: nil,
 		},
 	}
 
@@ -358,20 +424,251 @@ func (s *OrderRepositoryTestSuite) TestGetCurrentByCourier(t provider.T) {
 
 			orders, err := repo.GetCurrentByCourier(s.ctx, tc.courierID)
 
-			if tc.expectedError != nil {
-				t.Require().Error(err)
-				t.Require().Equal(tc.expectedError, err)
-			} else {
-				t.Require().NoError(err)
-				t.Require().Equal(len(orderIDs), len(orders))
-				for _, order := range orders {
-					t.Require().Contains(orderIDs, order.ID)
-				}
+			t.Require().NoError(err)
+			ids := make([]uuid.UUID, 0, len(orders))
+			for _, order := range orders {
+				t.Require().Equal(orderDomain.Delivering, order.Status)
+				ids = append(ids, order.ID)
 			}
+			t.Require().Equal(orderIDs, ids)
 		})
 	}
 }
 
+func (s *OrderRepositoryTestSuite) TestGetHistoryByCourier(t provider.T) {
+	courierID := uuid.New()
+	base := time.Now().UTC().Truncate(time.Millisecond)
+
+	repo := s.getRepo()
+	statuses := []orderDomain.Status{orderDomain.Delivered, orderDomain.Delivered, orderDomain.Delivering}
+	var created []*orderDomain.Order
+	for i, status := range statuses {
+		order := mothers.OrderDelivered()
+		order.Status = status
+		order.Delivery.CourierID = &courierID
+		order.Created = base.Add(time.Duration(i) * time.Minute)
+		t.Require().NoError(repo.Create(s.ctx, order))
+		created = append(created, order)
+	}
+
+	t.Run("Success: Paged history", func(t provider.T) {
+		query := orderDomain.ListQuery{Sort: orderDomain.NewestFirst, Limit: 2}
+		page, err := repo.GetHistoryByCourier(s.ctx, courierID, query)
+		t.Require().NoError(err)
+		t.Require().Len(page.Orders, 2)
+		t.Require().Equal(created[2].ID, page.Orders[0].ID)
+		t.Require().NotEmpty(page.NextCursor)
+
+		query.Cursor = page.NextCursor
+		page, err = repo.GetHistoryByCourier(s.ctx, courierID, query)
+		t.Require().NoError(err)
+		t.Require().Len(page.Orders, 1)
+		t.Require().Equal(created[0].ID, page.Orders[0].ID)
+		t.Require().Empty(page.NextCursor)
+	})
+
+	t.Run("Success: Counts per status", func(t provider.T) {
+		counts, err := repo.CountByCourier(s.ctx, courierID, nil, nil)
+		t.Require().NoError(err)
+		t.Require().Equal(orderDomain.StatusCounts{
+			orderDomain.Delivered:  2,
+			orderDomain.Delivering: 1,
+		}, counts)
+	})
+
+	t.Run("Success: Counts within created range", func(t provider.T) {
+		from := created[1].Created
+		counts, err := repo.CountByCourier(s.ctx, courierID, &from, nil)
+		t.Require().NoError(err)
+		t.Require().Equal(orderDomain.StatusCounts{
+			orderDomain.Delivered:  1,
+			orderDomain.Delivering: 1,
+		}, counts)
+	})
+
+	t.Run("Success: No orders", func(t provider.T) {
+		counts, err := repo.CountByCourier(s.ctx, uuid.New(), nil, nil)
+		t.Require().NoError(err)
+		t.Require().Empty(counts)
+	})
+
+	t.Run("Success: Reassigned order kept in the previous courier's history", func(t provider.T) {
+		previousID, nextID := uuid.New(), uuid.New()
+		order := mothers.DefaultOrder()
+		t.Require().NoError(order.NoteDelivering(previousID, uuid.New()))
+		t.Require().NoError(order.ReassignCourier(nextID, "Courier is ill"))
+		t.Require().NoError(repo.Create(s.ctx, order))
+
+		for _, id := range []uuid.UUID{previousID, nextID} {
+			page, err := repo.GetHistoryByCourier(s.ctx, id, orderDomain.ListQuery{Sort: orderDomain.NewestFirst, Limit: 10})
+			t.Require().NoError(err)
+			t.Require().Len(page.Orders, 1)
+			t.Require().Equal(order.ID, page.Orders[0].ID)
+
+			counts, err := repo.CountByCourier(s.ctx, id, nil, nil)
+			t.Require().NoError(err)
+			t.Require().Equal(orderDomain.StatusCounts{orderDomain.Delivering: 1}, counts)
+		}
+	})
+}
+
+func (s *OrderRepositoryTestSuite) TestSearch(t provider.T) {
+	customerID := uuid.New()
+	courierID := uuid.New()
+	productID := uuid.New()
+
+	repo := s.getRepo()
+	items := []orderDomain.Item{{ProductID: productID, Name: "Product", Price: mothers.USD("100"), Count: 1}}
+
+	placed := mothers.DefaultOrder()
+	placed.CustomerID = customerID
+	placed.Items = items
+	t.Require().NoError(repo.Create(s.ctx, placed))
+
+	delivering := mothers.OrderDelivering()
+	delivering.CustomerID = customerID
+	delivering.Delivery.CourierID = &courierID
+	t.Require().NoError(repo.Create(s.ctx, delivering))
+
+	other := mothers.DefaultOrder()
+	other.Items = items
+	t.Require().NoError(repo.Create(s.ctx, other))
+
+	tests := []struct {
+		name     string
+		filter   orderDomain.SearchFilter
+		query    orderDomain.ListQuery
+		expected []uuid.UUID
+	}{
+		{
+			name:     "Success: By customer",
+			filter:   orderDomain.SearchFilter{CustomerID: &customerID},
+			query:    firstPage,
+			expected: []uuid.UUID{placed.ID, delivering.ID},
+		},
+		{
+			name:     "Success: By courier",
+			filter:   orderDomain.SearchFilter{CourierID: &courierID},
+			query:    firstPage,
+			expected: []uuid.UUID{delivering.ID},
+		},
+		{
+			name:     "Success: By product",
+			filter:   orderDomain.SearchFilter{ProductID: &productID},
+			query:    firstPage,
+			expected: []uuid.UUID{placed.ID, other.ID},
+		},
+		{
+			name:     "Success: By customer and product",
+			filter:   orderDomain.SearchFilter{CustomerID: &customerID, ProductID: &productID},
+			query:    firstPage,
+			expected: []uuid.UUID{placed.ID},
+		},
+		{
+			name:   "Success: By customer and status",
+			filter: orderDomain.SearchFilter{CustomerID: &customerID},
+			query: orderDomain.ListQuery{
+				Statuses: []orderDomain.Status{orderDomain.Delivering},
+				Sort:     firstPage.Sort,
+				Limit:    firstPage.Limit,
+			},
+			expected: []uuid.UUID{delivering.ID},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			page, err := repo.Search(s.ctx, tc.filter, tc.query)
+
+			t.Require().NoError(err)
+			found := make([]uuid.UUID, 0, len(page.Orders))
+			for _, order := range page.Orders {
+				found = append(found, order.ID)
+			}
+			t.Require().ElementsMatch(tc.expected, found)
+			t.Require().Empty(page.NextCursor)
+		})
+	}
+}
+
+func (s *OrderRepositoryTestSuite) TestGetAllByCustomerPagination(t provider.T) {
+	customerID := uuid.New()
+	base := time.Now().UTC().Truncate(time.Millisecond)
+
+	repo := s.getRepo()
+	var created []*orderDomain.Order
+	for i := 0; i < 5; i++ {
+		order := mothers.DefaultOrder()
+		order.CustomerID = customerID
+		order.Created = base.Add(time.Duration(i) * time.Minute)
+		if i%2 == 1 {
+			order.Status = orderDomain.Delivered
+		}
+		t.Require().NoError(repo.Create(s.ctx, order))
+		created = append(created, order)
+	}
+
+	collect := func(query orderDomain.ListQuery) []uuid.UUID {
+		var ids []uuid.UUID
+		for {
+			page, err := repo.GetAllByCustomer(s.ctx, customerID, query)
+			t.Require().NoError(err)
+			t.Require().LessOrEqual(len(page.Orders), query.Limit)
+			for _, order := range page.Orders {
+				ids = append(ids, order.ID)
+			}
+			if page.NextCursor == "" {
+				return ids
+			}
+			query.Cursor = page.NextCursor
+		}
+	}
+
+	t.Run("Success: Newest first across pages", func(t provider.T) {
+		ids := collect(orderDomain.ListQuery{Sort: orderDomain.NewestFirst, Limit: 2})
+		t.Require().Equal([]uuid.UUID{
+			created[4].ID, created[3].ID, created[2].ID, created[1].ID, created[0].ID,
+		}, ids)
+	})
+
+	t.Run("Success: Oldest first across pages", func(t provider.T) {
+		ids := collect(orderDomain.ListQuery{Sort: orderDomain.OldestFirst, Limit: 2})
+		t.Require().Equal([]uuid.UUID{
+			created[0].ID, created[1].ID, created[2].ID, created[3].ID, created[4].ID,
+		}, ids)
+	})
+
+	t.Run("Success: Status filter", func(t provider.T) {
+		ids := collect(orderDomain.ListQuery{
+			Statuses: []orderDomain.Status{orderDomain.Delivered},
+			Sort:     orderDomain.OldestFirst,
+			Limit:    1,
+		})
+		t.Require().Equal([]uuid.UUID{created[1].ID, created[3].ID}, ids)
+	})
+
+	t.Run("Success: Created range", func(t provider.T) {
+		from := created[1].Created
+		to := created[3].Created
+		ids := collect(orderDomain.ListQuery{
+			CreatedFrom: &from,
+			CreatedTo:   &to,
+			Sort:        orderDomain.OldestFirst,
+			Limit:       10,
+		})
+		t.Require().Equal([]uuid.UUID{created[1].ID, created[2].ID}, ids)
+	})
+
+	t.Run("Failure: Invalid cursor", func(t provider.T) {
+		query := orderDomain.ListQuery{Sort: orderDomain.NewestFirst, Limit: 2, Cursor: "not-a-cursor"}
+		_, err := repo.GetAllByCustomer(s.ctx, customerID, query)
+		t.Require().ErrorIs(err, orderRepository.ErrInvalidCursor)
+	})
+}
+
 func TestOrderRepository(t *testing.T) {
-	suite.RunSuite(t, new(OrderRepositoryTestSuite))
+	for _, backend := range orderBackends {
+		suite.RunSuite(t, &OrderRepositoryTestSuite{backend: backend})
+	}
 }
@@ -0,0 +1,370 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"errors"
+	outboxDomain "order/internal/domain/outbox"
+	"order/internal/infrastructure/db"
+	outboxRepository "order/internal/infrastructure/repository/outbox"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type OutboxRepositoryTestSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	backend db.Backend
+	store   *orderStore
+}
+
+func (s *OutboxRepositoryTestSuite) BeforeAll(t provider.T) {
+	s.ctx = context.Background()
+
+	var err error
+	s.store, err = newOrderStore(s.ctx, s.backend)
+	t.Require().NoError(err)
+
+	s.clear(t)
+}
+
+func (s *OutboxRepositoryTestSuite) AfterAll(t provider.T) {
+	if s.store != nil {
+		err := s.store.close(s.ctx)
+		t.Require().NoError(err)
+	}
+}
+
+func (s *OutboxRepositoryTestSuite) AfterEach(t provider.T) {
+	s.clear(t)
+}
+
+func (s *OutboxRepositoryTestSuite) clear(t provider.T) {
+	ctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
+	defer cancel()
+
+	err := s.store.clear(ctx)
+	t.Require().NoError(err)
+}
+
+func (s *OutboxRepositoryTestSuite) getRepo() outboxDomain.Repository {
+	return s.store.outbox()
+}
+
+func (s *OutboxRepositoryTestSuite) TestCreate(t provider.T) {
+	tests := []struct {
+		name          string
+		setup         func(repo outboxDomain.Repository) *outboxDomain.Message
+		expectedError error
+	}{
+		{
+			name: "Success",
+			setup: func(_ outboxDomain.Repository) *outboxDomain.Message {
+				return mothers.OutboxMessage()
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failure: Message already exists",
+			setup: func(repo outboxDomain.Repository) *outboxDomain.Message {
+				message := mothers.OutboxMessage()
+				err := repo.Create(s.ctx, message)
+				t.Require().NoError(err)
+				return message
+			},
+			expectedError: outboxRepository.ErrOutboxMessageAlreadyExists,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			message := tc.setup(repo)
+
+			err := repo.Create(s.ctx, message)
+
+			if tc.expectedError != nil {
+				t.Require().Error(err)
+				t.Require().Equal(tc.expectedError, err)
+			} else {
+				t.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *OutboxRepositoryTestSuite) TestCreateOnce(t provider.T) {
+	tests := []struct {
+		name         string
+		setup        func(repo outboxDomain.Repository) *outboxDomain.Message
+		keepsPending bool
+	}{
+		{
+			name: "Success: Message stored",
+			setup: func(_ outboxDomain.Repository) *outboxDomain.Message {
+				return mothers.OutboxMessage()
+			},
+		},
+		{
+			name: "Success: Pending message left as it is",
+			setup: func(repo outboxDomain.Repository) *outboxDomain.Message {
+				message := mothers.OutboxMessage()
+				err := repo.Create(s.ctx, message)
+				t.Require().NoError(err)
+				return message
+			},
+			keepsPending: true,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			s.clear(t)
+			message := tc.setup(repo)
+
+			again := mothers.OutboxMessage()
+			again.ID = message.ID
+			again.Payload = []byte(`{"again":true}`)
+			err := repo.CreateOnce(s.ctx, again)
+			t.Require().NoError(err)
+
+			claimed, err := repo.ClaimPending(s.ctx, time.Now().Add(time.Minute))
+			t.Require().NoError(err)
+			t.Require().NotNil(claimed)
+			t.Require().Equal(message.ID, claimed.ID)
+			expected := again
+			if tc.keepsPending {
+				expected = message
+			}
+			t.Require().JSONEq(string(expected.Payload), string(claimed.Payload))
+
+			next, err := repo.ClaimPending(s.ctx, time.Now().Add(time.Minute))
+			t.Require().NoError(err)
+			t.Require().Nil(next)
+		})
+	}
+}
+
+func (s *OutboxRepositoryTestSuite) TestDelete(t provider.T) {
+	tests := []struct {
+		name          string
+		setup         func(repo outboxDomain.Repository) *outboxDomain.Message
+		expectedError error
+	}{
+		{
+			name: "Success",
+			setup: func(repo outboxDomain.Repository) *outboxDomain.Message {
+				message := mothers.OutboxMessage()
+				err := repo.Create(s.ctx, message)
+				t.Require().NoError(err)
+				return message
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failure: Message not found",
+			setup: func(_ outboxDomain.Repository) *outboxDomain.Message {
+				return mothers.OutboxMessage()
+			},
+			expectedError: outboxRepository.ErrOutboxMessageNotFound,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			message := tc.setup(repo)
+
+			err := repo.Delete(s.ctx, message)
+
+			if tc.expectedError != nil {
+				t.Require().Error(err)
+				t.Require().Equal(tc.expectedError, err)
+			} else {
+				t.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *OutboxRepositoryTestSuite) TestClaimPending(t provider.T) {
+	tests := []struct {
+		name       string
+		setup      func(repo outboxDomain.Repository) uuid.UUID
+		expectedID func(id uuid.UUID) uuid.UUID
+	}{
+		{
+			name: "Success: Due message claimed",
+			setup: func(repo outboxDomain.Repository) uuid.UUID {
+				message := mothers.OutboxMessage()
+				err := repo.Create(s.ctx, message)
+				t.Require().NoError(err)
+				return message.ID
+			},
+			expectedID: func(id uuid.UUID) uuid.UUID { return id },
+		},
+		{
+			name: "Success: Message scheduled for retry skipped",
+			setup: func(repo outboxDomain.Repository) uuid.UUID {
+				message := mothers.OutboxMessage()
+				message.NoteFailedAttempt(time.Now().Add(time.Hour))
+				err := repo.Create(s.ctx, message)
+				t.Require().NoError(err)
+				return message.ID
+			},
+			expectedID: func(_ uuid.UUID) uuid.UUID { return uuid.Nil },
+		},
+		{
+			name: "Success: Leased message skipped",
+			setup: func(repo outboxDomain.Repository) uuid.UUID {
+				message := mothers.OutboxMessage()
+				err := repo.Create(s.ctx, message)
+				t.Require().NoError(err)
+
+				claimed, err := repo.ClaimPending(s.ctx, time.Now().Add(time.Hour))
+				t.Require().NoError(err)
+				t.Require().NotNil(claimed)
+				return message.ID
+			},
+			expectedID: func(_ uuid.UUID) uuid.UUID { return uuid.Nil },
+		},
+		{
+			name: "Success: Message with expired lease claimed",
+			setup: func(repo outboxDomain.Repository) uuid.UUID {
+				message := mothers.OutboxMessage()
+				err := repo.Create(s.ctx, message)
+				t.Require().NoError(err)
+
+				claimed, err := repo.ClaimPending(s.ctx, time.Now().Add(-time.Second))
+				t.Require().NoError(err)
+				t.Require().NotNil(claimed)
+				return message.ID
+			},
+			expectedID: func(id uuid.UUID) uuid.UUID { return id },
+		},
+		{
+			name: "Success: Updated message released",
+			setup: func(repo outboxDomain.Repository) uuid.UUID {
+				message := mothers.OutboxMessage()
+				err := repo.Create(s.ctx, message)
+				t.Require().NoError(err)
+
+				claimed, err := repo.ClaimPending(s.ctx, time.Now().Add(time.Hour))
+				t.Require().NoError(err)
+				t.Require().NotNil(claimed)
+
+				claimed.NoteFailedAttempt(time.Now().Add(-time.Second))
+				err = repo.Update(s.ctx, claimed)
+				t.Require().NoError(err)
+				return message.ID
+			},
+			expectedID: func(id uuid.UUID) uuid.UUID { return id },
+		},
+		{
+			name: "Success: Message behind a retry of its key skipped",
+			setup: func(repo outboxDomain.Repository) uuid.UUID {
+				first := mothers.OutboxMessage()
+				first.NoteFailedAttempt(time.Now().Add(time.Hour))
+				err := repo.Create(s.ctx, first)
+				t.Require().NoError(err)
+
+				second := mothers.OutboxMessage()
+				second.Key = first.Key
+				second.Created = first.Created.Add(time.Second)
+				err = repo.Create(s.ctx, second)
+				t.Require().NoError(err)
+				return second.ID
+			},
+			expectedID: func(_ uuid.UUID) uuid.UUID { return uuid.Nil },
+		},
+		{
+			name: "Success: Failed message skipped",
+			setup: func(repo outboxDomain.Repository) uuid.UUID {
+				message := mothers.OutboxMessage()
+				message.NoteFailed(errors.New("unknown message"), time.Now())
+				err := repo.Create(s.ctx, message)
+				t.Require().NoError(err)
+				return message.ID
+			},
+			expectedID: func(_ uuid.UUID) uuid.UUID { return uuid.Nil },
+		},
+		{
+			name: "Success: Message behind a failed message of its key claimed",
+			setup: func(repo outboxDomain.Repository) uuid.UUID {
+				first := mothers.OutboxMessage()
+				err := repo.Create(s.ctx, first)
+				t.Require().NoError(err)
+
+				claimed, err := repo.ClaimPending(s.ctx, time.Now().Add(time.Hour))
+				t.Require().NoError(err)
+				t.Require().NotNil(claimed)
+				claimed.NoteFailed(errors.New("unknown message"), time.Now())
+				err = repo.Update(s.ctx, claimed)
+				t.Require().NoError(err)
+
+				second := mothers.OutboxMessage()
+				second.Key = first.Key
+				second.Created = first.Created.Add(time.Second)
+				err = repo.Create(s.ctx, second)
+				t.Require().NoError(err)
+				return second.ID
+			},
+			expectedID: func(id uuid.UUID) uuid.UUID { return id },
+		},
+		{
+			name: "Success: Message of another key claimed",
+			setup: func(repo outboxDomain.Repository) uuid.UUID {
+				first := mothers.OutboxMessage()
+				first.NoteFailedAttempt(time.Now().Add(time.Hour))
+				err := repo.Create(s.ctx, first)
+				t.Require().NoError(err)
+
+				other := mothers.OutboxMessage()
+				other.Created = first.Created.Add(time.Second)
+				err = repo.Create(s.ctx, other)
+				t.Require().NoError(err)
+				return other.ID
+			},
+			expectedID: func(id uuid.UUID) uuid.UUID { return id },
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			s.clear(t)
+			id := tc.setup(repo)
+
+			message, err := repo.ClaimPending(s.ctx, time.Now().Add(time.Minute))
+
+			t.Require().NoError(err)
+			expectedID := tc.expectedID(id)
+			if expectedID == uuid.Nil {
+				t.Require().Nil(message)
+			} else {
+				t.Require().NotNil(message)
+				t.Require().Equal(expectedID, message.ID)
+				t.Require().JSONEq(`{"key": "value"}`, string(message.Payload))
+			}
+		})
+	}
+}
+
+func TestOutboxRepository(t *testing.T) {
+	for _, backend := range orderBackends {
+		suite.RunSuite(t, &OutboxRepositoryTestSuite{backend: backend})
+	}
+}
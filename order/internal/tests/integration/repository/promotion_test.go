@@ -0,0 +1,321 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	promotionDomain "order/internal/domain/promotion"
+	"order/internal/infrastructure/db"
+	promotionRepository "order/internal/infrastructure/repository/promotion"
+	"order/internal/tests/testutils/mothers"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type PromotionRepositoryTestSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	backend db.Backend
+	store   *orderStore
+}
+
+func (s *PromotionRepositoryTestSuite) BeforeAll(t provider.T) {
+	s.ctx = context.Background()
+
+	var err error
+	s.store, err = newOrderStore(s.ctx, s.backend)
+	t.Require().NoError(err)
+
+	s.clear(t)
+}
+
+func (s *PromotionRepositoryTestSuite) AfterAll(t provider.T) {
+	if s.store != nil {
+		err := s.store.close(s.ctx)
+		t.Require().NoError(err)
+	}
+}
+
+func (s *PromotionRepositoryTestSuite) AfterEach(t provider.T) {
+	s.clear(t)
+}
+
+func (s *PromotionRepositoryTestSuite) clear(t provider.T) {
+	ctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
+	defer cancel()
+
+	err := s.store.clear(ctx)
+	t.Require().NoError(err)
+}
+
+func (s *PromotionRepositoryTestSuite) getRepo() promotionDomain.Repository {
+	return s.store.promotions()
+}
+
+func (s *PromotionRepositoryTestSuite) TestCreate(t provider.T) {
+	tests := []struct {
+		name          string
+		setup         func(repo promotionDomain.Repository) *promotionDomain.Promotion
+		expectedError error
+	}{
+		{
+			name: "Success: Percentage",
+			setup: func(_ promotionDomain.Repository) *promotionDomain.Promotion {
+				return mothers.PercentagePromotion(10)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success: Fixed amount with every rule",
+			setup: func(_ promotionDomain.Repository) *promotionDomain.Promotion {
+				promotion := mothers.FixedAmountPromotion("5")
+				minOrderValue := mothers.USD("20")
+				validFrom := time.Now().Truncate(time.Millisecond).UTC()
+				validTo := validFrom.Add(time.Hour)
+				promotion.Rules.MinOrderValue = &minOrderValue
+				promotion.Rules.PerCustomerLimit = 3
+				promotion.Rules.ValidFrom = &validFrom
+				promotion.Rules.ValidTo = &validTo
+				promotion.Rules.ProductIDs = []uuid.UUID{uuid.New()}
+				return promotion
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failure: Code already used",
+			setup: func(repo promotionDomain.Repository) *promotionDomain.Promotion {
+				existing := mothers.PercentagePromotion(10)
+				t.Require().NoError(repo.Create(s.ctx, existing))
+
+				promotion := mothers.PercentagePromotion(20)
+				promotion.Code = existing.Code
+				return promotion
+			},
+			expectedError: promotionRepository.ErrPromotionAlreadyExists,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			promotion := tc.setup(repo)
+			err := repo.Create(s.ctx, promotion)
+
+			if tc.expectedError != nil {
+				t.Require().Error(err)
+				t.Require().Equal(tc.expectedError, err)
+			} else {
+				t.Require().NoError(err)
+
+				created, err := repo.GetByID(s.ctx, promotion.ID)
+				t.Require().NoError(err)
+				t.Require().Equal(promotion.Code, created.Code)
+				t.Require().Equal(promotion.Rules.Kind, created.Rules.Kind)
+				t.Require().Equal(promotion.Rules.Percent, created.Rules.Percent)
+				t.Require().True(promotion.Rules.Amount.Equal(created.Rules.Amount))
+				t.Require().Equal(promotion.Rules.PerCustomerLimit, created.Rules.PerCustomerLimit)
+				t.Require().Equal(promotion.Rules.ProductIDs, created.Rules.ProductIDs)
+				if promotion.Rules.MinOrderValue != nil {
+					t.Require().True(promotion.Rules.MinOrderValue.Equal(*created.Rules.MinOrderValue))
+				}
+				if promotion.Rules.ValidFrom != nil {
+					t.Require().True(promotion.Rules.ValidFrom.Equal(*created.Rules.ValidFrom))
+					t.Require().True(promotion.Rules.ValidTo.Equal(*created.Rules.ValidTo))
+				}
+			}
+		})
+	}
+}
+
+func (s *PromotionRepositoryTestSuite) TestGetByCode(t provider.T) {
+	tests := []struct {
+		name          string
+		setup         func(repo promotionDomain.Repository) (string, *promotionDomain.Promotion)
+		expectedError error
+	}{
+		{
+			name: "Success: Case-insensitive",
+			setup: func(repo promotionDomain.Repository) (string, *promotionDomain.Promotion) {
+				promotion := mothers.PercentagePromotion(10)
+				t.Require().NoError(repo.Create(s.ctx, promotion))
+				return strings.ToLower(promotion.Code), promotion
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failure: Promotion not found",
+			setup: func(_ promotionDomain.Repository) (string, *promotionDomain.Promotion) {
+				return "MISSING", nil
+			},
+			expectedError: promotionRepository.ErrPromotionNotFound,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			code, expected := tc.setup(repo)
+
+			promotion, err := repo.GetByCode(s.ctx, code)
+
+			if tc.expectedError != nil {
+				t.Require().Error(err)
+				t.Require().Equal(tc.expectedError, err)
+			} else {
+				t.Require().NoError(err)
+				t.Require().Equal(expected.ID, promotion.ID)
+			}
+		})
+	}
+}
+
+func (s *PromotionRepositoryTestSuite) TestGetAll(t provider.T) {
+	repo := s.getRepo()
+
+	active := mothers.PercentagePromotion(10)
+	t.Require().NoError(repo.Create(s.ctx, active))
+	inactive := mothers.PercentagePromotion(20)
+	inactive.Deactivate()
+	t.Require().NoError(repo.Create(s.ctx, inactive))
+
+	all, err := repo.GetAll(s.ctx, false)
+	t.Require().NoError(err)
+	t.Require().Len(all, 2)
+
+	activeOnly, err := repo.GetAll(s.ctx, true)
+	t.Require().NoError(err)
+	t.Require().Len(activeOnly, 1)
+	t.Require().Equal(active.ID, activeOnly[0].ID)
+}
+
+func (s *PromotionRepositoryTestSuite) TestUpdate(t provider.T) {
+	repo := s.getRepo()
+
+	promotion := mothers.PercentagePromotion(10)
+	t.Require().NoError(repo.Create(s.ctx, promotion))
+
+	stale := *promotion
+	promotion.Deactivate()
+	t.Require().NoError(repo.Update(s.ctx, promotion))
+
+	updated, err := repo.GetByID(s.ctx, promotion.ID)
+	t.Require().NoError(err)
+	t.Require().False(updated.Active)
+
+	err = repo.Update(s.ctx, &stale)
+	t.Require().Equal(promotionRepository.ErrPromotionNotFound, err)
+}
+
+func (s *PromotionRepositoryTestSuite) TestRedeem(t provider.T) {
+	tests := []struct {
+		name          string
+		limit         int
+		previousUses  int
+		expectedError error
+	}{
+		{
+			name:          "Success: First use",
+			limit:         1,
+			previousUses:  0,
+			expectedError: nil,
+		},
+		{
+			name:          "Success: Below the limit",
+			limit:         3,
+			previousUses:  2,
+			expectedError: nil,
+		},
+		{
+			name:          "Success: Unlimited",
+			limit:         0,
+			previousUses:  5,
+			expectedError: nil,
+		},
+		{
+			name:          "Failure: Limit reached",
+			limit:         2,
+			previousUses:  2,
+			expectedError: promotionDomain.ErrUsageLimitReached,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			promotion := mothers.PercentagePromotion(10)
+			promotion.Rules.PerCustomerLimit = tc.limit
+			t.Require().NoError(repo.Create(s.ctx, promotion))
+
+			customerID := uuid.New()
+			for i := 0; i < tc.previousUses; i++ {
+				t.Require().NoError(repo.Redeem(s.ctx, promotion, customerID))
+			}
+
+			err := repo.Redeem(s.ctx, promotion, customerID)
+
+			if tc.expectedError != nil {
+				t.Require().ErrorIs(err, tc.expectedError)
+				// The limit is per customer: others can still use the code.
+				t.Require().NoError(repo.Redeem(s.ctx, promotion, uuid.New()))
+			} else {
+				t.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *PromotionRepositoryTestSuite) TestRelease(t provider.T) {
+	tests := []struct {
+		name         string
+		previousUses int
+	}{
+		{
+			name:         "Success: Use given back",
+			previousUses: 2,
+		},
+		{
+			name:         "Success: No uses",
+			previousUses: 0,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			promotion := mothers.PercentagePromotion(10)
+			promotion.Rules.PerCustomerLimit = 2
+			t.Require().NoError(repo.Create(s.ctx, promotion))
+
+			customerID := uuid.New()
+			for i := 0; i < tc.previousUses; i++ {
+				t.Require().NoError(repo.Redeem(s.ctx, promotion, customerID))
+			}
+
+			err := repo.Release(s.ctx, promotion, customerID)
+			t.Require().NoError(err)
+
+			// The customer can use the code as often as the uses left allow.
+			for i := max(tc.previousUses-1, 0); i < promotion.Rules.PerCustomerLimit; i++ {
+				t.Require().NoError(repo.Redeem(s.ctx, promotion, customerID))
+			}
+			t.Require().ErrorIs(repo.Redeem(s.ctx, promotion, customerID), promotionDomain.ErrUsageLimitReached)
+		})
+	}
+}
+
+func TestPromotionRepositoryTestSuite(t *testing.T) {
+	for _, backend := range orderBackends {
+		suite.RunSuite(t, &PromotionRepositoryTestSuite{backend: backend})
+	}
+}
@@ -0,0 +1,474 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"errors"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/infrastructure/db"
+	sagaRepository "order/internal/infrastructure/repository/saga"
+	"order/internal/tests/testutils/builders"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type SagaRepositoryTestSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	backend db.Backend
+	store   *orderStore
+}
+
+func (s *SagaRepositoryTestSuite) BeforeAll(t provider.T) {
+	s.ctx = context.Background()
+
+	var err error
+	s.store, err = newOrderStore(s.ctx, s.backend)
+	t.Require().NoError(err)
+
+	s.clear(t)
+}
+
+func (s *SagaRepositoryTestSuite) AfterAll(t provider.T) {
+	if s.store != nil {
+		err := s.store.close(s.ctx)
+		t.Require().NoError(err)
+	}
+}
+
+func (s *SagaRepositoryTestSuite) AfterEach(t provider.T) {
+	s.clear(t)
+}
+
+func (s *SagaRepositoryTestSuite) clear(t provider.T) {
+	ctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
+	defer cancel()
+
+	err := s.store.clear(ctx)
+	t.Require().NoError(err)
+}
+
+func (s *SagaRepositoryTestSuite) getRepo() sagaDomain.Repository {
+	return s.store.sagas()
+}
+
+func (s *SagaRepositoryTestSuite) TestCreate(t provider.T) {
+	tests := []struct {
+		name          string
+		setup         func(repo sagaDomain.Repository) *sagaDomain.Saga
+		expectedError error
+	}{
+		{
+			name: "Success",
+			setup: func(_ sagaDomain.Repository) *sagaDomain.Saga {
+				return mothers.DefaultSaga()
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failure: Saga already exists",
+			setup: func(repo sagaDomain.Repository) *sagaDomain.Saga {
+				saga := mothers.DefaultSaga()
+				err := repo.Create(s.ctx, saga)
+				t.Require().NoError(err)
+				return saga
+			},
+			expectedError: sagaRepository.ErrSagaAlreadyExists,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			saga := tc.setup(repo)
+			err := repo.Create(s.ctx, saga)
+
+			if tc.expectedError != nil {
+				t.Require().Error(err)
+				t.Require().Equal(tc.expectedError, err)
+			} else {
+				t.Require().NoError(err)
+
+				createdSaga, err := repo.GetByID(s.ctx, saga.ID)
+				t.Require().NoError(err)
+				t.Require().NotNil(createdSaga)
+				t.Require().Equal(saga.ID, createdSaga.ID)
+				t.Require().Equal(saga.Step, createdSaga.Step)
+			}
+		})
+	}
+}
+
+func (s *SagaRepositoryTestSuite) TestUpdate(t provider.T) {
+	tests := []struct {
+		name          string
+		setup         func(repo sagaDomain.Repository) *sagaDomain.Saga
+		update        func(saga *sagaDomain.Saga)
+		verify        func(updated, expected *sagaDomain.Saga)
+		expectedError error
+	}{
+		{
+			name: "Success: Update step",
+			setup: func(repo sagaDomain.Repository) *sagaDomain.Saga {
+				saga := mothers.DefaultSaga()
+				err := repo.Create(s.ctx, saga)
+				t.Require().NoError(err)
+				return saga
+			},
+			update: func(saga *sagaDomain.Saga) {
+				err := saga.NoteStep(sagaDomain.AssigningCourier)
+				t.Require().NoError(err)
+			},
+			verify: func(updated, expected *sagaDomain.Saga) {
+				t.Require().Equal(sagaDomain.AssigningCourier, updated.Step)
+				t.Require().Len(updated.History, len(expected.History))
+				t.Require().Nil(updated.LastError)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success: Update last error",
+			setup: func(repo sagaDomain.Repository) *sagaDomain.Saga {
+				saga := mothers.DefaultSaga()
+				err := repo.Create(s.ctx, saga)
+				t.Require().NoError(err)
+				return saga
+			},
+			update: func(saga *sagaDomain.Saga) {
+				saga.NoteFailure(errors.New("publisher error"))
+			},
+			verify: func(updated, expected *sagaDomain.Saga) {
+				t.Require().NotNil(updated.LastError)
+				t.Require().Equal(expected.LastError.Message, updated.LastError.Message)
+				t.Require().Equal(expected.LastError.Step, updated.LastError.Step)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failure: Saga not found",
+			setup: func(_ sagaDomain.Repository) *sagaDomain.Saga {
+				return mothers.DefaultSaga()
+			},
+			update:        func(saga *sagaDomain.Saga) {},
+			verify:        func(updated, expected *sagaDomain.Saga) {},
+			expectedError: sagaRepository.ErrSagaNotFound,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			saga := tc.setup(repo)
+			tc.update(saga)
+
+			err := repo.Update(s.ctx, saga)
+
+			if tc.expectedError != nil {
+				t.Require().Error(err)
+				t.Require().Equal(tc.expectedError, err)
+			} else {
+				t.Require().NoError(err)
+				updatedSaga, err := repo.GetByID(s.ctx, saga.ID)
+				t.Require().NoError(err)
+				t.Require().NotNil(updatedSaga)
+				tc.verify(updatedSaga, saga)
+			}
+		})
+	}
+}
+
+func (s *SagaRepositoryTestSuite) TestGetByOrderID(t provider.T) {
+	tests := []struct {
+		name          string
+		setup         func(repo sagaDomain.Repository) uuid.UUID
+		expectedError error
+	}{
+		{
+			name: "Success",
+			setup: func(repo sagaDomain.Repository) uuid.UUID {
+				saga := mothers.DefaultSaga()
+				err := repo.Create(s.ctx, saga)
+				t.Require().NoError(err)
+				return saga.OrderID
+			},
+		},
+		{
+			name: "Failure: Saga not found",
+			setup: func(_ sagaDomain.Repository) uuid.UUID {
+				return uuid.New()
+			},
+			expectedError: sagaRepository.ErrSagaNotFound,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			orderID := tc.setup(repo)
+
+			saga, err := repo.GetByOrderID(s.ctx, sagaDomain.CreateOrder, orderID)
+
+			if tc.expectedError != nil {
+				t.Require().Error(err)
+				t.Require().Equal(tc.expectedError, err)
+			} else {
+				t.Require().NoError(err)
+				t.Require().NotNil(saga)
+				t.Require().Equal(orderID, saga.OrderID)
+			}
+		})
+	}
+}
+
+func (s *SagaRepositoryTestSuite) TestGetAllByOrderID(t provider.T) {
+	repo := s.getRepo()
+
+	orderID := uuid.New()
+	saga := mothers.SagaReservingItems(orderID)
+	err := repo.Create(s.ctx, saga)
+	t.Require().NoError(err)
+
+	err = repo.Create(s.ctx, mothers.DefaultSaga())
+	t.Require().NoError(err)
+
+	sagas, err := repo.GetAllByOrderID(s.ctx, orderID)
+	t.Require().NoError(err)
+	t.Require().Len(sagas, 1)
+	t.Require().Equal(saga.ID, sagas[0].ID)
+}
+
+func (s *SagaRepositoryTestSuite) TestClaimStalled(t provider.T) {
+	steps := sagaDomain.AwaitingSteps(sagaDomain.CreateOrder)
+	stalledEntered := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name       string
+		setup      func(repo sagaDomain.Repository) *sagaDomain.Saga
+		expectedID func(stalled *sagaDomain.Saga) *uuid.UUID
+	}{
+		{
+			name: "Success: Claims stalled saga",
+			setup: func(repo sagaDomain.Repository) *sagaDomain.Saga {
+				saga := builders.NewSagaBuilder().
+					WithStepEntered(sagaDomain.AssigningCourier, stalledEntered).
+					Build()
+				err := repo.Create(s.ctx, saga)
+				t.Require().NoError(err)
+				return saga
+			},
+			expectedID: func(stalled *sagaDomain.Saga) *uuid.UUID {
+				return &stalled.ID
+			},
+		},
+		{
+			name: "Success: Skips saga within deadline",
+			setup: func(repo sagaDomain.Repository) *sagaDomain.Saga {
+				saga := mothers.SagaAssigningCourier(uuid.New())
+				err := repo.Create(s.ctx, saga)
+				t.Require().NoError(err)
+				return saga
+			},
+			expectedID: func(_ *sagaDomain.Saga) *uuid.UUID {
+				return nil
+			},
+		},
+		{
+			name: "Success: Skips saga in a final step",
+			setup: func(repo sagaDomain.Repository) *sagaDomain.Saga {
+				saga := builders.NewSagaBuilder().
+					WithStep(sagaDomain.AssigningCourier).
+					WithStepEntered(sagaDomain.BeginningDelivery, stalledEntered).
+					Build()
+				err := repo.Create(s.ctx, saga)
+				t.Require().NoError(err)
+				return saga
+			},
+			expectedID: func(_ *sagaDomain.Saga) *uuid.UUID {
+				return nil
+			},
+		},
+		{
+			name: "Success: Skips saga leased by another claim",
+			setup: func(repo sagaDomain.Repository) *sagaDomain.Saga {
+				saga := builders.NewSagaBuilder().
+					WithStepEntered(sagaDomain.AssigningCourier, stalledEntered).
+					Build()
+				err := repo.Create(s.ctx, saga)
+				t.Require().NoError(err)
+
+				claimed, err := repo.ClaimStalled(s.ctx, sagaDomain.CreateOrder, steps, time.Now(), time.Now().Add(time.Minute))
+				t.Require().NoError(err)
+				t.Require().NotNil(claimed)
+				return saga
+			},
+			expectedID: func(_ *sagaDomain.Saga) *uuid.UUID {
+				return nil
+			},
+		},
+		{
+			name: "Success: Reclaims saga with expired lease",
+			setup: func(repo sagaDomain.Repository) *sagaDomain.Saga {
+				saga := builders.NewSagaBuilder().
+					WithStepEntered(sagaDomain.AssigningCourier, stalledEntered).
+					Build()
+				err := repo.Create(s.ctx, saga)
+				t.Require().NoError(err)
+
+				claimed, err := repo.ClaimStalled(s.ctx, sagaDomain.CreateOrder, steps, time.Now(), time.Now().Add(-time.Second))
+				t.Require().NoError(err)
+				t.Require().NotNil(claimed)
+				return saga
+			},
+			expectedID: func(stalled *sagaDomain.Saga) *uuid.UUID {
+				return &stalled.ID
+			},
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			s.clear(t)
+			stalled := tc.setup(repo)
+
+			claimed, err := repo.ClaimStalled(
+				s.ctx,
+				sagaDomain.CreateOrder,
+				steps,
+				time.Now().Add(-time.Minute),
+				time.Now().Add(time.Minute),
+			)
+
+			t.Require().NoError(err)
+			expectedID := tc.expectedID(stalled)
+			if expectedID == nil {
+				t.Require().Nil(claimed)
+			} else {
+				t.Require().NotNil(claimed)
+				t.Require().Equal(*expectedID, claimed.ID)
+				t.Require().Equal(stalled.Version, claimed.Version)
+			}
+		})
+	}
+}
+
+func (s *SagaRepositoryTestSuite) TestClaimStalledReleasedOnUpdate(t provider.T) {
+	repo := s.getRepo()
+	steps := sagaDomain.AwaitingSteps(sagaDomain.CreateOrder)
+
+	saga := builders.NewSagaBuilder().
+		WithStepEntered(sagaDomain.AssigningCourier, time.Now().Add(-time.Hour)).
+		Build()
+	err := repo.Create(s.ctx, saga)
+	t.Require().NoError(err)
+
+	claimed, err := repo.ClaimStalled(s.ctx, sagaDomain.CreateOrder, steps, time.Now(), time.Now().Add(time.Hour))
+	t.Require().NoError(err)
+	t.Require().NotNil(claimed)
+
+	claimed.History[len(claimed.History)-1].Entered = time.Now().Add(-time.Hour)
+	err = repo.Update(s.ctx, claimed)
+	t.Require().NoError(err)
+
+	reclaimed, err := repo.ClaimStalled(s.ctx, sagaDomain.CreateOrder, steps, time.Now(), time.Now().Add(time.Hour))
+	t.Require().NoError(err)
+	t.Require().NotNil(reclaimed)
+	t.Require().Equal(saga.ID, reclaimed.ID)
+}
+
+func (s *SagaRepositoryTestSuite) TestClaimSuspended(t provider.T) {
+	tests := []struct {
+		name       string
+		setup      func(repo sagaDomain.Repository) *sagaDomain.Saga
+		expectedID func(suspended *sagaDomain.Saga) *uuid.UUID
+	}{
+		{
+			name: "Success: Claims saga due to resume",
+			setup: func(repo sagaDomain.Repository) *sagaDomain.Saga {
+				saga := mothers.SagaAwaitingDeliverySlot(uuid.New(), time.Now().Add(-time.Minute))
+				t.Require().NoError(repo.Create(s.ctx, saga))
+				return saga
+			},
+			expectedID: func(suspended *sagaDomain.Saga) *uuid.UUID {
+				return &suspended.ID
+			},
+		},
+		{
+			name: "Success: Skips saga not yet due",
+			setup: func(repo sagaDomain.Repository) *sagaDomain.Saga {
+				saga := mothers.SagaAwaitingDeliverySlot(uuid.New(), time.Now().Add(time.Hour))
+				t.Require().NoError(repo.Create(s.ctx, saga))
+				return saga
+			},
+			expectedID: func(_ *sagaDomain.Saga) *uuid.UUID {
+				return nil
+			},
+		},
+		{
+			name: "Success: Skips saga that is not suspended",
+			setup: func(repo sagaDomain.Repository) *sagaDomain.Saga {
+				saga := mothers.SagaAssigningCourier(uuid.New())
+				t.Require().NoError(repo.Create(s.ctx, saga))
+				return saga
+			},
+			expectedID: func(_ *sagaDomain.Saga) *uuid.UUID {
+				return nil
+			},
+		},
+		{
+			name: "Success: Skips saga leased by another claim",
+			setup: func(repo sagaDomain.Repository) *sagaDomain.Saga {
+				saga := mothers.SagaAwaitingDeliverySlot(uuid.New(), time.Now().Add(-time.Minute))
+				t.Require().NoError(repo.Create(s.ctx, saga))
+
+				claimed, err := repo.ClaimSuspended(s.ctx, sagaDomain.CreateOrder, time.Now(), time.Now().Add(time.Minute))
+				t.Require().NoError(err)
+				t.Require().NotNil(claimed)
+				return saga
+			},
+			expectedID: func(_ *sagaDomain.Saga) *uuid.UUID {
+				return nil
+			},
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			s.clear(t)
+			suspended := tc.setup(repo)
+
+			claimed, err := repo.ClaimSuspended(s.ctx, sagaDomain.CreateOrder, time.Now(), time.Now().Add(time.Minute))
+
+			t.Require().NoError(err)
+			expectedID := tc.expectedID(suspended)
+			if expectedID == nil {
+				t.Require().Nil(claimed)
+			} else {
+				t.Require().NotNil(claimed)
+				t.Require().Equal(*expectedID, claimed.ID)
+				t.Require().NotNil(claimed.ResumeAt)
+				t.Require().WithinDuration(*suspended.ResumeAt, *claimed.ResumeAt, time.Millisecond)
+			}
+		})
+	}
+}
+
+func TestSagaRepositoryTestSuite(t *testing.T) {
+	for _, backend := range orderBackends {
+		suite.RunSuite(t, &SagaRepositoryTestSuite{backend: backend})
+	}
+}
@@ -0,0 +1,157 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	orderDomain "order/internal/domain/order"
+	slotDomain "order/internal/domain/slot"
+	"order/internal/infrastructure/db"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type SlotRepositoryTestSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	backend db.Backend
+	store   *orderStore
+}
+
+func (s *SlotRepositoryTestSuite) BeforeAll(t provider.T) {
+	s.ctx = context.Background()
+
+	var err error
+	s.store, err = newOrderStore(s.ctx, s.backend)
+	t.Require().NoError(err)
+
+	s.clear(t)
+}
+
+func (s *SlotRepositoryTestSuite) AfterAll(t provider.T) {
+	if s.store != nil {
+		err := s.store.close(s.ctx)
+		t.Require().NoError(err)
+	}
+}
+
+func (s *SlotRepositoryTestSuite) AfterEach(t provider.T) {
+	s.clear(t)
+}
+
+func (s *SlotRepositoryTestSuite) clear(t provider.T) {
+	ctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
+	defer cancel()
+
+	err := s.store.clear(ctx)
+	t.Require().NoError(err)
+}
+
+func (s *SlotRepositoryTestSuite) getRepo() slotDomain.Repository {
+	return s.store.slots()
+}
+
+func (s *SlotRepositoryTestSuite) TestReserve(t provider.T) {
+	tests := []struct {
+		name          string
+		capacity      int
+		previous      int
+		expectedError error
+	}{
+		{
+			name:          "Success: First reservation",
+			capacity:      1,
+			previous:      0,
+			expectedError: nil,
+		},
+		{
+			name:          "Success: Below capacity",
+			capacity:      3,
+			previous:      2,
+			expectedError: nil,
+		},
+		{
+			name:          "Failure: Slot full",
+			capacity:      2,
+			previous:      2,
+			expectedError: slotDomain.ErrSlotFull,
+		},
+		{
+			name:          "Failure: Slot closed",
+			capacity:      0,
+			previous:      0,
+			expectedError: slotDomain.ErrSlotFull,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			s.clear(t)
+			slot := mothers.TomorrowSlot()
+			for i := 0; i < tc.previous; i++ {
+				t.Require().NoError(repo.Reserve(s.ctx, slot, tc.capacity))
+			}
+
+			err := repo.Reserve(s.ctx, slot, tc.capacity)
+
+			reserved, getErr := repo.GetReserved(s.ctx, slot.Start, slot.End)
+			t.Require().NoError(getErr)
+			if tc.expectedError != nil {
+				t.Require().ErrorIs(err, tc.expectedError)
+				t.Require().Equal(tc.previous, reserved[slot.Start])
+			} else {
+				t.Require().NoError(err)
+				t.Require().Equal(tc.previous+1, reserved[slot.Start])
+			}
+		})
+	}
+}
+
+func (s *SlotRepositoryTestSuite) TestRelease(t provider.T) {
+	repo := s.getRepo()
+	slot := mothers.TomorrowSlot()
+
+	t.Require().NoError(repo.Reserve(s.ctx, slot, 1))
+	t.Require().ErrorIs(repo.Reserve(s.ctx, slot, 1), slotDomain.ErrSlotFull)
+
+	t.Require().NoError(repo.Release(s.ctx, slot))
+	t.Require().NoError(repo.Reserve(s.ctx, slot, 1))
+
+	// Releasing more places than were taken never makes the counter negative.
+	t.Require().NoError(repo.Release(s.ctx, slot))
+	t.Require().NoError(repo.Release(s.ctx, slot))
+	reserved, err := repo.GetReserved(s.ctx, slot.Start, slot.End)
+	t.Require().NoError(err)
+	t.Require().Equal(0, reserved[slot.Start])
+}
+
+func (s *SlotRepositoryTestSuite) TestGetReserved(t provider.T) {
+	repo := s.getRepo()
+	first := mothers.TomorrowSlot()
+	second := orderDomain.DeliverySlot{Start: first.End, End: first.End.Add(time.Hour)}
+	later := orderDomain.DeliverySlot{Start: second.End, End: second.End.Add(time.Hour)}
+
+	t.Require().NoError(repo.Reserve(s.ctx, first, 5))
+	t.Require().NoError(repo.Reserve(s.ctx, first, 5))
+	t.Require().NoError(repo.Reserve(s.ctx, second, 5))
+	t.Require().NoError(repo.Reserve(s.ctx, later, 5))
+
+	reserved, err := repo.GetReserved(s.ctx, first.Start, second.End)
+
+	t.Require().NoError(err)
+	t.Require().Equal(map[time.Time]int{first.Start: 2, second.Start: 1}, reserved)
+}
+
+func TestSlotRepositoryTestSuite(t *testing.T) {
+	for _, backend := range orderBackends {
+		suite.RunSuite(t, &SlotRepositoryTestSuite{backend: backend})
+	}
+}
@@ -0,0 +1,268 @@
+//go:build integration
+
+package uow
+
+import (
+	"context"
+	"errors"
+	"order/internal/domain/uow"
+	"order/internal/infrastructure/db/migrations"
+	orderRepository "order/internal/infrastructure/repository/order"
+	uowImpl "order/internal/infrastructure/uow"
+	uowPostgres "order/internal/infrastructure/uow/postgres"
+	"order/internal/tests/testutils"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type UoWTestSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	db *testutils.TestDB
+	pg *testutils.TestPostgres
+}
+
+func (s *UoWTestSuite) BeforeAll(t provider.T) {
+	tCfg, err := testutils.NewConfig()
+	t.Require().NoError(err)
+
+	mCfg, err := migrations.NewConfig()
+	t.Require().NoError(err)
+
+	s.ctx = context.Background()
+
+	s.db, err = testutils.NewTestDB(s.ctx, tCfg, mCfg)
+	t.Require().NoError(err)
+
+	s.pg, err = testutils.NewTestPostgres(s.ctx, tCfg, mCfg)
+	t.Require().NoError(err)
+
+	s.clear(t)
+}
+
+func (s *UoWTestSuite) AfterAll(t provider.T) {
+	if s.db != nil {
+		err := s.db.Close(s.ctx)
+		t.Require().NoError(err)
+	}
+	if s.pg != nil {
+		err := s.pg.Close(s.ctx)
+		t.Require().NoError(err)
+	}
+}
+
+func (s *UoWTestSuite) AfterEach(t provider.T) {
+	s.clear(t)
+}
+
+func (s *UoWTestSuite) clear(t provider.T) {
+	ctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
+	defer cancel()
+
+	err := s.db.Clear(ctx)
+	t.Require().NoError(err)
+
+	err = s.pg.Clear(ctx)
+	t.Require().NoError(err)
+}
+
+func (s *UoWTestSuite) getUoW() uow.UoW {
+	return uowImpl.New(
+		s.db.DB.Collection(s.db.Cfg.OrderCollection),
+		s.db.DB.Collection(s.db.Cfg.SagaCollection),
+		s.db.DB.Collection(s.db.Cfg.OutboxCollection),
+		s.db.DB.Collection(s.db.Cfg.PromotionCollection),
+		s.db.DB.Collection(s.db.Cfg.PromotionUsageCollection),
+		s.db.DB.Collection(s.db.Cfg.DeliverySlotCollection),
+		s.db.DB.Collection(s.db.Cfg.InboxCollection),
+	)
+}
+
+func (s *UoWTestSuite) getPostgresUoW() uow.UoW {
+	return uowPostgres.New(s.pg.DB)
+}
+
+func (s *UoWTestSuite) TestTransaction(t provider.T) {
+	tests := []struct {
+		name             string
+		fnErr            error
+		expectedOrders   int64
+		expectedMessages int64
+	}{
+		{
+			name:             "Success: Changes committed",
+			fnErr:            nil,
+			expectedOrders:   1,
+			expectedMessages: 1,
+		},
+		{
+			name:             "Failure: Changes rolled back",
+			fnErr:            errors.New("transaction error"),
+			expectedOrders:   0,
+			expectedMessages: 0,
+		},
+	}
+
+	u := s.getUoW()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			s.clear(t)
+			order := mothers.DefaultOrder()
+
+			err := u.Transaction(s.ctx, func(ctx context.Context, tx uow.UoW) error {
+				if err := tx.Order().Create(ctx, order); err != nil {
+					return err
+				}
+				message := mothers.OutboxMessage()
+				if err := tx.Outbox().Create(ctx, message); err != nil {
+					return err
+				}
+				return tc.fnErr
+			})
+
+			if tc.fnErr != nil {
+				t.Require().ErrorIs(err, tc.fnErr)
+				_, getErr := u.Order().GetByID(s.ctx, order.ID)
+				t.Require().ErrorIs(getErr, orderRepository.ErrOrderNotFound)
+			} else {
+				t.Require().NoError(err)
+			}
+
+			orders, err := s.db.DB.Collection(s.db.Cfg.OrderCollection).CountDocuments(s.ctx, bson.M{})
+			t.Require().NoError(err)
+			t.Require().Equal(tc.expectedOrders, orders)
+
+			messages, err := s.db.DB.Collection(s.db.Cfg.OutboxCollection).CountDocuments(s.ctx, bson.M{})
+			t.Require().NoError(err)
+			t.Require().Equal(tc.expectedMessages, messages)
+		})
+	}
+}
+
+func (s *UoWTestSuite) TestNestedTransaction(t provider.T) {
+	tests := []struct {
+		name             string
+		fnErr            error
+		expectedOrders   int64
+		expectedMessages int64
+	}{
+		{
+			name:             "Success: Inner changes committed with the outer transaction",
+			fnErr:            nil,
+			expectedOrders:   1,
+			expectedMessages: 1,
+		},
+		{
+			name:             "Failure: Inner changes rolled back with the outer transaction",
+			fnErr:            errors.New("transaction error"),
+			expectedOrders:   0,
+			expectedMessages: 0,
+		},
+	}
+
+	u := s.getUoW()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			s.clear(t)
+			order := mothers.DefaultOrder()
+
+			err := u.Transaction(s.ctx, func(ctx context.Context, tx uow.UoW) error {
+				err := u.Transaction(ctx, func(ctx context.Context, tx uow.UoW) error {
+					return tx.Order().Create(ctx, order)
+				})
+				if err != nil {
+					return err
+				}
+				if err := tx.Inbox().Create(ctx, mothers.InboxMessage()); err != nil {
+					return err
+				}
+				return tc.fnErr
+			})
+
+			if tc.fnErr != nil {
+				t.Require().ErrorIs(err, tc.fnErr)
+			} else {
+				t.Require().NoError(err)
+			}
+
+			orders, err := s.db.DB.Collection(s.db.Cfg.OrderCollection).CountDocuments(s.ctx, bson.M{})
+			t.Require().NoError(err)
+			t.Require().Equal(tc.expectedOrders, orders)
+
+			messages, err := s.db.DB.Collection(s.db.Cfg.InboxCollection).CountDocuments(s.ctx, bson.M{})
+			t.Require().NoError(err)
+			t.Require().Equal(tc.expectedMessages, messages)
+		})
+	}
+}
+
+func (s *UoWTestSuite) TestTransactionWithPostgres(t provider.T) {
+	tests := []struct {
+		name             string
+		fnErr            error
+		expectedOrders   int64
+		expectedMessages int64
+	}{
+		{
+			name:             "Success: Changes committed",
+			fnErr:            nil,
+			expectedOrders:   1,
+			expectedMessages: 1,
+		},
+		{
+			name:             "Failure: Changes rolled back",
+			fnErr:            errors.New("transaction error"),
+			expectedOrders:   0,
+			expectedMessages: 0,
+		},
+	}
+
+	u := s.getPostgresUoW()
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			s.clear(t)
+			order := mothers.DefaultOrder()
+
+			err := u.Transaction(s.ctx, func(ctx context.Context, tx uow.UoW) error {
+				if err := tx.Order().Create(ctx, order); err != nil {
+					return err
+				}
+				message := mothers.OutboxMessage()
+				if err := tx.Outbox().Create(ctx, message); err != nil {
+					return err
+				}
+				return tc.fnErr
+			})
+
+			if tc.fnErr != nil {
+				t.Require().ErrorIs(err, tc.fnErr)
+			} else {
+				t.Require().NoError(err)
+			}
+
+			var orders int64
+			err = s.pg.DB.WithContext(s.ctx).Table("orders").Count(&orders).Error
+			t.Require().NoError(err)
+			t.Require().Equal(tc.expectedOrders, orders)
+
+			var messages int64
+			err = s.pg.DB.WithContext(s.ctx).Table("outbox_messages").Count(&messages).Error
+			t.Require().NoError(err)
+			t.Require().Equal(tc.expectedMessages, messages)
+		})
+	}
+}
+
+func TestUoW(t *testing.T) {
+	suite.RunSuite(t, new(UoWTestSuite))
+}
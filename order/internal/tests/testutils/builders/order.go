@@ -8,13 +8,14 @@ import (
 )
 
 type OrderBuilder struct {
-	id         uuid.UUID
-	customerID uuid.UUID
-	status     orderDomain.Status
-	created    time.Time
-	version    uuid.UUID
-	delivery   orderDomain.Delivery
-	items      []orderDomain.Item
+	id          uuid.UUID
+	customerID  uuid.UUID
+	status      orderDomain.Status
+	created     time.Time
+	version     uuid.UUID
+	delivery    orderDomain.Delivery
+	items       []orderDomain.Item
+	fulfillment orderDomain.FulfillmentPolicy
 }
 
 func NewOrderBuilder() *OrderBuilder {
@@ -25,9 +26,16 @@ func NewOrderBuilder() *OrderBuilder {
 		created:    time.Now(),
 		version:    uuid.New(),
 		delivery: orderDomain.Delivery{
-			Address: "Default address",
+			Address: orderDomain.Address{
+				Country:    "DE",
+				City:       "Berlin",
+				Street:     "Unter den Linden",
+				House:      "1",
+				PostalCode: "10117",
+			},
 		},
-		items: []orderDomain.Item{},
+		items:       []orderDomain.Item{},
+		fulfillment: orderDomain.AllOrNothing,
 	}
 }
 
@@ -41,7 +49,12 @@ func (b *OrderBuilder) WithStatus(status orderDomain.Status) *OrderBuilder {
 	return b
 }
 
-func (b *OrderBuilder) WithAddress(addr string) *OrderBuilder {
+func (b *OrderBuilder) WithCreated(created time.Time) *OrderBuilder {
+	b.created = created
+	return b
+}
+
+func (b *OrderBuilder) WithAddress(addr orderDomain.Address) *OrderBuilder {
 	b.delivery.Address = addr
 	return b
 }
@@ -51,19 +64,40 @@ func (b *OrderBuilder) WithDelivery(delivery orderDomain.Delivery) *OrderBuilder
 	return b
 }
 
+func (b *OrderBuilder) WithSlot(slot orderDomain.DeliverySlot) *OrderBuilder {
+	b.delivery.Slot = &slot
+	return b
+}
+
 func (b *OrderBuilder) WithItems(items []orderDomain.Item) *OrderBuilder {
 	b.items = items
 	return b
 }
 
+func (b *OrderBuilder) WithFulfillment(policy orderDomain.FulfillmentPolicy) *OrderBuilder {
+	b.fulfillment = policy
+	return b
+}
+
+// Build returns the order. Items without a requested count are taken to have
+// been fulfilled in full, as orderDomain.Create leaves them.
 func (b *OrderBuilder) Build() *orderDomain.Order {
+	items := make([]orderDomain.Item, len(b.items))
+	for i, item := range b.items {
+		if item.Requested == 0 {
+			item.Requested = item.Count
+		}
+		items[i] = item
+	}
+
 	return &orderDomain.Order{
-		ID:         b.id,
-		CustomerID: b.customerID,
-		Status:     b.status,
-		Created:    b.created,
-		Version:    b.version,
-		Delivery:   b.delivery,
-		Items:      b.items,
+		ID:          b.id,
+		CustomerID:  b.customerID,
+		Status:      b.status,
+		Created:     b.created,
+		Version:     b.version,
+		Delivery:    b.delivery,
+		Items:       items,
+		Fulfillment: b.fulfillment,
 	}
 }
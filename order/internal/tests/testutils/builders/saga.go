@@ -0,0 +1,80 @@
+package builders
+
+import (
+	sagaDomain "order/internal/domain/saga"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SagaBuilder struct {
+	id        uuid.UUID
+	sagaType  sagaDomain.Type
+	orderID   uuid.UUID
+	step      sagaDomain.Step
+	history   []sagaDomain.StepRecord
+	lastError *sagaDomain.Failure
+	resumeAt  *time.Time
+	created   time.Time
+	updated   time.Time
+	version   uuid.UUID
+}
+
+func NewSagaBuilder() *SagaBuilder {
+	now := time.Now()
+	return &SagaBuilder{
+		id:       uuid.New(),
+		sagaType: sagaDomain.CreateOrder,
+		orderID:  uuid.New(),
+		step:     sagaDomain.ReservingItems,
+		history: []sagaDomain.StepRecord{
+			{Step: sagaDomain.ReservingItems, Entered: now},
+		},
+		lastError: nil,
+		created:   now,
+		updated:   now,
+		version:   uuid.New(),
+	}
+}
+
+func (b *SagaBuilder) WithOrderID(id uuid.UUID) *SagaBuilder {
+	b.orderID = id
+	return b
+}
+
+func (b *SagaBuilder) WithStep(step sagaDomain.Step) *SagaBuilder {
+	b.step = step
+	b.history = append(b.history, sagaDomain.StepRecord{Step: step, Entered: time.Now()})
+	return b
+}
+
+func (b *SagaBuilder) WithStepEntered(step sagaDomain.Step, entered time.Time) *SagaBuilder {
+	b.step = step
+	b.history = append(b.history, sagaDomain.StepRecord{Step: step, Entered: entered})
+	return b
+}
+
+func (b *SagaBuilder) WithResumeAt(resumeAt time.Time) *SagaBuilder {
+	b.resumeAt = &resumeAt
+	return b
+}
+
+func (b *SagaBuilder) WithLastError(failure *sagaDomain.Failure) *SagaBuilder {
+	b.lastError = failure
+	return b
+}
+
+func (b *SagaBuilder) Build() *sagaDomain.Saga {
+	return &sagaDomain.Saga{
+		ID:        b.id,
+		Type:      b.sagaType,
+		OrderID:   b.orderID,
+		Step:      b.step,
+		History:   b.history,
+		LastError: b.lastError,
+		ResumeAt:  b.resumeAt,
+		Created:   b.created,
+		Updated:   b.updated,
+		Version:   b.version,
+	}
+}
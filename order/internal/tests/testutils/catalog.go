@@ -0,0 +1,29 @@
+package testutils
+
+import (
+	"context"
+	"order/internal/application/order/usecase"
+	orderDomain "order/internal/domain/order"
+
+	"github.com/google/uuid"
+)
+
+// StaticCatalog stands in for the warehouse catalog: every requested product
+// exists and costs Price.
+type StaticCatalog struct {
+	Price orderDomain.Money
+}
+
+func (c *StaticCatalog) GetProducts(_ context.Context, productIDs []uuid.UUID) (map[uuid.UUID]usecase.CatalogProduct, error) {
+	products := make(map[uuid.UUID]usecase.CatalogProduct, len(productIDs))
+	for _, productID := range productIDs {
+		products[productID] = usecase.CatalogProduct{
+			ID:    productID,
+			Name:  "Product " + productID.String(),
+			Price: c.Price,
+		}
+	}
+	return products, nil
+}
+
+var _ usecase.Catalog = (*StaticCatalog)(nil)
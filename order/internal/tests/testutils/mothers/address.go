@@ -0,0 +1,17 @@
+package mothers
+
+import orderDomain "order/internal/domain/order"
+
+func DefaultAddress() orderDomain.Address {
+	return orderDomain.Address{
+		Country:    "DE",
+		City:       "Berlin",
+		Street:     "Unter den Linden",
+		House:      "1",
+		PostalCode: "10117",
+	}
+}
+
+func LegacyAddress() orderDomain.Address {
+	return orderDomain.Address{Legacy: "Unter den Linden 1, 10117 Berlin"}
+}
@@ -0,0 +1,18 @@
+package mothers
+
+import (
+	inboxDomain "order/internal/domain/inbox"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func InboxMessage() *inboxDomain.Message {
+	now := time.Now()
+	return &inboxDomain.Message{
+		ID:        uuid.New(),
+		Response:  []byte(`{"key": "value"}`),
+		Processed: now,
+		Expires:   now.Add(time.Hour),
+	}
+}
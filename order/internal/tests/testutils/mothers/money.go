@@ -0,0 +1,17 @@
+package mothers
+
+import (
+	orderDomain "order/internal/domain/order"
+
+	"github.com/shopspring/decimal"
+)
+
+// USD is an amount in US dollars, e.g. USD("4.50").
+func USD(amount string) orderDomain.Money {
+	return orderDomain.Money{Amount: decimal.RequireFromString(amount), Currency: "USD"}
+}
+
+// EUR is an amount in euros, e.g. EUR("4.50").
+func EUR(amount string) orderDomain.Money {
+	return orderDomain.Money{Amount: decimal.RequireFromString(amount), Currency: "EUR"}
+}
@@ -3,6 +3,7 @@ package mothers
 import (
 	orderDomain "order/internal/domain/order"
 	"order/internal/tests/testutils/builders"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -11,13 +12,75 @@ func DefaultOrder() *orderDomain.Order {
 	return builders.NewOrderBuilder().Build()
 }
 
+func OrderWithItems() *orderDomain.Order {
+	return builders.NewOrderBuilder().
+		WithItems([]orderDomain.Item{
+			{ProductID: uuid.New(), Name: "Coffee", Price: USD("4.50"), Count: 2},
+			{ProductID: uuid.New(), Name: "Bagel", Price: USD("2.25"), Count: 1},
+		}).
+		Build()
+}
+
+// OrderAllowingPartial is OrderWithItems placed with partial fulfillment allowed.
+func OrderAllowingPartial() *orderDomain.Order {
+	order := OrderWithItems()
+	order.Fulfillment = orderDomain.AllowPartial
+	return order
+}
+
 func OrderDelivering() *orderDomain.Order {
 	courierID := uuid.New()
+	assigned := time.Now()
 	return builders.NewOrderBuilder().
 		WithStatus(orderDomain.Delivering).
 		WithDelivery(orderDomain.Delivery{
 			CourierID: &courierID,
-			Address:   "address",
+			Address:   DefaultAddress(),
+			Assigned:  &assigned,
+			Arrived:   nil,
+		}).
+		Build()
+}
+
+func OrderWithSlot(slot orderDomain.DeliverySlot) *orderDomain.Order {
+	return builders.NewOrderBuilder().
+		WithSlot(slot).
+		Build()
+}
+
+// OrderWithHistory is a delivering order placed by its customer and picked up
+// by a courier the saga assigned.
+func OrderWithHistory() *orderDomain.Order {
+	order := OrderDelivering()
+	messageID := uuid.New()
+	order.History = []orderDomain.StatusChange{
+		{To: orderDomain.Created, Occurred: order.Created, Actor: orderDomain.CustomerActor(order.CustomerID)},
+		{
+			From:      orderDomain.Created,
+			To:        orderDomain.Delivering,
+			Occurred:  *order.Delivery.Assigned,
+			Actor:     orderDomain.SystemActor(),
+			MessageID: &messageID,
+		},
+	}
+	return order
+}
+
+func OrderCanceling() *orderDomain.Order {
+	return builders.NewOrderBuilder().
+		WithStatus(orderDomain.Canceling).
+		Build()
+}
+
+func OrderDelivered() *orderDomain.Order {
+	courierID := uuid.New()
+	assigned := time.Now()
+	return builders.NewOrderBuilder().
+		WithStatus(orderDomain.Delivered).
+		WithDelivery(orderDomain.Delivery{
+			CourierID: &courierID,
+			Address:   DefaultAddress(),
+			Assigned:  &assigned,
 			Arrived:   nil,
 		}).
 		Build()
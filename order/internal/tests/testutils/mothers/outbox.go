@@ -0,0 +1,21 @@
+package mothers
+
+import (
+	outboxDomain "order/internal/domain/outbox"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func OutboxMessage() *outboxDomain.Message {
+	now := time.Now()
+	return &outboxDomain.Message{
+		ID:          uuid.New(),
+		Name:        "test.command",
+		Key:         uuid.NewString(),
+		Payload:     []byte(`{"key": "value"}`),
+		Metadata:    map[string]any{},
+		NextAttempt: now,
+		Created:     now,
+	}
+}
@@ -0,0 +1,33 @@
+package mothers
+
+import (
+	promotionDomain "order/internal/domain/promotion"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PercentagePromotion takes percent off the whole order, with no other limits.
+func PercentagePromotion(percent int) *promotionDomain.Promotion {
+	return &promotionDomain.Promotion{
+		ID:   uuid.New(),
+		Code: promotionDomain.NormalizeCode("save-" + uuid.NewString()[:8]),
+		Rules: promotionDomain.Rules{
+			Kind:    promotionDomain.Percentage,
+			Percent: percent,
+		},
+		Active:  true,
+		Created: time.Now(),
+		Version: uuid.New(),
+	}
+}
+
+// FixedAmountPromotion takes up to amount US dollars off the whole order.
+func FixedAmountPromotion(amount string) *promotionDomain.Promotion {
+	promotion := PercentagePromotion(0)
+	promotion.Rules = promotionDomain.Rules{
+		Kind:   promotionDomain.FixedAmount,
+		Amount: USD(amount),
+	}
+	return promotion
+}
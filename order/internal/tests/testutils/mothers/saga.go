@@ -0,0 +1,129 @@
+package mothers
+
+import (
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/tests/testutils/builders"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func DefaultSaga() *sagaDomain.Saga {
+	return builders.NewSagaBuilder().Build()
+}
+
+func SagaReservingItems(orderID uuid.UUID) *sagaDomain.Saga {
+	return builders.NewSagaBuilder().
+		WithOrderID(orderID).
+		Build()
+}
+
+func SagaAssigningCourier(orderID uuid.UUID) *sagaDomain.Saga {
+	return builders.NewSagaBuilder().
+		WithOrderID(orderID).
+		WithStep(sagaDomain.AssigningCourier).
+		Build()
+}
+
+func SagaAwaitingDeliverySlot(orderID uuid.UUID, resumeAt time.Time) *sagaDomain.Saga {
+	return builders.NewSagaBuilder().
+		WithOrderID(orderID).
+		WithStep(sagaDomain.AwaitingDeliverySlot).
+		WithResumeAt(resumeAt).
+		Build()
+}
+
+func SagaReleasingItems(orderID uuid.UUID) *sagaDomain.Saga {
+	return builders.NewSagaBuilder().
+		WithOrderID(orderID).
+		WithStep(sagaDomain.AssigningCourier).
+		WithStep(sagaDomain.ReleasingItems).
+		Build()
+}
+
+func SagaReleasingItemsOnTimeout(orderID uuid.UUID) *sagaDomain.Saga {
+	return builders.NewSagaBuilder().
+		WithOrderID(orderID).
+		WithStep(sagaDomain.AssigningCourier).
+		WithStep(sagaDomain.ReleasingItemsOnTimeout).
+		Build()
+}
+
+// SagaTimedOutReservingItems got no reservation reply within its deadline.
+func SagaTimedOutReservingItems(orderID uuid.UUID) *sagaDomain.Saga {
+	return builders.NewSagaBuilder().
+		WithOrderID(orderID).
+		WithStep(sagaDomain.CancelingTimeout).
+		Build()
+}
+
+// SagaTimedOutAssigningCourier had its items reserved and released again
+// after it got no courier within its deadline.
+func SagaTimedOutAssigningCourier(orderID uuid.UUID) *sagaDomain.Saga {
+	return builders.NewSagaBuilder().
+		WithOrderID(orderID).
+		WithStep(sagaDomain.AssigningCourier).
+		WithStep(sagaDomain.ReleasingItemsOnTimeout).
+		WithStep(sagaDomain.CancelingTimeout).
+		Build()
+}
+
+func SagaReleasingItemsAndCourier(orderID uuid.UUID) *sagaDomain.Saga {
+	saga, _ := sagaDomain.Create(sagaDomain.CancelOrder, orderID)
+	return saga
+}
+
+func SagaAwaitingCourierRelease(orderID uuid.UUID) *sagaDomain.Saga {
+	saga := SagaReleasingItemsAndCourier(orderID)
+	_ = saga.NoteStep(sagaDomain.AwaitingCourierRelease)
+	return saga
+}
+
+func SagaAwaitingItemsRelease(orderID uuid.UUID) *sagaDomain.Saga {
+	saga := SagaReleasingItemsAndCourier(orderID)
+	_ = saga.NoteStep(sagaDomain.AwaitingItemsRelease)
+	return saga
+}
+
+// SagaCancelingByCustomer had its courier released after it started while the
+// items were still being reserved, so it never asked for their release.
+func SagaCancelingByCustomer(orderID uuid.UUID) *sagaDomain.Saga {
+	saga := SagaAwaitingCourierRelease(orderID)
+	_ = saga.NoteStep(sagaDomain.CancelingByCustomer)
+	return saga
+}
+
+// SagaAwaitingCourierReleaseRetried has sent the courier release again the
+// given number of times without a reply.
+func SagaAwaitingCourierReleaseRetried(orderID uuid.UUID, retries int) *sagaDomain.Saga {
+	saga := SagaAwaitingCourierRelease(orderID)
+	for range retries {
+		_ = saga.NoteRetry()
+	}
+	return saga
+}
+
+func SagaBeginningDelivery(orderID uuid.UUID) *sagaDomain.Saga {
+	return builders.NewSagaBuilder().
+		WithOrderID(orderID).
+		WithStep(sagaDomain.AssigningCourier).
+		WithStep(sagaDomain.BeginningDelivery).
+		Build()
+}
+
+func SagaSupersededByCancel(orderID uuid.UUID) *sagaDomain.Saga {
+	return builders.NewSagaBuilder().
+		WithOrderID(orderID).
+		WithStep(sagaDomain.AssigningCourier).
+		WithStep(sagaDomain.SupersededByCancel).
+		Build()
+}
+
+// SagaSupersededReservingItems was superseded by a cancellation before the
+// warehouse replied to its reservation.
+func SagaSupersededReservingItems(orderID uuid.UUID) *sagaDomain.Saga {
+	return builders.NewSagaBuilder().
+		WithOrderID(orderID).
+		WithStep(sagaDomain.SupersededByCancel).
+		Build()
+}
@@ -0,0 +1,19 @@
+package mothers
+
+import (
+	orderDomain "order/internal/domain/order"
+	"time"
+)
+
+// SlotStartingIn returns a one-hour delivery slot starting after d.
+func SlotStartingIn(d time.Duration) orderDomain.DeliverySlot {
+	start := time.Now().Add(d).Truncate(time.Second)
+	return orderDomain.DeliverySlot{Start: start, End: start.Add(time.Hour)}
+}
+
+// TomorrowSlot returns the 10:00-11:00 UTC delivery slot of the next day.
+func TomorrowSlot() orderDomain.DeliverySlot {
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), now.Day()+1, 10, 0, 0, 0, time.UTC)
+	return orderDomain.DeliverySlot{Start: start, End: start.Add(time.Hour)}
+}
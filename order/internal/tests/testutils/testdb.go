@@ -20,6 +20,17 @@ import (
 const (
 	TestDbName              = "name"
 	TestOrderCollectionName = "order"
+	TestSagaCollectionName  = "saga"
+
+	TestOutboxCollectionName = "outbox"
+	TestInboxCollectionName  = "inbox"
+
+	TestPromotionCollectionName      = "promotions"
+	TestPromotionUsageCollectionName = "promotion_usages"
+
+	TestDeliverySlotCollectionName = "delivery_slots"
+
+	TestReplicaSetName = "rs0"
 )
 
 type TestDB struct {
@@ -29,13 +40,25 @@ type TestDB struct {
 	container testcontainers.Container
 }
 
+func (d *TestDB) collections() []string {
+	return []string{
+		d.Cfg.OrderCollection,
+		d.Cfg.SagaCollection,
+		d.Cfg.OutboxCollection,
+		d.Cfg.InboxCollection,
+		d.Cfg.PromotionCollection,
+		d.Cfg.PromotionUsageCollection,
+		d.Cfg.DeliverySlotCollection,
+	}
+}
+
 func (d *TestDB) Clear(ctx context.Context, collections ...string) error {
 	if d.DB == nil {
 		return nil
 	}
 
 	if len(collections) == 0 && d.Cfg != nil {
-		collections = []string{d.Cfg.OrderCollection}
+		collections = d.collections()
 	}
 	for _, col := range collections {
 		if col == "" {
@@ -52,14 +75,17 @@ func (d *TestDB) Close(ctx context.Context) error {
 	if d.container != nil {
 		return d.container.Terminate(ctx)
 	}
-	if _, err := d.DB.Collection(d.Cfg.OrderCollection).DeleteMany(ctx, bson.M{}); err != nil {
-		return err
+	for _, col := range d.collections() {
+		if _, err := d.DB.Collection(col).DeleteMany(ctx, bson.M{}); err != nil {
+			return err
+		}
 	}
 	return d.DB.Client().Disconnect(ctx)
 }
 
 func setupDBContainer(ctx context.Context) (testcontainers.Container, error) {
-	return mongoContainer.Run(ctx, "mongo:6")
+	// Transactions require a replica set.
+	return mongoContainer.Run(ctx, "mongo:6", mongoContainer.WithReplicaSet(TestReplicaSetName))
 }
 
 func createDSN(ctx context.Context, container testcontainers.Container) (string, error) {
@@ -74,7 +100,7 @@ func createDSN(ctx context.Context, container testcontainers.Container) (string,
 	}
 
 	return fmt.Sprintf(
-		"mongodb://%s:%d",
+		"mongodb://%s:%d/?directConnection=true",
 		host, port.Int(),
 	), nil
 }
@@ -171,9 +197,15 @@ func NewTestDB(ctx context.Context, tCfg *Config, mCfg *migrations.Config) (*Tes
 		}
 
 		genCfg := &db.Config{
-			URI:             dsn,
-			Database:        TestDbName,
-			OrderCollection: TestOrderCollectionName,
+			URI:                      dsn,
+			Database:                 TestDbName,
+			OrderCollection:          TestOrderCollectionName,
+			SagaCollection:           TestSagaCollectionName,
+			OutboxCollection:         TestOutboxCollectionName,
+			InboxCollection:          TestInboxCollectionName,
+			PromotionCollection:      TestPromotionCollectionName,
+			PromotionUsageCollection: TestPromotionUsageCollectionName,
+			DeliverySlotCollection:   TestDeliverySlotCollectionName,
 		}
 
 		return &TestDB{
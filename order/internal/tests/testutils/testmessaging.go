@@ -27,18 +27,27 @@ const (
 	TestOrderTopic           = "order-topic"
 	TestOrderResTopic        = "order-topic-res"
 	TestOrderConsumerGroupID = "order-consumer"
+	TestOrderEventTopic      = "order-events"
 	TestCourierTopic         = "courier-topic"
 	TestCourierResTopic      = "courier-topic-res"
 	TestCourierResGroupID    = "courier-res-consumer"
+
+	TestOrderDLQTopic        = "order-topic-dlq"
+	TestWarehouseResDLQTopic = "warehouse-topic-res-dlq"
+	TestCourierResDLQTopic   = "courier-topic-res-dlq"
 )
 
-func (m *TestMessaging) CreateWriter(topic string) (*otelkafkakonsumer.Writer, error) {
-	return otelkafkakonsumer.NewWriter(
+func (m *TestMessaging) CreateWriter(topic string) (messaging.Writer, error) {
+	writer, err := otelkafkakonsumer.NewWriter(
 		&kafka.Writer{
 			Addr:  kafka.TCP(m.Cfg.Address),
 			Topic: topic,
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+	return &messaging.KafkaWriter{Writer: writer}, nil
 }
 
 func (m *TestMessaging) CreateReader(topic string) (*otelkafkakonsumer.Reader, error) {
@@ -108,6 +117,10 @@ func (m *TestMessaging) Clear(ctx context.Context) error {
 		m.Cfg.WarehouseCmdResTopic,
 		m.Cfg.OrderCmdTopic,
 		m.Cfg.OrderCmdResTopic,
+		m.Cfg.OrderCmdDLQTopic,
+		m.Cfg.OrderEventTopic,
+		m.Cfg.WarehouseCmdResDLQTopic,
+		m.Cfg.CourierCmdResDLQTopic,
 	}
 
 	for _, topic := range topics {
@@ -192,7 +205,7 @@ func NewTestMessaging(ctx context.Context, tCfg *Config) (*TestMessaging, error)
 		}
 
 		if err = createTopics(ctx, url, TestWarehouseTopic, TestWarehouseResTopic, TestOrderTopic, TestOrderResTopic,
-			TestCourierTopic, TestCourierResTopic); err != nil {
+			TestOrderEventTopic, TestCourierTopic, TestCourierResTopic, TestOrderDLQTopic, TestWarehouseResDLQTopic, TestCourierResDLQTopic); err != nil {
 			return nil, fmt.Errorf("failed to create topics: %w", err)
 		}
 
@@ -202,14 +215,19 @@ func NewTestMessaging(ctx context.Context, tCfg *Config) (*TestMessaging, error)
 			OrderCmdTopic:           TestOrderTopic,
 			OrderCmdResTopic:        TestOrderResTopic,
 			OrderCmdConsumerGroupID: TestOrderConsumerGroupID,
+			OrderCmdDLQTopic:        TestOrderDLQTopic,
+
+			OrderEventTopic: TestOrderEventTopic,
 
 			WarehouseCmdTopic:              TestWarehouseTopic,
 			WarehouseCmdResTopic:           TestWarehouseResTopic,
 			WarehouseCmdResConsumerGroupID: TestWarehouseResGroupID,
+			WarehouseCmdResDLQTopic:        TestWarehouseResDLQTopic,
 
 			CourierCmdTopic:              TestCourierTopic,
 			CourierCmdResTopic:           TestCourierResTopic,
 			CourierCmdResConsumerGroupID: TestCourierResGroupID,
+			CourierCmdResDLQTopic:        TestCourierResDLQTopic,
 		}
 
 		return &TestMessaging{Cfg: mCfg, container: container}, nil
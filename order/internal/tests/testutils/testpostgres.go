@@ -0,0 +1,158 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+	"order/internal/infrastructure/db/migrations"
+	"order/internal/infrastructure/db/postgres"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	migratePostgres "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/testcontainers/testcontainers-go"
+	postgresContainer "github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	gormPostgres "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	TestPostgresUser = "user"
+	TestPostgresPass = "password"
+)
+
+// TestPostgres is the PostgreSQL database orders, sagas, outbox, inbox,
+// promotions and delivery slots are stored in when DB_ORDER_BACKEND=postgres.
+type TestPostgres struct {
+	DB *gorm.DB
+
+	container testcontainers.Container
+}
+
+// Clear empties every table; items and deliveries go with their orders.
+func (d *TestPostgres) Clear(ctx context.Context) error {
+	return d.DB.WithContext(ctx).Exec(`TRUNCATE orders, sagas, outbox_messages, inbox_messages,
+		promotions, promotion_usages, delivery_slots CASCADE`).Error
+}
+
+func (d *TestPostgres) Close(ctx context.Context) error {
+	if d.container != nil {
+		return d.container.Terminate(ctx)
+	}
+	if err := d.Clear(ctx); err != nil {
+		return err
+	}
+	sqlDB, err := d.DB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+func setupPostgresContainer(ctx context.Context) (testcontainers.Container, error) {
+	return postgresContainer.Run(ctx,
+		"postgres:16-alpine",
+		postgresContainer.WithDatabase(TestDbName),
+		postgresContainer.WithUsername(TestPostgresUser),
+		postgresContainer.WithPassword(TestPostgresPass),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(5*time.Second)),
+	)
+}
+
+func createPostgresDSN(ctx context.Context, container testcontainers.Container) (string, error) {
+	host, err := container.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	port, err := container.MappedPort(ctx, "5432/tcp")
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(
+		"host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
+		host, port.Int(), TestPostgresUser, TestPostgresPass, TestDbName,
+	), nil
+}
+
+func createPostgresMigrations(db *gorm.DB, config *migrations.Config) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+
+	driver, err := migratePostgres.WithInstance(sqlDB, &migratePostgres.Config{})
+	if err != nil {
+		return fmt.Errorf("failed to setup migrations: %w", err)
+	}
+
+	migration, err := migrate.NewWithDatabaseInstance(
+		"file://"+config.PostgresMigrationsPath,
+		TestDbName,
+		driver,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to setup migrations: %w", err)
+	}
+	if err = migration.Up(); err != nil {
+		return fmt.Errorf("failed to up migrations: %w", err)
+	}
+	return nil
+}
+
+func createPostgres(ctx context.Context, container testcontainers.Container, config *migrations.Config) (*gorm.DB, error) {
+	dsn, err := createPostgresDSN(ctx, container)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create DSN: %w", err)
+	}
+
+	db, err := gorm.Open(gormPostgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to init GORM: %w", err)
+	}
+
+	if err = createPostgresMigrations(db, config); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+func NewTestPostgres(ctx context.Context, tCfg *Config, mCfg *migrations.Config) (*TestPostgres, error) {
+	switch tCfg.Mode {
+	case ModeReal:
+		pgCfg, err := postgres.NewConfig()
+		if err != nil {
+			return nil, fmt.Errorf("unable to load postgres config: %w", err)
+		}
+
+		db, err := postgres.NewDB(pgCfg)
+		if err != nil {
+			return nil, err
+		}
+		return &TestPostgres{DB: db}, nil
+	default:
+		container, err := setupPostgresContainer(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to setup postgres: %w", err)
+		}
+
+		db, err := createPostgres(ctx, container, mCfg)
+		if err != nil {
+			if err := container.Terminate(ctx); err != nil {
+				return nil, fmt.Errorf("failed to terminate postgres: %w", err)
+			}
+			return nil, err
+		}
+
+		return &TestPostgres{
+			DB:        db,
+			container: container,
+		}, nil
+	}
+}
@@ -0,0 +1,291 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"order/internal/application/analytics/usecase"
+	analyticsDomain "order/internal/domain/analytics"
+	orderDomain "order/internal/domain/order"
+	analyticsMock "order/internal/mocks/analytics"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+var (
+	from = time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC)
+	to   = from.AddDate(0, 0, 7)
+)
+
+type AnalyticsUseCaseTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *AnalyticsUseCaseTestSuite) BeforeEach(_ provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *AnalyticsUseCaseTestSuite) TestGetStatusFunnel(t provider.T) {
+	t.Parallel()
+
+	funnel := &analyticsDomain.StatusFunnel{
+		Total:   2,
+		Current: orderDomain.StatusCounts{orderDomain.Delivered: 1, orderDomain.CustomerCanceled: 1},
+		Reached: orderDomain.StatusCounts{orderDomain.Created: 2, orderDomain.Delivering: 2, orderDomain.Delivered: 1},
+	}
+
+	tests := []struct {
+		name        string
+		period      analyticsDomain.Period
+		setup       func(repo *analyticsMock.RepositoryMock, period analyticsDomain.Period)
+		expected    *analyticsDomain.StatusFunnel
+		expectedErr error
+	}{
+		{
+			name:   "Success",
+			period: analyticsDomain.Period{From: &from, To: &to},
+			setup: func(repo *analyticsMock.RepositoryMock, period analyticsDomain.Period) {
+				repo.On("GetStatusFunnel", s.ctx, period).Return(funnel, nil).Once()
+			},
+			expected: funnel,
+		},
+		{
+			name:   "Success: Open period",
+			period: analyticsDomain.Period{},
+			setup: func(repo *analyticsMock.RepositoryMock, period analyticsDomain.Period) {
+				repo.On("GetStatusFunnel", s.ctx, period).Return(funnel, nil).Once()
+			},
+			expected: funnel,
+		},
+		{
+			name:        "Failure: Period ends before it starts",
+			period:      analyticsDomain.Period{From: &to, To: &from},
+			setup:       func(_ *analyticsMock.RepositoryMock, _ analyticsDomain.Period) {},
+			expectedErr: analyticsDomain.ErrInvalidPeriod,
+		},
+		{
+			name:        "Failure: Empty period",
+			period:      analyticsDomain.Period{From: &from, To: &from},
+			setup:       func(_ *analyticsMock.RepositoryMock, _ analyticsDomain.Period) {},
+			expectedErr: analyticsDomain.ErrInvalidPeriod,
+		},
+		{
+			name:   "Failure: Repository error",
+			period: analyticsDomain.Period{From: &from},
+			setup: func(repo *analyticsMock.RepositoryMock, period analyticsDomain.Period) {
+				repo.On("GetStatusFunnel", s.ctx, period).
+					Return((*analyticsDomain.StatusFunnel)(nil), errors.New("analytics repository error")).Once()
+			},
+			expectedErr: errors.New("analytics repository error"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			repo := new(analyticsMock.RepositoryMock)
+			tc.setup(repo, tc.period)
+			uc := usecase.New(repo)
+
+			result, err := uc.GetStatusFunnel(s.ctx, tc.period)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+				t.Require().Equal(tc.expected, result)
+			} else {
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *AnalyticsUseCaseTestSuite) TestGetRevenue(t provider.T) {
+	t.Parallel()
+
+	period := analyticsDomain.Period{From: &from, To: &to}
+	buckets := []analyticsDomain.RevenueBucket{
+		{Start: from, Revenue: mothers.USD("150.25"), Orders: 2},
+		{Start: from.AddDate(0, 0, 1), Revenue: mothers.USD("20"), Orders: 1},
+	}
+
+	tests := []struct {
+		name        string
+		period      analyticsDomain.Period
+		granularity analyticsDomain.Granularity
+		setup       func(repo *analyticsMock.RepositoryMock)
+		expected    []analyticsDomain.RevenueBucket
+		expectedErr error
+	}{
+		{
+			name:        "Success: By day",
+			period:      period,
+			granularity: analyticsDomain.Day,
+			setup: func(repo *analyticsMock.RepositoryMock) {
+				repo.On("GetRevenue", s.ctx, period, analyticsDomain.Day).Return(buckets, nil).Once()
+			},
+			expected: buckets,
+		},
+		{
+			name:        "Success: By week",
+			period:      period,
+			granularity: analyticsDomain.Week,
+			setup: func(repo *analyticsMock.RepositoryMock) {
+				repo.On("GetRevenue", s.ctx, period, analyticsDomain.Week).
+					Return([]analyticsDomain.RevenueBucket{}, nil).Once()
+			},
+			expected: []analyticsDomain.RevenueBucket{},
+		},
+		{
+			name:        "Failure: Unknown granularity",
+			period:      period,
+			granularity: analyticsDomain.Granularity("month"),
+			setup:       func(_ *analyticsMock.RepositoryMock) {},
+			expectedErr: analyticsDomain.ErrInvalidGranularity,
+		},
+		{
+			name:        "Failure: Invalid period",
+			period:      analyticsDomain.Period{From: &to, To: &from},
+			granularity: analyticsDomain.Day,
+			setup:       func(_ *analyticsMock.RepositoryMock) {},
+			expectedErr: analyticsDomain.ErrInvalidPeriod,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			repo := new(analyticsMock.RepositoryMock)
+			tc.setup(repo)
+			uc := usecase.New(repo)
+
+			result, err := uc.GetRevenue(s.ctx, tc.period, tc.granularity)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+				t.Require().Equal(tc.expected, result)
+			} else {
+				t.Require().ErrorIs(err, tc.expectedErr)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *AnalyticsUseCaseTestSuite) TestGetDeliveryTime(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		period      analyticsDomain.Period
+		setup       func(repo *analyticsMock.RepositoryMock, period analyticsDomain.Period)
+		expected    *analyticsDomain.DeliveryTime
+		expectedErr error
+	}{
+		{
+			name:   "Success",
+			period: analyticsDomain.Period{From: &from, To: &to},
+			setup: func(repo *analyticsMock.RepositoryMock, period analyticsDomain.Period) {
+				repo.On("GetDeliveryTime", s.ctx, period).
+					Return(&analyticsDomain.DeliveryTime{Orders: 3, Average: 45 * time.Minute}, nil).Once()
+			},
+			expected: &analyticsDomain.DeliveryTime{Orders: 3, Average: 45 * time.Minute},
+		},
+		{
+			name:        "Failure: Invalid period",
+			period:      analyticsDomain.Period{From: &to, To: &from},
+			setup:       func(_ *analyticsMock.RepositoryMock, _ analyticsDomain.Period) {},
+			expectedErr: analyticsDomain.ErrInvalidPeriod,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			repo := new(analyticsMock.RepositoryMock)
+			tc.setup(repo, tc.period)
+			uc := usecase.New(repo)
+
+			result, err := uc.GetDeliveryTime(s.ctx, tc.period)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+				t.Require().Equal(tc.expected, result)
+			} else {
+				t.Require().ErrorIs(err, tc.expectedErr)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *AnalyticsUseCaseTestSuite) TestGetCancellationReasons(t provider.T) {
+	t.Parallel()
+
+	reasons := analyticsDomain.CancellationReasons{
+		orderDomain.CancelReasonCustomer: 4,
+		orderDomain.CancelReasonTimeout:  1,
+	}
+
+	tests := []struct {
+		name        string
+		period      analyticsDomain.Period
+		setup       func(repo *analyticsMock.RepositoryMock, period analyticsDomain.Period)
+		expected    analyticsDomain.CancellationReasons
+		expectedErr error
+	}{
+		{
+			name:   "Success",
+			period: analyticsDomain.Period{To: &to},
+			setup: func(repo *analyticsMock.RepositoryMock, period analyticsDomain.Period) {
+				repo.On("GetCancellationReasons", s.ctx, period).Return(reasons, nil).Once()
+			},
+			expected: reasons,
+		},
+		{
+			name:        "Failure: Invalid period",
+			period:      analyticsDomain.Period{From: &to, To: &from},
+			setup:       func(_ *analyticsMock.RepositoryMock, _ analyticsDomain.Period) {},
+			expectedErr: analyticsDomain.ErrInvalidPeriod,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			repo := new(analyticsMock.RepositoryMock)
+			tc.setup(repo, tc.period)
+			uc := usecase.New(repo)
+
+			result, err := uc.GetCancellationReasons(s.ctx, tc.period)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+				t.Require().Equal(tc.expected, result)
+			} else {
+				t.Require().ErrorIs(err, tc.expectedErr)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAnalyticsUseCaseTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(AnalyticsUseCaseTestSuite))
+}
@@ -0,0 +1,177 @@
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	cancelOrder "order/internal/application/order/saga/cancel_order"
+	orderDomain "order/internal/domain/order"
+	outboxDomain "order/internal/domain/outbox"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/mocks"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+)
+
+type CancelOrderSagaManagerTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *CancelOrderSagaManagerTestSuite) BeforeEach(_ provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *CancelOrderSagaManagerTestSuite) TestCreate(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		order       *orderDomain.Order
+		setup       func(uow *mocks.UoWMock, order *orderDomain.Order)
+		expectedErr error
+	}{
+		{
+			name:  "Success: create_order saga assigning courier is superseded",
+			order: mothers.OrderCanceling(),
+			setup: func(uow *mocks.UoWMock, order *orderDomain.Order) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, order.ID).
+					Return(mothers.SagaAssigningCourier(order.ID), nil).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Type == sagaDomain.CreateOrder &&
+						instance.Step == sagaDomain.SupersededByCancel
+				})).Return(nil).Once()
+				uow.SagaMock.On("Create", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.OrderID == order.ID &&
+						instance.Type == sagaDomain.CancelOrder &&
+						instance.Step == sagaDomain.ReleasingItemsAndCourier
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, mock.MatchedBy(func(message *outboxDomain.Message) bool {
+					var cmd cancelOrder.ReleaseItemsCmd
+					if err := json.Unmarshal(message.Payload, &cmd); err != nil {
+						return false
+					}
+					return message.Name == cancelOrder.ReleaseItemsCmdName &&
+						cmd.OrderID == order.ID &&
+						len(cmd.Items) == len(order.Items)
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(cancelOrder.ReleaseCourierCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "Success: create_order saga awaiting delivery slot is superseded",
+			order: mothers.OrderCanceling(),
+			setup: func(uow *mocks.UoWMock, order *orderDomain.Order) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, order.ID).
+					Return(mothers.SagaAwaitingDeliverySlot(order.ID, time.Now().Add(time.Hour)), nil).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.SupersededByCancel && instance.ResumeAt == nil
+				})).Return(nil).Once()
+				uow.SagaMock.On("Create", s.ctx, mock.Anything).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(cancelOrder.ReleaseItemsCmdName)).
+					Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(cancelOrder.ReleaseCourierCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "Success: create_order saga beginning delivery",
+			order: mothers.OrderCanceling(),
+			setup: func(uow *mocks.UoWMock, order *orderDomain.Order) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, order.ID).
+					Return(mothers.SagaBeginningDelivery(order.ID), nil).Once()
+				uow.SagaMock.On("Create", s.ctx, mock.Anything).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(cancelOrder.ReleaseItemsCmdName)).
+					Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(cancelOrder.ReleaseCourierCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "Success: create_order saga reserving items is superseded, only the courier released",
+			order: mothers.OrderCanceling(),
+			setup: func(uow *mocks.UoWMock, order *orderDomain.Order) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, order.ID).
+					Return(mothers.SagaReservingItems(order.ID), nil).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Type == sagaDomain.CreateOrder &&
+						instance.Step == sagaDomain.SupersededByCancel
+				})).Return(nil).Once()
+				uow.SagaMock.On("Create", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Type == sagaDomain.CancelOrder &&
+						instance.Step == sagaDomain.AwaitingCourierRelease
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(cancelOrder.ReleaseCourierCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "Failure: create_order saga already compensating",
+			order: mothers.OrderCanceling(),
+			setup: func(uow *mocks.UoWMock, order *orderDomain.Order) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, order.ID).
+					Return(mothers.SagaReleasingItems(order.ID), nil).Once()
+			},
+			expectedErr: orderDomain.ErrCancellationNotAllowed,
+		},
+		{
+			name:  "Failure: Saga repository error",
+			order: mothers.OrderCanceling(),
+			setup: func(uow *mocks.UoWMock, order *orderDomain.Order) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, order.ID).
+					Return(mothers.SagaBeginningDelivery(order.ID), nil).Once()
+				uow.SagaMock.On("Create", s.ctx, mock.Anything).
+					Return(errors.New("saga repository error")).Once()
+			},
+			expectedErr: errors.New("saga repository error"),
+		},
+		{
+			name:  "Failure: Outbox error",
+			order: mothers.OrderCanceling(),
+			setup: func(uow *mocks.UoWMock, order *orderDomain.Order) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, order.ID).
+					Return(mothers.SagaBeginningDelivery(order.ID), nil).Once()
+				uow.SagaMock.On("Create", s.ctx, mock.Anything).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, mock.Anything).
+					Return(errors.New("outbox error")).Once()
+			},
+			expectedErr: errors.New("outbox error"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			manager := cancelOrder.NewManager()
+			tc.setup(uow, tc.order)
+
+			err := manager.Create(s.ctx, uow, tc.order)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			uow.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCancelOrderSagaManagerTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(CancelOrderSagaManagerTestSuite))
+}
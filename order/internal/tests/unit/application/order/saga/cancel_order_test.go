@@ -0,0 +1,358 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	cancelOrder "order/internal/application/order/saga/cancel_order"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/mocks"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+)
+
+var cancelSagaCfg = &cancelOrder.Config{MaxRetries: 3}
+
+type CancelOrderSagaTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *CancelOrderSagaTestSuite) BeforeEach(_ provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *CancelOrderSagaTestSuite) TestHandleItemsReleased(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		event       cancelOrder.ItemsReleased
+		setup       func(uow *mocks.UoWMock, orderID uuid.UUID)
+		expectedErr error
+	}{
+		{
+			name: "Success: courier not released yet",
+			event: cancelOrder.ItemsReleased{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CancelOrder, orderID).
+					Return(mothers.SagaReleasingItemsAndCourier(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.AwaitingCourierRelease
+				})).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: courier already released",
+			event: cancelOrder.ItemsReleased{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CancelOrder, orderID).
+					Return(mothers.SagaAwaitingItemsRelease(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.CancelingByCustomer
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(cancelOrder.CancelByCustomerCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: outbox error",
+			event: cancelOrder.ItemsReleased{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CancelOrder, orderID).
+					Return(mothers.SagaAwaitingItemsRelease(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.CancelingByCustomer
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(cancelOrder.CancelByCustomerCmdName)).
+					Return(errors.New("outbox error")).Once()
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CancelOrder, orderID).
+					Return(mothers.SagaAwaitingItemsRelease(orderID), nil).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.LastError != nil && instance.LastError.Message == "outbox error"
+				})).Return(nil).Once()
+			},
+			expectedErr: errors.New("outbox error"),
+		},
+		{
+			name: "Failure: items already released",
+			event: cancelOrder.ItemsReleased{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CancelOrder, orderID).
+					Return(mothers.SagaAwaitingCourierRelease(orderID), nil).Once()
+			},
+			expectedErr: cancelOrder.ErrItemsReleaseNotAwaited,
+		},
+		{
+			name: "Failure: cancellation started while the items were being reserved",
+			event: cancelOrder.ItemsReleased{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CancelOrder, orderID).
+					Return(mothers.SagaCancelingByCustomer(orderID), nil).Once()
+			},
+			expectedErr: cancelOrder.ErrItemsReleaseNotAwaited,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			uc := cancelOrder.New(uow, cancelSagaCfg)
+			tc.setup(uow, tc.event.OrderID)
+
+			err := uc.HandleItemsReleased(s.ctx, tc.event)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			uow.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *CancelOrderSagaTestSuite) TestHandleCourierReleased(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		event       cancelOrder.CourierReleased
+		setup       func(uow *mocks.UoWMock, orderID uuid.UUID)
+		expectedErr error
+	}{
+		{
+			name: "Success: items not released yet",
+			event: cancelOrder.CourierReleased{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CancelOrder, orderID).
+					Return(mothers.SagaReleasingItemsAndCourier(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.AwaitingItemsRelease
+				})).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: items already released",
+			event: cancelOrder.CourierReleased{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CancelOrder, orderID).
+					Return(mothers.SagaAwaitingCourierRelease(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.CancelingByCustomer
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(cancelOrder.CancelByCustomerCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: saga not found",
+			event: cancelOrder.CourierReleased{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CancelOrder, orderID).
+					Return((*sagaDomain.Saga)(nil), errors.New("saga repository error")).Once()
+			},
+			expectedErr: errors.New("saga repository error"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			uc := cancelOrder.New(uow, cancelSagaCfg)
+			tc.setup(uow, tc.event.OrderID)
+
+			err := uc.HandleCourierReleased(s.ctx, tc.event)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			uow.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *CancelOrderSagaTestSuite) TestHandleDeadlineExceeded(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		setup       func(uow *mocks.UoWMock, orderID uuid.UUID)
+		expectedErr error
+	}{
+		{
+			name: "Success: Courier release sent again",
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CancelOrder, orderID).
+					Return(mothers.SagaAwaitingCourierReleaseRetried(orderID, cancelSagaCfg.MaxRetries-1), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.AwaitingCourierRelease && instance.Retries() == cancelSagaCfg.MaxRetries
+				})).Return(nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(mothers.OrderCanceling(), nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(cancelOrder.ReleaseCourierCmdName)).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Cancellation completed once the retries ran out",
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CancelOrder, orderID).
+					Return(mothers.SagaAwaitingCourierReleaseRetried(orderID, cancelSagaCfg.MaxRetries), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.CancelingByCustomer &&
+						instance.LastError != nil && instance.LastError.Step == sagaDomain.AwaitingCourierRelease
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(cancelOrder.CancelByCustomerCmdName)).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: saga not found",
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CancelOrder, orderID).
+					Return((*sagaDomain.Saga)(nil), errors.New("saga repository error")).Once()
+			},
+			expectedErr: errors.New("saga repository error"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			uc := cancelOrder.New(uow, cancelSagaCfg)
+			orderID := uuid.New()
+			tc.setup(uow, orderID)
+
+			err := uc.HandleDeadlineExceeded(s.ctx, cancelOrder.DeadlineExceeded{OrderID: orderID})
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			uow.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *CancelOrderSagaTestSuite) TestRetryStep(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		setup       func(uow *mocks.UoWMock, orderID uuid.UUID)
+		expectedErr error
+	}{
+		{
+			name: "Success: Items and courier released again",
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CancelOrder, orderID).
+					Return(mothers.SagaReleasingItemsAndCourier(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.ReleasingItemsAndCourier && instance.History[len(instance.History)-1].Retry
+				})).Return(nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(mothers.OrderCanceling(), nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(cancelOrder.ReleaseItemsCmdName)).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(cancelOrder.ReleaseCourierCmdName)).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Only the courier released again",
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CancelOrder, orderID).
+					Return(mothers.SagaAwaitingCourierRelease(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.Anything).Return(nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(mothers.OrderCanceling(), nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(cancelOrder.ReleaseCourierCmdName)).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: saga not found",
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CancelOrder, orderID).
+					Return((*sagaDomain.Saga)(nil), errors.New("saga repository error")).Once()
+			},
+			expectedErr: errors.New("saga repository error"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			uc := cancelOrder.New(uow, cancelSagaCfg)
+			orderID := uuid.New()
+			tc.setup(uow, orderID)
+
+			err := uc.RetryStep(s.ctx, orderID)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			uow.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCancelOrderSagaTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(CancelOrderSagaTestSuite))
+}
@@ -0,0 +1,133 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	cancelOrder "order/internal/application/order/saga/cancel_order"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/mocks"
+	cancelOrderMock "order/internal/mocks/order/saga/cancel_order"
+	sagaMock "order/internal/mocks/saga"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+)
+
+type CancelOrderSagaWatchdogTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *CancelOrderSagaWatchdogTestSuite) BeforeEach(_ provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *CancelOrderSagaWatchdogTestSuite) TestRetryStalled(t provider.T) {
+	t.Parallel()
+
+	const (
+		deadline = time.Minute
+		lease    = 30 * time.Second
+	)
+	steps := sagaDomain.AwaitingSteps(sagaDomain.CancelOrder)
+
+	tests := []struct {
+		name            string
+		setup           func(saga *cancelOrderMock.SagaMock, sagaRepository *sagaMock.RepositoryMock)
+		expectedHandled int
+		expectedErr     bool
+	}{
+		{
+			name: "Success: Nothing stalled",
+			setup: func(_ *cancelOrderMock.SagaMock, sagaRepository *sagaMock.RepositoryMock) {
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CancelOrder, steps, mock.Anything, mock.Anything).
+					Return((*sagaDomain.Saga)(nil), nil).Once()
+			},
+			expectedHandled: 0,
+			expectedErr:     false,
+		},
+		{
+			name: "Success: Handles every claimed saga",
+			setup: func(saga *cancelOrderMock.SagaMock, sagaRepository *sagaMock.RepositoryMock) {
+				first := mothers.SagaReleasingItemsAndCourier(uuid.New())
+				second := mothers.SagaAwaitingItemsRelease(uuid.New())
+
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CancelOrder, steps, mock.Anything, mock.Anything).
+					Return(first, nil).Once()
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CancelOrder, steps, mock.Anything, mock.Anything).
+					Return(second, nil).Once()
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CancelOrder, steps, mock.Anything, mock.Anything).
+					Return((*sagaDomain.Saga)(nil), nil).Once()
+				saga.On("HandleDeadlineExceeded", s.ctx, cancelOrder.DeadlineExceeded{OrderID: first.OrderID}).
+					Return(nil).Once()
+				saga.On("HandleDeadlineExceeded", s.ctx, cancelOrder.DeadlineExceeded{OrderID: second.OrderID}).
+					Return(nil).Once()
+			},
+			expectedHandled: 2,
+			expectedErr:     false,
+		},
+		{
+			name: "Failure: Retry error does not stop the rest",
+			setup: func(saga *cancelOrderMock.SagaMock, sagaRepository *sagaMock.RepositoryMock) {
+				first := mothers.SagaReleasingItemsAndCourier(uuid.New())
+				second := mothers.SagaAwaitingCourierRelease(uuid.New())
+
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CancelOrder, steps, mock.Anything, mock.Anything).
+					Return(first, nil).Once()
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CancelOrder, steps, mock.Anything, mock.Anything).
+					Return(second, nil).Once()
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CancelOrder, steps, mock.Anything, mock.Anything).
+					Return((*sagaDomain.Saga)(nil), nil).Once()
+				saga.On("HandleDeadlineExceeded", s.ctx, cancelOrder.DeadlineExceeded{OrderID: first.OrderID}).
+					Return(errors.New("publisher error")).Once()
+				saga.On("HandleDeadlineExceeded", s.ctx, cancelOrder.DeadlineExceeded{OrderID: second.OrderID}).
+					Return(nil).Once()
+			},
+			expectedHandled: 1,
+			expectedErr:     true,
+		},
+		{
+			name: "Failure: Claim error",
+			setup: func(_ *cancelOrderMock.SagaMock, sagaRepository *sagaMock.RepositoryMock) {
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CancelOrder, steps, mock.Anything, mock.Anything).
+					Return((*sagaDomain.Saga)(nil), errors.New("saga repository error")).Once()
+			},
+			expectedHandled: 0,
+			expectedErr:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			saga := new(cancelOrderMock.SagaMock)
+			uow := mocks.NewUowMock()
+			sagaRepository := uow.SagaMock
+			watchdog := cancelOrder.NewWatchdog(saga, uow)
+			tc.setup(saga, sagaRepository)
+
+			handled, err := watchdog.RetryStalled(s.ctx, deadline, lease)
+
+			if tc.expectedErr {
+				t.Require().Error(err)
+			} else {
+				t.Require().NoError(err)
+			}
+			t.Require().Equal(tc.expectedHandled, handled)
+
+			saga.AssertExpectations(t)
+			uow.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCancelOrderSagaWatchdogTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(CancelOrderSagaWatchdogTestSuite))
+}
@@ -0,0 +1,112 @@
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	cancelOrder "order/internal/application/order/saga/cancel_order"
+	createOrder "order/internal/application/order/saga/create_order"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/infrastructure/memory"
+	createOrderConsumer "order/internal/presentation/saga/create_order"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+// CancelWhileReservingTestSuite runs a cancellation that supersedes a
+// create_order saga still waiting for its reservation through the replies that
+// follow, on the in-memory store.
+type CancelWhileReservingTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *CancelWhileReservingTestSuite) BeforeEach(_ provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *CancelWhileReservingTestSuite) TestLateReservationReleased(t provider.T) {
+	t.Parallel()
+
+	uow := memory.NewUoW(memory.NewStore())
+	handler := createOrderConsumer.NewHandler(createOrder.New(uow, sagaCfg), cancelOrder.New(uow, cancelSagaCfg))
+
+	order := mothers.OrderWithItems()
+	t.Require().NoError(uow.Order().Create(s.ctx, order))
+	createSaga := mothers.SagaReservingItems(order.ID)
+	t.Require().NoError(uow.Saga().Create(s.ctx, createSaga))
+
+	// The customer cancels before the warehouse replies to the reservation
+	err := cancelOrder.NewManager().Create(s.ctx, uow, order)
+	t.Require().NoError(err)
+	t.Require().Equal([]string{cancelOrder.ReleaseCourierCmdName}, s.drainOutbox(t, uow))
+
+	// The late reservation is handed back by the superseded saga
+	err = handler.Handle(s.ctx, s.result(t, createOrderConsumer.ItemsReservedName, createOrder.ItemsReserved{
+		OrderID: order.ID,
+	}))
+	t.Require().NoError(err)
+	t.Require().Equal([]string{createOrder.ReleaseItemsCmdName}, s.drainOutbox(t, uow))
+
+	// Its release is the superseded saga's, not the cancellation's
+	err = handler.Handle(s.ctx, s.result(t, createOrderConsumer.ItemsReleasedName, createOrder.ItemsReleased{
+		OrderID: order.ID,
+	}))
+	t.Require().NoError(err)
+	t.Require().Empty(s.drainOutbox(t, uow))
+	s.requireStep(t, uow, sagaDomain.CreateOrder, order.ID, sagaDomain.SupersededByCancel)
+	s.requireStep(t, uow, sagaDomain.CancelOrder, order.ID, sagaDomain.AwaitingCourierRelease)
+
+	// The cancellation completes once the courier is released
+	err = handler.Handle(s.ctx, s.result(t, createOrderConsumer.CourierReleasedName, cancelOrder.CourierReleased{
+		OrderID: order.ID,
+	}))
+	t.Require().NoError(err)
+	t.Require().Equal([]string{cancelOrder.CancelByCustomerCmdName}, s.drainOutbox(t, uow))
+	s.requireStep(t, uow, sagaDomain.CancelOrder, order.ID, sagaDomain.CancelingByCustomer)
+}
+
+func (s *CancelWhileReservingTestSuite) result(
+	t provider.T,
+	name createOrderConsumer.ResMessageName,
+	payload any,
+) *createOrderConsumer.ResMessage {
+	data, err := json.Marshal(payload)
+	t.Require().NoError(err)
+	return &createOrderConsumer.ResMessage{ID: uuid.New(), Name: name, Payload: data}
+}
+
+// drainOutbox removes the pending outbox messages and returns their names in
+// the order they would be published.
+func (s *CancelWhileReservingTestSuite) drainOutbox(t provider.T, uow *memory.UoW) []string {
+	var names []string
+	for {
+		message, err := uow.Outbox().ClaimPending(s.ctx, time.Now().Add(time.Minute))
+		t.Require().NoError(err)
+		if message == nil {
+			return names
+		}
+		names = append(names, message.Name)
+		t.Require().NoError(uow.Outbox().Delete(s.ctx, message))
+	}
+}
+
+func (s *CancelWhileReservingTestSuite) requireStep(
+	t provider.T,
+	uow *memory.UoW,
+	sagaType sagaDomain.Type,
+	orderID uuid.UUID,
+	step sagaDomain.Step,
+) {
+	instance, err := uow.Saga().GetByOrderID(s.ctx, sagaType, orderID)
+	t.Require().NoError(err)
+	t.Require().Equal(step, instance.Step)
+}
+
+func TestCancelWhileReservingTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(CancelWhileReservingTestSuite))
+}
@@ -2,10 +2,13 @@ package saga
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	createOrder "order/internal/application/order/saga/create_order"
 	orderDomain "order/internal/domain/order"
-	createOrderMock "order/internal/mocks/order/saga/create_order"
+	outboxDomain "order/internal/domain/outbox"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/mocks"
 	"order/internal/tests/testutils/mothers"
 	"testing"
 
@@ -29,25 +32,49 @@ func (s *CreateOrderSagaManagerTestSuite) TestCreate(t provider.T) {
 	tests := []struct {
 		name        string
 		order       *orderDomain.Order
-		setup       func(publisher *createOrderMock.PublisherMock)
+		setup       func(uow *mocks.UoWMock, order *orderDomain.Order)
 		expectedErr error
 	}{
 		{
 			name:  "Success",
 			order: mothers.DefaultOrder(),
-			setup: func(publisher *createOrderMock.PublisherMock) {
-				publisher.On("PublishReserveItemsCmd", mock.Anything, mock.Anything).Return(nil).Once()
+			setup: func(uow *mocks.UoWMock, order *orderDomain.Order) {
+				uow.SagaMock.On("Create", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.OrderID == order.ID &&
+						instance.Type == sagaDomain.CreateOrder &&
+						instance.Step == sagaDomain.ReservingItems
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, mock.MatchedBy(func(message *outboxDomain.Message) bool {
+					var cmd createOrder.ReserveItemsCmd
+					if err := json.Unmarshal(message.Payload, &cmd); err != nil {
+						return false
+					}
+					return message.Name == createOrder.ReserveItemsCmdName &&
+						cmd.OrderID == order.ID &&
+						len(cmd.Items) == len(order.Items) &&
+						cmd.Policy == order.Fulfillment
+				})).Return(nil).Once()
 			},
 			expectedErr: nil,
 		},
 		{
-			name:  "Failure: Publisher error",
+			name:  "Failure: Outbox error",
 			order: mothers.DefaultOrder(),
-			setup: func(publisher *createOrderMock.PublisherMock) {
-				publisher.On("PublishReserveItemsCmd", mock.Anything, mock.Anything).
-					Return(errors.New("publisher error")).Once()
+			setup: func(uow *mocks.UoWMock, _ *orderDomain.Order) {
+				uow.SagaMock.On("Create", s.ctx, mock.Anything).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, mock.Anything).
+					Return(errors.New("outbox error")).Once()
 			},
-			expectedErr: nil,
+			expectedErr: errors.New("outbox error"),
+		},
+		{
+			name:  "Failure: Saga repository error",
+			order: mothers.DefaultOrder(),
+			setup: func(uow *mocks.UoWMock, _ *orderDomain.Order) {
+				uow.SagaMock.On("Create", s.ctx, mock.Anything).
+					Return(errors.New("saga repository error")).Once()
+			},
+			expectedErr: errors.New("saga repository error"),
 		},
 	}
 
@@ -56,13 +83,20 @@ func (s *CreateOrderSagaManagerTestSuite) TestCreate(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 
-			publisher := new(createOrderMock.PublisherMock)
-			manager := createOrder.NewManager(publisher)
-			tc.setup(publisher)
+			uow := mocks.NewUowMock()
+			manager := createOrder.NewManager()
+			tc.setup(uow, tc.order)
+
+			err := manager.Create(s.ctx, uow, tc.order)
 
-			manager.Create(s.ctx, tc.order)
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
 
-			publisher.AssertExpectations(t)
+			uow.AssertExpectations(t)
 		})
 	}
 }
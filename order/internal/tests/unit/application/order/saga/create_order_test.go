@@ -2,13 +2,18 @@ package saga
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	createOrder "order/internal/application/order/saga/create_order"
 	orderDomain "order/internal/domain/order"
-	orderMock "order/internal/mocks/order"
-	createOrderMock "order/internal/mocks/order/saga/create_order"
+	outboxDomain "order/internal/domain/outbox"
+	promotionDomain "order/internal/domain/promotion"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/mocks"
 	"order/internal/tests/testutils/mothers"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 
@@ -17,6 +22,8 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+var sagaCfg = &createOrder.Config{CourierLeadTime: time.Hour}
+
 type CreateOrderSagaTestSuite struct {
 	suite.Suite
 	ctx context.Context
@@ -26,13 +33,37 @@ func (s *CreateOrderSagaTestSuite) BeforeEach(_ provider.T) {
 	s.ctx = context.Background()
 }
 
+func outboxMessage(name string) any {
+	return mock.MatchedBy(func(message *outboxDomain.Message) bool {
+		return message.Name == name
+	})
+}
+
 func (s *CreateOrderSagaTestSuite) TestHandleItemsReserved(t provider.T) {
 	t.Parallel()
 
+	partialOrder := mothers.OrderAllowingPartial()
+	partialItems := []createOrder.OrderItem{
+		{ProductID: partialOrder.Items[0].ProductID, Count: 1},
+		{ProductID: partialOrder.Items[1].ProductID, Count: 0},
+	}
+	// discounted holds the items of partialOrder, less the discount.
+	discounted := func(code string, amount orderDomain.Money) *orderDomain.Order {
+		order := mothers.OrderAllowingPartial()
+		order.Items = slices.Clone(partialOrder.Items)
+		_ = order.ApplyDiscount(code, amount)
+		return order
+	}
+	strictOrder := mothers.OrderWithItems()
+	strictItems := []createOrder.OrderItem{
+		{ProductID: strictOrder.Items[0].ProductID, Count: 1},
+		{ProductID: strictOrder.Items[1].ProductID, Count: 1},
+	}
+
 	tests := []struct {
 		name        string
 		event       createOrder.ItemsReserved
-		setup       func(publisher *createOrderMock.PublisherMock, repository *orderMock.RepositoryMock)
+		setup       func(uow *mocks.UoWMock, orderID uuid.UUID)
 		expectedErr error
 	}{
 		{
@@ -40,22 +71,315 @@ func (s *CreateOrderSagaTestSuite) TestHandleItemsReserved(t provider.T) {
 			event: createOrder.ItemsReserved{
 				OrderID: uuid.New(),
 			},
-			setup: func(publisher *createOrderMock.PublisherMock, _ *orderMock.RepositoryMock) {
-				publisher.On("PublishAssignCourierCmd", s.ctx, mock.Anything).
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(mothers.DefaultOrder(), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.AssigningCourier && instance.LastError == nil
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.AssignCourierCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Suspended until the courier lead time before the delivery slot",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				order := mothers.OrderWithSlot(mothers.SlotStartingIn(24 * time.Hour))
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(order, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.AwaitingDeliverySlot &&
+						instance.ResumeAt != nil &&
+						instance.ResumeAt.Equal(order.Delivery.Slot.Start.Add(-sagaCfg.CourierLeadTime))
+				})).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Delivery slot within the courier lead time",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).
+					Return(mothers.OrderWithSlot(mothers.SlotStartingIn(30*time.Minute)), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.AssigningCourier && instance.ResumeAt == nil
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.AssignCourierCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Partially fulfilled",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+				Items:   partialItems,
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(partialOrder, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.AssigningCourier
+				})).Return(nil).Once()
+				uow.OrderMock.On("Update", s.ctx, mock.MatchedBy(func(order *orderDomain.Order) bool {
+					return order.Items[0].Count == 1 && order.Items[0].Requested == 2 &&
+						order.Items[1].Count == 0 && order.Items[1].Requested == 1
+				})).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, outboxMessage(orderDomain.PartiallyFulfilledEventName)).
+					Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.AssignCourierCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Ignored once superseded by a cancellation after the reservation",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+				Items:   partialItems,
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaSupersededByCancel(orderID), nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Late reservation released once superseded by a cancellation",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+				Items:   partialItems,
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaSupersededReservingItems(orderID), nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.ReleaseItemsCmdName)).
 					Return(nil).Once()
 			},
 			expectedErr: nil,
 		},
 		{
-			name: "Failure: publisher error",
+			name: "Success: Late reservation released after a timeout",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+				Items:   partialItems,
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaTimedOutReservingItems(orderID), nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, mock.MatchedBy(func(message *outboxDomain.Message) bool {
+					var cmd createOrder.ReleaseItemsCmd
+					if err := json.Unmarshal(message.Payload, &cmd); err != nil {
+						return false
+					}
+					return message.Name == createOrder.ReleaseItemsCmdName &&
+						len(cmd.Items) == 1 &&
+						cmd.Items[0] == partialItems[0]
+				})).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Late reservation of every item released after a timeout",
 			event: createOrder.ItemsReserved{
 				OrderID: uuid.New(),
 			},
-			setup: func(publisher *createOrderMock.PublisherMock, _ *orderMock.RepositoryMock) {
-				publisher.On("PublishAssignCourierCmd", s.ctx, mock.Anything).
-					Return(errors.New("publisher error")).Once()
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				order := mothers.OrderWithItems()
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaTimedOutReservingItems(orderID), nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(order, nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, mock.MatchedBy(func(message *outboxDomain.Message) bool {
+					var cmd createOrder.ReleaseItemsCmd
+					if err := json.Unmarshal(message.Payload, &cmd); err != nil {
+						return false
+					}
+					return message.Name == createOrder.ReleaseItemsCmdName &&
+						len(cmd.Items) == len(order.Items)
+				})).Return(nil).Once()
 			},
-			expectedErr: errors.New("publisher error"),
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Redelivered reply ignored after the items were released on timeout",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaTimedOutAssigningCourier(orderID), nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Percentage discount repriced for the reserved items",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+				Items:   partialItems,
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				promotion := mothers.PercentagePromotion(10)
+				order := discounted(promotion.Code, mothers.USD("1.12"))
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(order, nil).Once()
+				uow.PromotionMock.On("GetByCode", s.ctx, promotion.Code).Return(promotion, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.Anything).Return(nil).Once()
+				uow.OrderMock.On("Update", s.ctx, mock.MatchedBy(func(order *orderDomain.Order) bool {
+					return order.Discount != nil && order.Discount.Amount.Equal(mothers.USD("0.45"))
+				})).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, outboxMessage(orderDomain.PartiallyFulfilledEventName)).
+					Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.AssignCourierCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Discount dropped below the promotion minimum",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+				Items:   partialItems,
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				promotion := mothers.FixedAmountPromotion("2")
+				minimum := mothers.USD("10")
+				promotion.Rules.MinOrderValue = &minimum
+				order := discounted(promotion.Code, mothers.USD("2"))
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(order, nil).Once()
+				uow.PromotionMock.On("GetByCode", s.ctx, promotion.Code).Return(promotion, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.Anything).Return(nil).Once()
+				uow.OrderMock.On("Update", s.ctx, mock.MatchedBy(func(order *orderDomain.Order) bool {
+					return order.Discount == nil && order.Total().Equal(mothers.USD("4.50"))
+				})).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, outboxMessage(orderDomain.PartiallyFulfilledEventName)).
+					Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.AssignCourierCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: Promotion of the discount not found",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+				Items:   partialItems,
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				order := discounted("SAVE", mothers.USD("1"))
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(order, nil).Once()
+				uow.PromotionMock.On("GetByCode", s.ctx, "SAVE").
+					Return((*promotionDomain.Promotion)(nil), errors.New("promotion repository error")).Once()
+			},
+			expectedErr: errors.New("promotion repository error"),
+		},
+		{
+			name: "Failure: Partially reserved order that needs every item",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+				Items:   strictItems,
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(strictOrder, nil).Once()
+			},
+			expectedErr: orderDomain.ErrInvalidFulfillment,
+		},
+		{
+			name: "Failure: order not found",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).
+					Return((*orderDomain.Order)(nil), errors.New("order repository error")).Once()
+			},
+			expectedErr: errors.New("order repository error"),
+		},
+		{
+			name: "Failure: outbox error",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(mothers.DefaultOrder(), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.AssigningCourier && instance.LastError == nil
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.AssignCourierCmdName)).
+					Return(errors.New("outbox error")).Once()
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.LastError != nil && instance.LastError.Message == "outbox error"
+				})).Return(nil).Once()
+			},
+			expectedErr: errors.New("outbox error"),
+		},
+		{
+			name: "Failure: saga advanced concurrently",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(mothers.DefaultOrder(), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.Anything).
+					Return(errors.New("saga repository error")).Once()
+			},
+			expectedErr: errors.New("saga repository error"),
+		},
+		{
+			name: "Failure: saga not found",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return((*sagaDomain.Saga)(nil), errors.New("saga repository error")).Once()
+			},
+			expectedErr: errors.New("saga repository error"),
+		},
+		{
+			name: "Failure: unsupported step transition",
+			event: createOrder.ItemsReserved{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaAssigningCourier(orderID), nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(mothers.DefaultOrder(), nil).Once()
+			},
+			expectedErr: sagaDomain.ErrUnsupportedStepTransition,
 		},
 	}
 
@@ -64,10 +388,9 @@ func (s *CreateOrderSagaTestSuite) TestHandleItemsReserved(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 
-			publisher := new(createOrderMock.PublisherMock)
-			repository := new(orderMock.RepositoryMock)
-			uc := createOrder.New(publisher, repository)
-			tc.setup(publisher, repository)
+			uow := mocks.NewUowMock()
+			uc := createOrder.New(uow, sagaCfg)
+			tc.setup(uow, tc.event.OrderID)
 
 			err := uc.HandleItemsReserved(s.ctx, tc.event)
 
@@ -78,8 +401,7 @@ func (s *CreateOrderSagaTestSuite) TestHandleItemsReserved(t provider.T) {
 				t.Require().EqualError(err, tc.expectedErr.Error())
 			}
 
-			publisher.AssertExpectations(t)
-			repository.AssertExpectations(t)
+			uow.AssertExpectations(t)
 		})
 	}
 }
@@ -90,7 +412,7 @@ func (s *CreateOrderSagaTestSuite) TestHandleItemsReservationFailed(t provider.T
 	tests := []struct {
 		name        string
 		event       createOrder.ItemsReservationFailed
-		setup       func(publisher *createOrderMock.PublisherMock, repository *orderMock.RepositoryMock)
+		setup       func(uow *mocks.UoWMock, orderID uuid.UUID)
 		expectedErr error
 	}{
 		{
@@ -98,22 +420,39 @@ func (s *CreateOrderSagaTestSuite) TestHandleItemsReservationFailed(t provider.T
 			event: createOrder.ItemsReservationFailed{
 				OrderID: uuid.New(),
 			},
-			setup: func(publisher *createOrderMock.PublisherMock, _ *orderMock.RepositoryMock) {
-				publisher.On("PublishCancelOutOfStockCmd", s.ctx, mock.Anything).
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.CancelingOutOfStock
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.CancelOutOfStockCmdName)).
 					Return(nil).Once()
 			},
 			expectedErr: nil,
 		},
 		{
-			name: "Failure: publisher error",
+			name: "Failure: outbox error",
 			event: createOrder.ItemsReservationFailed{
 				OrderID: uuid.New(),
 			},
-			setup: func(publisher *createOrderMock.PublisherMock, _ *orderMock.RepositoryMock) {
-				publisher.On("PublishCancelOutOfStockCmd", s.ctx, mock.Anything).
-					Return(errors.New("publisher error")).Once()
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.CancelingOutOfStock
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.CancelOutOfStockCmdName)).
+					Return(errors.New("outbox error")).Once()
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.LastError != nil && instance.LastError.Message == "outbox error"
+				})).Return(nil).Once()
 			},
-			expectedErr: errors.New("publisher error"),
+			expectedErr: errors.New("outbox error"),
 		},
 	}
 
@@ -122,10 +461,9 @@ func (s *CreateOrderSagaTestSuite) TestHandleItemsReservationFailed(t provider.T
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 
-			publisher := new(createOrderMock.PublisherMock)
-			repository := new(orderMock.RepositoryMock)
-			uc := createOrder.New(publisher, repository)
-			tc.setup(publisher, repository)
+			uow := mocks.NewUowMock()
+			uc := createOrder.New(uow, sagaCfg)
+			tc.setup(uow, tc.event.OrderID)
 
 			err := uc.HandleItemsReservationFailed(s.ctx, tc.event)
 
@@ -136,8 +474,7 @@ func (s *CreateOrderSagaTestSuite) TestHandleItemsReservationFailed(t provider.T
 				t.Require().EqualError(err, tc.expectedErr.Error())
 			}
 
-			publisher.AssertExpectations(t)
-			repository.AssertExpectations(t)
+			uow.AssertExpectations(t)
 		})
 	}
 }
@@ -148,9 +485,7 @@ func (s *CreateOrderSagaTestSuite) TestHandleCourierAssignmentFailed(t provider.
 	tests := []struct {
 		name        string
 		event       createOrder.CourierAssignmentFailed
-		order       *orderDomain.Order
-		repoErr     error
-		setup       func(publisher *createOrderMock.PublisherMock, repository *orderMock.RepositoryMock, order *orderDomain.Order)
+		setup       func(uow *mocks.UoWMock, orderID uuid.UUID)
 		expectedErr error
 	}{
 		{
@@ -158,11 +493,44 @@ func (s *CreateOrderSagaTestSuite) TestHandleCourierAssignmentFailed(t provider.
 			event: createOrder.CourierAssignmentFailed{
 				OrderID: uuid.New(),
 			},
-			order:   mothers.DefaultOrder(),
-			repoErr: nil,
-			setup: func(publisher *createOrderMock.PublisherMock, repository *orderMock.RepositoryMock, order *orderDomain.Order) {
-				publisher.On("PublishReleaseItemsCmd", s.ctx, mock.Anything).Return(nil).Once()
-				repository.On("GetByID", s.ctx, mock.Anything).Return(order, nil).Once()
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaAssigningCourier(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.ReleasingItems
+				})).Return(nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).
+					Return(mothers.DefaultOrder(), nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.ReleaseItemsCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Lines with nothing reserved are not released",
+			event: createOrder.CourierAssignmentFailed{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				order := mothers.OrderAllowingPartial()
+				order.Items[1].Count = 0
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaAssigningCourier(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.ReleasingItems
+				})).Return(nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(order, nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, mock.MatchedBy(func(message *outboxDomain.Message) bool {
+					var cmd createOrder.ReleaseItemsCmd
+					if err := json.Unmarshal(message.Payload, &cmd); err != nil {
+						return false
+					}
+					return message.Name == createOrder.ReleaseItemsCmdName &&
+						len(cmd.Items) == 1 &&
+						cmd.Items[0].ProductID == order.Items[0].ProductID
+				})).Return(nil).Once()
 			},
 			expectedErr: nil,
 		},
@@ -171,38 +539,57 @@ func (s *CreateOrderSagaTestSuite) TestHandleCourierAssignmentFailed(t provider.
 			event: createOrder.CourierAssignmentFailed{
 				OrderID: uuid.New(),
 			},
-			order:   nil,
-			repoErr: errors.New("repository error"),
-			setup: func(_ *createOrderMock.PublisherMock, repository *orderMock.RepositoryMock, _ *orderDomain.Order) {
-				repository.On("GetByID", s.ctx, mock.Anything).
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaAssigningCourier(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.ReleasingItems && instance.LastError == nil
+				})).Return(nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).
 					Return((*orderDomain.Order)(nil), errors.New("repository error")).Once()
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaAssigningCourier(orderID), nil).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.LastError != nil && instance.LastError.Message == "repository error"
+				})).Return(nil).Once()
 			},
 			expectedErr: errors.New("repository error"),
 		},
 		{
-			name: "Failure: publisher error",
+			name: "Failure: outbox error",
 			event: createOrder.CourierAssignmentFailed{
 				OrderID: uuid.New(),
 			},
-			order:   mothers.DefaultOrder(),
-			repoErr: nil,
-			setup: func(publisher *createOrderMock.PublisherMock, repository *orderMock.RepositoryMock, order *orderDomain.Order) {
-				publisher.On("PublishReleaseItemsCmd", s.ctx, mock.Anything).
-					Return(errors.New("publisher error")).Once()
-				repository.On("GetByID", s.ctx, mock.Anything).Return(order, nil).Once()
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaAssigningCourier(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.ReleasingItems
+				})).Return(nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).
+					Return(mothers.DefaultOrder(), nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.ReleaseItemsCmdName)).
+					Return(errors.New("outbox error")).Once()
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaAssigningCourier(orderID), nil).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.LastError != nil && instance.LastError.Message == "outbox error"
+				})).Return(nil).Once()
 			},
-			expectedErr: errors.New("publisher error"),
+			expectedErr: errors.New("outbox error"),
 		},
 	}
 
 	for _, tc := range tests {
+		tc := tc
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 
-			publisher := new(createOrderMock.PublisherMock)
-			repository := new(orderMock.RepositoryMock)
-			tc.setup(publisher, repository, tc.order)
-			uc := createOrder.New(publisher, repository)
+			uow := mocks.NewUowMock()
+			uc := createOrder.New(uow, sagaCfg)
+			tc.setup(uow, tc.event.OrderID)
 
 			err := uc.HandleCourierAssignmentFailed(s.ctx, tc.event)
 
@@ -213,8 +600,7 @@ func (s *CreateOrderSagaTestSuite) TestHandleCourierAssignmentFailed(t provider.
 				t.Require().EqualError(err, tc.expectedErr.Error())
 			}
 
-			publisher.AssertExpectations(t)
-			repository.AssertExpectations(t)
+			uow.AssertExpectations(t)
 		})
 	}
 }
@@ -225,7 +611,7 @@ func (s *CreateOrderSagaTestSuite) TestHandleItemsReleased(t provider.T) {
 	tests := []struct {
 		name        string
 		event       createOrder.ItemsReleased
-		setup       func(publisher *createOrderMock.PublisherMock, repository *orderMock.RepositoryMock)
+		setup       func(uow *mocks.UoWMock, orderID uuid.UUID)
 		expectedErr error
 	}{
 		{
@@ -233,22 +619,78 @@ func (s *CreateOrderSagaTestSuite) TestHandleItemsReleased(t provider.T) {
 			event: createOrder.ItemsReleased{
 				OrderID: uuid.New(),
 			},
-			setup: func(publisher *createOrderMock.PublisherMock, _ *orderMock.RepositoryMock) {
-				publisher.On("PublishCancelCourierNotFoundCmd", s.ctx, mock.Anything).
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReleasingItems(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.CancelingCourierNotFound
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.CancelCourierNotFoundCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Released on timeout",
+			event: createOrder.ItemsReleased{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReleasingItemsOnTimeout(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.CancelingTimeout
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.CancelTimeoutCmdName)).
 					Return(nil).Once()
 			},
 			expectedErr: nil,
 		},
 		{
-			name: "Failure: publisher error",
+			name: "Success: Release of a late reservation needs no follow-up",
+			event: createOrder.ItemsReleased{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaTimedOutReservingItems(orderID), nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Release of a reservation superseded by a cancellation needs no follow-up",
 			event: createOrder.ItemsReleased{
 				OrderID: uuid.New(),
 			},
-			setup: func(publisher *createOrderMock.PublisherMock, _ *orderMock.RepositoryMock) {
-				publisher.On("PublishCancelCourierNotFoundCmd", s.ctx, mock.Anything).
-					Return(errors.New("publisher error")).Once()
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaSupersededReservingItems(orderID), nil).Once()
 			},
-			expectedErr: errors.New("publisher error"),
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: outbox error",
+			event: createOrder.ItemsReleased{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReleasingItems(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.CancelingCourierNotFound
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.CancelCourierNotFoundCmdName)).
+					Return(errors.New("outbox error")).Once()
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReleasingItems(orderID), nil).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.LastError != nil && instance.LastError.Message == "outbox error"
+				})).Return(nil).Once()
+			},
+			expectedErr: errors.New("outbox error"),
 		},
 	}
 
@@ -257,10 +699,9 @@ func (s *CreateOrderSagaTestSuite) TestHandleItemsReleased(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 
-			publisher := new(createOrderMock.PublisherMock)
-			repository := new(orderMock.RepositoryMock)
-			uc := createOrder.New(publisher, repository)
-			tc.setup(publisher, repository)
+			uow := mocks.NewUowMock()
+			uc := createOrder.New(uow, sagaCfg)
+			tc.setup(uow, tc.event.OrderID)
 
 			err := uc.HandleItemsReleased(s.ctx, tc.event)
 
@@ -271,8 +712,7 @@ func (s *CreateOrderSagaTestSuite) TestHandleItemsReleased(t provider.T) {
 				t.Require().EqualError(err, tc.expectedErr.Error())
 			}
 
-			publisher.AssertExpectations(t)
-			repository.AssertExpectations(t)
+			uow.AssertExpectations(t)
 		})
 	}
 }
@@ -283,7 +723,7 @@ func (s *CreateOrderSagaTestSuite) TestHandleCourierAssigned(t provider.T) {
 	tests := []struct {
 		name        string
 		event       createOrder.CourierAssigned
-		setup       func(publisher *createOrderMock.PublisherMock, repository *orderMock.RepositoryMock)
+		setup       func(uow *mocks.UoWMock, orderID uuid.UUID)
 		expectedErr error
 	}{
 		{
@@ -292,23 +732,52 @@ func (s *CreateOrderSagaTestSuite) TestHandleCourierAssigned(t provider.T) {
 				OrderID:   uuid.New(),
 				CourierID: uuid.New(),
 			},
-			setup: func(publisher *createOrderMock.PublisherMock, _ *orderMock.RepositoryMock) {
-				publisher.On("PublishBeginDeliveryCmd", s.ctx, mock.Anything).
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaAssigningCourier(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.BeginningDelivery
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.BeginDeliveryCmdName)).
 					Return(nil).Once()
 			},
 			expectedErr: nil,
 		},
 		{
-			name: "Failure: publisher error",
+			name: "Failure: outbox error",
 			event: createOrder.CourierAssigned{
 				OrderID:   uuid.New(),
 				CourierID: uuid.New(),
 			},
-			setup: func(publisher *createOrderMock.PublisherMock, _ *orderMock.RepositoryMock) {
-				publisher.On("PublishBeginDeliveryCmd", s.ctx, mock.Anything).
-					Return(errors.New("publisher error")).Once()
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaAssigningCourier(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.BeginningDelivery
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.BeginDeliveryCmdName)).
+					Return(errors.New("outbox error")).Once()
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaAssigningCourier(orderID), nil).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.LastError != nil && instance.LastError.Message == "outbox error"
+				})).Return(nil).Once()
 			},
-			expectedErr: errors.New("publisher error"),
+			expectedErr: errors.New("outbox error"),
+		},
+		{
+			name: "Success: saga superseded by cancel ignores the reply",
+			event: createOrder.CourierAssigned{
+				OrderID:   uuid.New(),
+				CourierID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaSupersededByCancel(orderID), nil).Once()
+			},
+			expectedErr: nil,
 		},
 	}
 
@@ -317,10 +786,9 @@ func (s *CreateOrderSagaTestSuite) TestHandleCourierAssigned(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 
-			publisher := new(createOrderMock.PublisherMock)
-			repository := new(orderMock.RepositoryMock)
-			uc := createOrder.New(publisher, repository)
-			tc.setup(publisher, repository)
+			uow := mocks.NewUowMock()
+			uc := createOrder.New(uow, sagaCfg)
+			tc.setup(uow, tc.event.OrderID)
 
 			err := uc.HandleCourierAssigned(s.ctx, tc.event)
 
@@ -331,8 +799,297 @@ func (s *CreateOrderSagaTestSuite) TestHandleCourierAssigned(t provider.T) {
 				t.Require().EqualError(err, tc.expectedErr.Error())
 			}
 
-			publisher.AssertExpectations(t)
-			repository.AssertExpectations(t)
+			uow.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *CreateOrderSagaTestSuite) TestHandleDeadlineExceeded(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		event       createOrder.DeadlineExceeded
+		setup       func(uow *mocks.UoWMock, orderID uuid.UUID)
+		expectedErr error
+	}{
+		{
+			name: "Success: Reserving items",
+			event: createOrder.DeadlineExceeded{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.CancelingTimeout
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.CancelTimeoutCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Assigning courier releases items",
+			event: createOrder.DeadlineExceeded{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaAssigningCourier(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.ReleasingItemsOnTimeout
+				})).Return(nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).
+					Return(mothers.DefaultOrder(), nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.ReleaseItemsCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Releasing items on timeout",
+			event: createOrder.DeadlineExceeded{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReleasingItemsOnTimeout(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.CancelingTimeout
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.CancelTimeoutCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: saga already advanced",
+			event: createOrder.DeadlineExceeded{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				instance := mothers.SagaAssigningCourier(orderID)
+				err := instance.NoteStep(sagaDomain.BeginningDelivery)
+				t.Require().NoError(err)
+
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(instance, nil).Once()
+			},
+			expectedErr: sagaDomain.ErrUnsupportedStepTransition,
+		},
+		{
+			name: "Failure: saga advanced concurrently",
+			event: createOrder.DeadlineExceeded{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaAssigningCourier(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.Anything).
+					Return(errors.New("saga repository error")).Once()
+			},
+			expectedErr: errors.New("saga repository error"),
+		},
+		{
+			name: "Failure: outbox error",
+			event: createOrder.DeadlineExceeded{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.CancelingTimeout
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.CancelTimeoutCmdName)).
+					Return(errors.New("outbox error")).Once()
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaReservingItems(orderID), nil).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.LastError != nil && instance.LastError.Message == "outbox error"
+				})).Return(nil).Once()
+			},
+			expectedErr: errors.New("outbox error"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			uc := createOrder.New(uow, sagaCfg)
+			tc.setup(uow, tc.event.OrderID)
+
+			err := uc.HandleDeadlineExceeded(s.ctx, tc.event)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			uow.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *CreateOrderSagaTestSuite) TestHandleDeliverySlotApproaching(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		event       createOrder.DeliverySlotApproaching
+		setup       func(uow *mocks.UoWMock, orderID uuid.UUID)
+		expectedErr error
+	}{
+		{
+			name: "Success",
+			event: createOrder.DeliverySlotApproaching{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaAwaitingDeliverySlot(orderID, time.Now()), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.AssigningCourier && instance.ResumeAt == nil
+				})).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.AssignCourierCmdName)).
+					Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: saga superseded by cancel",
+			event: createOrder.DeliverySlotApproaching{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaSupersededByCancel(orderID), nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: unsupported step transition",
+			event: createOrder.DeliverySlotApproaching{
+				OrderID: uuid.New(),
+			},
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaBeginningDelivery(orderID), nil).Once()
+			},
+			expectedErr: sagaDomain.ErrUnsupportedStepTransition,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			uc := createOrder.New(uow, sagaCfg)
+			tc.setup(uow, tc.event.OrderID)
+
+			err := uc.HandleDeliverySlotApproaching(s.ctx, tc.event)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			uow.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *CreateOrderSagaTestSuite) TestRetryStep(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		setup       func(uow *mocks.UoWMock, orderID uuid.UUID)
+		expectedErr error
+	}{
+		{
+			name: "Success: Reserve items sent again under the same ID",
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				instance := mothers.SagaReservingItems(orderID)
+				commandID := instance.CommandID(createOrder.ReserveItemsCmdName)
+				order := mothers.DefaultOrder()
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).Return(instance, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.MatchedBy(func(instance *sagaDomain.Saga) bool {
+					return instance.Step == sagaDomain.ReservingItems && instance.History[len(instance.History)-1].Retry
+				})).Return(nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, orderID).Return(order, nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, mock.MatchedBy(func(message *outboxDomain.Message) bool {
+					return message.Name == createOrder.ReserveItemsCmdName && message.ID == commandID
+				})).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Assign courier sent again",
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaAssigningCourier(orderID), nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SagaMock.On("Update", s.ctx, mock.Anything).Return(nil).Once()
+				uow.OutboxMock.On("CreateOnce", s.ctx, outboxMessage(createOrder.AssignCourierCmdName)).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: Step not awaiting a reply",
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return(mothers.SagaAwaitingDeliverySlot(orderID, time.Now().Add(time.Hour)), nil).Once()
+			},
+			expectedErr: sagaDomain.ErrStepNotRetryable,
+		},
+		{
+			name: "Failure: saga not found",
+			setup: func(uow *mocks.UoWMock, orderID uuid.UUID) {
+				uow.SagaMock.On("GetByOrderID", s.ctx, sagaDomain.CreateOrder, orderID).
+					Return((*sagaDomain.Saga)(nil), errors.New("saga repository error")).Once()
+			},
+			expectedErr: errors.New("saga repository error"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			uc := createOrder.New(uow, sagaCfg)
+			orderID := uuid.New()
+			tc.setup(uow, orderID)
+
+			err := uc.RetryStep(s.ctx, orderID)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			uow.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,230 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	createOrder "order/internal/application/order/saga/create_order"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/mocks"
+	createOrderMock "order/internal/mocks/order/saga/create_order"
+	sagaMock "order/internal/mocks/saga"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+)
+
+type CreateOrderSagaWatchdogTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *CreateOrderSagaWatchdogTestSuite) BeforeEach(_ provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *CreateOrderSagaWatchdogTestSuite) TestCompensateStalled(t provider.T) {
+	t.Parallel()
+
+	const (
+		deadline = time.Minute
+		lease    = 30 * time.Second
+	)
+	steps := sagaDomain.AwaitingSteps(sagaDomain.CreateOrder)
+
+	tests := []struct {
+		name                string
+		setup               func(saga *createOrderMock.SagaMock, sagaRepository *sagaMock.RepositoryMock)
+		expectedCompensated int
+		expectedErr         bool
+	}{
+		{
+			name: "Success: Nothing stalled",
+			setup: func(_ *createOrderMock.SagaMock, sagaRepository *sagaMock.RepositoryMock) {
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CreateOrder, steps, mock.Anything, mock.Anything).
+					Return((*sagaDomain.Saga)(nil), nil).Once()
+			},
+			expectedCompensated: 0,
+			expectedErr:         false,
+		},
+		{
+			name: "Success: Compensates every claimed saga",
+			setup: func(saga *createOrderMock.SagaMock, sagaRepository *sagaMock.RepositoryMock) {
+				first := mothers.SagaReservingItems(uuid.New())
+				second := mothers.SagaAssigningCourier(uuid.New())
+
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CreateOrder, steps, mock.Anything, mock.Anything).
+					Return(first, nil).Once()
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CreateOrder, steps, mock.Anything, mock.Anything).
+					Return(second, nil).Once()
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CreateOrder, steps, mock.Anything, mock.Anything).
+					Return((*sagaDomain.Saga)(nil), nil).Once()
+				saga.On("HandleDeadlineExceeded", s.ctx, createOrder.DeadlineExceeded{OrderID: first.OrderID}).
+					Return(nil).Once()
+				saga.On("HandleDeadlineExceeded", s.ctx, createOrder.DeadlineExceeded{OrderID: second.OrderID}).
+					Return(nil).Once()
+			},
+			expectedCompensated: 2,
+			expectedErr:         false,
+		},
+		{
+			name: "Failure: Compensation error does not stop the rest",
+			setup: func(saga *createOrderMock.SagaMock, sagaRepository *sagaMock.RepositoryMock) {
+				first := mothers.SagaReservingItems(uuid.New())
+				second := mothers.SagaReleasingItems(uuid.New())
+
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CreateOrder, steps, mock.Anything, mock.Anything).
+					Return(first, nil).Once()
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CreateOrder, steps, mock.Anything, mock.Anything).
+					Return(second, nil).Once()
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CreateOrder, steps, mock.Anything, mock.Anything).
+					Return((*sagaDomain.Saga)(nil), nil).Once()
+				saga.On("HandleDeadlineExceeded", s.ctx, createOrder.DeadlineExceeded{OrderID: first.OrderID}).
+					Return(errors.New("publisher error")).Once()
+				saga.On("HandleDeadlineExceeded", s.ctx, createOrder.DeadlineExceeded{OrderID: second.OrderID}).
+					Return(nil).Once()
+			},
+			expectedCompensated: 1,
+			expectedErr:         true,
+		},
+		{
+			name: "Failure: Claim error",
+			setup: func(_ *createOrderMock.SagaMock, sagaRepository *sagaMock.RepositoryMock) {
+				sagaRepository.On("ClaimStalled", s.ctx, sagaDomain.CreateOrder, steps, mock.Anything, mock.Anything).
+					Return((*sagaDomain.Saga)(nil), errors.New("saga repository error")).Once()
+			},
+			expectedCompensated: 0,
+			expectedErr:         true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			saga := new(createOrderMock.SagaMock)
+			uow := mocks.NewUowMock()
+			sagaRepository := uow.SagaMock
+			watchdog := createOrder.NewWatchdog(saga, uow)
+			tc.setup(saga, sagaRepository)
+
+			compensated, err := watchdog.CompensateStalled(s.ctx, deadline, lease)
+
+			if tc.expectedErr {
+				t.Require().Error(err)
+			} else {
+				t.Require().NoError(err)
+			}
+			t.Require().Equal(tc.expectedCompensated, compensated)
+
+			saga.AssertExpectations(t)
+			uow.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *CreateOrderSagaWatchdogTestSuite) TestResumeSuspended(t provider.T) {
+	t.Parallel()
+
+	const lease = 30 * time.Second
+
+	tests := []struct {
+		name            string
+		setup           func(saga *createOrderMock.SagaMock, sagaRepository *sagaMock.RepositoryMock)
+		expectedResumed int
+		expectedErr     bool
+	}{
+		{
+			name: "Success: Nothing due",
+			setup: func(_ *createOrderMock.SagaMock, sagaRepository *sagaMock.RepositoryMock) {
+				sagaRepository.On("ClaimSuspended", s.ctx, sagaDomain.CreateOrder, mock.Anything, mock.Anything).
+					Return((*sagaDomain.Saga)(nil), nil).Once()
+			},
+			expectedResumed: 0,
+			expectedErr:     false,
+		},
+		{
+			name: "Success: Resumes every claimed saga",
+			setup: func(saga *createOrderMock.SagaMock, sagaRepository *sagaMock.RepositoryMock) {
+				first := mothers.SagaAwaitingDeliverySlot(uuid.New(), time.Now())
+				second := mothers.SagaAwaitingDeliverySlot(uuid.New(), time.Now())
+
+				sagaRepository.On("ClaimSuspended", s.ctx, sagaDomain.CreateOrder, mock.Anything, mock.Anything).
+					Return(first, nil).Once()
+				sagaRepository.On("ClaimSuspended", s.ctx, sagaDomain.CreateOrder, mock.Anything, mock.Anything).
+					Return(second, nil).Once()
+				sagaRepository.On("ClaimSuspended", s.ctx, sagaDomain.CreateOrder, mock.Anything, mock.Anything).
+					Return((*sagaDomain.Saga)(nil), nil).Once()
+				saga.On("HandleDeliverySlotApproaching", s.ctx, createOrder.DeliverySlotApproaching{OrderID: first.OrderID}).
+					Return(nil).Once()
+				saga.On("HandleDeliverySlotApproaching", s.ctx, createOrder.DeliverySlotApproaching{OrderID: second.OrderID}).
+					Return(nil).Once()
+			},
+			expectedResumed: 2,
+			expectedErr:     false,
+		},
+		{
+			name: "Failure: Resume error does not stop the rest",
+			setup: func(saga *createOrderMock.SagaMock, sagaRepository *sagaMock.RepositoryMock) {
+				first := mothers.SagaAwaitingDeliverySlot(uuid.New(), time.Now())
+				second := mothers.SagaAwaitingDeliverySlot(uuid.New(), time.Now())
+
+				sagaRepository.On("ClaimSuspended", s.ctx, sagaDomain.CreateOrder, mock.Anything, mock.Anything).
+					Return(first, nil).Once()
+				sagaRepository.On("ClaimSuspended", s.ctx, sagaDomain.CreateOrder, mock.Anything, mock.Anything).
+					Return(second, nil).Once()
+				sagaRepository.On("ClaimSuspended", s.ctx, sagaDomain.CreateOrder, mock.Anything, mock.Anything).
+					Return((*sagaDomain.Saga)(nil), nil).Once()
+				saga.On("HandleDeliverySlotApproaching", s.ctx, createOrder.DeliverySlotApproaching{OrderID: first.OrderID}).
+					Return(errors.New("publisher error")).Once()
+				saga.On("HandleDeliverySlotApproaching", s.ctx, createOrder.DeliverySlotApproaching{OrderID: second.OrderID}).
+					Return(nil).Once()
+			},
+			expectedResumed: 1,
+			expectedErr:     true,
+		},
+		{
+			name: "Failure: Claim error",
+			setup: func(_ *createOrderMock.SagaMock, sagaRepository *sagaMock.RepositoryMock) {
+				sagaRepository.On("ClaimSuspended", s.ctx, sagaDomain.CreateOrder, mock.Anything, mock.Anything).
+					Return((*sagaDomain.Saga)(nil), errors.New("saga repository error")).Once()
+			},
+			expectedResumed: 0,
+			expectedErr:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			saga := new(createOrderMock.SagaMock)
+			uow := mocks.NewUowMock()
+			sagaRepository := uow.SagaMock
+			watchdog := createOrder.NewWatchdog(saga, uow)
+			tc.setup(saga, sagaRepository)
+
+			resumed, err := watchdog.ResumeSuspended(s.ctx, lease)
+
+			if tc.expectedErr {
+				t.Require().Error(err)
+			} else {
+				t.Require().NoError(err)
+			}
+			t.Require().Equal(tc.expectedResumed, resumed)
+
+			saga.AssertExpectations(t)
+			uow.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateOrderSagaWatchdogTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(CreateOrderSagaWatchdogTestSuite))
+}
@@ -0,0 +1,65 @@
+package saga
+
+import (
+	"context"
+	createOrder "order/internal/application/order/saga/create_order"
+	"order/internal/infrastructure/memory"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+// RetryWhilePendingTestSuite retries a create_order step whose command has not
+// been published yet, on the in-memory store.
+type RetryWhilePendingTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *RetryWhilePendingTestSuite) BeforeEach(_ provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *RetryWhilePendingTestSuite) TestCommandQueuedOnce(t provider.T) {
+	t.Parallel()
+
+	uow := memory.NewUoW(memory.NewStore())
+	saga := createOrder.New(uow, sagaCfg)
+
+	order := mothers.OrderWithItems()
+	t.Require().NoError(uow.Order().Create(s.ctx, order))
+	err := createOrder.NewManager().Create(s.ctx, uow, order)
+	t.Require().NoError(err)
+
+	// The reservation is retried while its command is still in the outbox
+	err = saga.RetryStep(s.ctx, order.ID)
+	t.Require().NoError(err)
+	t.Require().Equal([]string{createOrder.ReserveItemsCmdName}, s.drainOutbox(t, uow))
+
+	// Once published, a retry sends the command again
+	err = saga.RetryStep(s.ctx, order.ID)
+	t.Require().NoError(err)
+	t.Require().Equal([]string{createOrder.ReserveItemsCmdName}, s.drainOutbox(t, uow))
+}
+
+// drainOutbox removes the pending outbox messages and returns their names in
+// the order they would be published.
+func (s *RetryWhilePendingTestSuite) drainOutbox(t provider.T, uow *memory.UoW) []string {
+	var names []string
+	for {
+		message, err := uow.Outbox().ClaimPending(s.ctx, time.Now().Add(time.Minute))
+		t.Require().NoError(err)
+		if message == nil {
+			return names
+		}
+		names = append(names, message.Name)
+		t.Require().NoError(uow.Outbox().Delete(s.ctx, message))
+	}
+}
+
+func TestRetryWhilePendingTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(RetryWhilePendingTestSuite))
+}
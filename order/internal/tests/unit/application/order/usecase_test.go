@@ -3,9 +3,16 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	cancelOrder "order/internal/application/order/saga/cancel_order"
 	"order/internal/application/order/usecase"
+	slotUsecase "order/internal/application/slot/usecase"
 	orderDomain "order/internal/domain/order"
+	outboxDomain "order/internal/domain/outbox"
+	promotionDomain "order/internal/domain/promotion"
+	slotDomain "order/internal/domain/slot"
+	"order/internal/mocks"
 	orderMock "order/internal/mocks/order"
+	cancelOrderMock "order/internal/mocks/order/saga/cancel_order"
 	createOrderMock "order/internal/mocks/order/saga/create_order"
 	"order/internal/tests/testutils/mothers"
 	"testing"
@@ -18,6 +25,20 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+var policyCfg = &cancelOrder.PolicyConfig{
+	CreatedWindow:    time.Hour,
+	DeliveringWindow: time.Hour,
+}
+
+var scheduleCfg = &slotUsecase.ScheduleConfig{
+	DayStart:    0,
+	DayEnd:      24 * time.Hour,
+	Length:      time.Hour,
+	Capacity:    2,
+	HorizonDays: 2,
+	Timezone:    slotUsecase.Timezone{Location: time.UTC},
+}
+
 type OrderUseCaseTestSuite struct {
 	suite.Suite
 	ctx context.Context
@@ -30,59 +51,275 @@ func (s *OrderUseCaseTestSuite) BeforeEach(_ provider.T) {
 func (s *OrderUseCaseTestSuite) TestCreate(t provider.T) {
 	t.Parallel()
 
+	productID := uuid.New()
+	product := usecase.CatalogProduct{ID: productID, Name: "Test Product", Price: mothers.USD("100")}
+	newDto := func(price orderDomain.Money) usecase.CreateDto {
+		return usecase.CreateDto{
+			CustomerID: uuid.New(),
+			Address:    mothers.DefaultAddress(),
+			Items: []orderDomain.Item{
+				{
+					ProductID: productID,
+					Price:     price,
+					Count:     2,
+				},
+			},
+		}
+	}
+
+	promotion := mothers.FixedAmountPromotion("20")
+	withPromoCode := func(dto usecase.CreateDto, code string) usecase.CreateDto {
+		dto.PromoCode = code
+		return dto
+	}
+	slot := mothers.TomorrowSlot()
+	withSlot := func(dto usecase.CreateDto, slot orderDomain.DeliverySlot) usecase.CreateDto {
+		dto.Slot = &slot
+		return dto
+	}
+	withFulfillment := func(dto usecase.CreateDto, policy orderDomain.FulfillmentPolicy) usecase.CreateDto {
+		dto.Fulfillment = policy
+		return dto
+	}
+	highMinimum := mothers.FixedAmountPromotion("20")
+	minOrderValue := mothers.USD("500")
+	highMinimum.Rules.MinOrderValue = &minOrderValue
+
 	tests := []struct {
 		name        string
 		dto         usecase.CreateDto
-		setup       func(repo *orderMock.RepositoryMock, manager *createOrderMock.ManagerMock)
+		setup       func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock)
 		expectedErr error
 	}{
 		{
 			name: "Success",
-			dto: usecase.CreateDto{
-				CustomerID: uuid.New(),
-				Address:    "Test Address",
-				Items: []orderDomain.Item{
-					{
-						ProductID: uuid.New(),
-						Price:     decimal.NewFromInt(100),
-						Count:     1,
-					},
-				},
+			dto:  newDto(mothers.USD("100")),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Create", s.ctx, mock.MatchedBy(func(order *orderDomain.Order) bool {
+					item := order.Items[0]
+					return item.Name == product.Name &&
+						item.Price.Equal(product.Price) &&
+						order.Total().Equal(mothers.USD("200"))
+				})).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, mock.MatchedBy(func(message *outboxDomain.Message) bool {
+					return message.Name == orderDomain.CreatedEventName
+				})).Return(nil).Once()
+				manager.On("Create", s.ctx, uow, mock.Anything).Return(nil).Once()
 			},
-			setup: func(repo *orderMock.RepositoryMock, manager *createOrderMock.ManagerMock) {
-				repo.On("Create", s.ctx, mock.Anything).Return(nil).Once()
-				manager.On("Create", s.ctx, mock.Anything).Return(nil).Once()
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Partial fulfillment allowed",
+			dto:  withFulfillment(newDto(mothers.USD("100")), orderDomain.AllowPartial),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Create", s.ctx, mock.MatchedBy(func(order *orderDomain.Order) bool {
+					return order.Fulfillment == orderDomain.AllowPartial && order.Items[0].Requested == 2
+				})).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, mock.MatchedBy(func(message *outboxDomain.Message) bool {
+					return message.Name == orderDomain.CreatedEventName
+				})).Return(nil).Once()
+				manager.On("Create", s.ctx, uow, mock.Anything).Return(nil).Once()
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "Failure: Unknown fulfillment policy",
+			dto:  withFulfillment(newDto(mothers.USD("100")), "some_of_it"),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+			},
+			expectedErr: orderDomain.ErrInvalidFulfillmentPolicy,
+		},
 		{
 			name: "Failure: Create order error",
 			dto: usecase.CreateDto{
 				CustomerID: uuid.New(),
-				Address:    "Test Address",
+				Address:    mothers.DefaultAddress(),
 				Items:      []orderDomain.Item{},
 			},
-			setup:       func(repo *orderMock.RepositoryMock, manager *createOrderMock.ManagerMock) {},
+			setup:       func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {},
 			expectedErr: orderDomain.ErrInvalidItems,
 		},
 		{
-			name: "Failure: Repository create order error",
-			dto: usecase.CreateDto{
-				CustomerID: uuid.New(),
-				Address:    "Test Address",
-				Items: []orderDomain.Item{
-					{
-						ProductID: uuid.New(),
-						Price:     decimal.NewFromInt(100),
-						Count:     1,
-					},
-				},
+			name: "Failure: Catalog error",
+			dto:  newDto(mothers.USD("100")),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct(nil), errors.New("catalog error")).Once()
+			},
+			expectedErr: errors.New("catalog error"),
+		},
+		{
+			name: "Failure: Unknown product",
+			dto:  newDto(mothers.USD("100")),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{}, nil).Once()
+			},
+			expectedErr: orderDomain.ErrUnknownProduct,
+		},
+		{
+			name: "Failure: Price mismatch",
+			dto:  newDto(mothers.USD("90")),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+			},
+			expectedErr: orderDomain.ErrPriceMismatch,
+		},
+		{
+			name: "Failure: Price in another currency",
+			dto:  newDto(orderDomain.Money{Amount: decimal.NewFromInt(100), Currency: "EUR"}),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+			},
+			expectedErr: orderDomain.ErrPriceMismatch,
+		},
+		{
+			name: "Success: Promo code",
+			dto:  withPromoCode(newDto(mothers.USD("100")), promotion.Code),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+				uow.PromotionMock.On("GetByCode", s.ctx, promotion.Code).Return(promotion, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.PromotionMock.On("Redeem", s.ctx, promotion, mock.Anything).Return(nil).Once()
+				uow.OrderMock.On("Create", s.ctx, mock.MatchedBy(func(order *orderDomain.Order) bool {
+					return order.Discount != nil &&
+						order.Discount.Code == promotion.Code &&
+						order.Total().Equal(mothers.USD("180"))
+				})).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, mock.Anything).Return(nil).Once()
+				manager.On("Create", s.ctx, uow, mock.Anything).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: Unknown promo code",
+			dto:  withPromoCode(newDto(mothers.USD("100")), "UNKNOWN"),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+				uow.PromotionMock.On("GetByCode", s.ctx, "UNKNOWN").
+					Return((*promotionDomain.Promotion)(nil), errors.New("promotion not found")).Once()
+			},
+			expectedErr: errors.New("promotion not found"),
+		},
+		{
+			name: "Failure: Promotion minimum not met",
+			dto:  withPromoCode(newDto(mothers.USD("100")), highMinimum.Code),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+				uow.PromotionMock.On("GetByCode", s.ctx, highMinimum.Code).Return(highMinimum, nil).Once()
+			},
+			expectedErr: promotionDomain.ErrMinOrderValueNotMet,
+		},
+		{
+			name: "Failure: Promotion usage limit reached",
+			dto:  withPromoCode(newDto(mothers.USD("100")), promotion.Code),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+				uow.PromotionMock.On("GetByCode", s.ctx, promotion.Code).Return(promotion, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.PromotionMock.On("Redeem", s.ctx, promotion, mock.Anything).
+					Return(promotionDomain.ErrUsageLimitReached).Once()
+			},
+			expectedErr: promotionDomain.ErrUsageLimitReached,
+		},
+		{
+			name: "Success: Delivery slot",
+			dto:  withSlot(newDto(mothers.USD("100")), slot),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SlotMock.On("Reserve", s.ctx, slot, 2).Return(nil).Once()
+				uow.OrderMock.On("Create", s.ctx, mock.MatchedBy(func(order *orderDomain.Order) bool {
+					return order.Delivery.Slot != nil && *order.Delivery.Slot == slot
+				})).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, mock.Anything).Return(nil).Once()
+				manager.On("Create", s.ctx, uow, mock.Anything).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: Delivery slot ends before it starts",
+			dto:  withSlot(newDto(mothers.USD("100")), orderDomain.DeliverySlot{Start: slot.End, End: slot.Start}),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+			},
+			expectedErr: orderDomain.ErrInvalidDeliverySlot,
+		},
+		{
+			name: "Failure: Delivery slot not offered",
+			dto: withSlot(newDto(mothers.USD("100")), orderDomain.DeliverySlot{
+				Start: slot.Start.Add(30 * time.Minute),
+				End:   slot.End.Add(30 * time.Minute),
+			}),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+			},
+			expectedErr: slotDomain.ErrSlotUnavailable,
+		},
+		{
+			name: "Failure: Delivery slot fully booked",
+			dto:  withSlot(newDto(mothers.USD("100")), slot),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.SlotMock.On("Reserve", s.ctx, slot, 2).Return(slotDomain.ErrSlotFull).Once()
 			},
-			setup: func(repo *orderMock.RepositoryMock, manager *createOrderMock.ManagerMock) {
-				repo.On("Create", s.ctx, mock.Anything).Return(errors.New("repo error")).Once()
+			expectedErr: slotDomain.ErrSlotFull,
+		},
+		{
+			name: "Failure: Repository create order error",
+			dto:  newDto(mothers.USD("100")),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Create", s.ctx, mock.Anything).Return(errors.New("repo error")).Once()
 			},
 			expectedErr: errors.New("repo error"),
 		},
+		{
+			name: "Failure: Outbox error",
+			dto:  newDto(mothers.USD("100")),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Create", s.ctx, mock.Anything).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, mock.Anything).Return(errors.New("outbox error")).Once()
+			},
+			expectedErr: errors.New("outbox error"),
+		},
+		{
+			name: "Failure: Saga manager error",
+			dto:  newDto(mothers.USD("100")),
+			setup: func(uow *mocks.UoWMock, manager *createOrderMock.ManagerMock, catalog *orderMock.CatalogMock) {
+				catalog.On("GetProducts", s.ctx, []uuid.UUID{productID}).
+					Return(map[uuid.UUID]usecase.CatalogProduct{productID: product}, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Create", s.ctx, mock.Anything).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, mock.Anything).Return(nil).Once()
+				manager.On("Create", s.ctx, uow, mock.Anything).Return(errors.New("outbox error")).Once()
+			},
+			expectedErr: errors.New("outbox error"),
+		},
 	}
 
 	for _, tc := range tests {
@@ -90,10 +327,11 @@ func (s *OrderUseCaseTestSuite) TestCreate(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 
-			repo := new(orderMock.RepositoryMock)
+			uow := mocks.NewUowMock()
 			manager := new(createOrderMock.ManagerMock)
-			uc := usecase.New(repo, manager)
-			tc.setup(repo, manager)
+			catalog := new(orderMock.CatalogMock)
+			uc := usecase.New(uow, manager, new(cancelOrderMock.ManagerMock), policyCfg, scheduleCfg, catalog)
+			tc.setup(uow, manager, catalog)
 
 			orderID, err := uc.Create(s.ctx, tc.dto)
 
@@ -106,8 +344,9 @@ func (s *OrderUseCaseTestSuite) TestCreate(t provider.T) {
 				t.Require().Equal(uuid.Nil, orderID)
 			}
 
-			repo.AssertExpectations(t)
+			uow.AssertExpectations(t)
 			manager.AssertExpectations(t)
+			catalog.AssertExpectations(t)
 		})
 	}
 }
@@ -116,27 +355,45 @@ func (s *OrderUseCaseTestSuite) TestCancelByCustomer(t provider.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name        string
-		setup       func(repo *orderMock.RepositoryMock) *orderDomain.Order
-		expectedErr error
-		finalStatus orderDomain.Status
+		name          string
+		setup         func(uow *mocks.UoWMock, cancelManager *cancelOrderMock.ManagerMock) *orderDomain.Order
+		otherCustomer bool
+		reason        string
+		expectedErr   error
+		finalStatus   orderDomain.Status
 	}{
 		{
 			name: "Success: Order in Delivering",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
+			setup: func(uow *mocks.UoWMock, cancelManager *cancelOrderMock.ManagerMock) *orderDomain.Order {
 				o := mothers.OrderDelivering()
-				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
-				repo.On("Update", s.ctx, o).Return(nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				cancelManager.On("Create", s.ctx, uow, o).Return(nil).Once()
 				return o
 			},
+			reason:      "Found a better price",
 			expectedErr: nil,
-			finalStatus: orderDomain.CustomerCanceled,
+			finalStatus: orderDomain.Canceling,
+		},
+		{
+			name: "Success: Order in Created",
+			setup: func(uow *mocks.UoWMock, cancelManager *cancelOrderMock.ManagerMock) *orderDomain.Order {
+				o := mothers.DefaultOrder()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				cancelManager.On("Create", s.ctx, uow, o).Return(nil).Once()
+				return o
+			},
+			expectedErr: nil,
+			finalStatus: orderDomain.Canceling,
 		},
 		{
 			name: "Failure: repo.GetByID error",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
+			setup: func(uow *mocks.UoWMock, cancelManager *cancelOrderMock.ManagerMock) *orderDomain.Order {
 				o := mothers.DefaultOrder()
-				repo.On("GetByID", s.ctx, o.ID).
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).
 					Return((*orderDomain.Order)(nil), errors.New("not found")).Once()
 				return o
 			},
@@ -144,25 +401,50 @@ func (s *OrderUseCaseTestSuite) TestCancelByCustomer(t provider.T) {
 			finalStatus: orderDomain.Created,
 		},
 		{
-			name: "Failure: domain method error (order in Created)",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
-				o := mothers.DefaultOrder()
-				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+			name: "Failure: domain method error (order delivered)",
+			setup: func(uow *mocks.UoWMock, cancelManager *cancelOrderMock.ManagerMock) *orderDomain.Order {
+				o := mothers.OrderDelivered()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
 				return o
 			},
 			expectedErr: orderDomain.ErrUnsupportedStatusTransition,
-			finalStatus: orderDomain.Created,
+			finalStatus: orderDomain.Delivered,
 		},
 		{
 			name: "Failure: repo.Update error",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
+			setup: func(uow *mocks.UoWMock, cancelManager *cancelOrderMock.ManagerMock) *orderDomain.Order {
 				o := mothers.OrderDelivering()
-				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
-				repo.On("Update", s.ctx, o).Return(errors.New("update error")).Once()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(errors.New("update error")).Once()
 				return o
 			},
 			expectedErr: errors.New("update error"),
-			finalStatus: orderDomain.CustomerCanceled,
+			finalStatus: orderDomain.Canceling,
+		},
+		{
+			name: "Failure: Saga manager error",
+			setup: func(uow *mocks.UoWMock, cancelManager *cancelOrderMock.ManagerMock) *orderDomain.Order {
+				o := mothers.DefaultOrder()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				cancelManager.On("Create", s.ctx, uow, o).Return(orderDomain.ErrCancellationNotAllowed).Once()
+				return o
+			},
+			expectedErr: orderDomain.ErrCancellationNotAllowed,
+			finalStatus: orderDomain.Canceling,
+		},
+		{
+			name: "Failure: Order of another customer",
+			setup: func(uow *mocks.UoWMock, cancelManager *cancelOrderMock.ManagerMock) *orderDomain.Order {
+				o := mothers.OrderDelivering()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				return o
+			},
+			otherCustomer: true,
+			expectedErr:   orderDomain.ErrPermissionDenied,
+			finalStatus:   orderDomain.Delivering,
 		},
 	}
 	for _, tc := range tests {
@@ -170,78 +452,98 @@ func (s *OrderUseCaseTestSuite) TestCancelByCustomer(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 
-			repo := new(orderMock.RepositoryMock)
+			uow := mocks.NewUowMock()
 			manager := new(createOrderMock.ManagerMock)
-			uc := usecase.New(repo, manager)
-			o := tc.setup(repo)
+			cancelManager := new(cancelOrderMock.ManagerMock)
+			uc := usecase.New(uow, manager, cancelManager, policyCfg, scheduleCfg, new(orderMock.CatalogMock))
+			o := tc.setup(uow, cancelManager)
+
+			customerID := o.CustomerID
+			if tc.otherCustomer {
+				customerID = uuid.New()
+			}
 
-			err := uc.CancelByCustomer(s.ctx, o.ID)
+			err := uc.CancelByCustomer(s.ctx, usecase.CancelByCustomerDto{
+				OrderID:    o.ID,
+				CustomerID: customerID,
+				Reason:     tc.reason,
+			})
 
 			if tc.expectedErr == nil {
 				t.Require().NoError(err)
+				t.Require().Equal(tc.reason, o.CancelReason)
 			} else {
 				t.Require().Error(err)
 				t.Require().EqualError(err, tc.expectedErr.Error())
 			}
 			t.Require().Equal(tc.finalStatus, o.Status)
 
-			repo.AssertExpectations(t)
+			uow.AssertExpectations(t)
 			manager.AssertExpectations(t)
+			cancelManager.AssertExpectations(t)
 		})
 	}
 }
 
-func (s *OrderUseCaseTestSuite) TestCancelOutOfStock(t provider.T) {
+func (s *OrderUseCaseTestSuite) TestForceCancel(t provider.T) {
 	t.Parallel()
 
 	tests := []struct {
 		name        string
-		setup       func(repo *orderMock.RepositoryMock) *orderDomain.Order
+		setup       func(uow *mocks.UoWMock, cancelManager *cancelOrderMock.ManagerMock) *orderDomain.Order
+		reason      string
 		expectedErr error
 		finalStatus orderDomain.Status
 	}{
 		{
-			name: "Success: Order in Created (default)",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
-				o := mothers.DefaultOrder()
-				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
-				repo.On("Update", s.ctx, o).Return(nil).Once()
+			name: "Success: Order in Delivering",
+			setup: func(uow *mocks.UoWMock, cancelManager *cancelOrderMock.ManagerMock) *orderDomain.Order {
+				o := mothers.OrderDelivering()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				cancelManager.On("Create", s.ctx, uow, o).Return(nil).Once()
 				return o
 			},
+			reason:      "Fraudulent order",
 			expectedErr: nil,
-			finalStatus: orderDomain.CanceledOutOfStock,
+			finalStatus: orderDomain.Canceling,
 		},
 		{
-			name: "Failure: GetByID error",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
+			name: "Failure: Blank reason",
+			setup: func(uow *mocks.UoWMock, cancelManager *cancelOrderMock.ManagerMock) *orderDomain.Order {
 				o := mothers.DefaultOrder()
-				repo.On("GetByID", s.ctx, o.ID).
-					Return((*orderDomain.Order)(nil), errors.New("not found")).Once()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
 				return o
 			},
-			expectedErr: errors.New("not found"),
+			reason:      "",
+			expectedErr: orderDomain.ErrInvalidCancelReason,
 			finalStatus: orderDomain.Created,
 		},
 		{
-			name: "Failure: domain method error (order in Delivering)",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
-				o := mothers.OrderDelivering()
-				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+			name: "Failure: domain method error (order delivered)",
+			setup: func(uow *mocks.UoWMock, cancelManager *cancelOrderMock.ManagerMock) *orderDomain.Order {
+				o := mothers.OrderDelivered()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
 				return o
 			},
+			reason:      "Fraudulent order",
 			expectedErr: orderDomain.ErrUnsupportedStatusTransition,
-			finalStatus: orderDomain.Delivering,
+			finalStatus: orderDomain.Delivered,
 		},
 		{
-			name: "Failure: Update error",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
+			name: "Failure: Saga manager error",
+			setup: func(uow *mocks.UoWMock, cancelManager *cancelOrderMock.ManagerMock) *orderDomain.Order {
 				o := mothers.DefaultOrder()
-				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
-				repo.On("Update", s.ctx, o).Return(errors.New("update error")).Once()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				cancelManager.On("Create", s.ctx, uow, o).Return(orderDomain.ErrCancellationNotAllowed).Once()
 				return o
 			},
-			expectedErr: errors.New("update error"),
-			finalStatus: orderDomain.CanceledOutOfStock,
+			reason:      "Fraudulent order",
+			expectedErr: orderDomain.ErrCancellationNotAllowed,
+			finalStatus: orderDomain.Canceling,
 		},
 	}
 	for _, tc := range tests {
@@ -249,78 +551,92 @@ func (s *OrderUseCaseTestSuite) TestCancelOutOfStock(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 
-			repo := new(orderMock.RepositoryMock)
+			uow := mocks.NewUowMock()
 			manager := new(createOrderMock.ManagerMock)
-			uc := usecase.New(repo, manager)
-			o := tc.setup(repo)
+			cancelManager := new(cancelOrderMock.ManagerMock)
+			uc := usecase.New(uow, manager, cancelManager, policyCfg, scheduleCfg, new(orderMock.CatalogMock))
+			o := tc.setup(uow, cancelManager)
 
-			err := uc.CancelOutOfStock(s.ctx, o.ID)
+			err := uc.ForceCancel(s.ctx, usecase.ForceCancelDto{
+				OrderID: o.ID,
+				Reason:  tc.reason,
+			})
 
 			if tc.expectedErr == nil {
 				t.Require().NoError(err)
+				t.Require().Equal(tc.reason, o.CancelReason)
+				t.Require().Equal(orderDomain.AdminActor(), o.History[len(o.History)-1].Actor)
 			} else {
 				t.Require().Error(err)
 				t.Require().EqualError(err, tc.expectedErr.Error())
 			}
 			t.Require().Equal(tc.finalStatus, o.Status)
 
-			repo.AssertExpectations(t)
+			uow.AssertExpectations(t)
 			manager.AssertExpectations(t)
+			cancelManager.AssertExpectations(t)
 		})
 	}
 }
 
-func (s *OrderUseCaseTestSuite) TestCancelCourierNotFound(t provider.T) {
+func (s *OrderUseCaseTestSuite) TestCompleteCancellation(t provider.T) {
 	t.Parallel()
 
 	tests := []struct {
 		name        string
-		setup       func(repo *orderMock.RepositoryMock) *orderDomain.Order
+		setup       func(uow *mocks.UoWMock) *orderDomain.Order
 		expectedErr error
 		finalStatus orderDomain.Status
 	}{
 		{
-			name: "Success: Order in Created",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
-				o := mothers.DefaultOrder()
-				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
-				repo.On("Update", s.ctx, o).Return(nil).Once()
+			name: "Success: Order in Canceling",
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
+				o := mothers.OrderCanceling()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, eventMessage(o, orderDomain.CanceledEventName)).Return(nil).Once()
 				return o
 			},
 			expectedErr: nil,
-			finalStatus: orderDomain.CanceledCourierNotFound,
+			finalStatus: orderDomain.CustomerCanceled,
 		},
 		{
-			name: "Failure: GetByID error",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
+			name: "Success: Cancellation requested by admin",
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
 				o := mothers.DefaultOrder()
-				repo.On("GetByID", s.ctx, o.ID).
-					Return((*orderDomain.Order)(nil), errors.New("not found")).Once()
+				_ = o.ForceCancel("Fraudulent order")
+				o.PullEvents()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, eventMessage(o, orderDomain.CanceledEventName)).Return(nil).Once()
 				return o
 			},
-			expectedErr: errors.New("not found"),
-			finalStatus: orderDomain.Created,
+			expectedErr: nil,
+			finalStatus: orderDomain.CanceledByAdmin,
 		},
 		{
 			name: "Failure: domain method error (order in Delivering)",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
 				o := mothers.OrderDelivering()
-				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
 				return o
 			},
 			expectedErr: orderDomain.ErrUnsupportedStatusTransition,
 			finalStatus: orderDomain.Delivering,
 		},
 		{
-			name: "Failure: Update error",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
-				o := mothers.DefaultOrder()
-				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
-				repo.On("Update", s.ctx, o).Return(errors.New("update error")).Once()
+			name: "Failure: repo.Update error",
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
+				o := mothers.OrderCanceling()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(errors.New("update error")).Once()
 				return o
 			},
 			expectedErr: errors.New("update error"),
-			finalStatus: orderDomain.CanceledCourierNotFound,
+			finalStatus: orderDomain.CustomerCanceled,
 		},
 	}
 	for _, tc := range tests {
@@ -328,12 +644,12 @@ func (s *OrderUseCaseTestSuite) TestCancelCourierNotFound(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 
-			repo := new(orderMock.RepositoryMock)
+			uow := mocks.NewUowMock()
 			manager := new(createOrderMock.ManagerMock)
-			uc := usecase.New(repo, manager)
-			o := tc.setup(repo)
+			uc := usecase.New(uow, manager, new(cancelOrderMock.ManagerMock), policyCfg, scheduleCfg, new(orderMock.CatalogMock))
+			o := tc.setup(uow)
 
-			err := uc.CancelCourierNotFound(s.ctx, o.ID)
+			err := uc.CompleteCancellation(s.ctx, o.ID, uuid.New())
 
 			if tc.expectedErr == nil {
 				t.Require().NoError(err)
@@ -343,79 +659,126 @@ func (s *OrderUseCaseTestSuite) TestCancelCourierNotFound(t provider.T) {
 			}
 			t.Require().Equal(tc.finalStatus, o.Status)
 
-			repo.AssertExpectations(t)
+			uow.AssertExpectations(t)
 			manager.AssertExpectations(t)
 		})
 	}
 }
 
-func (s *OrderUseCaseTestSuite) TestBeginDelivery(t provider.T) {
+func (s *OrderUseCaseTestSuite) TestCancelOutOfStock(t provider.T) {
 	t.Parallel()
 
 	tests := []struct {
 		name        string
-		setup       func(repo *orderMock.RepositoryMock) (usecase.BeginDeliveryDto, *orderDomain.Order)
+		setup       func(uow *mocks.UoWMock, messageID uuid.UUID) *orderDomain.Order
 		expectedErr error
 		finalStatus orderDomain.Status
 	}{
 		{
-			name: "Success: Order in Created",
-			setup: func(repo *orderMock.RepositoryMock) (usecase.BeginDeliveryDto, *orderDomain.Order) {
+			name: "Success: Order in Created (default)",
+			setup: func(uow *mocks.UoWMock, messageID uuid.UUID) *orderDomain.Order {
 				o := mothers.DefaultOrder()
-				dto := usecase.BeginDeliveryDto{
-					OrderID:   o.ID,
-					CourierID: uuid.New(),
-				}
-				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
-				repo.On("Update", s.ctx, o).Return(nil).Once()
-				return dto, o
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, eventMessage(o, orderDomain.CanceledEventName)).Return(nil).Once()
+				return o
 			},
 			expectedErr: nil,
-			finalStatus: orderDomain.Delivering,
+			finalStatus: orderDomain.CanceledOutOfStock,
 		},
 		{
-			name: "Failure: domain method error (already in Delivering)",
-			setup: func(repo *orderMock.RepositoryMock) (usecase.BeginDeliveryDto, *orderDomain.Order) {
-				o := mothers.OrderDelivering()
-				dto := usecase.BeginDeliveryDto{
-					OrderID:   o.ID,
-					CourierID: uuid.New(),
-				}
-				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
-				return dto, o
+			name: "Success: Delivery slot released",
+			setup: func(uow *mocks.UoWMock, messageID uuid.UUID) *orderDomain.Order {
+				o := mothers.OrderWithSlot(mothers.TomorrowSlot())
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, eventMessage(o, orderDomain.CanceledEventName)).Return(nil).Once()
+				uow.SlotMock.On("Release", s.ctx, *o.Delivery.Slot).Return(nil).Once()
+				return o
 			},
-			expectedErr: orderDomain.ErrUnsupportedStatusTransition,
-			finalStatus: orderDomain.Delivering,
+			expectedErr: nil,
+			finalStatus: orderDomain.CanceledOutOfStock,
 		},
 		{
-			name: "Failure: repo.GetByID error",
-			setup: func(repo *orderMock.RepositoryMock) (usecase.BeginDeliveryDto, *orderDomain.Order) {
+			name: "Success: Promo code use released",
+			setup: func(uow *mocks.UoWMock, messageID uuid.UUID) *orderDomain.Order {
+				promotion := mothers.FixedAmountPromotion("10")
 				o := mothers.DefaultOrder()
-				dto := usecase.BeginDeliveryDto{
-					OrderID:   o.ID,
-					CourierID: uuid.New(),
-				}
-				repo.On("GetByID", s.ctx, o.ID).
+				o.Discount = &orderDomain.Discount{Code: promotion.Code, Amount: mothers.USD("10")}
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, eventMessage(o, orderDomain.CanceledEventName)).Return(nil).Once()
+				uow.PromotionMock.On("GetByCode", s.ctx, promotion.Code).Return(promotion, nil).Once()
+				uow.PromotionMock.On("Release", s.ctx, promotion, o.CustomerID).Return(nil).Once()
+				return o
+			},
+			expectedErr: nil,
+			finalStatus: orderDomain.CanceledOutOfStock,
+		},
+		{
+			name: "Success: Command already applied",
+			setup: func(uow *mocks.UoWMock, messageID uuid.UUID) *orderDomain.Order {
+				o := mothers.DefaultOrder()
+				t.Require().NoError(o.NoteCanceledOutOfStock(messageID))
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				return o
+			},
+			expectedErr: nil,
+			finalStatus: orderDomain.CanceledOutOfStock,
+		},
+		{
+			name: "Failure: GetByID error",
+			setup: func(uow *mocks.UoWMock, messageID uuid.UUID) *orderDomain.Order {
+				o := mothers.DefaultOrder()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).
 					Return((*orderDomain.Order)(nil), errors.New("not found")).Once()
-				return dto, o
+				return o
 			},
 			expectedErr: errors.New("not found"),
 			finalStatus: orderDomain.Created,
 		},
+		{
+			name: "Failure: domain method error (order in Delivering)",
+			setup: func(uow *mocks.UoWMock, messageID uuid.UUID) *orderDomain.Order {
+				o := mothers.OrderDelivering()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				return o
+			},
+			expectedErr: orderDomain.ErrUnsupportedStatusTransition,
+			finalStatus: orderDomain.Delivering,
+		},
 		{
 			name: "Failure: Update error",
-			setup: func(repo *orderMock.RepositoryMock) (usecase.BeginDeliveryDto, *orderDomain.Order) {
+			setup: func(uow *mocks.UoWMock, messageID uuid.UUID) *orderDomain.Order {
 				o := mothers.DefaultOrder()
-				dto := usecase.BeginDeliveryDto{
-					OrderID:   o.ID,
-					CourierID: uuid.New(),
-				}
-				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
-				repo.On("Update", s.ctx, o).Return(errors.New("update error")).Once()
-				return dto, o
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(errors.New("update error")).Once()
+				return o
 			},
 			expectedErr: errors.New("update error"),
-			finalStatus: orderDomain.Delivering,
+			finalStatus: orderDomain.CanceledOutOfStock,
+		},
+		{
+			name: "Failure: Promo code use not released",
+			setup: func(uow *mocks.UoWMock, messageID uuid.UUID) *orderDomain.Order {
+				promotion := mothers.FixedAmountPromotion("10")
+				o := mothers.DefaultOrder()
+				o.Discount = &orderDomain.Discount{Code: promotion.Code, Amount: mothers.USD("10")}
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, eventMessage(o, orderDomain.CanceledEventName)).Return(nil).Once()
+				uow.PromotionMock.On("GetByCode", s.ctx, promotion.Code).Return(promotion, nil).Once()
+				uow.PromotionMock.On("Release", s.ctx, promotion, o.CustomerID).
+					Return(errors.New("promotion error")).Once()
+				return o
+			},
+			expectedErr: errors.New("promotion error"),
+			finalStatus: orderDomain.CanceledOutOfStock,
 		},
 	}
 	for _, tc := range tests {
@@ -423,12 +786,13 @@ func (s *OrderUseCaseTestSuite) TestBeginDelivery(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 
-			repo := new(orderMock.RepositoryMock)
+			uow := mocks.NewUowMock()
 			manager := new(createOrderMock.ManagerMock)
-			uc := usecase.New(repo, manager)
-			dto, o := tc.setup(repo)
+			uc := usecase.New(uow, manager, new(cancelOrderMock.ManagerMock), policyCfg, scheduleCfg, new(orderMock.CatalogMock))
+			messageID := uuid.New()
+			o := tc.setup(uow, messageID)
 
-			err := uc.BeginDelivery(s.ctx, dto)
+			err := uc.CancelOutOfStock(s.ctx, o.ID, messageID)
 
 			if tc.expectedErr == nil {
 				t.Require().NoError(err)
@@ -438,37 +802,39 @@ func (s *OrderUseCaseTestSuite) TestBeginDelivery(t provider.T) {
 			}
 			t.Require().Equal(tc.finalStatus, o.Status)
 
-			repo.AssertExpectations(t)
+			uow.AssertExpectations(t)
 			manager.AssertExpectations(t)
 		})
 	}
 }
 
-func (s *OrderUseCaseTestSuite) TestCompleteDelivery(t provider.T) {
+func (s *OrderUseCaseTestSuite) TestCancelCourierNotFound(t provider.T) {
 	t.Parallel()
 
 	tests := []struct {
 		name        string
-		setup       func(repo *orderMock.RepositoryMock) *orderDomain.Order
+		setup       func(uow *mocks.UoWMock) *orderDomain.Order
 		expectedErr error
 		finalStatus orderDomain.Status
 	}{
 		{
-			name: "Success: Order in Delivering",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
-				o := mothers.OrderDelivering()
-				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
-				repo.On("Update", s.ctx, o).Return(nil).Once()
+			name: "Success: Order in Created",
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
+				o := mothers.DefaultOrder()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, eventMessage(o, orderDomain.CanceledEventName)).Return(nil).Once()
 				return o
 			},
 			expectedErr: nil,
-			finalStatus: orderDomain.Delivered,
+			finalStatus: orderDomain.CanceledCourierNotFound,
 		},
 		{
 			name: "Failure: GetByID error",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
 				o := mothers.DefaultOrder()
-				repo.On("GetByID", s.ctx, o.ID).
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).
 					Return((*orderDomain.Order)(nil), errors.New("not found")).Once()
 				return o
 			},
@@ -476,25 +842,26 @@ func (s *OrderUseCaseTestSuite) TestCompleteDelivery(t provider.T) {
 			finalStatus: orderDomain.Created,
 		},
 		{
-			name: "Failure: domain method error (order in Created)",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
-				o := mothers.DefaultOrder()
-				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+			name: "Failure: domain method error (order in Delivering)",
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
+				o := mothers.OrderDelivering()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
 				return o
 			},
 			expectedErr: orderDomain.ErrUnsupportedStatusTransition,
-			finalStatus: orderDomain.Created,
+			finalStatus: orderDomain.Delivering,
 		},
 		{
 			name: "Failure: Update error",
-			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Order {
-				o := mothers.OrderDelivering()
-				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
-				repo.On("Update", s.ctx, o).Return(errors.New("update error")).Once()
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
+				o := mothers.DefaultOrder()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(errors.New("update error")).Once()
 				return o
 			},
 			expectedErr: errors.New("update error"),
-			finalStatus: orderDomain.Delivered,
+			finalStatus: orderDomain.CanceledCourierNotFound,
 		},
 	}
 	for _, tc := range tests {
@@ -502,52 +869,548 @@ func (s *OrderUseCaseTestSuite) TestCompleteDelivery(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 
-			repo := new(orderMock.RepositoryMock)
+			uow := mocks.NewUowMock()
 			manager := new(createOrderMock.ManagerMock)
-			uc := usecase.New(repo, manager)
-			o := tc.setup(repo)
+			uc := usecase.New(uow, manager, new(cancelOrderMock.ManagerMock), policyCfg, scheduleCfg, new(orderMock.CatalogMock))
+			o := tc.setup(uow)
 
-			err := uc.CompleteDelivery(s.ctx, o.ID)
+			err := uc.CancelCourierNotFound(s.ctx, o.ID, uuid.New())
 
 			if tc.expectedErr == nil {
 				t.Require().NoError(err)
-				t.Require().NotNil(o.Delivery.Arrived)
-				t.Require().WithinDuration(time.Now(), *o.Delivery.Arrived, time.Second)
 			} else {
 				t.Require().Error(err)
 				t.Require().EqualError(err, tc.expectedErr.Error())
 			}
 			t.Require().Equal(tc.finalStatus, o.Status)
+
+			uow.AssertExpectations(t)
+			manager.AssertExpectations(t)
 		})
 	}
 }
 
-func (s *OrderUseCaseTestSuite) TestGetAllByCustomer(t provider.T) {
+func (s *OrderUseCaseTestSuite) TestCancelTimeout(t provider.T) {
 	t.Parallel()
 
 	tests := []struct {
 		name        string
-		setup       func(repo *orderMock.RepositoryMock) (uuid.UUID, []*orderDomain.Order)
+		setup       func(uow *mocks.UoWMock) *orderDomain.Order
 		expectedErr error
+		finalStatus orderDomain.Status
 	}{
 		{
-			name: "Success: Get orders by customer",
-			setup: func(repo *orderMock.RepositoryMock) (uuid.UUID, []*orderDomain.Order) {
-				customerID := uuid.New()
-				expectedOrders := mothers.ListOfOrders(2)
-				repo.On("GetAllByCustomer", s.ctx, customerID).Return(expectedOrders, nil).Once()
-				return customerID, expectedOrders
+			name: "Success: Order in Created",
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
+				o := mothers.DefaultOrder()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, eventMessage(o, orderDomain.CanceledEventName)).Return(nil).Once()
+				return o
 			},
 			expectedErr: nil,
+			finalStatus: orderDomain.CanceledTimeout,
 		},
 		{
-			name: "Failure: GetAllByCustomer error",
-			setup: func(repo *orderMock.RepositoryMock) (uuid.UUID, []*orderDomain.Order) {
-				customerID := uuid.New()
-				var expectedOrders []*orderDomain.Order
-				repo.On("GetAllByCustomer", s.ctx, customerID).
-					Return(expectedOrders, errors.New("get error")).Once()
-				return customerID, expectedOrders
+			name: "Failure: GetByID error",
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
+				o := mothers.DefaultOrder()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).
+					Return((*orderDomain.Order)(nil), errors.New("not found")).Once()
+				return o
+			},
+			expectedErr: errors.New("not found"),
+			finalStatus: orderDomain.Created,
+		},
+		{
+			name: "Failure: domain method error (order in Delivering)",
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
+				o := mothers.OrderDelivering()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				return o
+			},
+			expectedErr: orderDomain.ErrUnsupportedStatusTransition,
+			finalStatus: orderDomain.Delivering,
+		},
+		{
+			name: "Failure: Update error",
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
+				o := mothers.DefaultOrder()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(errors.New("update error")).Once()
+				return o
+			},
+			expectedErr: errors.New("update error"),
+			finalStatus: orderDomain.CanceledTimeout,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			manager := new(createOrderMock.ManagerMock)
+			uc := usecase.New(uow, manager, new(cancelOrderMock.ManagerMock), policyCfg, scheduleCfg, new(orderMock.CatalogMock))
+			o := tc.setup(uow)
+
+			err := uc.CancelTimeout(s.ctx, o.ID, uuid.New())
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+			t.Require().Equal(tc.finalStatus, o.Status)
+
+			uow.AssertExpectations(t)
+			manager.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *OrderUseCaseTestSuite) TestBeginDelivery(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		setup       func(uow *mocks.UoWMock) (usecase.BeginDeliveryDto, *orderDomain.Order)
+		expectedErr error
+		finalStatus orderDomain.Status
+	}{
+		{
+			name: "Success: Order in Created",
+			setup: func(uow *mocks.UoWMock) (usecase.BeginDeliveryDto, *orderDomain.Order) {
+				o := mothers.DefaultOrder()
+				dto := usecase.BeginDeliveryDto{
+					OrderID:   o.ID,
+					CourierID: uuid.New(),
+					MessageID: uuid.New(),
+				}
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, eventMessage(o, orderDomain.DeliveryStartedEventName)).Return(nil).Once()
+				return dto, o
+			},
+			expectedErr: nil,
+			finalStatus: orderDomain.Delivering,
+		},
+		{
+			name: "Failure: domain method error (already in Delivering)",
+			setup: func(uow *mocks.UoWMock) (usecase.BeginDeliveryDto, *orderDomain.Order) {
+				o := mothers.OrderDelivering()
+				dto := usecase.BeginDeliveryDto{
+					OrderID:   o.ID,
+					CourierID: uuid.New(),
+				}
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				return dto, o
+			},
+			expectedErr: orderDomain.ErrUnsupportedStatusTransition,
+			finalStatus: orderDomain.Delivering,
+		},
+		{
+			name: "Failure: repo.GetByID error",
+			setup: func(uow *mocks.UoWMock) (usecase.BeginDeliveryDto, *orderDomain.Order) {
+				o := mothers.DefaultOrder()
+				dto := usecase.BeginDeliveryDto{
+					OrderID:   o.ID,
+					CourierID: uuid.New(),
+				}
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).
+					Return((*orderDomain.Order)(nil), errors.New("not found")).Once()
+				return dto, o
+			},
+			expectedErr: errors.New("not found"),
+			finalStatus: orderDomain.Created,
+		},
+		{
+			name: "Failure: Update error",
+			setup: func(uow *mocks.UoWMock) (usecase.BeginDeliveryDto, *orderDomain.Order) {
+				o := mothers.DefaultOrder()
+				dto := usecase.BeginDeliveryDto{
+					OrderID:   o.ID,
+					CourierID: uuid.New(),
+				}
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(errors.New("update error")).Once()
+				return dto, o
+			},
+			expectedErr: errors.New("update error"),
+			finalStatus: orderDomain.Delivering,
+		},
+		{
+			name: "Failure: Outbox error",
+			setup: func(uow *mocks.UoWMock) (usecase.BeginDeliveryDto, *orderDomain.Order) {
+				o := mothers.DefaultOrder()
+				dto := usecase.BeginDeliveryDto{
+					OrderID:   o.ID,
+					CourierID: uuid.New(),
+				}
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, eventMessage(o, orderDomain.DeliveryStartedEventName)).
+					Return(errors.New("outbox error")).Once()
+				return dto, o
+			},
+			expectedErr: errors.New("outbox error"),
+			finalStatus: orderDomain.Delivering,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			manager := new(createOrderMock.ManagerMock)
+			uc := usecase.New(uow, manager, new(cancelOrderMock.ManagerMock), policyCfg, scheduleCfg, new(orderMock.CatalogMock))
+			dto, o := tc.setup(uow)
+
+			err := uc.BeginDelivery(s.ctx, dto)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+				t.Require().Equal(&dto.MessageID, o.History[len(o.History)-1].MessageID)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+			t.Require().Equal(tc.finalStatus, o.Status)
+
+			uow.AssertExpectations(t)
+			manager.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *OrderUseCaseTestSuite) TestCompleteDelivery(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		setup        func(uow *mocks.UoWMock) *orderDomain.Order
+		otherCourier bool
+		expectedErr  error
+		finalStatus  orderDomain.Status
+	}{
+		{
+			name: "Success: Order in Delivering",
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
+				o := mothers.OrderDelivering()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, eventMessage(o, orderDomain.DeliveredEventName)).Return(nil).Once()
+				return o
+			},
+			expectedErr: nil,
+			finalStatus: orderDomain.Delivered,
+		},
+		{
+			name: "Failure: GetByID error",
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
+				o := mothers.DefaultOrder()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).
+					Return((*orderDomain.Order)(nil), errors.New("not found")).Once()
+				return o
+			},
+			expectedErr: errors.New("not found"),
+			finalStatus: orderDomain.Created,
+		},
+		{
+			name: "Failure: domain method error (order already Delivered)",
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
+				o := mothers.OrderDelivered()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				return o
+			},
+			expectedErr: orderDomain.ErrUnsupportedStatusTransition,
+			finalStatus: orderDomain.Delivered,
+		},
+		{
+			name: "Failure: Order of another courier",
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
+				o := mothers.OrderDelivering()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				return o
+			},
+			otherCourier: true,
+			expectedErr:  orderDomain.ErrPermissionDenied,
+			finalStatus:  orderDomain.Delivering,
+		},
+		{
+			name: "Failure: Update error",
+			setup: func(uow *mocks.UoWMock) *orderDomain.Order {
+				o := mothers.OrderDelivering()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(errors.New("update error")).Once()
+				return o
+			},
+			expectedErr: errors.New("update error"),
+			finalStatus: orderDomain.Delivered,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			manager := new(createOrderMock.ManagerMock)
+			uc := usecase.New(uow, manager, new(cancelOrderMock.ManagerMock), policyCfg, scheduleCfg, new(orderMock.CatalogMock))
+			o := tc.setup(uow)
+
+			courierID := uuid.New()
+			if o.Delivery.CourierID != nil && !tc.otherCourier {
+				courierID = *o.Delivery.CourierID
+			}
+
+			err := uc.CompleteDelivery(s.ctx, o.ID, courierID)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+				t.Require().NotNil(o.Delivery.Arrived)
+				t.Require().WithinDuration(time.Now(), *o.Delivery.Arrived, time.Second)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+			t.Require().Equal(tc.finalStatus, o.Status)
+		})
+	}
+}
+
+func (s *OrderUseCaseTestSuite) TestReassignCourier(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		setup       func(uow *mocks.UoWMock, courierID uuid.UUID) *orderDomain.Order
+		expectedErr error
+		reassigned  bool
+	}{
+		{
+			name: "Success: Order in Delivering",
+			setup: func(uow *mocks.UoWMock, courierID uuid.UUID) *orderDomain.Order {
+				o := mothers.OrderDelivering()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, eventMessage(o, orderDomain.CourierReassignedEventName)).Return(nil).Once()
+				uow.OutboxMock.On("Create", s.ctx, mock.MatchedBy(func(message *outboxDomain.Message) bool {
+					return message.Name == usecase.ReassignCourierCmdName && message.Key == o.ID.String()
+				})).Return(nil).Once()
+				return o
+			},
+			expectedErr: nil,
+			reassigned:  true,
+		},
+		{
+			name: "Failure: domain method error (order in Created)",
+			setup: func(uow *mocks.UoWMock, courierID uuid.UUID) *orderDomain.Order {
+				o := mothers.DefaultOrder()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				return o
+			},
+			expectedErr: orderDomain.ErrUnsupportedStatusTransition,
+		},
+		{
+			name: "Failure: repo.Update error",
+			setup: func(uow *mocks.UoWMock, courierID uuid.UUID) *orderDomain.Order {
+				o := mothers.OrderDelivering()
+				uow.OrderMock.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				uow.On("Transaction", s.ctx, mock.Anything).Once()
+				uow.OrderMock.On("Update", s.ctx, o).Return(errors.New("update error")).Once()
+				return o
+			},
+			expectedErr: errors.New("update error"),
+			reassigned:  true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			manager := new(createOrderMock.ManagerMock)
+			uc := usecase.New(uow, manager, new(cancelOrderMock.ManagerMock), policyCfg, scheduleCfg, new(orderMock.CatalogMock))
+			courierID := uuid.New()
+			o := tc.setup(uow, courierID)
+
+			err := uc.ReassignCourier(s.ctx, usecase.ReassignCourierDto{
+				OrderID:   o.ID,
+				CourierID: courierID,
+				Reason:    "Courier vehicle broke down",
+			})
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+			if tc.reassigned {
+				t.Require().Equal(&courierID, o.Delivery.CourierID)
+			} else {
+				t.Require().NotEqual(&courierID, o.Delivery.CourierID)
+			}
+
+			uow.AssertExpectations(t)
+			manager.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *OrderUseCaseTestSuite) TestGetByID(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		setup       func(repo *orderMock.RepositoryMock) (*orderDomain.Order, orderDomain.Actor)
+		expectedErr error
+	}{
+		{
+			name: "Success: Owning customer",
+			setup: func(repo *orderMock.RepositoryMock) (*orderDomain.Order, orderDomain.Actor) {
+				o := mothers.DefaultOrder()
+				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				return o, orderDomain.CustomerActor(o.CustomerID)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Assigned courier",
+			setup: func(repo *orderMock.RepositoryMock) (*orderDomain.Order, orderDomain.Actor) {
+				o := mothers.OrderDelivering()
+				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				return o, orderDomain.CourierActor(*o.Delivery.CourierID)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Admin",
+			setup: func(repo *orderMock.RepositoryMock) (*orderDomain.Order, orderDomain.Actor) {
+				o := mothers.DefaultOrder()
+				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				return o, orderDomain.AdminActor()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: Another courier",
+			setup: func(repo *orderMock.RepositoryMock) (*orderDomain.Order, orderDomain.Actor) {
+				o := mothers.OrderDelivering()
+				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				return o, orderDomain.CourierActor(uuid.New())
+			},
+			expectedErr: orderDomain.ErrPermissionDenied,
+		},
+		{
+			name: "Failure: repo.GetByID error",
+			setup: func(repo *orderMock.RepositoryMock) (*orderDomain.Order, orderDomain.Actor) {
+				o := mothers.DefaultOrder()
+				repo.On("GetByID", s.ctx, o.ID).
+					Return((*orderDomain.Order)(nil), errors.New("not found")).Once()
+				return o, orderDomain.AdminActor()
+			},
+			expectedErr: errors.New("not found"),
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			repo := uow.OrderMock
+			uc := usecase.New(uow, new(createOrderMock.ManagerMock), new(cancelOrderMock.ManagerMock), policyCfg, scheduleCfg, new(orderMock.CatalogMock))
+			o, requester := tc.setup(repo)
+
+			order, err := uc.GetByID(s.ctx, o.ID, requester)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+				t.Require().Equal(o, order)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+				t.Require().Nil(order)
+			}
+
+			uow.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *OrderUseCaseTestSuite) TestGetAllByCustomer(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		query       orderDomain.ListQuery
+		setup       func(repo *orderMock.RepositoryMock) (uuid.UUID, *orderDomain.Page)
+		expectedErr error
+	}{
+		{
+			name:  "Success: Get orders by customer with default page size and sort",
+			query: orderDomain.ListQuery{},
+			setup: func(repo *orderMock.RepositoryMock) (uuid.UUID, *orderDomain.Page) {
+				customerID := uuid.New()
+				expectedPage := &orderDomain.Page{Orders: mothers.ListOfOrders(2), NextCursor: "next"}
+				normalized := orderDomain.ListQuery{Sort: orderDomain.NewestFirst, Limit: orderDomain.DefaultPageSize}
+				repo.On("GetAllByCustomer", s.ctx, customerID, normalized).Return(expectedPage, nil).Once()
+				return customerID, expectedPage
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Get orders by customer caps page size",
+			query: orderDomain.ListQuery{
+				Statuses: []orderDomain.Status{orderDomain.Delivering},
+				Sort:     orderDomain.OldestFirst,
+				Limit:    orderDomain.MaxPageSize + 1,
+				Cursor:   "cursor",
+			},
+			setup: func(repo *orderMock.RepositoryMock) (uuid.UUID, *orderDomain.Page) {
+				customerID := uuid.New()
+				expectedPage := &orderDomain.Page{Orders: mothers.ListOfOrders(1)}
+				normalized := orderDomain.ListQuery{
+					Statuses: []orderDomain.Status{orderDomain.Delivering},
+					Sort:     orderDomain.OldestFirst,
+					Limit:    orderDomain.MaxPageSize,
+					Cursor:   "cursor",
+				}
+				repo.On("GetAllByCustomer", s.ctx, customerID, normalized).Return(expectedPage, nil).Once()
+				return customerID, expectedPage
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "Failure: Invalid list query",
+			query: orderDomain.ListQuery{Limit: -1},
+			setup: func(repo *orderMock.RepositoryMock) (uuid.UUID, *orderDomain.Page) {
+				return uuid.New(), nil
+			},
+			expectedErr: orderDomain.ErrInvalidListQuery,
+		},
+		{
+			name:  "Failure: GetAllByCustomer error",
+			query: orderDomain.ListQuery{},
+			setup: func(repo *orderMock.RepositoryMock) (uuid.UUID, *orderDomain.Page) {
+				customerID := uuid.New()
+				var expectedPage *orderDomain.Page
+				repo.On("GetAllByCustomer", s.ctx, customerID, mock.Anything).
+					Return(expectedPage, errors.New("get error")).Once()
+				return customerID, expectedPage
 			},
 			expectedErr: errors.New("get error"),
 		},
@@ -557,22 +1420,93 @@ func (s *OrderUseCaseTestSuite) TestGetAllByCustomer(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 
-			repo := new(orderMock.RepositoryMock)
+			uow := mocks.NewUowMock()
+			repo := uow.OrderMock
 			manager := new(createOrderMock.ManagerMock)
-			uc := usecase.New(repo, manager)
-			customerID, expectedOrders := tc.setup(repo)
+			uc := usecase.New(uow, manager, new(cancelOrderMock.ManagerMock), policyCfg, scheduleCfg, new(orderMock.CatalogMock))
+			customerID, expectedPage := tc.setup(repo)
 
-			orders, err := uc.GetAllByCustomer(s.ctx, customerID)
+			page, err := uc.GetAllByCustomer(s.ctx, customerID, tc.query)
 
 			if tc.expectedErr == nil {
 				t.Require().NoError(err)
-				t.Require().Equal(expectedOrders, orders)
+				t.Require().Equal(expectedPage, page)
 			} else {
 				t.Require().Error(err)
 				t.Require().EqualError(err, tc.expectedErr.Error())
 			}
 
-			repo.AssertExpectations(t)
+			uow.AssertExpectations(t)
+			manager.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *OrderUseCaseTestSuite) TestSearch(t provider.T) {
+	t.Parallel()
+
+	productID := uuid.New()
+	filter := orderDomain.SearchFilter{ProductID: &productID}
+
+	tests := []struct {
+		name        string
+		query       orderDomain.ListQuery
+		setup       func(repo *orderMock.RepositoryMock) *orderDomain.Page
+		expectedErr error
+	}{
+		{
+			name:  "Success: Search with default page size and sort",
+			query: orderDomain.ListQuery{},
+			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Page {
+				expectedPage := &orderDomain.Page{Orders: mothers.ListOfOrders(2), NextCursor: "next"}
+				normalized := orderDomain.ListQuery{Sort: orderDomain.NewestFirst, Limit: orderDomain.DefaultPageSize}
+				repo.On("Search", s.ctx, filter, normalized).Return(expectedPage, nil).Once()
+				return expectedPage
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "Failure: Invalid list query",
+			query: orderDomain.ListQuery{Limit: -1},
+			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Page {
+				return nil
+			},
+			expectedErr: orderDomain.ErrInvalidListQuery,
+		},
+		{
+			name:  "Failure: Search error",
+			query: orderDomain.ListQuery{},
+			setup: func(repo *orderMock.RepositoryMock) *orderDomain.Page {
+				var expectedPage *orderDomain.Page
+				repo.On("Search", s.ctx, filter, mock.Anything).
+					Return(expectedPage, errors.New("search error")).Once()
+				return expectedPage
+			},
+			expectedErr: errors.New("search error"),
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			repo := uow.OrderMock
+			manager := new(createOrderMock.ManagerMock)
+			uc := usecase.New(uow, manager, new(cancelOrderMock.ManagerMock), policyCfg, scheduleCfg, new(orderMock.CatalogMock))
+			expectedPage := tc.setup(repo)
+
+			page, err := uc.Search(s.ctx, filter, tc.query)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+				t.Require().Equal(expectedPage, page)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			uow.AssertExpectations(t)
 			manager.AssertExpectations(t)
 		})
 	}
@@ -613,9 +1547,10 @@ func (s *OrderUseCaseTestSuite) TestGetCurrentByCourier(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 
-			repo := new(orderMock.RepositoryMock)
+			uow := mocks.NewUowMock()
+			repo := uow.OrderMock
 			manager := new(createOrderMock.ManagerMock)
-			uc := usecase.New(repo, manager)
+			uc := usecase.New(uow, manager, new(cancelOrderMock.ManagerMock), policyCfg, scheduleCfg, new(orderMock.CatalogMock))
 			courierID, expectedOrders := tc.setup(repo)
 
 			orders, err := uc.GetCurrentByCourier(s.ctx, courierID)
@@ -628,12 +1563,179 @@ func (s *OrderUseCaseTestSuite) TestGetCurrentByCourier(t provider.T) {
 				t.Require().EqualError(err, tc.expectedErr.Error())
 			}
 
-			repo.AssertExpectations(t)
+			uow.AssertExpectations(t)
+			manager.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *OrderUseCaseTestSuite) TestGetHistoryByCourier(t provider.T) {
+	t.Parallel()
+
+	from := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name        string
+		query       orderDomain.ListQuery
+		setup       func(repo *orderMock.RepositoryMock) (uuid.UUID, *usecase.CourierHistoryDto)
+		expectedErr error
+	}{
+		{
+			name:  "Success: Get courier history with counts",
+			query: orderDomain.ListQuery{CreatedFrom: &from},
+			setup: func(repo *orderMock.RepositoryMock) (uuid.UUID, *usecase.CourierHistoryDto) {
+				courierID := uuid.New()
+				page := &orderDomain.Page{Orders: mothers.ListOfOrders(2), NextCursor: "next"}
+				counts := orderDomain.StatusCounts{orderDomain.Delivered: 5, orderDomain.Delivering: 1}
+				normalized := orderDomain.ListQuery{
+					CreatedFrom: &from,
+					Sort:        orderDomain.NewestFirst,
+					Limit:       orderDomain.DefaultPageSize,
+				}
+				repo.On("GetHistoryByCourier", s.ctx, courierID, normalized).Return(page, nil).Once()
+				repo.On("CountByCourier", s.ctx, courierID, &from, (*time.Time)(nil)).Return(counts, nil).Once()
+				return courierID, &usecase.CourierHistoryDto{Page: page, Counts: counts}
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "Failure: Invalid list query",
+			query: orderDomain.ListQuery{Sort: "random"},
+			setup: func(repo *orderMock.RepositoryMock) (uuid.UUID, *usecase.CourierHistoryDto) {
+				return uuid.New(), nil
+			},
+			expectedErr: orderDomain.ErrInvalidListQuery,
+		},
+		{
+			name:  "Failure: GetHistoryByCourier error",
+			query: orderDomain.ListQuery{},
+			setup: func(repo *orderMock.RepositoryMock) (uuid.UUID, *usecase.CourierHistoryDto) {
+				courierID := uuid.New()
+				repo.On("GetHistoryByCourier", s.ctx, courierID, mock.Anything).
+					Return((*orderDomain.Page)(nil), errors.New("get error")).Once()
+				return courierID, nil
+			},
+			expectedErr: errors.New("get error"),
+		},
+		{
+			name:  "Failure: CountByCourier error",
+			query: orderDomain.ListQuery{},
+			setup: func(repo *orderMock.RepositoryMock) (uuid.UUID, *usecase.CourierHistoryDto) {
+				courierID := uuid.New()
+				repo.On("GetHistoryByCourier", s.ctx, courierID, mock.Anything).
+					Return(&orderDomain.Page{}, nil).Once()
+				repo.On("CountByCourier", s.ctx, courierID, mock.Anything, mock.Anything).
+					Return(orderDomain.StatusCounts(nil), errors.New("count error")).Once()
+				return courierID, nil
+			},
+			expectedErr: errors.New("count error"),
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			repo := uow.OrderMock
+			manager := new(createOrderMock.ManagerMock)
+			uc := usecase.New(uow, manager, new(cancelOrderMock.ManagerMock), policyCfg, scheduleCfg, new(orderMock.CatalogMock))
+			courierID, expected := tc.setup(repo)
+
+			history, err := uc.GetHistoryByCourier(s.ctx, courierID, tc.query)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+				t.Require().Equal(expected, history)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			uow.AssertExpectations(t)
 			manager.AssertExpectations(t)
 		})
 	}
 }
 
+func (s *OrderUseCaseTestSuite) TestGetStatusHistory(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		setup       func(repo *orderMock.RepositoryMock) (*orderDomain.Order, orderDomain.Actor)
+		expectedErr error
+	}{
+		{
+			name: "Success: Owning customer",
+			setup: func(repo *orderMock.RepositoryMock) (*orderDomain.Order, orderDomain.Actor) {
+				o := mothers.OrderWithHistory()
+				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				return o, orderDomain.CustomerActor(o.CustomerID)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Success: Admin",
+			setup: func(repo *orderMock.RepositoryMock) (*orderDomain.Order, orderDomain.Actor) {
+				o := mothers.OrderWithHistory()
+				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				return o, orderDomain.AdminActor()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: Another customer",
+			setup: func(repo *orderMock.RepositoryMock) (*orderDomain.Order, orderDomain.Actor) {
+				o := mothers.OrderWithHistory()
+				repo.On("GetByID", s.ctx, o.ID).Return(o, nil).Once()
+				return o, orderDomain.CustomerActor(uuid.New())
+			},
+			expectedErr: orderDomain.ErrPermissionDenied,
+		},
+		{
+			name: "Failure: repo.GetByID error",
+			setup: func(repo *orderMock.RepositoryMock) (*orderDomain.Order, orderDomain.Actor) {
+				o := mothers.OrderWithHistory()
+				repo.On("GetByID", s.ctx, o.ID).
+					Return((*orderDomain.Order)(nil), errors.New("not found")).Once()
+				return o, orderDomain.AdminActor()
+			},
+			expectedErr: errors.New("not found"),
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			uow := mocks.NewUowMock()
+			repo := uow.OrderMock
+			uc := usecase.New(uow, new(createOrderMock.ManagerMock), new(cancelOrderMock.ManagerMock), policyCfg, scheduleCfg, new(orderMock.CatalogMock))
+			o, requester := tc.setup(repo)
+
+			history, err := uc.GetStatusHistory(s.ctx, o.ID, requester)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+				t.Require().Equal(o.History, history)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			uow.AssertExpectations(t)
+		})
+	}
+}
+
+// eventMessage matches the outbox message of an event the order raised.
+func eventMessage(o *orderDomain.Order, name string) any {
+	return mock.MatchedBy(func(message *outboxDomain.Message) bool {
+		return message.Name == name && message.Key == o.ID.String()
+	})
+}
+
 func TestOrderUseCaseTestSuite(t *testing.T) {
 	suite.RunSuite(t, new(OrderUseCaseTestSuite))
 }
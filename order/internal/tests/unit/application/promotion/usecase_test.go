@@ -0,0 +1,156 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"order/internal/application/promotion/usecase"
+	promotionDomain "order/internal/domain/promotion"
+	promotionMock "order/internal/mocks/promotion"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+)
+
+type PromotionUseCaseTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *PromotionUseCaseTestSuite) BeforeEach(_ provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *PromotionUseCaseTestSuite) TestCreate(t provider.T) {
+	t.Parallel()
+
+	rules := promotionDomain.Rules{Kind: promotionDomain.Percentage, Percent: 10}
+
+	tests := []struct {
+		name        string
+		dto         usecase.CreateDto
+		setup       func(repo *promotionMock.RepositoryMock)
+		expectedErr error
+	}{
+		{
+			name: "Success",
+			dto:  usecase.CreateDto{Code: "spring-10", Rules: rules},
+			setup: func(repo *promotionMock.RepositoryMock) {
+				repo.On("Create", s.ctx, mock.MatchedBy(func(p *promotionDomain.Promotion) bool {
+					return p.Code == "SPRING-10" && p.Active
+				})).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "Failure: Invalid rules",
+			dto:         usecase.CreateDto{Code: "spring-10", Rules: promotionDomain.Rules{Kind: promotionDomain.Percentage}},
+			setup:       func(repo *promotionMock.RepositoryMock) {},
+			expectedErr: promotionDomain.ErrInvalidRules,
+		},
+		{
+			name: "Failure: Repository create error",
+			dto:  usecase.CreateDto{Code: "spring-10", Rules: rules},
+			setup: func(repo *promotionMock.RepositoryMock) {
+				repo.On("Create", s.ctx, mock.Anything).Return(errors.New("promotion already exists")).Once()
+			},
+			expectedErr: errors.New("promotion already exists"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			repo := new(promotionMock.RepositoryMock)
+			uc := usecase.New(repo)
+			tc.setup(repo)
+
+			promotionID, err := uc.Create(s.ctx, tc.dto)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+				t.Require().NotEqual(uuid.Nil, promotionID)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+				t.Require().Equal(uuid.Nil, promotionID)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *PromotionUseCaseTestSuite) TestDeactivate(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		setup       func(repo *promotionMock.RepositoryMock) uuid.UUID
+		expectedErr error
+	}{
+		{
+			name: "Success",
+			setup: func(repo *promotionMock.RepositoryMock) uuid.UUID {
+				promotion := mothers.PercentagePromotion(10)
+				repo.On("GetByID", s.ctx, promotion.ID).Return(promotion, nil).Once()
+				repo.On("Update", s.ctx, mock.MatchedBy(func(p *promotionDomain.Promotion) bool {
+					return p.ID == promotion.ID && !p.Active
+				})).Return(nil).Once()
+				return promotion.ID
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: Promotion not found",
+			setup: func(repo *promotionMock.RepositoryMock) uuid.UUID {
+				promotionID := uuid.New()
+				repo.On("GetByID", s.ctx, promotionID).
+					Return((*promotionDomain.Promotion)(nil), errors.New("promotion not found")).Once()
+				return promotionID
+			},
+			expectedErr: errors.New("promotion not found"),
+		},
+		{
+			name: "Failure: Repository update error",
+			setup: func(repo *promotionMock.RepositoryMock) uuid.UUID {
+				promotion := mothers.PercentagePromotion(10)
+				repo.On("GetByID", s.ctx, promotion.ID).Return(promotion, nil).Once()
+				repo.On("Update", s.ctx, promotion).Return(errors.New("update error")).Once()
+				return promotion.ID
+			},
+			expectedErr: errors.New("update error"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			repo := new(promotionMock.RepositoryMock)
+			uc := usecase.New(repo)
+			promotionID := tc.setup(repo)
+
+			err := uc.Deactivate(s.ctx, promotionID)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPromotionUseCaseTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(PromotionUseCaseTestSuite))
+}
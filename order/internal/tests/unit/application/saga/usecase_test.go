@@ -0,0 +1,149 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"order/internal/application/saga/usecase"
+	sagaDomain "order/internal/domain/saga"
+	cancelOrderMock "order/internal/mocks/order/saga/cancel_order"
+	createOrderMock "order/internal/mocks/order/saga/create_order"
+	sagaMock "order/internal/mocks/saga"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type SagaUseCaseTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *SagaUseCaseTestSuite) BeforeEach(_ provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *SagaUseCaseTestSuite) TestGetAllByOrder(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		setup       func(repo *sagaMock.RepositoryMock) (uuid.UUID, []*sagaDomain.Saga)
+		expectedErr error
+	}{
+		{
+			name: "Success: Get sagas by order",
+			setup: func(repo *sagaMock.RepositoryMock) (uuid.UUID, []*sagaDomain.Saga) {
+				orderID := uuid.New()
+				expectedSagas := []*sagaDomain.Saga{mothers.SagaAssigningCourier(orderID)}
+				repo.On("GetAllByOrderID", s.ctx, orderID).Return(expectedSagas, nil).Once()
+				return orderID, expectedSagas
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: GetAllByOrderID error",
+			setup: func(repo *sagaMock.RepositoryMock) (uuid.UUID, []*sagaDomain.Saga) {
+				orderID := uuid.New()
+				var expectedSagas []*sagaDomain.Saga
+				repo.On("GetAllByOrderID", s.ctx, orderID).
+					Return(expectedSagas, errors.New("get error")).Once()
+				return orderID, expectedSagas
+			},
+			expectedErr: errors.New("get error"),
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			repo := new(sagaMock.RepositoryMock)
+			uc := usecase.New(repo, new(createOrderMock.SagaMock), new(cancelOrderMock.SagaMock))
+			orderID, expectedSagas := tc.setup(repo)
+
+			sagas, err := uc.GetAllByOrder(s.ctx, orderID)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+				t.Require().Equal(expectedSagas, sagas)
+			} else {
+				t.Require().Error(err)
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func (s *SagaUseCaseTestSuite) TestRetryStep(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		sagaType    sagaDomain.Type
+		setup       func(createSaga *createOrderMock.SagaMock, cancelSaga *cancelOrderMock.SagaMock, orderID uuid.UUID)
+		expectedErr error
+	}{
+		{
+			name:     "Success: Create order saga",
+			sagaType: sagaDomain.CreateOrder,
+			setup: func(createSaga *createOrderMock.SagaMock, _ *cancelOrderMock.SagaMock, orderID uuid.UUID) {
+				createSaga.On("RetryStep", s.ctx, orderID).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name:     "Success: Cancel order saga",
+			sagaType: sagaDomain.CancelOrder,
+			setup: func(_ *createOrderMock.SagaMock, cancelSaga *cancelOrderMock.SagaMock, orderID uuid.UUID) {
+				cancelSaga.On("RetryStep", s.ctx, orderID).Return(nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name:     "Failure: Step not retryable",
+			sagaType: sagaDomain.CreateOrder,
+			setup: func(createSaga *createOrderMock.SagaMock, _ *cancelOrderMock.SagaMock, orderID uuid.UUID) {
+				createSaga.On("RetryStep", s.ctx, orderID).Return(sagaDomain.ErrStepNotRetryable).Once()
+			},
+			expectedErr: sagaDomain.ErrStepNotRetryable,
+		},
+		{
+			name:        "Failure: Unsupported saga type",
+			sagaType:    sagaDomain.Type("unknown"),
+			setup:       func(_ *createOrderMock.SagaMock, _ *cancelOrderMock.SagaMock, _ uuid.UUID) {},
+			expectedErr: sagaDomain.ErrUnsupportedType,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			createSaga := new(createOrderMock.SagaMock)
+			cancelSaga := new(cancelOrderMock.SagaMock)
+			uc := usecase.New(new(sagaMock.RepositoryMock), createSaga, cancelSaga)
+			orderID := uuid.New()
+			tc.setup(createSaga, cancelSaga, orderID)
+
+			err := uc.RetryStep(s.ctx, orderID, tc.sagaType)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+			} else {
+				t.Require().ErrorIs(err, tc.expectedErr)
+			}
+
+			createSaga.AssertExpectations(t)
+			cancelSaga.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSagaUseCaseTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(SagaUseCaseTestSuite))
+}
@@ -0,0 +1,111 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"order/internal/application/slot/usecase"
+	orderDomain "order/internal/domain/order"
+	slotDomain "order/internal/domain/slot"
+	slotMock "order/internal/mocks/slot"
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+)
+
+// One slot a day, so the slot of today has always started already.
+var scheduleCfg = &usecase.ScheduleConfig{
+	DayStart:    0,
+	DayEnd:      24 * time.Hour,
+	Length:      24 * time.Hour,
+	Capacity:    2,
+	HorizonDays: 3,
+	Timezone:    usecase.Timezone{Location: time.UTC},
+}
+
+type SlotUseCaseTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *SlotUseCaseTestSuite) BeforeEach(_ provider.T) {
+	s.ctx = context.Background()
+}
+
+func dayAfter(days int) orderDomain.DeliverySlot {
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), now.Day()+days, 0, 0, 0, 0, time.UTC)
+	return orderDomain.DeliverySlot{Start: start, End: start.Add(24 * time.Hour)}
+}
+
+func (s *SlotUseCaseTestSuite) TestGetAvailable(t provider.T) {
+	t.Parallel()
+
+	tomorrow := dayAfter(1)
+	dayAfterTomorrow := dayAfter(2)
+
+	tests := []struct {
+		name        string
+		setup       func(repo *slotMock.RepositoryMock)
+		expected    []slotDomain.Availability
+		expectedErr error
+	}{
+		{
+			name: "Success: Nothing booked",
+			setup: func(repo *slotMock.RepositoryMock) {
+				repo.On("GetReserved", s.ctx, tomorrow.Start, dayAfterTomorrow.End).
+					Return(map[time.Time]int{}, nil).Once()
+			},
+			expected: []slotDomain.Availability{
+				{Slot: tomorrow, Capacity: 2, Reserved: 0},
+				{Slot: dayAfterTomorrow, Capacity: 2, Reserved: 0},
+			},
+		},
+		{
+			name: "Success: Full slots are left out",
+			setup: func(repo *slotMock.RepositoryMock) {
+				repo.On("GetReserved", s.ctx, tomorrow.Start, dayAfterTomorrow.End).
+					Return(map[time.Time]int{tomorrow.Start: 2, dayAfterTomorrow.Start: 1}, nil).Once()
+			},
+			expected: []slotDomain.Availability{
+				{Slot: dayAfterTomorrow, Capacity: 2, Reserved: 1},
+			},
+		},
+		{
+			name: "Failure: Repository error",
+			setup: func(repo *slotMock.RepositoryMock) {
+				repo.On("GetReserved", s.ctx, mock.Anything, mock.Anything).
+					Return(map[time.Time]int(nil), errors.New("slot repository error")).Once()
+			},
+			expectedErr: errors.New("slot repository error"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			repo := new(slotMock.RepositoryMock)
+			tc.setup(repo)
+			uc := usecase.New(repo, scheduleCfg)
+
+			available, err := uc.GetAvailable(s.ctx)
+
+			if tc.expectedErr == nil {
+				t.Require().NoError(err)
+				t.Require().Equal(tc.expected, available)
+			} else {
+				t.Require().EqualError(err, tc.expectedErr.Error())
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSlotUseCaseTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(SlotUseCaseTestSuite))
+}
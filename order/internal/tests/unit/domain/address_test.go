@@ -0,0 +1,134 @@
+package domain
+
+import (
+	orderDomain "order/internal/domain/order"
+	"order/internal/tests/testutils/mothers"
+	"strings"
+	"testing"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type AddressDomainTestSuite struct {
+	suite.Suite
+}
+
+func (s *AddressDomainTestSuite) TestNormalize(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		modify      func(address *orderDomain.Address)
+		check       func(t provider.T, address orderDomain.Address)
+		expectedErr error
+	}{
+		{
+			name:   "Success",
+			modify: func(address *orderDomain.Address) {},
+		},
+		{
+			name: "Success: Fields are trimmed and upper-cased",
+			modify: func(address *orderDomain.Address) {
+				address.Country = " de "
+				address.City = " Berlin "
+				address.PostalCode = "sw1a 1aa"
+			},
+			check: func(t provider.T, address orderDomain.Address) {
+				t.Require().Equal("DE", address.Country)
+				t.Require().Equal("Berlin", address.City)
+				t.Require().Equal("SW1A 1AA", address.PostalCode)
+			},
+		},
+		{
+			name: "Success: Optional fields",
+			modify: func(address *orderDomain.Address) {
+				address.Apartment = "12"
+				address.Location = &orderDomain.Location{Latitude: -33.8688, Longitude: 151.2093}
+				address.Instructions = "Ring twice"
+			},
+			check: func(t provider.T, address orderDomain.Address) {
+				t.Require().Equal("12", address.Apartment)
+				t.Require().NotNil(address.Location)
+				t.Require().Equal("Ring twice", address.Instructions)
+			},
+		},
+		{
+			name:        "Failure: Legacy address",
+			modify:      func(address *orderDomain.Address) { *address = mothers.LegacyAddress() },
+			expectedErr: orderDomain.ErrInvalidAddress,
+		},
+		{
+			name:        "Failure: Invalid country",
+			modify:      func(address *orderDomain.Address) { address.Country = "DEU" },
+			expectedErr: orderDomain.ErrInvalidAddressCountry,
+		},
+		{
+			name:        "Failure: Missing city",
+			modify:      func(address *orderDomain.Address) { address.City = "  " },
+			expectedErr: orderDomain.ErrInvalidAddressCity,
+		},
+		{
+			name:        "Failure: Missing street",
+			modify:      func(address *orderDomain.Address) { address.Street = "" },
+			expectedErr: orderDomain.ErrInvalidAddressStreet,
+		},
+		{
+			name:        "Failure: House too long",
+			modify:      func(address *orderDomain.Address) { address.House = strings.Repeat("1", 21) },
+			expectedErr: orderDomain.ErrInvalidAddressHouse,
+		},
+		{
+			name:        "Failure: Apartment too long",
+			modify:      func(address *orderDomain.Address) { address.Apartment = strings.Repeat("1", 21) },
+			expectedErr: orderDomain.ErrInvalidAddressApartment,
+		},
+		{
+			name:        "Failure: Postal code with invalid characters",
+			modify:      func(address *orderDomain.Address) { address.PostalCode = "10117!" },
+			expectedErr: orderDomain.ErrInvalidAddressPostalCode,
+		},
+		{
+			name:        "Failure: Postal code with repeated separators",
+			modify:      func(address *orderDomain.Address) { address.PostalCode = "SW1A  1AA" },
+			expectedErr: orderDomain.ErrInvalidAddressPostalCode,
+		},
+		{
+			name:        "Failure: Latitude out of range",
+			modify:      func(address *orderDomain.Address) { address.Location = &orderDomain.Location{Latitude: 91} },
+			expectedErr: orderDomain.ErrInvalidAddressLocation,
+		},
+		{
+			name:        "Failure: Instructions too long",
+			modify:      func(address *orderDomain.Address) { address.Instructions = strings.Repeat("a", 501) },
+			expectedErr: orderDomain.ErrInvalidAddressInstructions,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			address := mothers.DefaultAddress()
+			tc.modify(&address)
+
+			normalized, err := address.Normalize()
+
+			if tc.expectedErr != nil {
+				t.Require().ErrorIs(err, tc.expectedErr)
+				t.Require().ErrorIs(err, orderDomain.ErrInvalidAddress)
+			} else {
+				t.Require().NoError(err)
+				t.Require().False(normalized.IsLegacy())
+				if tc.check != nil {
+					tc.check(t, normalized)
+				}
+			}
+		})
+	}
+}
+
+func TestAddressDomainTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(AddressDomainTestSuite))
+}
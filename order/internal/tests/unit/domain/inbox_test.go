@@ -0,0 +1,58 @@
+package domain
+
+import (
+	inboxDomain "order/internal/domain/inbox"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type InboxDomainTestSuite struct {
+	suite.Suite
+}
+
+func (s *InboxDomainTestSuite) TestCreate(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		ID        uuid.UUID
+		Response  []byte
+		Retention time.Duration
+	}{
+		{
+			name:      "Success: With response",
+			ID:        uuid.New(),
+			Response:  []byte(`{"ID":"00000000-0000-0000-0000-000000000000"}`),
+			Retention: time.Hour,
+		},
+		{
+			name:      "Success: Without response",
+			ID:        uuid.New(),
+			Response:  nil,
+			Retention: time.Minute,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			message := inboxDomain.Create(tc.ID, tc.Response, tc.Retention)
+
+			t.Require().NotNil(message)
+			t.Require().Equal(tc.ID, message.ID)
+			t.Require().Equal(tc.Response, message.Response)
+			t.Require().WithinDuration(time.Now(), message.Processed, time.Second)
+			t.Require().Equal(tc.Retention, message.Expires.Sub(message.Processed))
+		})
+	}
+}
+
+func TestInboxDomainTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(InboxDomainTestSuite))
+}
@@ -3,6 +3,8 @@ package domain
 import (
 	orderDomain "order/internal/domain/order"
 	"order/internal/tests/testutils/mothers"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -23,18 +25,18 @@ func (s *OrderDomainTestSuite) TestCreate(t provider.T) {
 	tests := []struct {
 		name        string
 		CustomerID  uuid.UUID
-		Address     string
+		Address     orderDomain.Address
 		Items       []orderDomain.Item
 		expectedErr error
 	}{
 		{
 			name:       "Success",
 			CustomerID: uuid.New(),
-			Address:    "Test Address",
+			Address:    mothers.DefaultAddress(),
 			Items: []orderDomain.Item{
 				{
 					ProductID: uuid.New(),
-					Price:     decimal.NewFromInt(100),
+					Price:     mothers.USD("100"),
 					Count:     1,
 				},
 			},
@@ -43,25 +45,32 @@ func (s *OrderDomainTestSuite) TestCreate(t provider.T) {
 		{
 			name:        "Failure: Invalid items",
 			CustomerID:  uuid.New(),
-			Address:     "Test Address",
+			Address:     mothers.DefaultAddress(),
 			Items:       []orderDomain.Item{},
 			expectedErr: orderDomain.ErrInvalidItems,
 		},
 		{
 			name:        "Failure: Invalid address",
 			CustomerID:  uuid.New(),
-			Address:     "",
+			Address:     orderDomain.Address{},
+			Items:       []orderDomain.Item{},
+			expectedErr: orderDomain.ErrInvalidAddress,
+		},
+		{
+			name:        "Failure: Legacy address",
+			CustomerID:  uuid.New(),
+			Address:     mothers.LegacyAddress(),
 			Items:       []orderDomain.Item{},
 			expectedErr: orderDomain.ErrInvalidAddress,
 		},
 		{
 			name:       "Failure: Invalid item price",
 			CustomerID: uuid.New(),
-			Address:    "Test Address",
+			Address:    mothers.DefaultAddress(),
 			Items: []orderDomain.Item{
 				{
 					ProductID: uuid.New(),
-					Price:     decimal.NewFromInt(-1),
+					Price:     mothers.USD("-1"),
 					Count:     1,
 				},
 			},
@@ -70,16 +79,26 @@ func (s *OrderDomainTestSuite) TestCreate(t provider.T) {
 		{
 			name:       "Failure: Invalid item count",
 			CustomerID: uuid.New(),
-			Address:    "Test Address",
+			Address:    mothers.DefaultAddress(),
 			Items: []orderDomain.Item{
 				{
 					ProductID: uuid.New(),
-					Price:     decimal.NewFromInt(100),
+					Price:     mothers.USD("100"),
 					Count:     0,
 				},
 			},
 			expectedErr: orderDomain.ErrInvalidItems,
 		},
+		{
+			name:       "Failure: Items in different currencies",
+			CustomerID: uuid.New(),
+			Address:    mothers.DefaultAddress(),
+			Items: []orderDomain.Item{
+				{ProductID: uuid.New(), Price: mothers.USD("100"), Count: 1},
+				{ProductID: uuid.New(), Price: orderDomain.Money{Amount: decimal.NewFromInt(100), Currency: "EUR"}, Count: 1},
+			},
+			expectedErr: orderDomain.ErrCurrencyMismatch,
+		},
 	}
 
 	for _, tc := range tests {
@@ -95,34 +114,270 @@ func (s *OrderDomainTestSuite) TestCreate(t provider.T) {
 			} else {
 				t.Require().NoError(err)
 				t.Require().NotNil(order)
+				t.Require().Equal([]orderDomain.StatusChange{
+					{To: orderDomain.Created, Occurred: order.Created, Actor: orderDomain.CustomerActor(tc.CustomerID)},
+				}, order.History)
+				t.Require().Equal(orderDomain.AllOrNothing, order.Fulfillment)
+				for _, item := range order.Items {
+					t.Require().Equal(item.Count, item.Requested)
+				}
 			}
 		})
 	}
 }
 
-func (s *OrderDomainTestSuite) TestNoteCanceledByCustomer(t provider.T) {
+func (s *OrderDomainTestSuite) TestRequestCancellation(t provider.T) {
 	t.Parallel()
 
+	policy := orderDomain.CancellationPolicy{
+		CreatedWindow:    time.Hour,
+		DeliveringWindow: 10 * time.Minute,
+	}
+
 	tests := []struct {
 		name           string
 		setup          func() *orderDomain.Order
+		customerID     func(order *orderDomain.Order) uuid.UUID
+		reason         string
+		now            time.Time
 		expectedStatus orderDomain.Status
 		expectedErr    error
 	}{
+		{
+			name: "Success: Order in Created",
+			setup: func() *orderDomain.Order {
+				return mothers.DefaultOrder()
+			},
+			customerID: func(order *orderDomain.Order) uuid.UUID {
+				return order.CustomerID
+			},
+			reason:         "Changed my mind",
+			now:            time.Now(),
+			expectedStatus: orderDomain.Canceling,
+			expectedErr:    nil,
+		},
 		{
 			name: "Success: Order in Delivering",
 			setup: func() *orderDomain.Order {
 				return mothers.OrderDelivering()
 			},
-			expectedStatus: orderDomain.CustomerCanceled,
+			customerID: func(order *orderDomain.Order) uuid.UUID {
+				return order.CustomerID
+			},
+			reason:         "",
+			now:            time.Now(),
+			expectedStatus: orderDomain.Canceling,
+			expectedErr:    nil,
+		},
+		{
+			name: "Failure: Created window elapsed",
+			setup: func() *orderDomain.Order {
+				return mothers.DefaultOrder()
+			},
+			customerID: func(order *orderDomain.Order) uuid.UUID {
+				return order.CustomerID
+			},
+			now:            time.Now().Add(2 * time.Hour),
+			expectedStatus: orderDomain.Created,
+			expectedErr:    orderDomain.ErrCancellationNotAllowed,
+		},
+		{
+			name: "Failure: Delivering window elapsed",
+			setup: func() *orderDomain.Order {
+				return mothers.OrderDelivering()
+			},
+			customerID: func(order *orderDomain.Order) uuid.UUID {
+				return order.CustomerID
+			},
+			now:            time.Now().Add(30 * time.Minute),
+			expectedStatus: orderDomain.Delivering,
+			expectedErr:    orderDomain.ErrCancellationNotAllowed,
+		},
+		{
+			name: "Failure: Order already delivered",
+			setup: func() *orderDomain.Order {
+				return mothers.OrderDelivered()
+			},
+			customerID: func(order *orderDomain.Order) uuid.UUID {
+				return order.CustomerID
+			},
+			now:            time.Now(),
+			expectedStatus: orderDomain.Delivered,
+			expectedErr:    orderDomain.ErrUnsupportedStatusTransition,
+		},
+		{
+			name: "Failure: Reason too long",
+			setup: func() *orderDomain.Order {
+				return mothers.DefaultOrder()
+			},
+			customerID: func(order *orderDomain.Order) uuid.UUID {
+				return order.CustomerID
+			},
+			reason:         strings.Repeat("я", 501),
+			now:            time.Now(),
+			expectedStatus: orderDomain.Created,
+			expectedErr:    orderDomain.ErrInvalidCancelReason,
+		},
+		{
+			name: "Failure: Order of another customer",
+			setup: func() *orderDomain.Order {
+				return mothers.OrderDelivering()
+			},
+			customerID: func(_ *orderDomain.Order) uuid.UUID {
+				return uuid.New()
+			},
+			now:            time.Now(),
+			expectedStatus: orderDomain.Delivering,
+			expectedErr:    orderDomain.ErrPermissionDenied,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+			order := tc.setup()
+
+			err := order.RequestCancellation(tc.customerID(order), tc.reason, policy, tc.now)
+
+			if tc.expectedErr != nil {
+				t.Require().Error(err)
+				t.Require().ErrorIs(err, tc.expectedErr)
+				t.Require().Empty(order.CancelReason)
+				t.Require().Empty(order.History)
+			} else {
+				t.Require().NoError(err)
+				t.Require().Equal(tc.reason, order.CancelReason)
+				t.Require().Len(order.History, 1)
+				change := order.History[0]
+				t.Require().Equal(orderDomain.Canceling, change.To)
+				t.Require().Equal(orderDomain.CustomerActor(order.CustomerID), change.Actor)
+				t.Require().Equal(tc.reason, change.Reason)
+				t.Require().Nil(change.MessageID)
+			}
+			t.Require().Equal(tc.expectedStatus, order.Status)
+		})
+	}
+}
+
+func (s *OrderDomainTestSuite) TestForceCancel(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		setup          func() *orderDomain.Order
+		reason         string
+		expectedStatus orderDomain.Status
+		expectedErr    error
+	}{
+		{
+			name: "Success: Order in Created",
+			setup: func() *orderDomain.Order {
+				return mothers.DefaultOrder()
+			},
+			reason:         "Fraudulent order",
+			expectedStatus: orderDomain.Canceling,
+			expectedErr:    nil,
+		},
+		{
+			name: "Success: Order in Delivering",
+			setup: func() *orderDomain.Order {
+				return mothers.OrderDelivering()
+			},
+			reason:         "Courier unreachable",
+			expectedStatus: orderDomain.Canceling,
 			expectedErr:    nil,
 		},
 		{
-			name: "Failure: Order remains in Created",
+			name: "Failure: Order already delivered",
+			setup: func() *orderDomain.Order {
+				return mothers.OrderDelivered()
+			},
+			reason:         "Too late",
+			expectedStatus: orderDomain.Delivered,
+			expectedErr:    orderDomain.ErrUnsupportedStatusTransition,
+		},
+		{
+			name: "Failure: Blank reason",
+			setup: func() *orderDomain.Order {
+				return mothers.DefaultOrder()
+			},
+			reason:         "  ",
+			expectedStatus: orderDomain.Created,
+			expectedErr:    orderDomain.ErrInvalidCancelReason,
+		},
+		{
+			name: "Failure: Reason too long",
 			setup: func() *orderDomain.Order {
 				return mothers.DefaultOrder()
 			},
+			reason:         strings.Repeat("я", 501),
 			expectedStatus: orderDomain.Created,
+			expectedErr:    orderDomain.ErrInvalidCancelReason,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+			order := tc.setup()
+
+			err := order.ForceCancel(tc.reason)
+
+			if tc.expectedErr != nil {
+				t.Require().Error(err)
+				t.Require().ErrorIs(err, tc.expectedErr)
+				t.Require().Empty(order.CancelReason)
+				t.Require().Empty(order.History)
+			} else {
+				t.Require().NoError(err)
+				t.Require().Equal(tc.reason, order.CancelReason)
+				t.Require().Len(order.History, 1)
+				change := order.History[0]
+				t.Require().Equal(orderDomain.Canceling, change.To)
+				t.Require().Equal(orderDomain.AdminActor(), change.Actor)
+				t.Require().Equal(tc.reason, change.Reason)
+				t.Require().Nil(change.MessageID)
+			}
+			t.Require().Equal(tc.expectedStatus, order.Status)
+		})
+	}
+}
+
+func (s *OrderDomainTestSuite) TestNoteCancellationCompleted(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		setup          func() *orderDomain.Order
+		expectedStatus orderDomain.Status
+		expectedErr    error
+	}{
+		{
+			name: "Success: Order in Canceling",
+			setup: func() *orderDomain.Order {
+				return mothers.OrderCanceling()
+			},
+			expectedStatus: orderDomain.CustomerCanceled,
+			expectedErr:    nil,
+		},
+		{
+			name: "Success: Cancellation requested by admin",
+			setup: func() *orderDomain.Order {
+				order := mothers.DefaultOrder()
+				_ = order.ForceCancel("Fraudulent order")
+				return order
+			},
+			expectedStatus: orderDomain.CanceledByAdmin,
+			expectedErr:    nil,
+		},
+		{
+			name: "Failure: Order in Delivering",
+			setup: func() *orderDomain.Order {
+				return mothers.OrderDelivering()
+			},
+			expectedStatus: orderDomain.Delivering,
 			expectedErr:    orderDomain.ErrUnsupportedStatusTransition,
 		},
 	}
@@ -132,14 +387,24 @@ func (s *OrderDomainTestSuite) TestNoteCanceledByCustomer(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 			order := tc.setup()
+			from := order.Status
+			recorded := len(order.History)
+			messageID := uuid.New()
 
-			err := order.NoteCanceledByCustomer()
+			err := order.NoteCancellationCompleted(messageID)
 
 			if tc.expectedErr != nil {
 				t.Require().Error(err)
 				t.Require().ErrorIs(err, tc.expectedErr)
+				t.Require().Len(order.History, recorded)
 			} else {
 				t.Require().NoError(err)
+				t.Require().Len(order.History, recorded+1)
+				change := order.History[recorded]
+				t.Require().Equal(from, change.From)
+				t.Require().Equal(tc.expectedStatus, change.To)
+				t.Require().Equal(orderDomain.SystemActor(), change.Actor)
+				t.Require().Equal(&messageID, change.MessageID)
 			}
 			t.Require().Equal(tc.expectedStatus, order.Status)
 		})
@@ -178,14 +443,23 @@ func (s *OrderDomainTestSuite) TestNoteCanceledOutOfStock(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 			order := tc.setup()
+			from := order.Status
+			messageID := uuid.New()
 
-			err := order.NoteCanceledOutOfStock()
+			err := order.NoteCanceledOutOfStock(messageID)
 
 			if tc.expectedErr != nil {
 				t.Require().Error(err)
 				t.Require().ErrorIs(err, tc.expectedErr)
+				t.Require().Empty(order.History)
 			} else {
 				t.Require().NoError(err)
+				t.Require().Len(order.History, 1)
+				change := order.History[0]
+				t.Require().Equal(from, change.From)
+				t.Require().Equal(tc.expectedStatus, change.To)
+				t.Require().Equal(orderDomain.SystemActor(), change.Actor)
+				t.Require().Equal(&messageID, change.MessageID)
 			}
 			t.Require().Equal(tc.expectedStatus, order.Status)
 		})
@@ -224,14 +498,78 @@ func (s *OrderDomainTestSuite) TestNoteCanceledCourierNotFound(t provider.T) {
 		t.Run(tc.name, func(t provider.T) {
 			t.Parallel()
 			order := tc.setup()
+			from := order.Status
+			messageID := uuid.New()
+
+			err := order.NoteCanceledCourierNotFound(messageID)
+
+			if tc.expectedErr != nil {
+				t.Require().Error(err)
+				t.Require().ErrorIs(err, tc.expectedErr)
+				t.Require().Empty(order.History)
+			} else {
+				t.Require().NoError(err)
+				t.Require().Len(order.History, 1)
+				change := order.History[0]
+				t.Require().Equal(from, change.From)
+				t.Require().Equal(tc.expectedStatus, change.To)
+				t.Require().Equal(orderDomain.SystemActor(), change.Actor)
+				t.Require().Equal(&messageID, change.MessageID)
+			}
+			t.Require().Equal(tc.expectedStatus, order.Status)
+		})
+	}
+}
+
+func (s *OrderDomainTestSuite) TestNoteCanceledTimeout(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		setup          func() *orderDomain.Order
+		expectedStatus orderDomain.Status
+		expectedErr    error
+	}{
+		{
+			name: "Success: Order in Created (default)",
+			setup: func() *orderDomain.Order {
+				return mothers.DefaultOrder()
+			},
+			expectedStatus: orderDomain.CanceledTimeout,
+			expectedErr:    nil,
+		},
+		{
+			name: "Failure: Order in Delivering",
+			setup: func() *orderDomain.Order {
+				return mothers.OrderDelivering()
+			},
+			expectedStatus: orderDomain.Delivering,
+			expectedErr:    orderDomain.ErrUnsupportedStatusTransition,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+			order := tc.setup()
+			from := order.Status
+			messageID := uuid.New()
 
-			err := order.NoteCanceledCourierNotFound()
+			err := order.NoteCanceledTimeout(messageID)
 
 			if tc.expectedErr != nil {
 				t.Require().Error(err)
 				t.Require().ErrorIs(err, tc.expectedErr)
+				t.Require().Empty(order.History)
 			} else {
 				t.Require().NoError(err)
+				t.Require().Len(order.History, 1)
+				change := order.History[0]
+				t.Require().Equal(from, change.From)
+				t.Require().Equal(tc.expectedStatus, change.To)
+				t.Require().Equal(orderDomain.SystemActor(), change.Actor)
+				t.Require().Equal(&messageID, change.MessageID)
 			}
 			t.Require().Equal(tc.expectedStatus, order.Status)
 		})
@@ -274,7 +612,9 @@ func (s *OrderDomainTestSuite) TestNoteDelivering(t provider.T) {
 			t.Parallel()
 			order := tc.setup()
 
-			err := order.NoteDelivering(tc.courierID)
+			messageID := uuid.New()
+
+			err := order.NoteDelivering(tc.courierID, messageID)
 
 			if tc.expectedErr != nil {
 				t.Require().Error(err)
@@ -283,6 +623,12 @@ func (s *OrderDomainTestSuite) TestNoteDelivering(t provider.T) {
 				t.Require().NoError(err)
 				t.Require().NotNil(order.Delivery.CourierID)
 				t.Require().Equal(tc.courierID, *order.Delivery.CourierID)
+				t.Require().NotNil(order.Delivery.Assigned)
+				t.Require().WithinDuration(time.Now(), *order.Delivery.Assigned, time.Second)
+				t.Require().Len(order.History, 1)
+				t.Require().Equal(orderDomain.SystemActor(), order.History[0].Actor)
+				t.Require().Equal(&messageID, order.History[0].MessageID)
+				t.Require().Equal(&tc.courierID, order.History[0].CourierID)
 			}
 			t.Require().Equal(tc.expectedStatus, order.Status)
 		})
@@ -295,6 +641,7 @@ func (s *OrderDomainTestSuite) TestNoteDelivered(t provider.T) {
 	tests := []struct {
 		name           string
 		setup          func() *orderDomain.Order
+		courierID      func(order *orderDomain.Order) uuid.UUID
 		expectedStatus orderDomain.Status
 		expectedErr    error
 	}{
@@ -303,16 +650,44 @@ func (s *OrderDomainTestSuite) TestNoteDelivered(t provider.T) {
 			setup: func() *orderDomain.Order {
 				return mothers.OrderDelivering()
 			},
+			courierID: func(order *orderDomain.Order) uuid.UUID {
+				return *order.Delivery.CourierID
+			},
 			expectedStatus: orderDomain.Delivered,
 			expectedErr:    nil,
 		},
 		{
-			name: "Failure: Order in Created (default)",
+			name: "Failure: Order already Delivered",
+			setup: func() *orderDomain.Order {
+				return mothers.OrderDelivered()
+			},
+			courierID: func(order *orderDomain.Order) uuid.UUID {
+				return *order.Delivery.CourierID
+			},
+			expectedStatus: orderDomain.Delivered,
+			expectedErr:    orderDomain.ErrUnsupportedStatusTransition,
+		},
+		{
+			name: "Failure: Order in Created has no courier",
 			setup: func() *orderDomain.Order {
 				return mothers.DefaultOrder()
 			},
+			courierID: func(_ *orderDomain.Order) uuid.UUID {
+				return uuid.New()
+			},
 			expectedStatus: orderDomain.Created,
-			expectedErr:    orderDomain.ErrUnsupportedStatusTransition,
+			expectedErr:    orderDomain.ErrPermissionDenied,
+		},
+		{
+			name: "Failure: Order of another courier",
+			setup: func() *orderDomain.Order {
+				return mothers.OrderDelivering()
+			},
+			courierID: func(_ *orderDomain.Order) uuid.UUID {
+				return uuid.New()
+			},
+			expectedStatus: orderDomain.Delivering,
+			expectedErr:    orderDomain.ErrPermissionDenied,
 		},
 	}
 
@@ -322,7 +697,7 @@ func (s *OrderDomainTestSuite) TestNoteDelivered(t provider.T) {
 			t.Parallel()
 			order := tc.setup()
 
-			err := order.NoteDelivered()
+			err := order.NoteDelivered(tc.courierID(order))
 
 			if tc.expectedErr != nil {
 				t.Require().Error(err)
@@ -331,12 +706,995 @@ func (s *OrderDomainTestSuite) TestNoteDelivered(t provider.T) {
 				t.Require().NoError(err)
 				t.Require().NotNil(order.Delivery.CourierID)
 				t.Require().WithinDuration(time.Now(), *order.Delivery.Arrived, time.Second)
+				t.Require().Len(order.History, 1)
+				change := order.History[0]
+				t.Require().Equal(orderDomain.Delivering, change.From)
+				t.Require().Equal(orderDomain.Delivered, change.To)
+				t.Require().Equal(orderDomain.CourierActor(*order.Delivery.CourierID), change.Actor)
+				t.Require().Nil(change.MessageID)
 			}
 			t.Require().Equal(tc.expectedStatus, order.Status)
 		})
 	}
 }
 
+func (s *OrderDomainTestSuite) TestNoted(t provider.T) {
+	t.Parallel()
+
+	order := mothers.DefaultOrder()
+	messageID := uuid.New()
+	t.Require().False(order.Noted(messageID))
+
+	t.Require().NoError(order.NoteCanceledOutOfStock(messageID))
+
+	t.Require().True(order.Noted(messageID))
+	t.Require().False(order.Noted(uuid.New()))
+}
+
+func (s *OrderDomainTestSuite) TestReassignCourier(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		setup       func() *orderDomain.Order
+		courierID   func(order *orderDomain.Order) uuid.UUID
+		reason      string
+		expectedErr error
+	}{
+		{
+			name: "Success: Order in Delivering",
+			setup: func() *orderDomain.Order {
+				return mothers.OrderDelivering()
+			},
+			courierID: func(_ *orderDomain.Order) uuid.UUID {
+				return uuid.New()
+			},
+			reason:      "Courier vehicle broke down",
+			expectedErr: nil,
+		},
+		{
+			name: "Failure: Order in Created",
+			setup: func() *orderDomain.Order {
+				return mothers.DefaultOrder()
+			},
+			courierID: func(_ *orderDomain.Order) uuid.UUID {
+				return uuid.New()
+			},
+			expectedErr: orderDomain.ErrUnsupportedStatusTransition,
+		},
+		{
+			name: "Failure: Same courier",
+			setup: func() *orderDomain.Order {
+				return mothers.OrderDelivering()
+			},
+			courierID: func(order *orderDomain.Order) uuid.UUID {
+				return *order.Delivery.CourierID
+			},
+			expectedErr: orderDomain.ErrInvalidCourier,
+		},
+		{
+			name: "Failure: Nil courier",
+			setup: func() *orderDomain.Order {
+				return mothers.OrderDelivering()
+			},
+			courierID: func(_ *orderDomain.Order) uuid.UUID {
+				return uuid.Nil
+			},
+			expectedErr: orderDomain.ErrInvalidCourier,
+		},
+		{
+			name: "Failure: Reason too long",
+			setup: func() *orderDomain.Order {
+				return mothers.OrderDelivering()
+			},
+			courierID: func(_ *orderDomain.Order) uuid.UUID {
+				return uuid.New()
+			},
+			reason:      strings.Repeat("я", 501),
+			expectedErr: orderDomain.ErrInvalidReason,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+			order := tc.setup()
+			status := order.Status
+			previous := order.Delivery.CourierID
+			courierID := tc.courierID(order)
+
+			err := order.ReassignCourier(courierID, tc.reason)
+
+			if tc.expectedErr != nil {
+				t.Require().Error(err)
+				t.Require().ErrorIs(err, tc.expectedErr)
+				t.Require().Equal(previous, order.Delivery.CourierID)
+				t.Require().Empty(order.History)
+			} else {
+				t.Require().NoError(err)
+				t.Require().Equal(&courierID, order.Delivery.CourierID)
+				t.Require().NotNil(order.Delivery.Assigned)
+				t.Require().Len(order.History, 1)
+				change := order.History[0]
+				t.Require().Equal(orderDomain.Delivering, change.From)
+				t.Require().Equal(orderDomain.Delivering, change.To)
+				t.Require().Equal(orderDomain.AdminActor(), change.Actor)
+				t.Require().Equal(tc.reason, change.Reason)
+				t.Require().Equal(&courierID, change.CourierID)
+			}
+			t.Require().Equal(status, order.Status)
+		})
+	}
+}
+
+func (s *OrderDomainTestSuite) TestListQueryNormalize(t provider.T) {
+	t.Parallel()
+
+	from := time.Now()
+	to := from.Add(time.Hour)
+
+	tests := []struct {
+		name        string
+		query       orderDomain.ListQuery
+		expected    orderDomain.ListQuery
+		expectedErr error
+	}{
+		{
+			name:     "Success: Defaults",
+			query:    orderDomain.ListQuery{},
+			expected: orderDomain.ListQuery{Sort: orderDomain.NewestFirst, Limit: orderDomain.DefaultPageSize},
+		},
+		{
+			name:     "Success: Limit capped",
+			query:    orderDomain.ListQuery{Sort: orderDomain.OldestFirst, Limit: orderDomain.MaxPageSize * 2},
+			expected: orderDomain.ListQuery{Sort: orderDomain.OldestFirst, Limit: orderDomain.MaxPageSize},
+		},
+		{
+			name:     "Success: Created range kept",
+			query:    orderDomain.ListQuery{CreatedFrom: &from, CreatedTo: &to, Limit: 5},
+			expected: orderDomain.ListQuery{CreatedFrom: &from, CreatedTo: &to, Sort: orderDomain.NewestFirst, Limit: 5},
+		},
+		{
+			name:        "Failure: Negative limit",
+			query:       orderDomain.ListQuery{Limit: -1},
+			expectedErr: orderDomain.ErrInvalidListQuery,
+		},
+		{
+			name:        "Failure: Inverted created range",
+			query:       orderDomain.ListQuery{CreatedFrom: &to, CreatedTo: &from},
+			expectedErr: orderDomain.ErrInvalidListQuery,
+		},
+		{
+			name:        "Failure: Unknown sort",
+			query:       orderDomain.ListQuery{Sort: "random"},
+			expectedErr: orderDomain.ErrInvalidListQuery,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			query, err := tc.query.Normalize()
+
+			if tc.expectedErr != nil {
+				t.Require().ErrorIs(err, tc.expectedErr)
+			} else {
+				t.Require().NoError(err)
+				t.Require().Equal(tc.expected, query)
+			}
+		})
+	}
+}
+
+func (s *OrderDomainTestSuite) TestTotal(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		items    []orderDomain.Item
+		discount *orderDomain.Discount
+		expected orderDomain.Money
+	}{
+		{
+			name: "Single item",
+			items: []orderDomain.Item{
+				{ProductID: uuid.New(), Price: mothers.USD("19.99"), Count: 3},
+			},
+			expected: mothers.USD("59.97"),
+		},
+		{
+			name: "Several items",
+			items: []orderDomain.Item{
+				{ProductID: uuid.New(), Price: mothers.USD("0.10"), Count: 3},
+				{ProductID: uuid.New(), Price: mothers.USD("5"), Count: 2},
+			},
+			expected: mothers.USD("10.30"),
+		},
+		{
+			name: "Discounted",
+			items: []orderDomain.Item{
+				{ProductID: uuid.New(), Price: mothers.USD("5"), Count: 2},
+			},
+			discount: &orderDomain.Discount{Code: "SAVE", Amount: mothers.USD("1.50")},
+			expected: mothers.USD("8.50"),
+		},
+		{
+			name:     "No items",
+			items:    []orderDomain.Item{},
+			expected: orderDomain.Money{Amount: decimal.Zero},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			order := &orderDomain.Order{Items: tc.items, Discount: tc.discount}
+
+			t.Require().True(tc.expected.Equal(order.Total()), "got %s", order.Total())
+		})
+	}
+}
+
+func (s *OrderDomainTestSuite) TestApplyDiscount(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		order       func() *orderDomain.Order
+		amount      orderDomain.Money
+		expectedErr error
+	}{
+		{
+			name:   "Success",
+			order:  mothers.OrderWithItems,
+			amount: mothers.USD("1.25"),
+		},
+		{
+			name:   "Success: Whole subtotal",
+			order:  mothers.OrderWithItems,
+			amount: mothers.USD("11.25"),
+		},
+		{
+			name:        "Failure: More than the subtotal",
+			order:       mothers.OrderWithItems,
+			amount:      mothers.USD("11.26"),
+			expectedErr: orderDomain.ErrInvalidDiscount,
+		},
+		{
+			name:        "Failure: Zero amount",
+			order:       mothers.OrderWithItems,
+			amount:      mothers.USD("0"),
+			expectedErr: orderDomain.ErrInvalidDiscount,
+		},
+		{
+			name:        "Failure: Other currency",
+			order:       mothers.OrderWithItems,
+			amount:      orderDomain.Money{Amount: decimal.NewFromInt(1), Currency: "EUR"},
+			expectedErr: orderDomain.ErrCurrencyMismatch,
+		},
+		{
+			name: "Failure: Already discounted",
+			order: func() *orderDomain.Order {
+				order := mothers.OrderWithItems()
+				order.Discount = &orderDomain.Discount{Code: "FIRST", Amount: mothers.USD("1")}
+				return order
+			},
+			amount:      mothers.USD("1"),
+			expectedErr: orderDomain.ErrInvalidDiscount,
+		},
+		{
+			name: "Failure: Order already delivering",
+			order: func() *orderDomain.Order {
+				order := mothers.OrderWithItems()
+				order.Status = orderDomain.Delivering
+				return order
+			},
+			amount:      mothers.USD("1"),
+			expectedErr: orderDomain.ErrInvalidDiscount,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			order := tc.order()
+			subtotal := order.Subtotal()
+
+			err := order.ApplyDiscount("SAVE", tc.amount)
+
+			if tc.expectedErr != nil {
+				t.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			t.Require().NoError(err)
+			t.Require().Equal("SAVE", order.Discount.Code)
+			expected, err := subtotal.Sub(tc.amount)
+			t.Require().NoError(err)
+			t.Require().True(expected.Equal(order.Total()), "got %s", order.Total())
+		})
+	}
+}
+
+func (s *OrderDomainTestSuite) TestReviseDiscount(t provider.T) {
+	t.Parallel()
+
+	discounted := func() *orderDomain.Order {
+		order := mothers.OrderWithItems()
+		order.Discount = &orderDomain.Discount{Code: "SAVE", Amount: mothers.USD("5")}
+		return order
+	}
+	amount := func(value string) *orderDomain.Money {
+		money := mothers.USD(value)
+		return &money
+	}
+
+	tests := []struct {
+		name          string
+		order         func() *orderDomain.Order
+		amount        *orderDomain.Money
+		expectedTotal orderDomain.Money
+		expectedErr   error
+	}{
+		{
+			name:          "Success",
+			order:         discounted,
+			amount:        amount("1.25"),
+			expectedTotal: mothers.USD("10.00"),
+		},
+		{
+			name:          "Success: Dropped",
+			order:         discounted,
+			expectedTotal: mothers.USD("11.25"),
+		},
+		{
+			name:        "Failure: More than the subtotal",
+			order:       discounted,
+			amount:      amount("11.26"),
+			expectedErr: orderDomain.ErrInvalidDiscount,
+		},
+		{
+			name:        "Failure: Zero amount",
+			order:       discounted,
+			amount:      amount("0"),
+			expectedErr: orderDomain.ErrInvalidDiscount,
+		},
+		{
+			name:        "Failure: Other currency",
+			order:       discounted,
+			amount:      &orderDomain.Money{Amount: decimal.NewFromInt(1), Currency: "EUR"},
+			expectedErr: orderDomain.ErrCurrencyMismatch,
+		},
+		{
+			name:        "Failure: Not discounted",
+			order:       mothers.OrderWithItems,
+			amount:      amount("1"),
+			expectedErr: orderDomain.ErrInvalidDiscount,
+		},
+		{
+			name: "Failure: Order already delivering",
+			order: func() *orderDomain.Order {
+				order := discounted()
+				order.Status = orderDomain.Delivering
+				return order
+			},
+			amount:      amount("1"),
+			expectedErr: orderDomain.ErrInvalidDiscount,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			order := tc.order()
+			previous := order.Discount
+
+			err := order.ReviseDiscount(tc.amount)
+
+			if tc.expectedErr != nil {
+				t.Require().ErrorIs(err, tc.expectedErr)
+				t.Require().Equal(previous, order.Discount)
+				return
+			}
+			t.Require().NoError(err)
+			t.Require().True(tc.expectedTotal.Equal(order.Total()), "got %s", order.Total())
+		})
+	}
+}
+
+func (s *OrderDomainTestSuite) TestScheduleDelivery(t provider.T) {
+	t.Parallel()
+
+	slot := mothers.TomorrowSlot()
+
+	tests := []struct {
+		name        string
+		order       func() *orderDomain.Order
+		slot        orderDomain.DeliverySlot
+		expectedErr error
+	}{
+		{
+			name:  "Success",
+			order: mothers.DefaultOrder,
+			slot:  slot,
+		},
+		{
+			name:        "Failure: Empty window",
+			order:       mothers.DefaultOrder,
+			slot:        orderDomain.DeliverySlot{Start: slot.Start, End: slot.Start},
+			expectedErr: orderDomain.ErrInvalidDeliverySlot,
+		},
+		{
+			name:        "Failure: Ends before it starts",
+			order:       mothers.DefaultOrder,
+			slot:        orderDomain.DeliverySlot{Start: slot.End, End: slot.Start},
+			expectedErr: orderDomain.ErrInvalidDeliverySlot,
+		},
+		{
+			name: "Failure: Already scheduled",
+			order: func() *orderDomain.Order {
+				return mothers.OrderWithSlot(slot)
+			},
+			slot:        slot,
+			expectedErr: orderDomain.ErrInvalidDeliverySlot,
+		},
+		{
+			name:        "Failure: Order already delivering",
+			order:       mothers.OrderDelivering,
+			slot:        slot,
+			expectedErr: orderDomain.ErrInvalidDeliverySlot,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			order := tc.order()
+			previous := order.Delivery.Slot
+
+			err := order.ScheduleDelivery(tc.slot)
+
+			if tc.expectedErr != nil {
+				t.Require().ErrorIs(err, tc.expectedErr)
+				t.Require().Equal(previous, order.Delivery.Slot)
+				return
+			}
+			t.Require().NoError(err)
+			t.Require().Equal(&tc.slot, order.Delivery.Slot)
+		})
+	}
+}
+
+func (s *OrderDomainTestSuite) TestChooseFulfillment(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		order       func() *orderDomain.Order
+		policy      orderDomain.FulfillmentPolicy
+		expectedErr error
+	}{
+		{
+			name:   "Success: Allow partial",
+			order:  mothers.DefaultOrder,
+			policy: orderDomain.AllowPartial,
+		},
+		{
+			name:   "Success: All or nothing",
+			order:  mothers.OrderAllowingPartial,
+			policy: orderDomain.AllOrNothing,
+		},
+		{
+			name:        "Failure: Unknown policy",
+			order:       mothers.DefaultOrder,
+			policy:      "some_of_it",
+			expectedErr: orderDomain.ErrInvalidFulfillmentPolicy,
+		},
+		{
+			name:        "Failure: Order already delivering",
+			order:       mothers.OrderDelivering,
+			policy:      orderDomain.AllowPartial,
+			expectedErr: orderDomain.ErrInvalidFulfillmentPolicy,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			order := tc.order()
+			previous := order.Fulfillment
+
+			err := order.ChooseFulfillment(tc.policy)
+
+			if tc.expectedErr != nil {
+				t.Require().ErrorIs(err, tc.expectedErr)
+				t.Require().Equal(previous, order.Fulfillment)
+				return
+			}
+			t.Require().NoError(err)
+			t.Require().Equal(tc.policy, order.Fulfillment)
+		})
+	}
+}
+
+func (s *OrderDomainTestSuite) TestNoteItemsReserved(t provider.T) {
+	t.Parallel()
+
+	// OrderWithItems holds 2 x 4.50 USD and 1 x 2.25 USD.
+	reserved := func(order *orderDomain.Order, counts ...int) map[uuid.UUID]int {
+		result := make(map[uuid.UUID]int, len(counts))
+		for i, count := range counts {
+			result[order.Items[i].ProductID] += count
+		}
+		return result
+	}
+
+	tests := []struct {
+		name             string
+		order            func() *orderDomain.Order
+		reserved         func(order *orderDomain.Order) map[uuid.UUID]int
+		expectedShort    bool
+		expectedCounts   []int
+		expectedSubtotal orderDomain.Money
+		expectedTotal    orderDomain.Money
+		expectedErr      error
+	}{
+		{
+			name:  "Success: Everything reserved",
+			order: mothers.OrderWithItems,
+			reserved: func(order *orderDomain.Order) map[uuid.UUID]int {
+				return reserved(order, 2, 1)
+			},
+			expectedCounts:   []int{2, 1},
+			expectedSubtotal: mothers.USD("11.25"),
+			expectedTotal:    mothers.USD("11.25"),
+		},
+		{
+			name:  "Success: Partially reserved",
+			order: mothers.OrderAllowingPartial,
+			reserved: func(order *orderDomain.Order) map[uuid.UUID]int {
+				return reserved(order, 1, 0)
+			},
+			expectedShort:    true,
+			expectedCounts:   []int{1, 0},
+			expectedSubtotal: mothers.USD("4.50"),
+			expectedTotal:    mothers.USD("4.50"),
+		},
+		{
+			name: "Success: Product on several lines",
+			order: func() *orderDomain.Order {
+				order := mothers.OrderAllowingPartial()
+				order.Items[1].ProductID = order.Items[0].ProductID
+				return order
+			},
+			reserved: func(order *orderDomain.Order) map[uuid.UUID]int {
+				return reserved(order, 2)
+			},
+			expectedShort:    true,
+			expectedCounts:   []int{2, 0},
+			expectedSubtotal: mothers.USD("9.00"),
+			expectedTotal:    mothers.USD("9.00"),
+		},
+		{
+			name:  "Failure: Order needs every item",
+			order: mothers.OrderWithItems,
+			reserved: func(order *orderDomain.Order) map[uuid.UUID]int {
+				return reserved(order, 1, 1)
+			},
+			expectedErr: orderDomain.ErrInvalidFulfillment,
+		},
+		{
+			name:  "Failure: Nothing reserved",
+			order: mothers.OrderAllowingPartial,
+			reserved: func(order *orderDomain.Order) map[uuid.UUID]int {
+				return reserved(order, 0, 0)
+			},
+			expectedErr: orderDomain.ErrInvalidFulfillment,
+		},
+		{
+			name:  "Failure: More than requested",
+			order: mothers.OrderAllowingPartial,
+			reserved: func(order *orderDomain.Order) map[uuid.UUID]int {
+				return reserved(order, 3, 1)
+			},
+			expectedErr: orderDomain.ErrInvalidFulfillment,
+		},
+		{
+			name:  "Failure: Product missing",
+			order: mothers.OrderAllowingPartial,
+			reserved: func(order *orderDomain.Order) map[uuid.UUID]int {
+				return reserved(order, 1)
+			},
+			expectedErr: orderDomain.ErrInvalidFulfillment,
+		},
+		{
+			name:  "Failure: Order already delivering",
+			order: mothers.OrderDelivering,
+			reserved: func(order *orderDomain.Order) map[uuid.UUID]int {
+				return map[uuid.UUID]int{}
+			},
+			expectedErr: orderDomain.ErrUnsupportedStatusTransition,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			order := tc.order()
+			previous := slices.Clone(order.Items)
+
+			short, err := order.NoteItemsReserved(tc.reserved(order), time.Now())
+
+			if tc.expectedErr != nil {
+				t.Require().ErrorIs(err, tc.expectedErr)
+				t.Require().Equal(previous, order.Items)
+				t.Require().Empty(order.PullEvents())
+				return
+			}
+			t.Require().NoError(err)
+			t.Require().Equal(tc.expectedShort, short)
+			for i, item := range order.Items {
+				t.Require().Equal(tc.expectedCounts[i], item.Count)
+				t.Require().Equal(previous[i].Requested, item.Requested)
+			}
+			t.Require().True(tc.expectedSubtotal.Equal(order.Subtotal()), "got %s", order.Subtotal())
+			t.Require().True(tc.expectedTotal.Equal(order.Total()), "got %s", order.Total())
+		})
+	}
+}
+
+func (s *OrderDomainTestSuite) TestMoneyFromUnits(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		units       int64
+		nanos       int32
+		currency    string
+		expected    orderDomain.Money
+		expectedErr error
+	}{
+		{
+			name:     "Success: Fractional amount",
+			units:    19,
+			nanos:    990_000_000,
+			currency: "USD",
+			expected: mothers.USD("19.99"),
+		},
+		{
+			name:     "Success: Negative amount",
+			units:    -1,
+			nanos:    -500_000_000,
+			currency: "USD",
+			expected: mothers.USD("-1.5"),
+		},
+		{
+			name:        "Failure: Nanos out of range",
+			units:       1,
+			nanos:       1_000_000_000,
+			currency:    "USD",
+			expectedErr: orderDomain.ErrInvalidMoney,
+		},
+		{
+			name:        "Failure: Signs differ",
+			units:       1,
+			nanos:       -1,
+			currency:    "USD",
+			expectedErr: orderDomain.ErrInvalidMoney,
+		},
+		{
+			name:        "Failure: Invalid currency",
+			units:       1,
+			currency:    "usd",
+			expectedErr: orderDomain.ErrInvalidCurrency,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			money, err := orderDomain.MoneyFromUnits(tc.units, tc.nanos, tc.currency)
+
+			if tc.expectedErr != nil {
+				t.Require().ErrorIs(err, tc.expectedErr)
+			} else {
+				t.Require().NoError(err)
+				t.Require().True(tc.expected.Equal(money), "got %s", money)
+				t.Require().Equal(tc.units, money.Units())
+				t.Require().Equal(tc.nanos, money.Nanos())
+			}
+		})
+	}
+}
+
+func (s *OrderDomainTestSuite) TestMoneyAdd(t provider.T) {
+	t.Parallel()
+
+	sum, err := mothers.USD("0.10").Add(mothers.USD("0.20"))
+	t.Require().NoError(err)
+	t.Require().True(mothers.USD("0.30").Equal(sum))
+
+	_, err = mothers.USD("1").Add(orderDomain.Money{Amount: decimal.NewFromInt(1), Currency: "EUR"})
+	t.Require().ErrorIs(err, orderDomain.ErrCurrencyMismatch)
+}
+
+func (s *OrderDomainTestSuite) TestAuthorizeView(t provider.T) {
+	t.Parallel()
+
+	order := mothers.OrderDelivering()
+
+	tests := []struct {
+		name        string
+		actor       orderDomain.Actor
+		expectedErr error
+	}{
+		{
+			name:  "Success: Owning customer",
+			actor: orderDomain.CustomerActor(order.CustomerID),
+		},
+		{
+			name:  "Success: Assigned courier",
+			actor: orderDomain.CourierActor(*order.Delivery.CourierID),
+		},
+		{
+			name:  "Success: Admin",
+			actor: orderDomain.AdminActor(),
+		},
+		{
+			name:        "Failure: Another customer",
+			actor:       orderDomain.CustomerActor(uuid.New()),
+			expectedErr: orderDomain.ErrPermissionDenied,
+		},
+		{
+			name:        "Failure: Another courier",
+			actor:       orderDomain.CourierActor(uuid.New()),
+			expectedErr: orderDomain.ErrPermissionDenied,
+		},
+		{
+			name:        "Failure: Customer ID passed as courier",
+			actor:       orderDomain.CourierActor(order.CustomerID),
+			expectedErr: orderDomain.ErrPermissionDenied,
+		},
+		{
+			name:        "Failure: System",
+			actor:       orderDomain.SystemActor(),
+			expectedErr: orderDomain.ErrPermissionDenied,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			err := order.AuthorizeView(tc.actor)
+
+			if tc.expectedErr != nil {
+				t.Require().ErrorIs(err, tc.expectedErr)
+			} else {
+				t.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *OrderDomainTestSuite) TestEvents(t provider.T) {
+	t.Parallel()
+
+	var previousCourierID uuid.UUID
+	var partiallyFulfilledAt time.Time
+
+	tests := []struct {
+		name     string
+		act      func(t provider.T) *orderDomain.Order
+		expected func(o *orderDomain.Order) []any
+	}{
+		{
+			name: "Create raises OrderCreated",
+			act: func(t provider.T) *orderDomain.Order {
+				o, err := orderDomain.Create(uuid.New(), mothers.DefaultAddress(), []orderDomain.Item{
+					{ProductID: uuid.New(), Name: "Product", Price: mothers.USD("100"), Count: 2},
+				})
+				t.Require().NoError(err)
+				return o
+			},
+			expected: func(o *orderDomain.Order) []any {
+				return []any{orderDomain.CreatedPayload{
+					SchemaVersion: orderDomain.EventSchemaVersion,
+					OrderID:       o.ID,
+					CustomerID:    o.CustomerID,
+					Items: []orderDomain.EventItem{
+						{ProductID: o.Items[0].ProductID, Count: 2, Price: mothers.USD("100")},
+					},
+					Occurred: o.Created,
+				}}
+			},
+		},
+		{
+			name: "NoteDelivering raises OrderDeliveryStarted",
+			act: func(t provider.T) *orderDomain.Order {
+				o := mothers.DefaultOrder()
+				t.Require().NoError(o.NoteDelivering(uuid.New(), uuid.New()))
+				return o
+			},
+			expected: func(o *orderDomain.Order) []any {
+				return []any{orderDomain.DeliveryStartedPayload{
+					SchemaVersion: orderDomain.EventSchemaVersion,
+					OrderID:       o.ID,
+					CustomerID:    o.CustomerID,
+					CourierID:     *o.Delivery.CourierID,
+					Occurred:      o.History[len(o.History)-1].Occurred,
+				}}
+			},
+		},
+		{
+			name: "NoteDelivered raises OrderDelivered",
+			act: func(t provider.T) *orderDomain.Order {
+				o := mothers.OrderDelivering()
+				t.Require().NoError(o.NoteDelivered(*o.Delivery.CourierID))
+				return o
+			},
+			expected: func(o *orderDomain.Order) []any {
+				return []any{orderDomain.DeliveredPayload{
+					SchemaVersion: orderDomain.EventSchemaVersion,
+					OrderID:       o.ID,
+					CustomerID:    o.CustomerID,
+					CourierID:     *o.Delivery.CourierID,
+					Occurred:      o.History[len(o.History)-1].Occurred,
+				}}
+			},
+		},
+		{
+			name: "Customer cancellation raises OrderCanceled once canceled",
+			act: func(t provider.T) *orderDomain.Order {
+				o := mothers.DefaultOrder()
+				policy := orderDomain.CancellationPolicy{CreatedWindow: time.Hour, DeliveringWindow: time.Hour}
+				t.Require().NoError(o.RequestCancellation(o.CustomerID, "changed my mind", policy, time.Now()))
+				t.Require().NoError(o.NoteCancellationCompleted(uuid.New()))
+				return o
+			},
+			expected: func(o *orderDomain.Order) []any {
+				return []any{orderDomain.CanceledPayload{
+					SchemaVersion: orderDomain.EventSchemaVersion,
+					OrderID:       o.ID,
+					CustomerID:    o.CustomerID,
+					Status:        orderDomain.CustomerCanceled,
+					Reason:        orderDomain.CancelReasonCustomer,
+					Comment:       "changed my mind",
+					Occurred:      o.History[len(o.History)-1].Occurred,
+				}}
+			},
+		},
+		{
+			name: "Admin cancellation raises OrderCanceled once canceled",
+			act: func(t provider.T) *orderDomain.Order {
+				o := mothers.OrderDelivering()
+				t.Require().NoError(o.ForceCancel("Fraudulent order"))
+				t.Require().NoError(o.NoteCancellationCompleted(uuid.New()))
+				return o
+			},
+			expected: func(o *orderDomain.Order) []any {
+				return []any{orderDomain.CanceledPayload{
+					SchemaVersion: orderDomain.EventSchemaVersion,
+					OrderID:       o.ID,
+					CustomerID:    o.CustomerID,
+					Status:        orderDomain.CanceledByAdmin,
+					Reason:        orderDomain.CancelReasonAdmin,
+					Comment:       "Fraudulent order",
+					Occurred:      o.History[len(o.History)-1].Occurred,
+				}}
+			},
+		},
+		{
+			name: "ReassignCourier raises CourierReassigned",
+			act: func(t provider.T) *orderDomain.Order {
+				o := mothers.OrderDelivering()
+				previousCourierID = *o.Delivery.CourierID
+				t.Require().NoError(o.ReassignCourier(uuid.New(), "Courier vehicle broke down"))
+				return o
+			},
+			expected: func(o *orderDomain.Order) []any {
+				change := o.History[len(o.History)-1]
+				return []any{orderDomain.CourierReassignedPayload{
+					SchemaVersion:     orderDomain.EventSchemaVersion,
+					OrderID:           o.ID,
+					CustomerID:        o.CustomerID,
+					PreviousCourierID: &previousCourierID,
+					CourierID:         *o.Delivery.CourierID,
+					Reason:            "Courier vehicle broke down",
+					Occurred:          change.Occurred,
+				}}
+			},
+		},
+		{
+			name: "NoteCanceledOutOfStock raises OrderCanceled",
+			act: func(t provider.T) *orderDomain.Order {
+				o := mothers.DefaultOrder()
+				t.Require().NoError(o.NoteCanceledOutOfStock(uuid.New()))
+				return o
+			},
+			expected: func(o *orderDomain.Order) []any {
+				return []any{orderDomain.CanceledPayload{
+					SchemaVersion: orderDomain.EventSchemaVersion,
+					OrderID:       o.ID,
+					CustomerID:    o.CustomerID,
+					Status:        orderDomain.CanceledOutOfStock,
+					Reason:        orderDomain.CancelReasonOutOfStock,
+					Occurred:      o.History[len(o.History)-1].Occurred,
+				}}
+			},
+		},
+		{
+			name: "Partial reservation raises OrderPartiallyFulfilled",
+			act: func(t provider.T) *orderDomain.Order {
+				o := mothers.OrderAllowingPartial()
+				partiallyFulfilledAt = time.Now()
+				reserved := map[uuid.UUID]int{o.Items[0].ProductID: 1, o.Items[1].ProductID: 1}
+				short, err := o.NoteItemsReserved(reserved, partiallyFulfilledAt)
+				t.Require().NoError(err)
+				t.Require().True(short)
+				return o
+			},
+			expected: func(o *orderDomain.Order) []any {
+				return []any{orderDomain.PartiallyFulfilledPayload{
+					SchemaVersion: orderDomain.EventSchemaVersion,
+					OrderID:       o.ID,
+					CustomerID:    o.CustomerID,
+					Items: []orderDomain.FulfilledItem{
+						{ProductID: o.Items[0].ProductID, Requested: 2, Count: 1},
+						{ProductID: o.Items[1].ProductID, Requested: 1, Count: 1},
+					},
+					Occurred: partiallyFulfilledAt,
+				}}
+			},
+		},
+		{
+			name: "Failed transition raises nothing",
+			act: func(t provider.T) *orderDomain.Order {
+				o := mothers.OrderDelivered()
+				t.Require().Error(o.NoteCanceledTimeout(uuid.New()))
+				return o
+			},
+			expected: func(o *orderDomain.Order) []any {
+				return []any{}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			o := tc.act(t)
+
+			events := o.PullEvents()
+			payloads := make([]any, 0, len(events))
+			for _, event := range events {
+				payloads = append(payloads, event.Payload())
+			}
+			t.Require().Equal(tc.expected(o), payloads)
+			t.Require().Empty(o.PullEvents())
+		})
+	}
+}
+
 func TestOrderDomainTestSuite(t *testing.T) {
 	suite.RunSuite(t, new(OrderDomainTestSuite))
 }
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	outboxDomain "order/internal/domain/outbox"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type OutboxDomainTestSuite struct {
+	suite.Suite
+}
+
+func (s *OutboxDomainTestSuite) TestCreate(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		Name            string
+		Key             string
+		Payload         any
+		expectedPayload string
+		expectedErr     error
+	}{
+		{
+			name:            "Success",
+			Name:            "test.command",
+			Key:             "00000000-0000-0000-0000-000000000000",
+			Payload:         struct{ OrderID uuid.UUID }{OrderID: uuid.Nil},
+			expectedPayload: `{"OrderID": "00000000-0000-0000-0000-000000000000"}`,
+			expectedErr:     nil,
+		},
+		{
+			name:        "Failure: Invalid payload",
+			Name:        "test.command",
+			Key:         "00000000-0000-0000-0000-000000000000",
+			Payload:     make(chan int),
+			expectedErr: outboxDomain.ErrInvalidOutboxPayload,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			message, err := outboxDomain.Create(tc.Name, tc.Key, tc.Payload)
+
+			if tc.expectedErr != nil {
+				t.Require().Error(err)
+				t.Require().ErrorIs(err, tc.expectedErr)
+			} else {
+				t.Require().NoError(err)
+				t.Require().NotNil(message)
+				t.Require().Equal(tc.Name, message.Name)
+				t.Require().Equal(tc.Key, message.Key)
+				t.Require().JSONEq(tc.expectedPayload, string(message.Payload))
+				t.Require().Zero(message.Attempts)
+				t.Require().WithinDuration(time.Now(), message.NextAttempt, time.Second)
+			}
+		})
+	}
+}
+
+func (s *OutboxDomainTestSuite) TestNoteFailedAttempt(t provider.T) {
+	t.Parallel()
+
+	message := mothers.OutboxMessage()
+	retryAt := time.Now().Add(time.Minute)
+
+	message.NoteFailedAttempt(retryAt)
+
+	t.Require().Equal(1, message.Attempts)
+	t.Require().Equal(retryAt, message.NextAttempt)
+}
+
+func TestOutboxDomainTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(OutboxDomainTestSuite))
+}
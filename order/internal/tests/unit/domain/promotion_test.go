@@ -0,0 +1,341 @@
+package domain
+
+import (
+	orderDomain "order/internal/domain/order"
+	promotionDomain "order/internal/domain/promotion"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/shopspring/decimal"
+)
+
+type PromotionDomainTestSuite struct {
+	suite.Suite
+}
+
+func (s *PromotionDomainTestSuite) TestCreate(t provider.T) {
+	t.Parallel()
+
+	now := time.Now()
+	later := now.Add(time.Hour)
+	minOrderValue := mothers.USD("20")
+
+	tests := []struct {
+		name         string
+		code         string
+		rules        promotionDomain.Rules
+		expectedCode string
+		expectedErr  error
+	}{
+		{
+			name:         "Success: Percentage",
+			code:         " spring-10 ",
+			rules:        promotionDomain.Rules{Kind: promotionDomain.Percentage, Percent: 10},
+			expectedCode: "SPRING-10",
+		},
+		{
+			name: "Success: Fixed amount with every rule",
+			code: "FIVE_OFF",
+			rules: promotionDomain.Rules{
+				Kind:             promotionDomain.FixedAmount,
+				Amount:           mothers.USD("5"),
+				MinOrderValue:    &minOrderValue,
+				PerCustomerLimit: 1,
+				ValidFrom:        &now,
+				ValidTo:          &later,
+				ProductIDs:       []uuid.UUID{uuid.New()},
+			},
+			expectedCode: "FIVE_OFF",
+		},
+		{
+			name:        "Failure: Code too short",
+			code:        "AB",
+			rules:       promotionDomain.Rules{Kind: promotionDomain.Percentage, Percent: 10},
+			expectedErr: promotionDomain.ErrInvalidCode,
+		},
+		{
+			name:        "Failure: Code with spaces",
+			code:        "SPRING SALE",
+			rules:       promotionDomain.Rules{Kind: promotionDomain.Percentage, Percent: 10},
+			expectedErr: promotionDomain.ErrInvalidCode,
+		},
+		{
+			name:        "Failure: Percent above 100",
+			code:        "TOO-MUCH",
+			rules:       promotionDomain.Rules{Kind: promotionDomain.Percentage, Percent: 101},
+			expectedErr: promotionDomain.ErrInvalidRules,
+		},
+		{
+			name:        "Failure: Fixed amount without amount",
+			code:        "NOTHING",
+			rules:       promotionDomain.Rules{Kind: promotionDomain.FixedAmount},
+			expectedErr: promotionDomain.ErrInvalidRules,
+		},
+		{
+			name:        "Failure: Unknown kind",
+			code:        "UNKNOWN",
+			rules:       promotionDomain.Rules{Kind: "free_shipping", Percent: 10},
+			expectedErr: promotionDomain.ErrInvalidRules,
+		},
+		{
+			name:        "Failure: Negative usage limit",
+			code:        "NEGATIVE",
+			rules:       promotionDomain.Rules{Kind: promotionDomain.Percentage, Percent: 10, PerCustomerLimit: -1},
+			expectedErr: promotionDomain.ErrInvalidRules,
+		},
+		{
+			name:        "Failure: Empty validity window",
+			code:        "EMPTY",
+			rules:       promotionDomain.Rules{Kind: promotionDomain.Percentage, Percent: 10, ValidFrom: &later, ValidTo: &now},
+			expectedErr: promotionDomain.ErrInvalidRules,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			promotion, err := promotionDomain.Create(tc.code, tc.rules)
+
+			if tc.expectedErr != nil {
+				t.Require().ErrorIs(err, tc.expectedErr)
+				t.Require().Nil(promotion)
+				return
+			}
+			t.Require().NoError(err)
+			t.Require().Equal(tc.expectedCode, promotion.Code)
+			t.Require().True(promotion.Active)
+		})
+	}
+}
+
+func (s *PromotionDomainTestSuite) TestDiscount(t provider.T) {
+	t.Parallel()
+
+	// OrderWithItems: 2 x 4.50 + 1 x 2.25 = 11.25 USD.
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name        string
+		promotion   func(order *orderDomain.Order) *promotionDomain.Promotion
+		expected    orderDomain.Money
+		expectedErr error
+	}{
+		{
+			name: "Success: Percentage rounded down to the cent",
+			promotion: func(_ *orderDomain.Order) *promotionDomain.Promotion {
+				return mothers.PercentagePromotion(15)
+			},
+			expected: mothers.USD("1.68"),
+		},
+		{
+			name: "Success: Fixed amount",
+			promotion: func(_ *orderDomain.Order) *promotionDomain.Promotion {
+				return mothers.FixedAmountPromotion("5")
+			},
+			expected: mothers.USD("5"),
+		},
+		{
+			name: "Success: Fixed amount capped at the subtotal",
+			promotion: func(_ *orderDomain.Order) *promotionDomain.Promotion {
+				return mothers.FixedAmountPromotion("50")
+			},
+			expected: mothers.USD("11.25"),
+		},
+		{
+			name: "Success: Product-scoped",
+			promotion: func(order *orderDomain.Order) *promotionDomain.Promotion {
+				promotion := mothers.PercentagePromotion(50)
+				promotion.Rules.ProductIDs = []uuid.UUID{order.Items[0].ProductID}
+				return promotion
+			},
+			expected: mothers.USD("4.50"),
+		},
+		{
+			name: "Success: Minimum met",
+			promotion: func(_ *orderDomain.Order) *promotionDomain.Promotion {
+				promotion := mothers.FixedAmountPromotion("1")
+				minOrderValue := mothers.USD("11.25")
+				promotion.Rules.MinOrderValue = &minOrderValue
+				return promotion
+			},
+			expected: mothers.USD("1"),
+		},
+		{
+			name: "Success: Inside the validity window",
+			promotion: func(_ *orderDomain.Order) *promotionDomain.Promotion {
+				promotion := mothers.FixedAmountPromotion("1")
+				promotion.Rules.ValidFrom = &past
+				promotion.Rules.ValidTo = &future
+				return promotion
+			},
+			expected: mothers.USD("1"),
+		},
+		{
+			name: "Failure: Minimum not met",
+			promotion: func(_ *orderDomain.Order) *promotionDomain.Promotion {
+				promotion := mothers.FixedAmountPromotion("1")
+				minOrderValue := mothers.USD("11.26")
+				promotion.Rules.MinOrderValue = &minOrderValue
+				return promotion
+			},
+			expectedErr: promotionDomain.ErrMinOrderValueNotMet,
+		},
+		{
+			name: "Failure: Minimum in another currency",
+			promotion: func(_ *orderDomain.Order) *promotionDomain.Promotion {
+				promotion := mothers.FixedAmountPromotion("1")
+				promotion.Rules.MinOrderValue = &orderDomain.Money{Amount: decimal.NewFromInt(1), Currency: "EUR"}
+				return promotion
+			},
+			expectedErr: promotionDomain.ErrPromotionNotApplicable,
+		},
+		{
+			name: "Failure: Fixed amount in another currency",
+			promotion: func(_ *orderDomain.Order) *promotionDomain.Promotion {
+				promotion := mothers.FixedAmountPromotion("1")
+				promotion.Rules.Amount.Currency = "EUR"
+				return promotion
+			},
+			expectedErr: promotionDomain.ErrPromotionNotApplicable,
+		},
+		{
+			name: "Failure: No eligible products",
+			promotion: func(_ *orderDomain.Order) *promotionDomain.Promotion {
+				promotion := mothers.PercentagePromotion(10)
+				promotion.Rules.ProductIDs = []uuid.UUID{uuid.New()}
+				return promotion
+			},
+			expectedErr: promotionDomain.ErrPromotionNotApplicable,
+		},
+		{
+			name: "Failure: Deactivated",
+			promotion: func(_ *orderDomain.Order) *promotionDomain.Promotion {
+				promotion := mothers.PercentagePromotion(10)
+				promotion.Deactivate()
+				return promotion
+			},
+			expectedErr: promotionDomain.ErrPromotionInactive,
+		},
+		{
+			name: "Failure: Not started",
+			promotion: func(_ *orderDomain.Order) *promotionDomain.Promotion {
+				promotion := mothers.PercentagePromotion(10)
+				promotion.Rules.ValidFrom = &future
+				return promotion
+			},
+			expectedErr: promotionDomain.ErrPromotionInactive,
+		},
+		{
+			name: "Failure: Expired",
+			promotion: func(_ *orderDomain.Order) *promotionDomain.Promotion {
+				promotion := mothers.PercentagePromotion(10)
+				promotion.Rules.ValidTo = &now
+				return promotion
+			},
+			expectedErr: promotionDomain.ErrPromotionInactive,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			order := mothers.OrderWithItems()
+			promotion := tc.promotion(order)
+
+			discount, err := promotion.Discount(order, now)
+
+			if tc.expectedErr != nil {
+				t.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			t.Require().NoError(err)
+			t.Require().True(tc.expected.Equal(discount), "got %s", discount)
+		})
+	}
+}
+
+func (s *PromotionDomainTestSuite) TestReprice(t provider.T) {
+	t.Parallel()
+
+	// OrderAllowingPartial cut down to 1 x 4.50 USD of 2 x 4.50 + 1 x 2.25 USD.
+	partial := func() *orderDomain.Order {
+		order := mothers.OrderAllowingPartial()
+		_, _ = order.NoteItemsReserved(map[uuid.UUID]int{
+			order.Items[0].ProductID: 1,
+			order.Items[1].ProductID: 0,
+		}, time.Now())
+		return order
+	}
+
+	tests := []struct {
+		name        string
+		promotion   func() *promotionDomain.Promotion
+		expected    orderDomain.Money
+		expectedErr error
+	}{
+		{
+			name: "Success: Percentage of the reserved items",
+			promotion: func() *promotionDomain.Promotion {
+				return mothers.PercentagePromotion(10)
+			},
+			expected: mothers.USD("0.45"),
+		},
+		{
+			name: "Success: Fixed amount capped at the reduced subtotal",
+			promotion: func() *promotionDomain.Promotion {
+				return mothers.FixedAmountPromotion("5")
+			},
+			expected: mothers.USD("4.50"),
+		},
+		{
+			name: "Success: Expired since the order was placed",
+			promotion: func() *promotionDomain.Promotion {
+				promotion := mothers.FixedAmountPromotion("1")
+				expired := time.Now().Add(-time.Hour)
+				promotion.Rules.ValidTo = &expired
+				return promotion
+			},
+			expected: mothers.USD("1"),
+		},
+		{
+			name: "Failure: Reduced subtotal below the minimum",
+			promotion: func() *promotionDomain.Promotion {
+				promotion := mothers.FixedAmountPromotion("1")
+				minOrderValue := mothers.USD("10")
+				promotion.Rules.MinOrderValue = &minOrderValue
+				return promotion
+			},
+			expectedErr: promotionDomain.ErrMinOrderValueNotMet,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			discount, err := tc.promotion().Reprice(partial())
+
+			if tc.expectedErr != nil {
+				t.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			t.Require().NoError(err)
+			t.Require().True(tc.expected.Equal(discount), "got %s", discount)
+		})
+	}
+}
+
+func TestPromotionDomainTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(PromotionDomainTestSuite))
+}
@@ -0,0 +1,332 @@
+package domain
+
+import (
+	"errors"
+	sagaDomain "order/internal/domain/saga"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type SagaDomainTestSuite struct {
+	suite.Suite
+}
+
+func (s *SagaDomainTestSuite) TestCreate(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		Type         sagaDomain.Type
+		expectedStep sagaDomain.Step
+		expectedErr  error
+	}{
+		{
+			name:         "Success: Create order saga",
+			Type:         sagaDomain.CreateOrder,
+			expectedStep: sagaDomain.ReservingItems,
+			expectedErr:  nil,
+		},
+		{
+			name:        "Failure: Unsupported type",
+			Type:        sagaDomain.Type("unknown"),
+			expectedErr: sagaDomain.ErrUnsupportedType,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			orderID := uuid.New()
+			saga, err := sagaDomain.Create(tc.Type, orderID)
+
+			if tc.expectedErr != nil {
+				t.Require().Error(err)
+				t.Require().ErrorIs(err, tc.expectedErr)
+			} else {
+				t.Require().NoError(err)
+				t.Require().NotNil(saga)
+				t.Require().Equal(orderID, saga.OrderID)
+				t.Require().Equal(tc.expectedStep, saga.Step)
+				t.Require().Len(saga.History, 1)
+				t.Require().Equal(tc.expectedStep, saga.History[0].Step)
+				t.Require().Nil(saga.LastError)
+			}
+		})
+	}
+}
+
+func (s *SagaDomainTestSuite) TestNoteStep(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		setup        func() *sagaDomain.Saga
+		step         sagaDomain.Step
+		expectedStep sagaDomain.Step
+		expectedErr  error
+	}{
+		{
+			name: "Success: Reserving items to assigning courier",
+			setup: func() *sagaDomain.Saga {
+				return mothers.SagaReservingItems(uuid.New())
+			},
+			step:         sagaDomain.AssigningCourier,
+			expectedStep: sagaDomain.AssigningCourier,
+			expectedErr:  nil,
+		},
+		{
+			name: "Success: Reserving items to canceling out of stock",
+			setup: func() *sagaDomain.Saga {
+				return mothers.SagaReservingItems(uuid.New())
+			},
+			step:         sagaDomain.CancelingOutOfStock,
+			expectedStep: sagaDomain.CancelingOutOfStock,
+			expectedErr:  nil,
+		},
+		{
+			name: "Success: Assigning courier to releasing items",
+			setup: func() *sagaDomain.Saga {
+				return mothers.SagaAssigningCourier(uuid.New())
+			},
+			step:         sagaDomain.ReleasingItems,
+			expectedStep: sagaDomain.ReleasingItems,
+			expectedErr:  nil,
+		},
+		{
+			name: "Success: Releasing items to canceling courier not found",
+			setup: func() *sagaDomain.Saga {
+				return mothers.SagaReleasingItems(uuid.New())
+			},
+			step:         sagaDomain.CancelingCourierNotFound,
+			expectedStep: sagaDomain.CancelingCourierNotFound,
+			expectedErr:  nil,
+		},
+		{
+			name: "Success: Reserving items to canceling timeout",
+			setup: func() *sagaDomain.Saga {
+				return mothers.SagaReservingItems(uuid.New())
+			},
+			step:         sagaDomain.CancelingTimeout,
+			expectedStep: sagaDomain.CancelingTimeout,
+			expectedErr:  nil,
+		},
+		{
+			name: "Success: Reserving items superseded by a cancellation",
+			setup: func() *sagaDomain.Saga {
+				return mothers.SagaReservingItems(uuid.New())
+			},
+			step:         sagaDomain.SupersededByCancel,
+			expectedStep: sagaDomain.SupersededByCancel,
+			expectedErr:  nil,
+		},
+		{
+			name: "Success: Releasing items and courier completed without the replies",
+			setup: func() *sagaDomain.Saga {
+				return mothers.SagaReleasingItemsAndCourier(uuid.New())
+			},
+			step:         sagaDomain.CancelingByCustomer,
+			expectedStep: sagaDomain.CancelingByCustomer,
+			expectedErr:  nil,
+		},
+		{
+			name: "Success: Assigning courier to releasing items on timeout",
+			setup: func() *sagaDomain.Saga {
+				return mothers.SagaAssigningCourier(uuid.New())
+			},
+			step:         sagaDomain.ReleasingItemsOnTimeout,
+			expectedStep: sagaDomain.ReleasingItemsOnTimeout,
+			expectedErr:  nil,
+		},
+		{
+			name: "Success: Releasing items on timeout to canceling timeout",
+			setup: func() *sagaDomain.Saga {
+				return mothers.SagaReleasingItemsOnTimeout(uuid.New())
+			},
+			step:         sagaDomain.CancelingTimeout,
+			expectedStep: sagaDomain.CancelingTimeout,
+			expectedErr:  nil,
+		},
+		{
+			name: "Success: Awaiting delivery slot to assigning courier",
+			setup: func() *sagaDomain.Saga {
+				return mothers.SagaAwaitingDeliverySlot(uuid.New(), time.Now())
+			},
+			step:         sagaDomain.AssigningCourier,
+			expectedStep: sagaDomain.AssigningCourier,
+			expectedErr:  nil,
+		},
+		{
+			name: "Failure: Releasing items on timeout to canceling courier not found",
+			setup: func() *sagaDomain.Saga {
+				return mothers.SagaReleasingItemsOnTimeout(uuid.New())
+			},
+			step:         sagaDomain.CancelingCourierNotFound,
+			expectedStep: sagaDomain.ReleasingItemsOnTimeout,
+			expectedErr:  sagaDomain.ErrUnsupportedStepTransition,
+		},
+		{
+			name: "Failure: Reserving items to beginning delivery",
+			setup: func() *sagaDomain.Saga {
+				return mothers.SagaReservingItems(uuid.New())
+			},
+			step:         sagaDomain.BeginningDelivery,
+			expectedStep: sagaDomain.ReservingItems,
+			expectedErr:  sagaDomain.ErrUnsupportedStepTransition,
+		},
+		{
+			name: "Failure: Repeated step",
+			setup: func() *sagaDomain.Saga {
+				return mothers.SagaAssigningCourier(uuid.New())
+			},
+			step:         sagaDomain.AssigningCourier,
+			expectedStep: sagaDomain.AssigningCourier,
+			expectedErr:  sagaDomain.ErrUnsupportedStepTransition,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+			saga := tc.setup()
+			historyLen := len(saga.History)
+
+			err := saga.NoteStep(tc.step)
+
+			if tc.expectedErr != nil {
+				t.Require().Error(err)
+				t.Require().ErrorIs(err, tc.expectedErr)
+				t.Require().Len(saga.History, historyLen)
+			} else {
+				t.Require().NoError(err)
+				t.Require().Len(saga.History, historyLen+1)
+				t.Require().Equal(tc.step, saga.History[len(saga.History)-1].Step)
+			}
+			t.Require().Equal(tc.expectedStep, saga.Step)
+		})
+	}
+}
+
+func (s *SagaDomainTestSuite) TestSuspend(t provider.T) {
+	t.Parallel()
+
+	resumeAt := time.Now().Add(time.Hour)
+
+	t.Run("Success: Resume time cleared by the next step", func(t provider.T) {
+		t.Parallel()
+		saga := mothers.SagaReservingItems(uuid.New())
+
+		err := saga.Suspend(sagaDomain.AwaitingDeliverySlot, resumeAt)
+
+		t.Require().NoError(err)
+		t.Require().Equal(sagaDomain.AwaitingDeliverySlot, saga.Step)
+		t.Require().NotNil(saga.ResumeAt)
+		t.Require().Equal(resumeAt, *saga.ResumeAt)
+
+		t.Require().NoError(saga.NoteStep(sagaDomain.AssigningCourier))
+		t.Require().Nil(saga.ResumeAt)
+	})
+
+	t.Run("Failure: Unsupported step transition", func(t provider.T) {
+		t.Parallel()
+		saga := mothers.SagaAssigningCourier(uuid.New())
+
+		err := saga.Suspend(sagaDomain.AwaitingDeliverySlot, resumeAt)
+
+		t.Require().ErrorIs(err, sagaDomain.ErrUnsupportedStepTransition)
+		t.Require().Equal(sagaDomain.AssigningCourier, saga.Step)
+		t.Require().Nil(saga.ResumeAt)
+	})
+}
+
+func (s *SagaDomainTestSuite) TestNoteFailure(t provider.T) {
+	t.Parallel()
+
+	saga := mothers.SagaAssigningCourier(uuid.New())
+
+	saga.NoteFailure(errors.New("publisher error"))
+
+	t.Require().NotNil(saga.LastError)
+	t.Require().Equal(sagaDomain.AssigningCourier, saga.LastError.Step)
+	t.Require().Equal("publisher error", saga.LastError.Message)
+	t.Require().Equal(sagaDomain.AssigningCourier, saga.Step)
+}
+
+func (s *SagaDomainTestSuite) TestNoteRetry(t provider.T) {
+	t.Parallel()
+
+	t.Run("Success: Awaiting step retried", func(t provider.T) {
+		t.Parallel()
+		saga := mothers.SagaAssigningCourier(uuid.New())
+		recorded := len(saga.History)
+
+		err := saga.NoteRetry()
+
+		t.Require().NoError(err)
+		t.Require().Equal(sagaDomain.AssigningCourier, saga.Step)
+		t.Require().Len(saga.History, recorded+1)
+		record := saga.History[recorded]
+		t.Require().Equal(sagaDomain.AssigningCourier, record.Step)
+		t.Require().True(record.Retry)
+	})
+
+	t.Run("Failure: Step not awaiting a reply", func(t provider.T) {
+		t.Parallel()
+		saga := mothers.SagaAwaitingDeliverySlot(uuid.New(), time.Now().Add(time.Hour))
+		recorded := len(saga.History)
+
+		err := saga.NoteRetry()
+
+		t.Require().ErrorIs(err, sagaDomain.ErrStepNotRetryable)
+		t.Require().Len(saga.History, recorded)
+	})
+}
+
+func (s *SagaDomainTestSuite) TestRetries(t provider.T) {
+	t.Parallel()
+
+	saga := mothers.SagaReleasingItemsAndCourier(uuid.New())
+	_ = saga.NoteRetry()
+	t.Require().Equal(1, saga.Retries())
+
+	_ = saga.NoteStep(sagaDomain.AwaitingCourierRelease)
+	t.Require().Equal(0, saga.Retries())
+
+	_ = saga.NoteRetry()
+	_ = saga.NoteRetry()
+	t.Require().Equal(2, saga.Retries())
+}
+
+func (s *SagaDomainTestSuite) TestReached(t provider.T) {
+	t.Parallel()
+
+	saga := mothers.SagaReleasingItems(uuid.New())
+
+	t.Require().True(saga.Reached(sagaDomain.ReservingItems))
+	t.Require().True(saga.Reached(sagaDomain.AssigningCourier))
+	t.Require().True(saga.Reached(sagaDomain.ReleasingItems))
+	t.Require().False(saga.Reached(sagaDomain.AwaitingDeliverySlot))
+}
+
+func (s *SagaDomainTestSuite) TestCommandID(t provider.T) {
+	t.Parallel()
+
+	saga := mothers.DefaultSaga()
+	other := mothers.DefaultSaga()
+
+	t.Require().Equal(saga.CommandID("create_order.reserve_items"), saga.CommandID("create_order.reserve_items"))
+	t.Require().NotEqual(saga.CommandID("create_order.reserve_items"), saga.CommandID("create_order.release_items"))
+	t.Require().NotEqual(saga.CommandID("create_order.reserve_items"), other.CommandID("create_order.reserve_items"))
+}
+
+func TestSagaDomainTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(SagaDomainTestSuite))
+}
@@ -0,0 +1,192 @@
+package domain
+
+import (
+	orderDomain "order/internal/domain/order"
+	slotDomain "order/internal/domain/slot"
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type SlotDomainTestSuite struct {
+	suite.Suite
+}
+
+// Monday 2026-10-19, 07:30 UTC.
+var slotNow = time.Date(2026, time.October, 19, 7, 30, 0, 0, time.UTC)
+
+func testSchedule() slotDomain.Schedule {
+	return slotDomain.Schedule{
+		DayStart:          9 * time.Hour,
+		DayEnd:            13 * time.Hour,
+		Length:            2 * time.Hour,
+		Capacity:          3,
+		CapacityByWeekday: map[time.Weekday]int{time.Tuesday: 0, time.Wednesday: 5},
+		Horizon:           3,
+		MinLeadTime:       2 * time.Hour,
+		Location:          time.UTC,
+	}
+}
+
+func slotAt(day, hour int) orderDomain.DeliverySlot {
+	start := time.Date(2026, time.October, day, hour, 0, 0, 0, time.UTC)
+	return orderDomain.DeliverySlot{Start: start, End: start.Add(2 * time.Hour)}
+}
+
+func (s *SlotDomainTestSuite) TestValidate(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		modify      func(schedule *slotDomain.Schedule)
+		expectedErr error
+	}{
+		{
+			name:   "Success",
+			modify: func(_ *slotDomain.Schedule) {},
+		},
+		{
+			name:        "Failure: No slot fits into the day",
+			modify:      func(schedule *slotDomain.Schedule) { schedule.DayEnd = 10 * time.Hour },
+			expectedErr: slotDomain.ErrInvalidSchedule,
+		},
+		{
+			name:        "Failure: Day ends after midnight",
+			modify:      func(schedule *slotDomain.Schedule) { schedule.DayEnd = 25 * time.Hour },
+			expectedErr: slotDomain.ErrInvalidSchedule,
+		},
+		{
+			name:        "Failure: No horizon",
+			modify:      func(schedule *slotDomain.Schedule) { schedule.Horizon = 0 },
+			expectedErr: slotDomain.ErrInvalidSchedule,
+		},
+		{
+			name:        "Failure: Negative weekday capacity",
+			modify:      func(schedule *slotDomain.Schedule) { schedule.CapacityByWeekday[time.Friday] = -1 },
+			expectedErr: slotDomain.ErrInvalidSchedule,
+		},
+		{
+			name:        "Failure: No location",
+			modify:      func(schedule *slotDomain.Schedule) { schedule.Location = nil },
+			expectedErr: slotDomain.ErrInvalidSchedule,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			schedule := testSchedule()
+			tc.modify(&schedule)
+
+			err := schedule.Validate()
+
+			if tc.expectedErr != nil {
+				t.Require().ErrorIs(err, tc.expectedErr)
+			} else {
+				t.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *SlotDomainTestSuite) TestSlots(t provider.T) {
+	t.Parallel()
+
+	slots := testSchedule().Slots(slotNow)
+
+	// 09:00 today is inside the lead time and Tuesday is closed.
+	t.Require().Equal([]orderDomain.DeliverySlot{
+		slotAt(19, 11),
+		slotAt(21, 9),
+		slotAt(21, 11),
+	}, slots)
+}
+
+func (s *SlotDomainTestSuite) TestSlotsInLocation(t provider.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	t.Require().NoError(err)
+	schedule := testSchedule()
+	schedule.Location = berlin
+	schedule.CapacityByWeekday = nil
+	schedule.Horizon = 1
+	schedule.MinLeadTime = time.Hour
+
+	slots := schedule.Slots(slotNow)
+
+	// 07:30 UTC is 09:30 in Berlin, so only the 11:00 local slot can still be booked.
+	t.Require().Len(slots, 1)
+	t.Require().True(slots[0].Start.Equal(time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)))
+}
+
+func (s *SlotDomainTestSuite) TestCapacityOf(t provider.T) {
+	t.Parallel()
+
+	schedule := testSchedule()
+
+	t.Require().Equal(3, schedule.CapacityOf(slotAt(19, 9)))
+	t.Require().Equal(0, schedule.CapacityOf(slotAt(20, 9)))
+	t.Require().Equal(5, schedule.CapacityOf(slotAt(21, 9)))
+}
+
+func (s *SlotDomainTestSuite) TestCheck(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		slot        orderDomain.DeliverySlot
+		expectedErr error
+	}{
+		{
+			name: "Success",
+			slot: slotAt(21, 11),
+		},
+		{
+			name:        "Failure: Within the lead time",
+			slot:        slotAt(19, 9),
+			expectedErr: slotDomain.ErrSlotUnavailable,
+		},
+		{
+			name:        "Failure: Closed weekday",
+			slot:        slotAt(20, 9),
+			expectedErr: slotDomain.ErrSlotUnavailable,
+		},
+		{
+			name:        "Failure: Beyond the horizon",
+			slot:        slotAt(22, 9),
+			expectedErr: slotDomain.ErrSlotUnavailable,
+		},
+		{
+			name: "Failure: Not aligned to the schedule",
+			slot: orderDomain.DeliverySlot{
+				Start: slotAt(21, 10).Start,
+				End:   slotAt(21, 12).Start,
+			},
+			expectedErr: slotDomain.ErrSlotUnavailable,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			err := testSchedule().Check(tc.slot, slotNow)
+
+			if tc.expectedErr != nil {
+				t.Require().ErrorIs(err, tc.expectedErr)
+			} else {
+				t.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func TestSlotDomainTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(SlotDomainTestSuite))
+}
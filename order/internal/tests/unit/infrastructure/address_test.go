@@ -0,0 +1,81 @@
+package infrastructure
+
+import (
+	"order/internal/infrastructure/db/documents"
+	"testing"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type AddressDocumentTestSuite struct {
+	suite.Suite
+}
+
+func (s *AddressDocumentTestSuite) TestRoundTrip(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		address  documents.Address
+		expected any
+	}{
+		{
+			name: "Structured address",
+			address: documents.Address{
+				Country:    "DE",
+				City:       "Berlin",
+				Street:     "Unter den Linden",
+				House:      "1",
+				PostalCode: "10117",
+				Location:   &documents.Location{Latitude: 52.5, Longitude: 13.4},
+			},
+			expected: bson.M{
+				"country":     "DE",
+				"city":        "Berlin",
+				"street":      "Unter den Linden",
+				"house":       "1",
+				"postal_code": "10117",
+				"location":    bson.M{"latitude": 52.5, "longitude": 13.4},
+			},
+		},
+		{
+			name:     "Legacy address",
+			address:  documents.Address{Legacy: "Unter den Linden 1, 10117 Berlin"},
+			expected: "Unter den Linden 1, 10117 Berlin",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			data, err := bson.Marshal(documents.Delivery{Address: tc.address})
+			t.Require().NoError(err)
+
+			var raw bson.M
+			t.Require().NoError(bson.Unmarshal(data, &raw))
+			t.Require().Equal(tc.expected, raw["address"])
+
+			var decoded documents.Delivery
+			t.Require().NoError(bson.Unmarshal(data, &decoded))
+			t.Require().Equal(tc.address, decoded.Address)
+		})
+	}
+}
+
+func (s *AddressDocumentTestSuite) TestDecodeInvalidType(t provider.T) {
+	t.Parallel()
+
+	data, err := bson.Marshal(bson.M{"address": 42})
+	t.Require().NoError(err)
+
+	var decoded documents.Delivery
+	t.Require().Error(bson.Unmarshal(data, &decoded))
+}
+
+func TestAddressDocumentTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(AddressDocumentTestSuite))
+}
@@ -0,0 +1,154 @@
+package infrastructure
+
+import (
+	"context"
+	"order/internal/infrastructure/memory"
+	"testing"
+	"time"
+
+	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type BrokerTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *BrokerTestSuite) BeforeEach(t provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *BrokerTestSuite) TestEveryGroupGetsEveryMessage(t provider.T) {
+	t.Parallel()
+
+	broker := memory.NewBroker()
+	for _, value := range []string{"first", "second"} {
+		t.Require().NoError(broker.Publish(s.ctx, kafka.Message{Topic: "orders", Value: []byte(value)}))
+	}
+
+	for _, groupID := range []string{"billing", "shipping"} {
+		for offset, value := range []string{"first", "second"} {
+			msg, err := broker.Fetch(s.ctx, "orders", groupID)
+			t.Require().NoError(err)
+			t.Require().Equal(int64(offset), msg.Offset)
+			t.Require().Equal(value, string(msg.Value))
+		}
+	}
+}
+
+func (s *BrokerTestSuite) TestGroupMembersShareMessages(t provider.T) {
+	t.Parallel()
+
+	broker := memory.NewBroker()
+	t.Require().NoError(broker.Publish(s.ctx, kafka.Message{Topic: "orders", Value: []byte("only")}))
+
+	_, err := broker.Fetch(s.ctx, "orders", "billing")
+	t.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(s.ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = broker.Fetch(ctx, "orders", "billing")
+	t.Require().ErrorIs(err, context.DeadlineExceeded)
+}
+
+func (s *BrokerTestSuite) TestFetchWaitsForMessage(t provider.T) {
+	t.Parallel()
+
+	broker := memory.NewBroker()
+	fetched := make(chan kafka.Message, 1)
+	go func() {
+		msg, err := broker.Fetch(s.ctx, "orders", "billing")
+		if err == nil {
+			fetched <- msg
+		}
+	}()
+
+	t.Require().NoError(broker.Publish(s.ctx, kafka.Message{Topic: "orders", Value: []byte("late")}))
+
+	select {
+	case msg := <-fetched:
+		t.Require().Equal("late", string(msg.Value))
+	case <-time.After(time.Second):
+		t.Fatalf("message was not fetched")
+	}
+}
+
+func (s *BrokerTestSuite) TestCommit(t provider.T) {
+	t.Parallel()
+
+	broker := memory.NewBroker()
+	for range 3 {
+		t.Require().NoError(broker.Publish(s.ctx, kafka.Message{Topic: "orders"}))
+	}
+	t.Require().Equal(int64(0), broker.Committed("orders", "billing"))
+
+	msg, err := broker.Fetch(s.ctx, "orders", "billing")
+	t.Require().NoError(err)
+	t.Require().NoError(broker.Commit(s.ctx, "billing", msg))
+	t.Require().Equal(int64(1), broker.Committed("orders", "billing"))
+	t.Require().Equal(int64(0), broker.Committed("orders", "shipping"))
+}
+
+func (s *BrokerTestSuite) TestTransportPropagatesTrace(t provider.T) {
+	t.Parallel()
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	otherContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{3},
+		SpanID:     trace.SpanID{4},
+		TraceFlags: trace.FlagsSampled,
+	})
+	withOther := trace.ContextWithSpanContext(s.ctx, otherContext)
+
+	// An outbox message carries the trace it was created in.
+	carried := kafka.Message{}
+	propagation.TraceContext{}.Inject(
+		trace.ContextWithSpanContext(s.ctx, spanContext),
+		otelkafkakonsumer.NewMessageCarrier(&carried),
+	)
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		msg           kafka.Message
+		expectedTrace trace.TraceID
+	}{
+		{name: "Context trace", ctx: withOther, msg: kafka.Message{}, expectedTrace: otherContext.TraceID()},
+		{name: "Header trace kept", ctx: withOther, msg: carried, expectedTrace: spanContext.TraceID()},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			transport := memory.NewTransport(memory.NewBroker())
+			writer, err := transport.NewWriter("orders")
+			t.Require().NoError(err)
+			reader, err := transport.NewReader("orders", "billing")
+			t.Require().NoError(err)
+
+			t.Require().NoError(writer.WriteMessage(tc.ctx, tc.msg))
+
+			var msg kafka.Message
+			t.Require().NoError(reader.FetchMessage(s.ctx, &msg))
+			t.Require().Equal("orders", msg.Topic)
+
+			ctx := reader.Propagator().Extract(s.ctx, otelkafkakonsumer.NewMessageCarrier(&msg))
+			t.Require().Equal(tc.expectedTrace, trace.SpanContextFromContext(ctx).TraceID())
+		})
+	}
+}
+
+func TestBroker(t *testing.T) {
+	suite.RunSuite(t, new(BrokerTestSuite))
+}
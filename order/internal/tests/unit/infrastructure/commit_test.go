@@ -0,0 +1,170 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"order/internal/infrastructure/logger"
+	"order/internal/infrastructure/messaging/commit"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+)
+
+var errCommit = errors.New("commit error")
+
+type offsetCommitterMock struct {
+	mu      sync.Mutex
+	err     error
+	commits [][]kafka.Message
+}
+
+func (m *offsetCommitterMock) CommitMessages(_ context.Context, msgs ...kafka.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.err != nil {
+		return m.err
+	}
+	m.commits = append(m.commits, msgs)
+	return nil
+}
+
+// committed returns the offsets of the last commit by partition.
+func (m *offsetCommitterMock) committed() map[int]int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.commits) == 0 {
+		return nil
+	}
+	offsets := make(map[int]int64)
+	for _, msg := range m.commits[len(m.commits)-1] {
+		offsets[msg.Partition] = msg.Offset
+	}
+	return offsets
+}
+
+type CommitterTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *CommitterTestSuite) BeforeEach(t provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *CommitterTestSuite) newCommitter(reader commit.OffsetCommitter) *commit.Committer {
+	return commit.NewCommitter(reader, &commit.Config{Interval: time.Hour}, logger.NewLogger(logrus.New()))
+}
+
+func message(partition int, offset int64) *kafka.Message {
+	return &kafka.Message{Topic: "topic", Partition: partition, Offset: offset}
+}
+
+func (s *CommitterTestSuite) TestFlush(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		tracked           []*kafka.Message
+		handled           []*kafka.Message
+		expectedCommitted map[int]int64
+	}{
+		{
+			name:              "Nothing handled",
+			tracked:           []*kafka.Message{message(0, 0), message(0, 1)},
+			handled:           nil,
+			expectedCommitted: nil,
+		},
+		{
+			name:              "Earlier message still in flight",
+			tracked:           []*kafka.Message{message(0, 0), message(0, 1), message(0, 2)},
+			handled:           []*kafka.Message{message(0, 1), message(0, 2)},
+			expectedCommitted: nil,
+		},
+		{
+			name:              "Handled prefix is committed",
+			tracked:           []*kafka.Message{message(0, 0), message(0, 1), message(0, 2)},
+			handled:           []*kafka.Message{message(0, 1), message(0, 0)},
+			expectedCommitted: map[int]int64{0: 1},
+		},
+		{
+			name:              "Partitions are committed in one batch",
+			tracked:           []*kafka.Message{message(0, 3), message(1, 7), message(0, 4)},
+			handled:           []*kafka.Message{message(1, 7), message(0, 3)},
+			expectedCommitted: map[int]int64{0: 3, 1: 7},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			reader := &offsetCommitterMock{}
+			committer := s.newCommitter(reader)
+			for _, msg := range tc.tracked {
+				committer.Track(msg)
+			}
+			for _, msg := range tc.handled {
+				committer.Done(msg)
+			}
+
+			t.Require().NoError(committer.Flush(s.ctx))
+			t.Require().Equal(tc.expectedCommitted, reader.committed())
+			t.Require().LessOrEqual(len(reader.commits), 1)
+		})
+	}
+}
+
+func (s *CommitterTestSuite) TestFlushCommitsOnlyOnce(t provider.T) {
+	t.Parallel()
+
+	reader := &offsetCommitterMock{}
+	committer := s.newCommitter(reader)
+	committer.Track(message(0, 0))
+	committer.Done(message(0, 0))
+
+	t.Require().NoError(committer.Flush(s.ctx))
+	t.Require().NoError(committer.Flush(s.ctx))
+	t.Require().Len(reader.commits, 1)
+}
+
+func (s *CommitterTestSuite) TestFlushKeepsOffsetsOnError(t provider.T) {
+	t.Parallel()
+
+	reader := &offsetCommitterMock{err: errCommit}
+	committer := s.newCommitter(reader)
+	committer.Track(message(0, 0))
+	committer.Done(message(0, 0))
+
+	t.Require().ErrorIs(committer.Flush(s.ctx), errCommit)
+
+	reader.err = nil
+	t.Require().NoError(committer.Flush(s.ctx))
+	t.Require().Equal(map[int]int64{0: 0}, reader.committed())
+}
+
+func (s *CommitterTestSuite) TestRunFlushesOnShutdown(t provider.T) {
+	t.Parallel()
+
+	reader := &offsetCommitterMock{}
+	committer := s.newCommitter(reader)
+	committer.Track(message(0, 0))
+	committer.Done(message(0, 0))
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	cancel()
+	committer.Run(ctx)
+
+	t.Require().Equal(map[int]int64{0: 0}, reader.committed())
+}
+
+func TestCommitterTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(CommitterTestSuite))
+}
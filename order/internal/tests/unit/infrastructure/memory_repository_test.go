@@ -0,0 +1,170 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	promotionDomain "order/internal/domain/promotion"
+	sagaDomain "order/internal/domain/saga"
+	slotDomain "order/internal/domain/slot"
+	"order/internal/domain/uow"
+	"order/internal/infrastructure/memory"
+	sagaRepository "order/internal/infrastructure/repository/saga"
+	"order/internal/tests/testutils/mothers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type MemoryRepositoryTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *MemoryRepositoryTestSuite) BeforeEach(t provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *MemoryRepositoryTestSuite) TestClaimSuspendedSaga(t provider.T) {
+	t.Parallel()
+
+	repository := memory.NewSagaRepository(memory.NewStore())
+	due := mothers.SagaAwaitingDeliverySlot(uuid.New(), time.Now().Add(-time.Minute))
+	later := mothers.SagaAwaitingDeliverySlot(uuid.New(), time.Now().Add(time.Hour))
+	t.Require().NoError(repository.Create(s.ctx, due))
+	t.Require().NoError(repository.Create(s.ctx, later))
+
+	leaseUntil := time.Now().Add(time.Minute)
+	claimed, err := repository.ClaimSuspended(s.ctx, sagaDomain.CreateOrder, time.Now(), leaseUntil)
+	t.Require().NoError(err)
+	t.Require().NotNil(claimed)
+	t.Require().Equal(due.ID, claimed.ID)
+
+	// The lease keeps the saga from being claimed twice.
+	claimed, err = repository.ClaimSuspended(s.ctx, sagaDomain.CreateOrder, time.Now(), leaseUntil)
+	t.Require().NoError(err)
+	t.Require().Nil(claimed)
+}
+
+func (s *MemoryRepositoryTestSuite) TestSagaAlreadyExists(t provider.T) {
+	t.Parallel()
+
+	repository := memory.NewSagaRepository(memory.NewStore())
+	saga := mothers.DefaultSaga()
+	t.Require().NoError(repository.Create(s.ctx, saga))
+	t.Require().ErrorIs(repository.Create(s.ctx, saga), sagaRepository.ErrSagaAlreadyExists)
+}
+
+func (s *MemoryRepositoryTestSuite) TestClaimPendingOutboxMessage(t provider.T) {
+	t.Parallel()
+
+	repository := memory.NewOutboxRepository(memory.NewStore())
+	pending := mothers.OutboxMessage()
+	scheduled := mothers.OutboxMessage()
+	scheduled.NextAttempt = time.Now().Add(time.Hour)
+	t.Require().NoError(repository.Create(s.ctx, pending))
+	t.Require().NoError(repository.Create(s.ctx, scheduled))
+
+	leaseUntil := time.Now().Add(time.Minute)
+	claimed, err := repository.ClaimPending(s.ctx, leaseUntil)
+	t.Require().NoError(err)
+	t.Require().NotNil(claimed)
+	t.Require().Equal(pending.ID, claimed.ID)
+
+	claimed, err = repository.ClaimPending(s.ctx, leaseUntil)
+	t.Require().NoError(err)
+	t.Require().Nil(claimed)
+}
+
+func (s *MemoryRepositoryTestSuite) TestClaimPendingOutboxMessageInKeyOrder(t provider.T) {
+	t.Parallel()
+
+	repository := memory.NewOutboxRepository(memory.NewStore())
+	first := mothers.OutboxMessage()
+	second := mothers.OutboxMessage()
+	second.Key = first.Key
+	second.Created = first.Created.Add(time.Second)
+	t.Require().NoError(repository.Create(s.ctx, first))
+	t.Require().NoError(repository.Create(s.ctx, second))
+
+	leaseUntil := time.Now().Add(time.Minute)
+	claimed, err := repository.ClaimPending(s.ctx, leaseUntil)
+	t.Require().NoError(err)
+	t.Require().NotNil(claimed)
+	t.Require().Equal(first.ID, claimed.ID)
+
+	// The first message is scheduled for retry, so the second one has to wait.
+	claimed.NoteFailedAttempt(time.Now().Add(time.Hour))
+	t.Require().NoError(repository.Update(s.ctx, claimed))
+	claimed, err = repository.ClaimPending(s.ctx, leaseUntil)
+	t.Require().NoError(err)
+	t.Require().Nil(claimed)
+
+	t.Require().NoError(repository.Delete(s.ctx, first))
+	claimed, err = repository.ClaimPending(s.ctx, leaseUntil)
+	t.Require().NoError(err)
+	t.Require().NotNil(claimed)
+	t.Require().Equal(second.ID, claimed.ID)
+}
+
+func (s *MemoryRepositoryTestSuite) TestRedeemPromotion(t provider.T) {
+	t.Parallel()
+
+	repository := memory.NewPromotionRepository(memory.NewStore())
+	promotion := mothers.PercentagePromotion(10)
+	promotion.Rules.PerCustomerLimit = 1
+	t.Require().NoError(repository.Create(s.ctx, promotion))
+
+	customerID := uuid.New()
+	t.Require().NoError(repository.Redeem(s.ctx, promotion, customerID))
+	t.Require().ErrorIs(repository.Redeem(s.ctx, promotion, customerID), promotionDomain.ErrUsageLimitReached)
+	t.Require().NoError(repository.Redeem(s.ctx, promotion, uuid.New()))
+}
+
+func (s *MemoryRepositoryTestSuite) TestReserveSlot(t provider.T) {
+	t.Parallel()
+
+	repository := memory.NewSlotRepository(memory.NewStore())
+	slot := mothers.TomorrowSlot()
+
+	t.Require().NoError(repository.Reserve(s.ctx, slot, 1))
+	t.Require().ErrorIs(repository.Reserve(s.ctx, slot, 1), slotDomain.ErrSlotFull)
+
+	t.Require().NoError(repository.Release(s.ctx, slot))
+	t.Require().NoError(repository.Reserve(s.ctx, slot, 1))
+
+	reserved, err := repository.GetReserved(s.ctx, slot.Start.Add(-time.Hour), slot.End)
+	t.Require().NoError(err)
+	t.Require().Equal(1, reserved[slot.Start.UTC()])
+}
+
+func (s *MemoryRepositoryTestSuite) TestUoWRollback(t provider.T) {
+	t.Parallel()
+
+	store := memory.NewStore()
+	unitOfWork := memory.NewUoW(store)
+	order := mothers.DefaultOrder()
+	saga := mothers.SagaReservingItems(order.ID)
+
+	err := unitOfWork.Transaction(s.ctx, func(ctx context.Context, u uow.UoW) error {
+		if err := u.Order().Create(ctx, order); err != nil {
+			return err
+		}
+		if err := u.Saga().Create(ctx, saga); err != nil {
+			return err
+		}
+		return errors.New("transaction error")
+	})
+	t.Require().Error(err)
+
+	_, err = memory.NewOrderRepository(store).GetByID(s.ctx, order.ID)
+	t.Require().Error(err)
+	_, err = memory.NewSagaRepository(store).GetByID(s.ctx, saga.ID)
+	t.Require().ErrorIs(err, sagaRepository.ErrSagaNotFound)
+}
+
+func TestMemoryRepository(t *testing.T) {
+	suite.RunSuite(t, new(MemoryRepositoryTestSuite))
+}
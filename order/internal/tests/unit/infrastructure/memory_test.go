@@ -0,0 +1,133 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	orderDomain "order/internal/domain/order"
+	"order/internal/infrastructure/memory"
+	orderRepository "order/internal/infrastructure/repository/order"
+	"order/internal/tests/testutils/mothers"
+	"sync"
+	"testing"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type MemoryStoreTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *MemoryStoreTestSuite) BeforeEach(t provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *MemoryStoreTestSuite) TestTransaction(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		fnErr         error
+		expectedFound bool
+	}{
+		{name: "Success: Changes committed", fnErr: nil, expectedFound: true},
+		{name: "Failure: Changes rolled back", fnErr: errors.New("transaction error"), expectedFound: false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			store := memory.NewStore()
+			repository := memory.NewOrderRepository(store)
+			order := mothers.DefaultOrder()
+
+			err := store.Transaction(s.ctx, func(ctx context.Context) error {
+				if err := repository.Create(ctx, order); err != nil {
+					return err
+				}
+				// A nested transaction joins the outer one and sees its writes.
+				return store.Transaction(ctx, func(ctx context.Context) error {
+					if _, err := repository.GetByID(ctx, order.ID); err != nil {
+						return err
+					}
+					return tc.fnErr
+				})
+			})
+			t.Require().ErrorIs(err, tc.fnErr)
+
+			_, err = repository.GetByID(s.ctx, order.ID)
+			if tc.expectedFound {
+				t.Require().NoError(err)
+			} else {
+				t.Require().ErrorIs(err, orderRepository.ErrOrderNotFound)
+			}
+		})
+	}
+}
+
+func (s *MemoryStoreTestSuite) TestOrdersAreCopied(t provider.T) {
+	t.Parallel()
+
+	repository := memory.NewOrderRepository(memory.NewStore())
+	order := mothers.OrderWithItems()
+	t.Require().NoError(repository.Create(s.ctx, order))
+
+	order.Items[0].Count = 100
+	got, err := repository.GetByID(s.ctx, order.ID)
+	t.Require().NoError(err)
+	got.Status = orderDomain.CustomerCanceled
+
+	stored, err := repository.GetByID(s.ctx, order.ID)
+	t.Require().NoError(err)
+	t.Require().NotEqual(100, stored.Items[0].Count)
+	t.Require().NotEqual(orderDomain.CustomerCanceled, stored.Status)
+}
+
+func (s *MemoryStoreTestSuite) TestConcurrentUpdates(t provider.T) {
+	t.Parallel()
+
+	const workers = 16
+
+	repository := memory.NewOrderRepository(memory.NewStore())
+	order := mothers.DefaultOrder()
+	t.Require().NoError(repository.Create(s.ctx, order))
+
+	// Every worker updates the version it read; exactly one of them wins.
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+		conflicts int
+	)
+	start := make(chan struct{})
+	for range workers {
+		copied, err := repository.GetByID(s.ctx, order.ID)
+		t.Require().NoError(err)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			err := repository.Update(s.ctx, copied)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				succeeded++
+			case errors.Is(err, orderRepository.ErrOrderNotFound):
+				conflicts++
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	t.Require().Equal(1, succeeded)
+	t.Require().Equal(workers-1, conflicts)
+}
+
+func TestMemoryStore(t *testing.T) {
+	suite.RunSuite(t, new(MemoryStoreTestSuite))
+}
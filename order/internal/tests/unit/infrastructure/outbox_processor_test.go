@@ -0,0 +1,99 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	outboxDomain "order/internal/domain/outbox"
+	"order/internal/infrastructure/logger"
+	"order/internal/infrastructure/memory"
+	"order/internal/infrastructure/messaging/retry"
+	"order/internal/infrastructure/outbox"
+	"order/internal/tests/testutils/mothers"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/sirupsen/logrus"
+)
+
+// recordingPublisher publishes every message but those named unknown, which
+// it can never publish.
+type recordingPublisher struct {
+	unknown string
+
+	mu        sync.Mutex
+	attempts  map[string]int
+	published []string
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, message *outboxDomain.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.attempts[message.Name]++
+	if message.Name == p.unknown {
+		return retry.Permanent(errors.New("invalid outbox message"))
+	}
+	p.published = append(p.published, message.Name)
+	return nil
+}
+
+func (p *recordingPublisher) snapshot() (map[string]int, []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	attempts := make(map[string]int, len(p.attempts))
+	for name, count := range p.attempts {
+		attempts[name] = count
+	}
+	return attempts, append([]string(nil), p.published...)
+}
+
+type OutboxProcessorTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *OutboxProcessorTestSuite) BeforeEach(t provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *OutboxProcessorTestSuite) TestUnpublishableMessageFailed(t provider.T) {
+	t.Parallel()
+
+	repo := memory.NewOutboxRepository(memory.NewStore())
+	publisher := &recordingPublisher{unknown: "unknown.command", attempts: map[string]int{}}
+
+	unknown := mothers.OutboxMessage()
+	unknown.Name = "unknown.command"
+	next := mothers.OutboxMessage()
+	next.Key = unknown.Key
+	next.Created = unknown.Created.Add(time.Second)
+	t.Require().NoError(repo.Create(s.ctx, unknown))
+	t.Require().NoError(repo.Create(s.ctx, next))
+
+	processor := outbox.NewProcessor(repo, publisher, logger.NewLogger(logrus.New()))
+	t.Require().NoError(processor.Start(s.ctx))
+
+	// The message after the one that can never be published goes out
+	t.Require().Eventually(func() bool {
+		_, published := publisher.snapshot()
+		return len(published) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	t.Require().NoError(processor.Stop())
+
+	attempts, published := publisher.snapshot()
+	t.Require().Equal([]string{next.Name}, published)
+	t.Require().Equal(1, attempts[unknown.Name])
+
+	// The failed message is kept, but never claimed again
+	claimed, err := repo.ClaimPending(s.ctx, time.Now().Add(time.Minute))
+	t.Require().NoError(err)
+	t.Require().Nil(claimed)
+}
+
+func TestOutboxProcessorTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(OutboxProcessorTestSuite))
+}
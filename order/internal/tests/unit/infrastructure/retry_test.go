@@ -0,0 +1,114 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"order/internal/infrastructure/messaging/retry"
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+var errTransient = errors.New("transient error")
+
+type RetryPolicyTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *RetryPolicyTestSuite) BeforeEach(t provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *RetryPolicyTestSuite) newPolicy(maxAttempts int) *retry.Policy {
+	return retry.NewPolicy(
+		&retry.Config{
+			MaxAttempts:    maxAttempts,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     4 * time.Millisecond,
+		},
+		func(err error) bool { return !retry.IsPermanent(err) },
+	)
+}
+
+func (s *RetryPolicyTestSuite) TestDo(t provider.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		maxAttempts      int
+		failures         []error
+		expectedAttempts int
+		expectedErr      error
+	}{
+		{
+			name:             "Success: First attempt",
+			maxAttempts:      3,
+			failures:         nil,
+			expectedAttempts: 1,
+			expectedErr:      nil,
+		},
+		{
+			name:             "Success: After transient failures",
+			maxAttempts:      3,
+			failures:         []error{errTransient, errTransient},
+			expectedAttempts: 3,
+			expectedErr:      nil,
+		},
+		{
+			name:             "Failure: Attempts exhausted",
+			maxAttempts:      3,
+			failures:         []error{errTransient, errTransient, errTransient, errTransient},
+			expectedAttempts: 3,
+			expectedErr:      errTransient,
+		},
+		{
+			name:             "Failure: Permanent error is not retried",
+			maxAttempts:      3,
+			failures:         []error{retry.Permanent(errTransient)},
+			expectedAttempts: 1,
+			expectedErr:      errTransient,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t provider.T) {
+			t.Parallel()
+
+			calls := 0
+			attempts, err := s.newPolicy(tc.maxAttempts).Do(s.ctx, func(context.Context) error {
+				calls++
+				if calls <= len(tc.failures) {
+					return tc.failures[calls-1]
+				}
+				return nil
+			})
+
+			t.Require().Equal(tc.expectedAttempts, attempts)
+			t.Require().Equal(tc.expectedAttempts, calls)
+			if tc.expectedErr != nil {
+				t.Require().ErrorIs(err, tc.expectedErr)
+			} else {
+				t.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *RetryPolicyTestSuite) TestBackoff(t provider.T) {
+	t.Parallel()
+
+	policy := s.newPolicy(5)
+
+	t.Require().Equal(time.Millisecond, policy.Backoff(1))
+	t.Require().Equal(2*time.Millisecond, policy.Backoff(2))
+	t.Require().Equal(4*time.Millisecond, policy.Backoff(3))
+	t.Require().Equal(4*time.Millisecond, policy.Backoff(10))
+}
+
+func TestRetryPolicyTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(RetryPolicyTestSuite))
+}
@@ -0,0 +1,98 @@
+package infrastructure
+
+import (
+	"context"
+	"order/internal/infrastructure/messaging/worker"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/segmentio/kafka-go"
+)
+
+type WorkerPoolTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *WorkerPoolTestSuite) BeforeEach(t provider.T) {
+	s.ctx = context.Background()
+}
+
+func (s *WorkerPoolTestSuite) TestSameKeyKeepsOrder(t provider.T) {
+	t.Parallel()
+
+	pool := worker.NewPool(&worker.Config{Concurrency: 4})
+	pool.Start()
+
+	var mu sync.Mutex
+	var handled []int
+	msg := &kafka.Message{Key: []byte("order-id")}
+	for i := 0; i < 50; i++ {
+		t.Require().True(pool.Submit(s.ctx, msg, func() {
+			mu.Lock()
+			defer mu.Unlock()
+			handled = append(handled, i)
+		}))
+	}
+	pool.Stop()
+
+	t.Require().Len(handled, 50)
+	for i, n := range handled {
+		t.Require().Equal(i, n)
+	}
+}
+
+func (s *WorkerPoolTestSuite) TestPartitionsRunInParallel(t provider.T) {
+	t.Parallel()
+
+	pool := worker.NewPool(&worker.Config{Concurrency: 2})
+	pool.Start()
+	defer pool.Stop()
+
+	released := make(chan struct{})
+	done := make(chan struct{})
+
+	// The first task only finishes once the second, owned by another worker, has run.
+	pool.Submit(s.ctx, &kafka.Message{Partition: 0}, func() {
+		<-released
+		close(done)
+	})
+	pool.Submit(s.ctx, &kafka.Message{Partition: 1}, func() {
+		close(released)
+	})
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("tasks of different partitions were not handled in parallel")
+	}
+}
+
+func (s *WorkerPoolTestSuite) TestStopDrainsQueuedTasks(t provider.T) {
+	t.Parallel()
+
+	pool := worker.NewPool(&worker.Config{Concurrency: 2})
+	pool.Start()
+
+	var mu sync.Mutex
+	handled := 0
+	for i := 0; i < 10; i++ {
+		pool.Submit(s.ctx, &kafka.Message{Partition: i}, func() {
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			defer mu.Unlock()
+			handled++
+		})
+	}
+	pool.Stop()
+
+	t.Require().Equal(10, handled)
+}
+
+func TestWorkerPoolTestSuite(t *testing.T) {
+	suite.RunSuite(t, new(WorkerPoolTestSuite))
+}
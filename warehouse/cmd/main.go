@@ -7,35 +7,19 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
-	appDI "warehouse/internal/application/di"
-	infraDI "warehouse/internal/infrastructure/di"
+	warehouseApp "warehouse/app"
 	"warehouse/internal/infrastructure/logger"
-	presentationDI "warehouse/internal/presentation/di"
 
 	"go.uber.org/fx"
 )
 
 func main() {
-	app := fx.New(
-		// Infrastructure modules
-		infraDI.LoggerModule,
-		infraDI.MessagingModule,
-		infraDI.DatabaseModule,
-		infraDI.RepositoryModule,
-		infraDI.PublisherModule,
-		infraDI.OutboxProcessorModule,
-		infraDI.UowModule,
-		infraDI.ImageModule,
-		infraDI.TelemetryModule,
-
-		// Application modules
-		appDI.UseCaseModule,
+	if len(os.Args) > 1 && os.Args[1] == "dlq" {
+		os.Exit(runDLQ(os.Args[2:]))
+	}
 
-		// Presentation modules
-		presentationDI.GRPCModule,
-		presentationDI.CommandConsumerModule,
-		presentationDI.EventsModule,
-		presentationDI.TelemetryModule,
+	app := fx.New(
+		warehouseApp.Module,
 
 		// Add logging for application startup and shutdown
 		fx.Invoke(func(lc fx.Lifecycle, logger logger.Logger) {
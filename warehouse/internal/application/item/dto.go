@@ -9,8 +9,20 @@ type CreateDto struct {
 	Count     int
 }
 
+// ReservePolicy decides what Reserve does when some items are short.
+type ReservePolicy string
+
+const (
+	// ReserveAllOrNothing reserves every item in full or none of them.
+	ReserveAllOrNothing ReservePolicy = "all_or_nothing"
+	// ReserveAllowPartial reserves as much of every item as is in stock.
+	ReserveAllowPartial ReservePolicy = "allow_partial"
+)
+
 type ReserveDto struct {
 	Items []ItemDto
+	// Policy is optional; the zero value reserves all or nothing.
+	Policy ReservePolicy
 }
 
 type ReleaseDto struct {
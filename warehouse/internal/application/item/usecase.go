@@ -9,7 +9,7 @@ import (
 
 type UseCase interface {
 	Create(ctx context.Context, data CreateDto) (uuid.UUID, error)
-	Reserve(ctx context.Context, data ReserveDto) error
+	Reserve(ctx context.Context, data ReserveDto) ([]ItemDto, error)
 	Release(ctx context.Context, data ReleaseDto) error
 	GetAll(ctx context.Context) ([]*itemDomain.Item, error)
 }
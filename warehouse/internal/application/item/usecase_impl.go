@@ -2,6 +2,7 @@ package item
 
 import (
 	"context"
+	"slices"
 	itemDomain "warehouse/internal/domain/item"
 	"warehouse/internal/domain/uow"
 	itemRepository "warehouse/internal/infrastructure/repository/item"
@@ -36,58 +37,67 @@ func (u *UseCaseImpl) Create(ctx context.Context, data CreateDto) (uuid.UUID, er
 	return item.ID, nil
 }
 
-func (u *UseCaseImpl) Reserve(ctx context.Context, data ReserveDto) error {
-	productIDs := make([]uuid.UUID, 0, len(data.Items))
-	for _, itemDto := range data.Items {
-		productIDs = append(productIDs, itemDto.ProductID)
-	}
+// Reserve takes the items out of stock and returns how much of each it took.
+// Under ReserveAllowPartial an item short in stock is reserved as far as it
+// goes, and the reservation fails only if none of the items is in stock.
+func (u *UseCaseImpl) Reserve(ctx context.Context, data ReserveDto) ([]ItemDto, error) {
+	productIDs, reserveMap := countByProduct(data.Items)
 
 	items, err := u.uow.Item().GetAllByProductIDs(ctx, productIDs...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	reserveMap := make(map[uuid.UUID]int, len(data.Items))
-	for _, itemDto := range data.Items {
-		reserveMap[itemDto.ProductID] = itemDto.Count
-	}
-
-	return u.uow.Transaction(ctx, func(tx uow.UoW) error {
+	var reserved []ItemDto
+	err = u.uow.Transaction(ctx, func(tx uow.UoW) error {
+		reserved = make([]ItemDto, 0, len(items))
 		for _, item := range items {
 			count, exists := reserveMap[item.Product.ID]
 			if !exists {
 				return itemRepository.ErrItemsNotFound
 			}
 
-			if err := item.Reserve(count); err != nil {
+			count, err := reserve(item, count, data.Policy)
+			if err != nil {
 				return err
 			}
+			reserved = append(reserved, ItemDto{ProductID: item.Product.ID, Count: count})
+			if count == 0 {
+				continue
+			}
 
 			if err := tx.Item().Update(ctx, item); err != nil {
 				return err
 			}
 		}
 
+		if !slices.ContainsFunc(reserved, func(item ItemDto) bool { return item.Count > 0 }) {
+			return itemDomain.ErrOutOfStock
+		}
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reserved, nil
 }
 
-func (u *UseCaseImpl) Release(ctx context.Context, data ReleaseDto) error {
-	productIDs := make([]uuid.UUID, 0, len(data.Items))
-	for _, itemDto := range data.Items {
-		productIDs = append(productIDs, itemDto.ProductID)
+func reserve(item *itemDomain.Item, count int, policy ReservePolicy) (int, error) {
+	if policy == ReserveAllowPartial {
+		return item.ReserveAvailable(count)
 	}
+	return count, item.Reserve(count)
+}
+
+func (u *UseCaseImpl) Release(ctx context.Context, data ReleaseDto) error {
+	productIDs, releaseMap := countByProduct(data.Items)
 
 	items, err := u.uow.Item().GetAllByProductIDs(ctx, productIDs...)
 	if err != nil {
 		return err
 	}
 
-	releaseMap := make(map[uuid.UUID]int, len(data.Items))
-	for _, itemDto := range data.Items {
-		releaseMap[itemDto.ProductID] = itemDto.Count
-	}
-
 	return u.uow.Transaction(ctx, func(tx uow.UoW) error {
 		for _, item := range items {
 			count, exists := releaseMap[item.Product.ID]
@@ -108,6 +118,20 @@ func (u *UseCaseImpl) Release(ctx context.Context, data ReleaseDto) error {
 	})
 }
 
+// countByProduct sums the counts of the lines naming the same product and
+// returns the products in the order they first appear.
+func countByProduct(lines []ItemDto) ([]uuid.UUID, map[uuid.UUID]int) {
+	productIDs := make([]uuid.UUID, 0, len(lines))
+	counts := make(map[uuid.UUID]int, len(lines))
+	for _, line := range lines {
+		if _, seen := counts[line.ProductID]; !seen {
+			productIDs = append(productIDs, line.ProductID)
+		}
+		counts[line.ProductID] += line.Count
+	}
+	return productIDs, counts
+}
+
 func (u *UseCaseImpl) GetAll(ctx context.Context) ([]*itemDomain.Item, error) {
 	return u.uow.Item().GetAll(ctx)
 }
@@ -1,8 +1,8 @@
 package product
 
-import "github.com/shopspring/decimal"
+import productDomain "warehouse/internal/domain/product"
 
 type CreateDto struct {
 	Name  string
-	Price decimal.Decimal
+	Price productDomain.Money
 }
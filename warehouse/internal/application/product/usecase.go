@@ -2,9 +2,12 @@ package product
 
 import (
 	"context"
+	productDomain "warehouse/internal/domain/product"
+
 	"github.com/google/uuid"
 )
 
 type UseCase interface {
 	Create(ctx context.Context, data CreateDto) (uuid.UUID, error)
+	GetByIDs(ctx context.Context, productIDs []uuid.UUID) ([]*productDomain.Product, error)
 }
@@ -61,4 +61,11 @@ func (u *UseCaseImpl) Create(ctx context.Context, data CreateDto) (uuid.UUID, er
 	return product.ID, nil
 }
 
+func (u *UseCaseImpl) GetByIDs(ctx context.Context, productIDs []uuid.UUID) ([]*productDomain.Product, error) {
+	if len(productIDs) == 0 {
+		return []*productDomain.Product{}, nil
+	}
+	return u.uow.Product().GetAllByIDs(ctx, productIDs...)
+}
+
 var _ UseCase = (*UseCaseImpl)(nil)
@@ -0,0 +1,17 @@
+package inbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func Create(id uuid.UUID, response []byte, retention time.Duration) *Message {
+	now := time.Now()
+	return &Message{
+		ID:        id,
+		Response:  response,
+		Processed: now,
+		Expires:   now.Add(retention),
+	}
+}
@@ -0,0 +1,14 @@
+package inbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, message *Message) error
+	GetByID(ctx context.Context, messageID uuid.UUID) (*Message, error)
+	DeleteExpired(ctx context.Context, now time.Time) error
+}
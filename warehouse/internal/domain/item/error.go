@@ -4,4 +4,5 @@ import "errors"
 
 var (
 	ErrInvalidItemCount = errors.New("invalid item count")
+	ErrOutOfStock       = errors.New("items out of stock")
 )
@@ -26,6 +26,19 @@ func (i *Item) Reserve(count int) error {
 	return nil
 }
 
+// ReserveAvailable reserves as much of count as is in stock and returns how
+// much that was, possibly nothing.
+func (i *Item) ReserveAvailable(count int) (int, error) {
+	if count <= 0 {
+		return 0, ErrInvalidItemCount
+	}
+
+	reserved := min(count, i.Count)
+	i.Count -= reserved
+
+	return reserved, nil
+}
+
 func (i *Item) Release(count int) error {
 	if count <= 0 {
 		return ErrInvalidItemCount
@@ -5,4 +5,7 @@ import "errors"
 var (
 	ErrInvalidProductPrice = errors.New("invalid product price")
 	ErrInvalidProductName  = errors.New("invalid product name")
+	ErrInvalidMoney        = errors.New("invalid money amount")
+	ErrInvalidCurrency     = errors.New("invalid currency code")
+	ErrCurrencyMismatch    = errors.New("currency mismatch")
 )
@@ -6,13 +6,15 @@ import (
 	domain "warehouse/internal/domain/common"
 
 	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
 )
 
-func Create(name string, price decimal.Decimal, imagePath string) (*Product, []domain.Event, error) {
-	if price.LessThanOrEqual(decimal.Zero) {
+func Create(name string, price Money, imagePath string) (*Product, []domain.Event, error) {
+	if !price.IsPositive() {
 		return nil, []domain.Event{}, ErrInvalidProductPrice
 	}
+	if !validateCurrency(price.Currency) {
+		return nil, []domain.Event{}, ErrInvalidCurrency
+	}
 	if strings.TrimSpace(name) == "" {
 		return nil, []domain.Event{}, ErrInvalidProductName
 	}
@@ -0,0 +1,86 @@
+package product
+
+import "github.com/shopspring/decimal"
+
+// Amounts finer than a nano (1e-9 of a unit) cannot be represented and are rejected.
+const (
+	nanosPerUnit = 1_000_000_000
+	moneyScale   = 9
+)
+
+// Money is an amount in a single ISO 4217 currency. Amounts in different
+// currencies are never combined.
+type Money struct {
+	Amount   decimal.Decimal
+	Currency string
+}
+
+func NewMoney(amount decimal.Decimal, currency string) (Money, error) {
+	if !validateCurrency(currency) {
+		return Money{}, ErrInvalidCurrency
+	}
+	if !amount.Equal(amount.Truncate(moneyScale)) {
+		return Money{}, ErrInvalidMoney
+	}
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// MoneyFromUnits builds Money from whole units and nano units of the currency.
+// Both parts must have the same sign.
+func MoneyFromUnits(units int64, nanos int32, currency string) (Money, error) {
+	if nanos <= -nanosPerUnit || nanos >= nanosPerUnit {
+		return Money{}, ErrInvalidMoney
+	}
+	if (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return Money{}, ErrInvalidMoney
+	}
+
+	amount := decimal.NewFromInt(units).Add(decimal.New(int64(nanos), -moneyScale))
+	return NewMoney(amount, currency)
+}
+
+// Units is the whole part of the amount.
+func (m Money) Units() int64 {
+	return m.Amount.Truncate(0).IntPart()
+}
+
+// Nanos is the fractional part of the amount in billionths, with the sign of Units.
+func (m Money) Nanos() int32 {
+	return int32(m.Amount.Sub(m.Amount.Truncate(0)).Shift(moneyScale).IntPart())
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	return Money{Amount: m.Amount.Add(other.Amount), Currency: m.Currency}, nil
+}
+
+func (m Money) Mul(n int) Money {
+	return Money{Amount: m.Amount.Mul(decimal.NewFromInt(int64(n))), Currency: m.Currency}
+}
+
+func (m Money) Equal(other Money) bool {
+	return m.Currency == other.Currency && m.Amount.Equal(other.Amount)
+}
+
+func (m Money) IsPositive() bool {
+	return m.Amount.Sign() > 0
+}
+
+func (m Money) String() string {
+	return m.Amount.String() + " " + m.Currency
+}
+
+// validateCurrency checks the ISO 4217 shape: three upper-case letters.
+func validateCurrency(currency string) bool {
+	if len(currency) != 3 {
+		return false
+	}
+	for _, r := range currency {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -2,14 +2,13 @@ package product
 
 import (
 	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
 	"time"
 )
 
 type Product struct {
 	ID      uuid.UUID
 	Name    string
-	Price   decimal.Decimal
+	Price   Money
 	Created time.Time
 	Image   Image
 }
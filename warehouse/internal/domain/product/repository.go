@@ -9,4 +9,6 @@ type Repository interface {
 	Create(ctx context.Context, product *Product) error
 	GetByID(ctx context.Context, productID uuid.UUID) (*Product, error)
 	GetAll(ctx context.Context, limit, offset int) ([]*Product, error)
+	// GetAllByIDs returns the products that exist among productIDs; unknown IDs are skipped.
+	GetAllByIDs(ctx context.Context, productIDs ...uuid.UUID) ([]*Product, error)
 }
@@ -2,6 +2,7 @@ package uow
 
 import (
 	"context"
+	inboxDomain "warehouse/internal/domain/inbox"
 	itemDomain "warehouse/internal/domain/item"
 	outboxDomain "warehouse/internal/domain/outbox"
 	productDomain "warehouse/internal/domain/product"
@@ -11,5 +12,6 @@ type UoW interface {
 	Product() productDomain.Repository
 	Item() itemDomain.Repository
 	Outbox() outboxDomain.Repository
+	Inbox() inboxDomain.Repository
 	Transaction(ctx context.Context, fn func(u UoW) error) error
 }
@@ -0,0 +1,12 @@
+begin;
+
+CREATE TABLE inbox_messages (
+    id UUID PRIMARY KEY,
+    response BYTEA NULL,
+    processed TIMESTAMPTZ NOT NULL,
+    expires TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX inbox_messages_expires_idx ON inbox_messages (expires);
+
+commit;
@@ -0,0 +1,6 @@
+begin;
+
+ALTER TABLE products DROP COLUMN IF EXISTS currency;
+ALTER TABLE products ALTER COLUMN price TYPE NUMERIC(10,2);
+
+commit;
@@ -0,0 +1,10 @@
+begin;
+
+-- Prices are stored with nano precision to match the wire Money type.
+ALTER TABLE products ALTER COLUMN price TYPE NUMERIC(21,9);
+
+-- Existing prices were entered without a currency and are taken to be USD.
+ALTER TABLE products ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE products ALTER COLUMN currency DROP DEFAULT;
+
+commit;
@@ -7,9 +7,10 @@ import (
 )
 
 type Product struct {
-	ID      uuid.UUID `gorm:"primaryKey"`
-	Name    string
-	Price   decimal.Decimal `gorm:"type:numeric(10, 2)"`
-	Created time.Time
-	Image   ProductImage `gorm:"foreignKey:ProductID;"`
+	ID       uuid.UUID `gorm:"primaryKey"`
+	Name     string
+	Price    decimal.Decimal `gorm:"type:numeric(21, 9)"`
+	Currency string          `gorm:"type:char(3)"`
+	Created  time.Time
+	Image    ProductImage `gorm:"foreignKey:ProductID;"`
 }
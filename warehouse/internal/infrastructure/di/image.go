@@ -3,20 +3,30 @@ package di
 import (
 	productApplication "warehouse/internal/application/product"
 	productImage "warehouse/internal/infrastructure/image/product"
+	"warehouse/internal/infrastructure/memory"
 
 	"go.uber.org/fx"
 )
 
 var ImageModule = fx.Provide(
-	// Config
-	productImage.NewConfig,
-
-	// Client
-	productImage.NewClient,
-
 	// Service
-	fx.Annotate(
-		productImage.NewImageService,
-		fx.As(new(productApplication.ImageService)),
-	),
+	newImageService,
 )
+
+// newImageService stores the images in MinIO unless IN_MEMORY is set, in which
+// case no MINIO_* settings are needed.
+func newImageService(memCfg *memory.Config) (productApplication.ImageService, error) {
+	if memCfg.Enabled {
+		return memory.NewImageService(), nil
+	}
+
+	cfg, err := productImage.NewConfig()
+	if err != nil {
+		return nil, err
+	}
+	client, err := productImage.NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return productImage.NewImageService(cfg, client), nil
+}
@@ -3,10 +3,14 @@ package di
 import (
 	"context"
 	"fmt"
-	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
 	"warehouse/internal/infrastructure/logger"
+	"warehouse/internal/infrastructure/memory"
 	"warehouse/internal/infrastructure/messaging"
+	"warehouse/internal/infrastructure/messaging/commit"
+	"warehouse/internal/infrastructure/messaging/retry"
+	"warehouse/internal/infrastructure/messaging/worker"
 
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/fx"
 )
 
@@ -15,6 +19,15 @@ var MessagingModule = fx.Options(
 		// Configuration
 		messaging.NewConfig,
 
+		// In-memory broker, used when IN_MEMORY is set
+		fx.Annotate(
+			memory.NewBroker,
+			fx.As(new(memory.Bus)),
+		),
+
+		// Transport the readers and writers are opened on
+		newTransport,
+
 		// Message readers
 		fx.Annotate(
 			messaging.NewWarehouseCmdReader,
@@ -36,12 +49,41 @@ var MessagingModule = fx.Options(
 			messaging.NewProductEventWriter,
 			fx.ResultTags(`name:"productEventWriter"`),
 		),
+
+		// Dead-letter writers
+		fx.Annotate(
+			messaging.NewWarehouseCmdDLQWriter,
+			fx.ResultTags(`name:"warehouseCmdDLQWriter"`),
+		),
+
+		// Retry configuration
+		retry.NewConfig,
+
+		// Offset commit configuration
+		commit.NewConfig,
+
+		// Consumer worker pool configuration
+		worker.NewConfig,
 	),
 
 	// Kafka resources lifecycle management
 	fx.Invoke(setupMessagingLifecycle),
 )
 
+// newTransport opens the readers and writers on Kafka, or on the in-memory
+// broker when IN_MEMORY is set, in which case KAFKA_ADDRESS is not needed.
+func newTransport(
+	memCfg *memory.Config,
+	cfg *messaging.Config,
+	tp *sdktrace.TracerProvider,
+	bus memory.Bus,
+) (messaging.Transport, error) {
+	if memCfg.Enabled {
+		return memory.NewTransport(bus), nil
+	}
+	return messaging.NewKafkaTransport(cfg, tp)
+}
+
 func setupMessagingLifecycle(in struct {
 	fx.In
 
@@ -49,12 +91,15 @@ func setupMessagingLifecycle(in struct {
 	Logger    logger.Logger
 
 	// Readers
-	WarehouseCmdReader *otelkafkakonsumer.Reader `name:"warehouseCmdReader"`
-	ProductEventReader *otelkafkakonsumer.Reader `name:"productEventReader"`
+	WarehouseCmdReader messaging.Reader `name:"warehouseCmdReader"`
+	ProductEventReader messaging.Reader `name:"productEventReader"`
 
 	// Writers
-	WarehouseCmdResWriter *otelkafkakonsumer.Writer `name:"warehouseCmdResWriter"`
-	ProductEventWriter    *otelkafkakonsumer.Writer `name:"productEventWriter"`
+	WarehouseCmdResWriter messaging.Writer `name:"warehouseCmdResWriter"`
+	ProductEventWriter    messaging.Writer `name:"productEventWriter"`
+
+	// Dead-letter writers
+	WarehouseCmdDLQWriter messaging.Writer `name:"warehouseCmdDLQWriter"`
 }) {
 	in.Lifecycle.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
@@ -84,6 +129,10 @@ func setupMessagingLifecycle(in struct {
 				hasErrors = true
 			}
 
+			if err := closeWriter("warehouse command dlq writer", in.WarehouseCmdDLQWriter, in.Logger); err != nil {
+				hasErrors = true
+			}
+
 			if hasErrors {
 				return fmt.Errorf("errors occurred while closing Kafka resources")
 			}
@@ -93,7 +142,7 @@ func setupMessagingLifecycle(in struct {
 	})
 }
 
-func closeReader(name string, reader *otelkafkakonsumer.Reader, logger logger.Logger) error {
+func closeReader(name string, reader messaging.Reader, logger logger.Logger) error {
 	if reader == nil {
 		return nil
 	}
@@ -106,7 +155,7 @@ func closeReader(name string, reader *otelkafkakonsumer.Reader, logger logger.Lo
 	return nil
 }
 
-func closeWriter(name string, writer *otelkafkakonsumer.Writer, logger logger.Logger) error {
+func closeWriter(name string, writer messaging.Writer, logger logger.Logger) error {
 	if writer == nil {
 		return nil
 	}
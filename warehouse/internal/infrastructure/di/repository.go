@@ -1,32 +1,58 @@
 package di
 
 import (
+	inboxDomain "warehouse/internal/domain/inbox"
 	itemDomain "warehouse/internal/domain/item"
 	outboxDomain "warehouse/internal/domain/outbox"
 	productDomain "warehouse/internal/domain/product"
+	"warehouse/internal/infrastructure/memory"
+	inboxRepository "warehouse/internal/infrastructure/repository/inbox"
 	itemRepository "warehouse/internal/infrastructure/repository/item"
 	outboxRepository "warehouse/internal/infrastructure/repository/outbox"
 	productRepository "warehouse/internal/infrastructure/repository/product"
 
 	"go.uber.org/fx"
+	"gorm.io/gorm"
 )
 
 var RepositoryModule = fx.Provide(
 	// Item repository
-	fx.Annotate(
-		itemRepository.New,
-		fx.As(new(itemDomain.Repository)),
-	),
+	newItemRepository,
 
 	// Product repository
-	fx.Annotate(
-		productRepository.New,
-		fx.As(new(productDomain.Repository)),
-	),
+	newProductRepository,
 
 	// Outbox repository
-	fx.Annotate(
-		outboxRepository.New,
-		fx.As(new(outboxDomain.Repository)),
-	),
+	newOutboxRepository,
+
+	// Inbox repository
+	newInboxRepository,
 )
+
+func newItemRepository(memCfg *memory.Config, db *gorm.DB, store *memory.Store) itemDomain.Repository {
+	if memCfg.Enabled {
+		return memory.NewItemRepository(store)
+	}
+	return itemRepository.New(db)
+}
+
+func newProductRepository(memCfg *memory.Config, db *gorm.DB, store *memory.Store) productDomain.Repository {
+	if memCfg.Enabled {
+		return memory.NewProductRepository(store)
+	}
+	return productRepository.New(db)
+}
+
+func newOutboxRepository(memCfg *memory.Config, db *gorm.DB, store *memory.Store) outboxDomain.Repository {
+	if memCfg.Enabled {
+		return memory.NewOutboxRepository(store)
+	}
+	return outboxRepository.New(db)
+}
+
+func newInboxRepository(memCfg *memory.Config, db *gorm.DB, store *memory.Store) inboxDomain.Repository {
+	if memCfg.Enabled {
+		return memory.NewInboxRepository(store)
+	}
+	return inboxRepository.New(db)
+}
@@ -2,15 +2,21 @@ package di
 
 import (
 	"warehouse/internal/domain/uow"
+	"warehouse/internal/infrastructure/memory"
 	uowImpl "warehouse/internal/infrastructure/uow"
 
 	"go.uber.org/fx"
+	"gorm.io/gorm"
 )
 
 var UowModule = fx.Provide(
 	// UoW
-	fx.Annotate(
-		uowImpl.New,
-		fx.As(new(uow.UoW)),
-	),
+	newUoW,
 )
+
+func newUoW(memCfg *memory.Config, db *gorm.DB, store *memory.Store) uow.UoW {
+	if memCfg.Enabled {
+		return memory.NewUoW(store)
+	}
+	return uowImpl.New(db)
+}
@@ -0,0 +1,138 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Broker keeps topics in the process and hands their messages out the way
+// Kafka does for a topic with a single partition: every consumer group gets
+// every message once, in the order it was written, and the readers of one
+// group share the messages between them. It is safe for concurrent use.
+//
+// Its methods use nothing but the standard library and kafka-go, so that one
+// Broker can carry the messages of every service booted into the same process.
+type Broker struct {
+	mu     sync.Mutex
+	topics map[string]*topic
+}
+
+type topic struct {
+	messages []kafka.Message
+	groups   map[string]*group
+	// written is closed, and replaced, every time a message is written.
+	written chan struct{}
+}
+
+type group struct {
+	// next is the offset of the next message handed out to the group.
+	next      int64
+	committed int64
+}
+
+func NewBroker() *Broker {
+	return &Broker{topics: make(map[string]*topic)}
+}
+
+// Publish appends msg to msg.Topic and wakes up the readers waiting for it.
+func (b *Broker) Publish(ctx context.Context, msg kafka.Message) error {
+	if msg.Topic == "" {
+		return errors.New("message has no topic")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t := b.topic(msg.Topic)
+	msg.Partition = 0
+	msg.Offset = int64(len(t.messages))
+	msg.Time = time.Now()
+	t.messages = append(t.messages, msg)
+
+	close(t.written)
+	t.written = make(chan struct{})
+	return nil
+}
+
+// Fetch returns the next message of the topic the group has not been given
+// yet, waiting for one to be written if needed.
+func (b *Broker) Fetch(ctx context.Context, topicName, groupID string) (kafka.Message, error) {
+	for {
+		b.mu.Lock()
+		t := b.topic(topicName)
+		g := t.group(groupID)
+		if g.next < int64(len(t.messages)) {
+			msg := t.messages[g.next]
+			g.next++
+			b.mu.Unlock()
+			return msg, nil
+		}
+		written := t.written
+		b.mu.Unlock()
+
+		select {
+		case <-written:
+		case <-ctx.Done():
+			return kafka.Message{}, ctx.Err()
+		}
+	}
+}
+
+// Commit records the offsets the group has handled. The broker never hands a
+// message out twice, so committed offsets only matter to Committed.
+func (b *Broker) Commit(ctx context.Context, groupID string, msgs ...kafka.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, msg := range msgs {
+		g := b.topic(msg.Topic).group(groupID)
+		g.committed = max(g.committed, msg.Offset+1)
+	}
+	return nil
+}
+
+// Committed returns the offset the group will resume the topic from, that is
+// one past the last message it committed.
+func (b *Broker) Committed(topicName, groupID string) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.topic(topicName).group(groupID).committed
+}
+
+// Messages returns a copy of everything written to the topic so far.
+func (b *Broker) Messages(topicName string) []kafka.Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]kafka.Message(nil), b.topic(topicName).messages...)
+}
+
+func (b *Broker) topic(name string) *topic {
+	t, ok := b.topics[name]
+	if !ok {
+		t = &topic{groups: make(map[string]*group), written: make(chan struct{})}
+		b.topics[name] = t
+	}
+	return t
+}
+
+func (t *topic) group(id string) *group {
+	g, ok := t.groups[id]
+	if !ok {
+		g = &group{}
+		t.groups[id] = g
+	}
+	return g
+}
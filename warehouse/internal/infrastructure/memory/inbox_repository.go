@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+	inboxDomain "warehouse/internal/domain/inbox"
+	inboxRepository "warehouse/internal/infrastructure/repository/inbox"
+
+	"github.com/google/uuid"
+)
+
+// InboxRepository keeps the inbox messages in a Store.
+type InboxRepository struct {
+	store *Store
+	tx    *tables
+}
+
+func NewInboxRepository(store *Store) *InboxRepository {
+	return &InboxRepository{store: store}
+}
+
+func (r *InboxRepository) Create(ctx context.Context, message *inboxDomain.Message) error {
+	return r.store.run(r.tx, func(t *tables) error {
+		if _, ok := t.inbox[message.ID]; ok {
+			return inboxRepository.ErrInboxMessageAlreadyExists
+		}
+		t.inbox[message.ID] = cloneInboxMessage(message)
+		return nil
+	})
+}
+
+func (r *InboxRepository) GetByID(ctx context.Context, messageID uuid.UUID) (*inboxDomain.Message, error) {
+	var message *inboxDomain.Message
+	err := r.store.run(r.tx, func(t *tables) error {
+		stored, ok := t.inbox[messageID]
+		if !ok {
+			return inboxRepository.ErrInboxMessageNotFound
+		}
+		message = cloneInboxMessage(stored)
+		return nil
+	})
+	return message, err
+}
+
+func (r *InboxRepository) DeleteExpired(ctx context.Context, now time.Time) error {
+	return r.store.run(r.tx, func(t *tables) error {
+		for id, message := range t.inbox {
+			if !message.Expires.After(now) {
+				delete(t.inbox, id)
+			}
+		}
+		return nil
+	})
+}
+
+func cloneInboxMessage(message *inboxDomain.Message) *inboxDomain.Message {
+	c := *message
+	c.Response = slices.Clone(message.Response)
+	return &c
+}
+
+var _ inboxDomain.Repository = (*InboxRepository)(nil)
@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	itemDomain "warehouse/internal/domain/item"
+	itemRepository "warehouse/internal/infrastructure/repository/item"
+	productRepository "warehouse/internal/infrastructure/repository/product"
+
+	"github.com/google/uuid"
+)
+
+// ItemRepository keeps the items in a Store. It returns the errors of the
+// PostgreSQL repository, including ErrItemNotFound for an Update with a stale
+// version.
+type ItemRepository struct {
+	store *Store
+	tx    *tables
+}
+
+func NewItemRepository(store *Store) *ItemRepository {
+	return &ItemRepository{store: store}
+}
+
+func (r *ItemRepository) Create(ctx context.Context, i *itemDomain.Item) error {
+	return r.store.run(r.tx, func(t *tables) error {
+		if _, ok := t.items[i.ID]; ok {
+			return itemRepository.ErrItemAlreadyExists
+		}
+		if _, ok := t.products[i.Product.ID]; !ok {
+			return productRepository.ErrProductNotFound
+		}
+		t.items[i.ID] = &item{ID: i.ID, ProductID: i.Product.ID, Count: i.Count, Version: i.Version}
+		return nil
+	})
+}
+
+// Update stores the count under a new version. As in PostgreSQL, the version
+// of the item passed in is left as it is.
+func (r *ItemRepository) Update(ctx context.Context, i *itemDomain.Item) error {
+	return r.store.run(r.tx, func(t *tables) error {
+		stored, ok := t.items[i.ID]
+		if !ok || stored.Version != i.Version {
+			return itemRepository.ErrItemNotFound
+		}
+		updated := *stored
+		updated.Count = i.Count
+		updated.Version = uuid.New()
+		t.items[i.ID] = &updated
+		return nil
+	})
+}
+
+func (r *ItemRepository) GetByID(ctx context.Context, itemID uuid.UUID) (*itemDomain.Item, error) {
+	var i *itemDomain.Item
+	err := r.store.run(r.tx, func(t *tables) error {
+		stored, ok := t.items[itemID]
+		if !ok {
+			return itemRepository.ErrItemNotFound
+		}
+		i = toItem(t, stored)
+		return nil
+	})
+	return i, err
+}
+
+func (r *ItemRepository) GetAll(ctx context.Context) ([]*itemDomain.Item, error) {
+	return r.filter(func(*item) bool { return true }), nil
+}
+
+// GetAllByProductIDs fails with ErrItemsNotFound unless it finds exactly as
+// many items as product IDs were given.
+func (r *ItemRepository) GetAllByProductIDs(ctx context.Context, productIDs ...uuid.UUID) ([]*itemDomain.Item, error) {
+	items := r.filter(func(i *item) bool { return slices.Contains(productIDs, i.ProductID) })
+	if len(items) != len(productIDs) {
+		return nil, itemRepository.ErrItemsNotFound
+	}
+	return items, nil
+}
+
+// filter returns the items that match, ordered by ID.
+func (r *ItemRepository) filter(match func(*item) bool) []*itemDomain.Item {
+	items := make([]*itemDomain.Item, 0)
+	_ = r.store.run(r.tx, func(t *tables) error {
+		for _, stored := range t.items {
+			if match(stored) {
+				items = append(items, toItem(t, stored))
+			}
+		}
+		return nil
+	})
+	slices.SortFunc(items, func(a, b *itemDomain.Item) int {
+		return cmp.Compare(a.ID.String(), b.ID.String())
+	})
+	return items
+}
+
+func toItem(t *tables, stored *item) *itemDomain.Item {
+	return &itemDomain.Item{
+		ID:      stored.ID,
+		Count:   stored.Count,
+		Version: stored.Version,
+		Product: cloneProduct(t.products[stored.ProductID]),
+	}
+}
+
+var _ itemDomain.Repository = (*ItemRepository)(nil)
@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"slices"
+	outboxDomain "warehouse/internal/domain/outbox"
+	outboxRepository "warehouse/internal/infrastructure/repository/outbox"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// OutboxRepository keeps the outbox messages in a Store, in the order they
+// were created.
+type OutboxRepository struct {
+	store *Store
+	tx    *tables
+}
+
+func NewOutboxRepository(store *Store) *OutboxRepository {
+	return &OutboxRepository{store: store}
+}
+
+// Create stores the message with the trace context of ctx in its metadata,
+// as the PostgreSQL repository does.
+func (r *OutboxRepository) Create(ctx context.Context, message *outboxDomain.Message) error {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	if message.Metadata == nil {
+		message.Metadata = make(map[string]any, len(carrier))
+	}
+	for k, v := range carrier {
+		message.Metadata[k] = v
+	}
+
+	return r.store.run(r.tx, func(t *tables) error {
+		if slices.ContainsFunc(t.outbox, func(m *outboxDomain.Message) bool { return m.ID == message.ID }) {
+			return outboxRepository.ErrOutboxMessageAlreadyExists
+		}
+		t.outbox = append(t.outbox, cloneOutboxMessage(message))
+		return nil
+	})
+}
+
+func (r *OutboxRepository) GetByID(ctx context.Context, messageID uuid.UUID) (*outboxDomain.Message, error) {
+	var message *outboxDomain.Message
+	err := r.store.run(r.tx, func(t *tables) error {
+		i := slices.IndexFunc(t.outbox, func(m *outboxDomain.Message) bool { return m.ID == messageID })
+		if i < 0 {
+			return outboxRepository.ErrOutboxMessageNotFound
+		}
+		message = cloneOutboxMessage(t.outbox[i])
+		return nil
+	})
+	return message, err
+}
+
+func (r *OutboxRepository) GetAll(ctx context.Context) ([]*outboxDomain.Message, error) {
+	var messages []*outboxDomain.Message
+	_ = r.store.run(r.tx, func(t *tables) error {
+		messages = make([]*outboxDomain.Message, 0, len(t.outbox))
+		for _, message := range t.outbox {
+			messages = append(messages, cloneOutboxMessage(message))
+		}
+		return nil
+	})
+	return messages, nil
+}
+
+func (r *OutboxRepository) Delete(ctx context.Context, message *outboxDomain.Message) error {
+	return r.store.run(r.tx, func(t *tables) error {
+		i := slices.IndexFunc(t.outbox, func(m *outboxDomain.Message) bool { return m.ID == message.ID })
+		if i < 0 {
+			return outboxRepository.ErrOutboxMessageNotFound
+		}
+		t.outbox = slices.Delete(t.outbox, i, i+1)
+		return nil
+	})
+}
+
+func cloneOutboxMessage(message *outboxDomain.Message) *outboxDomain.Message {
+	return &outboxDomain.Message{
+		ID:       message.ID,
+		Name:     message.Name,
+		Payload:  slices.Clone(message.Payload),
+		Metadata: maps.Clone(message.Metadata),
+	}
+}
+
+var _ outboxDomain.Repository = (*OutboxRepository)(nil)
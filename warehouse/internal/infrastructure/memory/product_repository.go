@@ -0,0 +1,99 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	productDomain "warehouse/internal/domain/product"
+	productRepository "warehouse/internal/infrastructure/repository/product"
+
+	"github.com/google/uuid"
+)
+
+// ProductRepository keeps the products in a Store. It returns the errors of
+// the PostgreSQL repository.
+type ProductRepository struct {
+	store *Store
+	tx    *tables
+}
+
+func NewProductRepository(store *Store) *ProductRepository {
+	return &ProductRepository{store: store}
+}
+
+func (r *ProductRepository) Create(ctx context.Context, product *productDomain.Product) error {
+	return r.store.run(r.tx, func(t *tables) error {
+		if _, ok := t.products[product.ID]; ok {
+			return productRepository.ErrProductAlreadyExists
+		}
+		stored := *product
+		t.products[product.ID] = &stored
+		return nil
+	})
+}
+
+func (r *ProductRepository) GetByID(ctx context.Context, productID uuid.UUID) (*productDomain.Product, error) {
+	var product *productDomain.Product
+	err := r.store.run(r.tx, func(t *tables) error {
+		stored, ok := t.products[productID]
+		if !ok {
+			return productRepository.ErrProductNotFound
+		}
+		product = cloneProduct(stored)
+		return nil
+	})
+	return product, err
+}
+
+// GetAll pages through the products oldest first.
+func (r *ProductRepository) GetAll(ctx context.Context, limit, offset int) ([]*productDomain.Product, error) {
+	var products []*productDomain.Product
+	_ = r.store.run(r.tx, func(t *tables) error {
+		products = sortedProducts(t)
+		return nil
+	})
+
+	products = products[min(max(offset, 0), len(products)):]
+	if limit >= 0 {
+		products = products[:min(limit, len(products))]
+	}
+	return products, nil
+}
+
+func (r *ProductRepository) GetAllByIDs(ctx context.Context, productIDs ...uuid.UUID) ([]*productDomain.Product, error) {
+	var products []*productDomain.Product
+	_ = r.store.run(r.tx, func(t *tables) error {
+		for _, product := range sortedProducts(t) {
+			if slices.Contains(productIDs, product.ID) {
+				products = append(products, product)
+			}
+		}
+		return nil
+	})
+	if products == nil {
+		products = []*productDomain.Product{}
+	}
+	return products, nil
+}
+
+// sortedProducts returns copies of all products, ordered by creation.
+func sortedProducts(t *tables) []*productDomain.Product {
+	products := make([]*productDomain.Product, 0, len(t.products))
+	for _, product := range t.products {
+		products = append(products, cloneProduct(product))
+	}
+	slices.SortFunc(products, func(a, b *productDomain.Product) int {
+		if c := a.Created.Compare(b.Created); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID.String(), b.ID.String())
+	})
+	return products
+}
+
+func cloneProduct(product *productDomain.Product) *productDomain.Product {
+	c := *product
+	return &c
+}
+
+var _ productDomain.Repository = (*ProductRepository)(nil)
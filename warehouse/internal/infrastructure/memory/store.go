@@ -0,0 +1,92 @@
+package memory
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	inboxDomain "warehouse/internal/domain/inbox"
+	outboxDomain "warehouse/internal/domain/outbox"
+	productDomain "warehouse/internal/domain/product"
+
+	"github.com/google/uuid"
+)
+
+// item is a stored item. Like the items table, it refers to its product by ID.
+type item struct {
+	ID        uuid.UUID
+	ProductID uuid.UUID
+	Count     int
+	Version   uuid.UUID
+}
+
+// tables holds what the store keeps. Stored values are never changed in
+// place, so a shallow copy of the tables is a snapshot.
+type tables struct {
+	products map[uuid.UUID]*productDomain.Product
+	items    map[uuid.UUID]*item
+	// outbox keeps the messages in the order they were created.
+	outbox []*outboxDomain.Message
+	inbox  map[uuid.UUID]*inboxDomain.Message
+}
+
+func newTables() *tables {
+	return &tables{
+		products: make(map[uuid.UUID]*productDomain.Product),
+		items:    make(map[uuid.UUID]*item),
+		outbox:   make([]*outboxDomain.Message, 0),
+		inbox:    make(map[uuid.UUID]*inboxDomain.Message),
+	}
+}
+
+func (t *tables) clone() *tables {
+	return &tables{
+		products: maps.Clone(t.products),
+		items:    maps.Clone(t.items),
+		outbox:   slices.Clone(t.outbox),
+		inbox:    maps.Clone(t.inbox),
+	}
+}
+
+// Store keeps the data of the service in the process. It is safe for
+// concurrent use; a transaction holds the store until it ends.
+type Store struct {
+	mu     sync.Mutex
+	tables *tables
+}
+
+func NewStore() *Store {
+	return &Store{tables: newTables()}
+}
+
+// Clear removes everything the store keeps.
+func (s *Store) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tables = newTables()
+}
+
+// run calls fn with the tables of tx, or with the committed tables, holding
+// the store, if tx is nil.
+func (s *Store) run(tx *tables, fn func(t *tables) error) error {
+	if tx != nil {
+		return fn(tx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(s.tables)
+}
+
+// transaction runs fn on a copy of the tables and keeps the copy if fn
+// succeeds.
+func (s *Store) transaction(fn func(tx *tables) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx := s.tables.clone()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	s.tables = tx
+	return nil
+}
@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"context"
+	inboxDomain "warehouse/internal/domain/inbox"
+	itemDomain "warehouse/internal/domain/item"
+	outboxDomain "warehouse/internal/domain/outbox"
+	productDomain "warehouse/internal/domain/product"
+	"warehouse/internal/domain/uow"
+)
+
+type UoW struct {
+	productRepository *ProductRepository
+	itemRepository    *ItemRepository
+	outboxRepository  *OutboxRepository
+	inboxRepository   *InboxRepository
+
+	store *Store
+	tx    *tables
+}
+
+func NewUoW(store *Store) uow.UoW {
+	return newUoW(store, nil)
+}
+
+func newUoW(store *Store, tx *tables) *UoW {
+	return &UoW{
+		productRepository: &ProductRepository{store: store, tx: tx},
+		itemRepository:    &ItemRepository{store: store, tx: tx},
+		outboxRepository:  &OutboxRepository{store: store, tx: tx},
+		inboxRepository:   &InboxRepository{store: store, tx: tx},
+		store:             store,
+		tx:                tx,
+	}
+}
+
+// Transaction runs fn on a copy of the store that replaces it if fn succeeds.
+// Other callers wait until the transaction ends. A transaction started from
+// the UoW fn gets works on a copy of the running one in turn, so that, like a
+// PostgreSQL savepoint, it rolls back alone when its fn fails.
+func (u *UoW) Transaction(ctx context.Context, fn func(uow.UoW) error) error {
+	if u.tx != nil {
+		tx := u.tx.clone()
+		if err := fn(newUoW(u.store, tx)); err != nil {
+			return err
+		}
+		*u.tx = *tx
+		return nil
+	}
+
+	return u.store.transaction(func(tx *tables) error {
+		return fn(newUoW(u.store, tx))
+	})
+}
+
+func (u *UoW) Product() productDomain.Repository {
+	return u.productRepository
+}
+
+func (u *UoW) Item() itemDomain.Repository {
+	return u.itemRepository
+}
+
+func (u *UoW) Outbox() outboxDomain.Repository {
+	return u.outboxRepository
+}
+
+func (u *UoW) Inbox() inboxDomain.Repository {
+	return u.inboxRepository
+}
+
+var _ uow.UoW = (*UoW)(nil)
@@ -7,11 +7,13 @@ import (
 )
 
 type Config struct {
-	Address string `envconfig:"KAFKA_ADDRESS" required:"true"`
+	// Address is only needed when the messages go through Kafka.
+	Address string `envconfig:"KAFKA_ADDRESS"`
 
 	WarehouseCmdTopic           string `envconfig:"KAFKA_WAREHOUSE_COMMAND_TOPIC" required:"true"`
 	WarehouseCmdResTopic        string `envconfig:"KAFKA_WAREHOUSE_COMMAND_RESULT_TOPIC" required:"true"`
 	WarehouseCmdConsumerGroupID string `envconfig:"KAFKA_WAREHOUSE_COMMAND_CONSUMER_GROUP_ID" required:"true"`
+	WarehouseCmdDLQTopic        string `envconfig:"KAFKA_WAREHOUSE_COMMAND_DLQ_TOPIC" required:"true"`
 
 	ProductEventTopic           string `envconfig:"KAFKA_PRODUCT_EVENT_TOPIC" required:"true"`
 	ProductEventConsumerGroupID string `envconfig:"KAFKA_PRODUCT_EVENT_CONSUMER_GROUP_ID" required:"true"`
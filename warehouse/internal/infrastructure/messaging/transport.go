@@ -0,0 +1,33 @@
+package messaging
+
+import (
+	"context"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// Reader consumes one topic as a member of a consumer group.
+type Reader interface {
+	FetchMessage(ctx context.Context, msg *kafka.Message) error
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Topic() string
+	// Propagator extracts the trace context the writer put in the message headers.
+	Propagator() propagation.TextMapPropagator
+	Close() error
+}
+
+// Writer produces messages to one topic.
+type Writer interface {
+	WriteMessage(ctx context.Context, msg kafka.Message) error
+	Topic() string
+	Propagator() propagation.TextMapPropagator
+	Close() error
+}
+
+// Transport opens readers and writers on a message broker: Kafka, or the
+// in-memory broker when the service runs without one.
+type Transport interface {
+	NewReader(topic, groupID string) (Reader, error)
+	NewWriter(topic string) (Writer, error)
+}
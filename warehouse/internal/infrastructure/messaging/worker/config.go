@@ -0,0 +1,19 @@
+package worker
+
+import (
+	"fmt"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Config struct {
+	Concurrency int `envconfig:"KAFKA_CONSUMER_CONCURRENCY" required:"true"`
+}
+
+func NewConfig() (*Config, error) {
+	var cfg Config
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, fmt.Errorf("failed to load kafka consumer worker config: %w", err)
+	}
+	return &cfg, nil
+}
@@ -1,44 +1,13 @@
 package messaging
 
-import (
-	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
-	"github.com/segmentio/kafka-go"
-	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/propagation"
-	sdktrace "go.opentelemetry.io/otel/sdk/trace"
-	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
-)
+func NewWarehouseCmdResWriter(config *Config, transport Transport) (Writer, error) {
+	return transport.NewWriter(config.WarehouseCmdResTopic)
+}
 
-func NewWarehouseCmdResWriter(config *Config, tp *sdktrace.TracerProvider) (*otelkafkakonsumer.Writer, error) {
-	return otelkafkakonsumer.NewWriter(
-		&kafka.Writer{
-			Addr:  kafka.TCP(config.Address),
-			Topic: config.WarehouseCmdResTopic,
-		},
-		otelkafkakonsumer.WithTracerProvider(tp),
-		otelkafkakonsumer.WithPropagator(propagation.TraceContext{}),
-		otelkafkakonsumer.WithAttributes(
-			[]attribute.KeyValue{
-				semconv.MessagingDestinationKindTopic,
-				semconv.MessagingKafkaClientIDKey.String(config.WarehouseCmdResTopic),
-			},
-		),
-	)
+func NewProductEventWriter(config *Config, transport Transport) (Writer, error) {
+	return transport.NewWriter(config.ProductEventTopic)
 }
 
-func NewProductEventWriter(config *Config, tp *sdktrace.TracerProvider) (*otelkafkakonsumer.Writer, error) {
-	return otelkafkakonsumer.NewWriter(
-		&kafka.Writer{
-			Addr:  kafka.TCP(config.Address),
-			Topic: config.ProductEventTopic,
-		},
-		otelkafkakonsumer.WithTracerProvider(tp),
-		otelkafkakonsumer.WithPropagator(propagation.TraceContext{}),
-		otelkafkakonsumer.WithAttributes(
-			[]attribute.KeyValue{
-				semconv.MessagingDestinationKindTopic,
-				semconv.MessagingKafkaClientIDKey.String(config.ProductEventTopic),
-			},
-		),
-	)
+func NewWarehouseCmdDLQWriter(config *Config, transport Transport) (Writer, error) {
+	return transport.NewWriter(config.WarehouseCmdDLQTopic)
 }
@@ -3,17 +3,17 @@ package outbox
 import (
 	"context"
 	"encoding/json"
-	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
 	"github.com/segmentio/kafka-go"
 	outboxDomain "warehouse/internal/domain/outbox"
 	productDomain "warehouse/internal/domain/product"
+	"warehouse/internal/infrastructure/messaging"
 )
 
 type PublisherImpl struct {
-	productWriter *otelkafkakonsumer.Writer
+	productWriter messaging.Writer
 }
 
-func NewPublisher(productWriter *otelkafkakonsumer.Writer) *PublisherImpl {
+func NewPublisher(productWriter messaging.Writer) *PublisherImpl {
 	return &PublisherImpl{productWriter: productWriter}
 }
 
@@ -25,7 +25,7 @@ func (p *PublisherImpl) Publish(ctx context.Context, message *outboxDomain.Messa
 	return publishMessage(ctx, writer, message)
 }
 
-func (p *PublisherImpl) getWriterByMessage(message *outboxDomain.Message) (*otelkafkakonsumer.Writer, error) {
+func (p *PublisherImpl) getWriterByMessage(message *outboxDomain.Message) (messaging.Writer, error) {
 	switch message.Name {
 	case productDomain.CreatedEventName:
 		return p.productWriter, nil
@@ -48,7 +48,7 @@ func encodeMessage(message *outboxDomain.Message) ([]byte, error) {
 	return buf, nil
 }
 
-func publishMessage(ctx context.Context, writer *otelkafkakonsumer.Writer, message *outboxDomain.Message) error {
+func publishMessage(ctx context.Context, writer messaging.Writer, message *outboxDomain.Message) error {
 	value, err := encodeMessage(message)
 	if err != nil {
 		return err
@@ -0,0 +1,24 @@
+package inbox
+
+import (
+	inboxDomain "warehouse/internal/domain/inbox"
+	"warehouse/internal/infrastructure/db/tables"
+)
+
+func ToDomain(model *tables.InboxMessage) *inboxDomain.Message {
+	return &inboxDomain.Message{
+		ID:        model.ID,
+		Response:  model.Response,
+		Processed: model.Processed,
+		Expires:   model.Expires,
+	}
+}
+
+func ToModel(domain *inboxDomain.Message) *tables.InboxMessage {
+	return &tables.InboxMessage{
+		ID:        domain.ID,
+		Response:  domain.Response,
+		Processed: domain.Processed,
+		Expires:   domain.Expires,
+	}
+}
@@ -0,0 +1,39 @@
+package inbox
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+	inboxDomain "warehouse/internal/domain/inbox"
+	"warehouse/internal/infrastructure/db/tables"
+)
+
+type RepositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) *RepositoryImpl {
+	return &RepositoryImpl{db: db}
+}
+
+func (r *RepositoryImpl) Create(ctx context.Context, message *inboxDomain.Message) error {
+	res := r.db.WithContext(ctx).Create(ToModel(message))
+	return ParseError(res.Error)
+}
+
+func (r *RepositoryImpl) GetByID(ctx context.Context, messageID uuid.UUID) (*inboxDomain.Message, error) {
+	var model tables.InboxMessage
+	res := r.db.WithContext(ctx).First(&model, "id = ?", messageID)
+	if res.Error != nil {
+		return nil, ParseError(res.Error)
+	}
+	return ToDomain(&model), nil
+}
+
+func (r *RepositoryImpl) DeleteExpired(ctx context.Context, now time.Time) error {
+	res := r.db.WithContext(ctx).Delete(&tables.InboxMessage{}, "expires <= ?", now)
+	return ParseError(res.Error)
+}
+
+var _ inboxDomain.Repository = (*RepositoryImpl)(nil)
@@ -11,7 +11,7 @@ func ToDomain(model *tables.Product) *productDomain.Product {
 	return &productDomain.Product{
 		ID:      model.ID,
 		Name:    model.Name,
-		Price:   model.Price,
+		Price:   productDomain.Money{Amount: model.Price, Currency: model.Currency},
 		Created: model.Created,
 		Image:   toProductImageDomain(model.Image),
 	}
@@ -33,11 +33,12 @@ func toProductImageDomain(model tables.ProductImage) productDomain.Image {
 
 func ToModel(domain *productDomain.Product) tables.Product {
 	return tables.Product{
-		ID:      domain.ID,
-		Name:    domain.Name,
-		Price:   domain.Price,
-		Created: domain.Created,
-		Image:   toProductImageModel(domain.ID, domain.Image),
+		ID:       domain.ID,
+		Name:     domain.Name,
+		Price:    domain.Price.Amount,
+		Currency: domain.Price.Currency,
+		Created:  domain.Created,
+		Image:    toProductImageModel(domain.ID, domain.Image),
 	}
 }
 
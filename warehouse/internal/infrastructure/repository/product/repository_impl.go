@@ -51,4 +51,15 @@ func (r *RepositoryImpl) GetAll(ctx context.Context, limit, offset int) ([]*prod
 	return ToDomains(productModels), nil
 }
 
+func (r *RepositoryImpl) GetAllByIDs(ctx context.Context, productIDs ...uuid.UUID) ([]*productDomain.Product, error) {
+	var productModels []*tables.Product
+
+	res := r.db.WithContext(ctx).Find(&productModels, "id IN (?)", productIDs)
+	if res.Error != nil {
+		return nil, ParseError(res.Error)
+	}
+
+	return ToDomains(productModels), nil
+}
+
 var _ productDomain.Repository = (*RepositoryImpl)(nil)
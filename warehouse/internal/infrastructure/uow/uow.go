@@ -2,10 +2,12 @@ package uow
 
 import (
 	"context"
+	inboxDomain "warehouse/internal/domain/inbox"
 	itemDomain "warehouse/internal/domain/item"
 	outboxDomain "warehouse/internal/domain/outbox"
 	productDomain "warehouse/internal/domain/product"
 	"warehouse/internal/domain/uow"
+	inboxRepository "warehouse/internal/infrastructure/repository/inbox"
 	itemRepository "warehouse/internal/infrastructure/repository/item"
 	outboxRepository "warehouse/internal/infrastructure/repository/outbox"
 	productRepository "warehouse/internal/infrastructure/repository/product"
@@ -17,6 +19,7 @@ type UoWImpl struct {
 	productRepository productDomain.Repository
 	itemRepository    itemDomain.Repository
 	outboxRepository  outboxDomain.Repository
+	inboxRepository   inboxDomain.Repository
 
 	db *gorm.DB
 }
@@ -26,10 +29,14 @@ func New(db *gorm.DB) uow.UoW {
 		productRepository: productRepository.New(db),
 		itemRepository:    itemRepository.New(db),
 		outboxRepository:  outboxRepository.New(db),
+		inboxRepository:   inboxRepository.New(db),
 		db:                db,
 	}
 }
 
+// Transaction runs fn in a transaction of the database. Started from the UoW
+// fn gets, it runs in a savepoint of the enclosing transaction instead, which
+// rolls back alone when fn fails.
 func (u *UoWImpl) Transaction(ctx context.Context, fn func(uow.UoW) error) error {
 	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txManager := New(tx)
@@ -49,4 +56,8 @@ func (u *UoWImpl) Outbox() outboxDomain.Repository {
 	return u.outboxRepository
 }
 
+func (u *UoWImpl) Inbox() inboxDomain.Repository {
+	return u.inboxRepository
+}
+
 var _ uow.UoW = (*UoWImpl)(nil)
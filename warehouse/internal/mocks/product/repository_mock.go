@@ -26,4 +26,15 @@ func (r *RepositoryMock) GetAll(ctx context.Context, limit int, offset int) ([]*
 	return args.Get(0).([]*productDomain.Product), args.Error(1)
 }
 
+func (r *RepositoryMock) GetAllByIDs(ctx context.Context, productIDs ...uuid.UUID) ([]*productDomain.Product, error) {
+	argsForCalled := make([]interface{}, 0, len(productIDs)+1)
+	argsForCalled = append(argsForCalled, ctx)
+	for _, id := range productIDs {
+		argsForCalled = append(argsForCalled, id)
+	}
+
+	args := r.Called(argsForCalled...)
+	return args.Get(0).([]*productDomain.Product), args.Error(1)
+}
+
 var _ productDomain.Repository = (*RepositoryMock)(nil)
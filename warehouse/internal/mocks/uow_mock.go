@@ -4,10 +4,12 @@ import (
 	"context"
 	"github.com/stretchr/testify/mock"
 	"testing"
+	inboxDomain "warehouse/internal/domain/inbox"
 	itemDomain "warehouse/internal/domain/item"
 	outboxDomain "warehouse/internal/domain/outbox"
 	productDomain "warehouse/internal/domain/product"
 	"warehouse/internal/domain/uow"
+	inboxMock "warehouse/internal/mocks/inbox"
 	itemMock "warehouse/internal/mocks/item"
 	outboxMock "warehouse/internal/mocks/outbox"
 	productMock "warehouse/internal/mocks/product"
@@ -17,6 +19,7 @@ type UoWMock struct {
 	ProductMock *productMock.RepositoryMock
 	ItemMock    *itemMock.RepositoryMock
 	OutboxMock  *outboxMock.RepositoryMock
+	InboxMock   *inboxMock.RepositoryMock
 
 	mock.Mock
 }
@@ -25,10 +28,12 @@ func NewUowMock() *UoWMock {
 	product := &productMock.RepositoryMock{}
 	item := &itemMock.RepositoryMock{}
 	outbox := &outboxMock.RepositoryMock{}
+	inbox := &inboxMock.RepositoryMock{}
 	return &UoWMock{
 		ProductMock: product,
 		ItemMock:    item,
 		OutboxMock:  outbox,
+		InboxMock:   inbox,
 	}
 }
 
@@ -41,6 +46,9 @@ func (u *UoWMock) Item() itemDomain.Repository {
 func (u *UoWMock) Outbox() outboxDomain.Repository {
 	return u.OutboxMock
 }
+func (u *UoWMock) Inbox() inboxDomain.Repository {
+	return u.InboxMock
+}
 
 func (u *UoWMock) Transaction(ctx context.Context, fn func(u uow.UoW) error) error {
 	args := u.Called(ctx, fn)
@@ -54,6 +62,7 @@ func (u *UoWMock) AssertExpectations(t *testing.T) {
 	u.ProductMock.AssertExpectations(t)
 	u.ItemMock.AssertExpectations(t)
 	u.OutboxMock.AssertExpectations(t)
+	u.InboxMock.AssertExpectations(t)
 	u.Mock.AssertExpectations(t)
 }
 
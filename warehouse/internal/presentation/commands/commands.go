@@ -6,11 +6,15 @@ import (
 	itemApplication "warehouse/internal/application/item"
 
 	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
 )
 
 const (
 	ReserveItemsCmdName CmdMessageName = "create_order.reserve_items"
 	ReleaseItemsCmdName CmdMessageName = "create_order.release_items"
+
+	// CancelOrderReleaseItemsCmdName releases the items of an order the customer canceled.
+	CancelOrderReleaseItemsCmdName CmdMessageName = "cancel_order.release_items"
 )
 
 type (
@@ -27,12 +31,16 @@ type (
 		Msg       *CmdMessage
 		Topic     string
 		Partition int
+		Raw       *kafka.Message
 	}
 )
 
 type ReserveItemsCmd struct {
 	OrderID uuid.UUID
 	Items   []itemApplication.ItemDto
+	// Policy is missing from commands sent before orders could be partially
+	// fulfilled; those are reserved all or nothing.
+	Policy itemApplication.ReservePolicy
 }
 
 type ReleaseItemsCmd struct {
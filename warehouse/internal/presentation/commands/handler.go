@@ -3,62 +3,98 @@ package commands
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	itemApplication "warehouse/internal/application/item"
+	itemDomain "warehouse/internal/domain/item"
+	"warehouse/internal/domain/uow"
+	"warehouse/internal/infrastructure/messaging/retry"
+	itemRepository "warehouse/internal/infrastructure/repository/item"
 )
 
+// Handler handles a command within tx, the transaction the processor records
+// the command in.
 type Handler interface {
-	Handle(ctx context.Context, cmdMsg *CmdMessage) (*ResMessage, error)
+	Handle(ctx context.Context, tx uow.UoW, cmdMsg *CmdMessage) (*ResMessage, error)
 }
 
 type HandlerImpl struct {
-	usecase itemApplication.UseCase
+	newUseCase func(uow.UoW) itemApplication.UseCase
 }
 
-func NewHandler(usecase itemApplication.UseCase) *HandlerImpl {
-	return &HandlerImpl{usecase: usecase}
+// NewHandler builds the handler from newUseCase, which it calls with the
+// transaction of each command.
+func NewHandler(newUseCase func(uow.UoW) itemApplication.UseCase) *HandlerImpl {
+	return &HandlerImpl{newUseCase: newUseCase}
 }
 
-func (h *HandlerImpl) Handle(ctx context.Context, cmdMsg *CmdMessage) (*ResMessage, error) {
+func (h *HandlerImpl) Handle(ctx context.Context, tx uow.UoW, cmdMsg *CmdMessage) (*ResMessage, error) {
+	usecase := h.newUseCase(tx)
+
 	switch cmdMsg.Name {
 	case ReserveItemsCmdName:
 		var cmd ReserveItemsCmd
 		if err := json.Unmarshal(cmdMsg.Payload, &cmd); err != nil {
-			return nil, fmt.Errorf("failed to parse ReserveItemsCmd: %w", err)
+			return nil, retry.Permanent(fmt.Errorf("failed to parse ReserveItemsCmd: %w", err))
 		}
-		return h.onReserveItems(ctx, cmd), nil
+		return h.onReserveItems(ctx, usecase, cmd)
 
-	case ReleaseItemsCmdName:
+	case ReleaseItemsCmdName, CancelOrderReleaseItemsCmdName:
 		var cmd ReleaseItemsCmd
 		if err := json.Unmarshal(cmdMsg.Payload, &cmd); err != nil {
-			return nil, fmt.Errorf("failed to parse ReleaseItemsCmd: %w", err)
+			return nil, retry.Permanent(fmt.Errorf("failed to parse ReleaseItemsCmd: %w", err))
 		}
-		return h.onReleaseItems(ctx, cmd), nil
+		return h.onReleaseItems(ctx, usecase, cmd)
 	}
 
-	return nil, fmt.Errorf("unknown command: %s", cmdMsg.Name)
+	return nil, retry.Permanent(fmt.Errorf("unknown command: %s", cmdMsg.Name))
 }
 
-func (h *HandlerImpl) onReserveItems(ctx context.Context, cmd ReserveItemsCmd) *ResMessage {
+// onReserveItems replies that the reservation failed when the stock refuses
+// it; other errors are returned to be retried.
+func (h *HandlerImpl) onReserveItems(ctx context.Context, usecase itemApplication.UseCase, cmd ReserveItemsCmd) (*ResMessage, error) {
 	data := toReserveItemsDto(cmd)
 
-	err := h.usecase.Reserve(ctx, data)
+	reserved, err := usecase.Reserve(ctx, data)
 
+	if rejected(err) {
+		return toItemsReservationFailed(cmd.OrderID), nil
+	}
 	if err != nil {
-		return toItemsReservationFailed(cmd.OrderID)
+		return nil, err
 	}
-	return toItemsReserved(cmd.OrderID)
+	return toItemsReserved(cmd.OrderID, reserved), nil
 }
 
-func (h *HandlerImpl) onReleaseItems(ctx context.Context, cmd ReleaseItemsCmd) *ResMessage {
+// onReleaseItems returns the errors of a release the stock refuses as
+// permanent, since handling the command again would not change the answer.
+func (h *HandlerImpl) onReleaseItems(ctx context.Context, usecase itemApplication.UseCase, cmd ReleaseItemsCmd) (*ResMessage, error) {
 	data := toReleaseItemsDto(cmd)
 
-	err := h.usecase.Release(ctx, data)
+	err := usecase.Release(ctx, data)
 
+	if rejected(err) {
+		return nil, retry.Permanent(err)
+	}
 	if err != nil {
-		return nil
+		return nil, err
 	}
-	return toItemsReleased(cmd.OrderID)
+	return toItemsReleased(cmd.OrderID), nil
+}
+
+// rejected reports whether the stock refuses the command: an item is out of
+// stock, missing or asked for in an invalid count. ErrItemNotFound is not
+// among them, since the item repository also reports a lost race on the item
+// version with it.
+func rejected(err error) bool {
+	return errors.Is(err, itemDomain.ErrOutOfStock) ||
+		errors.Is(err, itemDomain.ErrInvalidItemCount) ||
+		errors.Is(err, itemRepository.ErrItemsNotFound)
+}
+
+// IsRetryable reports whether a failed command may succeed when handled again.
+func IsRetryable(err error) bool {
+	return !retry.IsPermanent(err)
 }
 
 var _ Handler = (*HandlerImpl)(nil)
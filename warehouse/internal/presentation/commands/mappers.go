@@ -8,7 +8,8 @@ import (
 
 func toReserveItemsDto(cmd ReserveItemsCmd) itemApplication.ReserveDto {
 	return itemApplication.ReserveDto{
-		Items: cmd.Items,
+		Items:  cmd.Items,
+		Policy: cmd.Policy,
 	}
 }
 
@@ -18,9 +19,10 @@ func toReleaseItemsDto(cmd ReleaseItemsCmd) itemApplication.ReleaseDto {
 	}
 }
 
-func toItemsReserved(orderID uuid.UUID) *ResMessage {
+func toItemsReserved(orderID uuid.UUID, items []itemApplication.ItemDto) *ResMessage {
 	return newResMessage(ItemsReservedName, ItemsReserved{
 		OrderID: orderID,
+		Items:   items,
 	})
 }
 
@@ -2,14 +2,23 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
 	"go.opentelemetry.io/otel/trace"
 	"sync"
 	"time"
+	inboxDomain "warehouse/internal/domain/inbox"
+	"warehouse/internal/domain/uow"
+	inboxConfig "warehouse/internal/infrastructure/inbox"
 	"warehouse/internal/infrastructure/logger"
+	"warehouse/internal/infrastructure/messaging/dlq"
+	"warehouse/internal/infrastructure/messaging/retry"
+	"warehouse/internal/infrastructure/messaging/worker"
+	inboxRepository "warehouse/internal/infrastructure/repository/inbox"
 )
 
 type Processor struct {
@@ -17,6 +26,13 @@ type Processor struct {
 	reader  Reader
 	writer  Writer
 
+	uow      uow.UoW
+	inboxCfg *inboxConfig.Config
+
+	retry     *retry.Policy
+	pool      *worker.Pool
+	dlqWriter dlq.Writer
+
 	cancelCtx  context.Context
 	cancelFunc context.CancelFunc
 
@@ -27,12 +43,27 @@ type Processor struct {
 	logger logger.Logger
 }
 
-func NewProcessor(handler Handler, reader Reader, writer Writer, logger logger.Logger) *Processor {
+func NewProcessor(
+	handler Handler,
+	reader Reader,
+	writer Writer,
+	uow uow.UoW,
+	inboxCfg *inboxConfig.Config,
+	retryCfg *retry.Config,
+	workerCfg *worker.Config,
+	dlqWriter dlq.Writer,
+	logger logger.Logger,
+) *Processor {
 	return &Processor{
-		handler: handler,
-		reader:  reader,
-		writer:  writer,
-		logger:  logger,
+		handler:   handler,
+		reader:    reader,
+		writer:    writer,
+		uow:       uow,
+		inboxCfg:  inboxCfg,
+		retry:     retry.NewPolicy(retryCfg, IsRetryable),
+		pool:      worker.NewPool(workerCfg),
+		dlqWriter: dlqWriter,
+		logger:    logger,
 	}
 }
 
@@ -60,6 +91,7 @@ func (p *Processor) Start(ctx context.Context) error {
 	p.started = true
 
 	p.log(logger.Info, "start", "Starting command processor", nil)
+	p.pool.Start()
 	p.wg.Add(1)
 	go p.processCommands(p.cancelCtx)
 	return nil
@@ -85,42 +117,145 @@ func (p *Processor) processCommands(ctx context.Context) {
 				continue
 			}
 
-			// Handle the command
-			sCtx, span := startProcessSpan(cmd)
-			startTime := time.Now()
+			// Handle the command on the worker owning its key and commit its offset once it is
+			// handled or dead-lettered; otherwise it is redelivered after a restart
+			p.pool.Submit(ctx, cmd.Raw, func() {
+				if p.processCommand(cmd) {
+					p.reader.Commit(cmd)
+				}
+			})
+		}
+	}
+}
 
-			res, err := p.handler.Handle(sCtx, cmd.Msg)
+// processCommand reports whether the command is done with: handled, or moved to the DLQ
+// once its attempts ran out.
+func (p *Processor) processCommand(cmd *CmdEnvelope) bool {
+	// Handle the command, retrying transient failures
+	sCtx, span := startProcessSpan(cmd)
+	startTime := time.Now()
 
-			duration := time.Since(startTime)
-			span.End()
+	var res *ResMessage
+	var processed *inboxDomain.Message
+	attempts, err := p.retry.Do(sCtx, func(ctx context.Context) error {
+		var err error
+		res, processed, err = p.handle(ctx, cmd)
+		return err
+	})
 
-			if err != nil {
-				p.log(logger.Error, "process_error", "Command processing failed", map[string]any{
-					"command_id":  cmd.Msg.ID,
-					"error":       err.Error(),
-					"duration_ms": duration.Milliseconds(),
-				})
-				continue
-			}
+	duration := time.Since(startTime)
+	span.End()
+
+	if err != nil {
+		p.log(logger.Error, "process_error", "Command processing failed", map[string]any{
+			"command_id":  cmd.Msg.ID,
+			"error":       err.Error(),
+			"attempts":    attempts,
+			"duration_ms": duration.Milliseconds(),
+		})
+		return p.deadLetter(cmd, err, attempts)
+	}
 
-			p.log(logger.Info, "process_success", "Command processed successfully", map[string]any{
-				"command_id":   cmd.Msg.ID,
-				"duration_ms":  duration.Milliseconds(),
-				"has_response": res != nil,
+	// Replay the stored response for an already processed command
+	if processed != nil {
+		p.replay(cmd, processed)
+		return true
+	}
+
+	p.log(logger.Info, "process_success", "Command processed successfully", map[string]any{
+		"command_id":   cmd.Msg.ID,
+		"duration_ms":  duration.Milliseconds(),
+		"has_response": res != nil,
+	})
+
+	// Write the response
+	if res != nil {
+		if err := p.writer.Write(cmd.Ctx, cmd.Raw.Key, res); err != nil {
+			p.log(logger.Error, "write_error", "Error sending response", map[string]any{
+				"command_id":  cmd.Msg.ID,
+				"response_id": res.ID,
+				"error":       err.Error(),
 			})
+		}
+	}
+	return true
+}
 
-			// Write the response
-			if res != nil {
-				if err := p.writer.Write(cmd.Ctx, res); err != nil {
-					p.log(logger.Error, "write_error", "Error sending response", map[string]any{
-						"command_id":  cmd.Msg.ID,
-						"response_id": res.ID,
-						"error":       err.Error(),
-					})
-				}
+// handle runs the handler and records the command with its response in the
+// inbox in one transaction, so the command counts as processed exactly when
+// the stock changes made for it are committed. A command found in the inbox
+// is not handled again; its inbox record is returned instead.
+func (p *Processor) handle(ctx context.Context, cmd *CmdEnvelope) (*ResMessage, *inboxDomain.Message, error) {
+	var res *ResMessage
+	var processed *inboxDomain.Message
+	err := p.uow.Transaction(ctx, func(tx uow.UoW) error {
+		message, err := tx.Inbox().GetByID(ctx, cmd.Msg.ID)
+		if err == nil {
+			processed = message
+			return nil
+		}
+		if !errors.Is(err, inboxRepository.ErrInboxMessageNotFound) {
+			return err
+		}
+
+		res, err = p.handler.Handle(ctx, tx, cmd.Msg)
+		if err != nil {
+			return err
+		}
+
+		var response []byte
+		if res != nil {
+			if response, err = json.Marshal(res); err != nil {
+				return retry.Permanent(fmt.Errorf("failed to encode response: %w", err))
 			}
 		}
+		return tx.Inbox().Create(ctx, inboxDomain.Create(cmd.Msg.ID, response, p.inboxCfg.Retention))
+	})
+	return res, processed, err
+}
+
+// replay resends the response stored for an already processed command.
+func (p *Processor) replay(cmd *CmdEnvelope, message *inboxDomain.Message) {
+	p.log(logger.Info, "duplicate", "Command already processed, replaying response", map[string]any{
+		"command_id":   cmd.Msg.ID,
+		"processed_at": message.Processed,
+		"has_response": message.Response != nil,
+	})
+	if message.Response == nil {
+		return
+	}
+
+	var res ResMessage
+	if err := json.Unmarshal(message.Response, &res); err != nil {
+		p.log(logger.Error, "replay_error", "Error decoding stored response", map[string]any{
+			"command_id": cmd.Msg.ID,
+			"error":      err.Error(),
+		})
+		return
+	}
+	if err := p.writer.Write(cmd.Ctx, cmd.Raw.Key, &res); err != nil {
+		p.log(logger.Error, "write_error", "Error sending response", map[string]any{
+			"command_id":  cmd.Msg.ID,
+			"response_id": res.ID,
+			"error":       err.Error(),
+		})
+	}
+}
+
+// deadLetter moves the command to the DLQ, retrying the write as the handler is
+// retried, and reports whether it got there.
+func (p *Processor) deadLetter(cmd *CmdEnvelope, cause error, attempts int) bool {
+	_, err := p.retry.Do(cmd.Ctx, func(ctx context.Context) error {
+		return p.dlqWriter.Write(ctx, cmd.Raw, cause, attempts)
+	})
+	if err != nil {
+		p.log(logger.Error, "dlq_error", "Error sending command to DLQ, leaving its offset uncommitted", map[string]any{
+			"command_id": cmd.Msg.ID,
+			"error":      err.Error(),
+		})
+		return false
 	}
+	return true
 }
 
 func (p *Processor) Stop() error {
@@ -134,6 +269,7 @@ func (p *Processor) Stop() error {
 	p.log(logger.Info, "stop_request", "Stopping command processor", nil)
 	p.cancelFunc()
 	p.wg.Wait()
+	p.pool.Stop()
 	p.started = false
 
 	p.log(logger.Info, "stopped", "Command processor stopped", nil)
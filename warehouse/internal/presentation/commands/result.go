@@ -1,6 +1,8 @@
 package commands
 
 import (
+	itemApplication "warehouse/internal/application/item"
+
 	"github.com/google/uuid"
 )
 
@@ -21,8 +23,11 @@ type (
 	}
 )
 
+// ItemsReserved lists how much of every item was reserved, which is less than
+// was asked for when the order allows partial fulfillment.
 type ItemsReserved struct {
 	OrderID uuid.UUID
+	Items   []itemApplication.ItemDto
 }
 
 type ItemsReservationFailed struct {
@@ -6,20 +6,21 @@ import (
 	"fmt"
 	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
 	"warehouse/internal/infrastructure/logger"
+	"warehouse/internal/infrastructure/messaging"
 
 	"github.com/segmentio/kafka-go"
 )
 
 type Writer interface {
-	Write(ctx context.Context, res *ResMessage) error
+	Write(ctx context.Context, key []byte, res *ResMessage) error
 }
 
 type WriterImpl struct {
-	writer *otelkafkakonsumer.Writer
+	writer messaging.Writer
 	logger logger.Logger
 }
 
-func NewWriter(writer *otelkafkakonsumer.Writer, logger logger.Logger) *WriterImpl {
+func NewWriter(writer messaging.Writer, logger logger.Logger) *WriterImpl {
 	return &WriterImpl{
 		writer: writer,
 		logger: logger,
@@ -30,7 +31,7 @@ func (w *WriterImpl) log(level logger.Level, action, message string, extraFields
 	fields := map[string]any{
 		"component": "command_writer",
 		"action":    action,
-		"topic":     w.writer.W.Topic,
+		"topic":     w.writer.Topic(),
 	}
 	for k, v := range extraFields {
 		fields[k] = v
@@ -39,7 +40,9 @@ func (w *WriterImpl) log(level logger.Level, action, message string, extraFields
 	w.logger.Log(level, message, fields)
 }
 
-func (w *WriterImpl) Write(ctx context.Context, res *ResMessage) error {
+// Write sends the response keyed like the command it answers, so that it lands on
+// the same partition as every other message of that order.
+func (w *WriterImpl) Write(ctx context.Context, key []byte, res *ResMessage) error {
 	if res == nil {
 		return nil
 	}
@@ -54,10 +57,10 @@ func (w *WriterImpl) Write(ctx context.Context, res *ResMessage) error {
 		return fmt.Errorf("error serializing response: %w", err)
 	}
 
-	kafkaMsg := kafka.Message{Value: msg}
+	kafkaMsg := kafka.Message{Key: key, Value: msg}
 
 	// Write the message to Kafka
-	ctx = w.writer.TraceConfig.Propagator.Extract(ctx, otelkafkakonsumer.NewMessageCarrier(&kafkaMsg))
+	ctx = w.writer.Propagator().Extract(ctx, otelkafkakonsumer.NewMessageCarrier(&kafkaMsg))
 
 	if err = w.writer.WriteMessage(ctx, kafkaMsg); err != nil {
 		w.log(logger.Error, "kafka_write_error", "Failed to send response to Kafka", map[string]any{
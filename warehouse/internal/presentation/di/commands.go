@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	itemApplication "warehouse/internal/application/item"
 	"warehouse/internal/infrastructure/logger"
+	"warehouse/internal/infrastructure/messaging/dlq"
 	"warehouse/internal/presentation/commands"
 
 	"go.uber.org/fx"
@@ -12,12 +14,19 @@ import (
 
 var CommandConsumerModule = fx.Options(
 	fx.Provide(
-		// Handlers
+		// Handlers, running the item use case in the transaction of each command
 		fx.Annotate(
-			commands.NewHandler,
+			newCommandHandler,
 			fx.As(new(commands.Handler)),
 		),
 
+		// Dead-letter writers
+		fx.Annotate(
+			dlq.NewWriter,
+			fx.ParamTags(`name:"warehouseCmdDLQWriter"`),
+			fx.As(new(dlq.Writer)),
+		),
+
 		// Readers
 		fx.Annotate(
 			commands.NewReader,
@@ -40,6 +49,10 @@ var CommandConsumerModule = fx.Options(
 	fx.Invoke(setupCommandsLifecycle),
 )
 
+func newCommandHandler() *commands.HandlerImpl {
+	return commands.NewHandler(itemApplication.NewUseCase)
+}
+
 func setupCommandsLifecycle(lc fx.Lifecycle, processor *commands.Processor, reader commands.Reader, logger logger.Logger) {
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
 )
 
 type (
@@ -20,6 +21,7 @@ type (
 		Msg       *EventMessage
 		Topic     string
 		Partition int
+		Raw       *kafka.Message
 	}
 )
 
@@ -10,11 +10,13 @@ import (
 	"sync"
 	"time"
 	"warehouse/internal/infrastructure/logger"
+	"warehouse/internal/infrastructure/messaging/worker"
 )
 
 type Processor struct {
 	handler       Handler
 	productReader Reader
+	pool          *worker.Pool
 
 	cancelCtx  context.Context
 	cancelFunc context.CancelFunc
@@ -26,10 +28,11 @@ type Processor struct {
 	logger logger.Logger
 }
 
-func NewProcessor(handler Handler, productReader Reader, logger logger.Logger) *Processor {
+func NewProcessor(handler Handler, productReader Reader, workerCfg *worker.Config, logger logger.Logger) *Processor {
 	return &Processor{
 		handler:       handler,
 		productReader: productReader,
+		pool:          worker.NewPool(workerCfg),
 		logger:        logger,
 	}
 }
@@ -58,6 +61,7 @@ func (p *Processor) Start(ctx context.Context) error {
 	p.started = true
 
 	p.log(logger.Info, "start", "Starting event processor", nil)
+	p.pool.Start()
 	p.wg.Add(1)
 	go p.processEvents(p.cancelCtx, p.productReader)
 	return nil
@@ -83,30 +87,38 @@ func (p *Processor) processEvents(ctx context.Context, reader Reader) {
 				continue
 			}
 
-			// Handle the event
-			sCtx, span := startProcessSpan(event)
-			startTime := time.Now()
+			// Handle the event on the worker owning its key and commit its offset, whatever the outcome
+			p.pool.Submit(ctx, event.Raw, func() {
+				p.processEvent(event)
+				reader.Commit(event)
+			})
+		}
+	}
+}
 
-			err = p.handler.Handle(sCtx, event.Msg)
+func (p *Processor) processEvent(event *EventEnvelope) {
+	// Handle the event
+	sCtx, span := startProcessSpan(event)
+	startTime := time.Now()
 
-			duration := time.Since(startTime)
-			span.End()
+	err := p.handler.Handle(sCtx, event.Msg)
 
-			if err != nil {
-				p.log(logger.Error, "process_error", "EventMessage processing failed", map[string]any{
-					"event_id":    event.Msg.ID,
-					"error":       err.Error(),
-					"duration_ms": duration.Milliseconds(),
-				})
-				continue
-			}
+	duration := time.Since(startTime)
+	span.End()
 
-			p.log(logger.Info, "process_success", "EventMessage processed successfully", map[string]any{
-				"event_id":    event.Msg.ID,
-				"duration_ms": duration.Milliseconds(),
-			})
-		}
+	if err != nil {
+		p.log(logger.Error, "process_error", "EventMessage processing failed", map[string]any{
+			"event_id":    event.Msg.ID,
+			"error":       err.Error(),
+			"duration_ms": duration.Milliseconds(),
+		})
+		return
 	}
+
+	p.log(logger.Info, "process_success", "EventMessage processed successfully", map[string]any{
+		"event_id":    event.Msg.ID,
+		"duration_ms": duration.Milliseconds(),
+	})
 }
 
 func (p *Processor) Stop() error {
@@ -120,6 +132,7 @@ func (p *Processor) Stop() error {
 	p.log(logger.Info, "stop_request", "Stopping event processor", nil)
 	p.cancelFunc()
 	p.wg.Wait()
+	p.pool.Stop()
 	p.started = false
 
 	p.log(logger.Info, "stopped", "EventMessage processor stopped", nil)
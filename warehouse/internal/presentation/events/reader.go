@@ -9,16 +9,20 @@ import (
 	"github.com/segmentio/kafka-go"
 	"sync"
 	"warehouse/internal/infrastructure/logger"
+	"warehouse/internal/infrastructure/messaging"
+	"warehouse/internal/infrastructure/messaging/commit"
 )
 
 type Reader interface {
 	Start(ctx context.Context) error
 	Read(ctx context.Context) (*EventEnvelope, error)
+	Commit(event *EventEnvelope)
 	Stop() error
 }
 
 type ReaderImpl struct {
-	reader    *otelkafkakonsumer.Reader
+	reader    messaging.Reader
+	committer *commit.Committer
 	eventChan chan *EventEnvelope
 	errorChan chan error
 
@@ -32,10 +36,11 @@ type ReaderImpl struct {
 	logger logger.Logger
 }
 
-func NewReader(reader *otelkafkakonsumer.Reader, logger logger.Logger) *ReaderImpl {
+func NewReader(reader messaging.Reader, commitCfg *commit.Config, logger logger.Logger) *ReaderImpl {
 	return &ReaderImpl{
-		reader: reader,
-		logger: logger,
+		reader:    reader,
+		committer: commit.NewCommitter(reader, commitCfg, logger),
+		logger:    logger,
 	}
 }
 
@@ -43,7 +48,7 @@ func (r *ReaderImpl) log(level logger.Level, action, message string, extraFields
 	fields := map[string]any{
 		"component": "event_reader",
 		"action":    action,
-		"topic":     r.reader.R.Config().Topic,
+		"topic":     r.reader.Topic(),
 	}
 	for k, v := range extraFields {
 		fields[k] = v
@@ -77,12 +82,16 @@ func (r *ReaderImpl) Start(ctx context.Context) error {
 	r.started = true
 
 	r.log(logger.Info, "start", "Starting event reader", nil)
-	r.wg.Add(1)
+	r.wg.Add(2)
 
 	go func() {
 		defer r.wg.Done()
 		r.readEvents(r.cancelCtx)
 	}()
+	go func() {
+		defer r.wg.Done()
+		r.committer.Run(r.cancelCtx)
+	}()
 
 	return nil
 }
@@ -117,7 +126,8 @@ func (r *ReaderImpl) readEvents(ctx context.Context) {
 
 		default:
 			// Read message
-			msg, err := r.reader.ReadMessage(ctx)
+			msg := &kafka.Message{}
+			err := r.reader.FetchMessage(ctx, msg)
 			if ctx.Err() != nil {
 				continue
 			}
@@ -125,6 +135,7 @@ func (r *ReaderImpl) readEvents(ctx context.Context) {
 				r.sendError(err, "read_message")
 				continue
 			}
+			r.committer.Track(msg)
 
 			// Parse the event
 			event, err := r.parseCommandEnvelope(ctx, msg)
@@ -133,6 +144,7 @@ func (r *ReaderImpl) readEvents(ctx context.Context) {
 					"error":    err.Error(),
 					"raw_data": msg.Value,
 				})
+				r.committer.Done(msg)
 				r.sendError(err, "parse_error")
 				continue
 			}
@@ -174,19 +186,26 @@ func (r *ReaderImpl) Read(ctx context.Context) (*EventEnvelope, error) {
 	}
 }
 
+// Commit marks the event as handled; its offset is committed with the next batch
+// once every earlier message from the same partition has been handled too.
+func (r *ReaderImpl) Commit(event *EventEnvelope) {
+	r.committer.Done(event.Raw)
+}
+
 func (r *ReaderImpl) parseCommandEnvelope(ctx context.Context, msg *kafka.Message) (*EventEnvelope, error) {
 	eventMsg, err := parseEventMessage(msg.Value)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx = r.reader.TraceConfig.Propagator.Extract(ctx, otelkafkakonsumer.NewMessageCarrier(msg))
+	ctx = r.reader.Propagator().Extract(ctx, otelkafkakonsumer.NewMessageCarrier(msg))
 
 	return &EventEnvelope{
 		Ctx:       ctx,
 		Msg:       eventMsg,
-		Topic:     r.reader.R.Config().Topic,
+		Topic:     r.reader.Topic(),
 		Partition: msg.Partition,
+		Raw:       msg,
 	}, nil
 }
 
@@ -30,7 +30,7 @@ func (h *ItemServiceHandler) ReserveItem(
 		return nil, err
 	}
 
-	err = h.usecase.Reserve(ctx, data)
+	_, err = h.usecase.Reserve(ctx, data)
 	if err != nil {
 		return nil, response.ParseError(err)
 	}
@@ -37,4 +37,21 @@ func (h *ProductServiceHandler) CreateProduct(
 	return response.ToCreateProductResponse(productID), nil
 }
 
+func (h *ProductServiceHandler) GetProducts(
+	ctx context.Context,
+	req *warehousev1.GetProductsRequest,
+) (*warehousev1.GetProductsResponse, error) {
+	productIDs, err := request.ToProductIDs(req)
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := h.usecase.GetByIDs(ctx, productIDs)
+	if err != nil {
+		return nil, response.ParseError(err)
+	}
+
+	return response.ToGetProductsResponse(products), nil
+}
+
 var _ warehousev1.ProductServiceServer = (*ProductServiceHandler)(nil)
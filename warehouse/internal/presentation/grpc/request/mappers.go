@@ -4,6 +4,8 @@ import (
 	itemApplication "warehouse/internal/application/item"
 	productApplication "warehouse/internal/application/product"
 	warehousev1 "warehouse/internal/presentation/grpc"
+
+	"github.com/google/uuid"
 )
 
 func toItemInfoDto(req *warehousev1.ItemInfo) (itemApplication.ItemDto, error) {
@@ -31,12 +33,29 @@ func toItemsInfoDto(req []*warehousev1.ItemInfo) ([]itemApplication.ItemDto, err
 }
 
 func ToCreateProductDto(req *warehousev1.CreateProductRequest) (productApplication.CreateDto, error) {
+	price, err := ParseMoney(req.Price)
+	if err != nil {
+		return productApplication.CreateDto{}, err
+	}
+
 	return productApplication.CreateDto{
 		Name:  req.Name,
-		Price: ParseDecimal(req.Price),
+		Price: price,
 	}, nil
 }
 
+func ToProductIDs(req *warehousev1.GetProductsRequest) ([]uuid.UUID, error) {
+	productIDs := make([]uuid.UUID, 0, len(req.ProductIds))
+	for _, key := range req.ProductIds {
+		productID, err := ParseUUID(key)
+		if err != nil {
+			return nil, err
+		}
+		productIDs = append(productIDs, productID)
+	}
+	return productIDs, nil
+}
+
 func ToReserveItemDto(req *warehousev1.ReserveItemRequest) (itemApplication.ReserveDto, error) {
 	var data itemApplication.ReserveDto
 
@@ -2,7 +2,8 @@ package request
 
 import (
 	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
+	productDomain "warehouse/internal/domain/product"
+	warehousev1 "warehouse/internal/presentation/grpc"
 	"warehouse/internal/presentation/grpc/response"
 )
 
@@ -14,6 +15,13 @@ func ParseUUID(key string) (uuid.UUID, error) {
 	}
 }
 
-func ParseDecimal(key float64) decimal.Decimal {
-	return decimal.NewFromFloat(key)
+func ParseMoney(money *warehousev1.Money) (productDomain.Money, error) {
+	if money == nil {
+		return productDomain.Money{}, response.ErrInvalidMoney
+	}
+	m, err := productDomain.MoneyFromUnits(money.Units, money.Nanos, money.CurrencyCode)
+	if err != nil {
+		return productDomain.Money{}, response.ErrInvalidMoney
+	}
+	return m, nil
 }
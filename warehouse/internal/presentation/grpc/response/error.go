@@ -20,6 +20,9 @@ var domainErrorMap = []struct {
 	// InvalidArgument
 	{productDomain.ErrInvalidProductPrice, codes.InvalidArgument},
 	{productDomain.ErrInvalidProductName, codes.InvalidArgument},
+	{productDomain.ErrInvalidMoney, codes.InvalidArgument},
+	{productDomain.ErrInvalidCurrency, codes.InvalidArgument},
+	{productDomain.ErrCurrencyMismatch, codes.InvalidArgument},
 	{itemDomain.ErrInvalidItemCount, codes.InvalidArgument},
 	{outboxDomain.ErrInvalidOutboxPayload, codes.InvalidArgument},
 	{outboxPublisher.ErrInvalidOutboxMessage, codes.InvalidArgument},
@@ -47,5 +50,6 @@ func ParseError(err error) error {
 
 var (
 	ErrInvalidID     = status.Error(codes.InvalidArgument, "invalid id")
+	ErrInvalidMoney  = status.Error(codes.InvalidArgument, "invalid money")
 	ErrInternalError = status.Error(codes.Internal, "internal error")
 )
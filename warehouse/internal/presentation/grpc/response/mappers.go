@@ -9,15 +9,31 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+func toMoneyResponse(money productDomain.Money) *warehousev1.Money {
+	return &warehousev1.Money{
+		CurrencyCode: money.Currency,
+		Units:        money.Units(),
+		Nanos:        money.Nanos(),
+	}
+}
+
 func toProductResponse(product *productDomain.Product) *warehousev1.Product {
 	return &warehousev1.Product{
 		ProductId: product.ID.String(),
 		Name:      product.Name,
-		Price:     product.Price.InexactFloat64(),
+		Price:     toMoneyResponse(product.Price),
 		Created:   timestamppb.New(product.Created),
 	}
 }
 
+func toProductsResponse(products []*productDomain.Product) []*warehousev1.Product {
+	productsResponse := make([]*warehousev1.Product, 0, len(products))
+	for _, product := range products {
+		productsResponse = append(productsResponse, toProductResponse(product))
+	}
+	return productsResponse
+}
+
 func toItemResponse(item *itemDomain.Item) *warehousev1.Item {
 	return &warehousev1.Item{
 		ItemId:  item.ID.String(),
@@ -46,3 +62,9 @@ func ToGetAllItemsResponse(items []*itemDomain.Item) *warehousev1.GetAllItemsRes
 		Items: toItemsResponse(items),
 	}
 }
+
+func ToGetProductsResponse(products []*productDomain.Product) *warehousev1.GetProductsResponse {
+	return &warehousev1.GetProductsResponse{
+		Products: toProductsResponse(products),
+	}
+}
@@ -278,7 +278,7 @@ func (x *ItemInfo) GetCount() int32 {
 type CreateProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Price         *Money                 `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -320,11 +320,11 @@ func (x *CreateProductRequest) GetName() string {
 	return ""
 }
 
-func (x *CreateProductRequest) GetPrice() float64 {
+func (x *CreateProductRequest) GetPrice() *Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 type CreateProductResponse struct {
@@ -371,19 +371,108 @@ func (x *CreateProductResponse) GetProductId() string {
 	return ""
 }
 
+// Unknown product IDs are left out of the response.
+type GetProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductsRequest) Reset() {
+	*x = GetProductsRequest{}
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsRequest) ProtoMessage() {}
+
+func (x *GetProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_presentation_grpc_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetProductsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type GetProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductsResponse) Reset() {
+	*x = GetProductsResponse{}
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsResponse) ProtoMessage() {}
+
+func (x *GetProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsResponse.ProtoReflect.Descriptor instead.
+func (*GetProductsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_presentation_grpc_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
 type Product struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
 	Created       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	Price         *Money                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Product) Reset() {
 	*x = Product{}
-	mi := &file_internal_presentation_grpc_service_proto_msgTypes[7]
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -395,7 +484,7 @@ func (x *Product) String() string {
 func (*Product) ProtoMessage() {}
 
 func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_presentation_grpc_service_proto_msgTypes[7]
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,7 +497,7 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Product.ProtoReflect.Descriptor instead.
 func (*Product) Descriptor() ([]byte, []int) {
-	return file_internal_presentation_grpc_service_proto_rawDescGZIP(), []int{7}
+	return file_internal_presentation_grpc_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Product) GetProductId() string {
@@ -425,18 +514,82 @@ func (x *Product) GetName() string {
 	return ""
 }
 
-func (x *Product) GetPrice() float64 {
+func (x *Product) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Product) GetPrice() *Money {
 	if x != nil {
 		return x.Price
 	}
+	return nil
+}
+
+// An amount of money in one currency, as in google.type.Money: the amount is
+// units + nanos * 1e-9, and units and nanos must share a sign.
+type Money struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ISO 4217 code, e.g. "USD".
+	CurrencyCode string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Units        int64  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	// In the range (-1e9, 1e9).
+	Nanos         int32 `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_internal_presentation_grpc_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
 	return 0
 }
 
-func (x *Product) GetCreated() *timestamppb.Timestamp {
+func (x *Money) GetNanos() int32 {
 	if x != nil {
-		return x.Created
+		return x.Nanos
 	}
-	return nil
+	return 0
 }
 
 type UpdateImageRequest struct {
@@ -452,7 +605,7 @@ type UpdateImageRequest struct {
 
 func (x *UpdateImageRequest) Reset() {
 	*x = UpdateImageRequest{}
-	mi := &file_internal_presentation_grpc_service_proto_msgTypes[8]
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -464,7 +617,7 @@ func (x *UpdateImageRequest) String() string {
 func (*UpdateImageRequest) ProtoMessage() {}
 
 func (x *UpdateImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_presentation_grpc_service_proto_msgTypes[8]
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,7 +630,7 @@ func (x *UpdateImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateImageRequest.ProtoReflect.Descriptor instead.
 func (*UpdateImageRequest) Descriptor() ([]byte, []int) {
-	return file_internal_presentation_grpc_service_proto_rawDescGZIP(), []int{8}
+	return file_internal_presentation_grpc_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateImageRequest) GetData() isUpdateImageRequest_Data {
@@ -531,7 +684,7 @@ type UpdateImageInfo struct {
 
 func (x *UpdateImageInfo) Reset() {
 	*x = UpdateImageInfo{}
-	mi := &file_internal_presentation_grpc_service_proto_msgTypes[9]
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -543,7 +696,7 @@ func (x *UpdateImageInfo) String() string {
 func (*UpdateImageInfo) ProtoMessage() {}
 
 func (x *UpdateImageInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_presentation_grpc_service_proto_msgTypes[9]
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -556,7 +709,7 @@ func (x *UpdateImageInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateImageInfo.ProtoReflect.Descriptor instead.
 func (*UpdateImageInfo) Descriptor() ([]byte, []int) {
-	return file_internal_presentation_grpc_service_proto_rawDescGZIP(), []int{9}
+	return file_internal_presentation_grpc_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateImageInfo) GetProductId() string {
@@ -582,7 +735,7 @@ type GetImageRequest struct {
 
 func (x *GetImageRequest) Reset() {
 	*x = GetImageRequest{}
-	mi := &file_internal_presentation_grpc_service_proto_msgTypes[10]
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -594,7 +747,7 @@ func (x *GetImageRequest) String() string {
 func (*GetImageRequest) ProtoMessage() {}
 
 func (x *GetImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_presentation_grpc_service_proto_msgTypes[10]
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,7 +760,7 @@ func (x *GetImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetImageRequest.ProtoReflect.Descriptor instead.
 func (*GetImageRequest) Descriptor() ([]byte, []int) {
-	return file_internal_presentation_grpc_service_proto_rawDescGZIP(), []int{10}
+	return file_internal_presentation_grpc_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetImageRequest) GetProductId() string {
@@ -630,7 +783,7 @@ type GetImageResponse struct {
 
 func (x *GetImageResponse) Reset() {
 	*x = GetImageResponse{}
-	mi := &file_internal_presentation_grpc_service_proto_msgTypes[11]
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -642,7 +795,7 @@ func (x *GetImageResponse) String() string {
 func (*GetImageResponse) ProtoMessage() {}
 
 func (x *GetImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_presentation_grpc_service_proto_msgTypes[11]
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,7 +808,7 @@ func (x *GetImageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetImageResponse.ProtoReflect.Descriptor instead.
 func (*GetImageResponse) Descriptor() ([]byte, []int) {
-	return file_internal_presentation_grpc_service_proto_rawDescGZIP(), []int{11}
+	return file_internal_presentation_grpc_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetImageResponse) GetData() isGetImageResponse_Data {
@@ -708,7 +861,7 @@ type GetImageInfo struct {
 
 func (x *GetImageInfo) Reset() {
 	*x = GetImageInfo{}
-	mi := &file_internal_presentation_grpc_service_proto_msgTypes[12]
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -720,7 +873,7 @@ func (x *GetImageInfo) String() string {
 func (*GetImageInfo) ProtoMessage() {}
 
 func (x *GetImageInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_presentation_grpc_service_proto_msgTypes[12]
+	mi := &file_internal_presentation_grpc_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,7 +886,7 @@ func (x *GetImageInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetImageInfo.ProtoReflect.Descriptor instead.
 func (*GetImageInfo) Descriptor() ([]byte, []int) {
-	return file_internal_presentation_grpc_service_proto_rawDescGZIP(), []int{12}
+	return file_internal_presentation_grpc_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetImageInfo) GetContentType() string {
@@ -762,19 +915,28 @@ const file_internal_presentation_grpc_service_proto_rawDesc = "" +
 	"\bItemInfo\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count\"@\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"[\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x02 \x01(\x01R\x05price\"6\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12)\n" +
+	"\x05price\x18\x03 \x01(\v2\x13.warehouse.v1.MoneyR\x05priceJ\x04\b\x02\x10\x03\"6\n" +
 	"\x15CreateProductResponse\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"\x88\x01\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"5\n" +
+	"\x12GetProductsRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\"H\n" +
+	"\x13GetProductsResponse\x121\n" +
+	"\bproducts\x18\x01 \x03(\v2\x15.warehouse.v1.ProductR\bproducts\"\xa3\x01\n" +
 	"\aProduct\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\x124\n" +
-	"\acreated\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\"r\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x124\n" +
+	"\acreated\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12)\n" +
+	"\x05price\x18\x05 \x01(\v2\x13.warehouse.v1.MoneyR\x05priceJ\x04\b\x03\x10\x04\"X\n" +
+	"\x05Money\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\x03R\x05units\x12\x14\n" +
+	"\x05nanos\x18\x03 \x01(\x05R\x05nanos\"r\n" +
 	"\x12UpdateImageRequest\x123\n" +
 	"\x04info\x18\x01 \x01(\v2\x1d.warehouse.v1.UpdateImageInfoH\x00R\x04info\x12\x1f\n" +
 	"\n" +
@@ -797,9 +959,10 @@ const file_internal_presentation_grpc_service_proto_rawDesc = "" +
 	"\vItemService\x12G\n" +
 	"\vReserveItem\x12 .warehouse.v1.ReserveItemRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
 	"\vReleaseItem\x12 .warehouse.v1.ReleaseItemRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
-	"\vGetAllItems\x12\x16.google.protobuf.Empty\x1a!.warehouse.v1.GetAllItemsResponse2j\n" +
+	"\vGetAllItems\x12\x16.google.protobuf.Empty\x1a!.warehouse.v1.GetAllItemsResponse2\xbe\x01\n" +
 	"\x0eProductService\x12X\n" +
-	"\rCreateProduct\x12\".warehouse.v1.CreateProductRequest\x1a#.warehouse.v1.CreateProductResponse2\xad\x01\n" +
+	"\rCreateProduct\x12\".warehouse.v1.CreateProductRequest\x1a#.warehouse.v1.CreateProductResponse\x12R\n" +
+	"\vGetProducts\x12 .warehouse.v1.GetProductsRequest\x1a!.warehouse.v1.GetProductsResponse2\xad\x01\n" +
 	"\x13ProductImageService\x12I\n" +
 	"\vUpdateImage\x12 .warehouse.v1.UpdateImageRequest\x1a\x16.google.protobuf.Empty(\x01\x12K\n" +
 	"\bGetImage\x12\x1d.warehouse.v1.GetImageRequest\x1a\x1e.warehouse.v1.GetImageResponse0\x01B2Z0warehouse/internal/presentation/grpc;warehousev1b\x06proto3"
@@ -816,7 +979,7 @@ func file_internal_presentation_grpc_service_proto_rawDescGZIP() []byte {
 	return file_internal_presentation_grpc_service_proto_rawDescData
 }
 
-var file_internal_presentation_grpc_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_internal_presentation_grpc_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_internal_presentation_grpc_service_proto_goTypes = []any{
 	(*ReserveItemRequest)(nil),    // 0: warehouse.v1.ReserveItemRequest
 	(*ReleaseItemRequest)(nil),    // 1: warehouse.v1.ReleaseItemRequest
@@ -825,40 +988,48 @@ var file_internal_presentation_grpc_service_proto_goTypes = []any{
 	(*ItemInfo)(nil),              // 4: warehouse.v1.ItemInfo
 	(*CreateProductRequest)(nil),  // 5: warehouse.v1.CreateProductRequest
 	(*CreateProductResponse)(nil), // 6: warehouse.v1.CreateProductResponse
-	(*Product)(nil),               // 7: warehouse.v1.Product
-	(*UpdateImageRequest)(nil),    // 8: warehouse.v1.UpdateImageRequest
-	(*UpdateImageInfo)(nil),       // 9: warehouse.v1.UpdateImageInfo
-	(*GetImageRequest)(nil),       // 10: warehouse.v1.GetImageRequest
-	(*GetImageResponse)(nil),      // 11: warehouse.v1.GetImageResponse
-	(*GetImageInfo)(nil),          // 12: warehouse.v1.GetImageInfo
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 14: google.protobuf.Empty
+	(*GetProductsRequest)(nil),    // 7: warehouse.v1.GetProductsRequest
+	(*GetProductsResponse)(nil),   // 8: warehouse.v1.GetProductsResponse
+	(*Product)(nil),               // 9: warehouse.v1.Product
+	(*Money)(nil),                 // 10: warehouse.v1.Money
+	(*UpdateImageRequest)(nil),    // 11: warehouse.v1.UpdateImageRequest
+	(*UpdateImageInfo)(nil),       // 12: warehouse.v1.UpdateImageInfo
+	(*GetImageRequest)(nil),       // 13: warehouse.v1.GetImageRequest
+	(*GetImageResponse)(nil),      // 14: warehouse.v1.GetImageResponse
+	(*GetImageInfo)(nil),          // 15: warehouse.v1.GetImageInfo
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 17: google.protobuf.Empty
 }
 var file_internal_presentation_grpc_service_proto_depIdxs = []int32{
 	4,  // 0: warehouse.v1.ReserveItemRequest.items:type_name -> warehouse.v1.ItemInfo
 	4,  // 1: warehouse.v1.ReleaseItemRequest.items:type_name -> warehouse.v1.ItemInfo
 	3,  // 2: warehouse.v1.GetAllItemsResponse.items:type_name -> warehouse.v1.Item
-	7,  // 3: warehouse.v1.Item.product:type_name -> warehouse.v1.Product
-	13, // 4: warehouse.v1.Product.created:type_name -> google.protobuf.Timestamp
-	9,  // 5: warehouse.v1.UpdateImageRequest.info:type_name -> warehouse.v1.UpdateImageInfo
-	12, // 6: warehouse.v1.GetImageResponse.info:type_name -> warehouse.v1.GetImageInfo
-	0,  // 7: warehouse.v1.ItemService.ReserveItem:input_type -> warehouse.v1.ReserveItemRequest
-	1,  // 8: warehouse.v1.ItemService.ReleaseItem:input_type -> warehouse.v1.ReleaseItemRequest
-	14, // 9: warehouse.v1.ItemService.GetAllItems:input_type -> google.protobuf.Empty
-	5,  // 10: warehouse.v1.ProductService.CreateProduct:input_type -> warehouse.v1.CreateProductRequest
-	8,  // 11: warehouse.v1.ProductImageService.UpdateImage:input_type -> warehouse.v1.UpdateImageRequest
-	10, // 12: warehouse.v1.ProductImageService.GetImage:input_type -> warehouse.v1.GetImageRequest
-	14, // 13: warehouse.v1.ItemService.ReserveItem:output_type -> google.protobuf.Empty
-	14, // 14: warehouse.v1.ItemService.ReleaseItem:output_type -> google.protobuf.Empty
-	2,  // 15: warehouse.v1.ItemService.GetAllItems:output_type -> warehouse.v1.GetAllItemsResponse
-	6,  // 16: warehouse.v1.ProductService.CreateProduct:output_type -> warehouse.v1.CreateProductResponse
-	14, // 17: warehouse.v1.ProductImageService.UpdateImage:output_type -> google.protobuf.Empty
-	11, // 18: warehouse.v1.ProductImageService.GetImage:output_type -> warehouse.v1.GetImageResponse
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	9,  // 3: warehouse.v1.Item.product:type_name -> warehouse.v1.Product
+	10, // 4: warehouse.v1.CreateProductRequest.price:type_name -> warehouse.v1.Money
+	9,  // 5: warehouse.v1.GetProductsResponse.products:type_name -> warehouse.v1.Product
+	16, // 6: warehouse.v1.Product.created:type_name -> google.protobuf.Timestamp
+	10, // 7: warehouse.v1.Product.price:type_name -> warehouse.v1.Money
+	12, // 8: warehouse.v1.UpdateImageRequest.info:type_name -> warehouse.v1.UpdateImageInfo
+	15, // 9: warehouse.v1.GetImageResponse.info:type_name -> warehouse.v1.GetImageInfo
+	0,  // 10: warehouse.v1.ItemService.ReserveItem:input_type -> warehouse.v1.ReserveItemRequest
+	1,  // 11: warehouse.v1.ItemService.ReleaseItem:input_type -> warehouse.v1.ReleaseItemRequest
+	17, // 12: warehouse.v1.ItemService.GetAllItems:input_type -> google.protobuf.Empty
+	5,  // 13: warehouse.v1.ProductService.CreateProduct:input_type -> warehouse.v1.CreateProductRequest
+	7,  // 14: warehouse.v1.ProductService.GetProducts:input_type -> warehouse.v1.GetProductsRequest
+	11, // 15: warehouse.v1.ProductImageService.UpdateImage:input_type -> warehouse.v1.UpdateImageRequest
+	13, // 16: warehouse.v1.ProductImageService.GetImage:input_type -> warehouse.v1.GetImageRequest
+	17, // 17: warehouse.v1.ItemService.ReserveItem:output_type -> google.protobuf.Empty
+	17, // 18: warehouse.v1.ItemService.ReleaseItem:output_type -> google.protobuf.Empty
+	2,  // 19: warehouse.v1.ItemService.GetAllItems:output_type -> warehouse.v1.GetAllItemsResponse
+	6,  // 20: warehouse.v1.ProductService.CreateProduct:output_type -> warehouse.v1.CreateProductResponse
+	8,  // 21: warehouse.v1.ProductService.GetProducts:output_type -> warehouse.v1.GetProductsResponse
+	17, // 22: warehouse.v1.ProductImageService.UpdateImage:output_type -> google.protobuf.Empty
+	14, // 23: warehouse.v1.ProductImageService.GetImage:output_type -> warehouse.v1.GetImageResponse
+	17, // [17:24] is the sub-list for method output_type
+	10, // [10:17] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_internal_presentation_grpc_service_proto_init() }
@@ -866,11 +1037,11 @@ func file_internal_presentation_grpc_service_proto_init() {
 	if File_internal_presentation_grpc_service_proto != nil {
 		return
 	}
-	file_internal_presentation_grpc_service_proto_msgTypes[8].OneofWrappers = []any{
+	file_internal_presentation_grpc_service_proto_msgTypes[11].OneofWrappers = []any{
 		(*UpdateImageRequest_Info)(nil),
 		(*UpdateImageRequest_ChunkData)(nil),
 	}
-	file_internal_presentation_grpc_service_proto_msgTypes[11].OneofWrappers = []any{
+	file_internal_presentation_grpc_service_proto_msgTypes[14].OneofWrappers = []any{
 		(*GetImageResponse_Info)(nil),
 		(*GetImageResponse_ChunkData)(nil),
 	}
@@ -880,7 +1051,7 @@ func file_internal_presentation_grpc_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_presentation_grpc_service_proto_rawDesc), len(file_internal_presentation_grpc_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
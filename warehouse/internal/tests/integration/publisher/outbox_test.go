@@ -11,6 +11,7 @@ import (
 	domain "warehouse/internal/domain/common"
 	outboxDomain "warehouse/internal/domain/outbox"
 	productDomain "warehouse/internal/domain/product"
+	"warehouse/internal/infrastructure/messaging"
 	outboxPublisher "warehouse/internal/infrastructure/publisher/outbox"
 	"warehouse/internal/tests/testutils"
 
@@ -29,7 +30,7 @@ type OutboxTestSuite struct {
 
 	testMessaging *testutils.TestMessaging
 
-	productWriter *otelkafkakonsumer.Writer
+	productWriter messaging.Writer
 	productReader *otelkafkakonsumer.Reader
 }
 
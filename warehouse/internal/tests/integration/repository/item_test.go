@@ -50,7 +50,7 @@ func (s *ItemRepositoryTestSuite) getProductRepo() productDomain.Repository {
 }
 
 func (s *ItemRepositoryTestSuite) createTestProduct() *productDomain.Product {
-	product, _, err := productDomain.Create("Test Product", decimal.NewFromInt(100), "test.png")
+	product, _, err := productDomain.Create("Test Product", productDomain.Money{Amount: decimal.NewFromInt(100), Currency: "USD"}, "test.png")
 	require.NoError(s.T(), err)
 	return product
 }
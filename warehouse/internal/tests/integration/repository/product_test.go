@@ -44,7 +44,7 @@ func (s *ProductRepositoryTestSuite) getRepo() productDomain.Repository {
 }
 
 func (s *ProductRepositoryTestSuite) createTestProduct() *productDomain.Product {
-	product, _, err := productDomain.Create("Test Product", decimal.NewFromInt(100), "test.png")
+	product, _, err := productDomain.Create("Test Product", productDomain.Money{Amount: decimal.NewFromInt(100), Currency: "USD"}, "test.png")
 	require.NoError(s.T(), err)
 	return product
 }
@@ -90,6 +90,7 @@ func (s *ProductRepositoryTestSuite) TestCreate() {
 				require.NoError(s.T(), err)
 				require.NotNil(s.T(), createdProduct)
 				require.Equal(s.T(), product.ID, createdProduct.ID)
+				require.True(s.T(), product.Price.Equal(createdProduct.Price))
 			}
 		})
 	}
@@ -170,6 +171,56 @@ func (s *ProductRepositoryTestSuite) TestGetAll() {
 	}
 }
 
+func (s *ProductRepositoryTestSuite) TestGetAllByIDs() {
+	tests := []struct {
+		name          string
+		setup         func(repo productDomain.Repository) ([]uuid.UUID, []uuid.UUID)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			setup: func(repo productDomain.Repository) ([]uuid.UUID, []uuid.UUID) {
+				first := s.createTestProduct()
+				require.NoError(s.T(), repo.Create(s.ctx, first))
+				second := s.createTestProduct()
+				require.NoError(s.T(), repo.Create(s.ctx, second))
+				return []uuid.UUID{first.ID, second.ID}, []uuid.UUID{first.ID, second.ID}
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Success: Unknown products are skipped",
+			setup: func(repo productDomain.Repository) ([]uuid.UUID, []uuid.UUID) {
+				product := s.createTestProduct()
+				require.NoError(s.T(), repo.Create(s.ctx, product))
+				return []uuid.UUID{product.ID, uuid.New()}, []uuid.UUID{product.ID}
+			},
+			expectedError: nil,
+		},
+	}
+
+	repo := s.getRepo()
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			productIDs, expectedIDs := tc.setup(repo)
+
+			products, err := repo.GetAllByIDs(s.ctx, productIDs...)
+
+			if tc.expectedError != nil {
+				require.Error(s.T(), err)
+				require.Equal(s.T(), tc.expectedError, err)
+			} else {
+				require.NoError(s.T(), err)
+				foundIDs := make([]uuid.UUID, 0, len(products))
+				for _, product := range products {
+					foundIDs = append(foundIDs, product.ID)
+				}
+				require.ElementsMatch(s.T(), expectedIDs, foundIDs)
+			}
+		})
+	}
+}
+
 func TestProductRepository(t *testing.T) {
 	suite.Run(t, new(ProductRepositoryTestSuite))
 }
@@ -6,6 +6,7 @@ import (
 	otelkafkakonsumer "github.com/Trendyol/otel-kafka-konsumer"
 	"net"
 	"strconv"
+	"warehouse/internal/infrastructure/messaging"
 
 	"github.com/segmentio/kafka-go"
 	"github.com/testcontainers/testcontainers-go"
@@ -46,13 +47,17 @@ func (m *TestMessaging) CreateTopics(ctx context.Context, topics ...string) erro
 	return controllerConn.CreateTopics(topicConfigs...)
 }
 
-func (m *TestMessaging) CreateWriter(topic string) (*otelkafkakonsumer.Writer, error) {
-	return otelkafkakonsumer.NewWriter(
+func (m *TestMessaging) CreateWriter(topic string) (messaging.Writer, error) {
+	writer, err := otelkafkakonsumer.NewWriter(
 		&kafka.Writer{
 			Addr:  kafka.TCP(m.url),
 			Topic: topic,
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+	return &messaging.KafkaWriter{Writer: writer}, nil
 }
 
 func (m *TestMessaging) CreateReader(topic string) (*otelkafkakonsumer.Reader, error) {
@@ -30,7 +30,7 @@ func (s *ItemUseCaseTestSuite) SetupTest() {
 }
 
 func (s *ItemUseCaseTestSuite) createTestProduct(name string, price decimal.Decimal) *productDomain.Product {
-	product, _, err := productDomain.Create(name, price, "product.png")
+	product, _, err := productDomain.Create(name, productDomain.Money{Amount: price, Currency: "USD"}, "product.png")
 	require.NoError(s.T(), err)
 	return product
 }
@@ -236,10 +236,11 @@ func (s *ItemUseCaseTestSuite) TestReserve() {
 			dto := tc.setup(uow)
 			useCase := itemApplication.NewUseCase(uow)
 
-			err := useCase.Reserve(s.ctx, dto)
+			reserved, err := useCase.Reserve(s.ctx, dto)
 
 			if tc.expectedErr == nil {
 				require.NoError(s.T(), err)
+				require.ElementsMatch(s.T(), dto.Items, reserved)
 			} else {
 				require.Error(s.T(), err)
 				require.EqualError(s.T(), err, tc.expectedErr.Error())
@@ -250,6 +251,102 @@ func (s *ItemUseCaseTestSuite) TestReserve() {
 	}
 }
 
+func (s *ItemUseCaseTestSuite) TestReserveDuplicateLines() {
+	uow := mocks.NewUowMock()
+	item := s.createTestItems(10)[0]
+	productID := item.Product.ID
+
+	uow.ItemMock.On("GetAllByProductIDs", s.ctx, productID).
+		Return([]*itemDomain.Item{item}, nil).Once()
+	uow.ItemMock.On("Update", s.ctx, mock.MatchedBy(func(item *itemDomain.Item) bool {
+		return item.Count == 3
+	})).Return(nil).Once()
+	uow.On("Transaction", s.ctx, mock.Anything).Once()
+	useCase := itemApplication.NewUseCase(uow)
+
+	reserved, err := useCase.Reserve(s.ctx, itemApplication.ReserveDto{
+		Items: []itemApplication.ItemDto{
+			{ProductID: productID, Count: 3},
+			{ProductID: productID, Count: 4},
+		},
+	})
+
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []itemApplication.ItemDto{{ProductID: productID, Count: 7}}, reserved)
+	uow.AssertExpectations(s.T())
+}
+
+func (s *ItemUseCaseTestSuite) TestReserveAllowPartial() {
+	tests := []struct {
+		name     string
+		stock    []int
+		requests []int
+		// reserved is the expected count per item, in the order of stock.
+		reserved    []int
+		updates     int
+		expectedErr error
+	}{
+		{
+			name:     "Success: Short item reserved as far as it goes",
+			stock:    []int{5, 10},
+			requests: []int{4, 12},
+			reserved: []int{4, 10},
+			updates:  2,
+		},
+		{
+			name:     "Success: Item out of stock left out",
+			stock:    []int{0, 10},
+			requests: []int{3, 2},
+			reserved: []int{0, 2},
+			updates:  1,
+		},
+		{
+			name:        "Failure: Nothing in stock",
+			stock:       []int{0, 0},
+			requests:    []int{3, 2},
+			expectedErr: itemDomain.ErrOutOfStock,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.T().Parallel()
+			uow := mocks.NewUowMock()
+			items := s.createTestItems(tc.stock...)
+
+			uow.ItemMock.On("GetAllByProductIDs", s.ctx, mock.Anything, mock.Anything).
+				Return(items, nil).Once()
+			if tc.updates > 0 {
+				uow.ItemMock.On("Update", s.ctx, mock.Anything).Return(nil).Times(tc.updates)
+			}
+			uow.On("Transaction", s.ctx, mock.Anything).Once()
+
+			dto := itemApplication.ReserveDto{Policy: itemApplication.ReserveAllowPartial}
+			expected := make([]itemApplication.ItemDto, 0, len(items))
+			for i, item := range items {
+				dto.Items = append(dto.Items, itemApplication.ItemDto{ProductID: item.Product.ID, Count: tc.requests[i]})
+				if tc.reserved != nil {
+					expected = append(expected, itemApplication.ItemDto{ProductID: item.Product.ID, Count: tc.reserved[i]})
+				}
+			}
+			useCase := itemApplication.NewUseCase(uow)
+
+			reserved, err := useCase.Reserve(s.ctx, dto)
+
+			if tc.expectedErr == nil {
+				require.NoError(s.T(), err)
+				require.ElementsMatch(s.T(), expected, reserved)
+			} else {
+				require.ErrorIs(s.T(), err, tc.expectedErr)
+				require.Nil(s.T(), reserved)
+			}
+
+			uow.AssertExpectations(s.T())
+		})
+	}
+}
+
 func (s *ItemUseCaseTestSuite) TestRelease() {
 	tests := []struct {
 		name        string
@@ -358,6 +455,30 @@ func (s *ItemUseCaseTestSuite) TestRelease() {
 	}
 }
 
+func (s *ItemUseCaseTestSuite) TestReleaseDuplicateLines() {
+	uow := mocks.NewUowMock()
+	item := s.createTestItems(10)[0]
+	productID := item.Product.ID
+
+	uow.ItemMock.On("GetAllByProductIDs", s.ctx, productID).
+		Return([]*itemDomain.Item{item}, nil).Once()
+	uow.ItemMock.On("Update", s.ctx, mock.MatchedBy(func(item *itemDomain.Item) bool {
+		return item.Count == 17
+	})).Return(nil).Once()
+	uow.On("Transaction", s.ctx, mock.Anything).Once()
+	useCase := itemApplication.NewUseCase(uow)
+
+	err := useCase.Release(s.ctx, itemApplication.ReleaseDto{
+		Items: []itemApplication.ItemDto{
+			{ProductID: productID, Count: 3},
+			{ProductID: productID, Count: 4},
+		},
+	})
+
+	require.NoError(s.T(), err)
+	uow.AssertExpectations(s.T())
+}
+
 func (s *ItemUseCaseTestSuite) TestGetAll() {
 	tests := []struct {
 		name        string
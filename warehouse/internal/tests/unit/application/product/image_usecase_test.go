@@ -28,7 +28,7 @@ func (s *ProductImageUseCaseTestSuite) SetupTest() {
 }
 
 func (s *ProductImageUseCaseTestSuite) createTestProduct() *productDomain.Product {
-	product, _, err := productDomain.Create("Test Product", decimal.NewFromInt(10), "product.png")
+	product, _, err := productDomain.Create("Test Product", productDomain.Money{Amount: decimal.NewFromInt(10), Currency: "USD"}, "product.png")
 	require.NoError(s.T(), err)
 	return product
 }
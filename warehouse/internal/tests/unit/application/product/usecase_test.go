@@ -34,7 +34,7 @@ func (s *ProductUseCaseTestSuite) TestCreate() {
 	}{
 		{
 			name: "Success",
-			dto:  productApplication.CreateDto{Name: "ValidProduct", Price: decimal.NewFromInt(1)},
+			dto:  productApplication.CreateDto{Name: "ValidProduct", Price: productDomain.Money{Amount: decimal.NewFromInt(1), Currency: "USD"}},
 			setup: func(uow *mocks.UoWMock, imageServiceMock *productMock.ImageServiceMock) {
 				uow.On("Transaction", s.ctx, mock.Anything).Once()
 				imageServiceMock.On("Create", s.ctx).
@@ -48,7 +48,7 @@ func (s *ProductUseCaseTestSuite) TestCreate() {
 		},
 		{
 			name: "Failure: Create product error (invalid price)",
-			dto:  productApplication.CreateDto{Name: "ValidProduct", Price: decimal.NewFromInt(-1)},
+			dto:  productApplication.CreateDto{Name: "ValidProduct", Price: productDomain.Money{Amount: decimal.NewFromInt(-1), Currency: "USD"}},
 			setup: func(uow *mocks.UoWMock, imageServiceMock *productMock.ImageServiceMock) {
 				imageServiceMock.On("Create", s.ctx).
 					Return("image_path", nil).Once()
@@ -57,7 +57,7 @@ func (s *ProductUseCaseTestSuite) TestCreate() {
 		},
 		{
 			name: "Failure: Product repository create error",
-			dto:  productApplication.CreateDto{Name: "ValidProduct", Price: decimal.NewFromInt(1)},
+			dto:  productApplication.CreateDto{Name: "ValidProduct", Price: productDomain.Money{Amount: decimal.NewFromInt(1), Currency: "USD"}},
 			setup: func(uow *mocks.UoWMock, imageServiceMock *productMock.ImageServiceMock) {
 				uow.On("Transaction", s.ctx, mock.Anything).Once()
 				imageServiceMock.On("Create", s.ctx).
@@ -69,7 +69,7 @@ func (s *ProductUseCaseTestSuite) TestCreate() {
 		},
 		{
 			name: "Failure: Outbox repository create error",
-			dto:  productApplication.CreateDto{Name: "ValidProduct", Price: decimal.NewFromInt(1)},
+			dto:  productApplication.CreateDto{Name: "ValidProduct", Price: productDomain.Money{Amount: decimal.NewFromInt(1), Currency: "USD"}},
 			setup: func(uow *mocks.UoWMock, imageServiceMock *productMock.ImageServiceMock) {
 				uow.On("Transaction", s.ctx, mock.Anything).Once()
 				imageServiceMock.On("Create", s.ctx).
@@ -83,7 +83,7 @@ func (s *ProductUseCaseTestSuite) TestCreate() {
 		},
 		{
 			name: "Failure: UoW transaction error",
-			dto:  productApplication.CreateDto{Name: "ValidProduct", Price: decimal.NewFromInt(1)},
+			dto:  productApplication.CreateDto{Name: "ValidProduct", Price: productDomain.Money{Amount: decimal.NewFromInt(1), Currency: "USD"}},
 			setup: func(uow *mocks.UoWMock, imageServiceMock *productMock.ImageServiceMock) {
 				imageServiceMock.On("Create", s.ctx).
 					Return("image_path", nil).Once()
@@ -120,6 +120,65 @@ func (s *ProductUseCaseTestSuite) TestCreate() {
 	}
 }
 
+func (s *ProductUseCaseTestSuite) TestGetByIDs() {
+	product := &productDomain.Product{ID: uuid.New(), Name: "Product", Price: productDomain.Money{Amount: decimal.NewFromInt(10), Currency: "USD"}}
+	missingID := uuid.New()
+
+	tests := []struct {
+		name        string
+		productIDs  []uuid.UUID
+		setup       func(uow *mocks.UoWMock)
+		expected    []*productDomain.Product
+		expectedErr error
+	}{
+		{
+			name:       "Success",
+			productIDs: []uuid.UUID{product.ID, missingID},
+			setup: func(uow *mocks.UoWMock) {
+				uow.ProductMock.On("GetAllByIDs", s.ctx, product.ID, missingID).
+					Return([]*productDomain.Product{product}, nil).Once()
+			},
+			expected: []*productDomain.Product{product},
+		},
+		{
+			name:       "Success: No product IDs",
+			productIDs: []uuid.UUID{},
+			setup:      func(uow *mocks.UoWMock) {},
+			expected:   []*productDomain.Product{},
+		},
+		{
+			name:       "Failure: Product repository error",
+			productIDs: []uuid.UUID{product.ID},
+			setup: func(uow *mocks.UoWMock) {
+				uow.ProductMock.On("GetAllByIDs", s.ctx, product.ID).
+					Return([]*productDomain.Product(nil), errors.New("query error")).Once()
+			},
+			expectedErr: errors.New("query error"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.T().Parallel()
+			uow := mocks.NewUowMock()
+			tc.setup(uow)
+			usecase := productApplication.NewUseCase(uow, &productMock.ImageServiceMock{})
+
+			products, err := usecase.GetByIDs(s.ctx, tc.productIDs)
+
+			if tc.expectedErr == nil {
+				require.NoError(s.T(), err)
+				require.Equal(s.T(), tc.expected, products)
+			} else {
+				require.EqualError(s.T(), err, tc.expectedErr.Error())
+			}
+
+			uow.AssertExpectations(s.T())
+		})
+	}
+}
+
 func TestProductUseCaseTestSuite(t *testing.T) {
 	suite.Run(t, new(ProductUseCaseTestSuite))
 }
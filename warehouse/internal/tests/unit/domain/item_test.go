@@ -15,7 +15,7 @@ type ItemDomainTestSuite struct {
 }
 
 func (i *ItemDomainTestSuite) createProduct(name string, price decimal.Decimal) *productDomain.Product {
-	product, _, err := productDomain.Create(name, price, "product.png")
+	product, _, err := productDomain.Create(name, productDomain.Money{Amount: price, Currency: "USD"}, "product.png")
 	require.NoError(i.T(), err)
 	return product
 }
@@ -152,6 +152,64 @@ func (i *ItemDomainTestSuite) TestReserve() {
 	}
 }
 
+func (i *ItemDomainTestSuite) TestReserveAvailable() {
+	tests := []struct {
+		name             string
+		stock            int
+		reserveCount     int
+		expectedReserved int
+		expectedCount    int
+		expectedErr      error
+	}{
+		{
+			name:             "Success: In stock",
+			stock:            10,
+			reserveCount:     4,
+			expectedReserved: 4,
+			expectedCount:    6,
+		},
+		{
+			name:             "Success: Short",
+			stock:            3,
+			reserveCount:     5,
+			expectedReserved: 3,
+			expectedCount:    0,
+		},
+		{
+			name:             "Success: Out of stock",
+			stock:            0,
+			reserveCount:     5,
+			expectedReserved: 0,
+			expectedCount:    0,
+		},
+		{
+			name:          "Failure: Zero reserve count",
+			stock:         10,
+			reserveCount:  0,
+			expectedCount: 10,
+			expectedErr:   itemDomain.ErrInvalidItemCount,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		i.Run(tc.name, func() {
+			i.T().Parallel()
+			item := i.createItem(i.createProduct("test", decimal.NewFromInt(1)), tc.stock)
+
+			reserved, err := item.ReserveAvailable(tc.reserveCount)
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(i.T(), err, tc.expectedErr)
+			} else {
+				require.NoError(i.T(), err)
+			}
+			require.Equal(i.T(), tc.expectedReserved, reserved)
+			require.Equal(i.T(), tc.expectedCount, item.Count)
+		})
+	}
+}
+
 func (i *ItemDomainTestSuite) TestRelease() {
 	tests := []struct {
 		name          string
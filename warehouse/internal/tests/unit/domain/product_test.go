@@ -18,44 +18,51 @@ func (p *ProductDomainTestSuite) TestCreate() {
 		name        string
 		pName       string
 		path        string
-		price       decimal.Decimal
+		price       productDomain.Money
 		expectedErr error
 	}{
 		{
 			name:        "Success",
 			pName:       "test",
 			path:        "product.png",
-			price:       decimal.NewFromInt(1),
+			price:       productDomain.Money{Amount: decimal.NewFromInt(1), Currency: "USD"},
 			expectedErr: nil,
 		},
 		{
 			name:        "Failure: Empty name",
 			pName:       "",
 			path:        "product.png",
-			price:       decimal.NewFromInt(1),
+			price:       productDomain.Money{Amount: decimal.NewFromInt(1), Currency: "USD"},
 			expectedErr: productDomain.ErrInvalidProductName,
 		},
 		{
 			name:        "Failure: Name consists only of whitespaces",
 			pName:       "   ",
 			path:        "product.png",
-			price:       decimal.NewFromInt(1),
+			price:       productDomain.Money{Amount: decimal.NewFromInt(1), Currency: "USD"},
 			expectedErr: productDomain.ErrInvalidProductName,
 		},
 		{
 			name:        "Failure: Price is zero",
 			pName:       "test",
 			path:        "product.png",
-			price:       decimal.Zero,
+			price:       productDomain.Money{Amount: decimal.Zero, Currency: "USD"},
 			expectedErr: productDomain.ErrInvalidProductPrice,
 		},
 		{
 			name:        "Failure: Price less then zero",
 			pName:       "test",
 			path:        "product.png",
-			price:       decimal.NewFromInt(-1),
+			price:       productDomain.Money{Amount: decimal.NewFromInt(-1), Currency: "USD"},
 			expectedErr: productDomain.ErrInvalidProductPrice,
 		},
+		{
+			name:        "Failure: Invalid currency",
+			pName:       "test",
+			path:        "product.png",
+			price:       productDomain.Money{Amount: decimal.NewFromInt(1), Currency: "dollar"},
+			expectedErr: productDomain.ErrInvalidCurrency,
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,268 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	inboxDomain "warehouse/internal/domain/inbox"
+	itemDomain "warehouse/internal/domain/item"
+	outboxDomain "warehouse/internal/domain/outbox"
+	productDomain "warehouse/internal/domain/product"
+	"warehouse/internal/domain/uow"
+	"warehouse/internal/infrastructure/memory"
+	itemRepository "warehouse/internal/infrastructure/repository/item"
+	productRepository "warehouse/internal/infrastructure/repository/product"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type MemoryTestSuite struct {
+	suite.Suite
+	ctx   context.Context
+	store *memory.Store
+	uow   uow.UoW
+}
+
+func (s *MemoryTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.store = memory.NewStore()
+	s.uow = memory.NewUoW(s.store)
+}
+
+func (s *MemoryTestSuite) createItem(count int) *itemDomain.Item {
+	product, _, err := productDomain.Create("Product", productDomain.Money{Amount: decimal.NewFromInt(10), Currency: "USD"}, "image")
+	require.NoError(s.T(), err)
+	item, err := itemDomain.Create(product, count)
+	require.NoError(s.T(), err)
+
+	require.NoError(s.T(), s.uow.Product().Create(s.ctx, product))
+	require.NoError(s.T(), s.uow.Item().Create(s.ctx, item))
+	return item
+}
+
+func (s *MemoryTestSuite) TestItemCreate() {
+	item := s.createItem(1)
+
+	err := s.uow.Item().Create(s.ctx, item)
+	require.ErrorIs(s.T(), err, itemRepository.ErrItemAlreadyExists)
+
+	orphan, err := itemDomain.Create(&productDomain.Product{ID: uuid.New()}, 1)
+	require.NoError(s.T(), err)
+	err = s.uow.Item().Create(s.ctx, orphan)
+	require.ErrorIs(s.T(), err, productRepository.ErrProductNotFound)
+}
+
+func (s *MemoryTestSuite) TestItemUpdate() {
+	tests := []struct {
+		name          string
+		item          func(stored *itemDomain.Item) *itemDomain.Item
+		expectedError error
+	}{
+		{
+			name:          "Success",
+			item:          func(stored *itemDomain.Item) *itemDomain.Item { return stored },
+			expectedError: nil,
+		},
+		{
+			name: "Failure: Stale version",
+			item: func(stored *itemDomain.Item) *itemDomain.Item {
+				stale := *stored
+				stale.Version = uuid.New()
+				return &stale
+			},
+			expectedError: itemRepository.ErrItemNotFound,
+		},
+		{
+			name: "Failure: Item not found",
+			item: func(stored *itemDomain.Item) *itemDomain.Item {
+				missing := *stored
+				missing.ID = uuid.New()
+				return &missing
+			},
+			expectedError: itemRepository.ErrItemNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			stored := s.createItem(5)
+			item := tc.item(stored)
+			item.Count = 3
+
+			err := s.uow.Item().Update(s.ctx, item)
+
+			got, getErr := s.uow.Item().GetByID(s.ctx, stored.ID)
+			require.NoError(s.T(), getErr)
+			if tc.expectedError != nil {
+				require.ErrorIs(s.T(), err, tc.expectedError)
+				require.Equal(s.T(), 5, got.Count)
+				return
+			}
+			require.NoError(s.T(), err)
+			require.Equal(s.T(), 3, got.Count)
+			require.NotEqual(s.T(), stored.Version, got.Version)
+		})
+	}
+}
+
+func (s *MemoryTestSuite) TestGetAllByProductIDs() {
+	first := s.createItem(1)
+	second := s.createItem(2)
+
+	items, err := s.uow.Item().GetAllByProductIDs(s.ctx, first.Product.ID, second.Product.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), items, 2)
+
+	_, err = s.uow.Item().GetAllByProductIDs(s.ctx, first.Product.ID, uuid.New())
+	require.ErrorIs(s.T(), err, itemRepository.ErrItemsNotFound)
+}
+
+func (s *MemoryTestSuite) TestTransaction() {
+	tests := []struct {
+		name             string
+		fnErr            error
+		expectedCount    int
+		expectedMessages int
+	}{
+		{name: "Success: Changes committed", fnErr: nil, expectedCount: 4, expectedMessages: 1},
+		{name: "Failure: Changes rolled back", fnErr: errors.New("transaction error"), expectedCount: 5, expectedMessages: 0},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			item := s.createItem(5)
+
+			err := s.uow.Transaction(s.ctx, func(tx uow.UoW) error {
+				require.NoError(s.T(), item.Reserve(1))
+				if err := tx.Item().Update(s.ctx, item); err != nil {
+					return err
+				}
+				message := &outboxDomain.Message{ID: uuid.New(), Name: "event", Payload: []byte("{}"), Metadata: map[string]any{}}
+				if err := tx.Outbox().Create(s.ctx, message); err != nil {
+					return err
+				}
+				return tc.fnErr
+			})
+			require.ErrorIs(s.T(), err, tc.fnErr)
+
+			got, err := s.uow.Item().GetByID(s.ctx, item.ID)
+			require.NoError(s.T(), err)
+			require.Equal(s.T(), tc.expectedCount, got.Count)
+
+			messages, err := memory.NewOutboxRepository(s.store).GetAll(s.ctx)
+			require.NoError(s.T(), err)
+			require.Len(s.T(), messages, tc.expectedMessages)
+		})
+	}
+}
+
+func (s *MemoryTestSuite) TestNestedTransaction() {
+	tests := []struct {
+		name          string
+		innerErr      error
+		expectedCount int
+	}{
+		{name: "Success: Inner changes committed with the outer", innerErr: nil, expectedCount: 4},
+		{name: "Failure: Inner changes rolled back alone", innerErr: errors.New("inner error"), expectedCount: 5},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			item := s.createItem(5)
+			message := inboxDomain.Create(uuid.New(), nil, time.Hour)
+
+			err := s.uow.Transaction(s.ctx, func(tx uow.UoW) error {
+				innerErr := tx.Transaction(s.ctx, func(inner uow.UoW) error {
+					reserved := *item
+					require.NoError(s.T(), reserved.Reserve(1))
+					if err := inner.Item().Update(s.ctx, &reserved); err != nil {
+						return err
+					}
+					return tc.innerErr
+				})
+				require.ErrorIs(s.T(), innerErr, tc.innerErr)
+				return tx.Inbox().Create(s.ctx, message)
+			})
+			require.NoError(s.T(), err)
+
+			got, err := s.uow.Item().GetByID(s.ctx, item.ID)
+			require.NoError(s.T(), err)
+			require.Equal(s.T(), tc.expectedCount, got.Count)
+
+			_, err = s.uow.Inbox().GetByID(s.ctx, message.ID)
+			require.NoError(s.T(), err)
+		})
+	}
+}
+
+func (s *MemoryTestSuite) TestConcurrentReserve() {
+	const workers = 20
+	item := s.createItem(workers)
+
+	// Every worker retries on a version conflict until its reservation is in.
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				current, err := s.uow.Item().GetByID(s.ctx, item.ID)
+				if err != nil {
+					return
+				}
+				err = s.uow.Transaction(s.ctx, func(tx uow.UoW) error {
+					if err := current.Reserve(1); err != nil {
+						return err
+					}
+					return tx.Item().Update(s.ctx, current)
+				})
+				if !errors.Is(err, itemRepository.ErrItemNotFound) {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	got, err := s.uow.Item().GetByID(s.ctx, item.ID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 0, got.Count)
+}
+
+func (s *MemoryTestSuite) TestImageService() {
+	images := memory.NewImageService()
+
+	path, err := images.Create(s.ctx)
+	require.NoError(s.T(), err)
+
+	reader, contentType, err := images.Get(s.ctx, path)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "image/png", contentType)
+	require.NoError(s.T(), reader.Close())
+
+	err = images.Update(s.ctx, path, strings.NewReader("jpeg"), "image/jpeg")
+	require.NoError(s.T(), err)
+
+	reader, contentType, err = images.Get(s.ctx, path)
+	require.NoError(s.T(), err)
+	data, err := io.ReadAll(reader)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "jpeg", string(data))
+	require.Equal(s.T(), "image/jpeg", contentType)
+
+	_, _, err = images.Get(s.ctx, "missing")
+	require.Error(s.T(), err)
+}
+
+func TestMemory(t *testing.T) {
+	suite.Run(t, new(MemoryTestSuite))
+}
@@ -0,0 +1,102 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"warehouse/internal/infrastructure/messaging/retry"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+var errTransient = errors.New("transient error")
+
+type RetryPolicyTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *RetryPolicyTestSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func (s *RetryPolicyTestSuite) newPolicy(maxAttempts int) *retry.Policy {
+	return retry.NewPolicy(
+		&retry.Config{
+			MaxAttempts:    maxAttempts,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     4 * time.Millisecond,
+		},
+		func(err error) bool { return !retry.IsPermanent(err) },
+	)
+}
+
+func (s *RetryPolicyTestSuite) TestDo() {
+	tests := []struct {
+		name             string
+		failures         []error
+		expectedAttempts int
+		expectedErr      error
+	}{
+		{
+			name:             "Success: First attempt",
+			failures:         nil,
+			expectedAttempts: 1,
+			expectedErr:      nil,
+		},
+		{
+			name:             "Success: After transient failures",
+			failures:         []error{errTransient, errTransient},
+			expectedAttempts: 3,
+			expectedErr:      nil,
+		},
+		{
+			name:             "Failure: Attempts exhausted",
+			failures:         []error{errTransient, errTransient, errTransient, errTransient},
+			expectedAttempts: 3,
+			expectedErr:      errTransient,
+		},
+		{
+			name:             "Failure: Permanent error is not retried",
+			failures:         []error{retry.Permanent(errTransient)},
+			expectedAttempts: 1,
+			expectedErr:      errTransient,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			calls := 0
+			attempts, err := s.newPolicy(3).Do(s.ctx, func(context.Context) error {
+				calls++
+				if calls <= len(test.failures) {
+					return test.failures[calls-1]
+				}
+				return nil
+			})
+
+			require.Equal(s.T(), test.expectedAttempts, attempts)
+			require.Equal(s.T(), test.expectedAttempts, calls)
+			if test.expectedErr != nil {
+				require.ErrorIs(s.T(), err, test.expectedErr)
+			} else {
+				require.NoError(s.T(), err)
+			}
+		})
+	}
+}
+
+func (s *RetryPolicyTestSuite) TestBackoff() {
+	policy := s.newPolicy(5)
+
+	require.Equal(s.T(), time.Millisecond, policy.Backoff(1))
+	require.Equal(s.T(), 2*time.Millisecond, policy.Backoff(2))
+	require.Equal(s.T(), 4*time.Millisecond, policy.Backoff(3))
+	require.Equal(s.T(), 4*time.Millisecond, policy.Backoff(10))
+}
+
+func TestRetryPolicyTestSuite(t *testing.T) {
+	suite.Run(t, new(RetryPolicyTestSuite))
+}
@@ -0,0 +1,92 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+	"warehouse/internal/infrastructure/messaging/worker"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type WorkerPoolTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *WorkerPoolTestSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func (s *WorkerPoolTestSuite) TestSameKeyKeepsOrder() {
+	pool := worker.NewPool(&worker.Config{Concurrency: 4})
+	pool.Start()
+
+	var mu sync.Mutex
+	var handled []int
+	msg := &kafka.Message{Key: []byte("order-id")}
+	for i := 0; i < 50; i++ {
+		require.True(s.T(), pool.Submit(s.ctx, msg, func() {
+			mu.Lock()
+			defer mu.Unlock()
+			handled = append(handled, i)
+		}))
+	}
+	pool.Stop()
+
+	require.Len(s.T(), handled, 50)
+	for i, n := range handled {
+		require.Equal(s.T(), i, n)
+	}
+}
+
+func (s *WorkerPoolTestSuite) TestPartitionsRunInParallel() {
+	pool := worker.NewPool(&worker.Config{Concurrency: 2})
+	pool.Start()
+	defer pool.Stop()
+
+	released := make(chan struct{})
+	done := make(chan struct{})
+
+	// The first task only finishes once the second, owned by another worker, has run.
+	pool.Submit(s.ctx, &kafka.Message{Partition: 0}, func() {
+		<-released
+		close(done)
+	})
+	pool.Submit(s.ctx, &kafka.Message{Partition: 1}, func() {
+		close(released)
+	})
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		s.T().Fatalf("tasks of different partitions were not handled in parallel")
+	}
+}
+
+func (s *WorkerPoolTestSuite) TestStopDrainsQueuedTasks() {
+	pool := worker.NewPool(&worker.Config{Concurrency: 2})
+	pool.Start()
+
+	var mu sync.Mutex
+	handled := 0
+	for i := 0; i < 10; i++ {
+		pool.Submit(s.ctx, &kafka.Message{Partition: i}, func() {
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			defer mu.Unlock()
+			handled++
+		})
+	}
+	pool.Stop()
+
+	require.Equal(s.T(), 10, handled)
+}
+
+func TestWorkerPoolTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkerPoolTestSuite))
+}